@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opencensus.io/stats"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhousemetricsexporter/base"
+)
+
+const dayMillis = int64(24 * time.Hour / time.Millisecond)
+
+// backfillWriter writes historical time series to the underlying storage one
+// day at a time, so that each INSERT only touches the daily partition of its
+// samples, and throttles the written samples so that a backfill does not
+// starve the live ingestion.
+type backfillWriter struct {
+	storage base.Storage
+	// interval is the time reserved for writing a single sample, zero when
+	// the writes are not throttled.
+	interval time.Duration
+
+	mu sync.Mutex
+	// next is the time from which the next write may start.
+	next time.Time
+	// oldest and newest are the bounds of the written sample timestamps.
+	oldest, newest int64
+	written        bool
+}
+
+var _ base.Storage = (*backfillWriter)(nil)
+
+func newBackfillWriter(storage base.Storage, cfg BackfillSettings) *backfillWriter {
+	var interval time.Duration
+	if cfg.MaxSamplesPerSecond > 0 {
+		interval = time.Second / time.Duration(cfg.MaxSamplesPerSecond)
+	}
+	return &backfillWriter{
+		storage:  storage,
+		interval: interval,
+	}
+}
+
+// Write splits the time series of the request by day and writes the days in
+// chronological order, waiting before each write for the time reserved for
+// the samples written before it.
+func (b *backfillWriter) Write(ctx context.Context, data *prompb.WriteRequest) error {
+	for _, day := range splitByDay(data) {
+		samples := 0
+		for _, ts := range day.Timeseries {
+			samples += len(ts.Samples)
+		}
+		if err := b.wait(ctx, samples); err != nil {
+			return err
+		}
+		if err := b.storage.Write(ctx, day); err != nil {
+			return err
+		}
+		b.record(day, samples)
+	}
+	return nil
+}
+
+// wait reserves the time needed to write the given number of samples and
+// blocks until the previous reservations elapsed.
+func (b *backfillWriter) wait(ctx context.Context, samples int) error {
+	if b.interval == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	if b.next.Before(now) {
+		b.next = now
+	}
+	delay := b.next.Sub(now)
+	b.next = b.next.Add(time.Duration(samples) * b.interval)
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// record updates the progress metrics with the written samples.
+func (b *backfillWriter) record(data *prompb.WriteRequest, samples int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ts := range data.Timeseries {
+		for _, s := range ts.Samples {
+			if !b.written || s.Timestamp < b.oldest {
+				b.oldest = s.Timestamp
+			}
+			if !b.written || s.Timestamp > b.newest {
+				b.newest = s.Timestamp
+			}
+			b.written = true
+		}
+	}
+	measurements := []stats.Measurement{mBackfillWrittenSamples.M(int64(samples))}
+	if b.written {
+		measurements = append(measurements,
+			mBackfillOldestTimestamp.M(float64(b.oldest)/1000),
+			mBackfillNewestTimestamp.M(float64(b.newest)/1000))
+	}
+	stats.Record(context.Background(), measurements...)
+}
+
+func (b *backfillWriter) Describe(c chan<- *prometheus.Desc) {
+	b.storage.Describe(c)
+}
+
+func (b *backfillWriter) Collect(c chan<- prometheus.Metric) {
+	b.storage.Collect(c)
+}
+
+// splitByDay splits the samples and exemplars of the time series of the
+// request into one request per UTC day, sorted chronologically. Each time
+// series appears in the requests of the days it has samples or exemplars in.
+func splitByDay(data *prompb.WriteRequest) []*prompb.WriteRequest {
+	days := make(map[int64]*prompb.WriteRequest)
+	for _, ts := range data.Timeseries {
+		// index of the time series in the request of each day.
+		indexes := make(map[int64]int)
+		series := func(timestamp int64) *prompb.TimeSeries {
+			day := timestamp / dayMillis
+			if timestamp < 0 && timestamp%dayMillis != 0 {
+				day--
+			}
+			req, ok := days[day]
+			if !ok {
+				req = &prompb.WriteRequest{}
+				days[day] = req
+			}
+			i, ok := indexes[day]
+			if !ok {
+				i = len(req.Timeseries)
+				indexes[day] = i
+				req.Timeseries = append(req.Timeseries, prompb.TimeSeries{Labels: ts.Labels})
+			}
+			return &req.Timeseries[i]
+		}
+		for _, s := range ts.Samples {
+			dayTS := series(s.Timestamp)
+			dayTS.Samples = append(dayTS.Samples, s)
+		}
+		for _, e := range ts.Exemplars {
+			dayTS := series(e.Timestamp)
+			dayTS.Exemplars = append(dayTS.Exemplars, e)
+		}
+	}
+
+	keys := make([]int64, 0, len(days))
+	for day := range days {
+		keys = append(keys, day)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	requests := make([]*prompb.WriteRequest, 0, len(keys))
+	for _, day := range keys {
+		requests = append(requests, days[day])
+	}
+	return requests
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func TestSplitByDay(t *testing.T) {
+	labels := []prompb.Label{{Name: "__name__", Value: "a"}}
+	requests := splitByDay(&prompb.WriteRequest{Timeseries: []prompb.TimeSeries{
+		{
+			Labels: labels,
+			Samples: []prompb.Sample{
+				{Value: 1, Timestamp: 2*dayMillis + 10},
+				{Value: 2, Timestamp: 10},
+				{Value: 3, Timestamp: 2*dayMillis + 20},
+			},
+			Exemplars: []prompb.Exemplar{{Value: 4, Timestamp: dayMillis}},
+		},
+		{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "b"}},
+			Samples: []prompb.Sample{{Value: 5, Timestamp: -10}},
+		},
+	}})
+
+	require.Len(t, requests, 4)
+	assert.Equal(t, []prompb.TimeSeries{
+		{Labels: []prompb.Label{{Name: "__name__", Value: "b"}}, Samples: []prompb.Sample{{Value: 5, Timestamp: -10}}},
+	}, requests[0].Timeseries)
+	assert.Equal(t, []prompb.TimeSeries{
+		{Labels: labels, Samples: []prompb.Sample{{Value: 2, Timestamp: 10}}},
+	}, requests[1].Timeseries)
+	assert.Equal(t, []prompb.TimeSeries{
+		{Labels: labels, Exemplars: []prompb.Exemplar{{Value: 4, Timestamp: dayMillis}}},
+	}, requests[2].Timeseries)
+	assert.Equal(t, []prompb.TimeSeries{
+		{Labels: labels, Samples: []prompb.Sample{{Value: 1, Timestamp: 2*dayMillis + 10}, {Value: 3, Timestamp: 2*dayMillis + 20}}},
+	}, requests[3].Timeseries)
+}
+
+func TestBackfillWriterProgress(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	storage := &mockStorage{}
+	b := newBackfillWriter(storage, BackfillSettings{Enabled: true})
+
+	data := writeRequest("a", 3)
+	data.Timeseries[0].Samples = append(data.Timeseries[0].Samples, prompb.Sample{Value: 1, Timestamp: dayMillis + 5000})
+	require.NoError(t, b.Write(context.Background(), data))
+
+	assert.Len(t, storage.writes(), 2)
+	assert.Equal(t, 4.0, viewValue(t, mBackfillWrittenSamples.Name()))
+	assert.Equal(t, 0.0, viewValue(t, mBackfillOldestTimestamp.Name()))
+	assert.Equal(t, float64(dayMillis+5000)/1000, viewValue(t, mBackfillNewestTimestamp.Name()))
+}
+
+func viewValue(t *testing.T, name string) float64 {
+	rows, err := view.RetrieveData(name)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	switch data := rows[0].Data.(type) {
+	case *view.SumData:
+		return data.Value
+	case *view.LastValueData:
+		return data.Value
+	}
+	t.Fatalf("unexpected aggregation data %T", rows[0].Data)
+	return 0
+}
+
+func TestBackfillWriterThrottle(t *testing.T) {
+	storage := &mockStorage{}
+	b := newBackfillWriter(storage, BackfillSettings{Enabled: true, MaxSamplesPerSecond: 100})
+
+	start := time.Now()
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 5)))
+	require.NoError(t, b.Write(context.Background(), writeRequest("b", 5)))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Len(t, storage.writes(), 2)
+
+	// the reservations of the previous writes are not cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, b.Write(context.Background(), writeRequest("c", 100)))
+	assert.ErrorIs(t, b.Write(ctx, writeRequest("d", 1)), context.Canceled)
+	assert.Len(t, storage.writes(), 3)
+}
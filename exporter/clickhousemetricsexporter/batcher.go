@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhousemetricsexporter/base"
+)
+
+// timeSeriesBatcher buffers the written time series and hands them to the
+// underlying storage once enough samples were buffered or the flush interval
+// elapsed, so that frequent small writes are merged into fewer INSERTs.
+//
+// The buffered time series are only dropped once they were written: a failed
+// flush puts them back in the buffer to be written by the next flush, except
+// for the time series of the write which triggered the flush, which gets the
+// error so that its request is retried by the exporter.
+type timeSeriesBatcher struct {
+	storage       base.Storage
+	maxSamples    int
+	flushInterval time.Duration
+	logger        *zap.Logger
+
+	mu      sync.Mutex
+	pending []*prompb.WriteRequest
+	samples int
+
+	// serializes flushes so that batches reach the storage in order.
+	flushMu sync.Mutex
+
+	started bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+var _ base.Storage = (*timeSeriesBatcher)(nil)
+
+func newTimeSeriesBatcher(storage base.Storage, cfg BatchSettings, logger *zap.Logger) *timeSeriesBatcher {
+	return &timeSeriesBatcher{
+		storage:       storage,
+		maxSamples:    cfg.MaxSamples,
+		flushInterval: cfg.FlushInterval,
+		logger:        logger,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+// start runs the periodic flush until shutdown is called.
+func (b *timeSeriesBatcher) start() {
+	b.started = true
+	go func() {
+		defer close(b.doneCh)
+		ticker := time.NewTicker(b.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.stopCh:
+				return
+			case <-ticker.C:
+				if err := b.flush(context.Background(), nil); err != nil {
+					b.logger.Error("failed to flush batched time series, retrying with the next flush", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// shutdown stops the periodic flush and writes the remaining time series.
+func (b *timeSeriesBatcher) shutdown(ctx context.Context) error {
+	if b.started {
+		close(b.stopCh)
+		<-b.doneCh
+	}
+	return b.flush(ctx, nil)
+}
+
+// Write buffers the time series of the request. The buffered time series are
+// flushed synchronously when they hold at least maxSamples samples, in which
+// case the flush error is returned and the time series of the request are not
+// kept in the buffer.
+func (b *timeSeriesBatcher) Write(ctx context.Context, data *prompb.WriteRequest) error {
+	b.mu.Lock()
+	b.pending = append(b.pending, data)
+	b.samples += countSamples(data)
+	full := b.samples >= b.maxSamples
+	b.mu.Unlock()
+
+	if !full {
+		return nil
+	}
+	return b.flush(ctx, data)
+}
+
+// flush writes all the buffered time series to the underlying storage. If the
+// write fails, the time series are put back in the buffer, except for the ones
+// of the failed request which are left to be retried by its sender.
+func (b *timeSeriesBatcher) flush(ctx context.Context, failed *prompb.WriteRequest) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.samples = 0
+	b.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var timeseries []prompb.TimeSeries
+	for _, req := range pending {
+		timeseries = append(timeseries, req.Timeseries...)
+	}
+	err := b.storage.Write(ctx, &prompb.WriteRequest{Timeseries: timeseries})
+	if err == nil {
+		return nil
+	}
+
+	requeued := make([]*prompb.WriteRequest, 0, len(pending))
+	samples := 0
+	for _, req := range pending {
+		if req == failed {
+			continue
+		}
+		requeued = append(requeued, req)
+		samples += countSamples(req)
+	}
+	b.mu.Lock()
+	b.pending = append(requeued, b.pending...)
+	b.samples += samples
+	b.mu.Unlock()
+	return err
+}
+
+// countSamples returns the number of samples of the request.
+func countSamples(data *prompb.WriteRequest) int {
+	samples := 0
+	for _, ts := range data.Timeseries {
+		samples += len(ts.Samples)
+	}
+	return samples
+}
+
+func (b *timeSeriesBatcher) Describe(c chan<- *prometheus.Desc) {
+	b.storage.Describe(c)
+}
+
+func (b *timeSeriesBatcher) Collect(c chan<- prometheus.Metric) {
+	b.storage.Collect(c)
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type mockStorage struct {
+	mu       sync.Mutex
+	requests []*prompb.WriteRequest
+	err      error
+}
+
+func (s *mockStorage) Write(_ context.Context, data *prompb.WriteRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, data)
+	return s.err
+}
+
+func (s *mockStorage) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *mockStorage) writes() []*prompb.WriteRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *mockStorage) Describe(chan<- *prometheus.Desc) {}
+
+func (s *mockStorage) Collect(chan<- prometheus.Metric) {}
+
+func writeRequest(name string, samples int) *prompb.WriteRequest {
+	ts := prompb.TimeSeries{
+		Labels: []prompb.Label{{Name: "__name__", Value: name}},
+	}
+	for i := 0; i < samples; i++ {
+		ts.Samples = append(ts.Samples, prompb.Sample{Value: float64(i), Timestamp: int64(i)})
+	}
+	return &prompb.WriteRequest{Timeseries: []prompb.TimeSeries{ts}}
+}
+
+func TestTimeSeriesBatcherFlushOnMaxSamples(t *testing.T) {
+	storage := &mockStorage{}
+	b := newTimeSeriesBatcher(storage, BatchSettings{MaxSamples: 5, FlushInterval: time.Hour}, zap.NewNop())
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 2)))
+	require.NoError(t, b.Write(context.Background(), writeRequest("b", 2)))
+	assert.Empty(t, storage.writes())
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("c", 1)))
+	require.Len(t, storage.writes(), 1)
+	assert.Len(t, storage.writes()[0].Timeseries, 3)
+
+	// the buffer is empty after a flush
+	require.NoError(t, b.shutdown(context.Background()))
+	assert.Len(t, storage.writes(), 1)
+}
+
+func TestTimeSeriesBatcherFlushOnInterval(t *testing.T) {
+	storage := &mockStorage{}
+	b := newTimeSeriesBatcher(storage, BatchSettings{MaxSamples: 1000, FlushInterval: 10 * time.Millisecond}, zap.NewNop())
+	b.start()
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 1)))
+	assert.Eventually(t, func() bool {
+		return len(storage.writes()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, b.shutdown(context.Background()))
+	assert.Len(t, storage.writes(), 1)
+}
+
+func TestTimeSeriesBatcherShutdownFlushes(t *testing.T) {
+	storage := &mockStorage{err: errors.New("write failed")}
+	b := newTimeSeriesBatcher(storage, BatchSettings{MaxSamples: 1000, FlushInterval: time.Hour}, zap.NewNop())
+	b.start()
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 1)))
+	require.NoError(t, b.Write(context.Background(), writeRequest("b", 1)))
+
+	assert.Error(t, b.shutdown(context.Background()))
+	require.Len(t, storage.writes(), 1)
+	assert.Len(t, storage.writes()[0].Timeseries, 2)
+}
+
+func TestTimeSeriesBatcherFailedFlushOnMaxSamples(t *testing.T) {
+	storage := &mockStorage{err: errors.New("write failed")}
+	b := newTimeSeriesBatcher(storage, BatchSettings{MaxSamples: 3, FlushInterval: time.Hour}, zap.NewNop())
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 1)))
+	require.NoError(t, b.Write(context.Background(), writeRequest("b", 1)))
+	// the request triggering the failed flush gets the error
+	assert.Error(t, b.Write(context.Background(), writeRequest("c", 1)))
+	require.Len(t, storage.writes(), 1)
+	assert.Len(t, storage.writes()[0].Timeseries, 3)
+
+	// the time series of the other requests are written by the next flush,
+	// the ones of the failed request are left to its retry
+	storage.setErr(nil)
+	require.NoError(t, b.shutdown(context.Background()))
+	require.Len(t, storage.writes(), 2)
+	require.Len(t, storage.writes()[1].Timeseries, 2)
+	assert.Equal(t, "a", storage.writes()[1].Timeseries[0].Labels[0].Value)
+	assert.Equal(t, "b", storage.writes()[1].Timeseries[1].Labels[0].Value)
+}
+
+func TestTimeSeriesBatcherFailedFlushOnIntervalIsRetried(t *testing.T) {
+	storage := &mockStorage{err: errors.New("write failed")}
+	b := newTimeSeriesBatcher(storage, BatchSettings{MaxSamples: 1000, FlushInterval: time.Hour}, zap.NewNop())
+
+	require.NoError(t, b.Write(context.Background(), writeRequest("a", 1)))
+	assert.Error(t, b.flush(context.Background(), nil))
+
+	storage.setErr(nil)
+	require.NoError(t, b.Write(context.Background(), writeRequest("b", 1)))
+	require.NoError(t, b.flush(context.Background(), nil))
+	require.Len(t, storage.writes(), 2)
+	require.Len(t, storage.writes()[1].Timeseries, 2)
+	assert.Equal(t, "a", storage.writes()[1].Timeseries[0].Labels[0].Value)
+}
@@ -17,7 +17,10 @@ package clickhousemetricsexporter
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"net/url"
 	"runtime/pprof"
 	"strings"
@@ -27,7 +30,9 @@ import (
 	clickhouse "github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/value"
 	"github.com/sirupsen/logrus"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhousemetricsexporter/base"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhousemetricsexporter/utils/timeseries"
@@ -41,10 +46,22 @@ const (
 
 // clickHouse implements storage interface for the ClickHouse.
 type clickHouse struct {
-	conn                 clickhouse.Conn
+	// conns are the connections to the shards the time series are spread
+	// over, a single connection when the samples are not sharded.
+	conns                []clickhouse.Conn
 	l                    *logrus.Entry
 	database             string
 	maxTimeSeriesInQuery int
+	// tablePrefix is the prefix of the tables written to, the prefix of the
+	// Distributed tables when writing through them.
+	tablePrefix string
+	// maxSampleAge is the age beyond which the samples are dropped, zero to
+	// keep the samples of any age.
+	maxSampleAge time.Duration
+	// backfill writes the time series with the timestamp of their first
+	// sample instead of the current time, so that they are in the partitions
+	// of their samples.
+	backfill bool
 
 	timeSeriesRW sync.RWMutex
 	// Maintains the lookup map for fingerprints that are
@@ -52,7 +69,22 @@ type clickHouse struct {
 	// unnecessary writes to table for the records that already exist.
 	timeSeries map[uint64]struct{}
 
+	writtenSeriesMu sync.Mutex
+	// Maintains the digests of the time series written to the shards which
+	// succeeded during a write which failed on other shards, so that the
+	// retry of the write only writes the time series of the failed shards
+	// instead of duplicating the samples of the others.
+	writtenSeries      map[uint64]struct{}
+	writtenSeriesOrder []uint64
+
+	metricNamesRW sync.RWMutex
+	// Maintains the names whose aliases are written to the metric names
+	// table, to write the aliases of each name only once.
+	metricNames map[string]struct{}
+
 	mWrittenTimeSeries prometheus.Counter
+	mWrittenTombstones prometheus.Counter
+	mDroppedOldSamples prometheus.Counter
 }
 
 type ClickHouseParams struct {
@@ -60,29 +92,174 @@ type ClickHouseParams struct {
 	DropDatabase         bool
 	MaxOpenConns         int
 	MaxTimeSeriesInQuery int
+	AsyncInsert          bool
+	WaitForAsyncInsert   bool
+	MaxInsertBlockSize   uint64
+	Retention            RetentionSettings
+
+	// ShardDSNs are the DSNs of the shards written to directly in addition
+	// to DSN, which is the first shard.
+	ShardDSNs []string
+
+	// Cluster is the name of the ClickHouse cluster the schema is created
+	// on. When set, the samples are written through the Distributed tables
+	// named with DistributedTablePrefix unless ShardDSNs are set.
+	Cluster                string
+	DistributedTablePrefix string
+
+	// MetricNaming is the naming scheme of the written metric names.
+	MetricNaming string
+	// CompatibilityViews creates the metric_names table and the views
+	// exposing the metrics under the names of the other naming schemes.
+	CompatibilityViews bool
+
+	// MaxSampleAge drops the samples older than this duration, ignored in
+	// Backfill mode.
+	MaxSampleAge time.Duration
+	// Backfill writes historical samples with synchronous INSERTs.
+	Backfill bool
+
+	// ResourceColumns are the resource attributes stored in dedicated
+	// columns of the time series table.
+	ResourceColumns []string
+}
+
+// metricNamesWriter records the names of the written metrics in each naming
+// scheme, backing the naming compatibility views.
+type metricNamesWriter interface {
+	WriteMetricNames(ctx context.Context, aliases []metricNameAlias) error
 }
 
 func NewClickHouse(params *ClickHouseParams) (base.Storage, error) {
 	l := logrus.WithField("component", "clickhouse")
 
-	dsnURL, err := url.Parse(params.DSN)
+	var database string
+	var conns []clickhouse.Conn
+	for _, dsn := range append([]string{params.DSN}, params.ShardDSNs...) {
+		dsnURL, err := url.Parse(dsn)
 
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+		dsnDatabase := dsnURL.Query().Get("database")
+		if dsnDatabase == "" {
+			return nil, fmt.Errorf("database should be set in ClickHouse DSN")
+		}
+		if database != "" && dsnDatabase != database {
+			return nil, fmt.Errorf("all the ClickHouse DSNs should use the database %q", database)
+		}
+		database = dsnDatabase
+
+		conn, err := openConn(dsnURL, params)
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, conn)
 	}
-	database := dsnURL.Query().Get("database")
-	if database == "" {
-		return nil, fmt.Errorf("database should be set in ClickHouse DSN")
+
+	// ON CLUSTER queries are propagated to all the nodes of the cluster,
+	// otherwise the schema is created on each shard.
+	schemaConns := conns
+	if params.Cluster != "" {
+		schemaConns = conns[:1]
+	}
+	queries := schemaQueries(database, params)
+	for _, conn := range schemaConns {
+		for _, q := range queries {
+			q = strings.TrimSpace(q)
+			l.Infof("Executing:\n%s\n", q)
+			if err := conn.Exec(context.Background(), q); err != nil {
+				return nil, err
+			}
+		}
 	}
 
+	var tablePrefix string
+	if params.Cluster != "" && len(conns) == 1 {
+		tablePrefix = params.DistributedTablePrefix
+	}
+
+	var maxSampleAge time.Duration
+	if !params.Backfill {
+		maxSampleAge = params.MaxSampleAge
+	}
+
+	ch := &clickHouse{
+		conns:                conns,
+		l:                    l,
+		database:             database,
+		maxTimeSeriesInQuery: params.MaxTimeSeriesInQuery,
+		tablePrefix:          tablePrefix,
+		maxSampleAge:         maxSampleAge,
+		backfill:             params.Backfill,
+
+		timeSeries:    make(map[uint64]struct{}, 8192),
+		writtenSeries: make(map[uint64]struct{}),
+		metricNames:   make(map[string]struct{}),
+
+		mWrittenTimeSeries: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "written_time_series",
+			Help:      "Number of written time series.",
+		}),
+		mWrittenTombstones: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "written_series_tombstones",
+			Help:      "Number of written series tombstones.",
+		}),
+		mDroppedOldSamples: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "dropped_old_samples",
+			Help:      "Number of samples dropped for being older than the max sample age.",
+		}),
+	}
+
+	go func() {
+		ctx := pprof.WithLabels(context.TODO(), pprof.Labels("component", "clickhouse_reloader"))
+		pprof.SetGoroutineLabels(ctx)
+		ch.runTimeSeriesReloader(ctx)
+	}()
+
+	return ch, nil
+}
+
+func openConn(dsnURL *url.URL, params *ClickHouseParams) (clickhouse.Conn, error) {
+	options := &clickhouse.Options{
+		Addr:     []string{dsnURL.Host},
+		Settings: insertSettings(params),
+	}
+	if dsnURL.Query().Get("username") != "" {
+		auth := clickhouse.Auth{
+			// Database: "",
+			Username: dsnURL.Query().Get("username"),
+			Password: dsnURL.Query().Get("password"),
+		}
+
+		options.Auth = auth
+	}
+	conn, err := clickhouse.Open(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to clickhouse: %s", err)
+	}
+	return conn, nil
+}
+
+// schemaQueries returns the queries creating the database and the tables.
+func schemaQueries(database string, params *ClickHouseParams) []string {
+	onCluster := onClusterClause(params.Cluster)
+
 	var queries []string
 	if params.DropDatabase {
-		queries = append(queries, fmt.Sprintf(`DROP DATABASE IF EXISTS %s`, database))
+		queries = append(queries, fmt.Sprintf(`DROP DATABASE IF EXISTS %s%s`, database, onCluster))
 	}
-	queries = append(queries, fmt.Sprintf(`CREATE DATABASE IF NOT EXISTS %s`, database))
+	queries = append(queries, fmt.Sprintf(`CREATE DATABASE IF NOT EXISTS %s%s`, database, onCluster))
 
 	queries = append(queries, fmt.Sprintf(`
-		CREATE TABLE IF NOT EXISTS %s.samples_v2 (
+		CREATE TABLE IF NOT EXISTS %s.samples_v2%s (
 			metric_name LowCardinality(String),
 			fingerprint UInt64 Codec(DoubleDelta, LZ4),
 			timestamp_ms Int64 Codec(DoubleDelta, LZ4),
@@ -90,7 +267,11 @@ func NewClickHouse(params *ClickHouseParams) (base.Storage, error) {
 		)
 		ENGINE = MergeTree
 			PARTITION BY toDate(timestamp_ms / 1000)
-			ORDER BY (metric_name, fingerprint, timestamp_ms)`, database))
+			ORDER BY (metric_name, fingerprint, timestamp_ms)`, database, onCluster))
+
+	if q := samplesTTLQuery(database, params.Cluster, params.Retention); q != "" {
+		queries = append(queries, q)
+	}
 
 	queries = append(queries, `SET allow_experimental_object_type = 1`)
 
@@ -99,7 +280,7 @@ func NewClickHouse(params *ClickHouseParams) (base.Storage, error) {
 	// using the DEFAULT expression. However, we can use labels_object
 	// in the querying for faster results.
 	queries = append(queries, fmt.Sprintf(`
-		CREATE TABLE IF NOT EXISTS %s.time_series_v2 (
+		CREATE TABLE IF NOT EXISTS %s.time_series_v2%s (
 			metric_name LowCardinality(String),
 			fingerprint UInt64 Codec(DoubleDelta, LZ4),
 			timestamp_ms Int64 Codec(DoubleDelta, LZ4),
@@ -108,57 +289,162 @@ func NewClickHouse(params *ClickHouseParams) (base.Storage, error) {
 		)
 		ENGINE = ReplacingMergeTree
 			PARTITION BY toDate(timestamp_ms / 1000)
-			ORDER BY (metric_name, fingerprint)`, database))
+			ORDER BY (metric_name, fingerprint)`, database, onCluster))
 
-	options := &clickhouse.Options{
-		Addr: []string{dsnURL.Host},
-	}
-	if dsnURL.Query().Get("username") != "" {
-		auth := clickhouse.Auth{
-			// Database: "",
-			Username: dsnURL.Query().Get("username"),
-			Password: dsnURL.Query().Get("password"),
-		}
+	// exemplars of the samples, linking them to the spans they were recorded in.
+	queries = append(queries, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.exemplars%s (
+			metric_name LowCardinality(String),
+			fingerprint UInt64 Codec(DoubleDelta, LZ4),
+			timestamp_ms Int64 Codec(DoubleDelta, LZ4),
+			value Float64 Codec(Gorilla, LZ4),
+			trace_id String Codec(ZSTD(1)),
+			span_id String Codec(ZSTD(1)),
+			attributes String Codec(ZSTD(5))
+		)
+		ENGINE = MergeTree
+			PARTITION BY toDate(timestamp_ms / 1000)
+			ORDER BY (metric_name, fingerprint, timestamp_ms)`, database, onCluster))
 
-		options.Auth = auth
+	// tombstones of the time series marked stale, keeping the timestamp of
+	// the last staleness marker of each time series. A time series is dead
+	// when its tombstone is more recent than its last sample.
+	queries = append(queries, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.series_tombstones%s (
+			metric_name LowCardinality(String),
+			fingerprint UInt64 Codec(DoubleDelta, LZ4),
+			timestamp_ms Int64 Codec(DoubleDelta, LZ4)
+		)
+		ENGINE = ReplacingMergeTree(timestamp_ms)
+			ORDER BY (metric_name, fingerprint)`, database, onCluster))
+
+	if params.CompatibilityViews {
+		queries = append(queries, metricNamesQueries(database, params.MetricNaming)...)
 	}
-	conn, err := clickhouse.Open(options)
 
-	if err != nil {
-		return nil, fmt.Errorf("could not connect to clickhouse: %s", err)
+	if params.Cluster != "" {
+		// the Distributed tables shard the rows by fingerprint, keeping
+		// the samples of a time series on the shard of its labels.
+		for _, table := range []string{"samples_v2", "time_series_v2", "exemplars", "series_tombstones"} {
+			queries = append(queries, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.%s%s%s AS %s.%s
+		ENGINE = Distributed(%s, %s, %s, fingerprint)`,
+				database, params.DistributedTablePrefix, table, onCluster, database, table,
+				quoteString(params.Cluster), quoteString(database), quoteString(table)))
+		}
 	}
 
-	for _, q := range queries {
-		q = strings.TrimSpace(q)
-		l.Infof("Executing:\n%s\n", q)
-		if err = conn.Exec(context.Background(), q); err != nil {
-			return nil, err
+	queries = append(queries, resourceColumnsQueries(database, params)...)
+
+	return queries
+}
+
+// metricNamesQueries returns the queries creating the metric_names table,
+// which maps the names the time series are written under to their names in
+// each naming scheme, and the views exposing the samples and time series
+// under the names of the schemes other than the written one.
+func metricNamesQueries(database string, written string) []string {
+	queries := []string{fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.metric_names (
+			metric_name LowCardinality(String),
+			raw_name String,
+			sanitized_name String,
+			prometheus_name String
+		)
+		ENGINE = ReplacingMergeTree
+			ORDER BY metric_name`, database)}
+
+	if written == "" {
+		written = MetricNamingSanitized
+	}
+	for _, scheme := range metricNamingSchemes {
+		if scheme == written {
+			continue
 		}
+		queries = append(queries, fmt.Sprintf(`
+		CREATE VIEW IF NOT EXISTS %[1]s.samples_v2_%[2]s AS
+			SELECT alias AS metric_name, fingerprint, timestamp_ms, value
+			FROM (
+				SELECT n.%[2]s_name AS alias, s.fingerprint AS fingerprint, s.timestamp_ms AS timestamp_ms, s.value AS value
+				FROM %[1]s.samples_v2 AS s
+				ANY INNER JOIN %[1]s.metric_names AS n ON s.metric_name = n.metric_name
+			)`, database, scheme))
+		queries = append(queries, fmt.Sprintf(`
+		CREATE VIEW IF NOT EXISTS %[1]s.time_series_v2_%[2]s AS
+			SELECT alias AS metric_name, fingerprint, timestamp_ms, labels
+			FROM (
+				SELECT n.%[2]s_name AS alias, t.fingerprint AS fingerprint, t.timestamp_ms AS timestamp_ms, t.labels AS labels
+				FROM %[1]s.time_series_v2 AS t
+				ANY INNER JOIN %[1]s.metric_names AS n ON t.metric_name = n.metric_name
+			)`, database, scheme))
 	}
+	return queries
+}
 
-	ch := &clickHouse{
-		conn:                 conn,
-		l:                    l,
-		database:             database,
-		maxTimeSeriesInQuery: params.MaxTimeSeriesInQuery,
+// insertSettings returns the ClickHouse settings applied to the queries
+// according to the insert parameters. Backfills are inserted synchronously,
+// their large blocks gaining nothing from the server-side buffering.
+func insertSettings(params *ClickHouseParams) clickhouse.Settings {
+	settings := clickhouse.Settings{}
+	if params.AsyncInsert && !params.Backfill {
+		settings["async_insert"] = 1
+		if params.WaitForAsyncInsert {
+			settings["wait_for_async_insert"] = 1
+		} else {
+			settings["wait_for_async_insert"] = 0
+		}
+	}
+	if params.MaxInsertBlockSize > 0 {
+		settings["max_insert_block_size"] = params.MaxInsertBlockSize
+	}
+	return settings
+}
 
-		timeSeries: make(map[uint64]struct{}, 8192),
+// samplesTTLQuery returns the query setting the TTL of the samples table
+// according to the retention settings, or an empty string when no retention
+// is configured. Each rule only deletes the samples not matched by the rules
+// before it, so that the first matching rule applies.
+func samplesTTLQuery(database string, cluster string, retention RetentionSettings) string {
+	if retention.Default <= 0 && len(retention.Rules) == 0 {
+		return ""
+	}
 
-		mWrittenTimeSeries: prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: subsystem,
-			Name:      "written_time_series",
-			Help:      "Number of written time series.",
-		}),
+	const timestamp = "toDateTime(intDiv(timestamp_ms, 1000))"
+	var ttls, unmatched []string
+	for _, rule := range retention.Rules {
+		match := fmt.Sprintf("match(metric_name, %s)", quoteString(rule.MetricNameRegex))
+		conditions := append([]string{match}, unmatched...)
+		ttls = append(ttls, fmt.Sprintf("%s + INTERVAL %d SECOND DELETE WHERE %s",
+			timestamp, int64(rule.TTL/time.Second), strings.Join(conditions, " AND ")))
+		unmatched = append(unmatched, "NOT "+match)
 	}
+	if retention.Default > 0 {
+		ttl := fmt.Sprintf("%s + INTERVAL %d SECOND DELETE", timestamp, int64(retention.Default/time.Second))
+		if len(unmatched) > 0 {
+			ttl += " WHERE " + strings.Join(unmatched, " AND ")
+		}
+		ttls = append(ttls, ttl)
+	}
+	return fmt.Sprintf("ALTER TABLE %s.samples_v2%s MODIFY TTL %s", database, onClusterClause(cluster), strings.Join(ttls, ", "))
+}
 
-	go func() {
-		ctx := pprof.WithLabels(context.TODO(), pprof.Labels("component", "clickhouse_reloader"))
-		pprof.SetGoroutineLabels(ctx)
-		ch.runTimeSeriesReloader(ctx)
-	}()
+// quoteString returns s as a ClickHouse string literal.
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
 
-	return ch, nil
+// quoteIdentifier returns s as a ClickHouse quoted identifier.
+func quoteIdentifier(s string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s) + "`"
+}
+
+// onClusterClause returns the ON CLUSTER clause of the queries run on the
+// cluster, empty when there is no cluster.
+func onClusterClause(cluster string) string {
+	if cluster == "" {
+		return ""
+	}
+	return " ON CLUSTER " + quoteIdentifier(cluster)
 }
 
 // runTimeSeriesReloader periodically queries the time series table
@@ -172,29 +458,35 @@ func (ch *clickHouse) runTimeSeriesReloader(ctx context.Context) {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
-	q := fmt.Sprintf(`SELECT DISTINCT fingerprint FROM %s.time_series_v2`, ch.database)
+	q := fmt.Sprintf(`SELECT DISTINCT fingerprint FROM %s.%stime_series_v2`, ch.database, ch.tablePrefix)
 	for {
 		ch.timeSeriesRW.RLock()
 		timeSeries := make(map[uint64]struct{}, len(ch.timeSeries))
 		ch.timeSeriesRW.RUnlock()
 
-		err := func() error {
-			ch.l.Debug(q)
-			rows, err := ch.conn.Query(ctx, q)
-			if err != nil {
-				return err
-			}
-			defer rows.Close()
-
-			var f uint64
-			for rows.Next() {
-				if err = rows.Scan(&f); err != nil {
+		var err error
+		for _, conn := range ch.conns {
+			err = func() error {
+				ch.l.Debug(q)
+				rows, err := conn.Query(ctx, q)
+				if err != nil {
 					return err
 				}
-				timeSeries[f] = struct{}{}
+				defer rows.Close()
+
+				var f uint64
+				for rows.Next() {
+					if err = rows.Scan(&f); err != nil {
+						return err
+					}
+					timeSeries[f] = struct{}{}
+				}
+				return rows.Err()
+			}()
+			if err != nil {
+				break
 			}
-			return rows.Err()
-		}()
+		}
 		if err == nil {
 			ch.timeSeriesRW.Lock()
 			n := len(timeSeries) - len(ch.timeSeries)
@@ -218,10 +510,14 @@ func (ch *clickHouse) runTimeSeriesReloader(ctx context.Context) {
 
 func (ch *clickHouse) Describe(c chan<- *prometheus.Desc) {
 	ch.mWrittenTimeSeries.Describe(c)
+	ch.mWrittenTombstones.Describe(c)
+	ch.mDroppedOldSamples.Describe(c)
 }
 
 func (ch *clickHouse) Collect(c chan<- prometheus.Metric) {
 	ch.mWrittenTimeSeries.Collect(c)
+	ch.mWrittenTombstones.Collect(c)
+	ch.mDroppedOldSamples.Collect(c)
 }
 
 func (ch *clickHouse) Write(ctx context.Context, data *prompb.WriteRequest) error {
@@ -264,23 +560,199 @@ func (ch *clickHouse) Write(ctx context.Context, data *prompb.WriteRequest) erro
 	}
 	ch.timeSeriesRW.Unlock()
 
+	// spread the time series over the shards by fingerprint, so that the
+	// samples of a time series are always written to the same shard. The
+	// time series already written by a partially failed write are skipped.
+	shardTimeSeries := make([][]int, len(ch.conns))
+	for i := range data.Timeseries {
+		if ch.popWrittenSeries(fingerprints[i], data.Timeseries[i]) {
+			continue
+		}
+		shard := jumpHash(fingerprints[i], len(ch.conns))
+		shardTimeSeries[shard] = append(shardTimeSeries[shard], i)
+	}
+	var errs error
+	var written []int
+	for shard, conn := range ch.conns {
+		if len(shardTimeSeries[shard]) == 0 {
+			continue
+		}
+		if err := ch.writeShard(conn, data, shardTimeSeries[shard], fingerprints, fingerprintToName, newTimeSeries); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to write to shard %d: %w", shard, err))
+			// the new time series of the shard are written again by the retry
+			ch.timeSeriesRW.Lock()
+			for _, i := range shardTimeSeries[shard] {
+				if _, ok := newTimeSeries[fingerprints[i]]; ok {
+					delete(ch.timeSeries, fingerprints[i])
+				}
+			}
+			ch.timeSeriesRW.Unlock()
+			continue
+		}
+		written = append(written, shardTimeSeries[shard]...)
+	}
+	if errs != nil {
+		if len(written) > 0 {
+			ch.pushWrittenSeries(fingerprints, data, written)
+		}
+		return errs
+	}
+
+	n := len(newTimeSeries)
+	if n != 0 {
+		ch.mWrittenTimeSeries.Add(float64(n))
+		ch.l.Debugf("Wrote %d new time series.", n)
+	}
+	return nil
+}
+
+// maxWrittenSeries bounds the number of digests of the time series written by
+// partially failed writes, the oldest are forgotten first.
+const maxWrittenSeries = 100000
+
+// pushWrittenSeries records the digests of the time series of data at the
+// given indexes, written by a write which failed on other shards.
+func (ch *clickHouse) pushWrittenSeries(fingerprints []uint64, data *prompb.WriteRequest, indexes []int) {
+	ch.writtenSeriesMu.Lock()
+	defer ch.writtenSeriesMu.Unlock()
+	for _, i := range indexes {
+		digest := timeSeriesDigest(fingerprints[i], data.Timeseries[i])
+		if _, ok := ch.writtenSeries[digest]; ok {
+			continue
+		}
+		ch.writtenSeries[digest] = struct{}{}
+		ch.writtenSeriesOrder = append(ch.writtenSeriesOrder, digest)
+	}
+	for len(ch.writtenSeriesOrder) > maxWrittenSeries {
+		delete(ch.writtenSeries, ch.writtenSeriesOrder[0])
+		ch.writtenSeriesOrder = ch.writtenSeriesOrder[1:]
+	}
+}
+
+// popWrittenSeries returns whether the time series was already written by a
+// partially failed write, forgetting it so that it is only skipped once.
+func (ch *clickHouse) popWrittenSeries(fingerprint uint64, ts prompb.TimeSeries) bool {
+	ch.writtenSeriesMu.Lock()
+	defer ch.writtenSeriesMu.Unlock()
+	if len(ch.writtenSeries) == 0 {
+		return false
+	}
+	digest := timeSeriesDigest(fingerprint, ts)
+	if _, ok := ch.writtenSeries[digest]; !ok {
+		return false
+	}
+	delete(ch.writtenSeries, digest)
+	for i, d := range ch.writtenSeriesOrder {
+		if d == digest {
+			ch.writtenSeriesOrder = append(ch.writtenSeriesOrder[:i], ch.writtenSeriesOrder[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// timeSeriesDigest returns a hash of the fingerprint of a time series along
+// with its samples and exemplars.
+func timeSeriesDigest(fingerprint uint64, ts prompb.TimeSeries) uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	write := func(v uint64) {
+		binary.LittleEndian.PutUint64(b[:], v)
+		h.Write(b[:])
+	}
+	write(fingerprint)
+	for _, s := range ts.Samples {
+		write(uint64(s.Timestamp))
+		write(math.Float64bits(s.Value))
+	}
+	for _, e := range ts.Exemplars {
+		write(uint64(e.Timestamp))
+		write(math.Float64bits(e.Value))
+	}
+	return h.Sum64()
+}
+
+// WriteMetricNames implements metricNamesWriter, writing the aliases of the
+// names not written yet since the exporter started.
+func (ch *clickHouse) WriteMetricNames(ctx context.Context, aliases []metricNameAlias) error {
+	ch.metricNamesRW.RLock()
+	var newAliases []metricNameAlias
+	for _, alias := range aliases {
+		if _, ok := ch.metricNames[alias.name]; !ok {
+			newAliases = append(newAliases, alias)
+		}
+	}
+	ch.metricNamesRW.RUnlock()
+	if len(newAliases) == 0 {
+		return nil
+	}
+
+	written := make(map[string]struct{}, len(newAliases))
+	var rows []metricNameAlias
+	for _, alias := range newAliases {
+		if _, ok := written[alias.name]; !ok {
+			written[alias.name] = struct{}{}
+			rows = append(rows, alias)
+		}
+	}
+
+	// the metric names are written to every shard, so that the views of
+	// each shard can resolve the names of its samples.
+	for _, conn := range ch.conns {
+		batch, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.metric_names (metric_name, raw_name, sanitized_name, prometheus_name)", ch.database))
+		if err != nil {
+			return err
+		}
+		for _, alias := range rows {
+			if err := batch.Append(alias.name, alias.rawName, alias.sanitizedName, alias.prometheusName); err != nil {
+				return err
+			}
+		}
+		if err := batch.Send(); err != nil {
+			return err
+		}
+	}
+
+	ch.metricNamesRW.Lock()
+	for name := range written {
+		ch.metricNames[name] = struct{}{}
+	}
+	ch.metricNamesRW.Unlock()
+	return nil
+}
+
+// writeShard writes the time series of data at the given indexes, along
+// with their samples, exemplars and tombstones, to a shard. The staleness
+// markers are not written as samples but as tombstones of their time series.
+func (ch *clickHouse) writeShard(conn clickhouse.Conn, data *prompb.WriteRequest, indexes []int, fingerprints []uint64, fingerprintToName map[uint64]string, newTimeSeries map[uint64][]*prompb.Label) error {
 	err := func() error {
 		ctx := context.Background()
-		err := ch.conn.Exec(ctx, `SET allow_experimental_object_type = 1`)
+		err := conn.Exec(ctx, `SET allow_experimental_object_type = 1`)
 		if err != nil {
 			return err
 		}
 
-		statement, err := ch.conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.time_series_v2 (metric_name, timestamp_ms, fingerprint, labels) VALUES (?, ?, ?, ?)", ch.database))
+		statement, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%stime_series_v2 (metric_name, timestamp_ms, fingerprint, labels) VALUES (?, ?, ?, ?)", ch.database, ch.tablePrefix))
 		if err != nil {
 			return err
 		}
 		timestamp := model.Now().Time().UnixMilli()
-		for fingerprint, labels := range newTimeSeries {
+		written := make(map[uint64]struct{})
+		for _, i := range indexes {
+			fingerprint := fingerprints[i]
+			labels, ok := newTimeSeries[fingerprint]
+			if _, done := written[fingerprint]; !ok || done {
+				continue
+			}
+			written[fingerprint] = struct{}{}
 			encodedLabels := string(marshalLabels(labels, make([]byte, 0, 128)))
+			seriesTimestamp := timestamp
+			if first, ok := firstSampleTimestamp(data.Timeseries[i].Samples); ch.backfill && ok {
+				seriesTimestamp = first
+			}
 			err = statement.Append(
 				fingerprintToName[fingerprint],
-				timestamp,
+				seriesTimestamp,
 				fingerprint,
 				encodedLabels,
 			)
@@ -300,13 +772,27 @@ func (ch *clickHouse) Write(ctx context.Context, data *prompb.WriteRequest) erro
 	err = func() error {
 		ctx := context.Background()
 
-		statement, err := ch.conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.samples_v2", ch.database))
+		var minTimestamp int64
+		if ch.maxSampleAge > 0 {
+			minTimestamp = time.Now().Add(-ch.maxSampleAge).UnixMilli()
+		}
+		var dropped int
+
+		statement, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%ssamples_v2", ch.database, ch.tablePrefix))
 		if err != nil {
 			return err
 		}
-		for i, ts := range data.Timeseries {
+		for _, i := range indexes {
+			ts := data.Timeseries[i]
 			fingerprint := fingerprints[i]
 			for _, s := range ts.Samples {
+				if value.IsStaleNaN(s.Value) {
+					continue
+				}
+				if s.Timestamp < minTimestamp {
+					dropped++
+					continue
+				}
 				err = statement.Append(
 					fingerprintToName[fingerprint],
 					fingerprint,
@@ -318,6 +804,10 @@ func (ch *clickHouse) Write(ctx context.Context, data *prompb.WriteRequest) erro
 				}
 			}
 		}
+		if dropped > 0 {
+			ch.mDroppedOldSamples.Add(float64(dropped))
+			ch.l.Debugf("Dropped %d samples older than %s.", dropped, ch.maxSampleAge)
+		}
 
 		return statement.Send()
 
@@ -326,15 +816,139 @@ func (ch *clickHouse) Write(ctx context.Context, data *prompb.WriteRequest) erro
 		return err
 	}
 
-	n := len(newTimeSeries)
-	if n != 0 {
-		ch.mWrittenTimeSeries.Add(float64(n))
-		ch.l.Debugf("Wrote %d new time series.", n)
+	err = func() error {
+		tombstones := make(map[uint64]int64)
+		for _, i := range indexes {
+			if t, ok := lastStaleMarker(data.Timeseries[i].Samples); ok && t > tombstones[fingerprints[i]] {
+				tombstones[fingerprints[i]] = t
+			}
+		}
+		if len(tombstones) == 0 {
+			return nil
+		}
+
+		ctx := context.Background()
+
+		statement, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%sseries_tombstones", ch.database, ch.tablePrefix))
+		if err != nil {
+			return err
+		}
+		for fingerprint, t := range tombstones {
+			err = statement.Append(
+				fingerprintToName[fingerprint],
+				fingerprint,
+				t,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = statement.Send(); err != nil {
+			return err
+		}
+		ch.mWrittenTombstones.Add(float64(len(tombstones)))
+		return nil
+	}()
+	if err != nil {
+		return err
 	}
-	return nil
+
+	err = func() error {
+		var exemplars int
+		for _, i := range indexes {
+			exemplars += len(data.Timeseries[i].Exemplars)
+		}
+		if exemplars == 0 {
+			return nil
+		}
+
+		ctx := context.Background()
+
+		statement, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%sexemplars", ch.database, ch.tablePrefix))
+		if err != nil {
+			return err
+		}
+		for _, i := range indexes {
+			ts := data.Timeseries[i]
+			fingerprint := fingerprints[i]
+			for _, e := range ts.Exemplars {
+				traceID, spanID, attributes := splitExemplarLabels(e.Labels)
+				err = statement.Append(
+					fingerprintToName[fingerprint],
+					fingerprint,
+					e.Timestamp,
+					e.Value,
+					traceID,
+					spanID,
+					string(marshalLabels(attributes, make([]byte, 0, 64))),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return statement.Send()
+	}()
+	return err
+}
+
+// splitExemplarLabels separates the trace and span IDs of an exemplar from its other labels.
+func splitExemplarLabels(labels []prompb.Label) (traceID string, spanID string, attributes []*prompb.Label) {
+	for i := range labels {
+		switch labels[i].Name {
+		case traceIDStr:
+			traceID = labels[i].Value
+		case spanIDStr:
+			spanID = labels[i].Value
+		default:
+			attributes = append(attributes, &labels[i])
+		}
+	}
+	return traceID, spanID, attributes
+}
+
+// firstSampleTimestamp returns the timestamp of the earliest sample, if any.
+func firstSampleTimestamp(samples []prompb.Sample) (int64, bool) {
+	var first int64
+	for i, s := range samples {
+		if i == 0 || s.Timestamp < first {
+			first = s.Timestamp
+		}
+	}
+	return first, len(samples) > 0
+}
+
+// lastStaleMarker returns the timestamp of the last staleness marker among
+// the samples, if any.
+func lastStaleMarker(samples []prompb.Sample) (int64, bool) {
+	var last int64
+	var found bool
+	for _, s := range samples {
+		if value.IsStaleNaN(s.Value) && (!found || s.Timestamp > last) {
+			last = s.Timestamp
+			found = true
+		}
+	}
+	return last, found
 }
 
 // check interfaces
 var (
 	_ base.Storage = (*clickHouse)(nil)
 )
+
+// jumpHash returns the bucket of the key among the given number of buckets
+// using the jump consistent hash algorithm (https://arxiv.org/abs/1406.2294).
+// When buckets are appended, only the keys moving to the new buckets change
+// bucket.
+func jumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	clickhouse "github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertSettings(t *testing.T) {
+	assert.Equal(t, clickhouse.Settings{}, insertSettings(&ClickHouseParams{WaitForAsyncInsert: true}))
+
+	assert.Equal(t,
+		clickhouse.Settings{
+			"async_insert":          1,
+			"wait_for_async_insert": 0,
+			"max_insert_block_size": uint64(100000),
+		},
+		insertSettings(&ClickHouseParams{AsyncInsert: true, MaxInsertBlockSize: 100000}),
+	)
+
+	// backfills are inserted synchronously.
+	assert.Equal(t, clickhouse.Settings{}, insertSettings(&ClickHouseParams{AsyncInsert: true, Backfill: true}))
+}
+
+func TestSplitExemplarLabels(t *testing.T) {
+	traceID, spanID, attributes := splitExemplarLabels([]prompb.Label{
+		{Name: "http.method", Value: "GET"},
+		{Name: traceIDStr, Value: "0102030405060708090a0b0c0d0e0f10"},
+		{Name: spanIDStr, Value: "0102030405060708"},
+	})
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", traceID)
+	assert.Equal(t, "0102030405060708", spanID)
+	assert.Equal(t, []*prompb.Label{{Name: "http.method", Value: "GET"}}, attributes)
+
+	traceID, spanID, attributes = splitExemplarLabels(nil)
+	assert.Empty(t, traceID)
+	assert.Empty(t, spanID)
+	assert.Empty(t, attributes)
+}
+
+func TestSamplesTTLQuery(t *testing.T) {
+	assert.Empty(t, samplesTTLQuery("signoz_metrics", "", RetentionSettings{}))
+
+	assert.Equal(t,
+		"ALTER TABLE signoz_metrics.samples_v2 MODIFY TTL toDateTime(intDiv(timestamp_ms, 1000)) + INTERVAL 2592000 SECOND DELETE",
+		samplesTTLQuery("signoz_metrics", "", RetentionSettings{Default: 720 * time.Hour}),
+	)
+
+	assert.Equal(t,
+		"ALTER TABLE signoz_metrics.samples_v2 MODIFY TTL "+
+			"toDateTime(intDiv(timestamp_ms, 1000)) + INTERVAL 86400 SECOND DELETE WHERE match(metric_name, '^http_\\\\w+_bucket$'), "+
+			"toDateTime(intDiv(timestamp_ms, 1000)) + INTERVAL 31536000 SECOND DELETE WHERE match(metric_name, '^business_') AND NOT match(metric_name, '^http_\\\\w+_bucket$'), "+
+			"toDateTime(intDiv(timestamp_ms, 1000)) + INTERVAL 604800 SECOND DELETE WHERE NOT match(metric_name, '^http_\\\\w+_bucket$') AND NOT match(metric_name, '^business_')",
+		samplesTTLQuery("signoz_metrics", "", RetentionSettings{
+			Default: 168 * time.Hour,
+			Rules: []RetentionRule{
+				{MetricNameRegex: `^http_\w+_bucket$`, TTL: 24 * time.Hour},
+				{MetricNameRegex: "^business_", TTL: 8760 * time.Hour},
+			},
+		}),
+	)
+}
+
+func TestSamplesTTLQueryOnCluster(t *testing.T) {
+	assert.Equal(t,
+		"ALTER TABLE signoz_metrics.samples_v2 ON CLUSTER `cluster` MODIFY TTL toDateTime(intDiv(timestamp_ms, 1000)) + INTERVAL 86400 SECOND DELETE",
+		samplesTTLQuery("signoz_metrics", "cluster", RetentionSettings{Default: 24 * time.Hour}),
+	)
+}
+
+func TestSchemaQueries(t *testing.T) {
+	queries := schemaQueries("signoz_metrics", &ClickHouseParams{})
+	for _, q := range queries {
+		assert.NotContains(t, q, "ON CLUSTER")
+		assert.NotContains(t, q, "Distributed")
+	}
+
+	queries = schemaQueries("signoz_metrics", &ClickHouseParams{
+		Cluster:                "cluster",
+		DistributedTablePrefix: "distributed_",
+		DropDatabase:           true,
+	})
+	assert.Equal(t, "DROP DATABASE IF EXISTS signoz_metrics ON CLUSTER `cluster`", queries[0])
+	assert.Equal(t, "CREATE DATABASE IF NOT EXISTS signoz_metrics ON CLUSTER `cluster`", queries[1])
+	for _, table := range []string{"samples_v2", "time_series_v2", "exemplars", "series_tombstones"} {
+		var created, distributed bool
+		for _, q := range queries {
+			q = strings.Join(strings.Fields(q), " ")
+			if strings.HasPrefix(q, "CREATE TABLE IF NOT EXISTS signoz_metrics."+table+" ON CLUSTER `cluster` (") {
+				created = true
+			}
+			if q == "CREATE TABLE IF NOT EXISTS signoz_metrics.distributed_"+table+" ON CLUSTER `cluster` AS signoz_metrics."+table+
+				" ENGINE = Distributed('cluster', 'signoz_metrics', '"+table+"', fingerprint)" {
+				distributed = true
+			}
+		}
+		assert.True(t, created, table)
+		assert.True(t, distributed, table)
+	}
+}
+
+func TestMetricNamesQueries(t *testing.T) {
+	for _, q := range schemaQueries("signoz_metrics", &ClickHouseParams{}) {
+		assert.NotContains(t, q, "metric_names")
+	}
+
+	queries := schemaQueries("signoz_metrics", &ClickHouseParams{CompatibilityViews: true, MetricNaming: MetricNamingPrometheus})
+	var created []string
+	for _, q := range queries {
+		fields := strings.Fields(q)
+		if len(fields) > 5 && fields[0] == "CREATE" && fields[3] == "NOT" && strings.Contains(q, "metric_names") {
+			created = append(created, fields[5])
+		}
+	}
+	assert.Equal(t, []string{
+		"signoz_metrics.metric_names",
+		"signoz_metrics.samples_v2_sanitized",
+		"signoz_metrics.time_series_v2_sanitized",
+		"signoz_metrics.samples_v2_raw",
+		"signoz_metrics.time_series_v2_raw",
+	}, created)
+}
+
+func TestLastStaleMarker(t *testing.T) {
+	staleNaN := math.Float64frombits(value.StaleNaN)
+
+	_, ok := lastStaleMarker(nil)
+	assert.False(t, ok)
+
+	_, ok = lastStaleMarker([]prompb.Sample{{Value: 1, Timestamp: 10}, {Value: math.NaN(), Timestamp: 20}})
+	assert.False(t, ok)
+
+	last, ok := lastStaleMarker([]prompb.Sample{
+		{Value: staleNaN, Timestamp: 30},
+		{Value: 1, Timestamp: 40},
+		{Value: staleNaN, Timestamp: 20},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, int64(30), last)
+}
+
+func TestFirstSampleTimestamp(t *testing.T) {
+	_, ok := firstSampleTimestamp(nil)
+	assert.False(t, ok)
+
+	first, ok := firstSampleTimestamp([]prompb.Sample{{Timestamp: 30}, {Timestamp: 10}, {Timestamp: 20}})
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), first)
+}
+
+func TestJumpHash(t *testing.T) {
+	for key := uint64(0); key < 1000; key++ {
+		assert.Equal(t, 0, jumpHash(key, 1))
+	}
+
+	counts := make([]int, 3)
+	for key := uint64(0); key < 30000; key++ {
+		shard := jumpHash(key*0x9e3779b97f4a7c15, 3)
+		counts[shard]++
+
+		// adding a shard only moves keys to the new shard.
+		if moved := jumpHash(key*0x9e3779b97f4a7c15, 4); moved != shard {
+			assert.Equal(t, 3, moved)
+		}
+	}
+	for _, count := range counts {
+		assert.InDelta(t, 10000, count, 500)
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	assert.Equal(t, `'it\'s'`, quoteString("it's"))
+	assert.Equal(t, `'\\d+'`, quoteString(`\d+`))
+}
+
+func TestOnClusterClause(t *testing.T) {
+	assert.Empty(t, onClusterClause(""))
+	assert.Equal(t, " ON CLUSTER `my-cluster`", onClusterClause("my-cluster"))
+	assert.Equal(t, " ON CLUSTER `a\\`b`", onClusterClause("a`b"))
+}
+
+func TestWrittenSeries(t *testing.T) {
+	ch := &clickHouse{writtenSeries: make(map[uint64]struct{})}
+	data := &prompb.WriteRequest{Timeseries: []prompb.TimeSeries{
+		{Samples: []prompb.Sample{{Timestamp: 1, Value: 1}}},
+		{Samples: []prompb.Sample{{Timestamp: 1, Value: 2}}},
+	}}
+	fingerprints := []uint64{1, 2}
+
+	assert.False(t, ch.popWrittenSeries(1, data.Timeseries[0]))
+	ch.pushWrittenSeries(fingerprints, data, []int{0})
+
+	// only the time series written with the same samples are skipped, once.
+	assert.False(t, ch.popWrittenSeries(2, data.Timeseries[1]))
+	assert.False(t, ch.popWrittenSeries(1, prompb.TimeSeries{Samples: []prompb.Sample{{Timestamp: 2, Value: 1}}}))
+	assert.True(t, ch.popWrittenSeries(1, data.Timeseries[0]))
+	assert.False(t, ch.popWrittenSeries(1, data.Timeseries[0]))
+	assert.Empty(t, ch.writtenSeriesOrder)
+}
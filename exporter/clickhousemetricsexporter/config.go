@@ -16,6 +16,9 @@ package clickhousemetricsexporter
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 	"go.opentelemetry.io/collector/config"
@@ -44,6 +47,148 @@ type Config struct {
 	// "Enabled" - A boolean field to enable/disable this option. Default is `false`.
 	// If enabled, all the resource attributes will be converted to metric labels by default.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+
+	// Insert configures the ClickHouse settings used for the INSERT queries.
+	Insert InsertSettings `mapstructure:"insert"`
+
+	// Batch configures the internal batcher that merges the time series of
+	// several exports into a single write to ClickHouse.
+	Batch BatchSettings `mapstructure:"batch"`
+
+	// Retention configures the TTL of the samples, applied to the samples
+	// table when the exporter starts.
+	Retention RetentionSettings `mapstructure:"retention"`
+
+	// Cluster configures writing to a clustered ClickHouse deployment.
+	Cluster ClusterSettings `mapstructure:"cluster"`
+
+	// MetricNaming configures the names the metrics are written under.
+	MetricNaming MetricNamingSettings `mapstructure:"metric_naming"`
+
+	// MaxSampleAge drops the samples older than this duration when they are
+	// written, unless Backfill is enabled. Zero accepts samples of any age.
+	MaxSampleAge time.Duration `mapstructure:"max_sample_age"`
+
+	// Backfill configures the import of historical samples, e.g. when
+	// migrating months of Prometheus data.
+	Backfill BackfillSettings `mapstructure:"backfill"`
+
+	// ResourceColumns configures the resource attributes stored in dedicated
+	// columns of the time series table.
+	ResourceColumns ResourceColumnsSettings `mapstructure:"resource_columns"`
+}
+
+// ResourceColumnsSettings allows to promote resource attributes to columns
+// of the time series table, which are faster to filter and group by than the
+// labels.
+type ResourceColumnsSettings struct {
+	// Enabled adds the Attributes of the resources to the labels of their
+	// time series, and a LowCardinality(String) column named after the label
+	// of each attribute to the time series table.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Attributes are the keys of the promoted resource attributes. They
+	// default to service.name, deployment.environment and the k8s cluster,
+	// namespace, node, deployment and pod names.
+	Attributes []string `mapstructure:"attributes"`
+}
+
+// BackfillSettings allows to import historical samples without destabilizing
+// the live ingestion writing to the same ClickHouse.
+type BackfillSettings struct {
+	// Enabled accepts samples of any age regardless of MaxSampleAge, and
+	// writes the samples one day partition at a time with synchronous
+	// INSERTs, ignoring the async insert settings.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxSamplesPerSecond throttles the written samples. Zero writes the
+	// samples as fast as they are received.
+	MaxSamplesPerSecond int `mapstructure:"max_samples_per_second"`
+}
+
+// MetricNamingSettings allows to choose the naming convention of the written
+// metric names, and to query the metrics under the other conventions.
+type MetricNamingSettings struct {
+	// Scheme is the naming scheme of the written metric names: sanitized
+	// (the default, also used when empty), raw or prometheus.
+	Scheme string `mapstructure:"scheme"`
+
+	// CompatibilityViews records the names of the written metrics in every
+	// scheme to the metric_names table, and creates the samples_v2_<scheme>
+	// and time_series_v2_<scheme> views exposing the metrics under the names
+	// of the other schemes.
+	CompatibilityViews bool `mapstructure:"compatibility_views"`
+}
+
+// ClusterSettings allows to spread the samples over several ClickHouse
+// shards, either through Distributed tables or by writing to each shard.
+type ClusterSettings struct {
+	// Name is the name of the ClickHouse cluster. When set, the schema is
+	// created ON CLUSTER along with Distributed tables over the tables, and
+	// the samples are written through the Distributed tables unless Shards
+	// are set.
+	Name string `mapstructure:"name"`
+
+	// DistributedTablePrefix is the prefix of the names of the Distributed
+	// tables, e.g. distributed_samples_v2.
+	DistributedTablePrefix string `mapstructure:"distributed_table_prefix"`
+
+	// Shards are the DSNs of the shards written to directly, in addition to
+	// the endpoint which is the first shard. Each time series is written to
+	// the shard selected by a consistent hash of its fingerprint, so shards
+	// should only be appended to this list, never reordered or removed.
+	Shards []string `mapstructure:"shards"`
+}
+
+// InsertSettings allows to tune how ClickHouse handles the INSERT queries.
+type InsertSettings struct {
+	// AsyncInsert enables the async_insert setting, letting ClickHouse buffer
+	// the inserted data server-side and write it in larger parts.
+	AsyncInsert bool `mapstructure:"async_insert"`
+
+	// WaitForAsyncInsert sets wait_for_async_insert, making an asynchronous
+	// INSERT return only once its data was flushed to the table.
+	// Ignored if AsyncInsert is false.
+	WaitForAsyncInsert bool `mapstructure:"wait_for_async_insert"`
+
+	// MaxInsertBlockSize sets max_insert_block_size, the number of rows in the
+	// blocks formed for insertion into a table. Zero keeps the server default.
+	MaxInsertBlockSize uint64 `mapstructure:"max_insert_block_size"`
+}
+
+// BatchSettings allows to configure the internal time series batcher.
+type BatchSettings struct {
+	// Enabled if false the time series of each export are written as soon as
+	// they are received.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxSamples is the number of buffered samples that triggers a write.
+	MaxSamples int `mapstructure:"max_samples"`
+
+	// FlushInterval is the maximum time the time series are buffered for.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// RetentionSettings allows to expire the samples of some metrics earlier, or
+// later, than the others. When neither Default nor Rules are set the TTL of
+// the samples table is left untouched.
+type RetentionSettings struct {
+	// Default is the TTL of the samples not matched by any rule. Zero keeps
+	// them forever.
+	Default time.Duration `mapstructure:"default"`
+
+	// Rules are the TTLs per metric name, the first matching rule applies.
+	Rules []RetentionRule `mapstructure:"rules"`
+}
+
+// RetentionRule sets the TTL of the samples of the metrics matching a regex.
+type RetentionRule struct {
+	// MetricNameRegex is the RE2 regular expression matched against the
+	// metric name. It is not anchored, use ^ and $ to match the whole name.
+	MetricNameRegex string `mapstructure:"metric_name_regex"`
+
+	// TTL is how long the matched samples are kept, rounded down to seconds.
+	TTL time.Duration `mapstructure:"ttl"`
 }
 
 // RemoteWriteQueue allows to configure the remote write queue.
@@ -76,5 +221,66 @@ func (cfg *Config) Validate() error {
 	if cfg.RemoteWriteQueue.NumConsumers < 0 {
 		return fmt.Errorf("remote write consumer number can't be negative")
 	}
+
+	if cfg.Batch.Enabled {
+		if cfg.Batch.MaxSamples <= 0 {
+			return fmt.Errorf("batch max samples must be positive")
+		}
+		if cfg.Batch.FlushInterval <= 0 {
+			return fmt.Errorf("batch flush interval must be positive")
+		}
+	}
+
+	if cfg.Cluster.Name != "" && cfg.Cluster.DistributedTablePrefix == "" {
+		return fmt.Errorf("cluster distributed table prefix can't be empty")
+	}
+	for _, shard := range cfg.Cluster.Shards {
+		if _, err := url.Parse(shard); shard == "" || err != nil {
+			return fmt.Errorf("cluster shard %q is not a valid DSN", shard)
+		}
+	}
+
+	if scheme := cfg.MetricNaming.Scheme; scheme != "" && !isMetricNamingScheme(scheme) {
+		return fmt.Errorf("metric naming scheme %q is not one of %v", cfg.MetricNaming.Scheme, metricNamingSchemes)
+	}
+	if cfg.MetricNaming.CompatibilityViews && cfg.Cluster.Name != "" {
+		return fmt.Errorf("metric naming compatibility views are not supported with a cluster")
+	}
+
+	if cfg.MaxSampleAge < 0 {
+		return fmt.Errorf("max sample age can't be negative")
+	}
+	if cfg.Backfill.MaxSamplesPerSecond < 0 {
+		return fmt.Errorf("backfill max samples per second can't be negative")
+	}
+
+	if cfg.ResourceColumns.Enabled {
+		columns := make(map[string]struct{}, len(timeSeriesColumns)+len(cfg.ResourceColumns.Attributes))
+		for _, column := range timeSeriesColumns {
+			columns[column] = struct{}{}
+		}
+		for _, attribute := range cfg.ResourceColumns.Attributes {
+			if attribute == "" {
+				return fmt.Errorf("resource column attribute can't be empty")
+			}
+			column := resourceColumnName(attribute)
+			if _, ok := columns[column]; ok {
+				return fmt.Errorf("resource column %q of attribute %q is not unique", column, attribute)
+			}
+			columns[column] = struct{}{}
+		}
+	}
+
+	if cfg.Retention.Default < 0 {
+		return fmt.Errorf("retention default can't be negative")
+	}
+	for _, rule := range cfg.Retention.Rules {
+		if _, err := regexp.Compile(rule.MetricNameRegex); rule.MetricNameRegex == "" || err != nil {
+			return fmt.Errorf("retention rule metric name regex %q is not a valid regular expression", rule.MetricNameRegex)
+		}
+		if rule.TTL < time.Second {
+			return fmt.Errorf("retention rule TTL for %q must be at least one second", rule.MetricNameRegex)
+		}
+	}
 	return nil
 }
@@ -115,3 +115,90 @@ func TestDisabledQueue(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, cfg.Exporters[config.NewComponentID(typeStr)].(*Config).RemoteWriteQueue.Enabled)
 }
+
+func TestValidateBatch(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Batch.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Batch.MaxSamples = 0
+	assert.Error(t, cfg.Validate())
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.Batch.Enabled = true
+	cfg.Batch.FlushInterval = 0
+	assert.Error(t, cfg.Validate())
+}
+
+func TestValidateRetention(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Retention = RetentionSettings{
+		Default: 30 * 24 * time.Hour,
+		Rules: []RetentionRule{
+			{MetricNameRegex: "^http_.*_bucket$", TTL: 24 * time.Hour},
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Retention.Default = -time.Hour
+	assert.Error(t, cfg.Validate())
+
+	cfg.Retention.Default = 0
+	cfg.Retention.Rules = []RetentionRule{{MetricNameRegex: "^http_(", TTL: time.Hour}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Retention.Rules = []RetentionRule{{MetricNameRegex: "", TTL: time.Hour}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Retention.Rules = []RetentionRule{{MetricNameRegex: "^http_", TTL: time.Millisecond}}
+	assert.Error(t, cfg.Validate())
+}
+
+func TestValidateCluster(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Cluster = ClusterSettings{
+		Name:                   "cluster",
+		DistributedTablePrefix: "distributed_",
+		Shards:                 []string{"tcp://clickhouse-2:9000/?database=signoz_metrics"},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Cluster.DistributedTablePrefix = ""
+	assert.Error(t, cfg.Validate())
+
+	cfg.Cluster.DistributedTablePrefix = "distributed_"
+	cfg.Cluster.Shards = []string{""}
+	assert.Error(t, cfg.Validate())
+}
+
+func TestValidateBackfill(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxSampleAge = time.Hour
+	cfg.Backfill = BackfillSettings{Enabled: true, MaxSamplesPerSecond: 10000}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Backfill.MaxSamplesPerSecond = -1
+	assert.Error(t, cfg.Validate())
+
+	cfg.Backfill.MaxSamplesPerSecond = 0
+	cfg.MaxSampleAge = -time.Hour
+	assert.Error(t, cfg.Validate())
+}
+
+func TestValidateResourceColumns(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ResourceColumns.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.ResourceColumns.Attributes = []string{"service.name", ""}
+	assert.Error(t, cfg.Validate())
+
+	cfg.ResourceColumns.Attributes = []string{"service.name", "service_name"}
+	assert.Error(t, cfg.Validate())
+
+	cfg.ResourceColumns.Attributes = []string{"labels"}
+	assert.Error(t, cfg.Validate())
+
+	cfg.ResourceColumns.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
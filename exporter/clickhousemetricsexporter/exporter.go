@@ -42,6 +42,7 @@ const maxBatchByteSize = 3000000
 // PrwExporter converts OTLP metrics to Prometheus remote write TimeSeries and sends them to a remote endpoint.
 type PrwExporter struct {
 	namespace       string
+	namer           metricNamer
 	externalLabels  map[string]string
 	endpointURL     *url.URL
 	client          *http.Client
@@ -52,6 +53,13 @@ type PrwExporter struct {
 	clientSettings  *confighttp.HTTPClientSettings
 	settings        component.TelemetrySettings
 	ch              base.Storage
+	batcher         *timeSeriesBatcher
+	// metricNames records the aliases of the written metric names backing the
+	// compatibility views, nil if they are disabled.
+	metricNames metricNamesWriter
+	// resourceColumns are the resource attributes added to the labels of
+	// their time series, nil if the resource columns are disabled.
+	resourceColumns []string
 }
 
 // NewPrwExporter initializes a new PrwExporter instance and sets fields accordingly.
@@ -71,18 +79,51 @@ func NewPrwExporter(cfg *Config, set component.ExporterCreateSettings) (*PrwExpo
 	userAgentHeader := fmt.Sprintf("%s/%s", strings.ReplaceAll(strings.ToLower(set.BuildInfo.Description), " ", "-"), set.BuildInfo.Version)
 
 	params := &ClickHouseParams{
-		DSN:                  cfg.HTTPClientSettings.Endpoint,
-		DropDatabase:         false,
-		MaxOpenConns:         75,
-		MaxTimeSeriesInQuery: 50,
+		DSN:                    cfg.HTTPClientSettings.Endpoint,
+		DropDatabase:           false,
+		MaxOpenConns:           75,
+		MaxTimeSeriesInQuery:   50,
+		AsyncInsert:            cfg.Insert.AsyncInsert,
+		WaitForAsyncInsert:     cfg.Insert.WaitForAsyncInsert,
+		MaxInsertBlockSize:     cfg.Insert.MaxInsertBlockSize,
+		Retention:              cfg.Retention,
+		ShardDSNs:              cfg.Cluster.Shards,
+		Cluster:                cfg.Cluster.Name,
+		DistributedTablePrefix: cfg.Cluster.DistributedTablePrefix,
+		MetricNaming:           cfg.MetricNaming.Scheme,
+		CompatibilityViews:     cfg.MetricNaming.CompatibilityViews,
+		MaxSampleAge:           cfg.MaxSampleAge,
+		Backfill:               cfg.Backfill.Enabled,
+	}
+	var resourceColumns []string
+	if cfg.ResourceColumns.Enabled {
+		resourceColumns = cfg.ResourceColumns.Attributes
+		params.ResourceColumns = resourceColumns
 	}
 	ch, err := NewClickHouse(params)
 	if err != nil {
 		zap.S().Error("couldn't create instance of clickhouse")
 	}
 
+	var metricNames metricNamesWriter
+	if cfg.MetricNaming.CompatibilityViews && ch != nil {
+		metricNames = ch.(metricNamesWriter)
+	}
+
+	// the backfill writer splits the batched time series by day.
+	if cfg.Backfill.Enabled && ch != nil {
+		ch = newBackfillWriter(ch, cfg.Backfill)
+	}
+
+	var batcher *timeSeriesBatcher
+	if cfg.Batch.Enabled && ch != nil {
+		batcher = newTimeSeriesBatcher(ch, cfg.Batch, set.Logger)
+		ch = batcher
+	}
+
 	return &PrwExporter{
 		namespace:       cfg.Namespace,
+		namer:           metricNamer{namespace: cfg.Namespace, scheme: cfg.MetricNaming.Scheme},
 		externalLabels:  sanitizedLabels,
 		endpointURL:     endpointURL,
 		wg:              new(sync.WaitGroup),
@@ -92,20 +133,32 @@ func NewPrwExporter(cfg *Config, set component.ExporterCreateSettings) (*PrwExpo
 		clientSettings:  &cfg.HTTPClientSettings,
 		settings:        set.TelemetrySettings,
 		ch:              ch,
+		batcher:         batcher,
+		metricNames:     metricNames,
+		resourceColumns: resourceColumns,
 	}, nil
 }
 
 // Start creates the prometheus client
 func (prwe *PrwExporter) Start(_ context.Context, host component.Host) (err error) {
 	prwe.client, err = prwe.clientSettings.ToClient(host.GetExtensions(), prwe.settings)
-	return err
+	if err != nil {
+		return err
+	}
+	if prwe.batcher != nil {
+		prwe.batcher.start()
+	}
+	return nil
 }
 
 // Shutdown stops the exporter from accepting incoming calls(and return error), and wait for current export operations
 // to finish before returning
-func (prwe *PrwExporter) Shutdown(context.Context) error {
+func (prwe *PrwExporter) Shutdown(ctx context.Context) error {
 	close(prwe.closeChan)
 	prwe.wg.Wait()
+	if prwe.batcher != nil {
+		return prwe.batcher.shutdown(ctx)
+	}
 	return nil
 }
 
@@ -121,6 +174,7 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 		return errors.New("shutdown has been called")
 	default:
 		tsMap := map[string]*prompb.TimeSeries{}
+		var aliases []metricNameAlias
 		dropped := 0
 		var errs error
 		resourceMetricsSlice := md.ResourceMetrics()
@@ -128,7 +182,7 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 			resourceMetrics := resourceMetricsSlice.At(i)
 			resource := resourceMetrics.Resource()
 			instrumentationLibraryMetricsSlice := resourceMetrics.InstrumentationLibraryMetrics()
-			// TODO: add resource attributes as labels, probably in next PR
+			externalLabels := prwe.resourceLabels(resource)
 			for j := 0; j < instrumentationLibraryMetricsSlice.Len(); j++ {
 				instrumentationLibraryMetrics := instrumentationLibraryMetricsSlice.At(j)
 				metricSlice := instrumentationLibraryMetrics.Metrics()
@@ -165,17 +219,21 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 						continue
 					}
 
+					if prwe.metricNames != nil {
+						aliases = append(aliases, metricNameAliases(metric, prwe.namer.namespace, prwe.namer.scheme)...)
+					}
+
 					// handle individual metric based on type
 					switch metric.DataType() {
 					case pdata.MetricDataTypeGauge:
 						dataPoints := metric.Gauge().DataPoints()
-						if err := prwe.addNumberDataPointSlice(dataPoints, tsMap, resource, metric); err != nil {
+						if err := prwe.addNumberDataPointSlice(dataPoints, tsMap, resource, metric, externalLabels); err != nil {
 							dropped++
 							errs = multierr.Append(errs, err)
 						}
 					case pdata.MetricDataTypeSum:
 						dataPoints := metric.Sum().DataPoints()
-						if err := prwe.addNumberDataPointSlice(dataPoints, tsMap, resource, metric); err != nil {
+						if err := prwe.addNumberDataPointSlice(dataPoints, tsMap, resource, metric, externalLabels); err != nil {
 							dropped++
 							errs = multierr.Append(errs, err)
 						}
@@ -186,7 +244,7 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 							errs = multierr.Append(errs, consumererror.NewPermanent(fmt.Errorf("empty data points. %s is dropped", metric.Name())))
 						}
 						for x := 0; x < dataPoints.Len(); x++ {
-							addSingleHistogramDataPoint(dataPoints.At(x), resource, metric, prwe.namespace, tsMap, prwe.externalLabels)
+							addSingleHistogramDataPoint(dataPoints.At(x), resource, metric, prwe.namer, tsMap, externalLabels)
 						}
 					case pdata.MetricDataTypeSummary:
 						dataPoints := metric.Summary().DataPoints()
@@ -195,7 +253,7 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 							errs = multierr.Append(errs, consumererror.NewPermanent(fmt.Errorf("empty data points. %s is dropped", metric.Name())))
 						}
 						for x := 0; x < dataPoints.Len(); x++ {
-							addSingleSummaryDataPoint(dataPoints.At(x), resource, metric, prwe.namespace, tsMap, prwe.externalLabels)
+							addSingleSummaryDataPoint(dataPoints.At(x), resource, metric, prwe.namer, tsMap, externalLabels)
 						}
 					default:
 						dropped++
@@ -210,6 +268,14 @@ func (prwe *PrwExporter) PushMetrics(ctx context.Context, md pdata.Metrics) erro
 			errs = multierr.Append(errs, multierr.Combine(exportErrors...))
 		}
 
+		// the aliases only back the compatibility views, failing to write
+		// them does not fail the export.
+		if len(aliases) > 0 {
+			if err := prwe.metricNames.WriteMetricNames(ctx, aliases); err != nil {
+				zap.S().Errorf("couldn't write the metric name aliases: %v", err)
+			}
+		}
+
 		if dropped != 0 {
 			return errs
 		}
@@ -237,12 +303,12 @@ func validateAndSanitizeExternalLabels(externalLabels map[string]string) (map[st
 	return sanitizedLabels, nil
 }
 
-func (prwe *PrwExporter) addNumberDataPointSlice(dataPoints pdata.NumberDataPointSlice, tsMap map[string]*prompb.TimeSeries, resource pdata.Resource, metric pdata.Metric) error {
+func (prwe *PrwExporter) addNumberDataPointSlice(dataPoints pdata.NumberDataPointSlice, tsMap map[string]*prompb.TimeSeries, resource pdata.Resource, metric pdata.Metric, externalLabels map[string]string) error {
 	if dataPoints.Len() == 0 {
 		return consumererror.NewPermanent(fmt.Errorf("empty data points. %s is dropped", metric.Name()))
 	}
 	for x := 0; x < dataPoints.Len(); x++ {
-		addSingleNumberDataPoint(dataPoints.At(x), resource, metric, prwe.namespace, tsMap, prwe.externalLabels)
+		addSingleNumberDataPoint(dataPoints.At(x), resource, metric, prwe.namer, tsMap, externalLabels)
 	}
 	return nil
 }
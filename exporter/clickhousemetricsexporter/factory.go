@@ -17,9 +17,11 @@ package clickhousemetricsexporter
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -31,8 +33,14 @@ const (
 	typeStr = "clickhousemetricswrite"
 )
 
+var once sync.Once
+
 // NewFactory creates a new Prometheus Remote Write exporter.
 func NewFactory() component.ExporterFactory {
+	once.Do(func() {
+		_ = view.Register(MetricViews()...)
+	})
+
 	return exporterhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -106,5 +114,22 @@ func createDefaultConfig() config.Exporter {
 			QueueSize:    10000,
 			NumConsumers: 5,
 		},
+		Insert: InsertSettings{
+			WaitForAsyncInsert: true,
+		},
+		Batch: BatchSettings{
+			Enabled:       false,
+			MaxSamples:    50000,
+			FlushInterval: 10 * time.Second,
+		},
+		Cluster: ClusterSettings{
+			DistributedTablePrefix: "distributed_",
+		},
+		MetricNaming: MetricNamingSettings{
+			Scheme: MetricNamingSanitized,
+		},
+		ResourceColumns: ResourceColumnsSettings{
+			Attributes: append([]string(nil), defaultResourceColumns...),
+		},
 	}
 }
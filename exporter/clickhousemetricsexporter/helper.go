@@ -40,6 +40,9 @@ const (
 	quantileStr = "quantile"
 	pInfStr     = "+Inf"
 	keyStr      = "key"
+	// labels of the exemplars holding the IDs of the span they were recorded in.
+	traceIDStr = "trace_id"
+	spanIDStr  = "span_id"
 )
 
 type bucketBoundsData struct {
@@ -161,7 +164,8 @@ func timeSeriesSignature(metric pdata.Metric, labels *[]prompb.Label) string {
 
 // createAttributes creates a slice of Cortex Label with OTLP attributes and pairs of string values.
 // Unpaired string value is ignored. String pairs overwrites OTLP labels if collision happens, and the overwrite is
-// logged. Resultant label names are sanitized.
+// logged. Resultant label names are sanitized. An OTLP attribute overwrites the external label its name is sanitized
+// to, such as the label of a resource column.
 func createAttributes(resource pdata.Resource, attributes pdata.AttributeMap, externalLabels map[string]string, extras ...string) []prompb.Label {
 	// map ensures no duplicate label name
 	l := map[string]prompb.Label{}
@@ -185,8 +189,10 @@ func createAttributes(resource pdata.Resource, attributes pdata.AttributeMap, ex
 		return true
 	})
 
+	// keyed by sanitized name, so that the attributes override the external
+	// labels of the same name instead of duplicating them.
 	attributes.Range(func(key string, value pdata.AttributeValue) bool {
-		l[key] = prompb.Label{
+		l[sanitize(key)] = prompb.Label{
 			Name:  sanitize(key),
 			Value: value.AsString(),
 		}
@@ -315,10 +321,10 @@ func sanitizeRune(r rune) rune {
 
 // addSingleNumberDataPoint converts the metric value stored in pt to a Prometheus sample, and add the sample
 // to its corresponding time series in tsMap
-func addSingleNumberDataPoint(pt pdata.NumberDataPoint, resource pdata.Resource, metric pdata.Metric, namespace string,
+func addSingleNumberDataPoint(pt pdata.NumberDataPoint, resource pdata.Resource, metric pdata.Metric, namer metricNamer,
 	tsMap map[string]*prompb.TimeSeries, externalLabels map[string]string) {
 	// create parameters for addSample
-	name := getPromMetricName(metric, namespace)
+	name := namer.name(metric)
 	labels := createAttributes(resource, pt.Attributes(), externalLabels, nameStr, name)
 	sample := &prompb.Sample{
 		// convert ns to ms
@@ -333,16 +339,19 @@ func addSingleNumberDataPoint(pt pdata.NumberDataPoint, resource pdata.Resource,
 	if pt.Flags().HasFlag(pdata.MetricDataPointFlagNoRecordedValue) {
 		sample.Value = math.Float64frombits(value.StaleNaN)
 	}
-	addSample(tsMap, sample, labels, metric)
+	sig := addSample(tsMap, sample, labels, metric)
+	if ts, ok := tsMap[sig]; ok && pt.Exemplars().Len() > 0 {
+		ts.Exemplars = append(ts.Exemplars, getPromExemplars(pt.Exemplars())...)
+	}
 }
 
 // addSingleHistogramDataPoint converts pt to 2 + min(len(ExplicitBounds), len(BucketCount)) + 1 samples. It
 // ignore extra buckets if len(ExplicitBounds) > len(BucketCounts)
-func addSingleHistogramDataPoint(pt pdata.HistogramDataPoint, resource pdata.Resource, metric pdata.Metric, namespace string,
+func addSingleHistogramDataPoint(pt pdata.HistogramDataPoint, resource pdata.Resource, metric pdata.Metric, namer metricNamer,
 	tsMap map[string]*prompb.TimeSeries, externalLabels map[string]string) {
 	time := convertTimeStamp(pt.Timestamp())
 	// sum, count, and buckets of the histogram should append suffix to baseName
-	baseName := getPromMetricName(metric, namespace)
+	baseName := namer.name(metric)
 	// treat sum as a sample in an individual TimeSeries
 	sum := &prompb.Sample{
 		Value:     pt.Sum(),
@@ -370,7 +379,7 @@ func addSingleHistogramDataPoint(pt pdata.HistogramDataPoint, resource pdata.Res
 	// cumulative count for conversion to cumulative histogram
 	var cumulativeCount uint64
 
-	promExemplars := getPromExemplars(pt)
+	promExemplars := getPromExemplars(pt.Exemplars())
 
 	bucketBounds := make([]bucketBoundsData, 0)
 
@@ -410,18 +419,33 @@ func addSingleHistogramDataPoint(pt pdata.HistogramDataPoint, resource pdata.Res
 	addExemplars(tsMap, promExemplars, bucketBounds)
 }
 
-func getPromExemplars(pt pdata.HistogramDataPoint) []prompb.Exemplar {
+// getPromExemplars converts the exemplars to Prometheus exemplars. The trace and span IDs of the exemplars
+// are set as the trace_id and span_id labels, along with the filtered attributes.
+func getPromExemplars(exemplars pdata.ExemplarSlice) []prompb.Exemplar {
 	var promExemplars []prompb.Exemplar
 
-	for i := 0; i < pt.Exemplars().Len(); i++ {
-		exemplar := pt.Exemplars().At(i)
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
 
 		promExemplar := &prompb.Exemplar{
-			Value:     exemplar.DoubleVal(),
 			Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
 		}
+		switch exemplar.Type() {
+		case pdata.MetricValueTypeInt:
+			promExemplar.Value = float64(exemplar.IntVal())
+		default:
+			promExemplar.Value = exemplar.DoubleVal()
+		}
+
+		traceID := exemplar.TraceID()
+		spanID := exemplar.SpanID()
 
 		exemplar.FilteredAttributes().Range(func(key string, value pdata.AttributeValue) bool {
+			// the IDs of the exemplar take precedence over the attributes
+			if (key == traceIDStr && !traceID.IsEmpty()) || (key == spanIDStr && !spanID.IsEmpty()) {
+				return true
+			}
+
 			promLabel := prompb.Label{
 				Name:  key,
 				Value: value.AsString(),
@@ -432,6 +456,13 @@ func getPromExemplars(pt pdata.HistogramDataPoint) []prompb.Exemplar {
 			return true
 		})
 
+		if !traceID.IsEmpty() {
+			promExemplar.Labels = append(promExemplar.Labels, prompb.Label{Name: traceIDStr, Value: traceID.HexString()})
+		}
+		if !spanID.IsEmpty() {
+			promExemplar.Labels = append(promExemplar.Labels, prompb.Label{Name: spanIDStr, Value: spanID.HexString()})
+		}
+
 		promExemplars = append(promExemplars, *promExemplar)
 	}
 
@@ -439,11 +470,11 @@ func getPromExemplars(pt pdata.HistogramDataPoint) []prompb.Exemplar {
 }
 
 // addSingleSummaryDataPoint converts pt to len(QuantileValues) + 2 samples.
-func addSingleSummaryDataPoint(pt pdata.SummaryDataPoint, resource pdata.Resource, metric pdata.Metric, namespace string,
+func addSingleSummaryDataPoint(pt pdata.SummaryDataPoint, resource pdata.Resource, metric pdata.Metric, namer metricNamer,
 	tsMap map[string]*prompb.TimeSeries, externalLabels map[string]string) {
 	time := convertTimeStamp(pt.Timestamp())
 	// sum and count of the summary should append suffix to baseName
-	baseName := getPromMetricName(metric, namespace)
+	baseName := namer.name(metric)
 	// treat sum as a sample in an individual TimeSeries
 	sum := &prompb.Sample{
 		Value:     pt.Sum(),
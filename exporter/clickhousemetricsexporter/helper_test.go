@@ -22,6 +22,7 @@ import (
 	"github.com/prometheus/prometheus/model/timestamp"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/model/pdata"
 )
 
@@ -259,6 +260,14 @@ func Test_createLabelSet(t *testing.T) {
 			[]string{label31, value31, label32, value32},
 			getPromLabels(label11, value11, label12, value12, label31, value31, label32, value32),
 		},
+		{
+			"external_labels_overwritten_by_sanitized_name",
+			getResource(),
+			getAttributes("service.name", "checkout"),
+			map[string]string{"service_name": "cart"},
+			[]string{label31, value31},
+			getPromLabels("service_name", "checkout", label31, value31),
+		},
 	}
 	// run tests
 	for _, tt := range tests {
@@ -587,12 +596,52 @@ func Test_getPromExemplars(t *testing.T) {
 			getHistogramDataPoint(),
 			nil,
 		},
+		{
+			"with_trace_and_span_ids",
+			getHistogramDataPointWithTraceIDs(tnow, intVal1),
+			[]prompb.Exemplar{
+				{
+					Value:     float64(intVal1),
+					Timestamp: timestamp.FromTime(tnow),
+					Labels: []prompb.Label{
+						getLabel(label11, value11),
+						getLabel(traceIDKey, "0102030405060708090a0b0c0d0e0f10"),
+						getLabel(spanIDStr, "0102030405060708"),
+					},
+				},
+			},
+		},
 	}
 	// run tests
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			requests := getPromExemplars(*tt.histogram)
+			requests := getPromExemplars(tt.histogram.Exemplars())
 			assert.Exactly(t, tt.expected, requests)
 		})
 	}
 }
+
+// Test_addSingleNumberDataPointExemplars checks the exemplars of a number data point are added to its time series.
+func Test_addSingleNumberDataPointExemplars(t *testing.T) {
+	metric := pdata.NewMetric()
+	metric.SetName("test_sum")
+	metric.SetDataType(pdata.MetricDataTypeSum)
+	metric.Sum().SetIsMonotonic(true)
+	metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+
+	pt := metric.Sum().DataPoints().AppendEmpty()
+	pt.SetDoubleVal(floatVal1)
+	e := pt.Exemplars().AppendEmpty()
+	e.SetDoubleVal(floatVal2)
+	e.SetTraceID(pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+
+	tsMap := map[string]*prompb.TimeSeries{}
+	addSingleNumberDataPoint(pt, pdata.NewResource(), metric, metricNamer{}, tsMap, nil)
+
+	require.Len(t, tsMap, 1)
+	for _, ts := range tsMap {
+		require.Len(t, ts.Exemplars, 1)
+		assert.Equal(t, floatVal2, ts.Exemplars[0].Value)
+		assert.Equal(t, []prompb.Label{getLabel(traceIDStr, "0102030405060708090a0b0c0d0e0f10")}, ts.Exemplars[0].Labels)
+	}
+}
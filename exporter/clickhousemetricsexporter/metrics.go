@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	mBackfillWrittenSamples  = stats.Int64("clickhousemetricswrite_backfill_written_samples", "Number of backfilled samples", stats.UnitDimensionless)
+	mBackfillOldestTimestamp = stats.Float64("clickhousemetricswrite_backfill_oldest_sample_timestamp", "Timestamp of the oldest backfilled sample", stats.UnitSeconds)
+	mBackfillNewestTimestamp = stats.Float64("clickhousemetricswrite_backfill_newest_sample_timestamp", "Timestamp of the newest backfilled sample", stats.UnitSeconds)
+)
+
+// MetricViews return the metrics views according to given telemetry level.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        mBackfillWrittenSamples.Name(),
+			Measure:     mBackfillWrittenSamples,
+			Description: mBackfillWrittenSamples.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        mBackfillOldestTimestamp.Name(),
+			Measure:     mBackfillOldestTimestamp,
+			Description: mBackfillOldestTimestamp.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mBackfillNewestTimestamp.Name(),
+			Measure:     mBackfillNewestTimestamp,
+			Description: mBackfillNewestTimestamp.Description(),
+			Aggregation: view.LastValue(),
+		},
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"strings"
+	"unicode"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	// MetricNamingSanitized writes the OTLP metric names with the characters
+	// invalid in Prometheus metric names replaced by underscores, e.g.
+	// system_cpu_time.
+	MetricNamingSanitized = "sanitized"
+	// MetricNamingRaw writes the OTLP metric names as they are, e.g.
+	// system.cpu.time.
+	MetricNamingRaw = "raw"
+	// MetricNamingPrometheus writes the metric names normalized according to
+	// the Prometheus conventions, with the unit and the _total suffixes, e.g.
+	// system_cpu_time_seconds_total.
+	MetricNamingPrometheus = "prometheus"
+)
+
+// metricNamingSchemes are the supported metric naming schemes.
+var metricNamingSchemes = []string{MetricNamingSanitized, MetricNamingRaw, MetricNamingPrometheus}
+
+// prometheusUnits maps the UCUM units of the OTLP metrics to the Prometheus
+// unit suffixes.
+var prometheusUnits = map[string]string{
+	// time
+	"d":   "days",
+	"h":   "hours",
+	"min": "minutes",
+	"s":   "seconds",
+	"ms":  "milliseconds",
+	"us":  "microseconds",
+	"ns":  "nanoseconds",
+
+	// bytes
+	"By":   "bytes",
+	"KiBy": "kibibytes",
+	"MiBy": "mebibytes",
+	"GiBy": "gibibytes",
+	"TiBy": "tibibytes",
+	"KBy":  "kilobytes",
+	"MBy":  "megabytes",
+	"GBy":  "gigabytes",
+	"TBy":  "terabytes",
+
+	// SI
+	"m":   "meters",
+	"V":   "volts",
+	"A":   "amperes",
+	"J":   "joules",
+	"W":   "watts",
+	"g":   "grams",
+	"Cel": "celsius",
+	"Hz":  "hertz",
+	"%":   "percent",
+}
+
+// prometheusPerUnits maps the denominators of the OTLP rate units, e.g. the s
+// of By/s, to the Prometheus unit suffixes.
+var prometheusPerUnits = map[string]string{
+	"s":  "second",
+	"m":  "minute",
+	"h":  "hour",
+	"d":  "day",
+	"w":  "week",
+	"mo": "month",
+	"y":  "year",
+}
+
+func isMetricNamingScheme(scheme string) bool {
+	for _, s := range metricNamingSchemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// metricNamer builds the names of the written metrics according to the
+// configured naming scheme.
+type metricNamer struct {
+	namespace string
+	scheme    string
+}
+
+// name returns the name of the metric, the suffixes of the histograms and
+// summaries time series being appended to it.
+func (n metricNamer) name(metric pdata.Metric) string {
+	return metricName(metric, n.namespace, n.scheme)
+}
+
+// metricName returns the name of the metric in the given naming scheme.
+func metricName(metric pdata.Metric, namespace string, scheme string) string {
+	switch scheme {
+	case MetricNamingRaw:
+		if namespace != "" {
+			return namespace + "_" + metric.Name()
+		}
+		return metric.Name()
+	case MetricNamingPrometheus:
+		return prometheusMetricName(metric, namespace)
+	default:
+		return getPromMetricName(metric, namespace)
+	}
+}
+
+// prometheusMetricName returns the metric name normalized according to the
+// Prometheus naming conventions: the unit is appended to the name unless
+// already part of it, the monotonic sums get the _total suffix and the
+// gauges of unit 1 the _ratio suffix.
+// See https://prometheus.io/docs/practices/naming/#metric-names
+func prometheusMetricName(metric pdata.Metric, namespace string) string {
+	tokens := strings.FieldsFunc(metric.Name(), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if unit := prometheusUnit(metric.Unit()); unit != "" {
+		tokens = appendSuffix(tokens, unit)
+	}
+
+	switch {
+	case metric.DataType() == pdata.MetricDataTypeSum && metric.Sum().IsMonotonic():
+		// _total must come last, after the unit.
+		tokens = removeToken(tokens, "total")
+		tokens = append(tokens, "total")
+	case metric.DataType() == pdata.MetricDataTypeGauge && metric.Unit() == "1":
+		tokens = appendSuffix(tokens, "ratio")
+	}
+
+	name := strings.Join(tokens, "_")
+	if namespace != "" {
+		name = namespace + "_" + name
+	}
+	return sanitize(name)
+}
+
+// prometheusUnit returns the Prometheus suffix of a UCUM unit, or an empty
+// string if the unit has none. The annotations, e.g. {requests}, are dropped
+// and the units without a known suffix are kept as they are.
+func prometheusUnit(unit string) string {
+	main, per := unit, ""
+	if i := strings.Index(unit, "/"); i >= 0 {
+		main, per = unit[:i], unit[i+1:]
+	}
+
+	main = unitSuffix(main, prometheusUnits)
+	per = unitSuffix(per, prometheusPerUnits)
+	switch {
+	case main != "" && per != "":
+		return main + "_per_" + per
+	case per != "":
+		return "per_" + per
+	default:
+		return main
+	}
+}
+
+func unitSuffix(unit string, suffixes map[string]string) string {
+	unit = strings.TrimSpace(unit)
+	if unit == "" || unit == "1" || strings.HasPrefix(unit, "{") {
+		return ""
+	}
+	if suffix, ok := suffixes[unit]; ok {
+		return suffix
+	}
+	return strings.Trim(strings.Map(sanitizeRune, unit), "_")
+}
+
+// appendSuffix appends the tokens of suffix to tokens unless they already
+// end with them.
+func appendSuffix(tokens []string, suffix string) []string {
+	suffixTokens := strings.Split(suffix, "_")
+	if len(tokens) >= len(suffixTokens) &&
+		strings.Join(tokens[len(tokens)-len(suffixTokens):], "_") == suffix {
+		return tokens
+	}
+	return append(tokens, suffixTokens...)
+}
+
+func removeToken(tokens []string, token string) []string {
+	kept := tokens[:0]
+	for _, t := range tokens {
+		if t != token {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+// metricNameAlias links the name a time series is written under to its names
+// in each naming scheme.
+type metricNameAlias struct {
+	name           string
+	rawName        string
+	sanitizedName  string
+	prometheusName string
+}
+
+// metricNameAliases returns the aliases of the names of the time series of the
+// metric, written under the given naming scheme.
+func metricNameAliases(metric pdata.Metric, namespace string, scheme string) []metricNameAlias {
+	var suffixes []string
+	switch metric.DataType() {
+	case pdata.MetricDataTypeHistogram:
+		suffixes = []string{sumStr, countStr, bucketStr}
+	case pdata.MetricDataTypeSummary:
+		suffixes = []string{"", sumStr, countStr}
+	default:
+		suffixes = []string{""}
+	}
+
+	name := metricName(metric, namespace, scheme)
+	raw := metricName(metric, namespace, MetricNamingRaw)
+	sanitized := metricName(metric, namespace, MetricNamingSanitized)
+	prometheus := metricName(metric, namespace, MetricNamingPrometheus)
+
+	aliases := make([]metricNameAlias, 0, len(suffixes))
+	for _, suffix := range suffixes {
+		aliases = append(aliases, metricNameAlias{
+			name:           name + suffix,
+			rawName:        raw + suffix,
+			sanitizedName:  sanitized + suffix,
+			prometheusName: prometheus + suffix,
+		})
+	}
+	return aliases
+}
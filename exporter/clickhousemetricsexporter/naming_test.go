@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func newNamingMetric(name string, unit string, dataType pdata.MetricDataType, monotonic bool) pdata.Metric {
+	metric := pdata.NewMetric()
+	metric.SetName(name)
+	metric.SetUnit(unit)
+	metric.SetDataType(dataType)
+	if dataType == pdata.MetricDataTypeSum {
+		metric.Sum().SetIsMonotonic(monotonic)
+	}
+	return metric
+}
+
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		name      string
+		metric    pdata.Metric
+		namespace string
+		want      map[string]string
+	}{
+		{
+			name:   "monotonic sum with unit",
+			metric: newNamingMetric("system.cpu.time", "s", pdata.MetricDataTypeSum, true),
+			want: map[string]string{
+				MetricNamingRaw:        "system.cpu.time",
+				MetricNamingSanitized:  "system_cpu_time",
+				MetricNamingPrometheus: "system_cpu_time_seconds_total",
+			},
+		},
+		{
+			name:   "non monotonic sum",
+			metric: newNamingMetric("system.memory.usage", "By", pdata.MetricDataTypeSum, false),
+			want: map[string]string{
+				MetricNamingRaw:        "system.memory.usage",
+				MetricNamingSanitized:  "system_memory_usage",
+				MetricNamingPrometheus: "system_memory_usage_bytes",
+			},
+		},
+		{
+			name:   "unit already in name",
+			metric: newNamingMetric("http_requests_total", "{requests}", pdata.MetricDataTypeSum, true),
+			want: map[string]string{
+				MetricNamingRaw:        "http_requests_total",
+				MetricNamingSanitized:  "http_requests_total",
+				MetricNamingPrometheus: "http_requests_total",
+			},
+		},
+		{
+			name:   "ratio gauge",
+			metric: newNamingMetric("system.cpu.utilization", "1", pdata.MetricDataTypeGauge, false),
+			want: map[string]string{
+				MetricNamingRaw:        "system.cpu.utilization",
+				MetricNamingSanitized:  "system_cpu_utilization",
+				MetricNamingPrometheus: "system_cpu_utilization_ratio",
+			},
+		},
+		{
+			name:   "rate unit",
+			metric: newNamingMetric("network.io.rate", "By/s", pdata.MetricDataTypeGauge, false),
+			want: map[string]string{
+				MetricNamingRaw:        "network.io.rate",
+				MetricNamingSanitized:  "network_io_rate",
+				MetricNamingPrometheus: "network_io_rate_bytes_per_second",
+			},
+		},
+		{
+			name:      "namespace",
+			metric:    newNamingMetric("http.server.duration", "ms", pdata.MetricDataTypeHistogram, false),
+			namespace: "app",
+			want: map[string]string{
+				MetricNamingRaw:        "app_http.server.duration",
+				MetricNamingSanitized:  "app_http_server_duration",
+				MetricNamingPrometheus: "app_http_server_duration_milliseconds",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for scheme, want := range tt.want {
+				assert.Equal(t, want, metricName(tt.metric, tt.namespace, scheme), scheme)
+			}
+			// the sanitized scheme is the default one.
+			assert.Equal(t, tt.want[MetricNamingSanitized], metricNamer{namespace: tt.namespace}.name(tt.metric))
+		})
+	}
+}
+
+func TestPrometheusUnit(t *testing.T) {
+	assert.Equal(t, "", prometheusUnit(""))
+	assert.Equal(t, "", prometheusUnit("1"))
+	assert.Equal(t, "", prometheusUnit("{packets}"))
+	assert.Equal(t, "seconds", prometheusUnit("s"))
+	assert.Equal(t, "per_second", prometheusUnit("{requests}/s"))
+	assert.Equal(t, "mebibytes_per_minute", prometheusUnit("MiBy/m"))
+	assert.Equal(t, "widgets", prometheusUnit("widgets"))
+}
+
+func TestMetricNameAliases(t *testing.T) {
+	metric := newNamingMetric("http.server.duration", "ms", pdata.MetricDataTypeHistogram, false)
+	assert.Equal(t, []metricNameAlias{
+		{
+			name:           "http.server.duration_sum",
+			rawName:        "http.server.duration_sum",
+			sanitizedName:  "http_server_duration_sum",
+			prometheusName: "http_server_duration_milliseconds_sum",
+		},
+		{
+			name:           "http.server.duration_count",
+			rawName:        "http.server.duration_count",
+			sanitizedName:  "http_server_duration_count",
+			prometheusName: "http_server_duration_milliseconds_count",
+		},
+		{
+			name:           "http.server.duration_bucket",
+			rawName:        "http.server.duration_bucket",
+			sanitizedName:  "http_server_duration_bucket",
+			prometheusName: "http_server_duration_milliseconds_bucket",
+		},
+	}, metricNameAliases(metric, "", MetricNamingRaw))
+
+	metric = newNamingMetric("system.cpu.time", "s", pdata.MetricDataTypeSum, true)
+	assert.Equal(t, []metricNameAlias{
+		{
+			name:           "system_cpu_time_seconds_total",
+			rawName:        "system.cpu.time",
+			sanitizedName:  "system_cpu_time",
+			prometheusName: "system_cpu_time_seconds_total",
+		},
+	}, metricNameAliases(metric, "", MetricNamingPrometheus))
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// defaultResourceColumns are the resource attributes promoted to columns by
+// default, the ones SigNoz groups the metrics by.
+var defaultResourceColumns = []string{
+	conventions.AttributeServiceName,
+	conventions.AttributeDeploymentEnvironment,
+	conventions.AttributeK8SClusterName,
+	conventions.AttributeK8SNamespaceName,
+	conventions.AttributeK8SNodeName,
+	conventions.AttributeK8SDeploymentName,
+	conventions.AttributeK8SPodName,
+}
+
+// timeSeriesColumns are the columns of the time series table, which the
+// resource columns can't be named after.
+var timeSeriesColumns = []string{"metric_name", "fingerprint", "timestamp_ms", "labels", "labels_object"}
+
+// resourceColumnName returns the name of the column, and of the label, the
+// resource attribute is stored in.
+func resourceColumnName(attribute string) string {
+	return sanitize(attribute)
+}
+
+// resourceColumnsQueries returns the queries adding the resource columns to
+// the time series table, and to its Distributed table on a cluster. Each
+// column defaults to the value of its label, so that the time series written
+// before the column was added get their value from their labels, and the
+// INSERTs don't need to list the columns. The columns of the attributes no
+// longer promoted are kept.
+func resourceColumnsQueries(database string, params *ClickHouseParams) []string {
+	onCluster := onClusterClause(params.Cluster)
+	tables := []string{"time_series_v2"}
+	if params.Cluster != "" {
+		tables = append(tables, params.DistributedTablePrefix+"time_series_v2")
+	}
+
+	var queries []string
+	for _, table := range tables {
+		for _, attribute := range params.ResourceColumns {
+			column := resourceColumnName(attribute)
+			queries = append(queries, fmt.Sprintf(
+				"ALTER TABLE %s.%s%s ADD COLUMN IF NOT EXISTS %s LowCardinality(String) DEFAULT JSONExtractString(labels, %s)",
+				database, table, onCluster, quoteIdentifier(column), quoteString(column)))
+		}
+	}
+	return queries
+}
+
+// resourceLabels returns the external labels along with the labels of the
+// promoted attributes of the resource. The attributes of the data points
+// override these labels.
+func (prwe *PrwExporter) resourceLabels(resource pdata.Resource) map[string]string {
+	var labels map[string]string
+	for _, attribute := range prwe.resourceColumns {
+		value, ok := resource.Attributes().Get(attribute)
+		if !ok {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string, len(prwe.externalLabels)+len(prwe.resourceColumns))
+			for name, value := range prwe.externalLabels {
+				labels[name] = value
+			}
+		}
+		labels[resourceColumnName(attribute)] = value.AsString()
+	}
+	if labels == nil {
+		return prwe.externalLabels
+	}
+	return labels
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousemetricsexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestResourceColumnsQueries(t *testing.T) {
+	assert.Empty(t, resourceColumnsQueries("signoz_metrics", &ClickHouseParams{}))
+
+	assert.Equal(t, []string{
+		"ALTER TABLE signoz_metrics.time_series_v2 ADD COLUMN IF NOT EXISTS `service_name` LowCardinality(String) DEFAULT JSONExtractString(labels, 'service_name')",
+		"ALTER TABLE signoz_metrics.time_series_v2 ADD COLUMN IF NOT EXISTS `k8s_pod_name` LowCardinality(String) DEFAULT JSONExtractString(labels, 'k8s_pod_name')",
+	}, resourceColumnsQueries("signoz_metrics", &ClickHouseParams{
+		ResourceColumns: []string{"service.name", "k8s.pod.name"},
+	}))
+
+	assert.Equal(t, []string{
+		"ALTER TABLE signoz_metrics.time_series_v2 ON CLUSTER `cluster` ADD COLUMN IF NOT EXISTS `service_name` LowCardinality(String) DEFAULT JSONExtractString(labels, 'service_name')",
+		"ALTER TABLE signoz_metrics.distributed_time_series_v2 ON CLUSTER `cluster` ADD COLUMN IF NOT EXISTS `service_name` LowCardinality(String) DEFAULT JSONExtractString(labels, 'service_name')",
+	}, resourceColumnsQueries("signoz_metrics", &ClickHouseParams{
+		Cluster:                "cluster",
+		DistributedTablePrefix: "distributed_",
+		ResourceColumns:        []string{"service.name"},
+	}))
+}
+
+func TestSchemaQueriesAddResourceColumnsLast(t *testing.T) {
+	queries := schemaQueries("signoz_metrics", &ClickHouseParams{
+		Cluster:                "cluster",
+		DistributedTablePrefix: "distributed_",
+		ResourceColumns:        []string{"service.name"},
+	})
+	assert.Contains(t, queries[len(queries)-1], "ALTER TABLE signoz_metrics.distributed_time_series_v2 ON CLUSTER `cluster` ADD COLUMN IF NOT EXISTS `service_name`")
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`service_name`", quoteIdentifier("service_name"))
+	assert.Equal(t, "`a\\`b\\\\c`", quoteIdentifier("a`b\\c"))
+}
+
+func TestResourceLabels(t *testing.T) {
+	resource := pdata.NewResource()
+	resource.Attributes().InsertString("service.name", "checkout")
+	resource.Attributes().InsertString("k8s.pod.name", "checkout-1")
+	resource.Attributes().InsertString("host.name", "node-1")
+
+	externalLabels := map[string]string{"cluster": "prod"}
+	prwe := &PrwExporter{externalLabels: externalLabels}
+	assert.Equal(t, externalLabels, prwe.resourceLabels(resource))
+
+	prwe.resourceColumns = []string{"deployment.environment"}
+	assert.Equal(t, externalLabels, prwe.resourceLabels(resource))
+
+	prwe.resourceColumns = defaultResourceColumns
+	assert.Equal(t, map[string]string{
+		"cluster":      "prod",
+		"service_name": "checkout",
+		"k8s_pod_name": "checkout-1",
+	}, prwe.resourceLabels(resource))
+	assert.Equal(t, map[string]string{"cluster": "prod"}, externalLabels)
+}
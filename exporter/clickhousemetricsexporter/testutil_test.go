@@ -255,6 +255,21 @@ func getHistogramDataPointWithExemplars(time time.Time, value float64, attribute
 	return &h
 }
 
+func getHistogramDataPointWithTraceIDs(time time.Time, value int64) *pdata.HistogramDataPoint {
+	h := pdata.NewHistogramDataPoint()
+
+	e := h.Exemplars().AppendEmpty()
+	e.SetIntVal(value)
+	e.SetTimestamp(pdata.NewTimestampFromTime(time))
+	e.SetTraceID(pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	e.SetSpanID(pdata.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	e.FilteredAttributes().Insert(label11, pdata.NewAttributeValueString(value11))
+	// overridden by the trace ID of the exemplar
+	e.FilteredAttributes().Insert(traceIDKey, pdata.NewAttributeValueString(traceIDValue1))
+
+	return &h
+}
+
 func getHistogramDataPoint() *pdata.HistogramDataPoint {
 	h := pdata.NewHistogramDataPoint()
 
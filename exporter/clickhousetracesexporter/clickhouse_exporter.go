@@ -36,12 +36,24 @@ func newExporter(cfg config.Exporter, logger *zap.Logger) (*storage, error) {
 	configClickHouse := cfg.(*Config)
 
 	f := ClickHouseNewFactory(configClickHouse.Migrations, configClickHouse.Datasource)
+	if !configClickHouse.SpanEvents {
+		f.Options.getPrimary().EventsTable = ""
+	}
+	if !configClickHouse.SpanLinks {
+		f.Options.getPrimary().LinksTable = ""
+	}
 
 	err := f.Initialize(logger)
 	if err != nil {
 		return nil, err
 	}
 
+	if configClickHouse.Compression.Enabled {
+		if err := f.applyCompression(context.Background(), configClickHouse.Compression); err != nil {
+			return nil, err
+		}
+	}
+
 	spanWriter, err := f.CreateSpanWriter()
 	if err != nil {
 		return nil, err
@@ -212,6 +224,22 @@ func populateEvents(events pdata.SpanEventSlice, span *Span) {
 		}
 		stringEvent, _ := json.Marshal(event)
 		span.Events = append(span.Events, string(stringEvent))
+		span.SpanEvents = append(span.SpanEvents, event)
+	}
+}
+
+func populateLinks(links pdata.SpanLinkSlice, span *Span) {
+	for i := 0; i < links.Len(); i++ {
+		link := Link{}
+		link.TraceId = links.At(i).TraceID().HexString()
+		link.SpanId = links.At(i).SpanID().HexString()
+		link.TraceState = string(links.At(i).TraceState())
+		link.AttributeMap = map[string]string{}
+		links.At(i).Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+			link.AttributeMap[k] = v.AsString()
+			return true
+		})
+		span.SpanLinks = append(span.SpanLinks, link)
 	}
 }
 
@@ -283,6 +311,7 @@ func newStructuredSpan(otelSpan pdata.Span, ServiceName string, resource pdata.R
 	}
 	populateOtherDimensions(attributes, span)
 	populateEvents(otelSpan.Events(), span)
+	populateLinks(otelSpan.Links(), span)
 	populateTraceModel(span)
 
 	return span
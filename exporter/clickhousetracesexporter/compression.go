@@ -0,0 +1,185 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousetracesexporter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TimestampCodecDelta stores the difference between consecutive timestamps.
+	TimestampCodecDelta = "Delta"
+	// TimestampCodecDoubleDelta stores the difference of the differences between consecutive
+	// timestamps, which compresses best the timestamps increasing at a steady pace.
+	TimestampCodecDoubleDelta = "DoubleDelta"
+
+	defaultZSTDLevel      = 1
+	defaultTimestampCodec = TimestampCodecDoubleDelta
+	maxZSTDLevel          = 22
+)
+
+var (
+	zstdCodecRegexp  = regexp.MustCompile(`ZSTD\(\d+\)`)
+	deltaParamRegexp = regexp.MustCompile(`(Delta|DoubleDelta)\(\d+\)`)
+)
+
+// CompressionConfig defines the compression codecs of the columns of the traces tables.
+type CompressionConfig struct {
+	// Enabled applies the codecs to the traces tables when the exporter starts.
+	Enabled bool `mapstructure:"enabled"`
+	// TableCompression defines the codecs of the tables not listed in Tables.
+	TableCompression `mapstructure:",squash"`
+	// Tables overrides the codecs per table name, the unset settings being
+	// inherited from the defaults above.
+	Tables map[string]TableCompression `mapstructure:"tables"`
+}
+
+// TableCompression defines the compression codecs of the columns of a table.
+type TableCompression struct {
+	// ZSTDLevel is the level of the ZSTD codec of the columns compressed with ZSTD,
+	// from 1 (fastest) to 22 (smallest). Zero uses the default level.
+	ZSTDLevel int `mapstructure:"zstd_level"`
+	// TimestampCodec is the codec applied to the timestamp columns before ZSTD,
+	// either Delta or DoubleDelta.
+	TimestampCodec string `mapstructure:"timestamp_codec"`
+}
+
+func (c TableCompression) validate() error {
+	if c.ZSTDLevel < 0 || c.ZSTDLevel > maxZSTDLevel {
+		return fmt.Errorf("zstd_level must be between 1 and %d, or 0 for the default level, got %d", maxZSTDLevel, c.ZSTDLevel)
+	}
+	switch c.TimestampCodec {
+	case "", TimestampCodecDelta, TimestampCodecDoubleDelta:
+		return nil
+	default:
+		return fmt.Errorf("timestamp_codec must be %q or %q, got %q", TimestampCodecDelta, TimestampCodecDoubleDelta, c.TimestampCodec)
+	}
+}
+
+func (c CompressionConfig) validate() error {
+	if err := c.TableCompression.validate(); err != nil {
+		return err
+	}
+	for table, tc := range c.Tables {
+		if err := tc.validate(); err != nil {
+			return fmt.Errorf("table %q: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// forTable returns the codecs of the given table.
+func (c CompressionConfig) forTable(table string) TableCompression {
+	tc := c.TableCompression
+	if tc.ZSTDLevel == 0 {
+		tc.ZSTDLevel = defaultZSTDLevel
+	}
+	if tc.TimestampCodec == "" {
+		tc.TimestampCodec = defaultTimestampCodec
+	}
+	if override, ok := c.Tables[table]; ok {
+		if override.ZSTDLevel != 0 {
+			tc.ZSTDLevel = override.ZSTDLevel
+		}
+		if override.TimestampCodec != "" {
+			tc.TimestampCodec = override.TimestampCodec
+		}
+	}
+	return tc
+}
+
+// columnCodec returns the codec of a column of the given type and current codec,
+// or an empty string if the column codec is left as it is. The timestamp columns
+// are compressed with the timestamp codec followed by ZSTD, the columns already
+// compressed with ZSTD get the configured level while keeping their other codecs,
+// e.g. CODEC(T64, ZSTD(1)) becomes CODEC(T64, ZSTD(3)), and the other columns,
+// e.g. the aggregate function states or the columns compressed with LZ4, are left
+// untouched.
+func columnCodec(colType string, current string, tc TableCompression) string {
+	var codec string
+	switch {
+	case strings.HasPrefix(colType, "AggregateFunction"), strings.HasPrefix(colType, "SimpleAggregateFunction"):
+		return ""
+	case strings.HasPrefix(colType, "DateTime"):
+		codec = fmt.Sprintf("CODEC(%s, ZSTD(%d))", tc.TimestampCodec, tc.ZSTDLevel)
+	case zstdCodecRegexp.MatchString(current):
+		codec = zstdCodecRegexp.ReplaceAllString(current, fmt.Sprintf("ZSTD(%d)", tc.ZSTDLevel))
+	default:
+		return ""
+	}
+
+	// system.columns reports the Delta codecs with their byte size parameter.
+	if deltaParamRegexp.ReplaceAllString(current, "$1") == codec {
+		return ""
+	}
+	return codec
+}
+
+// applyCompression modifies the codecs of the columns of the traces tables according
+// to the compression settings. Only the newly written parts use the new codecs, the
+// existing parts are recompressed when they are merged.
+func (f *Factory) applyCompression(ctx context.Context, cfg CompressionConfig) error {
+	ns := f.Options.getPrimary()
+	for _, table := range []string{ns.IndexTable, ns.SpansTable, ns.ErrorTable, ns.EventsTable, ns.LinksTable} {
+		if table == "" {
+			continue
+		}
+		if err := f.applyTableCompression(ctx, ns.TraceDatabase, table, cfg.forTable(table)); err != nil {
+			return fmt.Errorf("could not apply the compression codecs to %s.%s: %w", ns.TraceDatabase, table, err)
+		}
+	}
+	return nil
+}
+
+func (f *Factory) applyTableCompression(ctx context.Context, database string, table string, tc TableCompression) error {
+	rows, err := f.db.Query(ctx, "SELECT name, type, compression_codec FROM system.columns WHERE database = ? AND table = ?", database, table)
+	if err != nil {
+		return err
+	}
+
+	// The columns are modified once the rows are read, as the connection is busy until then.
+	var columns, codecs []string
+	for rows.Next() {
+		var name, colType, current string
+		if err := rows.Scan(&name, &colType, &current); err != nil {
+			rows.Close()
+			return err
+		}
+		if codec := columnCodec(colType, current, tc); codec != "" {
+			columns = append(columns, name)
+			codecs = append(codecs, codec)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i, name := range columns {
+		query := fmt.Sprintf("ALTER TABLE %s.%s MODIFY COLUMN `%s` %s", database, table, name, codecs[i])
+		if err := f.db.Exec(ctx, query); err != nil {
+			return err
+		}
+		f.logger.Info("Modified column codec", zap.String("table", table), zap.String("column", name), zap.String("codec", codecs[i]))
+	}
+	return nil
+}
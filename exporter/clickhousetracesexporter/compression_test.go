@@ -0,0 +1,111 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhousetracesexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionConfigValidate(t *testing.T) {
+	assert.NoError(t, CompressionConfig{}.validate())
+	assert.NoError(t, CompressionConfig{TableCompression: TableCompression{ZSTDLevel: 22, TimestampCodec: TimestampCodecDelta}}.validate())
+	assert.Error(t, CompressionConfig{TableCompression: TableCompression{ZSTDLevel: -1}}.validate())
+	assert.Error(t, CompressionConfig{TableCompression: TableCompression{ZSTDLevel: 23}}.validate())
+	assert.Error(t, CompressionConfig{TableCompression: TableCompression{TimestampCodec: "Gorilla"}}.validate())
+	assert.EqualError(t,
+		CompressionConfig{Tables: map[string]TableCompression{"signoz_spans": {ZSTDLevel: 30}}}.validate(),
+		`table "signoz_spans": zstd_level must be between 1 and 22, or 0 for the default level, got 30`)
+}
+
+func TestCompressionConfigForTable(t *testing.T) {
+	cfg := CompressionConfig{
+		TableCompression: TableCompression{ZSTDLevel: 3},
+		Tables: map[string]TableCompression{
+			"signoz_spans":    {ZSTDLevel: 9},
+			"signoz_index_v2": {TimestampCodec: TimestampCodecDelta},
+		},
+	}
+	assert.Equal(t, TableCompression{ZSTDLevel: 3, TimestampCodec: TimestampCodecDoubleDelta}, cfg.forTable("signoz_error_index_v2"))
+	assert.Equal(t, TableCompression{ZSTDLevel: 9, TimestampCodec: TimestampCodecDoubleDelta}, cfg.forTable("signoz_spans"))
+	assert.Equal(t, TableCompression{ZSTDLevel: 3, TimestampCodec: TimestampCodecDelta}, cfg.forTable("signoz_index_v2"))
+
+	assert.Equal(t, TableCompression{ZSTDLevel: defaultZSTDLevel, TimestampCodec: defaultTimestampCodec}, CompressionConfig{}.forTable("signoz_spans"))
+}
+
+func TestColumnCodec(t *testing.T) {
+	tc := TableCompression{ZSTDLevel: 3, TimestampCodec: TimestampCodecDoubleDelta}
+	tests := []struct {
+		name    string
+		colType string
+		current string
+		want    string
+	}{
+		{
+			name:    "timestamp",
+			colType: "DateTime64(9)",
+			current: "CODEC(Delta(8), ZSTD(1))",
+			want:    "CODEC(DoubleDelta, ZSTD(3))",
+		},
+		{
+			name:    "timestamp unchanged",
+			colType: "DateTime64(9)",
+			current: "CODEC(DoubleDelta(8), ZSTD(3))",
+			want:    "",
+		},
+		{
+			name:    "zstd",
+			colType: "String",
+			current: "CODEC(ZSTD(1))",
+			want:    "CODEC(ZSTD(3))",
+		},
+		{
+			name:    "zstd unchanged",
+			colType: "String",
+			current: "CODEC(ZSTD(3))",
+			want:    "",
+		},
+		{
+			name:    "t64 keeps t64",
+			colType: "UInt64",
+			current: "CODEC(T64, ZSTD(1))",
+			want:    "CODEC(T64, ZSTD(3))",
+		},
+		{
+			name:    "lz4",
+			colType: "String",
+			current: "CODEC(LZ4)",
+			want:    "",
+		},
+		{
+			name:    "no codec",
+			colType: "String",
+			current: "",
+			want:    "",
+		},
+		{
+			name:    "aggregate function",
+			colType: "AggregateFunction(quantiles(0.5), Float64)",
+			current: "CODEC(ZSTD(1))",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, columnCodec(tt.colType, tt.current, tc))
+		})
+	}
+}
@@ -25,11 +25,18 @@ type Config struct {
 	Options    `mapstructure:",squash"`
 	Datasource string `mapstructure:"datasource"`
 	Migrations string `mapstructure:"migrations"`
+	// SpanEvents enables writing the span events to the span_events table,
+	// one row per event, in addition to the events serialized in the spans.
+	SpanEvents bool `mapstructure:"span_events"`
+	// SpanLinks enables writing the span links to the span_links table.
+	SpanLinks bool `mapstructure:"span_links"`
+	// Compression defines the compression codecs of the columns of the traces tables.
+	Compression CompressionConfig `mapstructure:"compression"`
 }
 
 var _ config.Exporter = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	return cfg.Compression.validate()
 }
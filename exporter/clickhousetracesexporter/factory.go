@@ -35,6 +35,14 @@ func createDefaultConfig() config.Exporter {
 	return &Config{
 		// Options:          *opts,
 		ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+		SpanEvents:       true,
+		SpanLinks:        true,
+		Compression: CompressionConfig{
+			TableCompression: TableCompression{
+				ZSTDLevel:      defaultZSTDLevel,
+				TimestampCodec: defaultTimestampCodec,
+			},
+		},
 	}
 }
 
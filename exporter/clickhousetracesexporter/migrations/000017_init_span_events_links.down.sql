@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS signoz_traces.span_events;
+
+DROP TABLE IF EXISTS signoz_traces.span_links;
\ No newline at end of file
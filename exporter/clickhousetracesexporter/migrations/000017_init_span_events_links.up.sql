@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS signoz_traces.span_events (
+  timestamp DateTime64(9) CODEC(DoubleDelta, LZ4),
+  traceID FixedString(32) CODEC(ZSTD(1)),
+  spanID String CODEC(ZSTD(1)),
+  serviceName LowCardinality(String) CODEC(ZSTD(1)),
+  spanName LowCardinality(String) CODEC(ZSTD(1)),
+  name LowCardinality(String) CODEC(ZSTD(1)),
+  isError bool CODEC(T64, ZSTD(1)),
+  attributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+  INDEX idx_trace_id traceID TYPE bloom_filter GRANULARITY 4,
+  INDEX idx_attributesKeys mapKeys(attributes) TYPE bloom_filter(0.01) GRANULARITY 64,
+  INDEX idx_attributesValues mapValues(attributes) TYPE bloom_filter(0.01) GRANULARITY 64
+) ENGINE MergeTree()
+PARTITION BY toDate(timestamp)
+ORDER BY (serviceName, name, timestamp);
+
+CREATE TABLE IF NOT EXISTS signoz_traces.span_links (
+  timestamp DateTime64(9) CODEC(DoubleDelta, LZ4),
+  traceID FixedString(32) CODEC(ZSTD(1)),
+  spanID String CODEC(ZSTD(1)),
+  serviceName LowCardinality(String) CODEC(ZSTD(1)),
+  linkedTraceID FixedString(32) CODEC(ZSTD(1)),
+  linkedSpanID String CODEC(ZSTD(1)),
+  traceState String CODEC(ZSTD(1)),
+  attributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+  INDEX idx_trace_id traceID TYPE bloom_filter GRANULARITY 4,
+  INDEX idx_linked_trace_id linkedTraceID TYPE bloom_filter GRANULARITY 4
+) ENGINE MergeTree()
+PARTITION BY toDate(timestamp)
+ORDER BY (serviceName, timestamp);
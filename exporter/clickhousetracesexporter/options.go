@@ -33,6 +33,8 @@ const (
 	defaultIndexTable        string        = "signoz_index_v2"
 	defaultErrorTable        string        = "signoz_error_index_v2"
 	defaultSpansTable        string        = "signoz_spans"
+	defaultEventsTable       string        = "span_events"
+	defaultLinksTable        string        = "span_links"
 	defaultArchiveSpansTable string        = "signoz_archive_spans"
 	defaultWriteBatchDelay   time.Duration = 5 * time.Second
 	defaultWriteBatchSize    int           = 10000
@@ -47,6 +49,8 @@ const (
 	suffixOperationsTable = ".operations-table"
 	suffixIndexTable      = ".index-table"
 	suffixSpansTable      = ".spans-table"
+	suffixEventsTable     = ".events-table"
+	suffixLinksTable      = ".links-table"
 	suffixWriteBatchDelay = ".write-batch-delay"
 	suffixWriteBatchSize  = ".write-batch-size"
 	suffixEncoding        = ".encoding"
@@ -63,6 +67,8 @@ type namespaceConfig struct {
 	IndexTable      string
 	SpansTable      string
 	ErrorTable      string
+	EventsTable     string
+	LinksTable      string
 	WriteBatchDelay time.Duration
 	WriteBatchSize  int
 	Encoding        Encoding
@@ -129,6 +135,8 @@ func NewOptions(migrations string, datasource string, primaryNamespace string, o
 			IndexTable:      defaultIndexTable,
 			ErrorTable:      defaultErrorTable,
 			SpansTable:      defaultSpansTable,
+			EventsTable:     defaultEventsTable,
+			LinksTable:      defaultLinksTable,
 			WriteBatchDelay: defaultWriteBatchDelay,
 			WriteBatchSize:  defaultWriteBatchSize,
 			Encoding:        defaultEncoding,
@@ -193,6 +201,18 @@ func addFlags(flagSet *flag.FlagSet, nsConfig *namespaceConfig) {
 			nsConfig.IndexTable,
 			"Clickhouse index table name.",
 		)
+
+		flagSet.String(
+			nsConfig.namespace+suffixEventsTable,
+			nsConfig.EventsTable,
+			"Clickhouse span events table name.",
+		)
+
+		flagSet.String(
+			nsConfig.namespace+suffixLinksTable,
+			nsConfig.LinksTable,
+			"Clickhouse span links table name.",
+		)
 	}
 
 	flagSet.String(
@@ -234,6 +254,8 @@ func initFromViper(cfg *namespaceConfig, v *viper.Viper) {
 	cfg.TraceDatabase = v.GetString(cfg.namespace + suffixTraceDatabase)
 	cfg.IndexTable = v.GetString(cfg.namespace + suffixIndexTable)
 	cfg.SpansTable = v.GetString(cfg.namespace + suffixSpansTable)
+	cfg.EventsTable = v.GetString(cfg.namespace + suffixEventsTable)
+	cfg.LinksTable = v.GetString(cfg.namespace + suffixLinksTable)
 	cfg.OperationsTable = v.GetString(cfg.namespace + suffixOperationsTable)
 	cfg.WriteBatchDelay = v.GetDuration(cfg.namespace + suffixWriteBatchDelay)
 	cfg.WriteBatchSize = v.GetInt(cfg.namespace + suffixWriteBatchSize)
@@ -21,6 +21,13 @@ type Event struct {
 	IsError      bool              `json:"isError,omitempty"`
 }
 
+type Link struct {
+	TraceId      string            `json:"traceId,omitempty"`
+	SpanId       string            `json:"spanId,omitempty"`
+	TraceState   string            `json:"traceState,omitempty"`
+	AttributeMap map[string]string `json:"attributeMap,omitempty"`
+}
+
 type TraceModel struct {
 	TraceId           string            `json:"traceId,omitempty"`
 	SpanId            string            `json:"spanId,omitempty"`
@@ -61,6 +68,8 @@ type Span struct {
 	DBOperation        string            `json:"dbOperation,omitempty"`
 	PeerService        string            `json:"peerService,omitempty"`
 	Events             []string          `json:"event,omitempty"`
+	SpanEvents         []Event           `json:"spanEvents,omitempty"`
+	SpanLinks          []Link            `json:"spanLinks,omitempty"`
 	ErrorEvent         Event             `json:"errorEvent,omitempty"`
 	ErrorID            string            `json:"errorID,omitempty"`
 	ErrorGroupID       string            `json:"errorGroupID,omitempty"`
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -43,6 +44,8 @@ type SpanWriter struct {
 	indexTable    string
 	errorTable    string
 	spansTable    string
+	eventsTable   string
+	linksTable    string
 	encoding      Encoding
 	delay         time.Duration
 	size          int
@@ -52,7 +55,7 @@ type SpanWriter struct {
 }
 
 // NewSpanWriter returns a SpanWriter for the database
-func NewSpanWriter(logger *zap.Logger, db clickhouse.Conn, traceDatabase string, spansTable string, indexTable string, errorTable string, encoding Encoding, delay time.Duration, size int) *SpanWriter {
+func NewSpanWriter(logger *zap.Logger, db clickhouse.Conn, traceDatabase string, spansTable string, indexTable string, errorTable string, eventsTable string, linksTable string, encoding Encoding, delay time.Duration, size int) *SpanWriter {
 	writer := &SpanWriter{
 		logger:        logger,
 		db:            db,
@@ -60,6 +63,8 @@ func NewSpanWriter(logger *zap.Logger, db clickhouse.Conn, traceDatabase string,
 		indexTable:    indexTable,
 		errorTable:    errorTable,
 		spansTable:    spansTable,
+		eventsTable:   eventsTable,
+		linksTable:    linksTable,
 		encoding:      encoding,
 		delay:         delay,
 		size:          size,
@@ -125,13 +130,21 @@ func (w *SpanWriter) writeBatch(batch []*Span) error {
 			return err
 		}
 	}
+
+	// the errors, events and links of the batch are written even if one of
+	// these tables fails, so that the spans are not missing all of them.
+	var errs error
 	if w.errorTable != "" {
-		if err := w.writeErrorBatch(batch); err != nil {
-			return err
-		}
+		errs = multierr.Append(errs, w.writeErrorBatch(batch))
+	}
+	if w.eventsTable != "" {
+		errs = multierr.Append(errs, w.writeEventsBatch(batch))
+	}
+	if w.linksTable != "" {
+		errs = multierr.Append(errs, w.writeLinksBatch(batch))
 	}
 
-	return nil
+	return errs
 }
 
 func (w *SpanWriter) writeIndexBatch(batchSpans []*Span) error {
@@ -217,6 +230,64 @@ func (w *SpanWriter) writeErrorBatch(batchSpans []*Span) error {
 	return statement.Send()
 }
 
+func (w *SpanWriter) writeEventsBatch(batchSpans []*Span) error {
+
+	ctx := context.Background()
+	statement, err := w.db.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%s", w.traceDatabase, w.eventsTable))
+	if err != nil {
+		return err
+	}
+
+	for _, span := range batchSpans {
+		for _, event := range span.SpanEvents {
+			err = statement.Append(
+				time.Unix(0, int64(event.TimeUnixNano)),
+				span.TraceId,
+				span.SpanId,
+				span.ServiceName,
+				span.Name,
+				event.Name,
+				event.IsError,
+				event.AttributeMap,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return statement.Send()
+}
+
+func (w *SpanWriter) writeLinksBatch(batchSpans []*Span) error {
+
+	ctx := context.Background()
+	statement, err := w.db.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s.%s", w.traceDatabase, w.linksTable))
+	if err != nil {
+		return err
+	}
+
+	for _, span := range batchSpans {
+		for _, link := range span.SpanLinks {
+			err = statement.Append(
+				time.Unix(0, int64(span.StartTimeUnixNano)),
+				span.TraceId,
+				span.SpanId,
+				span.ServiceName,
+				link.TraceId,
+				link.SpanId,
+				link.TraceState,
+				link.AttributeMap,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return statement.Send()
+}
+
 func stringToBool(s string) bool {
 	if strings.ToLower(s) == "true" {
 		return true
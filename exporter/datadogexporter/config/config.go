@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -27,11 +28,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata/valid"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagset"
 )
 
 var (
 	errUnsetAPIKey = errors.New("api.key is not set")
 	errNoMetadata  = errors.New("only_metadata can't be enabled when send_metadata or use_resource_metadata is disabled")
+
+	errInvalidCircuitBreaker = errors.New("traces.circuit_breaker.failure_threshold and traces.circuit_breaker.open_duration must be positive")
+
+	errInvalidRunningMetricsInterval = errors.New("running_metrics_interval must not be negative")
 )
 
 // TODO: Import these from translator when we eliminate cyclic dependency.
@@ -39,6 +45,9 @@ const (
 	histogramModeNoBuckets     = "nobuckets"
 	histogramModeCounters      = "counters"
 	histogramModeDistributions = "distributions"
+
+	exemplarsModeDrop = "drop"
+	exemplarsModeTags = "tags"
 )
 
 const (
@@ -57,6 +66,11 @@ type APIConfig struct {
 	// It can also be set through the `DD_SITE` environment variable.
 	// The default value is "datadoghq.com".
 	Site string `mapstructure:"site"`
+
+	// FailOnInvalidKey states whether to fail the exporter creation when the API key is invalid.
+	// By default, the API key is validated in the background, retrying while Datadog is unreachable,
+	// and an invalid API key is only logged.
+	FailOnInvalidKey bool `mapstructure:"fail_on_invalid_key"`
 }
 
 // GetCensoredKey returns the API key censored for logging purposes
@@ -106,12 +120,42 @@ type HistogramConfig struct {
 	// SendCountSum states if the export should send .sum and .count metrics for histograms.
 	// The current default is false.
 	SendCountSum bool `mapstructure:"send_count_sum_metrics"`
+
+	// Exemplars defines the export of the exemplars of OTLP Histograms.
+	Exemplars ExemplarsConfig `mapstructure:"exemplars"`
+}
+
+// ExemplarsConfig customizes export of the exemplars of OTLP Histograms.
+type ExemplarsConfig struct {
+	// Mode for exporting exemplars. Valid values are 'drop' or 'tags'.
+	//  - 'drop' drops the exemplars, counting them with the datadog_dropped_exemplars metric.
+	//  - 'tags' adds the trace_id and span_id of a sample of the exemplars as tags of the
+	//    distribution points, to correlate them with the Datadog APM traces. The histograms
+	//    must be exported as distributions, the other exemplars are dropped.
+	//
+	// The current default is 'drop'.
+	Mode string `mapstructure:"mode"`
+
+	// MaxTags is the maximum number of exemplars added as tags to a distribution point in the 'tags' mode.
+	// Each of them makes the distribution point a new series in Datadog, so it should be kept low.
+	// The current default is 1.
+	MaxTags int `mapstructure:"max_tags"`
 }
 
 func (c *HistogramConfig) validate() error {
 	if c.Mode == histogramModeNoBuckets && !c.SendCountSum {
 		return fmt.Errorf("'nobuckets' mode and `send_count_sum_metrics` set to false will send no histogram metrics")
 	}
+
+	switch c.Exemplars.Mode {
+	case "", exemplarsModeDrop:
+	case exemplarsModeTags:
+		if c.Exemplars.MaxTags <= 0 {
+			return fmt.Errorf("exemplars `max_tags` must be positive: %d", c.Exemplars.MaxTags)
+		}
+	default:
+		return fmt.Errorf("invalid exemplars `mode` %s", c.Exemplars.Mode)
+	}
 	return nil
 }
 
@@ -125,6 +169,12 @@ type MetricsExporterConfig struct {
 	// InstrumentationLibraryMetadataAsTags, if set to true, adds the name and version of the
 	// instrumentation library that created a metric to the metric tags
 	InstrumentationLibraryMetadataAsTags bool `mapstructure:"instrumentation_library_metadata_as_tags"`
+
+	// ContainerTags, if set to false, leaves out of the metric tags the container and pod tags of the
+	// resource attributes (container_id, container_name, image_name, image_tag, kube_container_name,
+	// pod_name and ecs_container_name) to reduce the cardinality of the metrics. The cloud, ECS task
+	// and Kubernetes workload tags are kept. Defaults to true.
+	ContainerTags bool `mapstructure:"container_tags"`
 }
 
 // TracesConfig defines the traces exporter specific configuration options
@@ -157,6 +207,70 @@ type TracesConfig struct {
 	// If set to false the resource name will be filled with the instrumentation library name + span kind.
 	// The default value is `false`.
 	SpanNameAsResourceName bool `mapstructure:"span_name_as_resource_name"`
+
+	// RetrySettings defines the retries of the trace and trace stats payloads,
+	// independently from the top-level settings used for metrics.
+	// Retries are disabled by default since the intake does not dedupe APM events.
+	RetrySettings exporterhelper.RetrySettings `mapstructure:"retry_on_failure"`
+
+	// QueueSettings defines the sending queue of the traces exporter,
+	// independently from the top-level settings used for metrics.
+	QueueSettings exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+
+	// CircuitBreaker defines when trace payloads stop being sent after the
+	// intake rejected them, e.g. because of an invalid API key or an exceeded quota.
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+
+	// Ingestion defines the origin and source set on the spans.
+	Ingestion IngestionConfig `mapstructure:"ingestion"`
+}
+
+// IngestionConfig defines the origin and source set on the outgoing payloads,
+// so that Datadog usage attribution and pipelines can tell the data sent by
+// the collector apart from the data sent by the Datadog Agent.
+type IngestionConfig struct {
+	// Origin is the ingestion origin of the data, e.g. "otel-collector".
+	Origin string `mapstructure:"origin"`
+
+	// OriginAttribute is the resource attribute whose value, when present,
+	// is used as the origin instead of Origin.
+	OriginAttribute string `mapstructure:"origin_attribute"`
+
+	// Source is the source of the data, e.g. the team or product sending it.
+	Source string `mapstructure:"source"`
+
+	// SourceAttribute is the resource attribute whose value, when present,
+	// is used as the source instead of Source.
+	SourceAttribute string `mapstructure:"source_attribute"`
+}
+
+// OriginAndSource returns the origin and source of the data with the given
+// resource attributes. Empty values mean that nothing should be set.
+func (c IngestionConfig) OriginAndSource(resourceAttrs map[string]string) (origin string, source string) {
+	origin, source = c.Origin, c.Source
+	if v, ok := resourceAttrs[c.OriginAttribute]; ok && c.OriginAttribute != "" && v != "" {
+		origin = v
+	}
+	if v, ok := resourceAttrs[c.SourceAttribute]; ok && c.SourceAttribute != "" && v != "" {
+		source = v
+	}
+	return origin, source
+}
+
+// CircuitBreakerConfig defines the circuit breaker of the traces exporter.
+// Once open, trace payloads are dropped without being sent while metrics
+// keep being exported.
+type CircuitBreakerConfig struct {
+	// Enabled defines whether the circuit breaker is enabled.
+	Enabled bool `mapstructure:"enabled"`
+
+	// FailureThreshold is the number of consecutive 4xx responses from the
+	// intake after which the circuit opens.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+
+	// OpenDuration is how long the circuit stays open before a single payload
+	// is sent to probe the intake again.
+	OpenDuration time.Duration `mapstructure:"open_duration"`
 }
 
 // TagsConfig defines the tag-related configuration
@@ -190,7 +304,9 @@ type TagsConfig struct {
 	Tags []string `mapstructure:"tags"`
 }
 
-// GetHostTags gets the host tags extracted from the configuration
+// GetHostTags gets the host tags extracted from the configuration.
+// The returned tags are deduplicated and sorted, and the `env` setting
+// takes precedence over any `env` tag set through `tags` or `DD_TAGS`.
 func (t *TagsConfig) GetHostTags() []string {
 	tags := t.Tags
 
@@ -198,10 +314,11 @@ func (t *TagsConfig) GetHostTags() []string {
 		tags = strings.Split(t.EnvVarTags, " ")
 	}
 
+	var envTags []string
 	if t.Env != "none" {
-		tags = append(tags, fmt.Sprintf("env:%s", t.Env))
+		envTags = append(envTags, fmt.Sprintf("env:%s", t.Env))
 	}
-	return tags
+	return tagset.Canonicalize(envTags, tags)
 }
 
 // LimitedTLSClientSetting is a subset of TLSClientSetting, see LimitedHTTPClientSettings for more details
@@ -258,6 +375,11 @@ type Config struct {
 	// Disable this in the Collector if you are using an agent-collector setup.
 	UseResourceMetadata bool `mapstructure:"use_resource_metadata"`
 
+	// RunningMetricsInterval defines the minimum interval between two
+	// `otel.datadog_exporter.<type>.running` metrics reported for the same
+	// host or running tag. A zero interval reports them on every payload.
+	RunningMetricsInterval time.Duration `mapstructure:"running_metrics_interval"`
+
 	// onceMetadata ensures only one exporter (metrics/traces) sends host metadata
 	onceMetadata sync.Once
 
@@ -320,6 +442,10 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.Traces.CircuitBreaker.Enabled && (c.Traces.CircuitBreaker.FailureThreshold <= 0 || c.Traces.CircuitBreaker.OpenDuration <= 0) {
+		return errInvalidCircuitBreaker
+	}
+
 	if c.Traces.SpanNameRemappings != nil {
 		for key, value := range c.Traces.SpanNameRemappings {
 			if value == "" {
@@ -331,6 +457,10 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.RunningMetricsInterval < 0 {
+		return errInvalidRunningMetricsInterval
+	}
+
 	err := c.Metrics.HistConfig.validate()
 	if err != nil {
 		return err
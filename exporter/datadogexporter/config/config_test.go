@@ -16,6 +16,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +79,20 @@ func TestHostTags(t *testing.T) {
 		},
 		tc.GetHostTags(),
 	)
+
+	tc = TagsConfig{
+		Env:        "customenv",
+		EnvVarTags: "key2:val2  env:otherenv key1:val1 key2:val2",
+	}
+
+	assert.Equal(t,
+		[]string{
+			"env:customenv",
+			"key1:val1",
+			"key2:val2",
+		},
+		tc.GetHostTags(),
+	)
 }
 
 // TestOverrideMetricsURL tests that the metrics URL is overridden
@@ -190,3 +205,32 @@ func TestSpanNameRemappingsValidation(t *testing.T) {
 	require.NoError(t, noErr)
 	require.Error(t, err)
 }
+
+func TestCircuitBreakerValidation(t *testing.T) {
+	validCfg := Config{Traces: TracesConfig{CircuitBreaker: CircuitBreakerConfig{Enabled: true, FailureThreshold: 5, OpenDuration: time.Minute}}}
+	disabledCfg := Config{Traces: TracesConfig{CircuitBreaker: CircuitBreakerConfig{Enabled: false}}}
+	invalidCfg := Config{Traces: TracesConfig{CircuitBreaker: CircuitBreakerConfig{Enabled: true, FailureThreshold: 0, OpenDuration: time.Minute}}}
+	require.NoError(t, validCfg.Validate())
+	require.NoError(t, disabledCfg.Validate())
+	require.Equal(t, errInvalidCircuitBreaker, invalidCfg.Validate())
+}
+
+func TestRunningMetricsIntervalValidation(t *testing.T) {
+	validCfg := Config{RunningMetricsInterval: time.Minute}
+	disabledCfg := Config{}
+	invalidCfg := Config{RunningMetricsInterval: -time.Second}
+	require.NoError(t, validCfg.Validate())
+	require.NoError(t, disabledCfg.Validate())
+	require.Equal(t, errInvalidRunningMetricsInterval, invalidCfg.Validate())
+}
+
+func TestExemplarsValidation(t *testing.T) {
+	dropCfg := Config{Metrics: MetricsConfig{HistConfig: HistogramConfig{Mode: "distributions", Exemplars: ExemplarsConfig{Mode: "drop"}}}}
+	tagsCfg := Config{Metrics: MetricsConfig{HistConfig: HistogramConfig{Mode: "distributions", Exemplars: ExemplarsConfig{Mode: "tags", MaxTags: 1}}}}
+	noTagsCfg := Config{Metrics: MetricsConfig{HistConfig: HistogramConfig{Mode: "distributions", Exemplars: ExemplarsConfig{Mode: "tags", MaxTags: 0}}}}
+	invalidCfg := Config{Metrics: MetricsConfig{HistConfig: HistogramConfig{Mode: "distributions", Exemplars: ExemplarsConfig{Mode: "attributes"}}}}
+	require.NoError(t, dropCfg.Validate())
+	require.NoError(t, tagsCfg.Validate())
+	require.Error(t, noTagsCfg.Validate())
+	require.Error(t, invalidCfg.Validate())
+}
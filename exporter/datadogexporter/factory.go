@@ -17,8 +17,10 @@ package datadogexporter // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"os"
+	"sync"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -28,16 +30,27 @@ import (
 
 	ddconfig "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
 const (
 	// typeStr is the type of the exporter
 	typeStr = "datadog"
+
+	// defaultRunningMetricsInterval is the default minimum interval between
+	// two running metrics reported for the same host or running tag.
+	defaultRunningMetricsInterval = time.Minute
 )
 
+var once sync.Once
+
 // NewFactory creates a Datadog exporter factory
 func NewFactory() component.ExporterFactory {
+	once.Do(func() {
+		_ = view.Register(utils.MetricViews()...)
+	})
+
 	return exporterhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -52,6 +65,22 @@ func defaulttimeoutSettings() exporterhelper.TimeoutSettings {
 	}
 }
 
+// defaultTracesRetrySettings disables the retries of trace payloads, since
+// the trace intake does not dedupe APM events.
+func defaultTracesRetrySettings() exporterhelper.RetrySettings {
+	settings := exporterhelper.DefaultRetrySettings()
+	settings.Enabled = false
+	return settings
+}
+
+func defaultCircuitBreakerConfig() ddconfig.CircuitBreakerConfig {
+	return ddconfig.CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 5,
+		OpenDuration:     time.Minute,
+	}
+}
+
 // createDefaultConfig creates the default exporter configuration
 func createDefaultConfig() config.Exporter {
 	return &ddconfig.Config{
@@ -83,10 +112,15 @@ func createDefaultConfig() config.Exporter {
 			ExporterConfig: ddconfig.MetricsExporterConfig{
 				ResourceAttributesAsTags:             false,
 				InstrumentationLibraryMetadataAsTags: false,
+				ContainerTags:                        true,
 			},
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "drop",
+					MaxTags: 1,
+				},
 			},
 		},
 
@@ -96,10 +130,14 @@ func createDefaultConfig() config.Exporter {
 				Endpoint: os.Getenv("DD_APM_URL"), // If not provided, set during config sanitization
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
 
-		SendMetadata:        true,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}
 }
 
@@ -194,7 +232,12 @@ func createTracesExporter(
 			return nil
 		}
 	} else {
-		pushTracesFn = newTracesExporter(ctx, set, cfg).pushTraceDataScrubbed
+		exp, err := newTracesExporter(ctx, set, cfg)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		pushTracesFn = exp.pushTraceDataScrubbed
 	}
 
 	return exporterhelper.NewTracesExporter(
@@ -203,9 +246,10 @@ func createTracesExporter(
 		pushTracesFn,
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0 * time.Second}),
-		// We don't do retries on traces because of deduping concerns on APM Events.
+		// Trace payloads are retried by the exporter itself according to the traces retry
+		// settings, since retrying a whole batch would duplicate the payloads already sent.
 		exporterhelper.WithRetry(exporterhelper.RetrySettings{Enabled: false}),
-		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithQueue(cfg.Traces.QueueSettings),
 		exporterhelper.WithShutdown(func(context.Context) error {
 			cancel()
 			return nil
@@ -75,6 +75,9 @@ func TestCreateDefaultConfig(t *testing.T) {
 		},
 
 		Metrics: ddconfig.MetricsConfig{
+			ExporterConfig: ddconfig.MetricsExporterConfig{
+				ContainerTags: true,
+			},
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "URL",
 			},
@@ -84,6 +87,10 @@ func TestCreateDefaultConfig(t *testing.T) {
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "drop",
+					MaxTags: 1,
+				},
 			},
 		},
 
@@ -93,6 +100,9 @@ func TestCreateDefaultConfig(t *testing.T) {
 				Endpoint: "APM_URL",
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
 
 		TagsConfig: ddconfig.TagsConfig{
@@ -103,9 +113,10 @@ func TestCreateDefaultConfig(t *testing.T) {
 			EnvVarTags: "TAGS",
 		},
 
-		SendMetadata:        true,
-		OnlyMetadata:        false,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		OnlyMetadata:           false,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}, cfg, "failed to create default config")
 
 	assert.NoError(t, configtest.CheckConfigStruct(cfg))
@@ -148,6 +159,9 @@ func TestLoadConfig(t *testing.T) {
 		},
 
 		Metrics: ddconfig.MetricsConfig{
+			ExporterConfig: ddconfig.MetricsExporterConfig{
+				ContainerTags: true,
+			},
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.eu",
 			},
@@ -157,6 +171,10 @@ func TestLoadConfig(t *testing.T) {
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "drop",
+					MaxTags: 1,
+				},
 			},
 		},
 
@@ -166,10 +184,14 @@ func TestLoadConfig(t *testing.T) {
 				Endpoint: "https://trace.agent.datadoghq.eu",
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
-		SendMetadata:        true,
-		OnlyMetadata:        false,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		OnlyMetadata:           false,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}, apiConfig)
 
 	defaultConfig := cfg.Exporters[config.NewComponentIDWithName(typeStr, "default")].(*ddconfig.Config)
@@ -196,6 +218,9 @@ func TestLoadConfig(t *testing.T) {
 		},
 
 		Metrics: ddconfig.MetricsConfig{
+			ExporterConfig: ddconfig.MetricsExporterConfig{
+				ContainerTags: true,
+			},
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.com",
 			},
@@ -205,6 +230,10 @@ func TestLoadConfig(t *testing.T) {
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "drop",
+					MaxTags: 1,
+				},
 			},
 		},
 
@@ -214,10 +243,14 @@ func TestLoadConfig(t *testing.T) {
 				Endpoint: "https://trace.agent.datadoghq.com",
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
-		SendMetadata:        true,
-		OnlyMetadata:        false,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		OnlyMetadata:           false,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}, defaultConfig)
 
 	invalidConfig := cfg.Exporters[config.NewComponentIDWithName(typeStr, "invalid")].(*ddconfig.Config)
@@ -286,6 +319,9 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 		},
 
 		Metrics: ddconfig.MetricsConfig{
+			ExporterConfig: ddconfig.MetricsExporterConfig{
+				ContainerTags: true,
+			},
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.test",
 			},
@@ -295,6 +331,10 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "tags",
+					MaxTags: 2,
+				},
 			},
 		},
 
@@ -304,10 +344,14 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 				Endpoint: "https://trace.agent.datadoghq.test",
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
-		SendMetadata:        true,
-		OnlyMetadata:        false,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		OnlyMetadata:           false,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}, apiConfig)
 
 	defaultConfig := cfg.Exporters[config.NewComponentIDWithName(typeStr, "default2")].(*ddconfig.Config)
@@ -337,6 +381,9 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 		},
 
 		Metrics: ddconfig.MetricsConfig{
+			ExporterConfig: ddconfig.MetricsExporterConfig{
+				ContainerTags: true,
+			},
 			TCPAddr: confignet.TCPAddr{
 				Endpoint: "https://api.datadoghq.com",
 			},
@@ -346,6 +393,10 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 			HistConfig: ddconfig.HistogramConfig{
 				Mode:         "distributions",
 				SendCountSum: false,
+				Exemplars: ddconfig.ExemplarsConfig{
+					Mode:    "drop",
+					MaxTags: 1,
+				},
 			},
 		},
 
@@ -355,10 +406,14 @@ func TestLoadConfigEnvVariables(t *testing.T) {
 				Endpoint: "https://trace.agent.datadoghq.com",
 			},
 			IgnoreResources: []string{},
+			RetrySettings:   defaultTracesRetrySettings(),
+			QueueSettings:   exporterhelper.DefaultQueueSettings(),
+			CircuitBreaker:  defaultCircuitBreakerConfig(),
 		},
-		SendMetadata:        true,
-		OnlyMetadata:        false,
-		UseResourceMetadata: true,
+		SendMetadata:           true,
+		OnlyMetadata:           false,
+		UseResourceMetadata:    true,
+		RunningMetricsInterval: defaultRunningMetricsInterval,
 	}, defaultConfig)
 }
 
@@ -30,11 +30,13 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata/system"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata/valid"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes/azure"
 	ec2Attributes "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
 )
 
@@ -117,9 +119,34 @@ func metadataFromAttributes(attrs pdata.AttributeMap) *HostMetadata {
 		hm.Meta.HostAliases = append(hm.Meta.HostAliases, azureHostInfo.HostAliases...)
 	}
 
+	appendHostAliases(hm.Meta, attributes.HostAliasesFromAttributes(attrs))
+
 	return hm
 }
 
+// appendHostAliases adds the valid aliases to the host aliases,
+// skipping the ones already known as a name of the host.
+func appendHostAliases(meta *Meta, aliases []string) {
+	for _, alias := range aliases {
+		if alias == meta.Hostname || alias == meta.InstanceID || alias == meta.EC2Hostname {
+			continue
+		}
+		if valid.Hostname(alias) != nil || containsString(meta.HostAliases, alias) {
+			continue
+		}
+		meta.HostAliases = append(meta.HostAliases, alias)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func fillHostMetadata(params component.ExporterCreateSettings, cfg *config.Config, hm *HostMetadata) {
 	// Could not get hostname from attributes
 	if hm.InternalHostname == "" {
@@ -132,7 +159,8 @@ func fillHostMetadata(params component.ExporterCreateSettings, cfg *config.Confi
 	// since it does not come from OTEL conventions
 	hm.Flavor = params.BuildInfo.Command
 	hm.Version = params.BuildInfo.Version
-	hm.Tags.OTel = append(hm.Tags.OTel, cfg.GetHostTags()...)
+	// Tags set in the configuration take precedence over the ones from resource attributes
+	hm.Tags.OTel = tagset.Canonicalize(cfg.GetHostTags(), hm.Tags.OTel)
 
 	// EC2 data was not set from attributes
 	if hm.Meta.EC2Hostname == "" {
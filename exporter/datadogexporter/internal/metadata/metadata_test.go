@@ -153,6 +153,20 @@ func TestMetadataFromAttributes(t *testing.T) {
 	assert.Equal(t, metadataOther.Meta, &Meta{Hostname: "custom-name"})
 	assert.Equal(t, metadataOther.Tags, &HostTags{})
 
+	// Kubernetes node with a custom hostname, the node name is qualified with the cluster name
+	attrsK8s := testutils.NewAttributeMap(map[string]string{
+		attributes.AttributeDatadogHostname:    "custom-name",
+		attributes.AttributeK8sNodeName:        "node-name",
+		conventions.AttributeK8SClusterName:    "cluster-name",
+		conventions.AttributeHostName:          "host-name",
+		attributes.AttributeDatadogHostAliases: "custom-alias, host-name, invalid_alias, localhost",
+	})
+	metadataK8s := metadataFromAttributes(attrsK8s)
+	assert.Equal(t, metadataK8s.InternalHostname, "custom-name")
+	assert.Equal(t, metadataK8s.Meta, &Meta{
+		Hostname:    "custom-name",
+		HostAliases: []string{"custom-alias", "host-name", "node-name-cluster-name"},
+	})
 }
 
 func TestPushMetadata(t *testing.T) {
@@ -18,7 +18,6 @@ import (
 	"context"
 
 	"github.com/DataDog/datadog-agent/pkg/quantile"
-	"go.opentelemetry.io/collector/component"
 	"gopkg.in/zorkian/go-datadog-api.v2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/translator"
@@ -59,29 +58,25 @@ func (c *Consumer) toDataType(dt translator.MetricDataType) (out MetricDataType)
 	return
 }
 
-// runningMetrics gets the running metrics for the exporter.
-func (c *Consumer) runningMetrics(timestamp uint64, buildInfo component.BuildInfo) (series []datadog.Metric) {
+// runningMetrics gets the running metrics for the exporter that are due.
+func (c *Consumer) runningMetrics(timestamp uint64, running *RunningMetrics) []datadog.Metric {
+	hosts := make([]string, 0, len(c.seenHosts))
 	for host := range c.seenHosts {
-		// Report the host as running
-		runningMetric := DefaultMetrics("metrics", host, timestamp, buildInfo)
-		series = append(series, runningMetric...)
+		hosts = append(hosts, host)
 	}
 
+	tags := make([]string, 0, len(c.seenTags))
 	for tag := range c.seenTags {
-		runningMetrics := DefaultMetrics("metrics", "", timestamp, buildInfo)
-		for i := range runningMetrics {
-			runningMetrics[i].Tags = append(runningMetrics[i].Tags, tag)
-		}
-		series = append(series, runningMetrics...)
+		tags = append(tags, tag)
 	}
 
-	return
+	return running.Series(timestamp, hosts, tags)
 }
 
 // All gets all metrics (consumed metrics and running metrics).
-func (c *Consumer) All(timestamp uint64, buildInfo component.BuildInfo) ([]datadog.Metric, sketches.SketchSeriesList) {
+func (c *Consumer) All(timestamp uint64, running *RunningMetrics) ([]datadog.Metric, sketches.SketchSeriesList) {
 	series := c.ms
-	series = append(series, c.runningMetrics(timestamp, buildInfo)...)
+	series = append(series, c.runningMetrics(timestamp, running)...)
 	return series, c.sl
 }
 
@@ -72,7 +72,7 @@ func TestRunningMetrics(t *testing.T) {
 	tr.MapMetrics(ctx, ms, consumer)
 
 	runningHostnames := []string{}
-	for _, metric := range consumer.runningMetrics(0, component.BuildInfo{}) {
+	for _, metric := range consumer.runningMetrics(0, NewRunningMetrics("metrics", 0, component.BuildInfo{})) {
 		if metric.Host != nil {
 			runningHostnames = append(runningHostnames, *metric.Host)
 		}
@@ -115,7 +115,7 @@ func TestTagsMetrics(t *testing.T) {
 	consumer := NewConsumer()
 	tr.MapMetrics(ctx, ms, consumer)
 
-	runningMetrics := consumer.runningMetrics(0, component.BuildInfo{})
+	runningMetrics := consumer.runningMetrics(0, NewRunningMetrics("metrics", 0, component.BuildInfo{}))
 	runningTags := []string{}
 	runningHostnames := []string{}
 	for _, metric := range runningMetrics {
@@ -128,4 +128,6 @@ func TestTagsMetrics(t *testing.T) {
 	assert.ElementsMatch(t, runningHostnames, []string{"", "", ""})
 	assert.Len(t, runningMetrics, 3)
 	assert.ElementsMatch(t, runningTags, []string{"task_arn:task-arn-1", "task_arn:task-arn-2", "task_arn:task-arn-3"})
+	// Running metrics are reported in a deterministic order.
+	assert.Equal(t, []string{"task_arn:task-arn-1", "task_arn:task-arn-2", "task_arn:task-arn-3"}, runningTags)
 }
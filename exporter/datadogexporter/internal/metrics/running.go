@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"gopkg.in/zorkian/go-datadog-api.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagset"
+)
+
+// RunningMetrics consolidates the running metrics of an exporter, so that
+// each host and running tag is reported at most once per interval
+// instead of once per payload.
+type RunningMetrics struct {
+	exporterType string
+	interval     time.Duration
+	buildInfo    component.BuildInfo
+
+	mu sync.Mutex
+	// lastHosts and lastTags hold the timestamp (Unix nanoseconds) of the
+	// last running metric reported for each host and running tag.
+	lastHosts map[string]uint64
+	lastTags  map[string]uint64
+}
+
+// NewRunningMetrics creates a running metrics aggregator for the given exporter type.
+// An interval of zero reports the running metrics on every payload.
+func NewRunningMetrics(exporterType string, interval time.Duration, buildInfo component.BuildInfo) *RunningMetrics {
+	return &RunningMetrics{
+		exporterType: exporterType,
+		interval:     interval,
+		buildInfo:    buildInfo,
+		lastHosts:    make(map[string]uint64),
+		lastTags:     make(map[string]uint64),
+	}
+}
+
+// Series gets the running metrics due at the given Unix nanoseconds timestamp
+// for the hosts and running tags seen in a payload.
+func (r *RunningMetrics) Series(timestamp uint64, hosts []string, tags []string) (series []datadog.Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sortedHosts := make([]string, len(hosts))
+	copy(sortedHosts, hosts)
+	sort.Strings(sortedHosts)
+	for _, host := range sortedHosts {
+		if !r.due(r.lastHosts, host, timestamp) {
+			continue
+		}
+		// Report the host as running
+		series = append(series, DefaultMetrics(r.exporterType, host, timestamp, r.buildInfo)...)
+	}
+
+	for _, tag := range tagset.Canonicalize(tags) {
+		if !r.due(r.lastTags, tag, timestamp) {
+			continue
+		}
+		runningMetrics := DefaultMetrics(r.exporterType, "", timestamp, r.buildInfo)
+		for i := range runningMetrics {
+			runningMetrics[i].Tags = tagset.Canonicalize([]string{tag}, runningMetrics[i].Tags)
+		}
+		series = append(series, runningMetrics...)
+	}
+
+	r.prune(timestamp)
+	return
+}
+
+// due reports whether a running metric must be sent for the given key,
+// and records the timestamp if so.
+func (r *RunningMetrics) due(last map[string]uint64, key string, timestamp uint64) bool {
+	if ts, ok := last[key]; ok && timestamp < ts+uint64(r.interval) {
+		return false
+	}
+	last[key] = timestamp
+	return true
+}
+
+// prune removes the keys whose interval has elapsed, since they
+// would be reported again on their next occurrence anyway.
+func (r *RunningMetrics) prune(timestamp uint64) {
+	for _, last := range []map[string]uint64{r.lastHosts, r.lastTags} {
+		for key, ts := range last {
+			if timestamp >= ts+uint64(r.interval) {
+				delete(last, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component"
+	"gopkg.in/zorkian/go-datadog-api.v2"
+)
+
+func runningKeys(series []datadog.Metric) (hosts []string, tags []string) {
+	for _, metric := range series {
+		if host := metric.GetHost(); host != "" {
+			hosts = append(hosts, host)
+		} else {
+			tags = append(tags, metric.Tags...)
+		}
+	}
+	return
+}
+
+func TestRunningMetricsSeries(t *testing.T) {
+	running := NewRunningMetrics("traces", 0, component.BuildInfo{})
+
+	series := running.Series(uint64(1e9), []string{"host-2", "host-1"}, []string{"task_arn:b", "task_arn:a", "task_arn:b"})
+	hosts, tags := runningKeys(series)
+	assert.Len(t, series, 4)
+	assert.Equal(t, []string{"host-1", "host-2"}, hosts)
+	assert.Equal(t, []string{"task_arn:a", "task_arn:b"}, tags)
+	for _, metric := range series {
+		assert.Equal(t, "otel.datadog_exporter.traces.running", metric.GetMetric())
+	}
+
+	// without an interval, running metrics are reported on every payload
+	series = running.Series(uint64(1e9), []string{"host-1"}, []string{"task_arn:a"})
+	assert.Len(t, series, 2)
+}
+
+func TestRunningMetricsInterval(t *testing.T) {
+	running := NewRunningMetrics("metrics", time.Minute, component.BuildInfo{})
+	start := uint64(time.Now().UnixNano())
+
+	series := running.Series(start, []string{"host-1"}, []string{"task_arn:a"})
+	assert.Len(t, series, 2)
+
+	// already reported during the interval
+	series = running.Series(start+uint64(30*time.Second), []string{"host-1", "host-2"}, []string{"task_arn:a", "task_arn:b"})
+	hosts, tags := runningKeys(series)
+	assert.Equal(t, []string{"host-2"}, hosts)
+	assert.Equal(t, []string{"task_arn:b"}, tags)
+
+	// interval elapsed for the first host and tag only
+	series = running.Series(start+uint64(time.Minute), []string{"host-1", "host-2"}, []string{"task_arn:a", "task_arn:b"})
+	hosts, tags = runningKeys(series)
+	assert.Equal(t, []string{"host-1"}, hosts)
+	assert.Equal(t, []string{"task_arn:a"}, tags)
+
+	// entries whose interval elapsed are pruned
+	running.Series(start+uint64(3*time.Minute), nil, nil)
+	assert.Empty(t, running.lastHosts)
+	assert.Empty(t, running.lastTags)
+}
@@ -92,6 +92,19 @@ var (
 		conventions.AttributeAWSECSContainerARN,
 	}
 
+	// containerLevelAttributes is the set of container and pod attributes that are left out of
+	// the metric tags when container tags are disabled. Unlike containerTagsAttributes, it does not
+	// include the cloud, ECS task and Kubernetes workload attributes, which are kept.
+	containerLevelAttributes = map[string]bool{
+		conventions.AttributeContainerID:        true,
+		conventions.AttributeContainerName:      true,
+		conventions.AttributeContainerImageName: true,
+		conventions.AttributeContainerImageTag:  true,
+		conventions.AttributeK8SContainerName:   true,
+		conventions.AttributeK8SPodName:         true,
+		conventions.AttributeAWSECSContainerARN: true,
+	}
+
 	runningTagsAttributes = []string{
 		conventions.AttributeAWSECSTaskARN,
 	}
@@ -119,6 +132,18 @@ var (
 // TagsFromAttributes converts a selected list of attributes
 // to a tag list that can be added to metrics.
 func TagsFromAttributes(attrs pdata.AttributeMap) []string {
+	return tagsFromAttributes(attrs, true)
+}
+
+// TagsFromAttributesWithoutContainerTags converts a selected list of attributes
+// to a tag list that can be added to metrics, leaving out the container and pod tags:
+// container_id, container_name, image_name, image_tag, kube_container_name, pod_name
+// and ecs_container_name.
+func TagsFromAttributesWithoutContainerTags(attrs pdata.AttributeMap) []string {
+	return tagsFromAttributes(attrs, false)
+}
+
+func tagsFromAttributes(attrs pdata.AttributeMap, containerTags bool) []string {
 	tags := make([]string, 0, attrs.Len())
 
 	var processAttributes processAttributes
@@ -145,8 +170,8 @@ func TagsFromAttributes(attrs pdata.AttributeMap) []string {
 			systemAttributes.OSType = value.StringVal()
 		}
 
-		// conventions mapping
-		if datadogKey, found := conventionsMapping[key]; found && value.StringVal() != "" {
+		// conventions mapping, leaving out the container level tags when disabled
+		if datadogKey, found := conventionsMapping[key]; found && value.StringVal() != "" && (containerTags || !containerLevelAttributes[key]) {
 			tags = append(tags, fmt.Sprintf("%s:%s", datadogKey, value.StringVal()))
 		}
 
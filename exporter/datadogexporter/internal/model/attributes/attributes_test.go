@@ -47,6 +47,42 @@ func TestTagsFromAttributes(t *testing.T) {
 	}, TagsFromAttributes(attrs))
 }
 
+func TestTagsFromAttributesWithoutContainerTags(t *testing.T) {
+	attributeMap := map[string]pdata.AttributeValue{
+		conventions.AttributeDeploymentEnvironment: pdata.NewAttributeValueString("prod"),
+		conventions.AttributeContainerID:           pdata.NewAttributeValueString("container_id"),
+		conventions.AttributeContainerImageName:    pdata.NewAttributeValueString("image_name"),
+		conventions.AttributeK8SPodName:            pdata.NewAttributeValueString("pod_name"),
+		conventions.AttributeK8SNamespaceName:      pdata.NewAttributeValueString("namespace"),
+		conventions.AttributeCloudRegion:           pdata.NewAttributeValueString("us-east-1"),
+		conventions.AttributeAWSECSTaskFamily:      pdata.NewAttributeValueString("task_family"),
+		conventions.AttributeOSType:                pdata.NewAttributeValueString("linux"),
+		"app.kubernetes.io/name":                   pdata.NewAttributeValueString("app"),
+	}
+	attrs := pdata.NewAttributeMapFromMap(attributeMap)
+
+	assert.ElementsMatch(t, []string{
+		"env:prod",
+		"container_id:container_id",
+		"image_name:image_name",
+		"pod_name:pod_name",
+		"kube_namespace:namespace",
+		"region:us-east-1",
+		"task_family:task_family",
+		fmt.Sprintf("%s:%s", conventions.AttributeOSType, "linux"),
+		"kube_app_name:app",
+	}, TagsFromAttributes(attrs))
+
+	assert.ElementsMatch(t, []string{
+		"env:prod",
+		"kube_namespace:namespace",
+		"region:us-east-1",
+		"task_family:task_family",
+		fmt.Sprintf("%s:%s", conventions.AttributeOSType, "linux"),
+		"kube_app_name:app",
+	}, TagsFromAttributesWithoutContainerTags(attrs))
+}
+
 func TestTagsFromAttributesEmpty(t *testing.T) {
 	attrs := pdata.NewAttributeMap()
 
@@ -15,6 +15,8 @@
 package attributes // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes"
 
 import (
+	"strings"
+
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 
@@ -28,6 +30,9 @@ const (
 	AttributeDatadogHostname = "datadog.host.name"
 	// AttributeK8sNodeName the datadog k8s node name attribute
 	AttributeK8sNodeName = "k8s.node.name"
+	// AttributeDatadogHostAliases the datadog host aliases attribute, a list of strings
+	// or a comma separated string
+	AttributeDatadogHostAliases = "datadog.host.aliases"
 )
 
 func getClusterName(attrs pdata.AttributeMap) (string, bool) {
@@ -120,3 +125,47 @@ func unsanitizedHostnameFromAttributes(attrs pdata.AttributeMap) (string, bool)
 
 	return "", false
 }
+
+// HostAliasesFromAttributes gets the other names the host may be reported with,
+// so that Datadog merges them with the host instead of creating duplicates:
+//
+//   1. the custom Datadog host aliases provided by the "datadog.host.aliases" attribute,
+//   2. the Kubernetes node name (and cluster name if available),
+//   3. the host.name attribute and
+//   4. the cloud provider host ID.
+//
+// The aliases are not deduplicated and may include the hostname.
+func HostAliasesFromAttributes(attrs pdata.AttributeMap) []string {
+	var aliases []string
+
+	if customAliases, ok := attrs.Get(AttributeDatadogHostAliases); ok {
+		switch customAliases.Type() {
+		case pdata.AttributeValueTypeArray:
+			values := customAliases.SliceVal()
+			for i := 0; i < values.Len(); i++ {
+				aliases = append(aliases, values.At(i).AsString())
+			}
+		case pdata.AttributeValueTypeString:
+			for _, alias := range strings.Split(customAliases.StringVal(), ",") {
+				aliases = append(aliases, strings.TrimSpace(alias))
+			}
+		}
+	}
+
+	// Kubernetes: node-cluster if cluster name is available, else node
+	if k8sNodeName, ok := attrs.Get(AttributeK8sNodeName); ok {
+		alias := k8sNodeName.StringVal()
+		if k8sClusterName, ok := getClusterName(attrs); ok {
+			alias += "-" + k8sClusterName
+		}
+		aliases = append(aliases, alias)
+	}
+
+	for _, key := range []string{conventions.AttributeHostName, conventions.AttributeHostID} {
+		if alias, ok := attrs.Get(key); ok {
+			aliases = append(aliases, alias.StringVal())
+		}
+	}
+
+	return aliases
+}
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes/azure"
@@ -190,3 +191,42 @@ func TestHostnameKubernetes(t *testing.T) {
 	// cluster name gets ignored, fallback to next option
 	assert.Equal(t, hostname, testHostID)
 }
+
+func TestHostAliasesFromAttributes(t *testing.T) {
+	// Kubernetes node, host name and host ID
+	attrs := testutils.NewAttributeMap(map[string]string{
+		AttributeK8sNodeName:                testNodeName,
+		conventions.AttributeK8SClusterName: testClusterName,
+		conventions.AttributeContainerID:    testContainerID,
+		conventions.AttributeHostID:         testHostID,
+		conventions.AttributeHostName:       testHostName,
+	})
+	assert.Equal(t, []string{testNodeName + "-" + testClusterName, testHostName, testHostID}, HostAliasesFromAttributes(attrs))
+
+	// Kubernetes node without cluster name
+	attrs = testutils.NewAttributeMap(map[string]string{
+		AttributeK8sNodeName: testNodeName,
+	})
+	assert.Equal(t, []string{testNodeName}, HostAliasesFromAttributes(attrs))
+
+	// Custom aliases as a comma separated string
+	attrs = testutils.NewAttributeMap(map[string]string{
+		AttributeDatadogHostAliases:   "alias-1, alias-2",
+		conventions.AttributeHostName: testHostName,
+	})
+	assert.Equal(t, []string{"alias-1", "alias-2", testHostName}, HostAliasesFromAttributes(attrs))
+
+	// Custom aliases as a list
+	attrs = pdata.NewAttributeMap()
+	aliases := pdata.NewAttributeValueArray()
+	aliases.SliceVal().AppendEmpty().SetStringVal("alias-1")
+	aliases.SliceVal().AppendEmpty().SetStringVal("alias-2")
+	attrs.Insert(AttributeDatadogHostAliases, aliases)
+	assert.Equal(t, []string{"alias-1", "alias-2"}, HostAliasesFromAttributes(attrs))
+
+	// No alias
+	attrs = testutils.NewAttributeMap(map[string]string{
+		conventions.AttributeContainerID: testContainerID,
+	})
+	assert.Empty(t, HostAliasesFromAttributes(attrs))
+}
@@ -24,6 +24,9 @@ type translatorConfig struct {
 	SendMonotonic                        bool
 	ResourceAttributesAsTags             bool
 	InstrumentationLibraryMetadataAsTags bool
+	ContainerTags                        bool
+	ExemplarTags                         bool
+	MaxExemplarTags                      int
 
 	// cache configuration
 	sweepInterval int64
@@ -31,6 +34,9 @@ type translatorConfig struct {
 
 	// hostname provider configuration
 	fallbackHostnameProvider HostnameProvider
+
+	// onDroppedExemplars is called with the number of exemplars dropped from a data point
+	onDroppedExemplars func(count int)
 }
 
 // Option is a translator creation option.
@@ -85,6 +91,15 @@ func WithInstrumentationLibraryMetadataAsTags() Option {
 	}
 }
 
+// WithoutContainerTags leaves out the container and pod tags of the resource attributes,
+// such as container_id or pod_name, to reduce the cardinality of the metrics.
+func WithoutContainerTags() Option {
+	return func(t *translatorConfig) error {
+		t.ContainerTags = false
+		return nil
+	}
+}
+
 // HistogramMode is an export mode for OTLP Histogram metrics.
 type HistogramMode string
 
@@ -149,3 +164,27 @@ func WithNumberMode(mode NumberMode) Option {
 		return nil
 	}
 }
+
+// WithExemplarTags adds the trace and span IDs of up to maxTags exemplars
+// of the histogram data points as tags of the distribution points, to
+// correlate them with the Datadog APM traces. The other exemplars are dropped.
+// By default, all the exemplars are dropped.
+func WithExemplarTags(maxTags int) Option {
+	return func(t *translatorConfig) error {
+		if maxTags <= 0 {
+			return fmt.Errorf("maximum number of exemplar tags must be positive: %d", maxTags)
+		}
+		t.ExemplarTags = true
+		t.MaxExemplarTags = maxTags
+		return nil
+	}
+}
+
+// WithDroppedExemplarsCallback sets a function called with the number of
+// exemplars dropped from each histogram data point.
+func WithDroppedExemplarsCallback(onDropped func(count int)) Option {
+	return func(t *translatorConfig) error {
+		t.onDroppedExemplars = onDropped
+		return nil
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/internal/instrumentationlibrary"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
 )
 
 const metricName string = "metric name"
@@ -46,6 +47,9 @@ func New(logger *zap.Logger, options ...Option) (*Translator, error) {
 		SendMonotonic:                        true,
 		ResourceAttributesAsTags:             false,
 		InstrumentationLibraryMetadataAsTags: false,
+		ContainerTags:                        true,
+		ExemplarTags:                         false,
+		MaxExemplarTags:                      0,
 		sweepInterval:                        1800,
 		deltaTTL:                             3600,
 		fallbackHostnameProvider:             &noHostProvider{},
@@ -173,6 +177,7 @@ func (t *Translator) getSketchBuckets(
 	pointDims metricsDimensions,
 	p pdata.HistogramDataPoint,
 	histInfo histogramInfo,
+	exemplarTags []string,
 	delta bool,
 ) {
 	startTs := uint64(p.StartTimestamp())
@@ -215,7 +220,13 @@ func (t *Translator) getSketchBuckets(
 			sketch.Basic.Sum = histInfo.sum
 			sketch.Basic.Avg = sketch.Basic.Sum / float64(sketch.Basic.Cnt)
 		}
-		consumer.ConsumeSketch(ctx, pointDims.name, ts, sketch, pointDims.tags, pointDims.host)
+		// The exemplar tags are not part of the dimensions keying the previous points in the cache,
+		// as they change from one point to the next.
+		tags := pointDims.tags
+		if len(exemplarTags) > 0 {
+			tags = append(exemplarTags, pointDims.tags...)
+		}
+		consumer.ConsumeSketch(ctx, pointDims.name, ts, sketch, tags, pointDims.host)
 	}
 }
 
@@ -247,6 +258,36 @@ func (t *Translator) getLegacyBuckets(
 	}
 }
 
+// getExemplarTags returns the trace_id and span_id tags of the first exemplars
+// with a trace ID, up to MaxExemplarTags, when ExemplarTags is set. The IDs are the
+// 64-bit decimal ones used by Datadog APM. The other exemplars are dropped, as
+// well as all of them when the histograms are not exported as distributions.
+func (t *Translator) getExemplarTags(exemplars pdata.ExemplarSlice) []string {
+	exported := t.cfg.ExemplarTags && t.cfg.HistMode == HistogramModeDistributions
+
+	var tags []string
+	dropped := 0
+	tagged := 0
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		if !exported || tagged >= t.cfg.MaxExemplarTags || exemplar.TraceID().IsEmpty() {
+			dropped++
+			continue
+		}
+
+		tags = append(tags, fmt.Sprintf("trace_id:%d", idutils.TraceIDToLowUInt64(exemplar.TraceID())))
+		if !exemplar.SpanID().IsEmpty() {
+			tags = append(tags, fmt.Sprintf("span_id:%d", idutils.SpanIDToUInt64(exemplar.SpanID())))
+		}
+		tagged++
+	}
+
+	if dropped > 0 && t.cfg.onDroppedExemplars != nil {
+		t.cfg.onDroppedExemplars(dropped)
+	}
+	return tags
+}
+
 // mapHistogramMetrics maps double histogram metrics slices to Datadog metrics
 //
 // A Histogram metric has:
@@ -303,11 +344,14 @@ func (t *Translator) mapHistogramMetrics(
 			consumer.ConsumeTimeSeries(ctx, sumDims.name, Count, ts, histInfo.sum, sumDims.tags, sumDims.host)
 		}
 
+		// The exemplars are only exported as tags of the distribution points.
+		exemplarTags := t.getExemplarTags(p.Exemplars())
+
 		switch t.cfg.HistMode {
 		case HistogramModeCounters:
 			t.getLegacyBuckets(ctx, consumer, pointDims, p, delta)
 		case HistogramModeDistributions:
-			t.getSketchBuckets(ctx, consumer, pointDims, p, histInfo, delta)
+			t.getSketchBuckets(ctx, consumer, pointDims, p, histInfo, exemplarTags, delta)
 		}
 	}
 }
@@ -394,7 +438,12 @@ func (t *Translator) MapMetrics(ctx context.Context, md pdata.Metrics, consumer
 		rm := rms.At(i)
 
 		// Fetch tags from attributes.
-		attributeTags := attributes.TagsFromAttributes(rm.Resource().Attributes())
+		var attributeTags []string
+		if t.cfg.ContainerTags {
+			attributeTags = attributes.TagsFromAttributes(rm.Resource().Attributes())
+		} else {
+			attributeTags = attributes.TagsFromAttributesWithoutContainerTags(rm.Resource().Attributes())
+		}
 
 		host, ok := attributes.HostnameFromAttributes(rm.Resource().Attributes())
 		if !ok {
@@ -783,6 +783,113 @@ func TestMapCumulativeHistogramMetrics(t *testing.T) {
 	}
 }
 
+func TestMapHistogramExemplars(t *testing.T) {
+	newSlice := func(ts pdata.Timestamp, traceIDs ...byte) pdata.HistogramDataPointSlice {
+		slice := pdata.NewHistogramDataPointSlice()
+		point := slice.AppendEmpty()
+		point.SetCount(20)
+		point.SetSum(math.Pi)
+		point.SetBucketCounts([]uint64{2, 18})
+		point.SetExplicitBounds([]float64{0})
+		point.SetTimestamp(ts)
+		for _, traceID := range traceIDs {
+			exemplar := point.Exemplars().AppendEmpty()
+			exemplar.SetDoubleVal(1)
+			if traceID != 0 {
+				exemplar.SetTraceID(pdata.NewTraceID([16]byte{15: traceID}))
+				exemplar.SetSpanID(pdata.NewSpanID([8]byte{7: traceID + 1}))
+			}
+		}
+		return slice
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name            string
+		histogramMode   HistogramMode
+		options         []Option
+		traceIDs        []byte
+		expectedTags    []string
+		expectedDropped int
+	}{
+		{
+			name:            "No exemplar tags by default",
+			histogramMode:   HistogramModeDistributions,
+			traceIDs:        []byte{1, 2},
+			expectedTags:    []string{},
+			expectedDropped: 2,
+		},
+		{
+			name:            "Exemplar tags",
+			histogramMode:   HistogramModeDistributions,
+			options:         []Option{WithExemplarTags(2)},
+			traceIDs:        []byte{1, 2},
+			expectedTags:    []string{"trace_id:1", "span_id:2", "trace_id:2", "span_id:3"},
+			expectedDropped: 0,
+		},
+		{
+			name:            "Exemplar tags up to the maximum, skipping the exemplars without trace ID",
+			histogramMode:   HistogramModeDistributions,
+			options:         []Option{WithExemplarTags(1)},
+			traceIDs:        []byte{0, 1, 2},
+			expectedTags:    []string{"trace_id:1", "span_id:2"},
+			expectedDropped: 2,
+		},
+		{
+			name:            "No exemplar tags on counters",
+			histogramMode:   HistogramModeCounters,
+			options:         []Option{WithExemplarTags(2)},
+			traceIDs:        []byte{1, 2},
+			expectedDropped: 2,
+		},
+	}
+
+	for _, testInstance := range tests {
+		t.Run(testInstance.name, func(t *testing.T) {
+			dropped := 0
+			options := append([]Option{
+				WithDroppedExemplarsCallback(func(count int) { dropped += count }),
+			}, testInstance.options...)
+			tr := newTranslator(t, zap.NewNop(), options...)
+			tr.cfg.HistMode = testInstance.histogramMode
+			consumer := &mockFullConsumer{}
+			dims := newDims("doubleHist.test")
+			tr.mapHistogramMetrics(ctx, consumer, dims, newSlice(seconds(0), testInstance.traceIDs...), true)
+			if testInstance.histogramMode == HistogramModeDistributions {
+				require.Len(t, consumer.sketches, 1)
+				assert.Equal(t, testInstance.expectedTags, consumer.sketches[0].tags)
+			} else {
+				assert.Empty(t, consumer.sketches)
+			}
+			assert.Equal(t, testInstance.expectedDropped, dropped)
+		})
+	}
+}
+
+func TestMapCumulativeHistogramExemplarsNotCached(t *testing.T) {
+	// Test that the exemplar tags don't prevent computing the delta of cumulative histograms.
+	ctx := context.Background()
+	tr := newTranslator(t, zap.NewNop(), WithExemplarTags(1))
+
+	slice := pdata.NewHistogramDataPointSlice()
+	for i, traceID := range []byte{1, 2} {
+		point := slice.AppendEmpty()
+		point.SetCount(20 * uint64(i+1))
+		point.SetSum(float64(i + 1))
+		point.SetBucketCounts([]uint64{2 * uint64(i+1), 18 * uint64(i+1)})
+		point.SetExplicitBounds([]float64{0})
+		point.SetTimestamp(seconds(2 * i))
+		exemplar := point.Exemplars().AppendEmpty()
+		exemplar.SetTraceID(pdata.NewTraceID([16]byte{15: traceID}))
+	}
+
+	consumer := &mockFullConsumer{}
+	tr.mapHistogramMetrics(ctx, consumer, newDims("doubleHist.test"), slice, false)
+	require.Len(t, consumer.sketches, 1)
+	assert.Equal(t, []string{"trace_id:2"}, consumer.sketches[0].tags)
+	assert.Equal(t, int64(20), consumer.sketches[0].basic.Cnt)
+}
+
 func TestLegacyBucketsTags(t *testing.T) {
 	// Test that passing the same tags slice doesn't reuse the slice.
 	ctx := context.Background()
@@ -1430,3 +1537,43 @@ func TestNaNMetrics(t *testing.T) {
 	// One metric type was unknown or unsupported
 	assert.Equal(t, observed.FilterMessage("Unsupported metric value").Len(), 7)
 }
+
+func TestMapMetricsWithoutContainerTags(t *testing.T) {
+	attrs := map[string]string{
+		conventions.AttributeDeploymentEnvironment: "prod",
+		conventions.AttributeContainerID:           "container-id",
+		conventions.AttributeK8SPodName:            "pod-name",
+		conventions.AttributeCloudProvider:         "aws",
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		options []Option
+		tags    []string
+	}{
+		{
+			name: "default",
+			tags: []string{"env:prod", "container_id:container-id", "pod_name:pod-name", "cloud_provider:aws"},
+		},
+		{
+			name:    "without container tags",
+			options: []Option{WithoutContainerTags()},
+			tags:    []string{"env:prod", "cloud_provider:aws"},
+		},
+	}
+
+	for _, testInstance := range tests {
+		t.Run(testInstance.name, func(t *testing.T) {
+			md := createTestMetrics(attrs, "", "")
+			tr := newTranslator(t, zap.NewNop(), testInstance.options...)
+			consumer := &mockFullConsumer{}
+			require.NoError(t, tr.MapMetrics(ctx, md, consumer))
+
+			require.NotEmpty(t, consumer.metrics)
+			for _, m := range consumer.metrics {
+				assert.ElementsMatch(t, testInstance.tags, m.tags, m.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tagset builds canonical sets of Datadog tags.
+package tagset // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagset"
+
+import (
+	"sort"
+	"strings"
+)
+
+// Canonicalize merges the given tag sets into a single deduplicated and sorted
+// slice of tags, so that the same input always yields the same payload.
+//
+// Sets are given in decreasing order of precedence: when a tag key appears in
+// several sets, only the values from the first set defining it are kept. A set
+// may hold several values for the same key. Blank tags are dropped.
+func Canonicalize(sets ...[]string) []string {
+	owner := make(map[string]int)
+	seen := make(map[string]struct{})
+	var out []string
+	for i, set := range sets {
+		for _, tag := range set {
+			tag = strings.TrimSpace(tag)
+			if tag == "" {
+				continue
+			}
+			key := tagKey(tag)
+			if j, ok := owner[key]; ok && j != i {
+				continue
+			}
+			owner[key] = i
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+			seen[tag] = struct{}{}
+			out = append(out, tag)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// tagKey returns the key of a tag in the key:value form, or the whole tag
+// when it has no value.
+func tagKey(tag string) string {
+	if i := strings.IndexByte(tag, ':'); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+// NormalizeKeys returns the tags keyed by their normalized key. When several keys
+// normalize to the same one, the key which is already normalized wins, then the
+// smallest key, so that the result does not depend on the iteration order.
+func NormalizeKeys(tags map[string]string, normalize func(string) string) map[string]string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	owner := make(map[string]string, len(keys))
+	out := make(map[string]string, len(keys))
+	for _, k := range keys {
+		nk := normalize(k)
+		if prev, ok := owner[nk]; ok && (prev == nk || k != nk) {
+			continue
+		}
+		owner[nk] = k
+		out[nk] = tags[k]
+	}
+	return out
+}
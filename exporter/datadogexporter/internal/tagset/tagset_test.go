@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		sets [][]string
+		want []string
+	}{
+		{
+			name: "empty",
+			want: nil,
+		},
+		{
+			name: "sorts and drops duplicates and blanks",
+			sets: [][]string{{"b:2", "a:1", "", "  ", "b:2", "a:1"}},
+			want: []string{"a:1", "b:2"},
+		},
+		{
+			name: "keeps multiple values from the same set",
+			sets: [][]string{{"team:a", "team:b"}},
+			want: []string{"team:a", "team:b"},
+		},
+		{
+			name: "higher precedence set wins on conflicts",
+			sets: [][]string{
+				{"env:prod"},
+				{"env:staging", "team:a"},
+				{"team:b", "region:eu"},
+			},
+			want: []string{"env:prod", "region:eu", "team:a"},
+		},
+		{
+			name: "tags without value",
+			sets: [][]string{{"canary"}, {"canary", "canary:true"}},
+			want: []string{"canary"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Canonicalize(tt.sets...))
+		})
+	}
+}
+
+func TestNormalizeKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		tags map[string]string
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: map[string]string{},
+			want: map[string]string{},
+		},
+		{
+			name: "normalizes keys",
+			tags: map[string]string{"Team": "a", "env": "prod"},
+			want: map[string]string{"team": "a", "env": "prod"},
+		},
+		{
+			name: "normalized key wins on conflicts",
+			tags: map[string]string{"Team": "a", "TEAM": "b", "team": "c"},
+			want: map[string]string{"team": "c"},
+		},
+		{
+			name: "smallest key wins otherwise",
+			tags: map[string]string{"Team": "a", "TEAM": "b"},
+			want: map[string]string{"team": "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeKeys(tt.tags, strings.ToLower))
+		})
+	}
+}
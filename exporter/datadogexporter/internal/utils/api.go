@@ -15,10 +15,68 @@
 package utils // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
 	"go.uber.org/zap"
 	"gopkg.in/zorkian/go-datadog-api.v2"
 )
 
+// ErrInvalidAPIKey is returned when the API key validation fails and the exporter
+// is configured to fail on an invalid API key.
+var ErrInvalidAPIKey = errors.New("API key validation failed")
+
+// States of the API key validation reported by the datadog_api_key_validation_state gauge.
+const (
+	// APIKeyStateUnknown is reported until Datadog could be reached to validate the API key.
+	APIKeyStateUnknown int64 = iota
+	// APIKeyStateValid is reported once the API key is validated.
+	APIKeyStateValid
+	// APIKeyStateInvalid is reported once the API key is rejected.
+	APIKeyStateInvalid
+)
+
+var mAPIKeyState = stats.Int64(
+	"datadog_api_key_validation_state",
+	"State of the Datadog API key validation: 0 while unknown, 1 when valid, 2 when invalid",
+	stats.UnitDimensionless,
+)
+
+// The interval between the background API key validation attempts, doubled after each
+// attempt up to validationMaxRetryInterval.
+var (
+	validationRetryInterval    = 5 * time.Second
+	validationMaxRetryInterval = 5 * time.Minute
+)
+
+// MetricViews returns the views of the metrics reported about the exporter.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        mAPIKeyState.Name(),
+			Measure:     mAPIKeyState,
+			Description: mAPIKeyState.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mCircuitBreakerState.Name(),
+			Measure:     mCircuitBreakerState,
+			Description: mCircuitBreakerState.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mDroppedExemplars.Name(),
+			Measure:     mDroppedExemplars,
+			Description: mDroppedExemplars.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
+
 // CreateClient creates a new Datadog client
 func CreateClient(APIKey string, endpoint string) *datadog.Client {
 	client := datadog.NewClient(APIKey, "")
@@ -28,16 +86,65 @@ func CreateClient(APIKey string, endpoint string) *datadog.Client {
 }
 
 // ValidateAPIKey checks that the provided client was given a correct API key.
-func ValidateAPIKey(logger *zap.Logger, client *datadog.Client) {
+// When failOnInvalid is set, the API key is validated before returning and ErrInvalidAPIKey
+// is returned if it is rejected. Otherwise, or if Datadog can't be reached, the API key is
+// validated in the background, retrying until Datadog answers or ctx is done, so that the
+// exporter starts even when Datadog is unreachable.
+func ValidateAPIKey(ctx context.Context, logger *zap.Logger, client *datadog.Client, failOnInvalid bool) error {
+	stats.Record(ctx, mAPIKeyState.M(APIKeyStateUnknown))
+
+	if failOnInvalid {
+		valid, err := validateAPIKey(ctx, logger, client)
+		if err == nil {
+			if !valid {
+				return ErrInvalidAPIKey
+			}
+			return nil
+		}
+	}
+
+	go validateAPIKeyWithRetries(ctx, logger, client)
+	return nil
+}
+
+// validateAPIKeyWithRetries validates the API key until Datadog answers or ctx is done.
+func validateAPIKeyWithRetries(ctx context.Context, logger *zap.Logger, client *datadog.Client) {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = validationRetryInterval
+	expBackoff.MaxInterval = validationMaxRetryInterval
+	expBackoff.MaxElapsedTime = 0
+	expBackoff.Reset()
+
+	for {
+		if _, err := validateAPIKey(ctx, logger, client); err == nil {
+			return
+		}
+
+		interval := expBackoff.NextBackOff()
+		logger.Info("Will retry API key validation after interval.", zap.Duration("interval", interval))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// validateAPIKey validates the API key once and records the validation state.
+func validateAPIKey(ctx context.Context, logger *zap.Logger, client *datadog.Client) (bool, error) {
 	logger.Info("Validating API key.")
 	res, err := client.Validate()
 	if err != nil {
 		logger.Warn("Error while validating API key.", zap.Error(err))
+		return false, err
 	}
 
 	if res {
 		logger.Info("API key validation successful.")
+		stats.Record(ctx, mAPIKeyState.M(APIKeyStateValid))
 	} else {
 		logger.Warn("API key validation failed.")
+		stats.Record(ctx, mAPIKeyState.M(APIKeyStateInvalid))
 	}
+	return res, nil
 }
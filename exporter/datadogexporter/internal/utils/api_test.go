@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+)
+
+// newValidateServer returns a server answering the API key validation requests with
+// a 404 to the first failures requests and with the given validity afterwards.
+func newValidateServer(valid bool, failures int32) *httptest.Server {
+	var calls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if valid {
+			_, _ = w.Write([]byte(`{"valid": true}`))
+		} else {
+			_, _ = w.Write([]byte(`{"valid": false}`))
+		}
+	}))
+}
+
+func apiKeyState(t *testing.T) int64 {
+	rows, err := view.RetrieveData(mAPIKeyState.Name())
+	require.NoError(t, err)
+	if len(rows) == 0 {
+		return APIKeyStateUnknown
+	}
+	return int64(rows[0].Data.(*view.LastValueData).Value)
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	require.NoError(t, view.Register(MetricViews()...))
+	defer view.Unregister(MetricViews()...)
+
+	retryInterval := validationRetryInterval
+	validationRetryInterval = 10 * time.Millisecond
+	defer func() { validationRetryInterval = retryInterval }()
+
+	tests := []struct {
+		name          string
+		valid         bool
+		failures      int32
+		failOnInvalid bool
+		expectedErr   error
+		expectedState int64
+	}{
+		{
+			name:          "valid key",
+			valid:         true,
+			failOnInvalid: true,
+			expectedState: APIKeyStateValid,
+		},
+		{
+			name:          "invalid key",
+			failOnInvalid: true,
+			expectedErr:   ErrInvalidAPIKey,
+			expectedState: APIKeyStateInvalid,
+		},
+		{
+			name:          "invalid key without failing",
+			expectedState: APIKeyStateInvalid,
+		},
+		{
+			name:          "unreachable then valid",
+			valid:         true,
+			failures:      3,
+			expectedState: APIKeyStateValid,
+		},
+		{
+			name:          "unreachable then invalid when failing on invalid key",
+			failures:      3,
+			failOnInvalid: true,
+			expectedState: APIKeyStateInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newValidateServer(tt.valid, tt.failures)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			err := ValidateAPIKey(ctx, zap.NewNop(), CreateClient("apikey", server.URL), tt.failOnInvalid)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Eventually(t, func() bool {
+				return apiKeyState(t) == tt.expectedState
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
+)
+
+// States of the circuit breaker reported by the datadog_traces_circuit_breaker_state gauge.
+const (
+	// CircuitBreakerClosed is reported while payloads are sent.
+	CircuitBreakerClosed int64 = iota
+	// CircuitBreakerOpen is reported while payloads are dropped.
+	CircuitBreakerOpen
+	// CircuitBreakerHalfOpen is reported while a single payload probes the intake.
+	CircuitBreakerHalfOpen
+)
+
+var mCircuitBreakerState = stats.Int64(
+	"datadog_traces_circuit_breaker_state",
+	"State of the traces circuit breaker: 0 when closed, 1 when open, 2 when half-open",
+	stats.UnitDimensionless,
+)
+
+// CircuitBreaker stops sending payloads to an intake which keeps rejecting them
+// with 4xx responses, e.g. because of an invalid API key or an exceeded quota.
+// Other failures, such as 5xx responses, don't open the circuit.
+type CircuitBreaker struct {
+	cfg    config.CircuitBreakerConfig
+	logger *zap.Logger
+	// For easier unit testing
+	now func() time.Time
+
+	mu        sync.Mutex
+	state     int64
+	failures  int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker creates a closed circuit breaker.
+func NewCircuitBreaker(logger *zap.Logger, cfg config.CircuitBreakerConfig) *CircuitBreaker {
+	cb := &CircuitBreaker{
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+	}
+	if cfg.Enabled {
+		stats.Record(context.Background(), mCircuitBreakerState.M(CircuitBreakerClosed))
+	}
+	return cb
+}
+
+// Allow reports whether a payload can be sent. Once the open duration has
+// elapsed, a single payload is allowed to probe the intake, and its result
+// closes or opens the circuit again.
+func (cb *CircuitBreaker) Allow() bool {
+	if !cb.cfg.Enabled {
+		return true
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitBreakerClosed:
+		return true
+	case CircuitBreakerOpen:
+		if cb.now().Before(cb.openUntil) {
+			return false
+		}
+		cb.setState(CircuitBreakerHalfOpen)
+		return true
+	default:
+		// A probe is already in flight.
+		return false
+	}
+}
+
+// Record records the result of sending an allowed payload.
+func (cb *CircuitBreaker) Record(err error) {
+	if !cb.cfg.Enabled {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch {
+	case err == nil:
+		cb.failures = 0
+		if cb.state != CircuitBreakerClosed {
+			cb.logger.Info("Datadog intake accepted traces again, closing the circuit breaker.")
+			cb.setState(CircuitBreakerClosed)
+		}
+	case IsClientError(err):
+		cb.failures++
+		if cb.state == CircuitBreakerHalfOpen || cb.failures >= cb.cfg.FailureThreshold {
+			cb.openUntil = cb.now().Add(cb.cfg.OpenDuration)
+			if cb.state != CircuitBreakerOpen {
+				cb.logger.Warn("Datadog intake keeps rejecting traces, opening the circuit breaker. Traces are dropped until it closes.",
+					zap.Error(err), zap.Duration("open_duration", cb.cfg.OpenDuration))
+			}
+			cb.setState(CircuitBreakerOpen)
+		}
+	case cb.state == CircuitBreakerHalfOpen:
+		// The probe failed for another reason than a rejection: keep the
+		// failure count so that the next rejection opens the circuit again.
+		cb.setState(CircuitBreakerClosed)
+	}
+}
+
+func (cb *CircuitBreaker) setState(state int64) {
+	cb.state = state
+	stats.Record(context.Background(), mCircuitBreakerState.M(state))
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	cb := NewCircuitBreaker(zap.NewNop(), config.CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+	})
+	cb.now = func() time.Time { return now }
+
+	forbidden := &StatusError{URL: "http://localhost", Status: "403 Forbidden", StatusCode: http.StatusForbidden}
+	unavailable := &StatusError{URL: "http://localhost", Status: "503 Service Unavailable", StatusCode: http.StatusServiceUnavailable}
+
+	// Server errors and successes don't open the circuit.
+	assert.True(t, cb.Allow())
+	cb.Record(forbidden)
+	cb.Record(unavailable)
+	cb.Record(errors.New("connection refused"))
+	assert.True(t, cb.Allow())
+	cb.Record(nil)
+	cb.Record(forbidden)
+	assert.True(t, cb.Allow())
+
+	// Consecutive rejections open the circuit.
+	cb.Record(forbidden)
+	assert.Equal(t, CircuitBreakerOpen, cb.state)
+	assert.False(t, cb.Allow())
+
+	// A single probe is allowed once the open duration elapsed, and a rejected probe opens it again.
+	now = now.Add(time.Minute)
+	assert.True(t, cb.Allow())
+	assert.False(t, cb.Allow())
+	cb.Record(forbidden)
+	assert.False(t, cb.Allow())
+
+	// An accepted probe closes the circuit.
+	now = now.Add(time.Minute)
+	assert.True(t, cb.Allow())
+	cb.Record(nil)
+	assert.Equal(t, CircuitBreakerClosed, cb.state)
+	assert.True(t, cb.Allow())
+	assert.True(t, cb.Allow())
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	cb := NewCircuitBreaker(zap.NewNop(), config.CircuitBreakerConfig{})
+	for i := 0; i < 10; i++ {
+		cb.Record(&StatusError{StatusCode: http.StatusForbidden})
+	}
+	assert.True(t, cb.Allow())
+}
+
+func TestIsClientError(t *testing.T) {
+	assert.True(t, IsClientError(&StatusError{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsClientError(fmt.Errorf("failed: %w", &StatusError{StatusCode: http.StatusForbidden})))
+	assert.False(t, IsClientError(&StatusError{StatusCode: http.StatusBadGateway}))
+	assert.False(t, IsClientError(errors.New("connection refused")))
+	assert.False(t, IsClientError(nil))
+}
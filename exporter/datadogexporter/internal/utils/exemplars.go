@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+)
+
+var mDroppedExemplars = stats.Int64(
+	"datadog_dropped_exemplars",
+	"Number of exemplars of the histogram data points not exported as tags of the distribution points",
+	stats.UnitDimensionless,
+)
+
+// RecordDroppedExemplars counts the exemplars dropped from a histogram data point.
+func RecordDroppedExemplars(count int) {
+	stats.Record(context.Background(), mDroppedExemplars.M(int64(count)))
+}
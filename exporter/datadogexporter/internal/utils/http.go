@@ -16,6 +16,7 @@ package utils // import "github.com/open-telemetry/opentelemetry-collector-contr
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -74,3 +75,21 @@ func SetDDHeaders(reqHeader http.Header, buildInfo component.BuildInfo, apiKey s
 	reqHeader.Set("DD-Api-Key", apiKey)
 	reqHeader.Set("User-Agent", UserAgent(buildInfo))
 }
+
+// StatusError is returned when an intake responds with a non 2xx status code.
+type StatusError struct {
+	URL        string
+	Status     string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("request to %s responded with %s", e.URL, e.Status)
+}
+
+// IsClientError reports whether err was caused by a 4xx response, which
+// retrying the same request won't fix.
+func IsClientError(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode/100 == 4
+}
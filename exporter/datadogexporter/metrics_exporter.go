@@ -44,6 +44,7 @@ type metricsExporter struct {
 	tr       *translator.Translator
 	scrubber scrub.Scrubber
 	retrier  *utils.Retrier
+	running  *metrics.RunningMetrics
 }
 
 // assert `hostProvider` implements HostnameProvider interface
@@ -81,8 +82,17 @@ func translatorFromConfig(logger *zap.Logger, cfg *config.Config) (*translator.T
 		options = append(options, translator.WithInstrumentationLibraryMetadataAsTags())
 	}
 
+	if !cfg.Metrics.ExporterConfig.ContainerTags {
+		options = append(options, translator.WithoutContainerTags())
+	}
+
 	options = append(options, translator.WithHistogramMode(translator.HistogramMode(cfg.Metrics.HistConfig.Mode)))
 
+	if cfg.Metrics.HistConfig.Exemplars.Mode == "tags" {
+		options = append(options, translator.WithExemplarTags(cfg.Metrics.HistConfig.Exemplars.MaxTags))
+	}
+	options = append(options, translator.WithDroppedExemplarsCallback(utils.RecordDroppedExemplars))
+
 	var numberMode translator.NumberMode
 	if cfg.Metrics.SendMonotonic {
 		numberMode = translator.NumberModeCumulativeToDelta
@@ -99,7 +109,9 @@ func newMetricsExporter(ctx context.Context, params component.ExporterCreateSett
 	client.ExtraHeader["User-Agent"] = utils.UserAgent(params.BuildInfo)
 	client.HttpClient = utils.NewHTTPClient(cfg.TimeoutSettings, cfg.LimitedHTTPClientSettings)
 
-	utils.ValidateAPIKey(params.Logger, client)
+	if err := utils.ValidateAPIKey(ctx, params.Logger, client, cfg.API.FailOnInvalidKey); err != nil {
+		return nil, err
+	}
 
 	tr, err := translatorFromConfig(params.Logger, cfg)
 	if err != nil {
@@ -115,6 +127,7 @@ func newMetricsExporter(ctx context.Context, params component.ExporterCreateSett
 		tr:       tr,
 		scrubber: scrubber,
 		retrier:  utils.NewRetrier(params.Logger, cfg.RetrySettings, scrubber),
+		running:  metrics.NewRunningMetrics("metrics", cfg.RunningMetricsInterval, params.BuildInfo),
 	}, nil
 }
 
@@ -173,7 +186,7 @@ func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pdata.Metric
 	if err != nil {
 		return fmt.Errorf("failed to map metrics: %w", err)
 	}
-	ms, sl := consumer.All(pushTime, exp.params.BuildInfo)
+	ms, sl := consumer.All(pushTime, exp.running)
 	metrics.ProcessMetrics(ms, exp.cfg)
 
 	err = nil
@@ -169,7 +169,7 @@ func (con *traceEdgeConnectionImpl) sendPayloadToTraceEdge(ctx context.Context,
 	// We check the status code to see if the request has succeeded.
 	// TODO: define all legit status code and behave accordingly.
 	if resp.StatusCode/100 != 2 {
-		err := fmt.Errorf("request to %s responded with %s", url, resp.Status)
+		err := &utils.StatusError{URL: url, Status: resp.Status, StatusCode: resp.StatusCode}
 		if resp.StatusCode/100 == 5 {
 			// 5xx errors are retriable
 			return true, err
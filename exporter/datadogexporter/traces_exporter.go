@@ -22,6 +22,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/obfuscate"
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/pb"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumerhelper"
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/zap"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
 )
@@ -42,6 +44,9 @@ type traceExporter struct {
 	client         *datadog.Client
 	denylister     *denylister
 	scrubber       scrub.Scrubber
+	retrier        *utils.Retrier
+	breaker        *utils.CircuitBreaker
+	running        *metrics.RunningMetrics
 }
 
 var (
@@ -62,10 +67,12 @@ var (
 	}
 )
 
-func newTracesExporter(ctx context.Context, params component.ExporterCreateSettings, cfg *config.Config) *traceExporter {
+func newTracesExporter(ctx context.Context, params component.ExporterCreateSettings, cfg *config.Config) (*traceExporter, error) {
 	// client to send running metric to the backend & perform API key validation
 	client := utils.CreateClient(cfg.API.Key, cfg.Metrics.TCPAddr.Endpoint)
-	utils.ValidateAPIKey(params.Logger, client)
+	if err := utils.ValidateAPIKey(ctx, params.Logger, client, cfg.API.FailOnInvalidKey); err != nil {
+		return nil, err
+	}
 
 	// removes potentially sensitive info and PII, approach taken from serverless approach
 	// https://github.com/DataDog/datadog-serverless-functions/blob/11f170eac105d66be30f18eda09eca791bc0d31b/aws/logs_monitoring/trace_forwarder/cmd/trace/main.go#L43
@@ -83,9 +90,12 @@ func newTracesExporter(ctx context.Context, params component.ExporterCreateSetti
 		client:         client,
 		denylister:     denylister,
 		scrubber:       scrub.NewScrubber(),
+		retrier:        utils.NewRetrier(params.Logger, cfg.Traces.RetrySettings, scrub.NewScrubber()),
+		breaker:        utils.NewCircuitBreaker(params.Logger, cfg.Traces.CircuitBreaker),
+		running:        metrics.NewRunningMetrics("traces", cfg.RunningMetricsInterval, params.BuildInfo),
 	}
 
-	return exporter
+	return exporter, nil
 }
 
 // TODO: when component.Host exposes a way to retrieve processors, check for batch processors
@@ -128,7 +138,7 @@ func (exp *traceExporter) pushTraceData(
 	// we largely apply the same logic as the serverless implementation, simplified a bit
 	// https://github.com/DataDog/datadog-serverless-functions/blob/f5c3aedfec5ba223b11b76a4239fcbf35ec7d045/aws/logs_monitoring/trace_forwarder/cmd/trace/main.go#L61-L83
 	fallbackHost := metadata.GetHost(exp.params.Logger, exp.cfg)
-	ddTraces, ms := convertToDatadogTd(td, fallbackHost, exp.cfg, exp.denylister, exp.params.BuildInfo)
+	ddTraces, ms := convertToDatadogTd(td, fallbackHost, exp.cfg, exp.denylister, exp.running)
 
 	// group the traces by env to reduce the number of flushes
 	aggregatedTraces := aggregateTracePayloadsByEnv(ddTraces)
@@ -139,33 +149,53 @@ func (exp *traceExporter) pushTraceData(
 
 	pushTime := time.Now().UTC().UnixNano()
 	for _, ddTracePayload := range aggregatedTraces {
-		// currently we don't want to do retries since api endpoints may not dedupe in certain situations
-		// adding a helper function here to make custom retry logic easier in the future
-		exp.pushWithRetry(ctx, ddTracePayload, 1, pushTime, func() error {
-			return nil
-		})
+		exp.pushWithRetry(ctx, ddTracePayload, pushTime)
 	}
 
-	_ = exp.client.PostMetrics(ms)
+	if len(ms) > 0 {
+		_ = exp.client.PostMetrics(ms)
+	}
 
 	return nil
 }
 
-// gives us flexibility to add custom retry logic later
-func (exp *traceExporter) pushWithRetry(ctx context.Context, ddTracePayload *pb.TracePayload, maxRetries int, pushTime int64, fn func() error) error {
-	err := exp.edgeConnection.SendTraces(ctx, ddTracePayload, maxRetries)
+// pushWithRetry sends a trace payload and its stats, retrying according to the traces retry settings.
+// Retries are disabled by default since api endpoints may not dedupe in certain situations.
+// Nothing is sent while the circuit breaker is open.
+func (exp *traceExporter) pushWithRetry(ctx context.Context, ddTracePayload *pb.TracePayload, pushTime int64) {
+	if !exp.breaker.Allow() {
+		exp.params.Logger.Debug("circuit breaker is open, dropping traces")
+		return
+	}
+	err := exp.retrier.DoWithRetries(ctx, func(ctx context.Context) error {
+		return exp.recordSendResult(exp.edgeConnection.SendTraces(ctx, ddTracePayload, 1))
+	})
 
 	if err != nil {
 		exp.params.Logger.Info("failed to send traces", zap.Error(err))
 	}
 
+	if !exp.breaker.Allow() {
+		return
+	}
+
 	// this is for generating metrics like hits, errors, and latency, it uses a separate endpoint than Traces
 	stats := computeAPMStats(ddTracePayload, pushTime)
-	errStats := exp.edgeConnection.SendStats(context.Background(), stats, maxRetries)
+	errStats := exp.retrier.DoWithRetries(context.Background(), func(ctx context.Context) error {
+		return exp.recordSendResult(exp.edgeConnection.SendStats(ctx, stats, 1))
+	})
 
 	if errStats != nil {
 		exp.params.Logger.Info("failed to send trace stats", zap.Error(errStats))
 	}
+}
 
-	return fn()
+// recordSendResult records the result of a request in the circuit breaker, and marks
+// rejected requests as permanent errors so that they are not retried.
+func (exp *traceExporter) recordSendResult(err error) error {
+	exp.breaker.Record(err)
+	if utils.IsClientError(err) {
+		return consumererror.NewPermanent(err)
+	}
+	return err
 }
@@ -21,7 +21,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/pb"
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/stats"
@@ -31,6 +33,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	otelconfig "go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/model/pdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
@@ -163,7 +166,8 @@ func TestNewTracesExporter(t *testing.T) {
 	params := componenttest.NewNopExporterCreateSettings()
 
 	// The client should have been created correctly
-	exp := newTracesExporter(context.Background(), params, cfg)
+	exp, err := newTracesExporter(context.Background(), params, cfg)
+	assert.NoError(t, err)
 	assert.NotNil(t, exp)
 }
 
@@ -191,9 +195,10 @@ func TestPushTraceData(t *testing.T) {
 	}
 
 	params := componenttest.NewNopExporterCreateSettings()
-	exp := newTracesExporter(context.Background(), params, cfg)
+	exp, err := newTracesExporter(context.Background(), params, cfg)
+	require.NoError(t, err)
 
-	err := exp.pushTraceData(context.Background(), testutils.TestTraces.Clone())
+	err = exp.pushTraceData(context.Background(), testutils.TestTraces.Clone())
 	assert.NoError(t, err)
 
 	body := <-server.MetadataChan
@@ -214,6 +219,57 @@ func TestTraceAndStatsExporter(t *testing.T) {
 	assert.Equal(t, "application/x-protobuf", got[0])
 }
 
+func TestTracesCircuitBreaker(t *testing.T) {
+	metricsServer := testutils.DatadogServerMock()
+	defer metricsServer.Close()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		rw.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		API: config.APIConfig{
+			Key: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		},
+		TagsConfig: config.TagsConfig{
+			Hostname: "test-host",
+			Env:      "test_env",
+		},
+		Metrics: config.MetricsConfig{
+			TCPAddr: confignet.TCPAddr{Endpoint: metricsServer.URL},
+		},
+		Traces: config.TracesConfig{
+			SampleRate: 1,
+			TCPAddr:    confignet.TCPAddr{Endpoint: server.URL},
+			RetrySettings: exporterhelper.RetrySettings{
+				Enabled:         true,
+				InitialInterval: time.Millisecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Second,
+			},
+			CircuitBreaker: config.CircuitBreakerConfig{
+				Enabled:          true,
+				FailureThreshold: 2,
+				OpenDuration:     time.Hour,
+			},
+		},
+	}
+
+	exp, err := newTracesExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, exp.pushTraceData(context.Background(), simpleTraces()))
+	}
+
+	// The rejected trace and stats payloads are not retried, and open the circuit
+	// so that the following payloads are dropped.
+	assert.EqualValues(t, 2, atomic.LoadInt32(&requests))
+}
+
 func simpleTraces() pdata.Traces {
 	return simpleTracesWithID(pdata.NewTraceID([16]byte{1, 2, 3, 4}))
 }
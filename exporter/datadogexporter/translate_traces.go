@@ -15,7 +15,6 @@
 package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
 
 import (
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -23,7 +22,6 @@ import (
 
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/pb"
 	"github.com/DataDog/datadog-agent/pkg/trace/exportable/traceutil"
-	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/ext"
@@ -32,7 +30,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/tagset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
 )
 
@@ -63,6 +63,9 @@ const (
 	// pairs representing information about the container (Docker, EC2, etc).
 	tagContainersTags = "_dd.tags.container"
 	tagDatadogEnv     = "env"
+	// tagOrigin and tagSource hold the ingestion origin and source of the span.
+	tagOrigin = "_dd.origin"
+	tagSource = "source"
 )
 
 // AttributeExceptionEventName the name of the exception event.
@@ -70,7 +73,7 @@ const (
 const AttributeExceptionEventName = "exception"
 
 // converts Traces into an array of datadog trace payloads grouped by env
-func convertToDatadogTd(td pdata.Traces, fallbackHost string, cfg *config.Config, blk *denylister, buildInfo component.BuildInfo) ([]*pb.TracePayload, []datadog.Metric) {
+func convertToDatadogTd(td pdata.Traces, fallbackHost string, cfg *config.Config, blk *denylister, running *metrics.RunningMetrics) ([]*pb.TracePayload, []datadog.Metric) {
 	// TODO:
 	// do we apply other global tags, like version+service, to every span or only root spans of a service
 	// should globalTags['service'] take precedence over a trace's resource.service.name? I don't believe so, need to confirm
@@ -80,8 +83,7 @@ func convertToDatadogTd(td pdata.Traces, fallbackHost string, cfg *config.Config
 	var traces []*pb.TracePayload
 
 	seenHosts := make(map[string]struct{})
-	seenTags := make(map[string]struct{})
-	var series []datadog.Metric
+	var seenTags []string
 	pushTime := pdata.NewTimestampFromTime(time.Now())
 
 	spanNameMap := cfg.Traces.SpanNameRemappings
@@ -96,29 +98,18 @@ func convertToDatadogTd(td pdata.Traces, fallbackHost string, cfg *config.Config
 		if host != "" {
 			seenHosts[host] = struct{}{}
 		} else {
-			tags := attributes.RunningTagsFromAttributes(rs.Resource().Attributes())
-			for _, tag := range tags {
-				seenTags[tag] = struct{}{}
-			}
+			seenTags = append(seenTags, attributes.RunningTagsFromAttributes(rs.Resource().Attributes())...)
 		}
 		payload := resourceSpansToDatadogSpans(rs, host, cfg, blk, spanNameMap)
 
 		traces = append(traces, &payload)
 	}
 
+	hosts := make([]string, 0, len(seenHosts))
 	for host := range seenHosts {
-		// Report the host as running
-		runningMetric := metrics.DefaultMetrics("traces", host, uint64(pushTime), buildInfo)
-		series = append(series, runningMetric...)
-	}
-
-	for tag := range seenTags {
-		runningMetrics := metrics.DefaultMetrics("traces", "", uint64(pushTime), buildInfo)
-		for i := range runningMetrics {
-			runningMetrics[i].Tags = append(runningMetrics[i].Tags, tag)
-		}
-		series = append(series, runningMetrics...)
+		hosts = append(hosts, host)
 	}
+	series := running.Series(uint64(pushTime), hosts, seenTags)
 
 	return traces, series
 }
@@ -176,6 +167,8 @@ func resourceSpansToDatadogSpans(rs pdata.ResourceSpans, hostname string, cfg *c
 		payload.Env = utils.NormalizeTag(resourceEnv)
 	}
 
+	origin, source := cfg.Traces.Ingestion.OriginAndSource(datadogTags)
+
 	apiTraces := map[uint64]*pb.APITrace{}
 
 	for i := 0; i < ilss.Len(); i++ {
@@ -184,6 +177,7 @@ func resourceSpansToDatadogSpans(rs pdata.ResourceSpans, hostname string, cfg *c
 		spans := ils.Spans()
 		for j := 0; j < spans.Len(); j++ {
 			span := spanToDatadogSpan(spans.At(j), resourceServiceName, datadogTags, cfg, spanNameMap)
+			setIngestionTags(span, origin, source)
 			var apiTrace *pb.APITrace
 			var ok bool
 
@@ -335,6 +329,17 @@ func spanToDatadogSpan(s pdata.Span,
 	return span
 }
 
+// setIngestionTags sets the configured origin and source on the span, unless
+// they were already set by the instrumentation, e.g. for synthetics tests.
+func setIngestionTags(span *pb.Span, origin, source string) {
+	if _, ok := span.Meta[tagOrigin]; !ok && origin != "" {
+		setStringTag(span, tagOrigin, origin)
+	}
+	if _, ok := span.Meta[tagSource]; !ok && source != "" {
+		setStringTag(span, tagSource, source)
+	}
+}
+
 func resourceToDatadogServiceNameAndAttributeMap(
 	resource pdata.Resource,
 ) (serviceName string, datadogTags map[string]string) {
@@ -391,28 +396,36 @@ func aggregateSpanTags(span pdata.Span, datadogTags map[string]string) map[strin
 	// there may be overlap between the two.
 	spanTags := make(map[string]string, span.Attributes().Len()+len(datadogTags))
 
-	for key, val := range datadogTags {
-		spanTags[utils.NormalizeTag(key)] = val
+	// keys are normalized deterministically so that conflicting keys always
+	// yield the same tags, span attributes taking precedence over the resource ones.
+	for key, val := range tagset.NormalizeKeys(datadogTags, utils.NormalizeTag) {
+		spanTags[key] = val
 	}
 
+	attrs := make(map[string]string, span.Attributes().Len())
 	span.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
-		switch k {
-		case keySamplingPriority:
-			spanTags[k] = v.AsString()
-		case keySamplingRate:
-			spanTags[k] = v.AsString()
-		default:
-			spanTags[utils.NormalizeTag(k)] = v.AsString()
-		}
-
+		attrs[k] = v.AsString()
 		return true
 	})
+	for key, val := range tagset.NormalizeKeys(attrs, normalizeSpanTagKey) {
+		spanTags[key] = val
+	}
 
 	// we don't want to normalize these tags since `_dd` is a special case
 	spanTags[tagContainersTags] = attributes.ContainerTagFromAttributes(spanTags)
 	return spanTags
 }
 
+// normalizeSpanTagKey normalizes the key of a span attribute, except for the
+// sampling and origin keys which must keep their `_` prefix.
+func normalizeSpanTagKey(k string) string {
+	switch k {
+	case keySamplingPriority, keySamplingRate, tagOrigin:
+		return k
+	}
+	return utils.NormalizeTag(k)
+}
+
 // inferDatadogTypes returns a string for the datadog type based on metadata
 // in the otel span. DB semantic conventions state that what datadog
 // would mark as a db or cache span type, otel marks as a CLIENT span kind, but
@@ -489,22 +502,12 @@ func addToAPITrace(apiTrace *pb.APITrace, sp *pb.Span) {
 }
 
 func decodeAPMSpanID(rawID [8]byte) uint64 {
-	return decodeAPMId(hex.EncodeToString(rawID[:]))
+	return idutils.SpanIDToUInt64(pdata.NewSpanID(rawID))
 }
 
+// decodeAPMTraceID converts the trace id to the 64-bit one used by Datadog, keeping its low 64 bits.
 func decodeAPMTraceID(rawID [16]byte) uint64 {
-	return decodeAPMId(hex.EncodeToString(rawID[:]))
-}
-
-func decodeAPMId(id string) uint64 {
-	if len(id) > 16 {
-		id = id[len(id)-16:]
-	}
-	val, err := strconv.ParseUint(id, 16, 64)
-	if err != nil {
-		return 0
-	}
-	return val
+	return idutils.TraceIDToLowUInt64(pdata.NewTraceID(rawID))
 }
 
 func getDatadogSpanName(s pdata.Span, datadogTags map[string]string) string {
@@ -34,6 +34,7 @@ import (
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/ext"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/model/attributes"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/testutils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/utils"
@@ -133,7 +134,7 @@ func TestConvertToDatadogTd(t *testing.T) {
 		Version: "1.0",
 	}
 
-	outputTraces, runningMetrics := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, runningMetrics := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	assert.Equal(t, 1, len(outputTraces))
 	assert.Equal(t, 1, len(runningMetrics))
@@ -146,7 +147,7 @@ func TestConvertToDatadogTdNoResourceSpans(t *testing.T) {
 		Version: "1.0",
 	}
 
-	outputTraces, runningMetrics := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, runningMetrics := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	assert.Equal(t, 0, len(outputTraces))
 	assert.Equal(t, 0, len(runningMetrics))
@@ -175,7 +176,7 @@ func TestRunningTraces(t *testing.T) {
 		Version: "1.0",
 	}
 
-	_, runningMetrics := convertToDatadogTd(td, "fallbackHost", &config.Config{}, newDenylister([]string{}), buildInfo)
+	_, runningMetrics := convertToDatadogTd(td, "fallbackHost", &config.Config{}, newDenylister([]string{}), metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	runningHostnames := []string{}
 	for _, metric := range runningMetrics {
@@ -215,7 +216,7 @@ func TestRunningTracesARN(t *testing.T) {
 
 	buildInfo := component.BuildInfo{}
 
-	_, runningMetrics := convertToDatadogTd(td, "fallbackHost", &config.Config{}, newDenylister([]string{}), buildInfo)
+	_, runningMetrics := convertToDatadogTd(td, "fallbackHost", &config.Config{}, newDenylister([]string{}), metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	runningHostnames := []string{}
 	runningTags := []string{}
@@ -253,7 +254,7 @@ func TestObfuscation(t *testing.T) {
 	// of them is currently not supported.
 	span.Attributes().InsertString("testinfo?=123", "http.route")
 
-	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	aggregatedTraces := aggregateTracePayloadsByEnv(outputTraces)
 
@@ -700,6 +701,72 @@ func TestTracesTranslationConfig(t *testing.T) {
 	assert.Equal(t, 18, len(datadogPayload.Traces[0].Spans[0].Meta))
 }
 
+func TestTracesTranslationIngestion(t *testing.T) {
+	hostname := "testhostname"
+	denylister := newDenylister([]string{})
+
+	mockTraceID := [16]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F}
+	mockSpanID := [8]byte{0xF1, 0xF2, 0xF3, 0xF4, 0xF5, 0xF6, 0xF7, 0xF8}
+	mockParentSpanID := [8]byte{0xEF, 0xEE, 0xED, 0xEC, 0xEB, 0xEA, 0xE9, 0xE8}
+	mockEndTime := time.Now().Round(time.Second)
+
+	tests := []struct {
+		name           string
+		ingestion      config.IngestionConfig
+		spanOrigin     string
+		expectedOrigin string
+		expectedSource string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:           "static values",
+			ingestion:      config.IngestionConfig{Origin: "otel-collector", Source: "opentelemetry"},
+			expectedOrigin: "otel-collector",
+			expectedSource: "opentelemetry",
+		},
+		{
+			name: "resource attributes",
+			ingestion: config.IngestionConfig{
+				Origin:          "otel-collector",
+				Source:          "opentelemetry",
+				SourceAttribute: "namespace",
+				OriginAttribute: "missing",
+			},
+			expectedOrigin: "otel-collector",
+			expectedSource: "kube-system",
+		},
+		{
+			name:           "origin set by instrumentation",
+			ingestion:      config.IngestionConfig{Origin: "otel-collector"},
+			spanOrigin:     "synthetics",
+			expectedOrigin: "synthetics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := NewResourceSpansData(mockTraceID, mockSpanID, mockParentSpanID, pdata.StatusCodeUnset, false, mockEndTime)
+			if tt.spanOrigin != "" {
+				rs.InstrumentationLibrarySpans().At(0).Spans().At(0).Attributes().InsertString("_dd.origin", tt.spanOrigin)
+			}
+			cfg := config.Config{Traces: config.TracesConfig{Ingestion: tt.ingestion}}
+
+			datadogPayload := resourceSpansToDatadogSpans(rs, hostname, &cfg, denylister, map[string]string{})
+			require.Equal(t, 1, len(datadogPayload.Traces))
+			meta := datadogPayload.Traces[0].Spans[0].Meta
+
+			origin, ok := meta["_dd.origin"]
+			assert.Equal(t, tt.expectedOrigin != "", ok)
+			assert.Equal(t, tt.expectedOrigin, origin)
+			source, ok := meta["source"]
+			assert.Equal(t, tt.expectedSource != "", ok)
+			assert.Equal(t, tt.expectedSource, source)
+		})
+	}
+}
+
 // ensure that the translation returns early if no resource instrumentation library spans
 func TestTracesTranslationNoIls(t *testing.T) {
 	hostname := "testhostname"
@@ -1183,6 +1250,25 @@ func TestILTagsExctraction(t *testing.T) {
 
 }
 
+// ensure that conflicting tag keys are resolved the same way whatever the attributes order
+func TestAggregateSpanTagsConflicts(t *testing.T) {
+	span := pdata.NewSpan()
+	span.Attributes().InsertString("Team", "span-upper")
+	span.Attributes().InsertString("team", "span")
+	span.Attributes().InsertString(keySamplingRate, "0.5")
+
+	for i := 0; i < 10; i++ {
+		tags := aggregateSpanTags(span, map[string]string{
+			"Region": "resource-upper",
+			"region": "resource",
+			"team":   "resource",
+		})
+		assert.Equal(t, "span", tags["team"])
+		assert.Equal(t, "resource", tags["region"])
+		assert.Equal(t, "0.5", tags[keySamplingRate])
+	}
+}
+
 func TestHttpResourceTag(t *testing.T) {
 	span := pdata.NewSpan()
 	span.SetName("Default Name")
@@ -1359,7 +1445,7 @@ func TestSanitization(t *testing.T) {
 	instrumentationLibrary.SetVersion("v1")
 	ilss.Spans().EnsureCapacity(1)
 
-	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	aggregatedTraces := aggregateTracePayloadsByEnv(outputTraces)
 
@@ -1470,7 +1556,7 @@ func TestSpanNameMapping(t *testing.T) {
 
 	config := config.Config{Traces: config.TracesConfig{SpanNameRemappings: map[string]string{"flash.server": "bang.client"}}}
 
-	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config, denylister, buildInfo)
+	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 	aggregatedTraces := aggregateTracePayloadsByEnv(outputTraces)
 
 	obfuscator := obfuscate.NewObfuscator(obfuscatorConfig)
@@ -1520,7 +1606,7 @@ func TestSpanEnvClobbering(t *testing.T) {
 	span.SetStartTimestamp(pdataStartTime)
 	span.SetEndTimestamp(pdataEndTime)
 
-	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	// Ensure the deployment.environment value is copied to both deployment.environment and env
 	assert.Equal(t, "correctenv", outputTraces[0].Traces[0].Spans[0].Meta["env"])
@@ -1572,7 +1658,7 @@ func TestSpanRateLimitTag(t *testing.T) {
 	span.SetStartTimestamp(pdataStartTime)
 	span.SetEndTimestamp(pdataEndTime)
 
-	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, buildInfo)
+	outputTraces, _ := convertToDatadogTd(traces, "test-host", &config.Config{}, denylister, metrics.NewRunningMetrics("traces", 0, buildInfo))
 
 	assert.Equal(t, 0.5, outputTraces[0].Traces[0].Spans[0].Metrics["_sample_rate"])
 }
@@ -16,6 +16,7 @@ package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Shopify/sarama"
@@ -49,6 +50,12 @@ type Config struct {
 
 	// Authentication defines used authentication mechanism.
 	Authentication Authentication `mapstructure:"auth"`
+
+	// PartitionBy keys the messages so that the telemetry sharing a key lands
+	// in the same partition: trace_id splits the spans and log records by
+	// trace ID, and resource_attribute:<name> splits the resources by the
+	// value of the attribute. Empty leaves the messages unkeyed.
+	PartitionBy string `mapstructure:"partition_by"`
 }
 
 // Metadata defines configuration for retrieving metadata from the broker.
@@ -77,6 +84,11 @@ type Producer struct {
 	//   1 -> WaitForLocal. waits for only the local commit to succeed before responding ( default )
 	//   -1 -> WaitForAll. waits for all in-sync replicas to commit before responding.
 	RequiredAcks sarama.RequiredAcks `mapstructure:"required_acks"`
+
+	// Partitioner selects the partition of the keyed messages:
+	//   hash -> the FNV-1a hash of the key, the sarama default ( default )
+	//   murmur2 -> the murmur2 hash of the key, like the Java client
+	Partitioner string `mapstructure:"partitioner"`
 }
 
 // MetadataRetry defines retry configuration for Metadata.
@@ -96,5 +108,14 @@ func (cfg *Config) Validate() error {
 	if cfg.Producer.RequiredAcks < -1 || cfg.Producer.RequiredAcks > 1 {
 		return fmt.Errorf("producer.required_acks has to be between -1 and 1. configured value %v", cfg.Producer.RequiredAcks)
 	}
+	switch cfg.Producer.Partitioner {
+	case "", PartitionerHash, PartitionerMurmur2:
+	default:
+		return fmt.Errorf("producer.partitioner has to be %s or %s. configured value %v", PartitionerHash, PartitionerMurmur2, cfg.Producer.Partitioner)
+	}
+	if cfg.PartitionBy != "" && cfg.PartitionBy != PartitionByTraceID &&
+		(!strings.HasPrefix(cfg.PartitionBy, PartitionByResourceAttributePrefix) || cfg.PartitionBy == PartitionByResourceAttributePrefix) {
+		return fmt.Errorf("partition_by has to be %s or %s<name>. configured value %v", PartitionByTraceID, PartitionByResourceAttributePrefix, cfg.PartitionBy)
+	}
 	return nil
 }
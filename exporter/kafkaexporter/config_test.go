@@ -74,6 +74,26 @@ func TestLoadConfig(t *testing.T) {
 		Producer: Producer{
 			MaxMessageBytes: 10000000,
 			RequiredAcks:    sarama.WaitForAll,
+			Partitioner:     PartitionerMurmur2,
 		},
+		PartitionBy: PartitionByTraceID,
 	}, c)
 }
+
+func TestValidatePartitioning(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.PartitionBy = "resource_attribute:service.name"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.PartitionBy = "resource_attribute:"
+	assert.Error(t, cfg.Validate())
+
+	cfg.PartitionBy = "span_id"
+	assert.Error(t, cfg.Validate())
+
+	cfg.PartitionBy = PartitionByTraceID
+	cfg.Producer.Partitioner = "crc32"
+	assert.Error(t, cfg.Validate())
+}
@@ -95,6 +95,7 @@ func createDefaultConfig() config.Exporter {
 		Producer: Producer{
 			MaxMessageBytes: defaultProducerMaxMessageBytes,
 			RequiredAcks:    defaultProducerRequiredAcks,
+			Partitioner:     PartitionerHash,
 		},
 	}
 }
@@ -32,7 +32,10 @@ type kafkaTracesProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
 	marshaler TracesMarshaler
-	logger    *zap.Logger
+	// keyer splits the traces into keyed messages, nil if the messages are
+	// not keyed.
+	keyer  *messageKeyer
+	logger *zap.Logger
 }
 
 type kafkaErrors struct {
@@ -45,7 +48,7 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td pdata.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
+	messages, err := e.marshal(td)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -65,16 +68,35 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
 
+func (e *kafkaTracesProducer) marshal(td pdata.Traces) ([]*sarama.ProducerMessage, error) {
+	if e.keyer == nil {
+		return e.marshaler.Marshal(td, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.keyer.splitTraces(td) {
+		partMessages, err := e.marshaler.Marshal(part.traces, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		setMessagesKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
 	marshaler MetricsMarshaler
-	logger    *zap.Logger
+	// keyer splits the metrics into keyed messages, nil if the messages are
+	// not keyed.
+	keyer  *messageKeyer
+	logger *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pdata.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
+	messages, err := e.marshal(md)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -94,16 +116,35 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
 
+func (e *kafkaMetricsProducer) marshal(md pdata.Metrics) ([]*sarama.ProducerMessage, error) {
+	if e.keyer == nil {
+		return e.marshaler.Marshal(md, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.keyer.splitMetrics(md) {
+		partMessages, err := e.marshaler.Marshal(part.metrics, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		setMessagesKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
 	producer  sarama.SyncProducer
 	topic     string
 	marshaler LogsMarshaler
-	logger    *zap.Logger
+	// keyer splits the logs into keyed messages, nil if the messages are
+	// not keyed.
+	keyer  *messageKeyer
+	logger *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld pdata.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
+	messages, err := e.marshal(ld)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -123,6 +164,22 @@ func (e *kafkaLogsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
 
+func (e *kafkaLogsProducer) marshal(ld pdata.Logs) ([]*sarama.ProducerMessage, error) {
+	if e.keyer == nil {
+		return e.marshaler.Marshal(ld, e.topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.keyer.splitLogs(ld) {
+		partMessages, err := e.marshaler.Marshal(part.logs, e.topic)
+		if err != nil {
+			return nil, err
+		}
+		setMessagesKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
 	c := sarama.NewConfig()
 	// These setting are required by the sarama.SyncProducer implementation.
@@ -135,6 +192,9 @@ func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
 	c.Metadata.Retry.Max = config.Metadata.Retry.Max
 	c.Metadata.Retry.Backoff = config.Metadata.Retry.Backoff
 	c.Producer.MaxMessageBytes = config.Producer.MaxMessageBytes
+	if config.Producer.Partitioner == PartitionerMurmur2 {
+		c.Producer.Partitioner = newMurmur2Partitioner
+	}
 	if config.ProtocolVersion != "" {
 		version, err := sarama.ParseKafkaVersion(config.ProtocolVersion)
 		if err != nil {
@@ -166,6 +226,7 @@ func newMetricsExporter(config Config, set component.ExporterCreateSettings, mar
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		keyer:     newMessageKeyer(config.PartitionBy),
 		logger:    set.Logger,
 	}, nil
 
@@ -185,6 +246,7 @@ func newTracesExporter(config Config, set component.ExporterCreateSettings, mars
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		keyer:     newMessageKeyer(config.PartitionBy),
 		logger:    set.Logger,
 	}, nil
 }
@@ -203,6 +265,7 @@ func newLogsExporter(config Config, set component.ExporterCreateSettings, marsha
 		producer:  producer,
 		topic:     config.Topic,
 		marshaler: marshaler,
+		keyer:     newMessageKeyer(config.PartitionBy),
 		logger:    set.Logger,
 	}, nil
 
@@ -0,0 +1,311 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	// PartitionByTraceID keys the messages by the trace ID of their spans
+	// and log records.
+	PartitionByTraceID = "trace_id"
+	// PartitionByResourceAttributePrefix followed by an attribute name keys
+	// the messages by the value of that attribute of their resources.
+	PartitionByResourceAttributePrefix = "resource_attribute:"
+
+	// PartitionerHash selects the partition of the keyed messages with the
+	// FNV-1a hash of their key, the sarama default.
+	PartitionerHash = "hash"
+	// PartitionerMurmur2 selects the partition of the keyed messages like
+	// the default partitioner of the Java client, with the murmur2 hash of
+	// their key.
+	PartitionerMurmur2 = "murmur2"
+)
+
+// messageKeyer splits the telemetry into the parts produced as separate
+// messages, each keyed so that the telemetry sharing a trace ID or a
+// resource attribute value lands in the same partition.
+type messageKeyer struct {
+	traceID           bool
+	resourceAttribute string
+}
+
+// newMessageKeyer returns the keyer for the partition_by setting, nil if the
+// messages are not keyed.
+func newMessageKeyer(partitionBy string) *messageKeyer {
+	switch {
+	case partitionBy == PartitionByTraceID:
+		return &messageKeyer{traceID: true}
+	case strings.HasPrefix(partitionBy, PartitionByResourceAttributePrefix):
+		return &messageKeyer{resourceAttribute: strings.TrimPrefix(partitionBy, PartitionByResourceAttributePrefix)}
+	}
+	return nil
+}
+
+// keyedTraces are the traces produced with the given key, no key when empty.
+type keyedTraces struct {
+	key    string
+	traces pdata.Traces
+}
+
+// tracesGroup accumulates the spans of a key, tracking the resource and
+// library of the source the last spans were copied from.
+type tracesGroup struct {
+	keyedTraces
+	rsIndex  int
+	ilsIndex int
+	ils      pdata.InstrumentationLibrarySpans
+}
+
+// splitTraces groups the spans by key, in the order their keys are first seen.
+func (k *messageKeyer) splitTraces(td pdata.Traces) []keyedTraces {
+	var keys []string
+	groups := map[string]*tracesGroup{}
+	group := func(key string) *tracesGroup {
+		g, ok := groups[key]
+		if !ok {
+			g = &tracesGroup{keyedTraces: keyedTraces{key: key, traces: pdata.NewTraces()}, rsIndex: -1}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		return g
+	}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		if !k.traceID {
+			rs.CopyTo(group(k.resourceKey(rs.Resource())).traces.ResourceSpans().AppendEmpty())
+			continue
+		}
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			ils := ilss.At(j)
+			spans := ils.Spans()
+			for s := 0; s < spans.Len(); s++ {
+				span := spans.At(s)
+				g := group(traceIDKey(span.TraceID()))
+				if g.rsIndex != i {
+					rs.Resource().CopyTo(g.traces.ResourceSpans().AppendEmpty().Resource())
+					g.rsIndex, g.ilsIndex = i, -1
+				}
+				if g.ilsIndex != j {
+					dest := g.traces.ResourceSpans()
+					g.ils = dest.At(dest.Len() - 1).InstrumentationLibrarySpans().AppendEmpty()
+					ils.InstrumentationLibrary().CopyTo(g.ils.InstrumentationLibrary())
+					g.ils.SetSchemaUrl(ils.SchemaUrl())
+					g.ilsIndex = j
+				}
+				span.CopyTo(g.ils.Spans().AppendEmpty())
+			}
+		}
+	}
+
+	parts := make([]keyedTraces, len(keys))
+	for i, key := range keys {
+		parts[i] = groups[key].keyedTraces
+	}
+	return parts
+}
+
+// keyedMetrics are the metrics produced with the given key, no key when empty.
+type keyedMetrics struct {
+	key     string
+	metrics pdata.Metrics
+}
+
+// splitMetrics groups the resource metrics by the value of the resource
+// attribute. Metrics have no trace ID, they are not keyed by trace ID.
+func (k *messageKeyer) splitMetrics(md pdata.Metrics) []keyedMetrics {
+	if k.traceID {
+		return []keyedMetrics{{metrics: md}}
+	}
+	var parts []keyedMetrics
+	index := map[string]int{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		key := k.resourceKey(rm.Resource())
+		p, ok := index[key]
+		if !ok {
+			p = len(parts)
+			index[key] = p
+			parts = append(parts, keyedMetrics{key: key, metrics: pdata.NewMetrics()})
+		}
+		rm.CopyTo(parts[p].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return parts
+}
+
+// keyedLogs are the logs produced with the given key, no key when empty.
+type keyedLogs struct {
+	key  string
+	logs pdata.Logs
+}
+
+// logsGroup accumulates the log records of a key, tracking the resource and
+// library of the source the last log records were copied from.
+type logsGroup struct {
+	keyedLogs
+	rlIndex  int
+	illIndex int
+	ill      pdata.InstrumentationLibraryLogs
+}
+
+// splitLogs groups the log records by key, in the order their keys are first
+// seen. The log records without trace ID are not keyed by trace ID.
+func (k *messageKeyer) splitLogs(ld pdata.Logs) []keyedLogs {
+	var keys []string
+	groups := map[string]*logsGroup{}
+	group := func(key string) *logsGroup {
+		g, ok := groups[key]
+		if !ok {
+			g = &logsGroup{keyedLogs: keyedLogs{key: key, logs: pdata.NewLogs()}, rlIndex: -1}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		return g
+	}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		if !k.traceID {
+			rl.CopyTo(group(k.resourceKey(rl.Resource())).logs.ResourceLogs().AppendEmpty())
+			continue
+		}
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			records := ill.LogRecords()
+			for r := 0; r < records.Len(); r++ {
+				record := records.At(r)
+				g := group(traceIDKey(record.TraceID()))
+				if g.rlIndex != i {
+					rl.Resource().CopyTo(g.logs.ResourceLogs().AppendEmpty().Resource())
+					g.rlIndex, g.illIndex = i, -1
+				}
+				if g.illIndex != j {
+					dest := g.logs.ResourceLogs()
+					g.ill = dest.At(dest.Len() - 1).InstrumentationLibraryLogs().AppendEmpty()
+					ill.InstrumentationLibrary().CopyTo(g.ill.InstrumentationLibrary())
+					g.ill.SetSchemaUrl(ill.SchemaUrl())
+					g.illIndex = j
+				}
+				record.CopyTo(g.ill.LogRecords().AppendEmpty())
+			}
+		}
+	}
+
+	parts := make([]keyedLogs, len(keys))
+	for i, key := range keys {
+		parts[i] = groups[key].keyedLogs
+	}
+	return parts
+}
+
+// resourceKey returns the value of the resource attribute, empty if missing.
+func (k *messageKeyer) resourceKey(resource pdata.Resource) string {
+	if value, ok := resource.Attributes().Get(k.resourceAttribute); ok {
+		return value.AsString()
+	}
+	return ""
+}
+
+// traceIDKey returns the hex trace ID, empty for an invalid trace ID.
+func traceIDKey(traceID pdata.TraceID) string {
+	if traceID.IsEmpty() {
+		return ""
+	}
+	return traceID.HexString()
+}
+
+// setMessagesKey sets the key of the messages, unless empty.
+func setMessagesKey(messages []*sarama.ProducerMessage, key string) {
+	if key == "" {
+		return
+	}
+	for _, message := range messages {
+		message.Key = sarama.StringEncoder(key)
+	}
+}
+
+// murmur2Partitioner selects the partition of the keyed messages like the
+// default partitioner of the Java client, so that the messages produced with
+// a key by the collector and by Java clients land in the same partition. The
+// messages without key are spread randomly.
+type murmur2Partitioner struct {
+	random sarama.Partitioner
+}
+
+var _ sarama.Partitioner = (*murmur2Partitioner)(nil)
+
+func newMurmur2Partitioner(topic string) sarama.Partitioner {
+	return &murmur2Partitioner{random: sarama.NewRandomPartitioner(topic)}
+}
+
+func (p *murmur2Partitioner) Partition(message *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if message.Key == nil {
+		return p.random.Partition(message, numPartitions)
+	}
+	key, err := message.Key.Encode()
+	if err != nil {
+		return -1, err
+	}
+	return int32(murmur2(key)&0x7fffffff) % numPartitions, nil
+}
+
+func (p *murmur2Partitioner) RequiresConsistency() bool {
+	return true
+}
+
+// murmur2 is the murmur2 hash of the Java client, Utils.murmur2.
+func murmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+	length := len(data)
+	h := uint32(seed) ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := uint32(data[i]) | uint32(data[i+1])<<8 | uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
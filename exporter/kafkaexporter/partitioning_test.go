@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/otlp"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+var (
+	testTraceIDA = pdata.NewTraceID([16]byte{1})
+	testTraceIDB = pdata.NewTraceID([16]byte{2})
+)
+
+// testTraces returns two resources, of services a and b, the first with
+// spans of traces A, B, A and the second with a span of trace A.
+func testTraces() pdata.Traces {
+	td := pdata.NewTraces()
+	for _, resource := range []struct {
+		service  string
+		traceIDs []pdata.TraceID
+	}{
+		{"a", []pdata.TraceID{testTraceIDA, testTraceIDB, testTraceIDA}},
+		{"b", []pdata.TraceID{testTraceIDA}},
+	} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().InsertString("service.name", resource.service)
+		ils := rs.InstrumentationLibrarySpans().AppendEmpty()
+		ils.InstrumentationLibrary().SetName("library")
+		for _, traceID := range resource.traceIDs {
+			ils.Spans().AppendEmpty().SetTraceID(traceID)
+		}
+	}
+	return td
+}
+
+func TestNewMessageKeyer(t *testing.T) {
+	assert.Nil(t, newMessageKeyer(""))
+	assert.Equal(t, &messageKeyer{traceID: true}, newMessageKeyer("trace_id"))
+	assert.Equal(t, &messageKeyer{resourceAttribute: "service.name"}, newMessageKeyer("resource_attribute:service.name"))
+}
+
+func TestSplitTracesByTraceID(t *testing.T) {
+	parts := newMessageKeyer(PartitionByTraceID).splitTraces(testTraces())
+	require.Len(t, parts, 2)
+
+	assert.Equal(t, testTraceIDA.HexString(), parts[0].key)
+	assert.Equal(t, 3, parts[0].traces.SpanCount())
+	rss := parts[0].traces.ResourceSpans()
+	require.Equal(t, 2, rss.Len())
+	assert.Equal(t, 2, rss.At(0).InstrumentationLibrarySpans().At(0).Spans().Len())
+	assert.Equal(t, "library", rss.At(0).InstrumentationLibrarySpans().At(0).InstrumentationLibrary().Name())
+	service, _ := rss.At(1).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "b", service.StringVal())
+
+	assert.Equal(t, testTraceIDB.HexString(), parts[1].key)
+	assert.Equal(t, 1, parts[1].traces.SpanCount())
+}
+
+func TestSplitTracesByResourceAttribute(t *testing.T) {
+	td := testTraces()
+	td.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+
+	parts := newMessageKeyer("resource_attribute:service.name").splitTraces(td)
+	require.Len(t, parts, 3)
+	assert.Equal(t, "a", parts[0].key)
+	assert.Equal(t, 3, parts[0].traces.SpanCount())
+	assert.Equal(t, "b", parts[1].key)
+	assert.Equal(t, 1, parts[1].traces.SpanCount())
+	assert.Equal(t, "", parts[2].key)
+	assert.Equal(t, 1, parts[2].traces.SpanCount())
+}
+
+func TestSplitLogsByTraceID(t *testing.T) {
+	ld := pdata.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().SetTraceID(testTraceIDA)
+	records.AppendEmpty()
+	records.AppendEmpty().SetTraceID(testTraceIDA)
+
+	parts := newMessageKeyer(PartitionByTraceID).splitLogs(ld)
+	require.Len(t, parts, 2)
+	assert.Equal(t, testTraceIDA.HexString(), parts[0].key)
+	assert.Equal(t, 2, parts[0].logs.LogRecordCount())
+	assert.Equal(t, "", parts[1].key)
+	assert.Equal(t, 1, parts[1].logs.LogRecordCount())
+}
+
+func TestSplitMetrics(t *testing.T) {
+	md := pdata.NewMetrics()
+	for _, service := range []string{"a", "b", "a"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().InsertString("service.name", service)
+		rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	}
+
+	parts := newMessageKeyer("resource_attribute:service.name").splitMetrics(md)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "a", parts[0].key)
+	assert.Equal(t, 2, parts[0].metrics.MetricCount())
+	assert.Equal(t, "b", parts[1].key)
+	assert.Equal(t, 1, parts[1].metrics.MetricCount())
+
+	parts = newMessageKeyer(PartitionByTraceID).splitMetrics(md)
+	require.Len(t, parts, 1)
+	assert.Equal(t, "", parts[0].key)
+	assert.Equal(t, 3, parts[0].metrics.MetricCount())
+}
+
+func TestTracesPusherPartitionByTraceID(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, sarama.NewConfig())
+	var keys []string
+	producer.ExpectSendMessageAndSucceed()
+	producer.ExpectSendMessageAndSucceed()
+	p := kafkaTracesProducer{
+		producer:  &keyRecordingProducer{SyncProducer: producer, keys: &keys},
+		marshaler: newPdataTracesMarshaler(otlp.NewProtobufTracesMarshaler(), defaultEncoding),
+		keyer:     newMessageKeyer(PartitionByTraceID),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	require.NoError(t, p.tracesPusher(context.Background(), testTraces()))
+	assert.Equal(t, []string{testTraceIDA.HexString(), testTraceIDB.HexString()}, keys)
+}
+
+// keyRecordingProducer records the keys of the sent messages.
+type keyRecordingProducer struct {
+	sarama.SyncProducer
+	keys *[]string
+}
+
+func (p *keyRecordingProducer) SendMessages(messages []*sarama.ProducerMessage) error {
+	for _, message := range messages {
+		key, err := message.Key.Encode()
+		if err != nil {
+			return err
+		}
+		*p.keys = append(*p.keys, string(key))
+	}
+	return p.SyncProducer.SendMessages(messages)
+}
+
+func TestMurmur2(t *testing.T) {
+	// test vectors of the Java client, UtilsTest.testMurmur2
+	for key, hash := range map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	} {
+		assert.Equal(t, hash, int32(murmur2([]byte(key))), key)
+	}
+}
+
+func TestMurmur2Partitioner(t *testing.T) {
+	p := newMurmur2Partitioner("topic")
+	assert.True(t, p.RequiresConsistency())
+
+	partition, err := p.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder("foobar")}, 10)
+	require.NoError(t, err)
+	// toPositive(-790332482) % 10
+	assert.Equal(t, int32((-790332482&0x7fffffff)%10), partition)
+
+	partition, err = p.Partition(&sarama.ProducerMessage{}, 10)
+	require.NoError(t, err)
+	assert.True(t, partition >= 0 && partition < 10)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/prometheus/common/model"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
@@ -34,17 +35,109 @@ type Config struct {
 	// TenantID defines the tenant ID to associate log streams with.
 	TenantID string `mapstructure:"tenant_id"`
 
+	// Tenant defines how the tenant ID is resolved for each log record. When unset, TenantID is used for all logs.
+	Tenant *TenantConfig `mapstructure:"tenant"`
+
+	// TenantAuth defines the credentials used to push the logs of each tenant ID. Tenants without an entry are
+	// pushed with the credentials of the client settings.
+	TenantAuth map[string]TenantAuthConfig `mapstructure:"tenant_auth"`
+
 	// Labels defines how labels should be applied to log streams sent to Loki.
 	Labels LabelsConfig `mapstructure:"labels"`
-	// Allows you to choose the entry format in the exporter
+
+	// StructuredMetadata defines the structured metadata attached to the entries. When unset, no structured
+	// metadata is sent.
+	StructuredMetadata *StructuredMetadataConfig `mapstructure:"structured_metadata"`
+
+	// Allows you to choose the entry format in the exporter. Possible values: body, json, logfmt.
 	Format string `mapstructure:"format"`
+
+	// OnOutOfOrder defines what happens to entries older than the latest entry already pushed for their
+	// stream. Possible values: passthrough, drop, clamp.
+	OnOutOfOrder string `mapstructure:"on_out_of_order"`
+
+	// MaxStreams is the maximum number of streams sent in a single push. The entries of the streams above this
+	// limit are collapsed into the stream identified by OverflowLabels. Zero means no limit.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// MaxLabelValueLength is the maximum length in bytes of a label value, longer values are truncated.
+	// Zero means no limit.
+	MaxLabelValueLength int `mapstructure:"max_label_value_length"`
+
+	// OverflowLabels are the labels of the stream collecting the entries of the streams above MaxStreams.
+	// Defaults to loki_overflow="true".
+	OverflowLabels map[string]string `mapstructure:"overflow_labels"`
+
+	// OrderedDelivery makes the exporter retry the failed pushes itself, holding back the later pushes of their
+	// streams until the failed push succeeds or is given up on, instead of retrying them in parallel.
+	OrderedDelivery bool `mapstructure:"ordered_delivery"`
+
+	// MaxInFlightRequests is the maximum number of push requests sent concurrently. Zero means no limit.
+	MaxInFlightRequests int `mapstructure:"max_in_flight_requests"`
 }
 
+const (
+	formatBody   = "body"
+	formatJSON   = "json"
+	formatLogfmt = "logfmt"
+)
+
 func (c *Config) validate() error {
 	if _, err := url.Parse(c.Endpoint); c.Endpoint == "" || err != nil {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
 
+	switch c.Format {
+	case "", formatBody, formatJSON, formatLogfmt:
+	default:
+		return fmt.Errorf("\"format\" %q not recognized, possible values: %s, %s, %s", c.Format, formatBody, formatJSON, formatLogfmt)
+	}
+
+	switch c.OnOutOfOrder {
+	case "", outOfOrderPassthrough, outOfOrderDrop, outOfOrderClamp:
+	default:
+		return fmt.Errorf("\"on_out_of_order\" %q not recognized, possible values: %s, %s, %s", c.OnOutOfOrder, outOfOrderPassthrough, outOfOrderDrop, outOfOrderClamp)
+	}
+
+	if c.MaxStreams < 0 {
+		return fmt.Errorf("\"max_streams\" must not be negative")
+	}
+
+	if c.MaxLabelValueLength < 0 {
+		return fmt.Errorf("\"max_label_value_length\" must not be negative")
+	}
+
+	if c.MaxInFlightRequests < 0 {
+		return fmt.Errorf("\"max_in_flight_requests\" must not be negative")
+	}
+
+	for name, value := range c.OverflowLabels {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("the label `%s` in \"overflow_labels\" is not a valid label name. Label names must match %s", name, model.LabelNameRE.String())
+		}
+		if !model.LabelValue(value).IsValid() {
+			return fmt.Errorf("the value of the label `%s` in \"overflow_labels\" is not a valid label value", name)
+		}
+	}
+
+	if c.Tenant != nil {
+		if err := c.Tenant.validate(); err != nil {
+			return err
+		}
+	}
+
+	for tenant, auth := range c.TenantAuth {
+		if err := auth.validate(); err != nil {
+			return fmt.Errorf("\"tenant_auth\" for tenant %q: %w", tenant, err)
+		}
+	}
+
+	if c.StructuredMetadata != nil {
+		if err := c.StructuredMetadata.validate(); err != nil {
+			return err
+		}
+	}
+
 	return c.Labels.validate()
 }
 
@@ -52,6 +145,57 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+const (
+	// tenantSourceStatic uses the configured "tenant_id" for all logs.
+	tenantSourceStatic = "static"
+	// tenantSourceAttribute reads the tenant ID from a log record or resource attribute.
+	tenantSourceAttribute = "attribute"
+)
+
+// TenantConfig defines where the tenant ID of a log record comes from.
+type TenantConfig struct {
+	// Source defines where the tenant ID is read from. Possible values: static, attribute.
+	Source string `mapstructure:"source"`
+
+	// Attribute is the name of the log record attribute, or resource attribute when not present on the record,
+	// holding the tenant ID. Logs without this attribute are sent using "tenant_id". Required when Source is attribute.
+	Attribute string `mapstructure:"attribute"`
+}
+
+func (c *TenantConfig) validate() error {
+	switch c.Source {
+	case "", tenantSourceStatic:
+		return nil
+	case tenantSourceAttribute:
+		if c.Attribute == "" {
+			return fmt.Errorf("\"tenant.attribute\" must be set when \"tenant.source\" is %q", tenantSourceAttribute)
+		}
+		return nil
+	default:
+		return fmt.Errorf("\"tenant.source\" %q not recognized, possible values: %s, %s", c.Source, tenantSourceStatic, tenantSourceAttribute)
+	}
+}
+
+// TenantAuthConfig defines the credentials of a single tenant, either a static bearer token or an authenticator
+// extension such as oauth2client.
+type TenantAuthConfig struct {
+	// BearerToken is sent in the "Authorization" header of the push requests of the tenant.
+	BearerToken string `mapstructure:"bearer_token"`
+
+	// Auth configures the authenticator extension applied to the push requests of the tenant.
+	Auth *configauth.Authentication `mapstructure:"auth"`
+}
+
+func (c *TenantAuthConfig) validate() error {
+	if c.BearerToken != "" && c.Auth != nil {
+		return fmt.Errorf("only one of \"bearer_token\" and \"auth\" can be set")
+	}
+	if c.BearerToken == "" && c.Auth == nil {
+		return fmt.Errorf("one of \"bearer_token\" or \"auth\" must be set")
+	}
+	return nil
+}
+
 // LabelsConfig defines the labels-related configuration
 type LabelsConfig struct {
 	// Attributes are the log record attributes that are allowed to be added as labels on a log stream.
@@ -63,11 +207,14 @@ type LabelsConfig struct {
 	// RecordAttributes are the attributes from the record that are allowed to be added as labels on a log stream. Possible keys:
 	// traceID, spanID, severity, severityN.
 	RecordAttributes map[string]string `mapstructure:"record"`
+
+	// Templates are labels whose value is rendered from a template combining attributes.
+	Templates []LabelTemplate `mapstructure:"templates"`
 }
 
 func (c *LabelsConfig) validate() error {
-	if len(c.Attributes) == 0 && len(c.ResourceAttributes) == 0 && len(c.RecordAttributes) == 0 {
-		return fmt.Errorf("\"labels.attributes\", \"labels.resource\", or \"labels.record\" must be configured with at least one attribute")
+	if len(c.Attributes) == 0 && len(c.ResourceAttributes) == 0 && len(c.RecordAttributes) == 0 && len(c.Templates) == 0 {
+		return fmt.Errorf("\"labels.attributes\", \"labels.resource\", \"labels.record\" or \"labels.templates\" must be configured with at least one attribute")
 	}
 
 	logRecordNameInvalidErr := "the label `%s` in \"labels.attributes\" is not a valid label name. Label names must match " + model.LabelNameRE.String()
@@ -99,6 +246,12 @@ func (c *LabelsConfig) validate() error {
 			return fmt.Errorf("record attribute %q not recognized, possible values: traceID, spanID, severity, severityN", k)
 		}
 	}
+
+	for i := range c.Templates {
+		if err := c.Templates[i].validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -76,6 +77,14 @@ func TestLoadConfig(t *testing.T) {
 			QueueSize:    10,
 		},
 		TenantID: "example",
+		Tenant: &TenantConfig{
+			Source:    "attribute",
+			Attribute: conventions.AttributeK8SNamespaceName,
+		},
+		TenantAuth: map[string]TenantAuthConfig{
+			"team-a": {BearerToken: "token-a"},
+			"team-b": {Auth: &configauth.Authentication{AuthenticatorID: config.NewComponentIDWithName("oauth2client", "team-b")}},
+		},
 		Labels: LabelsConfig{
 			Attributes: map[string]string{
 				conventions.AttributeContainerName:  "container_name",
@@ -89,8 +98,31 @@ func TestLoadConfig(t *testing.T) {
 			RecordAttributes: map[string]string{
 				"traceID": "traceid",
 			},
+			Templates: []LabelTemplate{
+				{
+					Label:      "workload",
+					Template:   "{k8s.namespace.name}/{k8s.pod.name}",
+					Default:    "unknown",
+					Transforms: []string{"lowercase", "sanitize"},
+				},
+			},
+		},
+		StructuredMetadata: &StructuredMetadataConfig{
+			Static:             map[string]string{"env": "prod"},
+			Attributes:         map[string]string{"user.id": "user_id"},
+			ResourceAttributes: map[string]string{"host.name": "host"},
+			RecordAttributes:   map[string]string{"traceID": "trace_id"},
+			DetectSupport:      true,
+		},
+		Format:              "body",
+		OnOutOfOrder:        "clamp",
+		MaxStreams:          100,
+		MaxLabelValueLength: 256,
+		OverflowLabels: map[string]string{
+			"overflow": "true",
 		},
-		Format: "body",
+		OrderedDelivery:     true,
+		MaxInFlightRequests: 4,
 	}
 	require.Equal(t, &expectedCfg, actualCfg)
 }
@@ -142,7 +174,8 @@ func TestJSONLoadConfig(t *testing.T) {
 			Attributes:         map[string]string{},
 			ResourceAttributes: map[string]string{},
 		},
-		Format: "json",
+		Format:       "json",
+		OnOutOfOrder: "passthrough",
 	}
 	require.Equal(t, &expectedCfg, actualCfg)
 }
@@ -163,6 +196,14 @@ func TestConfig_validate(t *testing.T) {
 		CredentialFile string
 		Audience       string
 		Labels         LabelsConfig
+		Tenant         *TenantConfig
+		TenantAuth     map[string]TenantAuthConfig
+		Format         string
+		OnOutOfOrder   string
+		MaxStreams     int
+		MaxLabelLength int
+		OverflowLabels map[string]string
+		MaxInFlight    int
 	}
 	tests := []struct {
 		name         string
@@ -205,7 +246,7 @@ func TestConfig_validate(t *testing.T) {
 					ResourceAttributes: nil,
 				},
 			},
-			errorMessage: "\"labels.attributes\", \"labels.resource\", or \"labels.record\" must be configured with at least one attribute",
+			errorMessage: "\"labels.attributes\", \"labels.resource\", \"labels.record\" or \"labels.templates\" must be configured with at least one attribute",
 			shouldError:  true,
 		},
 		{
@@ -251,6 +292,154 @@ func TestConfig_validate(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{
+			name: "with invalid format",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Format:   "xml",
+			},
+			errorMessage: "\"format\" \"xml\" not recognized, possible values: body, json, logfmt",
+			shouldError:  true,
+		},
+		{
+			name: "with valid out-of-order policy",
+			fields: fields{
+				Endpoint:     validEndpoint,
+				Labels:       validAttribLabelsConfig,
+				OnOutOfOrder: "drop",
+			},
+			shouldError: false,
+		},
+		{
+			name: "with invalid out-of-order policy",
+			fields: fields{
+				Endpoint:     validEndpoint,
+				Labels:       validAttribLabelsConfig,
+				OnOutOfOrder: "reorder",
+			},
+			errorMessage: "\"on_out_of_order\" \"reorder\" not recognized, possible values: passthrough, drop, clamp",
+			shouldError:  true,
+		},
+		{
+			name: "with negative max in-flight requests",
+			fields: fields{
+				Endpoint:    validEndpoint,
+				Labels:      validAttribLabelsConfig,
+				MaxInFlight: -1,
+			},
+			errorMessage: "\"max_in_flight_requests\" must not be negative",
+			shouldError:  true,
+		},
+		{
+			name: "with valid limits",
+			fields: fields{
+				Endpoint:       validEndpoint,
+				Labels:         validAttribLabelsConfig,
+				MaxStreams:     10,
+				MaxLabelLength: 128,
+				OverflowLabels: map[string]string{"overflow": "true"},
+			},
+			shouldError: false,
+		},
+		{
+			name: "with negative max streams",
+			fields: fields{
+				Endpoint:   validEndpoint,
+				Labels:     validAttribLabelsConfig,
+				MaxStreams: -1,
+			},
+			errorMessage: "\"max_streams\" must not be negative",
+			shouldError:  true,
+		},
+		{
+			name: "with negative max label value length",
+			fields: fields{
+				Endpoint:       validEndpoint,
+				Labels:         validAttribLabelsConfig,
+				MaxLabelLength: -1,
+			},
+			errorMessage: "\"max_label_value_length\" must not be negative",
+			shouldError:  true,
+		},
+		{
+			name: "with invalid overflow label name",
+			fields: fields{
+				Endpoint:       validEndpoint,
+				Labels:         validAttribLabelsConfig,
+				OverflowLabels: map[string]string{"loki.overflow": "true"},
+			},
+			errorMessage: "the label `loki.overflow` in \"overflow_labels\" is not a valid label name. Label names must match " + model.LabelNameRE.String(),
+			shouldError:  true,
+		},
+		{
+			name: "with valid attribute tenant",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Tenant:   &TenantConfig{Source: "attribute", Attribute: "k8s.namespace.name"},
+			},
+			shouldError: false,
+		},
+		{
+			name: "with attribute tenant missing attribute",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Tenant:   &TenantConfig{Source: "attribute"},
+			},
+			errorMessage: "\"tenant.attribute\" must be set when \"tenant.source\" is \"attribute\"",
+			shouldError:  true,
+		},
+		{
+			name: "with invalid tenant source",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				Tenant:   &TenantConfig{Source: "context"},
+			},
+			errorMessage: "\"tenant.source\" \"context\" not recognized, possible values: static, attribute",
+			shouldError:  true,
+		},
+		{
+			name: "with valid tenant auth",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				TenantAuth: map[string]TenantAuthConfig{
+					"team-a": {BearerToken: "token-a"},
+					"team-b": {Auth: &configauth.Authentication{AuthenticatorID: config.NewComponentID("oauth2client")}},
+				},
+			},
+			shouldError: false,
+		},
+		{
+			name: "with tenant auth setting both token and authenticator",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				TenantAuth: map[string]TenantAuthConfig{
+					"team-a": {
+						BearerToken: "token-a",
+						Auth:        &configauth.Authentication{AuthenticatorID: config.NewComponentID("oauth2client")},
+					},
+				},
+			},
+			errorMessage: "\"tenant_auth\" for tenant \"team-a\": only one of \"bearer_token\" and \"auth\" can be set",
+			shouldError:  true,
+		},
+		{
+			name: "with empty tenant auth",
+			fields: fields{
+				Endpoint: validEndpoint,
+				Labels:   validAttribLabelsConfig,
+				TenantAuth: map[string]TenantAuthConfig{
+					"team-a": {},
+				},
+			},
+			errorMessage: "\"tenant_auth\" for tenant \"team-a\": one of \"bearer_token\" or \"auth\" must be set",
+			shouldError:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -260,6 +449,18 @@ func TestConfig_validate(t *testing.T) {
 			cfg.ExporterSettings = config.NewExporterSettings(config.NewComponentID(typeStr))
 			cfg.Endpoint = tt.fields.Endpoint
 			cfg.Labels = tt.fields.Labels
+			cfg.Tenant = tt.fields.Tenant
+			cfg.TenantAuth = tt.fields.TenantAuth
+			if tt.fields.Format != "" {
+				cfg.Format = tt.fields.Format
+			}
+			if tt.fields.OnOutOfOrder != "" {
+				cfg.OnOutOfOrder = tt.fields.OnOutOfOrder
+			}
+			cfg.MaxStreams = tt.fields.MaxStreams
+			cfg.MaxLabelValueLength = tt.fields.MaxLabelLength
+			cfg.OverflowLabels = tt.fields.OverflowLabels
+			cfg.MaxInFlightRequests = tt.fields.MaxInFlight
 
 			err := cfg.validate()
 			if (err != nil) != tt.shouldError {
@@ -290,7 +491,7 @@ func TestLabelsConfig_validate(t *testing.T) {
 				Attributes:         map[string]string{},
 				ResourceAttributes: map[string]string{},
 			},
-			errorMessage: "\"labels.attributes\", \"labels.resource\", or \"labels.record\" must be configured with at least one attribute",
+			errorMessage: "\"labels.attributes\", \"labels.resource\", \"labels.record\" or \"labels.templates\" must be configured with at least one attribute",
 			shouldError:  true,
 		},
 		{
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// encodeLogfmt encodes the log record as a line of logfmt key=value pairs, as parsed by the
+// Loki logfmt pipeline stage. A map body and map attributes are flattened using dotted keys,
+// any other body is written under the "msg" key.
+func encodeLogfmt(lr pdata.LogRecord, res pdata.Resource) (string, error) {
+	var b strings.Builder
+
+	body := lr.Body()
+	if body.Type() == pdata.AttributeValueTypeMap {
+		writeLogfmtMap(&b, "", body.MapVal())
+	} else if body.Type() != pdata.AttributeValueTypeEmpty {
+		writeLogfmtPair(&b, "msg", body.AsString())
+	}
+
+	if !lr.TraceID().IsEmpty() {
+		writeLogfmtPair(&b, "traceID", lr.TraceID().HexString())
+	}
+	if !lr.SpanID().IsEmpty() {
+		writeLogfmtPair(&b, "spanID", lr.SpanID().HexString())
+	}
+	if len(lr.SeverityText()) > 0 {
+		writeLogfmtPair(&b, "severity", lr.SeverityText())
+	}
+
+	writeLogfmtMap(&b, "", lr.Attributes())
+	writeLogfmtMap(&b, "", res.Attributes())
+
+	return b.String(), nil
+}
+
+func writeLogfmtMap(b *strings.Builder, prefix string, m pdata.AttributeMap) {
+	m.Range(func(k string, v pdata.AttributeValue) bool {
+		key := prefix + k
+		if v.Type() == pdata.AttributeValueTypeMap {
+			writeLogfmtMap(b, key+".", v.MapVal())
+			return true
+		}
+		writeLogfmtPair(b, key, v.AsString())
+		return true
+	})
+}
+
+func writeLogfmtPair(b *strings.Builder, key string, value string) {
+	if b.Len() > 0 {
+		b.WriteRune(' ')
+	}
+	b.WriteString(logfmtKey(key))
+	b.WriteRune('=')
+	if logfmtNeedsQuoting(value) {
+		b.WriteString(strconv.Quote(value))
+	} else {
+		b.WriteString(value)
+	}
+}
+
+// logfmtKey replaces the characters that are not allowed in a logfmt key with '_'.
+func logfmtKey(key string) string {
+	if key == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError {
+			return '_'
+		}
+		return r
+	}, key)
+}
+
+func logfmtNeedsQuoting(value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, r := range value {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestEncodeLogfmtWithStringBody(t *testing.T) {
+	in := `msg="Example log" traceID=01020304000000000000000000000000 spanID=0506070800000000 severity=error attr1=1 attr2=2 host.name=something`
+
+	out, err := encodeLogfmt(exampleLog())
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestEncodeLogfmtWithMapBody(t *testing.T) {
+	in := `key1=value nested.key2="quoted \"value\"" traceID=01020304000000000000000000000000 spanID=0506070800000000 severity=error attr1=1 attr2=2 host.name=something`
+
+	log, resource := exampleLog()
+	mapVal := pdata.NewAttributeValueMap()
+	mapVal.MapVal().InsertString("key1", "value")
+	nested := pdata.NewAttributeValueMap()
+	nested.MapVal().InsertString("key2", `quoted "value"`)
+	mapVal.MapVal().Insert("nested", nested)
+	mapVal.CopyTo(log.Body())
+
+	out, err := encodeLogfmt(log, resource)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
+
+func TestEncodeLogfmtKeysAndValues(t *testing.T) {
+	log := pdata.NewLogRecord()
+	log.Attributes().InsertString("key with space", "")
+	log.Attributes().InsertInt("count", 3)
+	log.Attributes().InsertBool("ok", true)
+
+	out, err := encodeLogfmt(log, pdata.NewResource())
+	assert.NoError(t, err)
+	assert.Equal(t, `key_with_space="" count=3 ok=true`, out)
+}
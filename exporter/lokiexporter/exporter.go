@@ -27,9 +27,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/prometheus/common/model"
+	"go.opencensus.io/stats"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -49,16 +51,60 @@ type lokiExporter struct {
 	client   *http.Client
 	wg       sync.WaitGroup
 	convert  func(pdata.LogRecord, pdata.Resource) (*logproto.Entry, error)
+	// templates are the compiled labels.templates.
+	templates []*compiledLabelTemplate
+	// ordering is nil when out-of-order entries are passed through.
+	ordering *streamOrdering
+	// overflowLabels are the labels of the stream collecting the entries above max_streams.
+	overflowLabels string
+	// tenantClients are the clients of the tenants configured with an authenticator in tenant_auth.
+	tenantClients map[string]*http.Client
+	// sequencer is nil when ordered delivery is disabled.
+	sequencer *streamSequencer
+	// inFlight bounds the number of concurrent push requests, nil when unbounded.
+	inFlight chan struct{}
+	// shutdownCh aborts the pushes waiting for their turn or for a retry.
+	shutdownCh chan struct{}
+	// structuredMetadata is nil when no structured metadata is configured.
+	structuredMetadata *structuredMetadata
 }
 
 func newExporter(config *Config, settings component.TelemetrySettings) *lokiExporter {
 	lokiexporter := &lokiExporter{
-		config:   config,
-		settings: settings,
+		config:     config,
+		settings:   settings,
+		templates:  compileLabelTemplates(config.Labels.Templates),
+		shutdownCh: make(chan struct{}),
 	}
-	if config.Format == "json" {
+	if config.OrderedDelivery {
+		lokiexporter.sequencer = newStreamSequencer()
+	}
+	if config.StructuredMetadata != nil {
+		lokiexporter.structuredMetadata = newStructuredMetadata(config.StructuredMetadata)
+	}
+	if config.MaxInFlightRequests > 0 {
+		lokiexporter.inFlight = make(chan struct{}, config.MaxInFlightRequests)
+	}
+	if config.MaxStreams > 0 {
+		overflowLabels := defaultOverflowLabels
+		if len(config.OverflowLabels) > 0 {
+			overflowLabels = model.LabelSet{}
+			for name, value := range config.OverflowLabels {
+				overflowLabels[model.LabelName(name)] = model.LabelValue(value)
+			}
+		}
+		lokiexporter.overflowLabels = overflowLabels.String()
+	}
+	switch config.OnOutOfOrder {
+	case outOfOrderDrop, outOfOrderClamp:
+		lokiexporter.ordering = newStreamOrdering(config.OnOutOfOrder)
+	}
+	switch config.Format {
+	case formatJSON:
 		lokiexporter.convert = lokiexporter.convertLogToJSONEntry
-	} else {
+	case formatLogfmt:
+		lokiexporter.convert = lokiexporter.convertLogToLogfmtEntry
+	default:
 		lokiexporter.convert = lokiexporter.convertLogBodyToEntry
 	}
 	return lokiexporter
@@ -68,11 +114,140 @@ func (l *lokiExporter) pushLogData(ctx context.Context, ld pdata.Logs) error {
 	l.wg.Add(1)
 	defer l.wg.Done()
 
+	if l.config.Tenant == nil || l.config.Tenant.Source != tenantSourceAttribute {
+		err := l.sendLogs(ctx, ld, l.config.TenantID)
+		if err != nil && !consumererror.IsPermanent(err) {
+			return consumererror.NewLogs(err, ld)
+		}
+		return err
+	}
+
+	// Each tenant is sent in its own request, only the logs of the tenants
+	// that failed with a retryable error are returned for retry.
+	var errs error
+	failed := pdata.NewLogs()
+	for tenant, tld := range splitLogsByTenant(ld, l.config.Tenant.Attribute, l.config.TenantID) {
+		err := l.sendLogs(ctx, tld, tenant)
+		if err == nil {
+			continue
+		}
+		errs = multierr.Append(errs, err)
+		if !consumererror.IsPermanent(err) {
+			tld.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
+	}
+
+	if failed.LogRecordCount() > 0 {
+		return consumererror.NewLogs(errs, failed)
+	}
+	return errs
+}
+
+// sendLogs pushes the logs of a single tenant to Loki.
+func (l *lokiExporter) sendLogs(ctx context.Context, ld pdata.Logs, tenant string) error {
 	pushReq, _ := l.logDataToLoki(ld)
 	if len(pushReq.Streams) == 0 {
 		return consumererror.NewPermanent(fmt.Errorf("failed to transform logs into Loki log streams"))
 	}
 
+	if l.config.MaxStreams > 0 {
+		if overflow := limitStreams(pushReq, l.config.MaxStreams, l.overflowLabels); overflow > 0 {
+			stats.Record(ctx, mOverflowLogs.M(int64(overflow)))
+		}
+	}
+
+	if l.sequencer == nil {
+		return l.push(ctx, pushReq, tenant)
+	}
+
+	ctx, cancel := l.withShutdown(ctx)
+	defer cancel()
+	done, err := l.sequencer.wait(ctx, tenant, pushReq)
+	if err != nil {
+		return err
+	}
+	defer done()
+	return l.pushWithRetry(ctx, pushReq, tenant)
+}
+
+// pushWithRetry pushes the request of a single tenant until it succeeds, fails
+// permanently or the retry settings give up on it. The later requests of its
+// streams wait meanwhile, so a retried request can't be overtaken.
+func (l *lokiExporter) pushWithRetry(ctx context.Context, pushReq *logproto.PushRequest, tenant string) error {
+	retry := l.config.RetrySettings
+	if !retry.Enabled {
+		return l.push(ctx, pushReq, tenant)
+	}
+
+	// the same backoff as the exporterhelper retries.
+	expBackoff := backoff.ExponentialBackOff{
+		InitialInterval:     retry.InitialInterval,
+		RandomizationFactor: backoff.DefaultRandomizationFactor,
+		Multiplier:          backoff.DefaultMultiplier,
+		MaxInterval:         retry.MaxInterval,
+		MaxElapsedTime:      retry.MaxElapsedTime,
+		Stop:                backoff.Stop,
+		Clock:               backoff.SystemClock,
+	}
+	expBackoff.Reset()
+	for {
+		err := l.push(ctx, pushReq, tenant)
+		if err == nil || consumererror.IsPermanent(err) {
+			return err
+		}
+		interval := expBackoff.NextBackOff()
+		if interval == backoff.Stop {
+			return consumererror.NewPermanent(fmt.Errorf("max elapsed time expired: %w", err))
+		}
+
+		l.settings.Logger.Debug("push failed, retrying in order",
+			zap.String("tenant", tenant), zap.Duration("interval", interval), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// withShutdown returns a context cancelled when the exporter is shut down.
+func (l *lokiExporter) withShutdown(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-l.shutdownCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// push sends a single push request to Loki. When Loki rejects the structured metadata of the request, the
+// request is sent again without it and structured metadata is no longer sent.
+func (l *lokiExporter) push(ctx context.Context, pushReq *logproto.PushRequest, tenant string) error {
+	err := l.pushRequest(ctx, pushReq, tenant)
+	if !errors.Is(err, errStructuredMetadataRejected) {
+		return err
+	}
+	if l.structuredMetadata.disable() {
+		l.settings.Logger.Warn("Loki rejected structured metadata, sending entries without it", zap.Error(err))
+	}
+	stripStructuredMetadata(pushReq)
+	return l.pushRequest(ctx, pushReq, tenant)
+}
+
+// pushRequest sends a single push request to Loki.
+func (l *lokiExporter) pushRequest(ctx context.Context, pushReq *logproto.PushRequest, tenant string) error {
+	if l.ordering != nil {
+		if dropped := l.ordering.order(tenant, pushReq); dropped > 0 {
+			l.settings.Logger.Debug("dropped out-of-order logs", zap.Int("dropped", dropped))
+		}
+		if len(pushReq.Streams) == 0 {
+			return nil
+		}
+	}
+
 	buf, err := encode(pushReq)
 	if err != nil {
 		return consumererror.NewPermanent(err)
@@ -88,13 +263,29 @@ func (l *lokiExporter) pushLogData(ctx context.Context, ld pdata.Logs) error {
 	}
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
-	if len(l.config.TenantID) > 0 {
-		req.Header.Set("X-Scope-OrgID", l.config.TenantID)
+	if len(tenant) > 0 {
+		req.Header.Set("X-Scope-OrgID", tenant)
+	}
+
+	client := l.client
+	if tenantClient, ok := l.tenantClients[tenant]; ok {
+		client = tenantClient
+	} else if auth, ok := l.config.TenantAuth[tenant]; ok && auth.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+auth.BearerToken)
+	}
+
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer func() { <-l.inFlight }()
 	}
 
-	resp, err := l.client.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
-		return consumererror.NewLogs(err, ld)
+		return err
 	}
 
 	defer func() {
@@ -108,8 +299,14 @@ func (l *lokiExporter) pushLogData(ctx context.Context, ld pdata.Logs) error {
 		if scanner.Scan() {
 			line = scanner.Text()
 		}
-		err = fmt.Errorf("HTTP %d %q: %s", resp.StatusCode, http.StatusText(resp.StatusCode), line)
-		return consumererror.NewLogs(err, ld)
+		if l.structuredMetadata.rejected(resp.StatusCode, line) {
+			return fmt.Errorf("%w: HTTP %d %q: %s", errStructuredMetadataRejected, resp.StatusCode, http.StatusText(resp.StatusCode), line)
+		}
+		return fmt.Errorf("HTTP %d %q: %s", resp.StatusCode, http.StatusText(resp.StatusCode), line)
+	}
+
+	if l.ordering != nil {
+		l.ordering.commit(tenant, pushReq)
 	}
 
 	return nil
@@ -124,7 +321,7 @@ func encode(pb proto.Message) ([]byte, error) {
 	return buf, nil
 }
 
-func (l *lokiExporter) start(_ context.Context, host component.Host) (err error) {
+func (l *lokiExporter) start(ctx context.Context, host component.Host) (err error) {
 	client, err := l.config.HTTPClientSettings.ToClient(host.GetExtensions(), l.settings)
 	if err != nil {
 		return err
@@ -132,10 +329,50 @@ func (l *lokiExporter) start(_ context.Context, host component.Host) (err error)
 
 	l.client = client
 
+	for tenant, auth := range l.config.TenantAuth {
+		if auth.Auth == nil {
+			continue
+		}
+		settings := l.config.HTTPClientSettings
+		settings.Auth = auth.Auth
+		tenantClient, err := settings.ToClient(host.GetExtensions(), l.settings)
+		if err != nil {
+			return fmt.Errorf("failed to create the client of tenant %q: %w", tenant, err)
+		}
+		if l.tenantClients == nil {
+			l.tenantClients = make(map[string]*http.Client)
+		}
+		l.tenantClients[tenant] = tenantClient
+	}
+
+	if l.structuredMetadata != nil && l.config.StructuredMetadata.DetectSupport {
+		l.detectStructuredMetadataSupport(ctx)
+	}
+
 	return nil
 }
 
+// detectStructuredMetadataSupport stops sending structured metadata when the version of Loki doesn't support
+// it. Structured metadata is still sent when the version can't be read, a rejection being detected on push.
+func (l *lokiExporter) detectStructuredMetadataSupport(ctx context.Context) {
+	url, ok := buildInfoURL(l.config.Endpoint)
+	if !ok {
+		l.settings.Logger.Debug("endpoint is not a Loki push endpoint, skipping the version check")
+		return
+	}
+	version, err := fetchLokiVersion(ctx, l.client, url, l.config.Headers, l.config.TenantID)
+	if err != nil {
+		l.settings.Logger.Warn("failed to read the Loki version, structured metadata support is unknown", zap.Error(err))
+		return
+	}
+	if !supportsStructuredMetadata(version) {
+		l.structuredMetadata.disable()
+		l.settings.Logger.Warn("Loki doesn't support structured metadata, sending entries without it", zap.String("version", version))
+	}
+}
+
 func (l *lokiExporter) stop(context.Context) (err error) {
+	close(l.shutdownCh)
 	l.wg.Wait()
 	return nil
 }
@@ -163,6 +400,10 @@ func (l *lokiExporter) logDataToLoki(ld pdata.Logs) (pr *logproto.PushRequest, n
 				recordLabels := l.convertRecordAttributesToLabels(log)
 				mergedLabels = mergedLabels.Merge(recordLabels)
 
+				if l.config.MaxLabelValueLength > 0 {
+					truncateLabelValues(mergedLabels, l.config.MaxLabelValueLength)
+				}
+
 				labels := mergedLabels.String()
 				var entry *logproto.Entry
 				var err error
@@ -182,6 +423,9 @@ func (l *lokiExporter) logDataToLoki(ld pdata.Logs) (pr *logproto.PushRequest, n
 					)
 					continue
 				}
+				if l.structuredMetadata.enabled() {
+					entry.StructuredMetadata = l.structuredMetadata.pairs(log, resource)
+				}
 
 				if stream, ok := streams[labels]; ok {
 					stream.Entries = append(stream.Entries, *entry)
@@ -220,6 +464,12 @@ func (l *lokiExporter) convertAttributesAndMerge(logAttrs pdata.AttributeMap, re
 	// This prometheus model.labelset Merge function overwrites	the logRecordAttributes with resourceAttributes
 	mergedAttributes = logRecordAttributes.Merge(resourceAttributes)
 
+	for _, template := range l.templates {
+		if value, ok := template.render(logAttrs, resourceAttrs); ok {
+			mergedAttributes[template.label] = value
+		}
+	}
+
 	if len(mergedAttributes) == 0 {
 		return nil, true
 	}
@@ -333,3 +583,14 @@ func (l *lokiExporter) convertLogToJSONEntry(lr pdata.LogRecord, res pdata.Resou
 		Line:      line,
 	}, nil
 }
+
+func (l *lokiExporter) convertLogToLogfmtEntry(lr pdata.LogRecord, res pdata.Resource) (*logproto.Entry, error) {
+	line, err := encodeLogfmt(lr, res)
+	if err != nil {
+		return nil, err
+	}
+	return &logproto.Entry{
+		Timestamp: time.Unix(0, int64(lr.Timestamp())),
+		Line:      line,
+	}, nil
+}
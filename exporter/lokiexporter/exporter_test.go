@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,11 +30,16 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
 )
@@ -255,6 +261,240 @@ func TestExporter_pushLogData(t *testing.T) {
 	}
 }
 
+func TestExporter_pushLogDataPerTenant(t *testing.T) {
+	var mu sync.Mutex
+	tenants := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tenants[r.Header.Get("X-Scope-OrgID")]++
+		mu.Unlock()
+		if r.Header.Get("X-Scope-OrgID") == "failing" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: server.URL,
+		},
+		TenantID: "default",
+		Tenant: &TenantConfig{
+			Source:    tenantSourceAttribute,
+			Attribute: conventions.AttributeK8SNamespaceName,
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+		},
+	}
+	exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	ld := pdata.NewLogs()
+	for _, namespace := range []string{"team-a", "team-b", "failing", ""} {
+		attrs := map[string]pdata.AttributeValue{
+			"severity": pdata.NewAttributeValueString("debug"),
+		}
+		if namespace != "" {
+			attrs[conventions.AttributeK8SNamespaceName] = pdata.NewAttributeValueString(namespace)
+		}
+		createLogData(2, pdata.NewAttributeMapFromMap(attrs)).ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	}
+
+	err := exp.pushLogData(context.Background(), ld)
+	require.Error(t, err)
+	var e consumererror.Logs
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, 2, e.GetLogs().LogRecordCount())
+
+	assert.Equal(t, map[string]int{"team-a": 1, "team-b": 1, "failing": 1, "default": 1}, tenants)
+}
+
+func TestExporter_pushLogDataOrderedDelivery(t *testing.T) {
+	var mu sync.Mutex
+	var pushed []int
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		buf, err := snappy.Decode(nil, body)
+		assert.NoError(t, err)
+		pr := &logproto.PushRequest{}
+		assert.NoError(t, pr.Unmarshal(buf))
+		pushed = append(pushed, len(pr.Streams[0].Entries))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: server.URL,
+		},
+		RetrySettings: exporterhelper.RetrySettings{
+			Enabled:         true,
+			InitialInterval: 50 * time.Millisecond,
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+		},
+		OrderedDelivery:     true,
+		MaxInFlightRequests: 1,
+	}
+	exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	attrs := pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+		"severity": pdata.NewAttributeValueString("debug"),
+	})
+	first := createLogData(5, attrs)
+	second := createLogData(1, attrs)
+
+	errs := make(chan error, 2)
+	go func() { errs <- exp.pushLogData(context.Background(), first) }()
+	// let the first push fail before the second one is received.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return !fail
+	}, time.Second, time.Millisecond)
+	go func() { errs <- exp.pushLogData(context.Background(), second) }()
+
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+
+	// the retried push of 5 records is delivered before the push of 1 record.
+	mu.Lock()
+	assert.Equal(t, []int{5, 1}, pushed)
+	mu.Unlock()
+	require.NoError(t, exp.stop(context.Background()))
+}
+
+type tenantAuthHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *tenantAuthHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+// headerAuthenticator is a configauth.ClientAuthenticator setting a static "Authorization" header.
+type headerAuthenticator struct {
+	value string
+}
+
+func (a *headerAuthenticator) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (a *headerAuthenticator) Shutdown(context.Context) error {
+	return nil
+}
+
+func (a *headerAuthenticator) RoundTripper(base http.RoundTripper) (http.RoundTripper, error) {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", a.value)
+		return base.RoundTrip(req)
+	}), nil
+}
+
+func (a *headerAuthenticator) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
+	return nil, nil
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestExporter_pushLogDataTenantAuth(t *testing.T) {
+	var mu sync.Mutex
+	authorizations := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorizations[r.Header.Get("X-Scope-OrgID")] = r.Header.Get("Authorization")
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	authenticatorID := config.NewComponentIDWithName("oauth2client", "team-b")
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: server.URL,
+		},
+		TenantID: "default",
+		Tenant: &TenantConfig{
+			Source:    tenantSourceAttribute,
+			Attribute: conventions.AttributeK8SNamespaceName,
+		},
+		TenantAuth: map[string]TenantAuthConfig{
+			"team-a": {BearerToken: "token-a"},
+			"team-b": {Auth: &configauth.Authentication{AuthenticatorID: authenticatorID}},
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"severity": "severity",
+			},
+		},
+	}
+	host := &tenantAuthHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{
+			authenticatorID: &headerAuthenticator{value: "Bearer oauth2-b"},
+		},
+	}
+	exp := newExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.start(context.Background(), host))
+
+	ld := pdata.NewLogs()
+	for _, namespace := range []string{"team-a", "team-b", ""} {
+		attrs := map[string]pdata.AttributeValue{
+			"severity": pdata.NewAttributeValueString("debug"),
+		}
+		if namespace != "" {
+			attrs[conventions.AttributeK8SNamespaceName] = pdata.NewAttributeValueString(namespace)
+		}
+		createLogData(2, pdata.NewAttributeMapFromMap(attrs)).ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	}
+
+	require.NoError(t, exp.pushLogData(context.Background(), ld))
+	assert.Equal(t, map[string]string{
+		"team-a":  "Bearer token-a",
+		"team-b":  "Bearer oauth2-b",
+		"default": "",
+	}, authorizations)
+}
+
+func TestExporter_startReturnsErrorWhenTenantAuthenticatorMissing(t *testing.T) {
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: validEndpoint,
+		},
+		TenantAuth: map[string]TenantAuthConfig{
+			"team-b": {Auth: &configauth.Authentication{AuthenticatorID: config.NewComponentID("oauth2client")}},
+		},
+	}
+	exp := newExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, exp)
+	require.Error(t, exp.start(context.Background(), componenttest.NewNopHost()))
+}
+
 func TestExporter_logDataToLoki(t *testing.T) {
 	config := &Config{
 		HTTPClientSettings: confighttp.HTTPClientSettings{
@@ -471,6 +711,34 @@ func TestExporter_convertAttributesToLabels(t *testing.T) {
 	})
 }
 
+func TestExporter_convertAttributesAndMergeWithTemplates(t *testing.T) {
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: validEndpoint,
+		},
+		Labels: LabelsConfig{
+			Templates: []LabelTemplate{
+				{Label: "workload", Template: "{k8s.namespace.name}/{k8s.pod.name}", Transforms: []string{"lowercase"}},
+				{Label: "cluster", Template: "{k8s.cluster.name}"},
+			},
+		},
+	}
+	exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, exp)
+
+	am := pdata.NewAttributeMap()
+	am.InsertString(conventions.AttributeK8SPodName, "API-0")
+	ram := pdata.NewAttributeMap()
+	ram.InsertString(conventions.AttributeK8SNamespaceName, "Prod")
+
+	ls, dropped := exp.convertAttributesAndMerge(am, ram)
+	assert.False(t, dropped)
+	assert.Equal(t, model.LabelSet{"workload": "prod/api-0"}, ls)
+
+	_, dropped = exp.convertAttributesAndMerge(pdata.NewAttributeMap(), pdata.NewAttributeMap())
+	assert.True(t, dropped)
+}
+
 func TestExporter_convertLogBodyToEntry(t *testing.T) {
 	res := pdata.NewResource()
 	res.Attributes().Insert("host.name", pdata.NewAttributeValueString("something"))
@@ -611,6 +879,22 @@ func TestExporter_convertLogtoJSONEntry(t *testing.T) {
 	require.Equal(t, expEntry, entry)
 }
 
+func TestExporter_convertLogToLogfmtEntry(t *testing.T) {
+	ts := pdata.Timestamp(int64(1) * time.Millisecond.Nanoseconds())
+	lr := pdata.NewLogRecord()
+	lr.Body().SetStringVal("log message")
+	lr.SetTimestamp(ts)
+
+	exp := newExporter(&Config{Format: "logfmt"}, componenttest.NewNopTelemetrySettings())
+	entry, err := exp.convert(lr, pdata.NewResource())
+	require.NoError(t, err)
+	expEntry := &logproto.Entry{
+		Timestamp: time.Unix(0, int64(lr.Timestamp())),
+		Line:      `msg="log message"`,
+	}
+	require.Equal(t, expEntry, entry)
+}
+
 func TestConvertRecordAttributesToLabels(t *testing.T) {
 	testCases := []struct {
 		desc     string
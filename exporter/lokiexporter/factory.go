@@ -16,8 +16,10 @@ package lokiexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -26,8 +28,14 @@ import (
 
 const typeStr = "loki"
 
+var once sync.Once
+
 // NewFactory creates a factory for Loki exporter.
 func NewFactory() component.ExporterFactory {
+	once.Do(func() {
+		_ = view.Register(MetricViews()...)
+	})
+
 	return exporterhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -48,7 +56,8 @@ func createDefaultConfig() config.Exporter {
 		RetrySettings: exporterhelper.DefaultRetrySettings(),
 		QueueSettings: exporterhelper.DefaultQueueSettings(),
 		TenantID:      "",
-		Format:        "body",
+		Format:        formatBody,
+		OnOutOfOrder:  outOfOrderPassthrough,
 		Labels: LabelsConfig{
 			Attributes:         map[string]string{},
 			ResourceAttributes: map[string]string{},
@@ -65,13 +74,19 @@ func createLogsExporter(_ context.Context, set component.ExporterCreateSettings,
 
 	exp := newExporter(expCfg, set.TelemetrySettings)
 
+	retrySettings := expCfg.RetrySettings
+	if expCfg.OrderedDelivery {
+		// the exporter retries the failed pushes itself to keep their streams in order.
+		retrySettings.Enabled = false
+	}
+
 	return exporterhelper.NewLogsExporter(
 		expCfg,
 		set,
 		exp.pushLogData,
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
-		exporterhelper.WithRetry(expCfg.RetrySettings),
+		exporterhelper.WithRetry(retrySettings),
 		exporterhelper.WithQueue(expCfg.QueueSettings),
 		exporterhelper.WithStart(exp.start),
 		exporterhelper.WithShutdown(exp.stop),
@@ -13,10 +13,17 @@ var (
 	stream = Stream{
 		Labels: `{job="foobar", cluster="foo-central1", namespace="bar", container_name="buzz"}`,
 		Entries: []Entry{
-			{now, line},
-			{now.Add(1 * time.Second), line},
-			{now.Add(2 * time.Second), line},
-			{now.Add(3 * time.Second), line},
+			{Timestamp: now, Line: line},
+			{Timestamp: now.Add(1 * time.Second), Line: line},
+			{Timestamp: now.Add(2 * time.Second), Line: line},
+			{Timestamp: now.Add(3 * time.Second), Line: line},
+		},
+	}
+	streamWithMetadata = Stream{
+		Labels: `{job="foobar"}`,
+		Entries: []Entry{
+			{Timestamp: now, Line: line, StructuredMetadata: []LabelAdapter{{Name: "trace_id", Value: "0102"}, {Name: "env", Value: "prod"}}},
+			{Timestamp: now.Add(1 * time.Second), Line: line},
 		},
 	}
 	streamAdapter = StreamAdapter{
@@ -44,6 +51,25 @@ func TestStream(t *testing.T) {
 	t.Log("avg allocs per run:", avg)
 }
 
+func TestStreamWithStructuredMetadata(t *testing.T) {
+	b, err := streamWithMetadata.Marshal()
+	require.NoError(t, err)
+	require.Len(t, b, streamWithMetadata.Size())
+
+	var new Stream
+	err = new.Unmarshal(b)
+	require.NoError(t, err)
+	require.Equal(t, streamWithMetadata, new)
+	require.True(t, streamWithMetadata.Equal(new))
+
+	// Loki versions before 2.9 skip the structured metadata.
+	var adapter StreamAdapter
+	err = adapter.Unmarshal(b)
+	require.NoError(t, err)
+	require.Len(t, adapter.Entries, 2)
+	require.Equal(t, line, adapter.Entries[0].Line)
+}
+
 func TestStreamAdapter(t *testing.T) {
 	avg := testing.AllocsPerRun(200, func() {
 		b, err := streamAdapter.Marshal()
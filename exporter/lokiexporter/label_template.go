@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	transformLowercase = "lowercase"
+	transformUppercase = "uppercase"
+	transformSanitize  = "sanitize"
+)
+
+// LabelTemplate defines a label which value is rendered from a template referencing attributes.
+type LabelTemplate struct {
+	// Label is the Loki label name.
+	Label string `mapstructure:"label"`
+
+	// Template is the label value template. Placeholders in the form `{attribute.name}` are replaced by the
+	// value of the log record attribute, or the resource attribute when not present on the record.
+	Template string `mapstructure:"template"`
+
+	// Default is the value used for placeholders whose attribute is missing. When empty and none of the
+	// placeholders could be resolved, the label is not added.
+	Default string `mapstructure:"default"`
+
+	// Transforms are applied in order to the rendered value. Possible values: lowercase, uppercase, sanitize.
+	Transforms []string `mapstructure:"transforms"`
+}
+
+// templatePart is either a literal string or an attribute placeholder of a parsed template.
+type templatePart struct {
+	literal   string
+	attribute string
+}
+
+// compiledLabelTemplate is a LabelTemplate parsed and ready to be rendered.
+type compiledLabelTemplate struct {
+	label      model.LabelName
+	parts      []templatePart
+	defaultVal string
+	transforms []string
+}
+
+func (t *LabelTemplate) validate() error {
+	if !model.LabelName(t.Label).IsValid() {
+		return fmt.Errorf("the label `%s` in \"labels.templates\" is not a valid label name. Label names must match %s", t.Label, model.LabelNameRE.String())
+	}
+	for _, transform := range t.Transforms {
+		switch transform {
+		case transformLowercase, transformUppercase, transformSanitize:
+		default:
+			return fmt.Errorf("transform %q of label `%s` not recognized, possible values: %s, %s, %s", transform, t.Label, transformLowercase, transformUppercase, transformSanitize)
+		}
+	}
+	_, err := t.compile()
+	return err
+}
+
+func (t *LabelTemplate) compile() (*compiledLabelTemplate, error) {
+	if t.Template == "" {
+		return nil, fmt.Errorf("the template of label `%s` in \"labels.templates\" must not be empty", t.Label)
+	}
+
+	var parts []templatePart
+	rest := t.Template
+	for len(rest) > 0 {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			parts = append(parts, templatePart{literal: rest})
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 || strings.IndexByte(rest[start+1:start+end], '{') >= 0 {
+			return nil, fmt.Errorf("the template %q of label `%s` has an unterminated placeholder", t.Template, t.Label)
+		}
+		end += start
+		if start > 0 {
+			parts = append(parts, templatePart{literal: rest[:start]})
+		}
+		attribute := rest[start+1 : end]
+		if attribute == "" {
+			return nil, fmt.Errorf("the template %q of label `%s` has an empty placeholder", t.Template, t.Label)
+		}
+		parts = append(parts, templatePart{attribute: attribute})
+		rest = rest[end+1:]
+	}
+
+	return &compiledLabelTemplate{
+		label:      model.LabelName(t.Label),
+		parts:      parts,
+		defaultVal: t.Default,
+		transforms: t.Transforms,
+	}, nil
+}
+
+// render returns the label value for the given attributes, and false when none of
+// the placeholders could be resolved and no default value is configured.
+func (c *compiledLabelTemplate) render(logAttrs pdata.AttributeMap, resourceAttrs pdata.AttributeMap) (model.LabelValue, bool) {
+	var b strings.Builder
+	resolved := false
+	hasPlaceholder := false
+	for _, part := range c.parts {
+		if part.attribute == "" {
+			b.WriteString(part.literal)
+			continue
+		}
+		hasPlaceholder = true
+		if v, ok := logAttrs.Get(part.attribute); ok {
+			b.WriteString(v.AsString())
+			resolved = true
+		} else if v, ok := resourceAttrs.Get(part.attribute); ok {
+			b.WriteString(v.AsString())
+			resolved = true
+		} else {
+			b.WriteString(c.defaultVal)
+		}
+	}
+
+	if hasPlaceholder && !resolved && c.defaultVal == "" {
+		return "", false
+	}
+
+	value := b.String()
+	for _, transform := range c.transforms {
+		switch transform {
+		case transformLowercase:
+			value = strings.ToLower(value)
+		case transformUppercase:
+			value = strings.ToUpper(value)
+		case transformSanitize:
+			value = sanitizeLabelValue(value)
+		}
+	}
+	return model.LabelValue(value), true
+}
+
+// sanitizeLabelValue replaces the characters that are not letters, digits, '_', '-', '.' or '/' with '_'.
+func sanitizeLabelValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '_' || r == '-' || r == '.' || r == '/':
+			return r
+		default:
+			return '_'
+		}
+	}, value)
+}
+
+// compileLabelTemplates compiles the configured templates, skipping the invalid ones
+// since the configuration is validated before the exporter is created.
+func compileLabelTemplates(templates []LabelTemplate) []*compiledLabelTemplate {
+	compiled := make([]*compiledLabelTemplate, 0, len(templates))
+	for i := range templates {
+		c, err := templates[i].compile()
+		if err != nil {
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestLabelTemplate_validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    LabelTemplate
+		shouldError bool
+	}{
+		{
+			name:     "valid",
+			template: LabelTemplate{Label: "workload", Template: "{k8s.namespace.name}/{k8s.pod.name}", Transforms: []string{"lowercase", "sanitize"}},
+		},
+		{
+			name:        "invalid label name",
+			template:    LabelTemplate{Label: "work.load", Template: "{k8s.pod.name}"},
+			shouldError: true,
+		},
+		{
+			name:        "empty template",
+			template:    LabelTemplate{Label: "workload"},
+			shouldError: true,
+		},
+		{
+			name:        "unterminated placeholder",
+			template:    LabelTemplate{Label: "workload", Template: "{k8s.namespace.name/{k8s.pod.name}"},
+			shouldError: true,
+		},
+		{
+			name:        "unclosed placeholder",
+			template:    LabelTemplate{Label: "workload", Template: "{k8s.namespace.name"},
+			shouldError: true,
+		},
+		{
+			name:        "empty placeholder",
+			template:    LabelTemplate{Label: "workload", Template: "{}"},
+			shouldError: true,
+		},
+		{
+			name:        "unknown transform",
+			template:    LabelTemplate{Label: "workload", Template: "{k8s.pod.name}", Transforms: []string{"camelcase"}},
+			shouldError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.validate()
+			if tt.shouldError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCompiledLabelTemplate_render(t *testing.T) {
+	logAttrs := pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+		"k8s.pod.name": pdata.NewAttributeValueString("API-Server 7f9c"),
+	})
+	resourceAttrs := pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+		"k8s.namespace.name": pdata.NewAttributeValueString("Prod"),
+		"k8s.pod.name":       pdata.NewAttributeValueString("overridden"),
+	})
+
+	tests := []struct {
+		name      string
+		template  LabelTemplate
+		wantValue model.LabelValue
+		wantOk    bool
+	}{
+		{
+			name:      "combines record and resource attributes",
+			template:  LabelTemplate{Label: "workload", Template: "{k8s.namespace.name}/{k8s.pod.name}"},
+			wantValue: "Prod/API-Server 7f9c",
+			wantOk:    true,
+		},
+		{
+			name:      "applies transforms in order",
+			template:  LabelTemplate{Label: "workload", Template: "{k8s.namespace.name}/{k8s.pod.name}", Transforms: []string{"lowercase", "sanitize"}},
+			wantValue: "prod/api-server_7f9c",
+			wantOk:    true,
+		},
+		{
+			name:      "uses default for missing attributes",
+			template:  LabelTemplate{Label: "workload", Template: "{k8s.namespace.name}/{k8s.container.name}", Default: "unknown"},
+			wantValue: "Prod/unknown",
+			wantOk:    true,
+		},
+		{
+			name:      "uses default when no attribute is resolved",
+			template:  LabelTemplate{Label: "cluster", Template: "{k8s.cluster.name}", Default: "none"},
+			wantValue: "none",
+			wantOk:    true,
+		},
+		{
+			name:     "skipped when no attribute is resolved without default",
+			template: LabelTemplate{Label: "cluster", Template: "cluster-{k8s.cluster.name}"},
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := tt.template.compile()
+			require.NoError(t, err)
+			value, ok := compiled.render(logAttrs, resourceAttrs)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+// defaultOverflowLabels are the labels of the overflow stream when "overflow_labels" is not configured.
+var defaultOverflowLabels = model.LabelSet{"loki_overflow": "true"}
+
+// truncateLabelValues truncates the label values longer than maxLength bytes,
+// without splitting multi-byte characters.
+func truncateLabelValues(labels model.LabelSet, maxLength int) {
+	for name, value := range labels {
+		if len(value) <= maxLength {
+			continue
+		}
+		end := maxLength
+		for end > 0 && !utf8.RuneStart(value[end]) {
+			end--
+		}
+		labels[name] = value[:end]
+	}
+}
+
+// limitStreams keeps the maxStreams streams with the most entries and collapses
+// the entries of the other ones into the overflow stream, which does not count
+// against the limit. It returns the number of entries moved to the overflow stream.
+func limitStreams(pr *logproto.PushRequest, maxStreams int, overflowLabels string) (numOverflowLogs int) {
+	if len(pr.Streams) <= maxStreams {
+		return 0
+	}
+
+	sort.SliceStable(pr.Streams, func(i, j int) bool {
+		if len(pr.Streams[i].Entries) != len(pr.Streams[j].Entries) {
+			return len(pr.Streams[i].Entries) > len(pr.Streams[j].Entries)
+		}
+		return pr.Streams[i].Labels < pr.Streams[j].Labels
+	})
+
+	overflow := logproto.Stream{Labels: overflowLabels}
+	streams := make([]logproto.Stream, 0, maxStreams+1)
+	for _, stream := range pr.Streams {
+		switch {
+		case stream.Labels == overflowLabels:
+			overflow.Entries = append(overflow.Entries, stream.Entries...)
+		case len(streams) < maxStreams:
+			streams = append(streams, stream)
+		default:
+			numOverflowLogs += len(stream.Entries)
+			overflow.Entries = append(overflow.Entries, stream.Entries...)
+		}
+	}
+	pr.Streams = append(streams, overflow)
+
+	return numOverflowLogs
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+func TestTruncateLabelValues(t *testing.T) {
+	labels := model.LabelSet{
+		"short":     "abc",
+		"long":      "abcdefgh",
+		"multibyte": "abcdé",
+	}
+
+	truncateLabelValues(labels, 5)
+
+	assert.Equal(t, model.LabelSet{
+		"short": "abc",
+		"long":  "abcde",
+		// "é" is two bytes long and is not split
+		"multibyte": "abcd",
+	}, labels)
+}
+
+func newLimitsStream(labels string, numEntries int) logproto.Stream {
+	stream := logproto.Stream{Labels: labels}
+	for i := 0; i < numEntries; i++ {
+		stream.Entries = append(stream.Entries, logproto.Entry{Timestamp: time.Unix(int64(i), 0), Line: labels})
+	}
+	return stream
+}
+
+func streamSizes(pr *logproto.PushRequest) map[string]int {
+	sizes := map[string]int{}
+	for _, stream := range pr.Streams {
+		sizes[stream.Labels] = len(stream.Entries)
+	}
+	return sizes
+}
+
+func TestLimitStreams(t *testing.T) {
+	const overflowLabels = `{loki_overflow="true"}`
+
+	tests := []struct {
+		name             string
+		streams          []logproto.Stream
+		maxStreams       int
+		expected         map[string]int
+		expectedOverflow int
+	}{
+		{
+			name:       "under the limit",
+			streams:    []logproto.Stream{newLimitsStream(`{app="a"}`, 1), newLimitsStream(`{app="b"}`, 2)},
+			maxStreams: 2,
+			expected:   map[string]int{`{app="a"}`: 1, `{app="b"}`: 2},
+		},
+		{
+			name: "smallest streams collapsed",
+			streams: []logproto.Stream{
+				newLimitsStream(`{app="a"}`, 1),
+				newLimitsStream(`{app="b"}`, 3),
+				newLimitsStream(`{app="c"}`, 2),
+				newLimitsStream(`{app="d"}`, 1),
+			},
+			maxStreams:       2,
+			expected:         map[string]int{`{app="b"}`: 3, `{app="c"}`: 2, overflowLabels: 2},
+			expectedOverflow: 2,
+		},
+		{
+			name: "existing overflow stream merged",
+			streams: []logproto.Stream{
+				newLimitsStream(`{app="a"}`, 3),
+				newLimitsStream(overflowLabels, 2),
+				newLimitsStream(`{app="b"}`, 1),
+			},
+			maxStreams:       1,
+			expected:         map[string]int{`{app="a"}`: 3, overflowLabels: 3},
+			expectedOverflow: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &logproto.PushRequest{Streams: tt.streams}
+			assert.Equal(t, tt.expectedOverflow, limitStreams(pr, tt.maxStreams, overflowLabels))
+			assert.Equal(t, tt.expected, streamSizes(pr))
+		})
+	}
+}
+
+func TestExporter_logDataToLokiTruncatesLabelValues(t *testing.T) {
+	config := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: validEndpoint,
+		},
+		Labels: LabelsConfig{
+			Attributes: map[string]string{
+				"pod": "pod",
+			},
+		},
+		MaxLabelValueLength: 8,
+	}
+	exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	logs := pdata.NewLogs()
+	for _, pod := range []string{"checkout-7d9f8b-abcde", "checkout-7d9f8b-fghij"} {
+		attrs := pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+			"pod": pdata.NewAttributeValueString(pod),
+		})
+		createLogData(1, attrs).ResourceLogs().MoveAndAppendTo(logs.ResourceLogs())
+	}
+
+	pr, numDroppedLogs := exp.logDataToLoki(logs)
+	assert.Equal(t, 0, numDroppedLogs)
+	// both pods share the same truncated label value
+	assert.Equal(t, map[string]int{`{pod="checkout"}`: 2}, streamSizes(pr))
+}
+
+func TestNewExporterOverflowLabels(t *testing.T) {
+	config := &Config{MaxStreams: 10}
+	exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+	assert.Equal(t, `{loki_overflow="true"}`, exp.overflowLabels)
+
+	config.OverflowLabels = map[string]string{"stream": "overflow"}
+	exp = newExporter(config, componenttest.NewNopTelemetrySettings())
+	assert.Equal(t, `{stream="overflow"}`, exp.overflowLabels)
+}
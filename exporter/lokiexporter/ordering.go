@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+const (
+	// outOfOrderPassthrough sends out-of-order entries as they are.
+	outOfOrderPassthrough = "passthrough"
+	// outOfOrderDrop drops the entries older than the latest one pushed for their stream.
+	outOfOrderDrop = "drop"
+	// outOfOrderClamp sets the timestamp of the entries older than the latest one
+	// pushed for their stream to the timestamp of that latest entry.
+	outOfOrderClamp = "clamp"
+
+	// streamOrderingTTL is how long the latest pushed timestamp of a stream is
+	// remembered after the last push to that stream.
+	streamOrderingTTL = time.Hour
+)
+
+type streamState struct {
+	// latest is the timestamp of the latest entry pushed for the stream.
+	latest time.Time
+	// seen is when the stream was last pushed.
+	seen time.Time
+}
+
+// streamOrdering keeps entries of each stream in timestamp order across pushes,
+// since older versions of Loki reject out-of-order entries for a stream.
+type streamOrdering struct {
+	policy string
+
+	mu      sync.Mutex
+	streams map[string]streamState
+	// For easier unit testing
+	now func() time.Time
+}
+
+func newStreamOrdering(policy string) *streamOrdering {
+	return &streamOrdering{
+		policy:  policy,
+		streams: map[string]streamState{},
+		now:     time.Now,
+	}
+}
+
+// order sorts the entries of each stream by timestamp and applies the policy to
+// the entries older than the latest one already pushed for the stream. Streams
+// left without entries are removed from the request. It returns the number of
+// dropped entries.
+func (o *streamOrdering) order(tenant string, pr *logproto.PushRequest) (numDroppedLogs int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	streams := pr.Streams[:0]
+	for _, stream := range pr.Streams {
+		entries := stream.Entries
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		})
+
+		if state, ok := o.streams[streamKey(tenant, stream.Labels)]; ok {
+			// Entries are sorted, only the leading ones can be out of order.
+			n := sort.Search(len(entries), func(i int) bool {
+				return !entries[i].Timestamp.Before(state.latest)
+			})
+			switch o.policy {
+			case outOfOrderDrop:
+				numDroppedLogs += n
+				entries = entries[n:]
+			case outOfOrderClamp:
+				for i := 0; i < n; i++ {
+					entries[i].Timestamp = state.latest
+				}
+			}
+		}
+
+		if len(entries) == 0 {
+			continue
+		}
+		stream.Entries = entries
+		streams = append(streams, stream)
+	}
+	pr.Streams = streams
+
+	return numDroppedLogs
+}
+
+// commit records the latest timestamp of each stream of a successfully pushed
+// request, and forgets the streams that were not pushed for a while.
+func (o *streamOrdering) commit(tenant string, pr *logproto.PushRequest) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := o.now()
+	for _, stream := range pr.Streams {
+		if len(stream.Entries) == 0 {
+			continue
+		}
+		key := streamKey(tenant, stream.Labels)
+		latest := stream.Entries[len(stream.Entries)-1].Timestamp
+		if state, ok := o.streams[key]; ok && state.latest.After(latest) {
+			latest = state.latest
+		}
+		o.streams[key] = streamState{latest: latest, seen: now}
+	}
+
+	for key, state := range o.streams {
+		if now.Sub(state.seen) >= streamOrderingTTL {
+			delete(o.streams, key)
+		}
+	}
+}
+
+func streamKey(tenant, labels string) string {
+	return tenant + "\x00" + labels
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+func newOrderingPushRequest(labels string, timestamps ...int64) *logproto.PushRequest {
+	entries := make([]logproto.Entry, 0, len(timestamps))
+	for _, ts := range timestamps {
+		entries = append(entries, logproto.Entry{Timestamp: time.Unix(ts, 0), Line: "line"})
+	}
+	return &logproto.PushRequest{
+		Streams: []logproto.Stream{{Labels: labels, Entries: entries}},
+	}
+}
+
+func entryTimestamps(pr *logproto.PushRequest) map[string][]int64 {
+	timestamps := map[string][]int64{}
+	for _, stream := range pr.Streams {
+		for _, entry := range stream.Entries {
+			timestamps[stream.Labels] = append(timestamps[stream.Labels], entry.Timestamp.Unix())
+		}
+	}
+	return timestamps
+}
+
+func TestStreamOrdering_order(t *testing.T) {
+	tests := []struct {
+		name            string
+		policy          string
+		timestamps      []int64
+		expected        map[string][]int64
+		expectedDropped int
+	}{
+		{
+			name:       "drop",
+			policy:     outOfOrderDrop,
+			timestamps: []int64{30, 5, 20, 10},
+			expected:   map[string][]int64{`{app="a"}`: {10, 20, 30}},
+			// 5 is older than the latest pushed timestamp
+			expectedDropped: 1,
+		},
+		{
+			name:       "clamp",
+			policy:     outOfOrderClamp,
+			timestamps: []int64{30, 5, 20, 8},
+			expected:   map[string][]int64{`{app="a"}`: {10, 10, 20, 30}},
+		},
+		{
+			name:            "all entries dropped",
+			policy:          outOfOrderDrop,
+			timestamps:      []int64{1, 2},
+			expected:        map[string][]int64{},
+			expectedDropped: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newStreamOrdering(tt.policy)
+			o.commit("tenant", newOrderingPushRequest(`{app="a"}`, 10))
+
+			pr := newOrderingPushRequest(`{app="a"}`, tt.timestamps...)
+			assert.Equal(t, tt.expectedDropped, o.order("tenant", pr))
+			assert.Equal(t, tt.expected, entryTimestamps(pr))
+		})
+	}
+}
+
+func TestStreamOrdering_orderPerTenantAndStream(t *testing.T) {
+	o := newStreamOrdering(outOfOrderDrop)
+	o.commit("tenant", newOrderingPushRequest(`{app="a"}`, 10))
+
+	pr := newOrderingPushRequest(`{app="b"}`, 5)
+	assert.Equal(t, 0, o.order("tenant", pr))
+	assert.Equal(t, map[string][]int64{`{app="b"}`: {5}}, entryTimestamps(pr))
+
+	pr = newOrderingPushRequest(`{app="a"}`, 5)
+	assert.Equal(t, 0, o.order("other", pr))
+	assert.Equal(t, map[string][]int64{`{app="a"}`: {5}}, entryTimestamps(pr))
+}
+
+func TestStreamOrdering_commit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	o := newStreamOrdering(outOfOrderDrop)
+	o.now = func() time.Time { return now }
+
+	o.commit("tenant", newOrderingPushRequest(`{app="a"}`, 10, 20))
+	assert.Equal(t, time.Unix(20, 0), o.streams[streamKey("tenant", `{app="a"}`)].latest)
+
+	// an older push does not move the latest timestamp back
+	o.commit("tenant", newOrderingPushRequest(`{app="a"}`, 15))
+	assert.Equal(t, time.Unix(20, 0), o.streams[streamKey("tenant", `{app="a"}`)].latest)
+
+	// streams not pushed for a while are forgotten
+	now = now.Add(streamOrderingTTL)
+	o.commit("tenant", newOrderingPushRequest(`{app="b"}`, 10))
+	assert.Len(t, o.streams, 1)
+	assert.Contains(t, o.streams, streamKey("tenant", `{app="b"}`))
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+// streamSequencer delivers the push requests of each stream one at a time, in
+// the order they were received, so that a request retried after a failure is
+// not overtaken by the later requests of its streams.
+type streamSequencer struct {
+	mu sync.Mutex
+	// tails are closed when the last request received for a stream is
+	// delivered, keyed by tenant and stream labels.
+	tails map[string]chan struct{}
+}
+
+func newStreamSequencer() *streamSequencer {
+	return &streamSequencer{tails: map[string]chan struct{}{}}
+}
+
+// wait blocks until all the requests received earlier for the streams of the
+// request are delivered. The returned function must be called once the request
+// is delivered, or given up on, to let the next requests of its streams through.
+// When the context is done first, the next requests of its streams are let
+// through once the earlier requests are delivered.
+func (s *streamSequencer) wait(ctx context.Context, tenant string, pr *logproto.PushRequest) (func(), error) {
+	// Every stream of the request is sequenced at once so that the requests are
+	// ordered the same way on all their streams and can't wait on each other.
+	s.mu.Lock()
+	keys := make([]string, 0, len(pr.Streams))
+	previous := make([]chan struct{}, 0, len(pr.Streams))
+	tails := make([]chan struct{}, 0, len(pr.Streams))
+	for _, stream := range pr.Streams {
+		key := streamKey(tenant, stream.Labels)
+		if prev, ok := s.tails[key]; ok {
+			previous = append(previous, prev)
+		}
+		tail := make(chan struct{})
+		s.tails[key] = tail
+		keys = append(keys, key)
+		tails = append(tails, tail)
+	}
+	s.mu.Unlock()
+
+	done := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, key := range keys {
+			close(tails[i])
+			if s.tails[key] == tails[i] {
+				delete(s.tails, key)
+			}
+		}
+	}
+
+	for i, prev := range previous {
+		select {
+		case <-prev:
+		case <-ctx.Done():
+			// the tails are only closed once the earlier requests are delivered,
+			// so that the later requests of the streams are not let through
+			// before them.
+			go func() {
+				for _, prev := range previous[i:] {
+					<-prev
+				}
+				done()
+			}()
+			return nil, ctx.Err()
+		}
+	}
+	return done, nil
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSequencer_wait(t *testing.T) {
+	s := newStreamSequencer()
+
+	done1, err := s.wait(context.Background(), "tenant", newOrderingPushRequest(`{app="a"}`, 1))
+	require.NoError(t, err)
+
+	// another stream, or the same stream of another tenant, is not held back.
+	done2, err := s.wait(context.Background(), "tenant", newOrderingPushRequest(`{app="b"}`, 1))
+	require.NoError(t, err)
+	done2()
+	done3, err := s.wait(context.Background(), "other", newOrderingPushRequest(`{app="a"}`, 1))
+	require.NoError(t, err)
+	done3()
+
+	delivered := make(chan struct{})
+	go func() {
+		done, err := s.wait(context.Background(), "tenant", newOrderingPushRequest(`{app="a"}`, 2))
+		assert.NoError(t, err)
+		done()
+		close(delivered)
+	}()
+
+	select {
+	case <-delivered:
+		t.Fatal("the later request of the stream was not held back")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	done1()
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("the later request of the stream was not let through")
+	}
+	assert.Empty(t, s.tails)
+}
+
+func TestStreamSequencer_waitCancelled(t *testing.T) {
+	s := newStreamSequencer()
+
+	done, err := s.wait(context.Background(), "tenant", newOrderingPushRequest(`{app="a"}`, 1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.wait(ctx, "tenant", newOrderingPushRequest(`{app="a"}`, 2))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the cancelled request doesn't let the later requests overtake the first one.
+	delivered := make(chan struct{})
+	go func() {
+		laterDone, err := s.wait(context.Background(), "tenant", newOrderingPushRequest(`{app="a"}`, 3))
+		assert.NoError(t, err)
+		laterDone()
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+		t.Fatal("the later request overtook the first request of the stream")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	done()
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("the later request of the stream was not let through")
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+const (
+	lokiPushPath      = "/loki/api/v1/push"
+	lokiBuildInfoPath = "/loki/api/v1/status/buildinfo"
+)
+
+// errStructuredMetadataRejected is returned by a push rejected by Loki because structured metadata is disabled.
+var errStructuredMetadataRejected = errors.New("structured metadata rejected by Loki")
+
+// lokiVersionRE matches the major and minor version of a Loki release, such as "2.9.1" or "v3.0.0".
+var lokiVersionRE = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// StructuredMetadataConfig defines the structured metadata attached to the entries, supported by Loki 2.9 and
+// later. Unlike labels, structured metadata doesn't create streams, so it can carry high cardinality values such
+// as trace IDs.
+type StructuredMetadataConfig struct {
+	// Static are name/value pairs attached to all entries.
+	Static map[string]string `mapstructure:"static"`
+
+	// Attributes maps the log record attributes to structured metadata names, the attribute name being used when
+	// the structured metadata name is empty.
+	Attributes map[string]string `mapstructure:"attributes"`
+
+	// ResourceAttributes maps the resource attributes to structured metadata names, the attribute name being used
+	// when the structured metadata name is empty.
+	ResourceAttributes map[string]string `mapstructure:"resource"`
+
+	// RecordAttributes maps the fields of the record to structured metadata names. Possible keys: traceID, spanID,
+	// severity, severityN.
+	RecordAttributes map[string]string `mapstructure:"record"`
+
+	// DetectSupport checks the version of Loki at start, and stops sending structured metadata when Loki is older
+	// than 2.9 or rejects a push because structured metadata is disabled in its limits.
+	DetectSupport bool `mapstructure:"detect_support"`
+}
+
+func (c *StructuredMetadataConfig) validate() error {
+	if len(c.Static) == 0 && len(c.Attributes) == 0 && len(c.ResourceAttributes) == 0 && len(c.RecordAttributes) == 0 {
+		return fmt.Errorf("\"structured_metadata.static\", \"structured_metadata.attributes\", \"structured_metadata.resource\" or \"structured_metadata.record\" must be configured with at least one entry")
+	}
+
+	invalidNameErr := "the name `%s` in \"structured_metadata.%s\" is not a valid structured metadata name. Names must match " + model.LabelNameRE.String()
+	for name := range c.Static {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf(invalidNameErr, name, "static")
+		}
+	}
+	for section, names := range map[string]map[string]string{"attributes": c.Attributes, "resource": c.ResourceAttributes} {
+		for attr, name := range names {
+			if name == "" {
+				name = attr
+			}
+			if !model.LabelName(name).IsValid() {
+				return fmt.Errorf(invalidNameErr, name, section)
+			}
+		}
+	}
+	for field, name := range c.RecordAttributes {
+		switch field {
+		case "traceID", "spanID", "severity", "severityN":
+		default:
+			return fmt.Errorf("\"structured_metadata.record\" field %q not recognized, possible values: traceID, spanID, severity, severityN", field)
+		}
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf(invalidNameErr, name, "record")
+		}
+	}
+	return nil
+}
+
+// structuredMetadata builds the structured metadata of the entries.
+type structuredMetadata struct {
+	config *StructuredMetadataConfig
+	// attributes and resource map the attribute names to structured metadata names.
+	attributes map[string]string
+	resource   map[string]string
+	// unsupported is set to 1 once Loki is detected not to support structured metadata.
+	unsupported int32
+}
+
+func newStructuredMetadata(config *StructuredMetadataConfig) *structuredMetadata {
+	return &structuredMetadata{
+		config:     config,
+		attributes: structuredMetadataNames(config.Attributes),
+		resource:   structuredMetadataNames(config.ResourceAttributes),
+	}
+}
+
+func structuredMetadataNames(names map[string]string) map[string]string {
+	result := make(map[string]string, len(names))
+	for attr, name := range names {
+		if name == "" {
+			name = attr
+		}
+		result[attr] = name
+	}
+	return result
+}
+
+// enabled reports whether structured metadata is sent, nil meaning it isn't configured.
+func (m *structuredMetadata) enabled() bool {
+	return m != nil && atomic.LoadInt32(&m.unsupported) == 0
+}
+
+// disable stops sending structured metadata, reporting whether it was sent until now.
+func (m *structuredMetadata) disable() bool {
+	return atomic.CompareAndSwapInt32(&m.unsupported, 0, 1)
+}
+
+// pairs returns the structured metadata of a log record, sorted by name. The record fields take precedence over
+// the record attributes, which take precedence over the resource attributes and the static values.
+func (m *structuredMetadata) pairs(lr pdata.LogRecord, res pdata.Resource) []logproto.LabelAdapter {
+	values := make(map[string]string, len(m.config.Static)+len(m.resource)+len(m.attributes)+len(m.config.RecordAttributes))
+	for name, value := range m.config.Static {
+		values[name] = value
+	}
+	for attr, name := range m.resource {
+		if v, ok := res.Attributes().Get(attr); ok {
+			values[name] = v.AsString()
+		}
+	}
+	for attr, name := range m.attributes {
+		if v, ok := lr.Attributes().Get(attr); ok {
+			values[name] = v.AsString()
+		}
+	}
+	for field, name := range m.config.RecordAttributes {
+		switch field {
+		case "traceID":
+			if !lr.TraceID().IsEmpty() {
+				values[name] = lr.TraceID().HexString()
+			}
+		case "spanID":
+			if !lr.SpanID().IsEmpty() {
+				values[name] = lr.SpanID().HexString()
+			}
+		case "severity":
+			if lr.SeverityText() != "" {
+				values[name] = lr.SeverityText()
+			}
+		case "severityN":
+			if lr.SeverityNumber() != pdata.SeverityNumberUNDEFINED {
+				values[name] = lr.SeverityNumber().String()
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	pairs := make([]logproto.LabelAdapter, 0, len(values))
+	for name, value := range values {
+		pairs = append(pairs, logproto.LabelAdapter{Name: name, Value: value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Name < pairs[j].Name })
+	return pairs
+}
+
+// rejected reports whether a failed push was rejected because structured metadata is disabled in the limits of
+// Loki, which is only detected when detect_support is enabled.
+func (m *structuredMetadata) rejected(statusCode int, message string) bool {
+	return m.enabled() && m.config.DetectSupport && statusCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(message), "structured metadata")
+}
+
+// stripStructuredMetadata removes the structured metadata of the entries of a push request.
+func stripStructuredMetadata(pushReq *logproto.PushRequest) {
+	for i := range pushReq.Streams {
+		entries := pushReq.Streams[i].Entries
+		for j := range entries {
+			entries[j].StructuredMetadata = nil
+		}
+	}
+}
+
+// buildInfoURL returns the URL of the build info endpoint of Loki, derived from the push endpoint.
+func buildInfoURL(endpoint string) (string, bool) {
+	if !strings.HasSuffix(endpoint, lokiPushPath) {
+		return "", false
+	}
+	return strings.TrimSuffix(endpoint, lokiPushPath) + lokiBuildInfoPath, true
+}
+
+// supportsStructuredMetadata reports whether a Loki version supports structured metadata. Versions that aren't
+// releases, such as the builds of the main branch, are assumed to support it.
+func supportsStructuredMetadata(version string) bool {
+	match := lokiVersionRE.FindStringSubmatch(version)
+	if match == nil {
+		return true
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major > 2 || (major == 2 && minor >= 9)
+}
+
+// fetchLokiVersion reads the version of Loki from its build info endpoint.
+func fetchLokiVersion(ctx context.Context, client *http.Client, url string, headers map[string]string, tenant string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if len(tenant) > 0 {
+		req.Header.Set("X-Scope-OrgID", tenant)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("HTTP %d %q", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	var buildInfo struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&buildInfo); err != nil {
+		return "", err
+	}
+	return buildInfo.Version, nil
+}
@@ -0,0 +1,249 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/third_party/loki/logproto"
+)
+
+func TestStructuredMetadataConfig_validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config StructuredMetadataConfig
+		err    string
+	}{
+		{
+			name: "valid",
+			config: StructuredMetadataConfig{
+				Static:             map[string]string{"env": "prod"},
+				Attributes:         map[string]string{"http.method": "http_method", "user_id": ""},
+				ResourceAttributes: map[string]string{"host.name": "host"},
+				RecordAttributes:   map[string]string{"traceID": "trace_id", "spanID": "span_id"},
+			},
+		},
+		{
+			name:   "empty",
+			config: StructuredMetadataConfig{DetectSupport: true},
+			err:    "\"structured_metadata.static\", \"structured_metadata.attributes\", \"structured_metadata.resource\" or \"structured_metadata.record\" must be configured with at least one entry",
+		},
+		{
+			name:   "invalid static name",
+			config: StructuredMetadataConfig{Static: map[string]string{"deployment.environment": "prod"}},
+			err:    "the name `deployment.environment` in \"structured_metadata.static\" is not a valid structured metadata name. Names must match ^[a-zA-Z_][a-zA-Z0-9_]*$",
+		},
+		{
+			name:   "invalid attribute name",
+			config: StructuredMetadataConfig{Attributes: map[string]string{"http.method": ""}},
+			err:    "the name `http.method` in \"structured_metadata.attributes\" is not a valid structured metadata name. Names must match ^[a-zA-Z_][a-zA-Z0-9_]*$",
+		},
+		{
+			name:   "unknown record field",
+			config: StructuredMetadataConfig{RecordAttributes: map[string]string{"body": "body"}},
+			err:    "\"structured_metadata.record\" field \"body\" not recognized, possible values: traceID, spanID, severity, severityN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestStructuredMetadata_pairs(t *testing.T) {
+	metadata := newStructuredMetadata(&StructuredMetadataConfig{
+		Static:             map[string]string{"env": "prod", "host": "unknown"},
+		Attributes:         map[string]string{"user_id": "", "retries": "retries"},
+		ResourceAttributes: map[string]string{"host.name": "host"},
+		RecordAttributes:   map[string]string{"traceID": "trace_id", "spanID": "span_id", "severity": "level"},
+	})
+
+	lr := pdata.NewLogRecord()
+	lr.SetTraceID(pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	lr.Attributes().InsertString("user_id", "42")
+	lr.Attributes().InsertInt("retries", 3)
+	res := pdata.NewResource()
+	res.Attributes().InsertString("host.name", "web-1")
+
+	assert.Equal(t, []logproto.LabelAdapter{
+		{Name: "env", Value: "prod"},
+		{Name: "host", Value: "web-1"},
+		{Name: "retries", Value: "3"},
+		{Name: "trace_id", Value: "0102030405060708090a0b0c0d0e0f10"},
+		{Name: "user_id", Value: "42"},
+	}, metadata.pairs(lr, res))
+
+	assert.Nil(t, newStructuredMetadata(&StructuredMetadataConfig{
+		RecordAttributes: map[string]string{"traceID": "trace_id"},
+	}).pairs(pdata.NewLogRecord(), pdata.NewResource()))
+}
+
+func TestSupportsStructuredMetadata(t *testing.T) {
+	for version, expected := range map[string]bool{
+		"2.8.4":        false,
+		"v2.8.0":       false,
+		"1.6.1":        false,
+		"2.9.0":        true,
+		"v2.9.2":       true,
+		"3.0.0":        true,
+		"HEAD-4a5e2d6": true,
+		"":             true,
+	} {
+		assert.Equal(t, expected, supportsStructuredMetadata(version), version)
+	}
+}
+
+func TestBuildInfoURL(t *testing.T) {
+	url, ok := buildInfoURL("https://loki:3100/loki/api/v1/push")
+	assert.True(t, ok)
+	assert.Equal(t, "https://loki:3100/loki/api/v1/status/buildinfo", url)
+
+	_, ok = buildInfoURL("https://gateway/logs")
+	assert.False(t, ok)
+}
+
+// lokiServer records the structured metadata of the first entry of each push.
+type lokiServer struct {
+	*httptest.Server
+	version string
+	// rejectMetadata rejects the pushes with structured metadata like a Loki with it disabled in its limits.
+	rejectMetadata bool
+
+	mu     sync.Mutex
+	pushes [][]logproto.LabelAdapter
+}
+
+func newLokiServer(t *testing.T, version string, rejectMetadata bool) *lokiServer {
+	s := &lokiServer{version: version, rejectMetadata: rejectMetadata}
+	mux := http.NewServeMux()
+	mux.HandleFunc(lokiBuildInfoPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version":%q,"revision":"abc"}`, s.version)
+	})
+	mux.HandleFunc(lokiPushPath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		buf, err := snappy.Decode(nil, body)
+		assert.NoError(t, err)
+		pr := &logproto.PushRequest{}
+		assert.NoError(t, pr.Unmarshal(buf))
+		metadata := pr.Streams[0].Entries[0].StructuredMetadata
+
+		s.mu.Lock()
+		s.pushes = append(s.pushes, metadata)
+		s.mu.Unlock()
+		if s.rejectMetadata && len(metadata) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "stream '{severity=\"debug\"}' includes structured metadata, but this feature is disallowed. Please see `limits_config.allow_structured_metadata` or contact your Loki administrator to enable it.")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func TestExporter_pushLogDataStructuredMetadata(t *testing.T) {
+	traceIDMetadata := []logproto.LabelAdapter{{Name: "trace_id", Value: "0102030405060708090a0b0c0d0e0f10"}}
+	tests := []struct {
+		name           string
+		version        string
+		rejectMetadata bool
+		detectSupport  bool
+		expected       [][]logproto.LabelAdapter
+		expectedErr    bool
+	}{
+		{
+			name:     "supported",
+			version:  "2.9.1",
+			expected: [][]logproto.LabelAdapter{traceIDMetadata, traceIDMetadata},
+		},
+		{
+			name:          "detected unsupported version",
+			version:       "2.8.4",
+			detectSupport: true,
+			expected:      [][]logproto.LabelAdapter{nil, nil},
+		},
+		{
+			name:           "detected rejection",
+			version:        "2.9.1",
+			rejectMetadata: true,
+			detectSupport:  true,
+			expected:       [][]logproto.LabelAdapter{traceIDMetadata, nil, nil},
+		},
+		{
+			name:           "rejection without detection",
+			version:        "2.9.1",
+			rejectMetadata: true,
+			expected:       [][]logproto.LabelAdapter{traceIDMetadata, traceIDMetadata},
+			expectedErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newLokiServer(t, tt.version, tt.rejectMetadata)
+			defer server.Close()
+
+			config := &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: server.URL + lokiPushPath,
+				},
+				Labels: LabelsConfig{
+					Attributes: map[string]string{"severity": "severity"},
+				},
+				StructuredMetadata: &StructuredMetadataConfig{
+					RecordAttributes: map[string]string{"traceID": "trace_id"},
+					DetectSupport:    tt.detectSupport,
+				},
+			}
+			exp := newExporter(config, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+			for i := 0; i < 2; i++ {
+				ld := createLogData(1, pdata.NewAttributeMapFromMap(map[string]pdata.AttributeValue{
+					"severity": pdata.NewAttributeValueString("debug"),
+				}))
+				ld.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(0).SetTraceID(
+					pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+				err := exp.pushLogData(context.Background(), ld)
+				if tt.expectedErr {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+
+			assert.Equal(t, tt.expected, server.pushes)
+		})
+	}
+}
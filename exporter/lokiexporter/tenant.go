@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
+
+import (
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// tenantLogs holds the logs of a single tenant while splitting a batch.
+type tenantLogs struct {
+	logs pdata.Logs
+	// last resource/library index of the source batch copied into logs, used to
+	// append consecutive records to the same resource and library.
+	resource int
+	library  int
+	ills     pdata.InstrumentationLibraryLogs
+}
+
+// splitLogsByTenant partitions the logs per tenant ID. The tenant ID is read from the record attribute
+// named by tenant.attribute, or from the resource attribute with the same name when the record does
+// not have it. Logs without the attribute are assigned to the default tenant.
+func splitLogsByTenant(ld pdata.Logs, attribute string, defaultTenant string) map[string]pdata.Logs {
+	tenants := map[string]*tenantLogs{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceTenant := defaultTenant
+		if v, ok := rl.Resource().Attributes().Get(attribute); ok {
+			resourceTenant = v.AsString()
+		}
+
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			logs := ill.LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				log := logs.At(k)
+
+				tenant := resourceTenant
+				if v, ok := log.Attributes().Get(attribute); ok {
+					tenant = v.AsString()
+				}
+
+				tl, ok := tenants[tenant]
+				if !ok {
+					tl = &tenantLogs{logs: pdata.NewLogs(), resource: -1, library: -1}
+					tenants[tenant] = tl
+				}
+				if tl.resource != i {
+					newRL := tl.logs.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(newRL.Resource())
+					newRL.SetSchemaUrl(rl.SchemaUrl())
+					tl.resource, tl.library = i, -1
+				}
+				if tl.library != j {
+					newRL := tl.logs.ResourceLogs().At(tl.logs.ResourceLogs().Len() - 1)
+					tl.ills = newRL.InstrumentationLibraryLogs().AppendEmpty()
+					ill.InstrumentationLibrary().CopyTo(tl.ills.InstrumentationLibrary())
+					tl.ills.SetSchemaUrl(ill.SchemaUrl())
+					tl.library = j
+				}
+				log.CopyTo(tl.ills.LogRecords().AppendEmpty())
+			}
+		}
+	}
+
+	result := make(map[string]pdata.Logs, len(tenants))
+	for tenant, tl := range tenants {
+		result[tenant] = tl.logs
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lokiexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+func TestSplitLogsByTenant(t *testing.T) {
+	ld := pdata.NewLogs()
+
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString(conventions.AttributeK8SNamespaceName, "team-a")
+	logs := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords()
+	logs.AppendEmpty().Body().SetStringVal("resource tenant")
+	lr := logs.AppendEmpty()
+	lr.Body().SetStringVal("record tenant")
+	lr.Attributes().InsertString(conventions.AttributeK8SNamespaceName, "team-b")
+	logs.AppendEmpty().Body().SetStringVal("resource tenant again")
+
+	rl = ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("other", "value")
+	rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("default tenant")
+
+	tenants := splitLogsByTenant(ld, conventions.AttributeK8SNamespaceName, "fallback")
+	require.Len(t, tenants, 3)
+
+	teamA := tenants["team-a"]
+	assert.Equal(t, 2, teamA.LogRecordCount())
+	require.Equal(t, 1, teamA.ResourceLogs().Len())
+	require.Equal(t, 1, teamA.ResourceLogs().At(0).InstrumentationLibraryLogs().Len())
+	assert.Equal(t, "resource tenant", teamA.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(0).Body().StringVal())
+	assert.Equal(t, "resource tenant again", teamA.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(1).Body().StringVal())
+
+	teamB := tenants["team-b"]
+	assert.Equal(t, 1, teamB.LogRecordCount())
+	v, ok := teamB.ResourceLogs().At(0).Resource().Attributes().Get(conventions.AttributeK8SNamespaceName)
+	require.True(t, ok)
+	assert.Equal(t, "team-a", v.StringVal())
+
+	fallback := tenants["fallback"]
+	assert.Equal(t, 1, fallback.LogRecordCount())
+	assert.Equal(t, "default tenant", fallback.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(0).Body().StringVal())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/correlation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 )
 
@@ -90,6 +91,11 @@ type Config struct {
 	//            And keep `override=true` in resourcedetection config.
 	SyncHostMetadata bool `mapstructure:"sync_host_metadata"`
 
+	// HostMetadataSyncTTL defines how long synchronized host metadata is considered
+	// up to date. Host metadata is synced again only when the host resource attributes
+	// change or the TTL expires. Zero (default) disables the expiry.
+	HostMetadataSyncTTL time.Duration `mapstructure:"host_metadata_sync_ttl"`
+
 	// ExcludeMetrics defines dpfilter.MetricFilters that will determine metrics to be
 	// excluded from sending to SignalFx backend. If translations enabled with
 	// TranslationRules options, the exclusion will be applie on translated metrics.
@@ -109,6 +115,19 @@ type Config struct {
 
 	// MaxConnections is used to set a limit to the maximum idle HTTP connection the exporter can keep open.
 	MaxConnections int `mapstructure:"max_connections"`
+
+	// DisableCompression disables the gzip compression of the datapoints and
+	// events sent to SignalFx.
+	DisableCompression bool `mapstructure:"disable_compression"`
+
+	// CompressionThreshold is the size in bytes above which the datapoints and
+	// events payloads are compressed. Default is 1500, to avoid compressing the
+	// payloads which fit into a single ethernet frame.
+	CompressionThreshold int `mapstructure:"compression_threshold"`
+
+	// TraceMetrics configures the generation of request, error and duration metrics
+	// from the spans received by the traces exporter.
+	TraceMetrics TraceMetricsConfig `mapstructure:"trace_metrics"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -135,14 +154,20 @@ func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
 		return nil, fmt.Errorf("invalid \"%s\": %v", translationRulesConfigKey, err)
 	}
 
+	compressionThreshold := cfg.CompressionThreshold
+	if cfg.DisableCompression {
+		compressionThreshold = -1
+	}
+
 	return &exporterOptions{
-		ingestURL:        ingestURL,
-		apiURL:           apiURL,
-		httpTimeout:      cfg.Timeout,
-		token:            cfg.AccessToken,
-		logDataPoints:    cfg.LogDataPoints,
-		logDimUpdate:     cfg.LogDimensionUpdates,
-		metricTranslator: metricTranslator,
+		ingestURL:            ingestURL,
+		apiURL:               apiURL,
+		httpTimeout:          cfg.Timeout,
+		token:                cfg.AccessToken,
+		logDataPoints:        cfg.LogDataPoints,
+		logDimUpdate:         cfg.LogDimensionUpdates,
+		metricTranslator:     metricTranslator,
+		compressionThreshold: compressionThreshold,
 	}, nil
 }
 
@@ -164,6 +189,26 @@ func (cfg *Config) validateConfig() error {
 		return errors.New(`cannot have a negative "max_connections"`)
 	}
 
+	if cfg.CompressionThreshold < 0 {
+		return errors.New(`cannot have a negative "compression_threshold"`)
+	}
+
+	if cfg.HostMetadataSyncTTL < 0 {
+		return errors.New(`cannot have a negative "host_metadata_sync_ttl"`)
+	}
+
+	if cfg.TraceMetrics.Enabled && cfg.TraceMetrics.FlushInterval <= 0 {
+		return errors.New(`requires a positive "trace_metrics.flush_interval"`)
+	}
+
+	if cfg.TraceMetrics.MaxSeries < 0 {
+		return errors.New(`cannot have a negative "trace_metrics.max_series"`)
+	}
+
+	if err := spanmetrics.ValidateDimensions(cfg.TraceMetrics.Dimensions); err != nil {
+		return fmt.Errorf(`invalid "trace_metrics.dimensions": %w`, err)
+	}
+
 	return nil
 }
 
@@ -33,6 +33,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/correlation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 )
 
@@ -58,6 +59,7 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, defaultCfg, e0)
 
 	e1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "allsettings")]
+	defaultMethod := "GET"
 	expectedCfg := Config{
 		ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "allsettings")),
 		AccessToken:      "testToken",
@@ -178,6 +180,15 @@ func TestLoadConfig(t *testing.T) {
 			},
 		},
 		NonAlphanumericDimensionChars: "_-.",
+		CompressionThreshold:          4096,
+		TraceMetrics: TraceMetricsConfig{
+			Enabled:                 true,
+			FlushInterval:           30 * time.Second,
+			LatencyHistogramBuckets: []time.Duration{10 * time.Millisecond, 100 * time.Millisecond, time.Second},
+			Dimensions:              []spanmetrics.Dimension{{Name: "http.method", Default: &defaultMethod}},
+			MaxSeries:               500,
+			MetricNamePrefix:        "red.",
+		},
 	}
 	assert.Equal(t, &expectedCfg, e1)
 
@@ -201,6 +212,11 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		Headers          map[string]string
 		TranslationRules []translation.Rule
 		SyncHostMetadata bool
+		HostMetadataTTL  time.Duration
+		TraceMetrics     TraceMetricsConfig
+
+		DisableCompression   bool
+		CompressionThreshold int
 	}
 	tests := []struct {
 		name    string
@@ -256,6 +272,63 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Test compression threshold",
+			fields: fields{
+				Realm:                "us0",
+				AccessToken:          "access_token",
+				CompressionThreshold: 4096,
+			},
+			want: &exporterOptions{
+				ingestURL: &url.URL{
+					Scheme: "https",
+					Host:   "ingest.us0.signalfx.com",
+				},
+				apiURL: &url.URL{
+					Scheme: "https",
+					Host:   "api.us0.signalfx.com",
+				},
+				httpTimeout:          5 * time.Second,
+				token:                "access_token",
+				metricTranslator:     emptyTranslator(),
+				compressionThreshold: 4096,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test disabled compression",
+			fields: fields{
+				Realm:                "us0",
+				AccessToken:          "access_token",
+				DisableCompression:   true,
+				CompressionThreshold: 4096,
+			},
+			want: &exporterOptions{
+				ingestURL: &url.URL{
+					Scheme: "https",
+					Host:   "ingest.us0.signalfx.com",
+				},
+				apiURL: &url.URL{
+					Scheme: "https",
+					Host:   "api.us0.signalfx.com",
+				},
+				httpTimeout:          5 * time.Second,
+				token:                "access_token",
+				metricTranslator:     emptyTranslator(),
+				compressionThreshold: -1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test negative compression threshold",
+			fields: fields{
+				Realm:                "us0",
+				AccessToken:          "access_token",
+				CompressionThreshold: -1,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Test empty realm and API URL",
 			fields: fields{
@@ -306,6 +379,50 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test negative host metadata sync TTL",
+			fields: fields{
+				Realm:            "us0",
+				AccessToken:      "access_token",
+				SyncHostMetadata: true,
+				HostMetadataTTL:  -1 * time.Second,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test trace metrics without flush interval",
+			fields: fields{
+				Realm:        "us0",
+				AccessToken:  "access_token",
+				TraceMetrics: TraceMetricsConfig{Enabled: true},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test negative trace metrics max series",
+			fields: fields{
+				Realm:        "us0",
+				AccessToken:  "access_token",
+				TraceMetrics: TraceMetricsConfig{FlushInterval: time.Second, MaxSeries: -1},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test unknown trace metrics dimension normalizer",
+			fields: fields{
+				Realm:       "us0",
+				AccessToken: "access_token",
+				TraceMetrics: TraceMetricsConfig{
+					FlushInterval: time.Second,
+					Dimensions:    []spanmetrics.Dimension{{Name: "http.url", Normalizer: "invalid"}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -321,7 +438,12 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 				Headers:             tt.fields.Headers,
 				TranslationRules:    tt.fields.TranslationRules,
 				SyncHostMetadata:    tt.fields.SyncHostMetadata,
+				HostMetadataSyncTTL: tt.fields.HostMetadataTTL,
 				DeltaTranslationTTL: 3600,
+				TraceMetrics:        tt.fields.TraceMetrics,
+
+				DisableCompression:   tt.fields.DisableCompression,
+				CompressionThreshold: tt.fields.CompressionThreshold,
 			}
 
 			got, err := cfg.getOptionsFromConfig()
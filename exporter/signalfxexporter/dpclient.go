@@ -41,14 +41,17 @@ type sfxClientBase struct {
 	headers   map[string]string
 	client    *http.Client
 	zippers   sync.Pool
+	// compressionThreshold is the size in bytes above which the payloads are
+	// compressed, they are never compressed when it is negative.
+	compressionThreshold int
 }
 
 var metricsMarshaler = otlp.NewJSONMetricsMarshaler()
 
-// avoid attempting to compress things that fit into a single ethernet frame
+// getReader compresses the payloads larger than the compression threshold.
 func (s *sfxClientBase) getReader(b []byte) (io.Reader, bool, error) {
 	var err error
-	if len(b) > 1500 {
+	if s.compressionThreshold >= 0 && len(b) > s.compressionThreshold {
 		buf := new(bytes.Buffer)
 		w := s.zippers.Get().(*gzip.Writer)
 		defer s.zippers.Put(w)
@@ -71,6 +74,7 @@ type sfxDPClient struct {
 	logger                 *zap.Logger
 	accessTokenPassthrough bool
 	converter              *translation.MetricsConverter
+	dpmEstimator           *dpmEstimator
 }
 
 func (s *sfxDPClient) pushMetricsData(
@@ -145,6 +149,13 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 	if err != nil {
 		return len(sfxDataPoints), err
 	}
+
+	if s.dpmEstimator != nil {
+		if accessToken == "" {
+			accessToken = s.headers[splunk.SFxAccessTokenHeader]
+		}
+		s.dpmEstimator.record(ctx, accessToken, len(sfxDataPoints))
+	}
 	return 0, nil
 }
 
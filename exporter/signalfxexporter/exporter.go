@@ -71,6 +71,9 @@ type exporterOptions struct {
 	logDataPoints    bool
 	logDimUpdate     bool
 	metricTranslator *translation.MetricTranslator
+	// compressionThreshold is the size in bytes above which the payloads are
+	// compressed, they are never compressed when it is negative.
+	compressionThreshold int
 }
 
 // newSignalFxExporter returns a new SignalFx exporter.
@@ -108,12 +111,14 @@ func newSignalFxExporter(
 				Timeout:   config.Timeout,
 				Transport: transport,
 			},
-			zippers: newGzipPool(),
+			zippers:              newGzipPool(),
+			compressionThreshold: options.compressionThreshold,
 		},
 		logDataPoints:          options.logDataPoints,
 		logger:                 logger,
 		accessTokenPassthrough: config.AccessTokenPassthrough,
 		converter:              converter,
+		dpmEstimator:           newDPMEstimator(),
 	}
 
 	dimClient := dimensions.NewDimensionClient(
@@ -136,7 +141,7 @@ func newSignalFxExporter(
 
 	var hms *hostmetadata.Syncer
 	if config.SyncHostMetadata {
-		hms = hostmetadata.NewSyncer(logger, dimClient)
+		hms = hostmetadata.NewSyncer(logger, dimClient, config.HostMetadataSyncTTL)
 	}
 
 	return &signalfxExporter{
@@ -178,7 +183,8 @@ func newEventExporter(config *Config, logger *zap.Logger) (*signalfxExporter, er
 				Timeout:   config.Timeout,
 				Transport: transport,
 			},
-			zippers: newGzipPool(),
+			zippers:              newGzipPool(),
+			compressionThreshold: options.compressionThreshold,
 		},
 		logger:                 logger,
 		accessTokenPassthrough: config.AccessTokenPassthrough,
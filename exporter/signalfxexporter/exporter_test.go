@@ -237,6 +237,100 @@ func TestConsumeMetrics(t *testing.T) {
 	}
 }
 
+func TestConsumeMetricsCompression(t *testing.T) {
+	smallBatch := pdata.NewMetrics()
+	m := smallBatch.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("test_gauge")
+	m.SetDataType(pdata.MetricDataTypeGauge)
+	m.Gauge().DataPoints().AppendEmpty().SetDoubleVal(123)
+
+	tests := []struct {
+		name                 string
+		md                   pdata.Metrics
+		compressionThreshold int
+		wantCompressed       bool
+	}{
+		{
+			name:                 "small_batch",
+			md:                   smallBatch,
+			compressionThreshold: defaultCompressionThreshold,
+			wantCompressed:       false,
+		},
+		{
+			name:                 "small_batch_above_threshold",
+			md:                   smallBatch,
+			compressionThreshold: 0,
+			wantCompressed:       true,
+		},
+		{
+			name:                 "large_batch",
+			md:                   generateLargeDPBatch(),
+			compressionThreshold: defaultCompressionThreshold,
+			wantCompressed:       true,
+		},
+		{
+			name:                 "large_batch_compression_disabled",
+			md:                   generateLargeDPBatch(),
+			compressionThreshold: -1,
+			wantCompressed:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := r.Body
+				if tt.wantCompressed {
+					assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+					gzipReader, err := gzip.NewReader(r.Body)
+					require.NoError(t, err)
+					body = gzipReader
+				} else {
+					assert.Empty(t, r.Header.Get("Content-Encoding"))
+				}
+
+				b, err := ioutil.ReadAll(body)
+				require.NoError(t, err)
+				var msg sfxpb.DataPointUploadMessage
+				require.NoError(t, msg.Unmarshal(b))
+				assert.Len(t, msg.Datapoints, tt.md.DataPointCount())
+
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "")
+			require.NoError(t, err)
+			dpClient := &sfxDPClient{
+				sfxClientBase: sfxClientBase{
+					ingestURL: serverURL,
+					headers:   map[string]string{splunk.SFxAccessTokenHeader: "test"},
+					client: &http.Client{
+						Timeout: 1 * time.Second,
+					},
+					zippers:              newGzipPool(),
+					compressionThreshold: tt.compressionThreshold,
+				},
+				logger:       zap.NewNop(),
+				converter:    c,
+				dpmEstimator: newDPMEstimator(),
+			}
+
+			numDroppedTimeSeries, err := dpClient.pushMetricsData(context.Background(), tt.md)
+			require.NoError(t, err)
+			assert.Zero(t, numDroppedTimeSeries)
+
+			var sent int64
+			for _, count := range dpClient.dpmEstimator.windows[accessTokenID("test")].counts {
+				sent += count
+			}
+			assert.EqualValues(t, tt.md.DataPointCount(), sent)
+		})
+	}
+}
+
 func TestConsumeMetricsWithAccessTokenPassthrough(t *testing.T) {
 	fromHeaders := "AccessTokenFromClientHeaders"
 	fromLabels := []string{"AccessTokenFromLabel0", "AccessTokenFromLabel1"}
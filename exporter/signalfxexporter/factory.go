@@ -18,11 +18,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 
@@ -38,10 +42,20 @@ const (
 	typeStr = "signalfx"
 
 	defaultHTTPTimeout = time.Second * 5
+
+	// defaultCompressionThreshold avoids compressing the payloads which fit
+	// into a single ethernet frame.
+	defaultCompressionThreshold = 1500
 )
 
+var once sync.Once
+
 // NewFactory creates a factory for SignalFx exporter.
 func NewFactory() component.ExporterFactory {
+	once.Do(func() {
+		_ = view.Register(MetricViews()...)
+	})
+
 	return exporterhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -64,6 +78,12 @@ func createDefaultConfig() config.Exporter {
 		Correlation:                   correlation.DefaultConfig(),
 		NonAlphanumericDimensionChars: "_-.",
 		MaxConnections:                100,
+		CompressionThreshold:          defaultCompressionThreshold,
+		TraceMetrics: TraceMetricsConfig{
+			FlushInterval:    defaultTraceMetricsFlushInterval,
+			MaxSeries:        defaultTraceMetricsMaxSeries,
+			MetricNamePrefix: defaultTraceMetricsNamePrefix,
+		},
 	}
 }
 
@@ -88,12 +108,38 @@ func createTracesExporter(
 	set.Logger.Info("Correlation tracking enabled", zap.String("endpoint", corrCfg.Endpoint))
 	tracker := correlation.NewTracker(corrCfg, cfg.AccessToken, set)
 
+	if !cfg.TraceMetrics.Enabled {
+		return exporterhelper.NewTracesExporter(
+			cfg,
+			set,
+			tracker.AddSpans,
+			exporterhelper.WithStart(tracker.Start),
+			exporterhelper.WithShutdown(tracker.Shutdown))
+	}
+
+	exp, err := newSignalFxExporter(cfg, set.Logger)
+	if err != nil {
+		return nil, err
+	}
+	set.Logger.Info("Trace metrics enabled", zap.Duration("flush_interval", cfg.TraceMetrics.FlushInterval))
+	emitter := newTraceMetricsEmitter(cfg.TraceMetrics, set.Logger, exp.pushMetricsData)
+
 	return exporterhelper.NewTracesExporter(
 		cfg,
 		set,
-		tracker.AddSpans,
-		exporterhelper.WithStart(tracker.Start),
-		exporterhelper.WithShutdown(tracker.Shutdown))
+		func(ctx context.Context, td pdata.Traces) error {
+			emitter.consumeTraces(td)
+			return tracker.AddSpans(ctx, td)
+		},
+		exporterhelper.WithStart(func(ctx context.Context, host component.Host) error {
+			if err := tracker.Start(ctx, host); err != nil {
+				return err
+			}
+			return emitter.start(ctx, host)
+		}),
+		exporterhelper.WithShutdown(func(ctx context.Context) error {
+			return multierr.Append(emitter.shutdown(ctx), tracker.Shutdown(ctx))
+		}))
 }
 
 func createMetricsExporter(
@@ -15,8 +15,14 @@
 package hostmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/hostmetadata"
 
 import (
+	"context"
+	"hash/fnv"
+	"sort"
 	"sync"
+	"time"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/zap"
 
@@ -25,29 +31,126 @@ import (
 	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 )
 
+// maxSyncedResources bounds the number of synced resources remembered by the
+// syncer, so that the cache does not grow without limit when the TTL is 0.
+const maxSyncedResources = 1000
+
+var (
+	mSyncs        = stats.Int64("signalfx_host_metadata_syncs", "Number of host metadata syncs performed", stats.UnitDimensionless)
+	mSyncsSkipped = stats.Int64("signalfx_host_metadata_syncs_skipped", "Number of host metadata syncs skipped because the host resource did not change and its TTL has not expired", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views of the host metadata syncer.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        mSyncs.Name(),
+			Measure:     mSyncs,
+			Description: mSyncs.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        mSyncsSkipped.Name(),
+			Measure:     mSyncsSkipped,
+			Description: mSyncsSkipped.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
+
 // Syncer is a config structure for host metadata syncer.
 type Syncer struct {
 	logger    *zap.Logger
 	dimClient dimensions.MetadataUpdateClient
-	once      sync.Once
+	// How long a synced host resource is considered up to date. Zero means
+	// the resource is only synced again once its attributes change.
+	ttl time.Duration
+
+	mu sync.Mutex
+	// Last sync time keyed by the hash of the synced resource attributes.
+	synced map[uint64]time.Time
+	// For easier unit testing
+	now func() time.Time
 }
 
 // NewSyncer creates new instance of host metadata syncer.
-func NewSyncer(logger *zap.Logger, dimClient dimensions.MetadataUpdateClient) *Syncer {
+func NewSyncer(logger *zap.Logger, dimClient dimensions.MetadataUpdateClient, ttl time.Duration) *Syncer {
 	return &Syncer{
 		logger:    logger,
 		dimClient: dimClient,
+		ttl:       ttl,
+		synced:    map[uint64]time.Time{},
+		now:       time.Now,
 	}
 }
 
 func (s *Syncer) Sync(md pdata.Metrics) {
-	// skip if already synced or if metrics data is empty
+	// skip if metrics data is empty
 	if md.ResourceMetrics().Len() == 0 {
 		return
 	}
-	s.once.Do(func() {
-		s.syncOnResource(md.ResourceMetrics().At(0).Resource())
+	res := md.ResourceMetrics().At(0).Resource()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// skip if this resource was already synced and its TTL has not expired yet.
+	hash := resourceHash(res)
+	now := s.now()
+	if last, ok := s.synced[hash]; ok && (s.ttl <= 0 || now.Sub(last) < s.ttl) {
+		stats.Record(context.Background(), mSyncsSkipped.M(1))
+		return
+	}
+	s.evict(now)
+	s.synced[hash] = now
+
+	stats.Record(context.Background(), mSyncs.M(1))
+	s.syncOnResource(res)
+}
+
+// evict removes the cached resources which TTL has expired, and the least
+// recently synced one when the cache is full.
+func (s *Syncer) evict(now time.Time) {
+	if s.ttl > 0 {
+		for hash, last := range s.synced {
+			if now.Sub(last) >= s.ttl {
+				delete(s.synced, hash)
+			}
+		}
+	}
+	if len(s.synced) < maxSyncedResources {
+		return
+	}
+	var oldestHash uint64
+	var oldest time.Time
+	for hash, last := range s.synced {
+		if oldest.IsZero() || last.Before(oldest) {
+			oldestHash, oldest = hash, last
+		}
+	}
+	delete(s.synced, oldestHash)
+}
+
+// resourceHash returns a hash of the resource attributes that does not depend
+// on the attributes order.
+func resourceHash(res pdata.Resource) uint64 {
+	attrs := res.Attributes()
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pdata.AttributeValue) bool {
+		keys = append(keys, k)
+		return true
 	})
+	sort.Strings(keys)
+
+	h := fnv.New64a()
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(v.AsString()))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
 }
 
 func (s *Syncer) syncOnResource(res pdata.Resource) {
@@ -18,14 +18,17 @@ import (
 	"context"
 	"errors"
 	"os"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/mem"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
@@ -248,7 +251,7 @@ func TestSyncMetadata(t *testing.T) {
 			observedLogger, logs := observer.New(zapcore.WarnLevel)
 			logger := zap.New(observedLogger)
 			dimClient := &fakeDimClient{fail: tt.pushFail}
-			syncer := NewSyncer(logger, dimClient)
+			syncer := NewSyncer(logger, dimClient, 0)
 
 			// mock system stats calls.
 			os.Setenv("HOST_ETC", ".")
@@ -283,6 +286,66 @@ func TestSyncMetadata(t *testing.T) {
 	}
 }
 
+func TestSyncMetadataOnResourceChange(t *testing.T) {
+	dimClient := &fakeDimClient{}
+	require.NoError(t, view.Register(MetricViews()...))
+	syncs, skipped := syncCount(t, mSyncs.Name()), syncCount(t, mSyncsSkipped.Name())
+
+	syncer := NewSyncer(zap.NewNop(), dimClient, time.Hour)
+	now := time.Now()
+	syncer.now = func() time.Time { return now }
+
+	// mock system stats calls.
+	cpuInfo = func(context.Context) ([]cpu.InfoStat, error) {
+		return []cpu.InfoStat{{Cores: 4, ModelName: "testprocessor"}}, nil
+	}
+	cpuCounts = func(context.Context, bool) (int, error) { return 1, nil }
+	memVirtualMemory = func() (*mem.VirtualMemoryStat, error) {
+		return &mem.VirtualMemoryStat{Total: 100}, nil
+	}
+
+	hostA := generateSampleMetricsData(map[string]string{conventions.AttributeHostName: "host-a"})
+	hostB := generateSampleMetricsData(map[string]string{conventions.AttributeHostName: "host-b"})
+
+	syncer.Sync(hostA)
+	syncer.Sync(hostA)
+	assert.Equal(t, 1, len(dimClient.getMetadataUpdates()))
+	assert.EqualValues(t, skipped+1, syncCount(t, mSyncsSkipped.Name()))
+
+	// resource change triggers a sync
+	syncer.Sync(hostB)
+	assert.Equal(t, 2, len(dimClient.getMetadataUpdates()))
+
+	// TTL expiry triggers a sync
+	now = now.Add(time.Hour)
+	syncer.Sync(hostA)
+	assert.Equal(t, 3, len(dimClient.getMetadataUpdates()))
+	assert.EqualValues(t, syncs+3, syncCount(t, mSyncs.Name()))
+	assert.EqualValues(t, skipped+1, syncCount(t, mSyncsSkipped.Name()))
+}
+
+func TestSyncedResourcesBounded(t *testing.T) {
+	syncer := NewSyncer(zap.NewNop(), &fakeDimClient{}, 0)
+	now := time.Now()
+	syncer.now = func() time.Time { return now }
+
+	for i := 0; i < maxSyncedResources+10; i++ {
+		now = now.Add(time.Second)
+		syncer.Sync(generateSampleMetricsData(map[string]string{"host.id": strconv.Itoa(i)}))
+	}
+	assert.Equal(t, maxSyncedResources, len(syncer.synced))
+}
+
+// syncCount returns the number of syncs recorded so far in the view.
+func syncCount(t *testing.T, name string) float64 {
+	rows, err := view.RetrieveData(name)
+	require.NoError(t, err)
+	if len(rows) == 0 {
+		return 0
+	}
+	return rows[0].Data.(*view.SumData).Value
+}
+
 type fakeDimClient struct {
 	sync.Mutex
 	fail            bool
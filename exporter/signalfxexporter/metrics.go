@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfxexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/hostmetadata"
+)
+
+const (
+	// dpmBuckets buckets of dpmBucketDuration cover the minute over which the
+	// datapoints per minute are estimated.
+	dpmBuckets        = 6
+	dpmBucketDuration = 10 * time.Second
+)
+
+var (
+	tokenIDKey = tag.MustNewKey("token_id")
+
+	mDatapointsPerMinute = stats.Int64("signalfx_datapoints_per_minute", "Estimated number of datapoints sent per minute with the access token", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views of the exporter.
+func MetricViews() []*view.View {
+	views := []*view.View{
+		{
+			Name:        mDatapointsPerMinute.Name(),
+			Measure:     mDatapointsPerMinute,
+			Description: mDatapointsPerMinute.Description(),
+			TagKeys:     []tag.Key{tokenIDKey},
+			Aggregation: view.LastValue(),
+		},
+	}
+	return append(views, hostmetadata.MetricViews()...)
+}
+
+// dpmEstimator estimates the number of datapoints sent per minute with each
+// access token from the datapoints sent during the last minute, so that the
+// usage of the datapoints per minute quotas of SignalFx is visible.
+type dpmEstimator struct {
+	mu      sync.Mutex
+	windows map[string]*dpmWindow
+	now     func() time.Time
+}
+
+// dpmWindow counts the datapoints sent in each bucket of the last minute.
+type dpmWindow struct {
+	counts [dpmBuckets]int64
+	// bucket is the index, since the epoch, of the bucket of the last record.
+	bucket int64
+}
+
+func newDPMEstimator() *dpmEstimator {
+	return &dpmEstimator{
+		windows: make(map[string]*dpmWindow),
+		now:     time.Now,
+	}
+}
+
+// record counts the datapoints sent with the access token and records the
+// estimated datapoints per minute of the token.
+func (e *dpmEstimator) record(ctx context.Context, accessToken string, datapoints int) {
+	tokenID := accessTokenID(accessToken)
+
+	e.mu.Lock()
+	w, ok := e.windows[tokenID]
+	if !ok {
+		w = &dpmWindow{}
+		e.windows[tokenID] = w
+	}
+	bucket := e.now().UnixNano() / int64(dpmBucketDuration)
+	// Reset the buckets which elapsed since the last record.
+	for b := w.bucket + 1; b <= bucket && b <= w.bucket+dpmBuckets; b++ {
+		w.counts[b%dpmBuckets] = 0
+	}
+	if bucket > w.bucket {
+		w.bucket = bucket
+	}
+	w.counts[w.bucket%dpmBuckets] += int64(datapoints)
+
+	var dpm int64
+	for _, count := range w.counts {
+		dpm += count
+	}
+	e.mu.Unlock()
+
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tokenIDKey, tokenID)}, mDatapointsPerMinute.M(dpm))
+}
+
+// accessTokenID identifies an access token in the metrics without revealing
+// it, with the first 8 hexadecimal characters of its SHA-256 hash.
+func accessTokenID(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:4])
+}
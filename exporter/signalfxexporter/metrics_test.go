@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfxexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func TestDPMEstimator(t *testing.T) {
+	// The views are registered with the factory.
+	NewFactory()
+
+	now := time.Unix(0, 0)
+	e := newDPMEstimator()
+	e.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	e.record(ctx, "token0", 100)
+	assert.EqualValues(t, 100, lastDPM(t, "token0"))
+
+	now = now.Add(30 * time.Second)
+	e.record(ctx, "token0", 50)
+	e.record(ctx, "token1", 10)
+	assert.EqualValues(t, 150, lastDPM(t, "token0"))
+	assert.EqualValues(t, 10, lastDPM(t, "token1"))
+
+	// The datapoints sent more than a minute ago are no longer counted.
+	now = now.Add(40 * time.Second)
+	e.record(ctx, "token0", 1)
+	assert.EqualValues(t, 51, lastDPM(t, "token0"))
+
+	now = now.Add(2 * time.Minute)
+	e.record(ctx, "token0", 1)
+	assert.EqualValues(t, 1, lastDPM(t, "token0"))
+	assert.EqualValues(t, 10, lastDPM(t, "token1"))
+}
+
+func TestAccessTokenID(t *testing.T) {
+	assert.Equal(t, "9f86d081", accessTokenID("test"))
+	assert.NotEqual(t, accessTokenID("token0"), accessTokenID("token1"))
+}
+
+// lastDPM returns the last recorded datapoints per minute of the access token.
+func lastDPM(t *testing.T, accessToken string) float64 {
+	rows, err := view.RetrieveData(mDatapointsPerMinute.Name())
+	require.NoError(t, err)
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			if tag.Key == tokenIDKey && tag.Value == accessTokenID(accessToken) {
+				return row.Data.(*view.LastValueData).Value
+			}
+		}
+	}
+	t.Fatalf("no datapoints per minute recorded for %q", accessToken)
+	return 0
+}
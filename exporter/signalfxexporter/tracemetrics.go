@@ -0,0 +1,134 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package signalfxexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
+)
+
+const (
+	defaultTraceMetricsFlushInterval = 10 * time.Second
+	defaultTraceMetricsMaxSeries     = 1000
+	defaultTraceMetricsNamePrefix    = "spans."
+)
+
+// TraceMetricsConfig defines the configuration of the metrics generated from
+// the spans received by the traces exporter.
+type TraceMetricsConfig struct {
+	// Enabled turns on the generation of request, error and duration metrics per
+	// service, operation, span kind and status code from the exported spans.
+	Enabled bool `mapstructure:"enabled"`
+
+	// FlushInterval is the interval at which the aggregated metrics are sent.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// LatencyHistogramBuckets is the list of durations representing the duration histogram buckets.
+	LatencyHistogramBuckets []time.Duration `mapstructure:"latency_histogram_buckets"`
+
+	// Dimensions defines the list of additional dimensions fetched from the span
+	// or resource attributes.
+	Dimensions []spanmetrics.Dimension `mapstructure:"dimensions"`
+
+	// MaxSeries limits the number of series aggregated during a flush interval,
+	// spans of new series are dropped once reached. Zero means no limit.
+	MaxSeries int `mapstructure:"max_series"`
+
+	// MetricNamePrefix is prepended to the names of the generated metrics.
+	MetricNamePrefix string `mapstructure:"metric_name_prefix"`
+}
+
+// traceMetricsEmitter aggregates the exported spans and periodically sends the
+// resulting metrics to SignalFx.
+type traceMetricsEmitter struct {
+	logger          *zap.Logger
+	interval        time.Duration
+	aggregator      *spanmetrics.Aggregator
+	pushMetricsData func(ctx context.Context, md pdata.Metrics) (droppedTimeSeries int, err error)
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newTraceMetricsEmitter(
+	cfg TraceMetricsConfig,
+	logger *zap.Logger,
+	pushMetricsData func(ctx context.Context, md pdata.Metrics) (droppedTimeSeries int, err error),
+) *traceMetricsEmitter {
+	return &traceMetricsEmitter{
+		logger:   logger,
+		interval: cfg.FlushInterval,
+		aggregator: spanmetrics.NewAggregator(spanmetrics.AggregatorSettings{
+			MetricNamePrefix: cfg.MetricNamePrefix,
+			LatencyBounds:    spanmetrics.LatencyBounds(cfg.LatencyHistogramBuckets),
+			Dimensions:       cfg.Dimensions,
+			MaxSeries:        cfg.MaxSeries,
+			Temporality:      pdata.MetricAggregationTemporalityDelta,
+		}),
+		pushMetricsData: pushMetricsData,
+		done:            make(chan struct{}),
+	}
+}
+
+// consumeTraces aggregates the spans of the given traces.
+func (e *traceMetricsEmitter) consumeTraces(td pdata.Traces) {
+	if dropped := e.aggregator.Aggregate(td); dropped > 0 {
+		e.logger.Debug("Max series reached, spans not aggregated into trace metrics", zap.Int("dropped_spans", dropped))
+	}
+}
+
+func (e *traceMetricsEmitter) start(context.Context, component.Host) error {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				e.flush(context.Background())
+			case <-e.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (e *traceMetricsEmitter) shutdown(ctx context.Context) error {
+	close(e.done)
+	e.wg.Wait()
+	// Send what was aggregated since the last flush.
+	e.flush(ctx)
+	return nil
+}
+
+func (e *traceMetricsEmitter) flush(ctx context.Context) {
+	md := pdata.NewMetrics()
+	ilm := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
+	e.aggregator.Metrics(ilm)
+	if ilm.Metrics().Len() == 0 {
+		return
+	}
+	if _, err := e.pushMetricsData(ctx, md); err != nil {
+		e.logger.Warn("Failed to send trace metrics", zap.Error(err))
+	}
+}
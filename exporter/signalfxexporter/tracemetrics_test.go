@@ -0,0 +1,119 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package signalfxexporter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func newTestSpans(serviceName string, names ...string) pdata.Traces {
+	traces := pdata.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", serviceName)
+	ils := rs.InstrumentationLibrarySpans().AppendEmpty()
+	for _, name := range names {
+		span := ils.Spans().AppendEmpty()
+		span.SetName(name)
+		span.SetStartTimestamp(pdata.NewTimestampFromTime(time.Now()))
+		span.SetEndTimestamp(pdata.NewTimestampFromTime(time.Now().Add(time.Millisecond)))
+	}
+	return traces
+}
+
+func TestTraceMetricsEmitter(t *testing.T) {
+	var mu sync.Mutex
+	var pushed []pdata.Metrics
+	push := func(_ context.Context, md pdata.Metrics) (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		pushed = append(pushed, md)
+		return 0, nil
+	}
+
+	cfg := createDefaultConfig().(*Config).TraceMetrics
+	cfg.FlushInterval = time.Hour
+	emitter := newTraceMetricsEmitter(cfg, zap.NewNop(), push)
+	require.NoError(t, emitter.start(context.Background(), componenttest.NewNopHost()))
+
+	emitter.consumeTraces(newTestSpans("svc", "op1", "op2", "op1"))
+
+	// Shutdown flushes what was aggregated since the last flush.
+	require.NoError(t, emitter.shutdown(context.Background()))
+	require.Len(t, pushed, 1)
+
+	metrics := pushed[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+	assert.Equal(t, "spans.calls_total", metrics.At(0).Name())
+	assert.Equal(t, pdata.MetricAggregationTemporalityDelta, metrics.At(0).Sum().AggregationTemporality())
+	assert.Equal(t, 2, metrics.At(0).Sum().DataPoints().Len())
+	assert.Equal(t, "spans.errors_total", metrics.At(1).Name())
+	assert.Equal(t, "spans.latency", metrics.At(2).Name())
+}
+
+func TestTraceMetricsEmitterNothingToFlush(t *testing.T) {
+	pushes := 0
+	push := func(_ context.Context, md pdata.Metrics) (int, error) {
+		pushes++
+		return 0, nil
+	}
+
+	emitter := newTraceMetricsEmitter(createDefaultConfig().(*Config).TraceMetrics, zap.NewNop(), push)
+	emitter.flush(context.Background())
+	assert.Zero(t, pushes)
+}
+
+func TestCreateTracesExporterWithTraceMetrics(t *testing.T) {
+	received := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/datapoint" {
+			select {
+			case received <- struct{}{}:
+			default:
+			}
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.AccessToken = "access_token"
+	cfg.IngestURL = server.URL
+	cfg.APIURL = server.URL
+	cfg.TraceMetrics.Enabled = true
+
+	te, err := createTracesExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, te)
+
+	require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, te.ConsumeTraces(context.Background(), newTestSpans("svc", "op")))
+	assert.NoError(t, te.Shutdown(context.Background()))
+
+	select {
+	case <-received:
+	default:
+		t.Fatal("trace metrics were not sent on shutdown")
+	}
+}
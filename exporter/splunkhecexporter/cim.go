@@ -0,0 +1,65 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// cimFields maps the semantic conventions attributes to the Splunk Common Information Model fields, see
+// https://docs.splunk.com/Documentation/CIM/latest/User/Overview. The network attributes are mapped from the
+// point of view of the server handling the request, as in the Web and Network Traffic data models: the peer is
+// the source and the host the destination.
+var cimFields = map[string]string{
+	conventions.AttributeHTTPMethod:                "http_method",
+	conventions.AttributeHTTPStatusCode:            "status",
+	conventions.AttributeHTTPURL:                   "url",
+	conventions.AttributeHTTPTarget:                "uri_path",
+	conventions.AttributeHTTPHost:                  "site",
+	conventions.AttributeHTTPUserAgent:             "http_user_agent",
+	conventions.AttributeHTTPRequestContentLength:  "bytes_in",
+	conventions.AttributeHTTPResponseContentLength: "bytes_out",
+	conventions.AttributeNetPeerName:               "src",
+	conventions.AttributeNetPeerIP:                 "src_ip",
+	conventions.AttributeNetPeerPort:               "src_port",
+	conventions.AttributeNetHostName:               "dest",
+	conventions.AttributeNetHostIP:                 "dest_ip",
+	conventions.AttributeNetHostPort:               "dest_port",
+	conventions.AttributeNetTransport:              "transport",
+	conventions.AttributeEnduserID:                 "user",
+	conventions.AttributeK8SClusterName:            "kubernetes_cluster",
+	conventions.AttributeK8SNamespaceName:          "kubernetes_namespace",
+	conventions.AttributeK8SNodeName:               "kubernetes_node",
+	conventions.AttributeK8SPodName:                "kubernetes_pod_name",
+	conventions.AttributeK8SPodUID:                 "kubernetes_pod_uid",
+	conventions.AttributeK8SContainerName:          "container_name",
+	conventions.AttributeK8SDeploymentName:         "kubernetes_deployment",
+}
+
+// toCIMFields renames the fields named after a semantic conventions attribute to their Splunk Common Information
+// Model name. A field is left untouched when the CIM field is already set.
+func toCIMFields(fields map[string]interface{}) {
+	for attribute, field := range cimFields {
+		value, ok := fields[attribute]
+		if !ok {
+			continue
+		}
+		if _, exists := fields[field]; exists {
+			continue
+		}
+		delete(fields, attribute)
+		fields[field] = value
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func Test_toCIMFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		want   map[string]interface{}
+	}{
+		{
+			name:   "empty",
+			fields: map[string]interface{}{},
+			want:   map[string]interface{}{},
+		},
+		{
+			name: "renamed",
+			fields: map[string]interface{}{
+				"http.method":        "GET",
+				"http.status_code":   int64(200),
+				"net.peer.ip":        "10.0.0.1",
+				"net.host.port":      int64(8080),
+				"k8s.namespace.name": "default",
+				"custom":             "value",
+			},
+			want: map[string]interface{}{
+				"http_method":          "GET",
+				"status":               int64(200),
+				"src_ip":               "10.0.0.1",
+				"dest_port":            int64(8080),
+				"kubernetes_namespace": "default",
+				"custom":               "value",
+			},
+		},
+		{
+			name: "existing CIM field",
+			fields: map[string]interface{}{
+				"http.method": "GET",
+				"http_method": "POST",
+			},
+			want: map[string]interface{}{
+				"http.method": "GET",
+				"http_method": "POST",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toCIMFields(tt.fields)
+			assert.Equal(t, tt.want, tt.fields)
+		})
+	}
+}
+
+func TestReceiveLogsWithCIMFields(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		logs := pdata.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().InsertString("k8s.pod.name", "mypod")
+		lr := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Body().SetStringVal("mylog")
+		lr.Attributes().InsertString("http.method", "GET")
+
+		cfg := NewFactory().CreateDefaultConfig().(*Config)
+		cfg.DisableCompression = true
+		cfg.CIMFields.Logs = enabled
+		actual, err := runLogExport(cfg, logs, t)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		msg := string(actual[0])
+		if enabled {
+			assert.Contains(t, msg, `"http_method":"GET"`)
+			assert.Contains(t, msg, `"kubernetes_pod_name":"mypod"`)
+			assert.NotContains(t, msg, `"http.method"`)
+		} else {
+			assert.Contains(t, msg, `"http.method":"GET"`)
+			assert.Contains(t, msg, `"k8s.pod.name":"mypod"`)
+		}
+	}
+}
+
+func TestReceiveMetricsWithCIMFields(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		metrics := pdata.NewMetrics()
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().InsertString("k8s.cluster.name", "mycluster")
+		m := rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("http.server.requests")
+		m.SetDataType(pdata.MetricDataTypeGauge)
+		dp := m.Gauge().DataPoints().AppendEmpty()
+		dp.SetIntVal(12)
+		dp.Attributes().InsertInt("http.status_code", 200)
+
+		cfg := NewFactory().CreateDefaultConfig().(*Config)
+		cfg.DisableCompression = true
+		cfg.CIMFields.Metrics = enabled
+		actual, err := runMetricsExport(cfg, metrics, t)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		msg := string(actual[0])
+		if enabled {
+			assert.Contains(t, msg, `"status":"200"`)
+			assert.Contains(t, msg, `"kubernetes_cluster":"mycluster"`)
+		} else {
+			assert.Contains(t, msg, `"http.status_code":"200"`)
+			assert.Contains(t, msg, `"k8s.cluster.name":"mycluster"`)
+		}
+	}
+}
+
+func Test_traceDataToSplunkWithCIMFields(t *testing.T) {
+	traces := pdata.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("k8s.namespace.name", "default")
+	span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("myspan")
+	span.Attributes().InsertString("http.url", "http://example.com/index.html")
+	span.Attributes().InsertString("net.peer.name", "client.example.com")
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CIMFields.Traces = true
+	events, dropped := traceDataToSplunk(zap.NewNop(), traces, cfg)
+	require.Equal(t, 0, dropped)
+	require.Len(t, events, 1)
+
+	assert.Equal(t, map[string]interface{}{"kubernetes_namespace": "default"}, events[0].Fields)
+	assert.Equal(t, map[string]interface{}{
+		"url": "http://example.com/index.html",
+		"src": "client.example.com",
+	}, events[0].Event.(hecSpan).Attributes)
+}
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"go.opentelemetry.io/collector/component"
@@ -127,15 +128,20 @@ func (c *client) pushTraceData(
 		return nil
 	}
 
-	return c.sendSplunkEvents(ctx, splunkEvents)
+	var headers map[string]string
+	if c.config.TracesFormat == tracesFormatSplunkAPM {
+		headers = apmHeaders
+	}
+
+	return c.sendSplunkEvents(ctx, splunkEvents, headers)
 }
 
-func (c *client) sendSplunkEvents(ctx context.Context, splunkEvents []*splunk.Event) error {
+func (c *client) sendSplunkEvents(ctx context.Context, splunkEvents []*splunk.Event, headers map[string]string) error {
 	body, compressed, err := encodeBodyEvents(&c.zippers, splunkEvents, c.config.DisableCompression)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
-	return c.postEvents(ctx, body, nil, compressed)
+	return c.postEvents(ctx, body, headers, compressed)
 }
 
 func (c *client) pushLogData(ctx context.Context, ld pdata.Logs) error {
@@ -195,6 +201,12 @@ var profilingHeaders = map[string]string{
 	libraryHeaderName: profilingLibraryName,
 }
 
+const tracesFormatHeaderName = "X-Splunk-Traces-Format"
+
+var apmHeaders = map[string]string{
+	tracesFormatHeaderName: tracesFormatSplunkAPM,
+}
+
 func isProfilingData(ill pdata.InstrumentationLibraryLogs) bool {
 	return ill.InstrumentationLibrary().Name() == profilingLibraryName
 }
@@ -271,6 +283,7 @@ func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice
 	res := lds.At(state.resource)
 	logs := res.InstrumentationLibraryLogs().At(state.library).LogRecords()
 	bufCap := int(c.config.MaxContentLengthLogs)
+	received := time.Now()
 
 	for k := 0; k < logs.Len(); k++ {
 		if state.bufFront == nil {
@@ -279,6 +292,13 @@ func (c *client) pushLogRecords(ctx context.Context, lds pdata.ResourceLogsSlice
 
 		// Parsing log record to Splunk event.
 		event := mapLogRecordToSplunkEvent(res.Resource(), logs.At(k), c.config, c.logger)
+		if c.config.CIMFields.Logs {
+			toCIMFields(event.Fields)
+		}
+		if !correctEventTime(event, logs.At(k).Timestamp(), c.config.Timestamps, received) {
+			c.logger.Debug("Dropped log record without timestamp")
+			continue
+		}
 		// JSON encoding event and writing to buffer.
 		b, err := jsoniter.Marshal(event)
 		if err != nil {
@@ -345,6 +365,9 @@ func (c *client) pushMetricsRecords(ctx context.Context, mds pdata.ResourceMetri
 		// Parsing metric record to Splunk event.
 		events := mapMetricToSplunkEvent(res.Resource(), metrics.At(k), c.config, c.logger)
 		for _, event := range events {
+			if c.config.CIMFields.Metrics {
+				toCIMFields(event.Fields)
+			}
 			// JSON encoding event and writing to buffer.
 			b, err := jsoniter.Marshal(event)
 			if err != nil {
@@ -712,7 +712,7 @@ func TestInvalidJsonClient(t *testing.T) {
 		}},
 		config: &Config{},
 	}
-	err := c.sendSplunkEvents(context.Background(), evs)
+	err := c.sendSplunkEvents(context.Background(), evs, nil)
 	assert.EqualError(t, err, "Permanent error: splunk.Event.Event: splunkhecexporter.badJSON.Foo: unsupported value: +Inf")
 }
 
@@ -724,7 +724,7 @@ func TestInvalidURLClient(t *testing.T) {
 		}},
 		config: &Config{},
 	}
-	err := c.sendSplunkEvents(context.Background(), []*splunk.Event{})
+	err := c.sendSplunkEvents(context.Background(), []*splunk.Event{}, nil)
 	assert.EqualError(t, err, "Permanent error: parse \"//in%20va%20lid\": invalid URL escape \"%20\"")
 }
 
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -32,6 +33,20 @@ const (
 	hecPath                      = "services/collector"
 	maxContentLengthLogsLimit    = 2 * 1024 * 1024
 	maxContentLengthMetricsLimit = 2 * 1024 * 1024
+
+	// tracesFormatOTel exports spans using the generic OpenTelemetry JSON layout.
+	tracesFormatOTel = "otel"
+	// tracesFormatSplunkAPM exports spans using the field layout expected by Splunk APM dashboards.
+	tracesFormatSplunkAPM = "splunk_apm"
+	// tracesFormatSAPM exports traces to Splunk Observability APM using the SAPM protocol.
+	tracesFormatSAPM = "sapm"
+
+	// missingTimestampIndexingTime omits the time of the events without timestamp, the HEC using the indexing time.
+	missingTimestampIndexingTime = "indexing_time"
+	// missingTimestampReceiveTime sets the time of the events without timestamp to the time the exporter received them.
+	missingTimestampReceiveTime = "receive_time"
+	// missingTimestampDrop drops the events without timestamp.
+	missingTimestampDrop = "drop"
 )
 
 // OtelToHecFields defines the mapping of attributes to HEC fields
@@ -44,6 +59,28 @@ type OtelToHecFields struct {
 	Name string `mapstructure:"name"`
 }
 
+// TimestampSettings defines how the timestamps of the log records are corrected before being sent as event times.
+type TimestampSettings struct {
+	// Missing selects what to do with the log records without timestamp: "indexing_time" (default) lets the HEC use
+	// the indexing time, "receive_time" uses the time the exporter received them and "drop" drops them.
+	Missing string `mapstructure:"missing"`
+	// MaxFuture clamps the timestamps later than the receive time by more than MaxFuture. Zero disables the clamp.
+	MaxFuture time.Duration `mapstructure:"max_future"`
+	// MaxPast clamps the timestamps earlier than the receive time by more than MaxPast. Zero disables the clamp.
+	MaxPast time.Duration `mapstructure:"max_past"`
+}
+
+// CIMFieldsSettings toggles per signal the renaming of the semantic conventions attributes sent as HEC fields to their
+// Splunk Common Information Model name, such as http.method to http_method.
+type CIMFieldsSettings struct {
+	// Logs renames the fields of the log events.
+	Logs bool `mapstructure:"logs"`
+	// Metrics renames the dimensions of the metric events.
+	Metrics bool `mapstructure:"metrics"`
+	// Traces renames the fields of the span events and, with the "otel" traces format, the span attributes.
+	Traces bool `mapstructure:"traces"`
+}
+
 // Config defines configuration for Splunk exporter.
 type Config struct {
 	config.ExporterSettings        `mapstructure:",squash"`
@@ -91,6 +128,18 @@ type Config struct {
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
 	// HecFields creates a mapping from attributes to HEC fields.
 	HecFields OtelToHecFields `mapstructure:"otel_to_hec_fields"`
+	// TracesFormat selects the span event layout: "otel" (default) or "splunk_apm", or "sapm" to send the
+	// traces to SAPMEndpoint instead of HEC.
+	TracesFormat string `mapstructure:"traces_format"`
+	// SAPMEndpoint is the Splunk Observability APM endpoint the traces are sent to when TracesFormat is "sapm",
+	// e.g. https://ingest.us0.signalfx.com/v2/trace. The token is used as access token.
+	SAPMEndpoint string `mapstructure:"sapm_endpoint"`
+	// Timestamps configures the correction of the log record timestamps, which the HEC would otherwise silently
+	// index out of the searched time ranges when missing or skewed.
+	Timestamps TimestampSettings `mapstructure:"timestamps"`
+	// CIMFields configures the mapping of the semantic conventions attributes to the Splunk Common Information Model
+	// fields, so that the Splunk Enterprise Security content works with the exported data. Disabled by default.
+	CIMFields CIMFieldsSettings `mapstructure:"cim_fields"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -126,6 +175,33 @@ func (cfg *Config) validateConfig() error {
 		return fmt.Errorf(`requires "max_content_length_metrics" <= %d`, maxContentLengthMetricsLimit)
 	}
 
+	switch cfg.TracesFormat {
+	case "", tracesFormatOTel, tracesFormatSplunkAPM:
+	case tracesFormatSAPM:
+		if cfg.SAPMEndpoint == "" {
+			return fmt.Errorf(`requires a non-empty "sapm_endpoint" when "traces_format" is %q`, tracesFormatSAPM)
+		}
+		if _, err := url.Parse(cfg.SAPMEndpoint); err != nil {
+			return fmt.Errorf(`invalid "sapm_endpoint": %v`, err)
+		}
+	default:
+		return fmt.Errorf(`unsupported "traces_format" %q, must be one of %q, %q or %q`, cfg.TracesFormat, tracesFormatOTel, tracesFormatSplunkAPM, tracesFormatSAPM)
+	}
+
+	switch cfg.Timestamps.Missing {
+	case "", missingTimestampIndexingTime, missingTimestampReceiveTime, missingTimestampDrop:
+	default:
+		return fmt.Errorf(`unsupported "timestamps::missing" %q, must be one of %q, %q or %q`, cfg.Timestamps.Missing, missingTimestampIndexingTime, missingTimestampReceiveTime, missingTimestampDrop)
+	}
+
+	if cfg.Timestamps.MaxFuture < 0 {
+		return errors.New(`requires "timestamps::max_future" >= 0`)
+	}
+
+	if cfg.Timestamps.MaxPast < 0 {
+		return errors.New(`requires "timestamps::max_past" >= 0`)
+	}
+
 	return nil
 }
 
@@ -97,6 +97,16 @@ func TestLoadConfig(t *testing.T) {
 			SeverityNumber: "myseveritynumfield",
 			Name:           "mynamefield",
 		},
+		TracesFormat: "splunk_apm",
+		Timestamps: TimestampSettings{
+			Missing:   "receive_time",
+			MaxFuture: time.Hour,
+			MaxPast:   30 * 24 * time.Hour,
+		},
+		CIMFields: CIMFieldsSettings{
+			Logs:   true,
+			Traces: true,
+		},
 	}
 	assert.Equal(t, &expectedCfg, e1)
 
@@ -115,6 +125,9 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		Index                   string
 		MaxContentLengthLogs    uint
 		MaxContentLengthMetrics uint
+		TracesFormat            string
+		SAPMEndpoint            string
+		Timestamps              TimestampSettings
 	}
 	tests := []struct {
 		name    string
@@ -179,6 +192,74 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test unsupported traces format",
+			fields: fields{
+				Token:        "1234",
+				Endpoint:     "https://example.com:8000",
+				TracesFormat: "zipkin",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test sapm traces format without endpoint",
+			fields: fields{
+				Token:        "1234",
+				Endpoint:     "https://example.com:8000",
+				TracesFormat: "sapm",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test sapm traces format",
+			fields: fields{
+				Token:        "1234",
+				Endpoint:     "https://example.com:8000",
+				TracesFormat: "sapm",
+				SAPMEndpoint: "https://ingest.us0.signalfx.com/v2/trace",
+			},
+			want: &exporterOptions{
+				token: "1234",
+				url: &url.URL{
+					Scheme: "https",
+					Host:   "example.com:8000",
+					Path:   "services/collector",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test unsupported missing timestamp handling",
+			fields: fields{
+				Token:      "1234",
+				Endpoint:   "https://example.com:8000",
+				Timestamps: TimestampSettings{Missing: "now"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test negative max future",
+			fields: fields{
+				Token:      "1234",
+				Endpoint:   "https://example.com:8000",
+				Timestamps: TimestampSettings{MaxFuture: -time.Hour},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test negative max past",
+			fields: fields{
+				Token:      "1234",
+				Endpoint:   "https://example.com:8000",
+				Timestamps: TimestampSettings{MaxPast: -time.Hour},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -190,6 +271,9 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 				Index:                   tt.fields.Index,
 				MaxContentLengthLogs:    tt.fields.MaxContentLengthLogs,
 				MaxContentLengthMetrics: tt.fields.MaxContentLengthMetrics,
+				TracesFormat:            tt.fields.TracesFormat,
+				SAPMEndpoint:            tt.fields.SAPMEndpoint,
+				Timestamps:              tt.fields.Timestamps,
 			}
 			got, err := cfg.getOptionsFromConfig()
 			if (err != nil) != tt.wantErr {
@@ -86,13 +86,28 @@ func createExporter(
 		return nil, err
 	}
 
-	return &splunkExporter{
+	exp := &splunkExporter{
 		pushMetricsData: client.pushMetricsData,
 		pushTraceData:   client.pushTraceData,
 		pushLogData:     client.pushLogData,
 		stop:            client.stop,
 		start:           client.start,
-	}, nil
+	}
+
+	if config.TracesFormat == tracesFormatSAPM {
+		sapm, err := newSAPMClient(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the SAPM client: %w", err)
+		}
+		exp.pushTraceData = sapm.pushTraceData
+		exp.stop = func(ctx context.Context) error {
+			err := client.stop(ctx)
+			_ = sapm.stop(ctx)
+			return err
+		}
+	}
+
+	return exp, nil
 }
 
 func buildClient(options *exporterOptions, config *Config, logger *zap.Logger) (*client, error) {
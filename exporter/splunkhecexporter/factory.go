@@ -81,6 +81,10 @@ func createDefaultConfig() config.Exporter {
 			SeverityNumber: splunk.DefaultSeverityNumberLabel,
 			Name:           splunk.DefaultNameLabel,
 		},
+		TracesFormat: tracesFormatOTel,
+		Timestamps: TimestampSettings{
+			Missing: missingTimestampIndexingTime,
+		},
 	}
 }
 
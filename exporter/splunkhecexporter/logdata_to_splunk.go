@@ -29,6 +29,11 @@ const (
 	spanIDFieldKey = "span_id"
 	// traceIDFieldKey is the key used in the log event for the trace id (if any).
 	traceIDFieldKey = "trace_id"
+	// timeCorrectionFieldKey is the key used in the log event for the correction applied to its time (if any):
+	// "missing" when the receive time is used for a record without timestamp, "future" or "past" when clamped.
+	timeCorrectionFieldKey = "time_correction"
+	// originalTimeFieldKey is the key used in the log event for its original time, when clamped.
+	originalTimeFieldKey = "original_time"
 )
 
 func mapLogRecordToSplunkEvent(res pdata.Resource, lr pdata.LogRecord, config *Config, logger *zap.Logger) *splunk.Event {
@@ -139,6 +144,36 @@ func convertAttributeValue(value pdata.AttributeValue, logger *zap.Logger) inter
 	}
 }
 
+// correctEventTime applies the timestamp settings to the time of the event mapped from a log record with the given
+// timestamp, received at the given time. It returns false when the event must be dropped.
+func correctEventTime(event *splunk.Event, ts pdata.Timestamp, settings TimestampSettings, received time.Time) bool {
+	if ts == 0 {
+		switch settings.Missing {
+		case missingTimestampDrop:
+			return false
+		case missingTimestampReceiveTime:
+			event.Time = nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received))
+			event.Fields[timeCorrectionFieldKey] = "missing"
+		}
+		return true
+	}
+
+	var clamped time.Time
+	switch t := ts.AsTime(); {
+	case settings.MaxFuture > 0 && t.After(received.Add(settings.MaxFuture)):
+		clamped = received.Add(settings.MaxFuture)
+		event.Fields[timeCorrectionFieldKey] = "future"
+	case settings.MaxPast > 0 && t.Before(received.Add(-settings.MaxPast)):
+		clamped = received.Add(-settings.MaxPast)
+		event.Fields[timeCorrectionFieldKey] = "past"
+	default:
+		return true
+	}
+	event.Fields[originalTimeFieldKey] = *event.Time
+	event.Time = nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(clamped))
+	return true
+}
+
 // nanoTimestampToEpochMilliseconds transforms nanoseconds into <sec>.<ms>. For example, 1433188255.500 indicates 1433188255 seconds and 500 milliseconds after epoch.
 func nanoTimestampToEpochMilliseconds(ts pdata.Timestamp) *float64 {
 	duration := time.Duration(ts)
@@ -16,6 +16,7 @@ package splunkhecexporter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -479,3 +480,80 @@ func Test_nanoTimestampToEpochMilliseconds(t *testing.T) {
 	splunkTs = nanoTimestampToEpochMilliseconds(0)
 	assert.True(t, nil == splunkTs)
 }
+
+func Test_correctEventTime(t *testing.T) {
+	received := time.Unix(1600000000, 0)
+	settings := TimestampSettings{MaxFuture: time.Hour, MaxPast: 24 * time.Hour}
+	tests := []struct {
+		name           string
+		ts             pdata.Timestamp
+		missing        string
+		wantKept       bool
+		wantTime       *float64
+		wantCorrection interface{}
+		wantOriginal   interface{}
+	}{
+		{
+			name:     "missing indexing time",
+			missing:  missingTimestampIndexingTime,
+			wantKept: true,
+		},
+		{
+			name:           "missing receive time",
+			missing:        missingTimestampReceiveTime,
+			wantKept:       true,
+			wantTime:       nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received)),
+			wantCorrection: "missing",
+		},
+		{
+			name:    "missing dropped",
+			missing: missingTimestampDrop,
+		},
+		{
+			name:     "within range",
+			ts:       pdata.NewTimestampFromTime(received.Add(-time.Hour)),
+			wantKept: true,
+			wantTime: nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received.Add(-time.Hour))),
+		},
+		{
+			name:           "future",
+			ts:             pdata.NewTimestampFromTime(received.Add(3 * time.Hour)),
+			wantKept:       true,
+			wantTime:       nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received.Add(time.Hour))),
+			wantCorrection: "future",
+			wantOriginal:   *nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received.Add(3 * time.Hour))),
+		},
+		{
+			name:           "past",
+			ts:             pdata.NewTimestampFromTime(received.Add(-48 * time.Hour)),
+			wantKept:       true,
+			wantTime:       nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received.Add(-24 * time.Hour))),
+			wantCorrection: "past",
+			wantOriginal:   *nanoTimestampToEpochMilliseconds(pdata.NewTimestampFromTime(received.Add(-48 * time.Hour))),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := pdata.NewLogRecord()
+			lr.SetTimestamp(tt.ts)
+			event := mapLogRecordToSplunkEvent(pdata.NewResource(), lr, &Config{}, zap.NewNop())
+
+			settings.Missing = tt.missing
+			kept := correctEventTime(event, tt.ts, settings, received)
+			assert.Equal(t, tt.wantKept, kept)
+			if !kept {
+				return
+			}
+			assert.Equal(t, tt.wantTime, event.Time)
+			assert.Equal(t, tt.wantCorrection, event.Fields[timeCorrectionFieldKey])
+			assert.Equal(t, tt.wantOriginal, event.Fields[originalTimeFieldKey])
+		})
+	}
+
+	// The clamps are disabled by default.
+	lr := pdata.NewLogRecord()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(received.Add(72 * time.Hour)))
+	event := mapLogRecordToSplunkEvent(pdata.NewResource(), lr, &Config{}, zap.NewNop())
+	assert.True(t, correctEventTime(event, lr.Timestamp(), TimestampSettings{}, received))
+	assert.Empty(t, event.Fields)
+}
@@ -0,0 +1,72 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"context"
+
+	sapmclient "github.com/signalfx/sapm-proto/client"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
+)
+
+// sapmClient sends the traces to a Splunk Observability APM endpoint using
+// the SAPM protocol instead of HEC events.
+type sapmClient struct {
+	client *sapmclient.Client
+}
+
+func newSAPMClient(config *Config) (*sapmClient, error) {
+	opts := []sapmclient.Option{
+		sapmclient.WithEndpoint(config.SAPMEndpoint),
+		sapmclient.WithAccessToken(config.Token),
+	}
+	if config.MaxConnections > 0 {
+		opts = append(opts, sapmclient.WithMaxConnections(config.MaxConnections))
+	}
+	if config.DisableCompression {
+		opts = append(opts, sapmclient.WithDisabledCompression())
+	}
+
+	client, err := sapmclient.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &sapmClient{client: client}, nil
+}
+
+func (c *sapmClient) pushTraceData(ctx context.Context, td pdata.Traces) error {
+	if td.SpanCount() == 0 {
+		return nil
+	}
+
+	batches, err := jaeger.ProtoFromTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	err = c.client.Export(ctx, batches)
+	if sendErr, ok := err.(*sapmclient.ErrSend); ok && sendErr.Permanent {
+		return consumererror.NewPermanent(sendErr)
+	}
+	return err
+}
+
+func (c *sapmClient) stop(context.Context) error {
+	c.client.Stop()
+	return nil
+}
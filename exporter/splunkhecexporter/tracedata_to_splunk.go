@@ -15,7 +15,10 @@
 package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
 
 import (
+	"strings"
+
 	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
@@ -57,6 +60,29 @@ type hecSpan struct {
 	Links      []hecLink              `json:"links,omitempty"`
 }
 
+// apmSpan is a data structure used to export a span to Splunk HEC using the field layout
+// expected by the Splunk APM dashboards.
+type apmSpan struct {
+	TraceID       string                 `json:"trace_id"`
+	SpanID        string                 `json:"span_id"`
+	ParentSpanID  string                 `json:"parent_span_id,omitempty"`
+	Name          string                 `json:"name"`
+	Kind          string                 `json:"kind"`
+	Service       string                 `json:"service,omitempty"`
+	StartTime     *float64               `json:"start_time"`
+	DurationMs    float64                `json:"duration_ms"`
+	Error         bool                   `json:"error"`
+	StatusMessage string                 `json:"status_message,omitempty"`
+	Tags          map[string]interface{} `json:"tags,omitempty"`
+}
+
+const (
+	// apmServiceField is the HEC field holding the service name in the Splunk APM layout.
+	apmServiceField = "service"
+	// apmEnvironmentField is the HEC field holding the deployment environment in the Splunk APM layout.
+	apmEnvironmentField = "environment"
+)
+
 func traceDataToSplunk(logger *zap.Logger, data pdata.Traces, config *Config) ([]*splunk.Event, int) {
 	sourceKey := config.HecToOtelAttrs.Source
 	sourceTypeKey := config.HecToOtelAttrs.SourceType
@@ -90,6 +116,13 @@ func traceDataToSplunk(logger *zap.Logger, data pdata.Traces, config *Config) ([
 			}
 			return true
 		})
+		serviceName := ""
+		if config.TracesFormat == tracesFormatSplunkAPM {
+			serviceName = promoteAPMServiceTags(rs.Resource(), commonFields)
+		}
+		if config.CIMFields.Traces {
+			toCIMFields(commonFields)
+		}
 		ilss := rs.InstrumentationLibrarySpans()
 		for sils := 0; sils < ilss.Len(); sils++ {
 			ils := ilss.At(sils)
@@ -102,9 +135,17 @@ func traceDataToSplunk(logger *zap.Logger, data pdata.Traces, config *Config) ([
 					Source:     source,
 					SourceType: sourceType,
 					Index:      index,
-					Event:      toHecSpan(logger, span),
 					Fields:     commonFields,
 				}
+				if config.TracesFormat == tracesFormatSplunkAPM {
+					se.Event = toAPMSpan(logger, span, serviceName)
+				} else {
+					hs := toHecSpan(logger, span)
+					if config.CIMFields.Traces {
+						toCIMFields(hs.Attributes)
+					}
+					se.Event = hs
+				}
 				splunkEvents = append(splunkEvents, se)
 			}
 		}
@@ -167,3 +208,44 @@ func toHecSpan(logger *zap.Logger, span pdata.Span) hecSpan {
 		Events:     events,
 	}
 }
+
+// promoteAPMServiceTags copies the service identifying resource attributes to the
+// dedicated fields used by Splunk APM and returns the service name.
+func promoteAPMServiceTags(res pdata.Resource, fields map[string]interface{}) string {
+	serviceName := ""
+	if v, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+		serviceName = v.AsString()
+		fields[apmServiceField] = serviceName
+	}
+	if v, ok := res.Attributes().Get(conventions.AttributeDeploymentEnvironment); ok {
+		fields[apmEnvironmentField] = v.AsString()
+	}
+	return serviceName
+}
+
+func toAPMSpan(logger *zap.Logger, span pdata.Span, serviceName string) apmSpan {
+	tags := map[string]interface{}{}
+	span.Attributes().Range(func(k string, v pdata.AttributeValue) bool {
+		tags[k] = convertAttributeValue(v, logger)
+		return true
+	})
+
+	var durationMs float64
+	if span.EndTimestamp() > span.StartTimestamp() {
+		durationMs = float64(span.EndTimestamp()-span.StartTimestamp()) / 1e6
+	}
+
+	return apmSpan{
+		TraceID:       span.TraceID().HexString(),
+		SpanID:        span.SpanID().HexString(),
+		ParentSpanID:  span.ParentSpanID().HexString(),
+		Name:          span.Name(),
+		Kind:          strings.TrimPrefix(span.Kind().String(), "SPAN_KIND_"),
+		Service:       serviceName,
+		StartTime:     timestampToSecondsWithMillisecondPrecision(span.StartTimestamp()),
+		DurationMs:    durationMs,
+		Error:         span.Status().Code() == pdata.StatusCodeError,
+		StatusMessage: span.Status().Message(),
+		Tags:          tags,
+	}
+}
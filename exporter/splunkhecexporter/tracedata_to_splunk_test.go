@@ -58,6 +58,56 @@ func Test_traceDataToSplunk(t *testing.T) {
 			},
 			wantNumDroppedSpans: 0,
 		},
+		{
+			name: "splunk_apm",
+			traceDataFn: func() pdata.Traces {
+				traces := pdata.NewTraces()
+				rs := traces.ResourceSpans().AppendEmpty()
+				rs.Resource().Attributes().InsertString("service.name", "myapp")
+				rs.Resource().Attributes().InsertString("deployment.environment", "prod")
+				span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+				span.SetName("myspan")
+				span.SetKind(pdata.SpanKindServer)
+				span.SetTraceID(pdata.NewTraceID([16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}))
+				span.SetSpanID(pdata.NewSpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 1}))
+				span.SetStartTimestamp(pdata.Timestamp(1000000000))
+				span.SetEndTimestamp(pdata.Timestamp(1250000000))
+				span.Status().SetCode(pdata.StatusCodeError)
+				span.Status().SetMessage("boom")
+				span.Attributes().InsertString("foo", "bar")
+				return traces
+			},
+			wantSplunkEvents: []*splunk.Event{
+				{
+					Time: timestampToSecondsWithMillisecondPrecision(pdata.Timestamp(1000000000)),
+					Host: "unknown",
+					Event: apmSpan{
+						TraceID:       "01010101010101010101010101010101",
+						SpanID:        "0000000000000001",
+						Name:          "myspan",
+						Kind:          "SERVER",
+						Service:       "myapp",
+						StartTime:     timestampToSecondsWithMillisecondPrecision(pdata.Timestamp(1000000000)),
+						DurationMs:    250,
+						Error:         true,
+						StatusMessage: "boom",
+						Tags:          map[string]interface{}{"foo": "bar"},
+					},
+					Fields: map[string]interface{}{
+						"service.name":           "myapp",
+						"deployment.environment": "prod",
+						"service":                "myapp",
+						"environment":            "prod",
+					},
+				},
+			},
+			configFn: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.TracesFormat = tracesFormatSplunkAPM
+				return cfg
+			},
+			wantNumDroppedSpans: 0,
+		},
 		{
 			name: "empty_rs",
 			traceDataFn: func() pdata.Traces {
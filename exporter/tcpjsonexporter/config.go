@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcpjsonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+// Config defines configuration for the TCP JSON exporter.
+type Config struct {
+	config.ExporterSettings      `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the host:port of the TCP server to write to.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// TLSSetting enables TLS for the connection when set.
+	TLSSetting *configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// DialTimeout is the maximum time to wait for the connection to be established. Zero means no timeout.
+	DialTimeout time.Duration `mapstructure:"dial_timeout"`
+
+	// WriteTimeout is the maximum time to wait for a payload to be written, the connection is re-established
+	// on the next export when it expires. Zero means no timeout.
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be non-empty")
+	}
+	if _, _, err := net.SplitHostPort(cfg.Endpoint); err != nil {
+		return fmt.Errorf("endpoint must be in the host:port format: %w", err)
+	}
+	if cfg.DialTimeout < 0 {
+		return errors.New("dial_timeout must not be negative")
+	}
+	if cfg.WriteTimeout < 0 {
+		return errors.New("write_timeout must not be negative")
+	}
+	return nil
+}
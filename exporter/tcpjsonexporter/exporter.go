@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcpjsonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/model/otlp"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+// Marshalers used to encode the data as OTLP JSON.
+var tracesMarshaler = otlp.NewJSONTracesMarshaler()
+var metricsMarshaler = otlp.NewJSONMetricsMarshaler()
+var logsMarshaler = otlp.NewJSONLogsMarshaler()
+
+// tcpJSONExporter writes each batch of telemetry data as a single line of
+// OTLP JSON to a TCP connection shared by all the signals.
+type tcpJSONExporter struct {
+	config    *Config
+	logger    *zap.Logger
+	tlsConfig *tls.Config
+
+	// mu guards conn and ensures that lines are not interleaved.
+	mu sync.Mutex
+	// conn is nil until the first export, and after a failed write.
+	conn net.Conn
+}
+
+func newTCPJSONExporter(config *Config, settings component.TelemetrySettings) *tcpJSONExporter {
+	return &tcpJSONExporter{
+		config: config,
+		logger: settings.Logger,
+	}
+}
+
+func (e *tcpJSONExporter) Start(context.Context, component.Host) error {
+	if e.config.TLSSetting == nil {
+		return nil
+	}
+	tlsConfig, err := e.config.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load TLS config: %w", err)
+	}
+	e.tlsConfig = tlsConfig
+	return nil
+}
+
+// Shutdown closes the connection, if any.
+func (e *tcpJSONExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close()
+	e.conn = nil
+	return err
+}
+
+func (e *tcpJSONExporter) pushTraces(ctx context.Context, td pdata.Traces) error {
+	buf, err := tracesMarshaler.MarshalTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.writeLine(ctx, buf)
+}
+
+func (e *tcpJSONExporter) pushMetrics(ctx context.Context, md pdata.Metrics) error {
+	buf, err := metricsMarshaler.MarshalMetrics(md)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.writeLine(ctx, buf)
+}
+
+func (e *tcpJSONExporter) pushLogs(ctx context.Context, ld pdata.Logs) error {
+	buf, err := logsMarshaler.MarshalLogs(ld)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.writeLine(ctx, buf)
+}
+
+// writeLine writes buf followed by a newline, connecting first if needed. On
+// failure the connection is closed so the retried export reconnects.
+func (e *tcpJSONExporter) writeLine(ctx context.Context, buf []byte) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		conn, err := e.dial(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to connect to %q: %w", e.config.Endpoint, err)
+		}
+		e.logger.Debug("Connected to the TCP endpoint", zap.String("endpoint", e.config.Endpoint))
+		e.conn = conn
+	}
+
+	if e.config.WriteTimeout > 0 {
+		if err := e.conn.SetWriteDeadline(time.Now().Add(e.config.WriteTimeout)); err != nil {
+			e.closeConn()
+			return fmt.Errorf("failed to set write deadline: %w", err)
+		}
+	}
+
+	// A slow endpoint blocks the write, pushing back on the sending queue.
+	if _, err := e.conn.Write(append(buf, '\n')); err != nil {
+		e.closeConn()
+		return fmt.Errorf("failed to write to %q: %w", e.config.Endpoint, err)
+	}
+	return nil
+}
+
+func (e *tcpJSONExporter) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: e.config.DialTimeout}
+	if e.tlsConfig != nil {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: e.tlsConfig}
+		return tlsDialer.DialContext(ctx, "tcp", e.config.Endpoint)
+	}
+	return dialer.DialContext(ctx, "tcp", e.config.Endpoint)
+}
+
+func (e *tcpJSONExporter) closeConn() {
+	if err := e.conn.Close(); err != nil {
+		e.logger.Debug("Failed to close the connection", zap.Error(err))
+	}
+	e.conn = nil
+}
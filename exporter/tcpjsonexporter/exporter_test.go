@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcpjsonexporter
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/otlp"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// acceptLines accepts connections on l and sends every line received to the returned channel.
+func acceptLines(t *testing.T, l net.Listener) (<-chan string, <-chan net.Conn) {
+	lines := make(chan string, 10)
+	conns := make(chan net.Conn, 10)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conns <- conn
+			go func() {
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					lines <- scanner.Text()
+				}
+			}()
+		}
+	}()
+	t.Cleanup(func() { _ = l.Close() })
+	return lines, conns
+}
+
+func newTestExporter(t *testing.T, endpoint string) *tcpJSONExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	exp := newTCPJSONExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, exp.Shutdown(context.Background())) })
+	return exp
+}
+
+func receiveLine(t *testing.T, lines <-chan string) string {
+	select {
+	case line := <-lines:
+		return line
+	case <-time.After(5 * time.Second):
+		t.Fatal("no line received")
+		return ""
+	}
+}
+
+func TestPushAllSignals(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	lines, _ := acceptLines(t, l)
+	exp := newTestExporter(t, l.Addr().String())
+
+	td := pdata.NewTraces()
+	td.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+	gotTraces, err := otlp.NewJSONTracesUnmarshaler().UnmarshalTraces([]byte(receiveLine(t, lines)))
+	require.NoError(t, err)
+	assert.Equal(t, td, gotTraces)
+
+	md := pdata.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+	gotMetrics, err := otlp.NewJSONMetricsUnmarshaler().UnmarshalMetrics([]byte(receiveLine(t, lines)))
+	require.NoError(t, err)
+	assert.Equal(t, md, gotMetrics)
+
+	ld := pdata.NewLogs()
+	ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	gotLogs, err := otlp.NewJSONLogsUnmarshaler().UnmarshalLogs([]byte(receiveLine(t, lines)))
+	require.NoError(t, err)
+	assert.Equal(t, ld, gotLogs)
+}
+
+func TestPushReconnects(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	lines, conns := acceptLines(t, l)
+	exp := newTestExporter(t, l.Addr().String())
+
+	ld := pdata.NewLogs()
+	ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	receiveLine(t, lines)
+
+	// The server drops the connection, the following writes eventually fail
+	// and the exporter connects again.
+	require.NoError(t, (<-conns).Close())
+	assert.Eventually(t, func() bool {
+		return exp.pushLogs(context.Background(), ld) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	receiveLine(t, lines)
+}
+
+func TestPushConnectionRefused(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	exp := newTestExporter(t, endpoint)
+	err = exp.pushLogs(context.Background(), pdata.NewLogs())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to connect to")
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcpjsonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "tcpjson"
+
+	defaultDialTimeout  = 10 * time.Second
+	defaultWriteTimeout = 5 * time.Second
+)
+
+// NewFactory creates a factory for the TCP JSON exporter.
+func NewFactory() component.ExporterFactory {
+	return exporterhelper.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		exporterhelper.WithTraces(createTracesExporter),
+		exporterhelper.WithMetrics(createMetricsExporter),
+		exporterhelper.WithLogs(createLogsExporter))
+}
+
+func createDefaultConfig() config.Exporter {
+	return &Config{
+		ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+		QueueSettings:    exporterhelper.DefaultQueueSettings(),
+		RetrySettings:    exporterhelper.DefaultRetrySettings(),
+		DialTimeout:      defaultDialTimeout,
+		WriteTimeout:     defaultWriteTimeout,
+	}
+}
+
+func createTracesExporter(
+	_ context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.TracesExporter, error) {
+	oCfg := cfg.(*Config)
+	exp := exporters.GetOrAdd(cfg, func() component.Component {
+		return newTCPJSONExporter(oCfg, set.TelemetrySettings)
+	})
+	return exporterhelper.NewTracesExporter(
+		cfg,
+		set,
+		exp.Unwrap().(*tcpJSONExporter).pushTraces,
+		exporterhelper.WithQueue(oCfg.QueueSettings),
+		exporterhelper.WithRetry(oCfg.RetrySettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+func createMetricsExporter(
+	_ context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.MetricsExporter, error) {
+	oCfg := cfg.(*Config)
+	exp := exporters.GetOrAdd(cfg, func() component.Component {
+		return newTCPJSONExporter(oCfg, set.TelemetrySettings)
+	})
+	return exporterhelper.NewMetricsExporter(
+		cfg,
+		set,
+		exp.Unwrap().(*tcpJSONExporter).pushMetrics,
+		exporterhelper.WithQueue(oCfg.QueueSettings),
+		exporterhelper.WithRetry(oCfg.RetrySettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+func createLogsExporter(
+	_ context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.LogsExporter, error) {
+	oCfg := cfg.(*Config)
+	exp := exporters.GetOrAdd(cfg, func() component.Component {
+		return newTCPJSONExporter(oCfg, set.TelemetrySettings)
+	})
+	return exporterhelper.NewLogsExporter(
+		cfg,
+		set,
+		exp.Unwrap().(*tcpJSONExporter).pushLogs,
+		exporterhelper.WithQueue(oCfg.QueueSettings),
+		exporterhelper.WithRetry(oCfg.RetrySettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+// This is the map of already created TCP JSON exporters for particular configurations.
+// We maintain this map because the Factory is asked trace, metric and log exporters separately
+// but they must share the same connection, so one exporter object is used per configuration.
+var exporters = sharedcomponent.NewSharedComponents()
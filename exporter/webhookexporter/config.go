@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+// Config defines configuration for the webhook exporter.
+type Config struct {
+	config.ExporterSettings       `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	confighttp.HTTPClientSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
+
+	// BodyTemplate is the Go text/template the request body is rendered from.
+	// It is executed with the signal name in `.signal` and the spans, metric
+	// data points or log records of the request in `.records`. The `json`
+	// function renders a value as JSON. By default, the whole data is rendered
+	// as JSON.
+	BodyTemplate string `mapstructure:"body_template"`
+
+	// ContentType is the Content-Type header of the requests, "application/json"
+	// by default. The rendered body is checked to be valid JSON when the content
+	// type is JSON.
+	ContentType string `mapstructure:"content_type"`
+
+	// MaxBatchSize is the maximum number of records rendered in a single request.
+	// Zero means all the records of a batch are sent in a single request.
+	MaxBatchSize int `mapstructure:"max_batch_size"`
+
+	// RateLimit limits the rate of the requests sent to the endpoint.
+	RateLimit RateLimitSettings `mapstructure:"rate_limit"`
+}
+
+// RateLimitSettings defines the rate limiting of the requests.
+type RateLimitSettings struct {
+	// RequestsPerSecond is the maximum sustained rate of requests. Zero disables rate limiting.
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+
+	// Burst is the maximum number of requests sent at once, 1 if not set.
+	Burst int `mapstructure:"burst"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be non-empty")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint must be a valid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("endpoint must be an http or https URL, got %q", cfg.Endpoint)
+	}
+	if _, err := newBodyTemplate(cfg.BodyTemplate); err != nil {
+		return fmt.Errorf("invalid body_template: %w", err)
+	}
+	if cfg.MaxBatchSize < 0 {
+		return errors.New("max_batch_size must not be negative")
+	}
+	if cfg.RateLimit.RequestsPerSecond < 0 {
+		return errors.New("rate_limit::requests_per_second must not be negative")
+	}
+	if cfg.RateLimit.Burst < 0 {
+		return errors.New("rate_limit::burst must not be negative")
+	}
+	return nil
+}
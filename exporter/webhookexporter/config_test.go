@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/service/servicetest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	require.EqualError(t, err, "exporter \"webhook\" has invalid configuration: endpoint must be non-empty")
+	require.NotNil(t, cfg)
+
+	e0 := cfg.Exporters[config.NewComponentID(typeStr)]
+	assert.Equal(t, e0, factory.CreateDefaultConfig())
+
+	e1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "2")]
+	assert.Equal(t, e1,
+		&Config{
+			ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "2")),
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: "https://hooks.example.com/services/incidents",
+				Timeout:  10 * time.Second,
+				Headers: map[string]string{
+					"X-Source": "otelcol",
+				},
+			},
+			QueueSettings: exporterhelper.QueueSettings{
+				Enabled:      true,
+				NumConsumers: 2,
+				QueueSize:    10,
+			},
+			RetrySettings: exporterhelper.RetrySettings{
+				Enabled:         true,
+				InitialInterval: 10 * time.Second,
+				MaxInterval:     1 * time.Minute,
+				MaxElapsedTime:  10 * time.Minute,
+			},
+			BodyTemplate: `{"text": "{{ len .records }} new error logs", "logs": {{ json .records }}}`,
+			ContentType:  defaultContentType,
+			MaxBatchSize: 50,
+			RateLimit: RateLimitSettings{
+				RequestsPerSecond: 0.5,
+				Burst:             2,
+			},
+		})
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		modify       func(cfg *Config)
+		errorMessage string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:         "not an http endpoint",
+			modify:       func(cfg *Config) { cfg.Endpoint = "localhost:8080" },
+			errorMessage: "endpoint must be an http or https URL, got \"localhost:8080\"",
+		},
+		{
+			name:         "invalid body template",
+			modify:       func(cfg *Config) { cfg.BodyTemplate = "{{ .records" },
+			errorMessage: "invalid body_template: template: body:1: unclosed action",
+		},
+		{
+			name:         "unknown template function",
+			modify:       func(cfg *Config) { cfg.BodyTemplate = "{{ yaml .records }}" },
+			errorMessage: "invalid body_template: template: body:1: function \"yaml\" not defined",
+		},
+		{
+			name:         "negative max batch size",
+			modify:       func(cfg *Config) { cfg.MaxBatchSize = -1 },
+			errorMessage: "max_batch_size must not be negative",
+		},
+		{
+			name:         "negative rate limit",
+			modify:       func(cfg *Config) { cfg.RateLimit.RequestsPerSecond = -1 },
+			errorMessage: "rate_limit::requests_per_second must not be negative",
+		},
+		{
+			name:         "negative burst",
+			modify:       func(cfg *Config) { cfg.RateLimit.Burst = -1 },
+			errorMessage: "rate_limit::burst must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "https://hooks.example.com/services/incidents"
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.errorMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errorMessage)
+		})
+	}
+}
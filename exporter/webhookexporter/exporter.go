@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/model/pdata"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultContentType = "application/json"
+	maxErrMsgLen       = 256
+)
+
+type webhookExporter struct {
+	config   *Config
+	settings component.TelemetrySettings
+	client   *http.Client
+	body     *template.Template
+	limiter  *rate.Limiter
+}
+
+func newWebhookExporter(cfg *Config, body *template.Template, settings component.TelemetrySettings) *webhookExporter {
+	e := &webhookExporter{
+		config:   cfg,
+		settings: settings,
+		body:     body,
+	}
+	if cfg.RateLimit.RequestsPerSecond > 0 {
+		burst := cfg.RateLimit.Burst
+		if burst == 0 {
+			burst = 1
+		}
+		e.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit.RequestsPerSecond), burst)
+	}
+	return e
+}
+
+func (e *webhookExporter) Start(_ context.Context, host component.Host) error {
+	client, err := e.config.HTTPClientSettings.ToClient(host.GetExtensions(), e.settings)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return nil
+}
+
+func (e *webhookExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (e *webhookExporter) pushTraces(ctx context.Context, td pdata.Traces) error {
+	return e.send(ctx, signalTraces, tracesToRecords(td))
+}
+
+func (e *webhookExporter) pushMetrics(ctx context.Context, md pdata.Metrics) error {
+	return e.send(ctx, signalMetrics, metricsToRecords(md))
+}
+
+func (e *webhookExporter) pushLogs(ctx context.Context, ld pdata.Logs) error {
+	return e.send(ctx, signalLogs, logsToRecords(ld))
+}
+
+// send posts the records in requests of at most MaxBatchSize records. When a request
+// fails, the whole batch is retried, so records may be delivered more than once.
+func (e *webhookExporter) send(ctx context.Context, signal string, records []record) error {
+	batchSize := e.config.MaxBatchSize
+	if batchSize <= 0 {
+		batchSize = len(records)
+	}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		body, err := e.render(signal, records[start:end])
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if e.limiter != nil {
+			if err := e.limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		if err := e.post(ctx, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// render executes the body template with the records.
+func (e *webhookExporter) render(signal string, records []record) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := e.body.Execute(&buf, templateData(signal, records)); err != nil {
+		return nil, fmt.Errorf("failed to render the request body: %w", err)
+	}
+	if strings.Contains(e.contentType(), "json") && !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("the rendered request body is not valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+func (e *webhookExporter) contentType() string {
+	if e.config.ContentType == "" {
+		return defaultContentType
+	}
+	return e.config.ContentType
+}
+
+func (e *webhookExporter) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	for k, v := range e.config.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", e.contentType())
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxErrMsgLen))
+	line := ""
+	if scanner.Scan() {
+		line = scanner.Text()
+	}
+	err = fmt.Errorf("HTTP %d %q: %s", resp.StatusCode, http.StatusText(resp.StatusCode), line)
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return exporterhelper.NewThrottleRetry(err, delay)
+		}
+		return err
+	case resp.StatusCode == http.StatusRequestTimeout:
+		return err
+	case resp.StatusCode < http.StatusInternalServerError:
+		// Other client errors won't succeed if retried.
+		return consumererror.NewPermanent(err)
+	default:
+		return err
+	}
+}
+
+// retryAfter parses the delay of a Retry-After header given in seconds.
+func retryAfter(header string) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
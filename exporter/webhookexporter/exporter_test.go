@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookexporter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+type capturedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newTestServer returns a server responding with status and recording the requests it receives.
+func newTestServer(t *testing.T, status int, header http.Header) (*httptest.Server, func() []capturedRequest) {
+	var mu sync.Mutex
+	var requests []capturedRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		mu.Lock()
+		requests = append(requests, capturedRequest{header: r.Header, body: body})
+		mu.Unlock()
+
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []capturedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func newTestExporter(t *testing.T, cfg *Config) *webhookExporter {
+	body, err := newBodyTemplate(cfg.BodyTemplate)
+	require.NoError(t, err)
+	exp := newWebhookExporter(cfg, body, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, exp.Shutdown(context.Background())) })
+	return exp
+}
+
+func newTestLogs(bodies ...string) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("service.name", "checkout")
+	logs := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		lr := logs.AppendEmpty()
+		lr.Body().SetStringVal(body)
+		lr.SetSeverityText("ERROR")
+		lr.SetSeverityNumber(pdata.SeverityNumberERROR)
+		lr.SetTimestamp(pdata.NewTimestampFromTime(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)))
+		lr.Attributes().InsertString("http.route", "/cart")
+	}
+	return ld
+}
+
+func TestPushLogsDefaultTemplate(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusOK, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.Headers = map[string]string{"X-Source": "otelcol"}
+	exp := newTestExporter(t, cfg)
+
+	require.NoError(t, exp.pushLogs(context.Background(), newTestLogs("payment failed")))
+
+	reqs := requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "application/json", reqs[0].header.Get("Content-Type"))
+	assert.Equal(t, "otelcol", reqs[0].header.Get("X-Source"))
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(reqs[0].body, &got))
+	assert.Equal(t, map[string]interface{}{
+		"signal": "logs",
+		"records": []interface{}{
+			map[string]interface{}{
+				"timestamp":       "2022-03-01T10:00:00Z",
+				"severity_text":   "ERROR",
+				"severity_number": float64(pdata.SeverityNumberERROR),
+				"name":            "",
+				"body":            "payment failed",
+				"trace_id":        "",
+				"span_id":         "",
+				"attributes":      map[string]interface{}{"http.route": "/cart"},
+				"resource":        map[string]interface{}{"service.name": "checkout"},
+			},
+		},
+	}, got)
+}
+
+func TestPushLogsBatches(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusNoContent, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.BodyTemplate = `{"text": "{{ len .records }} errors", "first": {{ json (index .records 0).body }}}`
+	cfg.MaxBatchSize = 2
+	exp := newTestExporter(t, cfg)
+
+	require.NoError(t, exp.pushLogs(context.Background(), newTestLogs("a", "b", "c", "d", "e")))
+
+	reqs := requests()
+	require.Len(t, reqs, 3)
+	assert.JSONEq(t, `{"text": "2 errors", "first": "a"}`, string(reqs[0].body))
+	assert.JSONEq(t, `{"text": "2 errors", "first": "c"}`, string(reqs[1].body))
+	assert.JSONEq(t, `{"text": "1 errors", "first": "e"}`, string(reqs[2].body))
+}
+
+func TestPushEmpty(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusOK, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	exp := newTestExporter(t, cfg)
+
+	require.NoError(t, exp.pushLogs(context.Background(), pdata.NewLogs()))
+	assert.Empty(t, requests())
+}
+
+func TestPushTracesAndMetrics(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusOK, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.BodyTemplate = `{{ range .records }}{{ .name }} {{ end }}`
+	cfg.ContentType = "text/plain"
+	exp := newTestExporter(t, cfg)
+
+	td := pdata.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+
+	md := pdata.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("http.errors")
+	metric.SetDataType(pdata.MetricDataTypeSum)
+	metric.Sum().DataPoints().AppendEmpty().SetIntVal(1)
+	metric.Sum().DataPoints().AppendEmpty().SetIntVal(2)
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+
+	reqs := requests()
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "text/plain", reqs[0].header.Get("Content-Type"))
+	assert.Equal(t, "GET /cart ", string(reqs[0].body))
+	assert.Equal(t, "http.errors http.errors ", string(reqs[1].body))
+}
+
+func TestPushInvalidJSONBody(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusOK, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.BodyTemplate = `{"text": {{ (index .records 0).body }}}`
+	exp := newTestExporter(t, cfg)
+
+	err := exp.pushLogs(context.Background(), newTestLogs("not quoted"))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Empty(t, requests())
+}
+
+func TestPushErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		header    http.Header
+		permanent bool
+		throttle  bool
+	}{
+		{
+			name:      "bad request",
+			status:    http.StatusBadRequest,
+			permanent: true,
+		},
+		{
+			name:   "request timeout",
+			status: http.StatusRequestTimeout,
+		},
+		{
+			name:     "too many requests",
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"30"}},
+			throttle: true,
+		},
+		{
+			name:   "server error",
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newTestServer(t, tt.status, tt.header)
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = srv.URL
+			exp := newTestExporter(t, cfg)
+
+			err := exp.pushLogs(context.Background(), newTestLogs("payment failed"))
+			require.Error(t, err)
+			assert.Equal(t, tt.permanent, consumererror.IsPermanent(err))
+			if tt.throttle {
+				assert.Equal(t, "Throttle (30s), error: HTTP 429 \"Too Many Requests\": ", err.Error())
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv, requests := newTestServer(t, http.StatusOK, nil)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL
+	cfg.MaxBatchSize = 1
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	exp := newTestExporter(t, cfg)
+	require.NotNil(t, exp.limiter)
+	assert.Equal(t, 1, exp.limiter.Burst())
+
+	// The second request waits for the rate limiter longer than the context allows.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := exp.pushLogs(ctx, newTestLogs("a", "b"))
+	require.Error(t, err)
+	assert.Len(t, requests(), 1)
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter"
+
+import (
+	"encoding/json"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+
+	// defaultBodyTemplate renders the whole data as JSON.
+	defaultBodyTemplate = "{{ json . }}"
+)
+
+// record is a span, a metric data point or a log record as seen by the body template.
+type record map[string]interface{}
+
+// newBodyTemplate parses the body template, the default one if it is empty.
+func newBodyTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultBodyTemplate
+	}
+	return template.New("body").Funcs(template.FuncMap{
+		"json": toJSON,
+	}).Option("missingkey=zero").Parse(text)
+}
+
+// templateData returns the data the body template is executed with.
+func templateData(signal string, records []record) map[string]interface{} {
+	return map[string]interface{}{
+		"signal":  signal,
+		"records": records,
+	}
+}
+
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func formatTimestamp(ts pdata.Timestamp) string {
+	if ts == 0 {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
+
+// rawValue converts an attribute value to its plain Go representation.
+func rawValue(v pdata.AttributeValue) interface{} {
+	switch v.Type() {
+	case pdata.AttributeValueTypeString:
+		return v.StringVal()
+	case pdata.AttributeValueTypeInt:
+		return v.IntVal()
+	case pdata.AttributeValueTypeDouble:
+		return v.DoubleVal()
+	case pdata.AttributeValueTypeBool:
+		return v.BoolVal()
+	case pdata.AttributeValueTypeMap:
+		return v.MapVal().AsRaw()
+	case pdata.AttributeValueTypeArray:
+		values := v.SliceVal()
+		raw := make([]interface{}, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			raw = append(raw, rawValue(values.At(i)))
+		}
+		return raw
+	case pdata.AttributeValueTypeBytes:
+		return v.BytesVal()
+	default:
+		return nil
+	}
+}
+
+func tracesToRecords(td pdata.Traces) []record {
+	records := make([]record, 0, td.SpanCount())
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource().Attributes().AsRaw()
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				records = append(records, record{
+					"trace_id":       span.TraceID().HexString(),
+					"span_id":        span.SpanID().HexString(),
+					"parent_span_id": span.ParentSpanID().HexString(),
+					"name":           span.Name(),
+					"kind":           span.Kind().String(),
+					"start_time":     formatTimestamp(span.StartTimestamp()),
+					"end_time":       formatTimestamp(span.EndTimestamp()),
+					"duration_ms":    float64(span.EndTimestamp()-span.StartTimestamp()) / float64(time.Millisecond),
+					"status_code":    span.Status().Code().String(),
+					"status_message": span.Status().Message(),
+					"attributes":     span.Attributes().AsRaw(),
+					"resource":       resource,
+				})
+			}
+		}
+	}
+	return records
+}
+
+func metricsToRecords(md pdata.Metrics) []record {
+	records := make([]record, 0, md.DataPointCount())
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resource := rm.Resource().Attributes().AsRaw()
+		ilms := rm.InstrumentationLibraryMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			metrics := ilms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				records = appendDataPointRecords(records, metrics.At(k), resource)
+			}
+		}
+	}
+	return records
+}
+
+func appendDataPointRecords(records []record, metric pdata.Metric, resource map[string]interface{}) []record {
+	newRecord := func(ts pdata.Timestamp, attrs pdata.AttributeMap) record {
+		return record{
+			"name":        metric.Name(),
+			"description": metric.Description(),
+			"unit":        metric.Unit(),
+			"type":        metric.DataType().String(),
+			"timestamp":   formatTimestamp(ts),
+			"attributes":  attrs.AsRaw(),
+			"resource":    resource,
+		}
+	}
+	numberValue := func(dp pdata.NumberDataPoint) interface{} {
+		if dp.ValueType() == pdata.MetricValueTypeInt {
+			return dp.IntVal()
+		}
+		return dp.DoubleVal()
+	}
+
+	switch metric.DataType() {
+	case pdata.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			r := newRecord(dps.At(i).Timestamp(), dps.At(i).Attributes())
+			r["value"] = numberValue(dps.At(i))
+			records = append(records, r)
+		}
+	case pdata.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			r := newRecord(dps.At(i).Timestamp(), dps.At(i).Attributes())
+			r["value"] = numberValue(dps.At(i))
+			records = append(records, r)
+		}
+	case pdata.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			r := newRecord(dps.At(i).Timestamp(), dps.At(i).Attributes())
+			r["count"] = dps.At(i).Count()
+			r["sum"] = dps.At(i).Sum()
+			records = append(records, r)
+		}
+	case pdata.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			r := newRecord(dps.At(i).Timestamp(), dps.At(i).Attributes())
+			r["count"] = dps.At(i).Count()
+			r["sum"] = dps.At(i).Sum()
+			records = append(records, r)
+		}
+	case pdata.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			r := newRecord(dps.At(i).Timestamp(), dps.At(i).Attributes())
+			r["count"] = dps.At(i).Count()
+			r["sum"] = dps.At(i).Sum()
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+func logsToRecords(ld pdata.Logs) []record {
+	records := make([]record, 0, ld.LogRecordCount())
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := rl.Resource().Attributes().AsRaw()
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				records = append(records, record{
+					"timestamp":       formatTimestamp(lr.Timestamp()),
+					"severity_text":   lr.SeverityText(),
+					"severity_number": int32(lr.SeverityNumber()),
+					"name":            lr.Name(),
+					"body":            rawValue(lr.Body()),
+					"trace_id":        lr.TraceID().HexString(),
+					"span_id":         lr.SpanID().HexString(),
+					"attributes":      lr.Attributes().AsRaw(),
+					"resource":        resource,
+				})
+			}
+		}
+	}
+	return records
+}
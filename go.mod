@@ -42,7 +42,9 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/stackdriverexporter v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/asapauthextension v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/awsproxy v0.45.1
@@ -63,6 +65,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatorateprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor v0.45.1
@@ -74,22 +77,29 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanprocessor v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanstatusprocessor v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dotnetdiagnosticsreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/influxdbreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jaegerreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver v0.45.1
@@ -105,6 +115,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/processlogsreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusexecreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator v0.45.1
@@ -112,6 +123,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sapmreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/signalfxreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/simpleprometheusreceiver v0.45.1
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver v0.45.1
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver v0.45.1
@@ -141,6 +153,7 @@ require (
 	github.com/prometheus/common v0.32.1
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spf13/viper v1.10.1
+	go.opencensus.io v0.23.0
 	go.opentelemetry.io/collector/model v0.45.0
 	go.uber.org/multierr v1.7.0
 	go.uber.org/zap v1.21.0
@@ -354,6 +367,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet v0.45.1 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.45.1 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk v0.45.1 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters v0.45.1 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchperresourceattr v0.45.1 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal v0.45.1 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata v0.45.1 // indirect
@@ -435,7 +449,6 @@ require (
 	go.elastic.co/fastjson v1.1.0 // indirect
 	go.etcd.io/bbolt v1.3.6 // indirect
 	go.mongodb.org/atlas v0.15.0 // indirect
-	go.opencensus.io v0.23.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.28.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.28.0 // indirect
 	go.opentelemetry.io/contrib/zpages v0.28.0 // indirect
@@ -527,6 +540,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/splun
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza => ./internal/stanza
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters => ./internal/winperfcounters
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alibabacloudlogserviceexporter => ./exporter/alibabacloudlogserviceexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter => ./exporter/awscloudwatchlogsexporter
@@ -603,8 +618,12 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumol
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter => ./exporter/tanzuobservabilityexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter => ./exporter/tcpjsonexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter => ./exporter/tencentcloudlogserviceexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter => ./exporter/webhookexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticexporter => ./exporter/elasticexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter => ./exporter/elasticsearchexporter
@@ -665,6 +684,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/attr
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor => ./processor/cumulativetodeltaprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor => ./processor/datapointhistorybufferprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatorateprocessor => ./processor/deltatorateprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor => ./processor/filterprocessor
@@ -687,16 +708,22 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/reso
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor => ./processor/routingprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor => ./processor/semconvprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/signozspanmetricsprocessor => ./processor/signozspanmetricsprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor => ./processor/spanmetricsprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanprocessor => ./processor/spanprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanstatusprocessor => ./processor/spanstatusprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor => ./processor/tailsamplingprocessor
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor => ./processor/transformprocessor
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver => ./receiver/activemqreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver => ./receiver/awscontainerinsightreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver => ./receiver/awsecscontainermetricsreceiver
@@ -705,12 +732,18 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfi
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver => ./receiver/awsxrayreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver => ./receiver/bigipreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver => ./receiver/carbonreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver => ./receiver/chronyreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver => ./receiver/cloudfoundryreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver => ./receiver/collectdreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver => ./receiver/couchbasereceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver => ./receiver/dockerstatsreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dotnetdiagnosticsreceiver => ./receiver/dotnetdiagnosticsreceiver
@@ -723,6 +756,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googl
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver => ./receiver/hostmetricsreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver => ./receiver/iisreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/influxdbreceiver => ./receiver/influxdbreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jaegerreceiver => ./receiver/jaegerreceiver
@@ -753,6 +788,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podma
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver => ./receiver/postgresqlreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/processlogsreceiver => ./receiver/processlogsreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusexecreceiver => ./receiver/prometheusexecreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver => ./receiver/prometheusreceiver
@@ -767,6 +804,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/signa
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/simpleprometheusreceiver => ./receiver/simpleprometheusreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver => ./receiver/sparkreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver => ./receiver/splunkhecreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver => ./receiver/statsdreceiver
@@ -66,7 +66,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tcpjsonexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/webhookexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 )
@@ -432,6 +434,14 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "tcpjson",
+			getConfigFn: func() config.Exporter {
+				cfg := expFactories["tcpjson"].CreateDefaultConfig().(*tcpjsonexporter.Config)
+				cfg.Endpoint = endpoint
+				return cfg
+			},
+		},
 		{
 			exporter: "tencentcloud_logservice",
 			getConfigFn: func() config.Exporter {
@@ -440,6 +450,14 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "webhook",
+			getConfigFn: func() config.Exporter {
+				cfg := expFactories["webhook"].CreateDefaultConfig().(*webhookexporter.Config)
+				cfg.Endpoint = "http://" + endpoint
+				return cfg
+			},
+		},
 	}
 
 	assert.Len(t, tests, len(expFactories), "All user configurable components must be added to the lifecycle test")
@@ -17,6 +17,7 @@ package components
 import (
 	"context"
 	"errors"
+	"os"
 	"path"
 	"runtime"
 	"testing"
@@ -34,6 +35,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/processlogsreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver"
@@ -51,6 +53,9 @@ func TestDefaultReceivers(t *testing.T) {
 		skipLifecyle bool
 		getConfigFn  getReceiverConfigFn
 	}{
+		{
+			receiver: "activemq",
+		},
 		{
 			receiver: "awscontainerinsightreceiver",
 			// TODO: skipped since it will only function in a container environment with procfs in expected location.
@@ -67,6 +72,9 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "awsxray",
 			skipLifecyle: true, // Requires AWS endpoint to check identity to run
 		},
+		{
+			receiver: "bigip",
+		},
 		{
 			receiver: "carbon",
 			getConfigFn: func() config.Receiver {
@@ -76,6 +84,9 @@ func TestDefaultReceivers(t *testing.T) {
 			},
 			skipLifecyle: true, // Panics after test have completed, requires a wait group
 		},
+		{
+			receiver: "chrony",
+		},
 		{
 			receiver:     "cloudfoundry",
 			skipLifecyle: true, // Requires UAA (auth) endpoint to run
@@ -83,6 +94,9 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "collectd",
 		},
+		{
+			receiver: "couchbase",
+		},
 		{
 			receiver:     "docker_stats",
 			skipLifecyle: true,
@@ -112,6 +126,10 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "hostmetrics",
 		},
+		{
+			receiver:     "iis",
+			skipLifecyle: true, // Requires a running windows process
+		},
 		{
 			receiver: "influxdb",
 		},
@@ -180,6 +198,18 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver: "processlogs",
+			getConfigFn: func() config.Receiver {
+				cfg := rcvrFactories["processlogs"].CreateDefaultConfig().(*processlogsreceiver.ProcessLogsConfig)
+				// the test binary itself, running no test, is a command available on all platforms
+				cfg.Input = stanza.InputConfig{
+					"command": os.Args[0],
+					"args":    []string{"-test.run=^$"},
+				}
+				return cfg
+			},
+		},
 		{
 			receiver:     "prometheus_exec",
 			skipLifecyle: true, // Requires running a subproccess that can not be easily set across platforms
@@ -199,6 +229,9 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "prometheus_simple",
 		},
+		{
+			receiver: "spark",
+		},
 		{
 			receiver: "splunk_hec",
 		},
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"go.opentelemetry.io/collector/client"
@@ -29,7 +30,7 @@ import (
 // Settings specifies the processor settings.
 type Settings struct {
 	// Actions specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, RENAME_PATTERN, CONVERT, REPLACE, MASK}.
 	// This is a required field.
 	Actions []ActionKeyValue `mapstructure:"actions"`
 }
@@ -37,7 +38,7 @@ type Settings struct {
 // ActionKeyValue specifies the attribute key to act upon.
 type ActionKeyValue struct {
 	// Key specifies the attribute to act upon.
-	// This is a required field.
+	// This is a required field, except for the action RENAME_PATTERN.
 	Key string `mapstructure:"key"`
 
 	// Value specifies the value to populate for the key.
@@ -52,8 +53,53 @@ type ActionKeyValue struct {
 	// Note: All subexpressions must have a name.
 	// Note: The value type of the source key must be a string. If it isn't,
 	// no extraction will occur.
+	// For the action REPLACE, it is the regex pattern which matches are
+	// replaced in the value of the attribute specified by `key'.
 	RegexPattern string `mapstructure:"pattern"`
 
+	// Replacement replaces the matches of RegexPattern for the action REPLACE.
+	// Capture groups can be referenced using $1 or ${name}, as documented in
+	// regexp.Regexp.Expand. An empty replacement removes the matches.
+	// For the action MASK with the strategy "fixed", it is the string the
+	// value is replaced with, "****" by default.
+	Replacement string `mapstructure:"replacement"`
+
+	// FromPattern is the regex pattern matched against every attribute key for
+	// the action RENAME_PATTERN. The pattern must match the whole key, and all
+	// the matching keys are renamed.
+	FromPattern string `mapstructure:"from_pattern"`
+
+	// ToTemplate is the new key of an attribute matching FromPattern for the
+	// action RENAME_PATTERN. Capture groups of FromPattern can be referenced
+	// using $1 or ${name}, as documented in regexp.Regexp.Expand.
+	ToTemplate string `mapstructure:"to_template"`
+
+	// ConvertedType is the type the attribute is converted to for the action
+	// CONVERT. The set of values are {string, int, double, bool}.
+	ConvertedType string `mapstructure:"converted_type"`
+
+	// Scale is an optional factor the numeric value is multiplied with for the
+	// action CONVERT. It can't be specified along with FromUnit and ToUnit.
+	Scale *float64 `mapstructure:"scale"`
+
+	// FromUnit and ToUnit optionally convert the numeric value from a duration
+	// unit to another for the action CONVERT, e.g. from "ms" to "s". The set of
+	// values are {ns, us, ms, s, m, h}.
+	FromUnit string `mapstructure:"from_unit"`
+	ToUnit   string `mapstructure:"to_unit"`
+
+	// MaskStrategy is how the value is masked for the action MASK. The set of
+	// values are {partial, fixed, email, credit_card}, "partial" by default.
+	MaskStrategy string `mapstructure:"mask_strategy"`
+
+	// KeepLast is the number of trailing characters, or digits for the strategy
+	// "credit_card", left unmasked by the action MASK. It defaults to 4.
+	KeepLast *int `mapstructure:"keep_last"`
+
+	// MaskChar is the character masked characters are replaced with by the
+	// action MASK, "*" by default.
+	MaskChar string `mapstructure:"mask_char"`
+
 	// FromAttribute specifies the attribute to use to populate
 	// the value. If the attribute doesn't exist, no action is performed.
 	FromAttribute string `mapstructure:"from_attribute"`
@@ -85,6 +131,16 @@ type ActionKeyValue struct {
 	// EXTRACT - Extracts values using a regular expression rule from the input
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
+	// RENAME_PATTERN - Renames all the attributes which key matches 'from_pattern'
+	//           to the key rendered from 'to_template'. If a target key
+	//           already exists, it will be overridden.
+	// CONVERT - Converts an existing value to 'converted_type', optionally
+	//           scaling numeric values by 'scale' or from 'from_unit' to
+	//           'to_unit'. The value is left unchanged if it can't be converted.
+	// REPLACE - Replaces the matches of 'pattern' in an existing string value
+	//           with 'replacement'.
+	// MASK    - Masks an existing value according to 'mask_strategy', as a
+	//           lighter alternative to HASH keeping the value recognizable.
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -134,6 +190,28 @@ const (
 	// 'key' to target keys specified in the 'rule'. If a target key already
 	// exists, it will be overridden.
 	EXTRACT Action = "extract"
+
+	// RENAMEPATTERN renames all the attributes which key matches the regular
+	// expression 'from_pattern' to the key rendered from 'to_template'. If a
+	// target key already exists, it will be overridden.
+	RENAMEPATTERN Action = "rename_pattern"
+
+	// CONVERT converts an existing value to the type 'converted_type', optionally
+	// scaling numeric values by 'scale' or from the duration unit 'from_unit' to
+	// 'to_unit'. The value is left unchanged if it can't be converted.
+	CONVERT Action = "convert"
+
+	// REPLACE replaces all the matches of the regular expression 'pattern' in
+	// an existing string value with 'replacement', which can reference the
+	// capture groups of 'pattern'. Other value types are left unchanged.
+	REPLACE Action = "replace"
+
+	// MASK masks an existing string or numeric value according to 'mask_strategy':
+	// "partial" keeps the last 'keep_last' characters, "fixed" replaces the value
+	// with 'replacement', "email" keeps the first character and the domain of an
+	// email address and "credit_card" keeps the last 'keep_last' digits and the
+	// separators.
+	MASK Action = "mask"
 )
 
 type attributeAction struct {
@@ -144,6 +222,18 @@ type attributeAction struct {
 	Regex *regexp.Regexp
 	// Attribute names extracted from the regexp's subexpressions.
 	AttrNames []string
+	// Template of the new key for the action RENAME_PATTERN, or of the
+	// replaced value for the action REPLACE.
+	ToTemplate string
+	// Target type and numeric factor of the action CONVERT, a zero factor
+	// meaning that the value is not scaled.
+	ConvertedType string
+	Factor        float64
+	// Strategy, number of kept characters and mask character of the action
+	// MASK, the replacement of the strategy "fixed" being set in ToTemplate.
+	MaskStrategy string
+	KeepLast     int
+	MaskChar     rune
 	// Number of non empty strings in above array
 
 	// TODO https://go.opentelemetry.io/collector/issues/296
@@ -165,13 +255,13 @@ type AttrProc struct {
 func NewAttrProc(settings *Settings) (*AttrProc, error) {
 	var attributeActions []attributeAction
 	for i, a := range settings.Actions {
-		// `key` is a required field
-		if a.Key == "" {
-			return nil, fmt.Errorf("error creating AttrProc due to missing required field \"key\" at the %d-th actions", i)
-		}
-
 		// Convert `action` to lowercase for comparison.
 		a.Action = Action(strings.ToLower(string(a.Action)))
+
+		// `key` is a required field, except for RENAME_PATTERN which acts on all matching keys
+		if a.Key == "" && a.Action != RENAMEPATTERN {
+			return nil, fmt.Errorf("error creating AttrProc due to missing required field \"key\" at the %d-th actions", i)
+		}
 		action := attributeAction{
 			Key:    a.Key,
 			Action: a.Action,
@@ -179,6 +269,25 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 
 		valueSourceCount := a.valueSourceCount()
 
+		switch a.Action {
+		case INSERT, UPDATE, UPSERT, HASH, DELETE, EXTRACT, CONVERT, REPLACE, MASK:
+			if a.FromPattern != "" || a.ToTemplate != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"from_pattern\" or \"to_template\" fields. These must not be specified for %d-th action", a.Action, i)
+			}
+		}
+
+		if a.Action != REPLACE && a.Action != MASK && a.Replacement != "" {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"replacement\" field. This must not be specified for %d-th action", a.Action, i)
+		}
+
+		if a.Action != CONVERT && a.hasConvertFields() {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"converted_type\", \"scale\", \"from_unit\" or \"to_unit\" fields. These must not be specified for %d-th action", a.Action, i)
+		}
+
+		if a.Action != MASK && a.hasMaskFields() {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"mask_strategy\", \"keep_last\" or \"mask_char\" fields. These must not be specified for %d-th action", a.Action, i)
+		}
+
 		switch a.Action {
 		case INSERT, UPDATE, UPSERT:
 			if valueSourceCount == 0 {
@@ -231,6 +340,50 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			}
 			action.Regex = re
 			action.AttrNames = attrNames
+		case RENAMEPATTERN:
+			if a.Key != "" || valueSourceCount > 0 || a.RegexPattern != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use \"key\", value sources or \"pattern\" fields. These must not be specified for %d-th action", a.Action, i)
+			}
+			if a.FromPattern == "" || a.ToTemplate == "" {
+				return nil, fmt.Errorf("error creating AttrProc due to missing required fields \"from_pattern\" and \"to_template\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
+			// anchor the pattern so that it matches the whole key
+			re, err := regexp.Compile("^(?:" + a.FromPattern + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. Field \"from_pattern\" has invalid pattern: \"%s\" to be set at the %d-th actions", a.FromPattern, i)
+			}
+			action.Regex = re
+			action.ToTemplate = a.ToTemplate
+		case CONVERT:
+			if valueSourceCount > 0 || a.RegexPattern != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use value sources or \"pattern\" field. These must not be specified for %d-th action", a.Action, i)
+			}
+			convertedType, factor, err := a.convertSettings()
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. %v for %d-th action", err, i)
+			}
+			action.ConvertedType = convertedType
+			action.Factor = factor
+		case REPLACE:
+			if valueSourceCount > 0 {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use a value source field. These must not be specified for %d-th action", a.Action, i)
+			}
+			if a.RegexPattern == "" {
+				return nil, fmt.Errorf("error creating AttrProc due to missing required field \"pattern\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
+			re, err := regexp.Compile(a.RegexPattern)
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. Field \"pattern\" has invalid pattern: \"%s\" to be set at the %d-th actions", a.RegexPattern, i)
+			}
+			action.Regex = re
+			action.ToTemplate = a.Replacement
+		case MASK:
+			if valueSourceCount > 0 || a.RegexPattern != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use value sources or \"pattern\" field. These must not be specified for %d-th action", a.Action, i)
+			}
+			if err := a.maskSettings(&action); err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. %v for %d-th action", err, i)
+			}
 		default:
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
@@ -272,6 +425,14 @@ func (ap *AttrProc) Process(ctx context.Context, attrs pdata.AttributeMap) {
 			hashAttribute(action, attrs)
 		case EXTRACT:
 			extractAttributes(action, attrs)
+		case RENAMEPATTERN:
+			renameAttributes(action, attrs)
+		case CONVERT:
+			convertAttribute(action, attrs)
+		case REPLACE:
+			replaceAttribute(action, attrs)
+		case MASK:
+			maskAttribute(action, attrs)
 		}
 	}
 }
@@ -327,3 +488,39 @@ func extractAttributes(action attributeAction, attrs pdata.AttributeMap) {
 		attrs.UpsertString(action.AttrNames[i], matches[i])
 	}
 }
+
+func renameAttributes(action attributeAction, attrs pdata.AttributeMap) {
+	// Collect the matching keys first since the map can't be modified while
+	// ranging over it.
+	var keys []string
+	attrs.Range(func(k string, _ pdata.AttributeValue) bool {
+		if action.Regex.MatchString(k) {
+			keys = append(keys, k)
+		}
+		return true
+	})
+	// Rename in a deterministic order in case several keys are renamed to
+	// the same target key.
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		newKey := action.Regex.ReplaceAllString(k, action.ToTemplate)
+		if newKey == "" || newKey == k {
+			continue
+		}
+		value, _ := attrs.Get(k)
+		attrs.Upsert(newKey, value)
+		attrs.Delete(k)
+	}
+}
+
+func replaceAttribute(action attributeAction, attrs pdata.AttributeMap) {
+	value, found := attrs.Get(action.Key)
+
+	// Replacing values only functions on strings.
+	if !found || value.Type() != pdata.AttributeValueTypeString {
+		return
+	}
+
+	value.SetStringVal(action.Regex.ReplaceAllString(value.StringVal(), action.ToTemplate))
+}
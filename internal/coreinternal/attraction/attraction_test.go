@@ -427,6 +427,299 @@ func TestAttributes_Extract(t *testing.T) {
 	}
 }
 
+func TestAttributes_RenamePattern(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:               "RenameEmptyAttributes",
+			inputAttributes:    map[string]pdata.AttributeValue{},
+			expectedAttributes: map[string]pdata.AttributeValue{},
+		},
+		{
+			name: "No rename with no pattern matching",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.method": pdata.NewAttributeValueString("GET"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.method": pdata.NewAttributeValueString("GET"),
+			},
+		},
+		{
+			name: "Rename all matching keys",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"legacy_http_method": pdata.NewAttributeValueString("GET"),
+				"legacy_http_status": pdata.NewAttributeValueInt(200),
+				"boo":                pdata.NewAttributeValueString("ghosts are scary"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.method": pdata.NewAttributeValueString("GET"),
+				"http.status": pdata.NewAttributeValueInt(200),
+				"boo":         pdata.NewAttributeValueString("ghosts are scary"),
+			},
+		},
+		{
+			name: "Rename overrides existing target key",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"legacy_http_method": pdata.NewAttributeValueString("GET"),
+				"http.method":        pdata.NewAttributeValueString("POST"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.method": pdata.NewAttributeValueString("GET"),
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{FromPattern: "^legacy_(?P<namespace>[a-z]+)_(.*)$", ToTemplate: "${namespace}.$2", Action: RENAMEPATTERN},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_RenamePatternMatchesWholeKey(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "Rename keys matching the whole pattern only",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"legacy_method":     pdata.NewAttributeValueString("GET"),
+				"new_legacy_method": pdata.NewAttributeValueString("POST"),
+				"legacy_method_old": pdata.NewAttributeValueString("PUT"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"method":            pdata.NewAttributeValueString("GET"),
+				"new_legacy_method": pdata.NewAttributeValueString("POST"),
+				"legacy_method_old": pdata.NewAttributeValueString("PUT"),
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{FromPattern: "legacy_(method)", ToTemplate: "$1", Action: RENAMEPATTERN},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_Convert(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:               "ConvertEmptyAttributes",
+			inputAttributes:    map[string]pdata.AttributeValue{},
+			expectedAttributes: map[string]pdata.AttributeValue{},
+		},
+		{
+			name: "Convert numeric strings",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.status_code": pdata.NewAttributeValueString("200"),
+				"ratio":            pdata.NewAttributeValueString("0.25"),
+				"cache.hit":        pdata.NewAttributeValueString("true"),
+				"user.id":          pdata.NewAttributeValueInt(123),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.status_code": pdata.NewAttributeValueInt(200),
+				"ratio":            pdata.NewAttributeValueDouble(0.25),
+				"cache.hit":        pdata.NewAttributeValueBool(true),
+				"user.id":          pdata.NewAttributeValueString("123"),
+			},
+		},
+		{
+			name: "Convert with scale and units",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"duration":     pdata.NewAttributeValueString("1500"),
+				"duration_int": pdata.NewAttributeValueDouble(2500),
+				"percent":      pdata.NewAttributeValueString("0.5"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"duration":     pdata.NewAttributeValueDouble(1.5),
+				"duration_int": pdata.NewAttributeValueInt(2),
+				"percent":      pdata.NewAttributeValueString("50"),
+			},
+		},
+		{
+			name: "Unconvertible values are left unchanged",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.status_code": pdata.NewAttributeValueString("OK"),
+				"cache.hit":        pdata.NewAttributeValueString("maybe"),
+				"duration":         pdata.NewAttributeValueBool(true),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.status_code": pdata.NewAttributeValueString("OK"),
+				"cache.hit":        pdata.NewAttributeValueString("maybe"),
+				"duration":         pdata.NewAttributeValueDouble(0.001),
+			},
+		},
+	}
+
+	scale := 100.0
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "http.status_code", ConvertedType: "int", Action: CONVERT},
+			{Key: "ratio", ConvertedType: "double", Action: CONVERT},
+			{Key: "cache.hit", ConvertedType: "bool", Action: CONVERT},
+			{Key: "user.id", ConvertedType: "string", Action: CONVERT},
+			{Key: "duration", ConvertedType: "double", FromUnit: "ms", ToUnit: "s", Action: CONVERT},
+			{Key: "duration_int", ConvertedType: "int", FromUnit: "ms", ToUnit: "s", Action: CONVERT},
+			{Key: "percent", ConvertedType: "string", Scale: &scale, Action: CONVERT},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_Replace(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:               "ReplaceEmptyAttributes",
+			inputAttributes:    map[string]pdata.AttributeValue{},
+			expectedAttributes: map[string]pdata.AttributeValue{},
+		},
+		{
+			name: "Replace with capture groups",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueString("/users/123/orders/456"),
+				"http.url":   pdata.NewAttributeValueString("https://example.com/reset?token=abc123&lang=en"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueString("/users/{id}/orders/{id}"),
+				"http.url":   pdata.NewAttributeValueString("https://example.com/reset?token=***&lang=en"),
+			},
+		},
+		{
+			name: "No match",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueString("/health"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueString("/health"),
+			},
+		},
+		{
+			name: "Non string value",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueInt(123),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"http.route": pdata.NewAttributeValueInt(123),
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "http.route", RegexPattern: "/[0-9]+(/|$)", Replacement: "/{id}$1", Action: REPLACE},
+			{Key: "http.url", RegexPattern: "(?P<param>[?&]token=)[^&]*", Replacement: "${param}***", Action: REPLACE},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_Mask(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:               "MaskEmptyAttributes",
+			inputAttributes:    map[string]pdata.AttributeValue{},
+			expectedAttributes: map[string]pdata.AttributeValue{},
+		},
+		{
+			name: "Mask with strategies",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"user.phone":   pdata.NewAttributeValueString("555-0123-4567"),
+				"user.token":   pdata.NewAttributeValueString("s3cr3t"),
+				"user.email":   pdata.NewAttributeValueString("jane.doe@example.com"),
+				"payment.card": pdata.NewAttributeValueString("4111 1111 1111 1234"),
+				"user.ssn":     pdata.NewAttributeValueString("123-45-6789"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"user.phone":   pdata.NewAttributeValueString("*********4567"),
+				"user.token":   pdata.NewAttributeValueString("[REDACTED]"),
+				"user.email":   pdata.NewAttributeValueString("j*******@example.com"),
+				"payment.card": pdata.NewAttributeValueString("#### #### #### 1234"),
+				"user.ssn":     pdata.NewAttributeValueString("*********89"),
+			},
+		},
+		{
+			name: "Short and invalid values",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"user.phone":   pdata.NewAttributeValueString("123"),
+				"user.ssn":     pdata.NewAttributeValueString("12"),
+				"payment.card": pdata.NewAttributeValueString("12-34"),
+				"user.email":   pdata.NewAttributeValueString("not-an-email"),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"user.phone":   pdata.NewAttributeValueString("***"),
+				"user.ssn":     pdata.NewAttributeValueString("**"),
+				"payment.card": pdata.NewAttributeValueString("##-##"),
+				"user.email":   pdata.NewAttributeValueString("************"),
+			},
+		},
+		{
+			name: "Numeric value",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"payment.card": pdata.NewAttributeValueInt(4111111111111234),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"payment.card": pdata.NewAttributeValueString("############1234"),
+			},
+		},
+		{
+			name: "Non masked value type",
+			inputAttributes: map[string]pdata.AttributeValue{
+				"user.token": pdata.NewAttributeValueBool(true),
+			},
+			expectedAttributes: map[string]pdata.AttributeValue{
+				"user.token": pdata.NewAttributeValueBool(true),
+			},
+		},
+	}
+
+	keepLast := 2
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "user.phone", Action: MASK},
+			{Key: "user.token", MaskStrategy: "fixed", Replacement: "[REDACTED]", Action: MASK},
+			{Key: "user.email", MaskStrategy: "email", Action: MASK},
+			{Key: "payment.card", MaskStrategy: "credit_card", MaskChar: "#", Action: MASK},
+			{Key: "user.ssn", MaskStrategy: "partial", KeepLast: &keepLast, Action: MASK},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.Nil(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
 func TestAttributes_UpsertFromAttribute(t *testing.T) {
 
 	testCases := []testCase{
@@ -836,6 +1129,153 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "missing template for rename pattern",
+			actionLists: []ActionKeyValue{
+				{FromPattern: "^legacy_(.*)$", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc due to missing required fields \"from_pattern\" and \"to_template\" for action \"rename_pattern\" at the 0-th action",
+		},
+		{
+			name: "set key for rename pattern",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", FromPattern: "^legacy_(.*)$", ToTemplate: "$1", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc. Action \"rename_pattern\" does not use \"key\", value sources or \"pattern\" fields. These must not be specified for 0-th action",
+		},
+		{
+			name: "invalid rename pattern",
+			actionLists: []ActionKeyValue{
+				{FromPattern: "(?P<invalid.regex>.*?)$", ToTemplate: "$1", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc. Field \"from_pattern\" has invalid pattern: \"(?P<invalid.regex>.*?)$\" to be set at the 0-th actions",
+		},
+		{
+			name: "from pattern for upsert",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: 123, FromPattern: "^legacy_(.*)$", Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" does not use the \"from_pattern\" or \"to_template\" fields. These must not be specified for 0-th action",
+		},
+		{
+			name: "missing converted type",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. missing required field \"converted_type\" for 0-th action",
+		},
+		{
+			name: "unsupported converted type",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", ConvertedType: "bytes", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. unsupported \"converted_type\" \"bytes\" for 0-th action",
+		},
+		{
+			name: "invalid unit",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", ConvertedType: "int", FromUnit: "ms", ToUnit: "days", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. \"from_unit\" and \"to_unit\" must be both one of ns, us, ms, s, m or h for 0-th action",
+		},
+		{
+			name: "zero scale",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", ConvertedType: "double", Scale: new(float64), Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. \"scale\" must not be zero for 0-th action",
+		},
+		{
+			name: "scaled bool",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", ConvertedType: "bool", FromUnit: "ms", ToUnit: "s", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. a value converted to \"bool\" can't be scaled for 0-th action",
+		},
+		{
+			name: "value for convert",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: 1, ConvertedType: "int", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc. Action \"convert\" does not use value sources or \"pattern\" field. These must not be specified for 0-th action",
+		},
+		{
+			name: "converted type for upsert",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: 123, ConvertedType: "string", Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" does not use the \"converted_type\", \"scale\", \"from_unit\" or \"to_unit\" fields. These must not be specified for 0-th action",
+		},
+		{
+			name: "missing pattern for replace",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Replacement: "***", Action: REPLACE},
+			},
+			errorString: "error creating AttrProc due to missing required field \"pattern\" for action \"replace\" at the 0-th action",
+		},
+		{
+			name: "invalid replace pattern",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "(?P<invalid.regex>.*?)$", Replacement: "***", Action: REPLACE},
+			},
+			errorString: "error creating AttrProc. Field \"pattern\" has invalid pattern: \"(?P<invalid.regex>.*?)$\" to be set at the 0-th actions",
+		},
+		{
+			name: "value for replace",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", RegexPattern: "[0-9]+", Action: REPLACE},
+			},
+			errorString: "error creating AttrProc. Action \"replace\" does not use a value source field. These must not be specified for 0-th action",
+		},
+		{
+			name: "replacement for extract",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "^(?P<id>[0-9]+)$", Replacement: "$1", Action: EXTRACT},
+			},
+			errorString: "error creating AttrProc. Action \"extract\" does not use the \"replacement\" field. This must not be specified for 0-th action",
+		},
+		{
+			name: "unsupported mask strategy",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MaskStrategy: "shuffle", Action: MASK},
+			},
+			errorString: "error creating AttrProc. unsupported \"mask_strategy\" \"shuffle\" for 0-th action",
+		},
+		{
+			name: "replacement for partial mask",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Replacement: "***", Action: MASK},
+			},
+			errorString: "error creating AttrProc. \"replacement\" can only be specified for the \"mask_strategy\" \"fixed\" for 0-th action",
+		},
+		{
+			name: "keep last for email mask",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MaskStrategy: "email", KeepLast: new(int), Action: MASK},
+			},
+			errorString: "error creating AttrProc. \"keep_last\" can only be specified for the \"mask_strategy\" \"partial\" or \"credit_card\" for 0-th action",
+		},
+		{
+			name: "invalid mask char",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MaskChar: "**", Action: MASK},
+			},
+			errorString: "error creating AttrProc. \"mask_char\" must be a single character and can't be specified for the \"mask_strategy\" \"fixed\" for 0-th action",
+		},
+		{
+			name: "pattern for mask",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "[0-9]+", Action: MASK},
+			},
+			errorString: "error creating AttrProc. Action \"mask\" does not use value sources or \"pattern\" field. These must not be specified for 0-th action",
+		},
+		{
+			name: "mask strategy for hash",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MaskStrategy: "partial", Action: HASH},
+			},
+			errorString: "error creating AttrProc. Action \"hash\" does not use the \"mask_strategy\", \"keep_last\" or \"mask_char\" fields. These must not be specified for 0-th action",
+		},
 	}
 
 	for _, tc := range testcase {
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// The types an attribute can be converted to by the action CONVERT.
+const (
+	convertToString = "string"
+	convertToInt    = "int"
+	convertToDouble = "double"
+	convertToBool   = "bool"
+)
+
+// durationUnits are the units supported by the "from_unit" and "to_unit" fields.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+}
+
+func (a *ActionKeyValue) hasConvertFields() bool {
+	return a.ConvertedType != "" || a.Scale != nil || a.FromUnit != "" || a.ToUnit != ""
+}
+
+// convertSettings validates the fields of the action CONVERT, and returns the target
+// type and the factor numeric values are multiplied with, zero if they are not scaled.
+func (a *ActionKeyValue) convertSettings() (string, float64, error) {
+	convertedType := strings.ToLower(a.ConvertedType)
+	switch convertedType {
+	case convertToString, convertToInt, convertToDouble, convertToBool:
+	case "":
+		return "", 0, fmt.Errorf("missing required field \"converted_type\"")
+	default:
+		return "", 0, fmt.Errorf("unsupported \"converted_type\" %q", a.ConvertedType)
+	}
+
+	var factor float64
+	switch {
+	case a.Scale != nil && (a.FromUnit != "" || a.ToUnit != ""):
+		return "", 0, fmt.Errorf("\"scale\" and \"from_unit\"/\"to_unit\" must not be both specified")
+	case a.Scale != nil && *a.Scale == 0:
+		return "", 0, fmt.Errorf("\"scale\" must not be zero")
+	case a.Scale != nil:
+		factor = *a.Scale
+	case a.FromUnit != "" || a.ToUnit != "":
+		from, fromOk := durationUnits[a.FromUnit]
+		to, toOk := durationUnits[a.ToUnit]
+		if !fromOk || !toOk {
+			return "", 0, fmt.Errorf("\"from_unit\" and \"to_unit\" must be both one of ns, us, ms, s, m or h")
+		}
+		factor = float64(from) / float64(to)
+	}
+
+	if factor != 0 && convertedType == convertToBool {
+		return "", 0, fmt.Errorf("a value converted to \"bool\" can't be scaled")
+	}
+	return convertedType, factor, nil
+}
+
+func convertAttribute(action attributeAction, attrs pdata.AttributeMap) {
+	value, found := attrs.Get(action.Key)
+	if !found {
+		return
+	}
+
+	if action.ConvertedType == convertToBool {
+		if b, ok := toBool(value); ok {
+			attrs.UpsertBool(action.Key, b)
+		}
+		return
+	}
+
+	if action.ConvertedType == convertToString && action.Factor == 0 {
+		attrs.UpsertString(action.Key, value.AsString())
+		return
+	}
+
+	// Integers are kept as such when not scaled, so that large values don't lose precision.
+	if action.Factor == 0 {
+		if i, ok := toInt(value); ok {
+			switch action.ConvertedType {
+			case convertToInt:
+				attrs.UpsertInt(action.Key, i)
+			case convertToDouble:
+				attrs.UpsertDouble(action.Key, float64(i))
+			}
+			return
+		}
+	}
+
+	f, ok := toDouble(value)
+	if !ok {
+		return
+	}
+	if action.Factor != 0 {
+		f *= action.Factor
+	}
+	switch action.ConvertedType {
+	case convertToInt:
+		// The fractional part is truncated.
+		attrs.UpsertInt(action.Key, int64(f))
+	case convertToDouble:
+		attrs.UpsertDouble(action.Key, f)
+	case convertToString:
+		attrs.UpsertString(action.Key, strconv.FormatFloat(f, 'f', -1, 64))
+	}
+}
+
+// toInt returns the value as an integer, if it is an integer, a boolean or a string
+// representation of an integer.
+func toInt(value pdata.AttributeValue) (int64, bool) {
+	switch value.Type() {
+	case pdata.AttributeValueTypeInt:
+		return value.IntVal(), true
+	case pdata.AttributeValueTypeBool:
+		if value.BoolVal() {
+			return 1, true
+		}
+		return 0, true
+	case pdata.AttributeValueTypeString:
+		i, err := strconv.ParseInt(strings.TrimSpace(value.StringVal()), 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// toDouble returns the value as a double, if it is a number, a boolean or a string
+// representation of a number.
+func toDouble(value pdata.AttributeValue) (float64, bool) {
+	switch value.Type() {
+	case pdata.AttributeValueTypeDouble:
+		return value.DoubleVal(), true
+	case pdata.AttributeValueTypeString:
+		f, err := strconv.ParseFloat(strings.TrimSpace(value.StringVal()), 64)
+		return f, err == nil
+	}
+	i, ok := toInt(value)
+	return float64(i), ok
+}
+
+// toBool returns the value as a boolean, if it is a boolean, a number (true when not
+// zero) or a string representation of a boolean.
+func toBool(value pdata.AttributeValue) (bool, bool) {
+	switch value.Type() {
+	case pdata.AttributeValueTypeBool:
+		return value.BoolVal(), true
+	case pdata.AttributeValueTypeInt:
+		return value.IntVal() != 0, true
+	case pdata.AttributeValueTypeDouble:
+		return value.DoubleVal() != 0, true
+	case pdata.AttributeValueTypeString:
+		b, err := strconv.ParseBool(strings.TrimSpace(value.StringVal()))
+		return b, err == nil
+	}
+	return false, false
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// The strategies used by the action MASK.
+const (
+	maskPartial    = "partial"
+	maskFixed      = "fixed"
+	maskEmail      = "email"
+	maskCreditCard = "credit_card"
+)
+
+const (
+	defaultMaskKeepLast    = 4
+	defaultMaskChar        = '*'
+	defaultMaskReplacement = "****"
+)
+
+func (a *ActionKeyValue) hasMaskFields() bool {
+	return a.MaskStrategy != "" || a.KeepLast != nil || a.MaskChar != ""
+}
+
+// maskSettings validates the fields of the action MASK and sets them on the action,
+// filling in the defaults of the fields that are not specified.
+func (a *ActionKeyValue) maskSettings(action *attributeAction) error {
+	strategy := strings.ToLower(a.MaskStrategy)
+	switch strategy {
+	case "":
+		strategy = maskPartial
+	case maskPartial, maskFixed, maskEmail, maskCreditCard:
+	default:
+		return fmt.Errorf("unsupported \"mask_strategy\" %q", a.MaskStrategy)
+	}
+
+	if a.Replacement != "" && strategy != maskFixed {
+		return fmt.Errorf("\"replacement\" can only be specified for the \"mask_strategy\" %q", maskFixed)
+	}
+	if a.KeepLast != nil && strategy != maskPartial && strategy != maskCreditCard {
+		return fmt.Errorf("\"keep_last\" can only be specified for the \"mask_strategy\" %q or %q", maskPartial, maskCreditCard)
+	}
+	if a.KeepLast != nil && *a.KeepLast < 0 {
+		return fmt.Errorf("\"keep_last\" must not be negative")
+	}
+	if a.MaskChar != "" && (strategy == maskFixed || utf8.RuneCountInString(a.MaskChar) != 1) {
+		return fmt.Errorf("\"mask_char\" must be a single character and can't be specified for the \"mask_strategy\" %q", maskFixed)
+	}
+
+	action.MaskStrategy = strategy
+	action.KeepLast = defaultMaskKeepLast
+	if a.KeepLast != nil {
+		action.KeepLast = *a.KeepLast
+	}
+	action.MaskChar = defaultMaskChar
+	if a.MaskChar != "" {
+		action.MaskChar, _ = utf8.DecodeRuneInString(a.MaskChar)
+	}
+	action.ToTemplate = defaultMaskReplacement
+	if a.Replacement != "" {
+		action.ToTemplate = a.Replacement
+	}
+	return nil
+}
+
+func maskAttribute(action attributeAction, attrs pdata.AttributeMap) {
+	value, found := attrs.Get(action.Key)
+	if !found {
+		return
+	}
+
+	// Numbers are masked as well since identifiers like card numbers
+	// may be recorded as such. The masked value is always a string.
+	switch value.Type() {
+	case pdata.AttributeValueTypeString, pdata.AttributeValueTypeInt, pdata.AttributeValueTypeDouble:
+		attrs.UpsertString(action.Key, maskString(action, value.AsString()))
+	}
+}
+
+func maskString(action attributeAction, s string) string {
+	switch action.MaskStrategy {
+	case maskFixed:
+		return action.ToTemplate
+	case maskEmail:
+		return maskEmailAddress(s, action.MaskChar)
+	case maskCreditCard:
+		return maskDigits(s, action.KeepLast, action.MaskChar)
+	default:
+		return maskKeepLast(s, action.KeepLast, action.MaskChar)
+	}
+}
+
+// maskKeepLast replaces all the characters of s but the last keepLast ones with maskChar.
+// Values of keepLast characters or fewer are masked entirely, not to be left in clear.
+func maskKeepLast(s string, keepLast int, maskChar rune) string {
+	runes := []rune(s)
+	if len(runes) <= keepLast {
+		keepLast = 0
+	}
+	for i := 0; i < len(runes)-keepLast; i++ {
+		runes[i] = maskChar
+	}
+	return string(runes)
+}
+
+// maskEmailAddress masks the local part of an email address but its first character,
+// keeping the domain, e.g. "j*******@example.com". Values which are not an email
+// address are masked entirely.
+func maskEmailAddress(s string, maskChar rune) string {
+	at := strings.LastIndex(s, "@")
+	if at <= 0 || at == len(s)-1 {
+		return maskKeepLast(s, 0, maskChar)
+	}
+	_, firstLen := utf8.DecodeRuneInString(s)
+	return s[:firstLen] + maskKeepLast(s[firstLen:at], 0, maskChar) + s[at:]
+}
+
+// maskDigits replaces all the digits of s but the last keepLast ones with maskChar,
+// keeping separators like spaces and dashes, e.g. "****-****-****-1234". Values of
+// keepLast digits or fewer have all their digits masked.
+func maskDigits(s string, keepLast int, maskChar rune) string {
+	runes := []rune(s)
+	digits := 0
+	for _, r := range runes {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	if digits <= keepLast {
+		keepLast = 0
+	}
+	kept := 0
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.IsDigit(runes[i]) {
+			continue
+		}
+		if kept < keepLast {
+			kept++
+			continue
+		}
+		runes[i] = maskChar
+	}
+	return string(runes)
+}
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The B3 propagation headers, see https://github.com/openzipkin/b3-propagation.
+const (
+	B3SingleHeader       = "b3"
+	B3TraceIDHeader      = "X-B3-TraceId"
+	B3SpanIDHeader       = "X-B3-SpanId"
+	B3ParentSpanIDHeader = "X-B3-ParentSpanId"
+	B3SampledHeader      = "X-B3-Sampled"
+	B3FlagsHeader        = "X-B3-Flags"
+)
+
+// ErrInvalidB3 is returned when parsing invalid B3 headers.
+var ErrInvalidB3 = errors.New("invalid B3 headers")
+
+// ParseB3Single parses the value of the B3 single header, in the
+// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId} format where the last two fields are
+// optional, or only made of the {SamplingState}.
+func ParseB3Single(value string) (SpanContext, error) {
+	var sc SpanContext
+	if value == "" {
+		return sc, fmt.Errorf("%w: empty %s header", ErrInvalidB3, B3SingleHeader)
+	}
+
+	parts := strings.Split(value, "-")
+	if len(parts) == 1 {
+		sampling, err := parseB3SamplingState(parts[0])
+		if err != nil {
+			return sc, err
+		}
+		sc.Sampling = sampling
+		return sc, nil
+	}
+	if len(parts) > 4 {
+		return sc, fmt.Errorf("%w: too many fields in %s header", ErrInvalidB3, B3SingleHeader)
+	}
+
+	if err := parseB3IDs(&sc, parts[0], parts[1]); err != nil {
+		return sc, err
+	}
+	if len(parts) > 2 {
+		sampling, err := parseB3SamplingState(parts[2])
+		if err != nil {
+			return sc, err
+		}
+		sc.Sampling = sampling
+	}
+	if len(parts) > 3 {
+		parentSpanID, err := HexToSpanID(parts[3])
+		if err != nil {
+			return sc, fmt.Errorf("%w: parent span ID: %v", ErrInvalidB3, err)
+		}
+		sc.ParentSpanID = parentSpanID
+	}
+	return sc, nil
+}
+
+// FormatB3Single formats the value of the B3 single header for the span context.
+// Only the sampling state is formatted when the span context has no trace ID.
+func FormatB3Single(sc SpanContext) string {
+	if sc.TraceID.IsEmpty() {
+		return formatB3SamplingState(sc.Sampling)
+	}
+
+	var b strings.Builder
+	b.WriteString(sc.TraceID.HexString())
+	b.WriteString("-")
+	b.WriteString(sc.SpanID.HexString())
+	if sc.Sampling != SamplingUnset {
+		b.WriteString("-")
+		b.WriteString(formatB3SamplingState(sc.Sampling))
+		if !sc.ParentSpanID.IsEmpty() {
+			b.WriteString("-")
+			b.WriteString(sc.ParentSpanID.HexString())
+		}
+	}
+	return b.String()
+}
+
+// ParseB3Multi parses the B3 multiple headers, using get to fetch the value of a header,
+// like http.Header.Get does.
+func ParseB3Multi(get func(key string) string) (SpanContext, error) {
+	var sc SpanContext
+
+	traceID, spanID := get(B3TraceIDHeader), get(B3SpanIDHeader)
+	if traceID != "" || spanID != "" {
+		if err := parseB3IDs(&sc, traceID, spanID); err != nil {
+			return sc, err
+		}
+		if parentSpanID := get(B3ParentSpanIDHeader); parentSpanID != "" {
+			id, err := HexToSpanID(parentSpanID)
+			if err != nil {
+				return sc, fmt.Errorf("%w: parent span ID: %v", ErrInvalidB3, err)
+			}
+			sc.ParentSpanID = id
+		}
+	}
+
+	// The debug flag implies an accept decision, the sampled header is then ignored.
+	if get(B3FlagsHeader) == "1" {
+		sc.Sampling = SamplingDebug
+		return sc, nil
+	}
+	switch sampled := get(B3SampledHeader); sampled {
+	case "":
+	case "1", "true":
+		sc.Sampling = SamplingAccept
+	case "0", "false":
+		sc.Sampling = SamplingDeny
+	default:
+		return sc, fmt.Errorf("%w: invalid %s header %q", ErrInvalidB3, B3SampledHeader, sampled)
+	}
+	return sc, nil
+}
+
+// FormatB3Multi formats the B3 multiple headers for the span context, using set to set
+// the value of a header, like http.Header.Set does.
+func FormatB3Multi(sc SpanContext, set func(key, value string)) {
+	if !sc.TraceID.IsEmpty() {
+		set(B3TraceIDHeader, sc.TraceID.HexString())
+		set(B3SpanIDHeader, sc.SpanID.HexString())
+		if !sc.ParentSpanID.IsEmpty() {
+			set(B3ParentSpanIDHeader, sc.ParentSpanID.HexString())
+		}
+	}
+
+	switch sc.Sampling {
+	case SamplingAccept:
+		set(B3SampledHeader, "1")
+	case SamplingDeny:
+		set(B3SampledHeader, "0")
+	case SamplingDebug:
+		set(B3FlagsHeader, "1")
+	}
+}
+
+func parseB3IDs(sc *SpanContext, traceID, spanID string) error {
+	var err error
+	if sc.TraceID, err = HexToTraceID(traceID); err != nil {
+		return fmt.Errorf("%w: trace ID: %v", ErrInvalidB3, err)
+	}
+	if sc.SpanID, err = HexToSpanID(spanID); err != nil {
+		return fmt.Errorf("%w: span ID: %v", ErrInvalidB3, err)
+	}
+	if sc.TraceID.IsEmpty() || sc.SpanID.IsEmpty() {
+		return fmt.Errorf("%w: zero trace or span ID", ErrInvalidB3)
+	}
+	return nil
+}
+
+func parseB3SamplingState(state string) (Sampling, error) {
+	switch state {
+	case "0":
+		return SamplingDeny, nil
+	case "1":
+		return SamplingAccept, nil
+	case "d":
+		return SamplingDebug, nil
+	}
+	return SamplingUnset, fmt.Errorf("%w: invalid sampling state %q", ErrInvalidB3, state)
+}
+
+func formatB3SamplingState(sampling Sampling) string {
+	switch sampling {
+	case SamplingDeny:
+		return "0"
+	case SamplingAccept:
+		return "1"
+	case SamplingDebug:
+		return "d"
+	}
+	return ""
+}
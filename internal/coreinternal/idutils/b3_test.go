@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils
+
+import (
+	"net/http"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+var (
+	testTraceID      = pdata.NewTraceID([16]byte{0x46, 0x3a, 0xc3, 0x5c, 0x9f, 0x64, 0x13, 0xad, 0x48, 0x48, 0x5a, 0x39, 0x53, 0xbb, 0x61, 0x24})
+	testSpanID       = pdata.NewSpanID([8]byte{0x0, 0x20, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1})
+	testParentSpanID = pdata.NewSpanID([8]byte{0x0, 0x20, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2})
+)
+
+func TestParseB3Single(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    SpanContext
+		wantErr bool
+	}{
+		{
+			name:  "all fields",
+			value: "463ac35c9f6413ad48485a3953bb6124-0020000000000001-1-0020000000000002",
+			want:  SpanContext{TraceID: testTraceID, SpanID: testSpanID, ParentSpanID: testParentSpanID, Sampling: SamplingAccept},
+		},
+		{
+			name:  "ids only",
+			value: "463ac35c9f6413ad48485a3953bb6124-0020000000000001",
+			want:  SpanContext{TraceID: testTraceID, SpanID: testSpanID},
+		},
+		{
+			name:  "64-bit trace id",
+			value: "48485a3953bb6124-0020000000000001-d",
+			want:  SpanContext{TraceID: UInt64ToTraceID(0, 0x48485a3953bb6124), SpanID: testSpanID, Sampling: SamplingDebug},
+		},
+		{
+			name:  "sampling only",
+			value: "0",
+			want:  SpanContext{Sampling: SamplingDeny},
+		},
+		{
+			name:    "empty",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid sampling state",
+			value:   "463ac35c9f6413ad48485a3953bb6124-0020000000000001-x",
+			wantErr: true,
+		},
+		{
+			name:    "invalid span id",
+			value:   "463ac35c9f6413ad48485a3953bb6124-00200000",
+			wantErr: true,
+		},
+		{
+			name:    "zero trace id",
+			value:   "0000000000000000-0020000000000001",
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			value:   "463ac35c9f6413ad48485a3953bb6124-0020000000000001-1-0020000000000002-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseB3Single(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidB3)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatB3Single(t *testing.T) {
+	assert.Equal(t,
+		"463ac35c9f6413ad48485a3953bb6124-0020000000000001-1-0020000000000002",
+		FormatB3Single(SpanContext{TraceID: testTraceID, SpanID: testSpanID, ParentSpanID: testParentSpanID, Sampling: SamplingAccept}))
+	assert.Equal(t,
+		"463ac35c9f6413ad48485a3953bb6124-0020000000000001",
+		FormatB3Single(SpanContext{TraceID: testTraceID, SpanID: testSpanID, ParentSpanID: testParentSpanID}))
+	assert.Equal(t, "d", FormatB3Single(SpanContext{Sampling: SamplingDebug}))
+}
+
+func TestParseB3Multi(t *testing.T) {
+	header := http.Header{}
+	header.Set(B3TraceIDHeader, "463ac35c9f6413ad48485a3953bb6124")
+	header.Set(B3SpanIDHeader, "0020000000000001")
+	header.Set(B3ParentSpanIDHeader, "0020000000000002")
+	header.Set(B3SampledHeader, "true")
+
+	got, err := ParseB3Multi(header.Get)
+	require.NoError(t, err)
+	assert.Equal(t, SpanContext{TraceID: testTraceID, SpanID: testSpanID, ParentSpanID: testParentSpanID, Sampling: SamplingAccept}, got)
+
+	header.Set(B3FlagsHeader, "1")
+	got, err = ParseB3Multi(header.Get)
+	require.NoError(t, err)
+	assert.Equal(t, SamplingDebug, got.Sampling)
+
+	header.Del(B3FlagsHeader)
+	header.Set(B3SampledHeader, "yes")
+	_, err = ParseB3Multi(header.Get)
+	assert.ErrorIs(t, err, ErrInvalidB3)
+
+	header = http.Header{}
+	header.Set(B3TraceIDHeader, "463ac35c9f6413ad48485a3953bb6124")
+	_, err = ParseB3Multi(header.Get)
+	assert.ErrorIs(t, err, ErrInvalidB3)
+}
+
+func TestFormatB3Multi(t *testing.T) {
+	header := http.Header{}
+	FormatB3Multi(SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampling: SamplingDebug}, header.Set)
+	assert.Equal(t, http.Header{
+		"X-B3-Traceid": {"463ac35c9f6413ad48485a3953bb6124"},
+		"X-B3-Spanid":  {"0020000000000001"},
+		"X-B3-Flags":   {"1"},
+	}, header)
+}
+
+func TestB3RoundTrip(t *testing.T) {
+	roundTrip := func(traceID [16]byte, spanID, parentSpanID [8]byte, sampling uint8) bool {
+		sc := SpanContext{
+			TraceID:      pdata.NewTraceID(traceID),
+			SpanID:       pdata.NewSpanID(spanID),
+			ParentSpanID: pdata.NewSpanID(parentSpanID),
+			// The parent span ID is only formatted along with a sampling decision.
+			Sampling: Sampling(sampling%3) + SamplingDeny,
+		}
+		if sc.TraceID.IsEmpty() || sc.SpanID.IsEmpty() {
+			return true
+		}
+
+		single, err := ParseB3Single(FormatB3Single(sc))
+		if err != nil || single != sc {
+			return false
+		}
+
+		header := http.Header{}
+		FormatB3Multi(sc, header.Set)
+		multi, err := ParseB3Multi(header.Get)
+		return err == nil && multi == sc
+	}
+	assert.NoError(t, quick.Check(roundTrip, nil))
+}
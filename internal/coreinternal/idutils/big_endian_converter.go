@@ -34,6 +34,18 @@ func TraceIDToUInt64Pair(traceID pdata.TraceID) (uint64, uint64) {
 	return binary.BigEndian.Uint64(bytes[:8]), binary.BigEndian.Uint64(bytes[8:])
 }
 
+// LowUInt64ToTraceID converts a 64-bit trace ID, as used by B3, Zipkin or Datadog, to a 128-bit
+// pdata.TraceID, with the high 64 bits set to zero.
+func LowUInt64ToTraceID(low uint64) pdata.TraceID {
+	return UInt64ToTraceID(0, low)
+}
+
+// TraceIDToLowUInt64 converts the pdata.TraceID to a 64-bit trace ID, keeping its low 64 bits.
+func TraceIDToLowUInt64(traceID pdata.TraceID) uint64 {
+	_, low := TraceIDToUInt64Pair(traceID)
+	return low
+}
+
 // UInt64ToSpanID converts the uint64 representation of a SpanID to pdata.SpanID.
 func UInt64ToSpanID(id uint64) pdata.SpanID {
 	spanID := [8]byte{}
@@ -73,3 +73,12 @@ func TestSpanIdUInt64RoundTrip(t *testing.T) {
 	w := uint64(0x0001020304050607)
 	assert.Equal(t, w, SpanIDToUInt64(UInt64ToSpanID(w)))
 }
+
+func TestLowUInt64TraceIDRoundTrip(t *testing.T) {
+	w := uint64(0x0001020304050607)
+	traceID := LowUInt64ToTraceID(w)
+	assert.Equal(t, UInt64ToTraceID(0, w), traceID)
+	assert.Equal(t, w, TraceIDToLowUInt64(traceID))
+	// The high 64 bits are dropped.
+	assert.Equal(t, w, TraceIDToLowUInt64(UInt64ToTraceID(math.MaxUint64, w)))
+}
@@ -15,5 +15,9 @@
 // Package idutils provides a set of helper functions to convert ids.
 //
 // Functions in big_endian_converter.go help converting uint64 ids to TraceID
-// and SpanID using big endian, and vice versa.
+// and SpanID using big endian, and vice versa, including 64-bit trace ids.
+//
+// Functions in hex_converter.go parse hex encoded TraceID and SpanID, while
+// b3.go and w3c.go parse and format the B3 and W3C trace context propagation
+// headers.
 package idutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+var (
+	// ErrInvalidHexIDLength is returned when parsing a hex encoded ID which has an unexpected length.
+	ErrInvalidHexIDLength = errors.New("hex ID has wrong length")
+	// ErrInvalidHexID is returned when parsing an ID which is not hex encoded.
+	ErrInvalidHexID = errors.New("failed to parse hex ID")
+)
+
+// HexToTraceID parses the 16 or 32 characters hex representation of a trace ID. A 16 characters
+// (64-bit) trace ID is converted to a 128-bit pdata.TraceID with the high 64 bits set to zero.
+func HexToTraceID(s string) (pdata.TraceID, error) {
+	traceID := [16]byte{}
+	switch len(s) {
+	case 16:
+		if _, err := hex.Decode(traceID[8:], []byte(s)); err != nil {
+			return pdata.InvalidTraceID(), fmt.Errorf("%w: %v", ErrInvalidHexID, err)
+		}
+	case 32:
+		if _, err := hex.Decode(traceID[:], []byte(s)); err != nil {
+			return pdata.InvalidTraceID(), fmt.Errorf("%w: %v", ErrInvalidHexID, err)
+		}
+	default:
+		return pdata.InvalidTraceID(), fmt.Errorf("%w: %d (expected 16 or 32)", ErrInvalidHexIDLength, len(s))
+	}
+	return pdata.NewTraceID(traceID), nil
+}
+
+// HexToSpanID parses the 16 characters hex representation of a span ID.
+func HexToSpanID(s string) (pdata.SpanID, error) {
+	spanID := [8]byte{}
+	if len(s) != 16 {
+		return pdata.InvalidSpanID(), fmt.Errorf("%w: %d (expected 16)", ErrInvalidHexIDLength, len(s))
+	}
+	if _, err := hex.Decode(spanID[:], []byte(s)); err != nil {
+		return pdata.InvalidSpanID(), fmt.Errorf("%w: %v", ErrInvalidHexID, err)
+	}
+	return pdata.NewSpanID(spanID), nil
+}
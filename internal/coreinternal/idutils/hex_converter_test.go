@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestHexToTraceID(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    pdata.TraceID
+		wantErr error
+	}{
+		{
+			name: "128-bit",
+			hex:  "0102030405060708090a0b0c0d0e0f10",
+			want: pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+		},
+		{
+			name: "64-bit",
+			hex:  "0102030405060708",
+			want: pdata.NewTraceID([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}),
+		},
+		{
+			name:    "wrong length",
+			hex:     "01020304",
+			want:    pdata.InvalidTraceID(),
+			wantErr: ErrInvalidHexIDLength,
+		},
+		{
+			name:    "not hex",
+			hex:     "010203040506070x",
+			want:    pdata.InvalidTraceID(),
+			wantErr: ErrInvalidHexID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HexToTraceID(tt.hex)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHexToSpanID(t *testing.T) {
+	got, err := HexToSpanID("0102030405060708")
+	require.NoError(t, err)
+	assert.Equal(t, pdata.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}), got)
+
+	_, err = HexToSpanID("0102030405060708090a0b0c0d0e0f10")
+	assert.ErrorIs(t, err, ErrInvalidHexIDLength)
+
+	_, err = HexToSpanID("zz02030405060708")
+	assert.ErrorIs(t, err, ErrInvalidHexID)
+}
+
+func TestHexRoundTrip(t *testing.T) {
+	traceIDRoundTrip := func(b [16]byte) bool {
+		traceID := pdata.NewTraceID(b)
+		if traceID.IsEmpty() {
+			return true
+		}
+		got, err := HexToTraceID(traceID.HexString())
+		return err == nil && got == traceID
+	}
+	assert.NoError(t, quick.Check(traceIDRoundTrip, nil))
+
+	spanIDRoundTrip := func(b [8]byte) bool {
+		spanID := pdata.NewSpanID(b)
+		if spanID.IsEmpty() {
+			return true
+		}
+		got, err := HexToSpanID(spanID.HexString())
+		return err == nil && got == spanID
+	}
+	assert.NoError(t, quick.Check(spanIDRoundTrip, nil))
+
+	lowRoundTrip := func(low uint64) bool {
+		if low == 0 {
+			return true
+		}
+		got, err := HexToTraceID(UInt64ToSpanID(low).HexString())
+		return err == nil && got == LowUInt64ToTraceID(low)
+	}
+	assert.NoError(t, quick.Check(lowRoundTrip, nil))
+}
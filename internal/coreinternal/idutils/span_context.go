@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
+
+import (
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Sampling is the sampling decision propagated along with a span context.
+type Sampling int
+
+const (
+	// SamplingUnset means that no sampling decision was propagated.
+	SamplingUnset Sampling = iota
+	// SamplingDeny means that the trace is not sampled.
+	SamplingDeny
+	// SamplingAccept means that the trace is sampled.
+	SamplingAccept
+	// SamplingDebug means that the trace is sampled and flagged for debugging.
+	SamplingDebug
+)
+
+// SpanContext holds the identifiers and the sampling decision propagated by the B3 and
+// W3C trace context headers.
+type SpanContext struct {
+	TraceID pdata.TraceID
+	SpanID  pdata.SpanID
+	// ParentSpanID is only propagated by B3, it is ignored by W3C trace context.
+	ParentSpanID pdata.SpanID
+	Sampling     Sampling
+}
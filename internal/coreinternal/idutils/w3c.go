@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TraceparentHeader is the W3C trace context header, see https://www.w3.org/TR/trace-context/.
+const TraceparentHeader = "traceparent"
+
+const (
+	traceparentVersion     = "00"
+	traceparentLength      = 55
+	traceparentSampledFlag = 0x01
+)
+
+// ErrInvalidTraceparent is returned when parsing an invalid traceparent header.
+var ErrInvalidTraceparent = errors.New("invalid traceparent header")
+
+// ParseTraceparent parses the value of the W3C traceparent header, in the
+// {version}-{trace-id}-{parent-id}-{trace-flags} format. The parent-id is the ID of the
+// span which sent the request, it is set as the SpanID of the returned span context.
+// The sampling is either accepted or denied depending on the sampled trace flag.
+func ParseTraceparent(value string) (SpanContext, error) {
+	var sc SpanContext
+
+	parts := strings.Split(value, "-")
+	if len(parts) < 4 || len(parts[0]) != 2 {
+		return sc, fmt.Errorf("%w: %q", ErrInvalidTraceparent, value)
+	}
+	version, err := hex.DecodeString(parts[0])
+	if err != nil || version[0] == 0xff {
+		return sc, fmt.Errorf("%w: invalid version %q", ErrInvalidTraceparent, parts[0])
+	}
+	// Future versions may append fields, the version 00 ones are parsed anyway.
+	if parts[0] == traceparentVersion && (len(value) != traceparentLength || len(parts) != 4) {
+		return sc, fmt.Errorf("%w: %q", ErrInvalidTraceparent, value)
+	}
+
+	if len(parts[1]) != 32 {
+		return sc, fmt.Errorf("%w: trace ID length %d (expected 32)", ErrInvalidTraceparent, len(parts[1]))
+	}
+	if sc.TraceID, err = HexToTraceID(parts[1]); err != nil {
+		return sc, fmt.Errorf("%w: trace ID: %v", ErrInvalidTraceparent, err)
+	}
+	if sc.SpanID, err = HexToSpanID(parts[2]); err != nil {
+		return sc, fmt.Errorf("%w: parent ID: %v", ErrInvalidTraceparent, err)
+	}
+	if sc.TraceID.IsEmpty() || sc.SpanID.IsEmpty() {
+		return sc, fmt.Errorf("%w: zero trace or parent ID", ErrInvalidTraceparent)
+	}
+
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return sc, fmt.Errorf("%w: invalid trace flags %q", ErrInvalidTraceparent, parts[3])
+	}
+	sc.Sampling = SamplingDeny
+	if flags[0]&traceparentSampledFlag != 0 {
+		sc.Sampling = SamplingAccept
+	}
+	return sc, nil
+}
+
+// FormatTraceparent formats the value of the W3C traceparent header for the span context.
+// The accept and debug sampling decisions set the sampled trace flag.
+func FormatTraceparent(sc SpanContext) string {
+	flags := "00"
+	if sc.Sampling == SamplingAccept || sc.Sampling == SamplingDebug {
+		flags = "01"
+	}
+	return traceparentVersion + "-" + sc.TraceID.HexString() + "-" + sc.SpanID.HexString() + "-" + flags
+}
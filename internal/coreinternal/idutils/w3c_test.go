@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package idutils
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    SpanContext
+		wantErr bool
+	}{
+		{
+			name:  "sampled",
+			value: "00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-01",
+			want:  SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampling: SamplingAccept},
+		},
+		{
+			name:  "not sampled",
+			value: "00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-00",
+			want:  SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampling: SamplingDeny},
+		},
+		{
+			name:  "future version with extra fields",
+			value: "01-463ac35c9f6413ad48485a3953bb6124-0020000000000001-01-extra",
+			want:  SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampling: SamplingAccept},
+		},
+		{
+			name:    "version 00 with extra fields",
+			value:   "00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-01-extra",
+			wantErr: true,
+		},
+		{
+			name:    "invalid version",
+			value:   "ff-463ac35c9f6413ad48485a3953bb6124-0020000000000001-01",
+			wantErr: true,
+		},
+		{
+			name:    "64-bit trace id",
+			value:   "00-48485a3953bb6124-0020000000000001-01",
+			wantErr: true,
+		},
+		{
+			name:    "zero span id",
+			value:   "00-463ac35c9f6413ad48485a3953bb6124-0000000000000000-01",
+			wantErr: true,
+		},
+		{
+			name:    "invalid flags",
+			value:   "00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-0x",
+			wantErr: true,
+		},
+		{
+			name:    "missing fields",
+			value:   "00-463ac35c9f6413ad48485a3953bb6124",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTraceparent(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTraceparent)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatTraceparent(t *testing.T) {
+	assert.Equal(t,
+		"00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-01",
+		FormatTraceparent(SpanContext{TraceID: testTraceID, SpanID: testSpanID, Sampling: SamplingDebug}))
+	assert.Equal(t,
+		"00-463ac35c9f6413ad48485a3953bb6124-0020000000000001-00",
+		FormatTraceparent(SpanContext{TraceID: testTraceID, SpanID: testSpanID}))
+}
+
+func TestTraceparentRoundTrip(t *testing.T) {
+	roundTrip := func(traceID [16]byte, spanID [8]byte, sampled bool) bool {
+		sc := SpanContext{
+			TraceID:  pdata.NewTraceID(traceID),
+			SpanID:   pdata.NewSpanID(spanID),
+			Sampling: SamplingDeny,
+		}
+		if sampled {
+			sc.Sampling = SamplingAccept
+		}
+		if sc.TraceID.IsEmpty() || sc.SpanID.IsEmpty() {
+			return true
+		}
+		got, err := ParseTraceparent(FormatTraceparent(sc))
+		return err == nil && got == sc
+	}
+	assert.NoError(t, quick.Check(roundTrip, nil))
+}
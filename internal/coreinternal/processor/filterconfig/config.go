@@ -16,6 +16,10 @@ package filterconfig // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 )
@@ -74,9 +78,12 @@ type MatchProperties struct {
 	// Note: For spans, one of Services, SpanNames, Attributes, Resources or Libraries must be specified with a
 	// non-empty value for a valid configuration.
 
-	// For logs, one of LogNames, Attributes, Resources or Libraries must be specified with a
+	// For logs, one of LogNames, LogSeverityMin, Attributes, Resources or Libraries must be specified with a
 	// non-empty value for a valid configuration.
 
+	// For metrics, one of MetricNames, MetricTypes, Attributes, Resources or Libraries must be specified
+	// with a non-empty value for a valid configuration.
+
 	// Services specify the list of of items to match service name against.
 	// A match occurs if the span's service name matches at least one item in this list.
 	// This is an optional field.
@@ -92,6 +99,24 @@ type MatchProperties struct {
 	// Deprecated: the Name field is removed from the log data model.
 	LogNames []string `mapstructure:"log_names"`
 
+	// LogSeverityMin is the minimum severity of the log records to match, among
+	// TRACE, DEBUG, INFO, WARN, ERROR and FATAL. A log record matches if its severity
+	// number is at least the lowest severity number of this severity, log records
+	// without severity number never match.
+	// This is an optional field.
+	LogSeverityMin string `mapstructure:"log_severity_min"`
+
+	// MetricNames specify the list of items to match metric names against.
+	// A match occurs if the metric name matches at least one item in this list.
+	// This is an optional field.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// MetricTypes specify the list of data types to match metrics against, among gauge, sum,
+	// histogram, exponential_histogram and summary.
+	// A match occurs if the metric data type is in this list.
+	// This is an optional field.
+	MetricTypes []string `mapstructure:"metric_types"`
+
 	// Attributes specifies the list of attributes to match against.
 	// All of these attributes must match exactly for a match to occur.
 	// Only match_type=strict is allowed if "attributes" are specified.
@@ -109,12 +134,39 @@ type MatchProperties struct {
 	Libraries []InstrumentationLibrary `mapstructure:"libraries"`
 }
 
+// severityNumbers are the lowest severity numbers of the severities supported by "log_severity_min".
+var severityNumbers = map[string]pdata.SeverityNumber{
+	"TRACE": pdata.SeverityNumberTRACE,
+	"DEBUG": pdata.SeverityNumberDEBUG,
+	"INFO":  pdata.SeverityNumberINFO,
+	"WARN":  pdata.SeverityNumberWARN,
+	"ERROR": pdata.SeverityNumberERROR,
+	"FATAL": pdata.SeverityNumberFATAL,
+}
+
+// LogSeverityNumberMin returns the minimum severity number of the log records to match,
+// SeverityNumberUNDEFINED if LogSeverityMin isn't specified.
+func (mp *MatchProperties) LogSeverityNumberMin() (pdata.SeverityNumber, error) {
+	if mp.LogSeverityMin == "" {
+		return pdata.SeverityNumberUNDEFINED, nil
+	}
+	number, ok := severityNumbers[strings.ToUpper(mp.LogSeverityMin)]
+	if !ok {
+		return pdata.SeverityNumberUNDEFINED, fmt.Errorf("unsupported log_severity_min %q, must be one of TRACE, DEBUG, INFO, WARN, ERROR or FATAL", mp.LogSeverityMin)
+	}
+	return number, nil
+}
+
 // ValidateForSpans validates properties for spans.
 func (mp *MatchProperties) ValidateForSpans() error {
 	if len(mp.LogNames) > 0 {
 		return errors.New("log_names should not be specified for trace spans")
 	}
 
+	if mp.LogSeverityMin != "" || len(mp.MetricNames) > 0 || len(mp.MetricTypes) > 0 {
+		return errors.New("none of log_severity_min, metric_names and metric_types should be specified for trace spans")
+	}
+
 	if len(mp.Services) == 0 && len(mp.SpanNames) == 0 && len(mp.Attributes) == 0 &&
 		len(mp.Libraries) == 0 && len(mp.Resources) == 0 {
 		return errors.New(`at least one of "services", "span_names", "attributes", "libraries" or "resources" field must be specified`)
@@ -129,8 +181,34 @@ func (mp *MatchProperties) ValidateForLogs() error {
 		return errors.New("neither services nor span_names should be specified for log records")
 	}
 
-	if len(mp.Attributes) == 0 && len(mp.Libraries) == 0 && len(mp.Resources) == 0 {
-		return errors.New(`at least one of "attributes", "libraries" or "resources" field must be specified`)
+	if len(mp.MetricNames) > 0 || len(mp.MetricTypes) > 0 {
+		return errors.New("neither metric_names nor metric_types should be specified for log records")
+	}
+
+	if _, err := mp.LogSeverityNumberMin(); err != nil {
+		return err
+	}
+
+	if len(mp.Attributes) == 0 && len(mp.Libraries) == 0 && len(mp.Resources) == 0 && mp.LogSeverityMin == "" {
+		return errors.New(`at least one of "attributes", "libraries", "resources" or "log_severity_min" field must be specified`)
+	}
+
+	return nil
+}
+
+// ValidateForMetrics validates properties for metrics.
+func (mp *MatchProperties) ValidateForMetrics() error {
+	if len(mp.SpanNames) > 0 || len(mp.Services) > 0 {
+		return errors.New("neither services nor span_names should be specified for metrics")
+	}
+
+	if len(mp.LogNames) > 0 || mp.LogSeverityMin != "" {
+		return errors.New("neither log_names nor log_severity_min should be specified for metrics")
+	}
+
+	if len(mp.MetricNames) == 0 && len(mp.MetricTypes) == 0 && len(mp.Attributes) == 0 &&
+		len(mp.Libraries) == 0 && len(mp.Resources) == 0 {
+		return errors.New(`at least one of "metric_names", "metric_types", "attributes", "libraries" or "resources" field must be specified`)
 	}
 
 	return nil
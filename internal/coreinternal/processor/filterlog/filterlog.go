@@ -38,6 +38,9 @@ type propertiesMatcher struct {
 
 	// log names to compare to.
 	nameFilters filterset.FilterSet
+
+	// minimum severity number, SeverityNumberUNDEFINED if the severity is not compared.
+	severityMin pdata.SeverityNumber
 }
 
 // NewMatcher creates a LogRecord Matcher that matches based on the given MatchProperties.
@@ -63,23 +66,34 @@ func NewMatcher(mp *filterconfig.MatchProperties) (Matcher, error) {
 		}
 	}
 
+	severityMin, err := mp.LogSeverityNumberMin()
+	if err != nil {
+		return nil, err
+	}
+
 	return &propertiesMatcher{
 		PropertiesMatcher: rm,
 		nameFilters:       nameFS,
+		severityMin:       severityMin,
 	}, nil
 }
 
 // MatchLogRecord matches a log record to a set of properties.
 // There are 3 sets of properties to match against.
 // The log record names are matched, if specified.
+// The log record severity is compared to the minimum severity, if specified.
 // The attributes are then checked, if specified.
-// At least one of log record names or attributes must be specified. It is
-// supported to have more than one of these specified, and all specified must
-// evaluate to true for a match to occur.
+// At least one of log record names, minimum severity or attributes must be
+// specified. It is supported to have more than one of these specified, and all
+// specified must evaluate to true for a match to occur.
 func (mp *propertiesMatcher) MatchLogRecord(lr pdata.LogRecord, resource pdata.Resource, library pdata.InstrumentationLibrary) bool {
 	if mp.nameFilters != nil && !mp.nameFilters.Matches(lr.Name()) {
 		return false
 	}
 
+	if mp.severityMin != pdata.SeverityNumberUNDEFINED && lr.SeverityNumber() < mp.severityMin {
+		return false
+	}
+
 	return mp.PropertiesMatcher.Match(lr.Attributes(), resource, library)
 }
@@ -40,14 +40,14 @@ func TestLogRecord_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 		{
 			name:        "empty_property",
 			property:    filterconfig.MatchProperties{},
-			errorString: "at least one of \"attributes\", \"libraries\" or \"resources\" field must be specified",
+			errorString: "at least one of \"attributes\", \"libraries\", \"resources\" or \"log_severity_min\" field must be specified",
 		},
 		{
 			name: "empty_log_names_and_attributes",
 			property: filterconfig.MatchProperties{
 				LogNames: []string{},
 			},
-			errorString: "at least one of \"attributes\", \"libraries\" or \"resources\" field must be specified",
+			errorString: "at least one of \"attributes\", \"libraries\", \"resources\" or \"log_severity_min\" field must be specified",
 		},
 		{
 			name: "span_properties",
@@ -56,6 +56,20 @@ func TestLogRecord_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 			},
 			errorString: "neither services nor span_names should be specified for log records",
 		},
+		{
+			name: "metric_properties",
+			property: filterconfig.MatchProperties{
+				MetricNames: []string{"metric"},
+			},
+			errorString: "neither metric_names nor metric_types should be specified for log records",
+		},
+		{
+			name: "invalid_log_severity_min",
+			property: filterconfig.MatchProperties{
+				LogSeverityMin: "CRITICAL",
+			},
+			errorString: "unsupported log_severity_min \"CRITICAL\", must be one of TRACE, DEBUG, INFO, WARN, ERROR or FATAL",
+		},
 		{
 			name: "invalid_match_type",
 			property: filterconfig.MatchProperties{
@@ -165,3 +179,49 @@ func TestLogRecord_Matching_True(t *testing.T) {
 		})
 	}
 }
+
+func TestLogRecord_MatchingSeverity(t *testing.T) {
+	testcases := []struct {
+		name     string
+		severity pdata.SeverityNumber
+		matches  bool
+	}{
+		{
+			name:     "undefined",
+			severity: pdata.SeverityNumberUNDEFINED,
+			matches:  false,
+		},
+		{
+			name:     "below",
+			severity: pdata.SeverityNumberINFO4,
+			matches:  false,
+		},
+		{
+			name:     "equal",
+			severity: pdata.SeverityNumberWARN,
+			matches:  true,
+		},
+		{
+			name:     "above",
+			severity: pdata.SeverityNumberERROR2,
+			matches:  true,
+		},
+	}
+
+	mp, err := NewMatcher(&filterconfig.MatchProperties{
+		Config:         *createConfig(filterset.Strict),
+		LogSeverityMin: "warn",
+		Attributes:     []filterconfig.Attribute{{Key: "abc", Value: "def"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, mp)
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			lr := pdata.NewLogRecord()
+			lr.Attributes().InsertString("abc", "def")
+			lr.SetSeverityNumber(tc.severity)
+			assert.Equal(t, tc.matches, mp.MatchLogRecord(lr, pdata.Resource{}, pdata.InstrumentationLibrary{}))
+		})
+	}
+}
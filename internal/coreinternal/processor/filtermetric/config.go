@@ -54,6 +54,17 @@ type MatchProperties struct {
 	// ResourceAttributes defines a list of possible resource attributes to match metrics against.
 	// A match occurs if any resource attribute matches all expressions in this given list.
 	ResourceAttributes []filterconfig.Attribute `mapstructure:"resource_attributes"`
+
+	// MetricTypes specifies the list of data types to match metrics against, among gauge, sum,
+	// histogram, exponential_histogram and summary.
+	// A match occurs if the metric data type is in this list.
+	MetricTypes []string `mapstructure:"metric_types"`
+
+	// AggregationTemporalities specifies the list of aggregation temporalities to match metrics
+	// against, among delta and cumulative.
+	// A match occurs if the metric is a sum, histogram or exponential histogram with an aggregation
+	// temporality in this list.
+	AggregationTemporalities []string `mapstructure:"aggregation_temporalities"`
 }
 
 // ChecksMetrics returns whether or not the check should iterate through all the metrics
@@ -62,12 +73,21 @@ func (mp *MatchProperties) ChecksMetrics() bool {
 		return false
 	}
 
+	if mp.checksShape() {
+		return true
+	}
+
 	if mp.MatchType == Expr {
 		return len(mp.Expressions) > 0
 	}
 	return len(mp.MetricNames) > 0
 }
 
+// checksShape returns whether or not it checks the metric types or aggregation temporalities
+func (mp *MatchProperties) checksShape() bool {
+	return len(mp.MetricTypes) > 0 || len(mp.AggregationTemporalities) > 0
+}
+
 // ChecksResourceAtributes returns whether or not it checks the resource_attributes
 func (mp *MatchProperties) ChecksResourceAtributes() bool {
 	if mp == nil {
@@ -75,6 +75,13 @@ func TestConfig(t *testing.T) {
 		}, {
 			name:   "config/emptyproperties",
 			expCfg: createConfig(nil, filterset.Regexp),
+		}, {
+			name: "config/metrictypes",
+			expCfg: &MatchProperties{
+				MatchType:                Strict,
+				MetricTypes:              []string{"summary", "exponential_histogram"},
+				AggregationTemporalities: []string{"delta"},
+			},
 		},
 	}
 
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermetric // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+// DataPointMatcher is an interface that allows matching a metric data point against
+// a configuration of a match, as used by the processors acting on data point attributes.
+type DataPointMatcher interface {
+	MatchDataPoint(metric pdata.Metric, attributes pdata.AttributeMap, resource pdata.Resource, library pdata.InstrumentationLibrary) bool
+}
+
+// dataPointMatcher allows matching a data point against various metric and data point properties.
+type dataPointMatcher struct {
+	filtermatcher.PropertiesMatcher
+
+	// metric names to compare to.
+	nameFilters filterset.FilterSet
+
+	// metric data types to compare to.
+	shapeMatcher *shapeMatcher
+}
+
+// NewDataPointMatcher creates a DataPointMatcher that matches based on the given MatchProperties.
+func NewDataPointMatcher(mp *filterconfig.MatchProperties) (DataPointMatcher, error) {
+	if mp == nil {
+		return nil, nil
+	}
+
+	if err := mp.ValidateForMetrics(); err != nil {
+		return nil, err
+	}
+
+	rm, err := filtermatcher.NewMatcher(mp)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameFS filterset.FilterSet
+	if len(mp.MetricNames) > 0 {
+		nameFS, err = filterset.CreateFilterSet(mp.MetricNames, &mp.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating metric name filters: %v", err)
+		}
+	}
+
+	var sm *shapeMatcher
+	if len(mp.MetricTypes) > 0 {
+		sm, err = newShapeMatcher(&MatchProperties{MetricTypes: mp.MetricTypes})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dataPointMatcher{
+		PropertiesMatcher: rm,
+		nameFilters:       nameFS,
+		shapeMatcher:      sm,
+	}, nil
+}
+
+// MatchDataPoint matches a data point to a set of properties.
+// The metric names and data types are matched, if specified.
+// The data point attributes, resource and library are then checked, if specified.
+// All specified properties must evaluate to true for a match to occur.
+func (mp *dataPointMatcher) MatchDataPoint(metric pdata.Metric, attributes pdata.AttributeMap, resource pdata.Resource, library pdata.InstrumentationLibrary) bool {
+	if mp.nameFilters != nil && !mp.nameFilters.Matches(metric.Name()) {
+		return false
+	}
+
+	if mp.shapeMatcher != nil {
+		if matches, _ := mp.shapeMatcher.MatchMetric(metric); !matches {
+			return false
+		}
+	}
+
+	return mp.PropertiesMatcher.Match(attributes, resource, library)
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+func TestDataPointMatcher_InvalidConfig(t *testing.T) {
+	testcases := []struct {
+		name        string
+		property    filterconfig.MatchProperties
+		errorString string
+	}{
+		{
+			name:        "empty_property",
+			property:    filterconfig.MatchProperties{},
+			errorString: "at least one of \"metric_names\", \"metric_types\", \"attributes\", \"libraries\" or \"resources\" field must be specified",
+		},
+		{
+			name: "span_properties",
+			property: filterconfig.MatchProperties{
+				SpanNames: []string{"span"},
+			},
+			errorString: "neither services nor span_names should be specified for metrics",
+		},
+		{
+			name: "log_properties",
+			property: filterconfig.MatchProperties{
+				LogSeverityMin: "WARN",
+			},
+			errorString: "neither log_names nor log_severity_min should be specified for metrics",
+		},
+		{
+			name: "invalid_match_type",
+			property: filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: "wrong_match_type"},
+				MetricNames: []string{"metric"},
+			},
+			errorString: "error creating metric name filters: unrecognized match_type: 'wrong_match_type', valid types are: [regexp strict]",
+		},
+		{
+			name: "invalid_metric_type",
+			property: filterconfig.MatchProperties{
+				MetricTypes: []string{"counter"},
+			},
+			errorString: "unrecognized metric type \"counter\"",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := NewDataPointMatcher(&tc.property)
+			assert.Nil(t, output)
+			require.NotNil(t, err)
+			assert.Equal(t, tc.errorString, err.Error())
+		})
+	}
+}
+
+func TestDataPointMatcher_Matching(t *testing.T) {
+	testcases := []struct {
+		name       string
+		properties *filterconfig.MatchProperties
+		matches    bool
+	}{
+		{
+			name: "metric_name_regexp_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Regexp},
+				MetricNames: []string{"^http\\..*"},
+			},
+			matches: true,
+		},
+		{
+			name: "metric_name_no_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Strict},
+				MetricNames: []string{"http.server.duration"},
+			},
+			matches: false,
+		},
+		{
+			name: "metric_name_and_attribute_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Strict},
+				MetricNames: []string{"http.server.requests"},
+				Attributes:  []filterconfig.Attribute{{Key: "http.method", Value: "GET"}},
+			},
+			matches: true,
+		},
+		{
+			name: "attribute_no_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Strict},
+				MetricNames: []string{"http.server.requests"},
+				Attributes:  []filterconfig.Attribute{{Key: "http.method", Value: "POST"}},
+			},
+			matches: false,
+		},
+		{
+			name: "metric_type_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Strict},
+				MetricTypes: []string{"gauge", "sum"},
+			},
+			matches: true,
+		},
+		{
+			name: "metric_name_and_type_no_match",
+			properties: &filterconfig.MatchProperties{
+				Config:      filterset.Config{MatchType: filterset.Strict},
+				MetricNames: []string{"http.server.requests"},
+				MetricTypes: []string{"histogram"},
+			},
+			matches: false,
+		},
+		{
+			name: "resource_match",
+			properties: &filterconfig.MatchProperties{
+				Config:    filterset.Config{MatchType: filterset.Strict},
+				Resources: []filterconfig.Attribute{{Key: "service.name", Value: "checkout"}},
+			},
+			matches: true,
+		},
+	}
+
+	metric := pdata.NewMetric()
+	metric.SetName("http.server.requests")
+	metric.SetDataType(pdata.MetricDataTypeSum)
+	attrs := pdata.NewAttributeMap()
+	attrs.InsertString("http.method", "GET")
+	resource := pdata.NewResource()
+	resource.Attributes().InsertString("service.name", "checkout")
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := NewDataPointMatcher(tc.properties)
+			require.NoError(t, err)
+			require.NotNil(t, matcher)
+			assert.Equal(t, tc.matches, matcher.MatchDataPoint(metric, attrs, resource, pdata.NewInstrumentationLibrary()))
+		})
+	}
+}
+
+func TestNewDataPointMatcherNil(t *testing.T) {
+	matcher, err := NewDataPointMatcher(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, matcher)
+}
@@ -23,8 +23,29 @@ type Matcher interface {
 }
 
 // NewMatcher constructs a metric Matcher. If an 'expr' match type is specified,
-// returns an expr matcher, otherwise a name matcher.
+// returns an expr matcher, otherwise a name matcher. When metric types or aggregation
+// temporalities are specified, the metrics must also match them, and the expr or name
+// matcher is only used if expressions or metric names are specified.
 func NewMatcher(config *MatchProperties) (Matcher, error) {
+	if !config.checksShape() {
+		return newPropertiesMatcher(config)
+	}
+
+	shape, err := newShapeMatcher(config)
+	if err != nil {
+		return nil, err
+	}
+	if (config.MatchType == Expr && len(config.Expressions) == 0) || (config.MatchType != Expr && len(config.MetricNames) == 0) {
+		return shape, nil
+	}
+	properties, err := newPropertiesMatcher(config)
+	if err != nil {
+		return nil, err
+	}
+	return allMatcher{properties, shape}, nil
+}
+
+func newPropertiesMatcher(config *MatchProperties) (Matcher, error) {
 	if config.MatchType == Expr {
 		return newExprMatcher(config.Expressions)
 	}
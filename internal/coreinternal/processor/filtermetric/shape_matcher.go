@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermetric // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// metricTypes are the metric data types that can be specified in MatchProperties.MetricTypes.
+var metricTypes = map[string]pdata.MetricDataType{
+	"gauge":                 pdata.MetricDataTypeGauge,
+	"sum":                   pdata.MetricDataTypeSum,
+	"histogram":             pdata.MetricDataTypeHistogram,
+	"exponential_histogram": pdata.MetricDataTypeExponentialHistogram,
+	"summary":               pdata.MetricDataTypeSummary,
+}
+
+// aggregationTemporalities are the aggregation temporalities that can be specified in
+// MatchProperties.AggregationTemporalities.
+var aggregationTemporalities = map[string]pdata.MetricAggregationTemporality{
+	"delta":      pdata.MetricAggregationTemporalityDelta,
+	"cumulative": pdata.MetricAggregationTemporalityCumulative,
+}
+
+// shapeMatcher matches metrics by data type and aggregation temporality.
+type shapeMatcher struct {
+	dataTypes     map[pdata.MetricDataType]bool
+	temporalities map[pdata.MetricAggregationTemporality]bool
+}
+
+func newShapeMatcher(config *MatchProperties) (*shapeMatcher, error) {
+	m := &shapeMatcher{}
+	if len(config.MetricTypes) > 0 {
+		m.dataTypes = make(map[pdata.MetricDataType]bool, len(config.MetricTypes))
+		for _, name := range config.MetricTypes {
+			dataType, ok := metricTypes[name]
+			if !ok {
+				return nil, fmt.Errorf("unrecognized metric type %q", name)
+			}
+			m.dataTypes[dataType] = true
+		}
+	}
+	if len(config.AggregationTemporalities) > 0 {
+		m.temporalities = make(map[pdata.MetricAggregationTemporality]bool, len(config.AggregationTemporalities))
+		for _, name := range config.AggregationTemporalities {
+			temporality, ok := aggregationTemporalities[name]
+			if !ok {
+				return nil, fmt.Errorf("unrecognized aggregation temporality %q", name)
+			}
+			m.temporalities[temporality] = true
+		}
+	}
+	return m, nil
+}
+
+// MatchMetric matches a metric if its data type is one of the configured types and its
+// aggregation temporality one of the configured temporalities. Metrics without aggregation
+// temporality, gauges and summaries, never match configured temporalities.
+func (m *shapeMatcher) MatchMetric(metric pdata.Metric) (bool, error) {
+	if m.dataTypes != nil && !m.dataTypes[metric.DataType()] {
+		return false, nil
+	}
+	if m.temporalities != nil {
+		temporality, ok := aggregationTemporality(metric)
+		if !ok || !m.temporalities[temporality] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func aggregationTemporality(metric pdata.Metric) (pdata.MetricAggregationTemporality, bool) {
+	switch metric.DataType() {
+	case pdata.MetricDataTypeSum:
+		return metric.Sum().AggregationTemporality(), true
+	case pdata.MetricDataTypeHistogram:
+		return metric.Histogram().AggregationTemporality(), true
+	case pdata.MetricDataTypeExponentialHistogram:
+		return metric.ExponentialHistogram().AggregationTemporality(), true
+	}
+	return pdata.MetricAggregationTemporalityUnspecified, false
+}
+
+// allMatcher matches metrics matched by all of its matchers.
+type allMatcher []Matcher
+
+func (m allMatcher) MatchMetric(metric pdata.Metric) (bool, error) {
+	for _, matcher := range m {
+		matches, err := matcher.MatchMetric(metric)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermetric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func createShapedMetric(name string, dataType pdata.MetricDataType, temporality pdata.MetricAggregationTemporality) pdata.Metric {
+	metric := createMetric(name)
+	metric.SetDataType(dataType)
+	switch dataType {
+	case pdata.MetricDataTypeSum:
+		metric.Sum().SetAggregationTemporality(temporality)
+	case pdata.MetricDataTypeHistogram:
+		metric.Histogram().SetAggregationTemporality(temporality)
+	case pdata.MetricDataTypeExponentialHistogram:
+		metric.ExponentialHistogram().SetAggregationTemporality(temporality)
+	}
+	return metric
+}
+
+func TestShapeMatcherMatches(t *testing.T) {
+	deltaSum := createShapedMetric("delta_sum", pdata.MetricDataTypeSum, pdata.MetricAggregationTemporalityDelta)
+	cumulativeSum := createShapedMetric("cumulative_sum", pdata.MetricDataTypeSum, pdata.MetricAggregationTemporalityCumulative)
+	deltaExpHistogram := createShapedMetric("delta_exp_histogram", pdata.MetricDataTypeExponentialHistogram, pdata.MetricAggregationTemporalityDelta)
+	summary := createShapedMetric("summary", pdata.MetricDataTypeSummary, pdata.MetricAggregationTemporalityUnspecified)
+	gauge := createShapedMetric("gauge", pdata.MetricDataTypeGauge, pdata.MetricAggregationTemporalityUnspecified)
+
+	tests := []struct {
+		name       string
+		cfg        *MatchProperties
+		matches    []pdata.Metric
+		mismatches []pdata.Metric
+	}{
+		{
+			name: "metricTypes",
+			cfg: &MatchProperties{
+				MatchType:   Strict,
+				MetricTypes: []string{"summary", "exponential_histogram"},
+			},
+			matches:    []pdata.Metric{summary, deltaExpHistogram},
+			mismatches: []pdata.Metric{deltaSum, cumulativeSum, gauge},
+		},
+		{
+			name: "aggregationTemporalities",
+			cfg: &MatchProperties{
+				MatchType:                Strict,
+				AggregationTemporalities: []string{"delta"},
+			},
+			matches:    []pdata.Metric{deltaSum, deltaExpHistogram},
+			mismatches: []pdata.Metric{cumulativeSum, summary, gauge},
+		},
+		{
+			name: "metricTypesAndAggregationTemporalities",
+			cfg: &MatchProperties{
+				MatchType:                Strict,
+				MetricTypes:              []string{"sum"},
+				AggregationTemporalities: []string{"delta"},
+			},
+			matches:    []pdata.Metric{deltaSum},
+			mismatches: []pdata.Metric{cumulativeSum, deltaExpHistogram, summary, gauge},
+		},
+		{
+			name: "metricNamesAndMetricTypes",
+			cfg: &MatchProperties{
+				MatchType:   Regexp,
+				MetricNames: []string{"^delta_.*"},
+				MetricTypes: []string{"sum"},
+			},
+			matches:    []pdata.Metric{deltaSum},
+			mismatches: []pdata.Metric{cumulativeSum, deltaExpHistogram, summary, gauge},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, test.cfg.ChecksMetrics())
+
+			matcher, err := NewMatcher(test.cfg)
+			require.NoError(t, err)
+			for _, metric := range test.matches {
+				matches, err := matcher.MatchMetric(metric)
+				assert.NoError(t, err)
+				assert.True(t, matches, metric.Name())
+			}
+			for _, metric := range test.mismatches {
+				matches, err := matcher.MatchMetric(metric)
+				assert.NoError(t, err)
+				assert.False(t, matches, metric.Name())
+			}
+		})
+	}
+}
+
+func TestShapeMatcherInvalidConfig(t *testing.T) {
+	_, err := NewMatcher(&MatchProperties{MatchType: Strict, MetricTypes: []string{"int_gauge"}})
+	assert.EqualError(t, err, `unrecognized metric type "int_gauge"`)
+
+	_, err = NewMatcher(&MatchProperties{MatchType: Strict, AggregationTemporalities: []string{"unspecified"}})
+	assert.EqualError(t, err, `unrecognized aggregation temporality "unspecified"`)
+}
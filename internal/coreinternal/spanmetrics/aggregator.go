@@ -0,0 +1,324 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// The names of the generated metrics, without prefix.
+const (
+	CallsMetricName   = "calls_total"
+	ErrorsMetricName  = "errors_total"
+	LatencyMetricName = "latency"
+
+	metricKeySeparator = string(byte(0))
+
+	traceIDKey = "trace_id"
+	spanIDKey  = "span_id"
+)
+
+// AggregatorSettings defines how the spans are aggregated into metrics.
+type AggregatorSettings struct {
+	// MetricNamePrefix is prepended to the names of the generated metrics.
+	MetricNamePrefix string
+	// LatencyBounds are the latency histogram bounds in milliseconds, see LatencyBounds.
+	LatencyBounds []float64
+	// Dimensions are the additional dimensions on top of service.name, operation,
+	// span.kind and status.code.
+	Dimensions []Dimension
+	// MaxSeries limits the number of series kept, the spans of new series are dropped
+	// once reached. Zero means no limit.
+	MaxSeries int
+	// MaxExemplarsPerBucket is the maximum number of exemplars, holding the trace
+	// and span IDs of the first spans seen, attached to each latency histogram
+	// bucket per collection. Zero disables the exemplars.
+	MaxExemplarsPerBucket int
+	// Temporality of the generated metrics. The aggregated values are reset after
+	// each collection when delta.
+	Temporality pdata.MetricAggregationTemporality
+}
+
+// exemplar is the latency of a span with its trace and span IDs.
+type exemplar struct {
+	traceID pdata.TraceID
+	spanID  pdata.SpanID
+	value   float64
+	// bucket is the index of the latency histogram bucket the value falls into.
+	bucket int
+}
+
+// series holds the aggregated values of a unique set of dimensions.
+type series struct {
+	dimensions          pdata.AttributeMap
+	calls               int64
+	errors              int64
+	latencyCount        uint64
+	latencySum          float64
+	latencyBucketCounts []uint64
+	exemplars           []exemplar
+}
+
+// SeriesDataPoints are the data points the aggregated values of a series are
+// written to.
+type SeriesDataPoints struct {
+	Calls   pdata.NumberDataPoint
+	Errors  pdata.NumberDataPoint
+	Latency pdata.HistogramDataPoint
+}
+
+// Aggregator aggregates spans into calls, errors and latency metrics per service,
+// operation, span kind, status code and the configured additional dimensions.
+// It is safe for concurrent use.
+type Aggregator struct {
+	settings AggregatorSettings
+
+	lock      sync.Mutex
+	startTime time.Time
+	series    map[string]*series
+}
+
+// NewAggregator creates an Aggregator with the given settings.
+func NewAggregator(settings AggregatorSettings) *Aggregator {
+	if settings.LatencyBounds == nil {
+		settings.LatencyBounds = DefaultLatencyHistogramBucketsMs
+	}
+	return &Aggregator{
+		settings:  settings,
+		startTime: time.Now(),
+		series:    make(map[string]*series),
+	}
+}
+
+// Aggregate adds the spans of the given traces to the aggregated values. The spans
+// without a service.name resource attribute are ignored. Returns the number of spans
+// dropped because of the MaxSeries limit.
+func (a *Aggregator) Aggregate(traces pdata.Traces) (dropped int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rss := traces.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resourceAttrs := rs.Resource().Attributes()
+		attr, ok := resourceAttrs.Get(ServiceNameKey)
+		if !ok {
+			continue
+		}
+		serviceName := attr.StringVal()
+
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if !a.aggregateSpan(serviceName, spans.At(k), resourceAttrs) {
+					dropped++
+				}
+			}
+		}
+	}
+	return dropped
+}
+
+func (a *Aggregator) aggregateSpan(serviceName string, span pdata.Span, resourceAttrs pdata.AttributeMap) bool {
+	key := BuildKey(serviceName, span, a.settings.Dimensions, resourceAttrs)
+	s, ok := a.series[key]
+	if !ok {
+		if a.settings.MaxSeries > 0 && len(a.series) >= a.settings.MaxSeries {
+			return false
+		}
+		s = &series{
+			dimensions:          BuildDimensions(serviceName, span, a.settings.Dimensions, resourceAttrs),
+			latencyBucketCounts: make([]uint64, len(a.settings.LatencyBounds)),
+		}
+		a.series[key] = s
+	}
+
+	latencyInMilliseconds := float64(span.EndTimestamp()-span.StartTimestamp()) / float64(time.Millisecond.Nanoseconds())
+	// Binary search to find the latencyInMilliseconds bucket index.
+	index := sort.SearchFloat64s(a.settings.LatencyBounds, latencyInMilliseconds)
+
+	s.calls++
+	if span.Status().Code() == pdata.StatusCodeError {
+		s.errors++
+	}
+	s.latencyCount++
+	s.latencySum += latencyInMilliseconds
+	s.latencyBucketCounts[index]++
+	a.addExemplar(s, latencyInMilliseconds, index, span.TraceID(), span.SpanID())
+	return true
+}
+
+// addExemplar appends the exemplar of a span to the series, unless the span has
+// no trace ID or the bucket already holds the maximum number of exemplars.
+func (a *Aggregator) addExemplar(s *series, value float64, index int, traceID pdata.TraceID, spanID pdata.SpanID) {
+	if traceID.IsEmpty() {
+		return
+	}
+
+	inBucket := 0
+	for _, e := range s.exemplars {
+		if e.bucket == index {
+			inBucket++
+		}
+	}
+	if inBucket >= a.settings.MaxExemplarsPerBucket {
+		return
+	}
+
+	s.exemplars = append(s.exemplars, exemplar{
+		traceID: traceID,
+		spanID:  spanID,
+		value:   value,
+		bucket:  index,
+	})
+}
+
+// BuildKey builds the series key from the service name and span metadata such as operation, kind, status_code and
+// any additional dimensions that match the span's attributes or resource attributes. If the dimension exists in
+// both, the span's attributes, being the most specific, takes precedence.
+//
+// The series key is a simple concatenation of dimension values, delimited by a null character.
+func BuildKey(serviceName string, span pdata.Span, dimensions []Dimension, resourceAttrs pdata.AttributeMap) string {
+	var b strings.Builder
+	b.WriteString(serviceName)
+	for _, v := range []string{span.Name(), span.Kind().String(), span.Status().Code().String()} {
+		b.WriteString(metricKeySeparator)
+		b.WriteString(v)
+	}
+	for _, d := range dimensions {
+		if v, ok := GetDimensionValue(d, span.Attributes(), resourceAttrs); ok {
+			b.WriteString(metricKeySeparator)
+			b.WriteString(v.AsString())
+		}
+	}
+	return b.String()
+}
+
+// BuildDimensions builds the attributes of the series of the span, holding the
+// values its key is built from.
+func BuildDimensions(serviceName string, span pdata.Span, dimensions []Dimension, resourceAttrs pdata.AttributeMap) pdata.AttributeMap {
+	dims := pdata.NewAttributeMap()
+	dims.UpsertString(ServiceNameKey, serviceName)
+	dims.UpsertString(OperationKey, span.Name())
+	dims.UpsertString(SpanKindKey, span.Kind().String())
+	dims.UpsertString(StatusCodeKey, span.Status().Code().String())
+	for _, d := range dimensions {
+		if v, ok := GetDimensionValue(d, span.Attributes(), resourceAttrs); ok {
+			dims.Upsert(d.Name, v)
+		}
+	}
+	return dims
+}
+
+// Collect writes the aggregated values of each series into the data points
+// returned by newDataPoints. The aggregated values are reset when the temporality
+// is delta, the exemplars are reset in any case.
+func (a *Aggregator) Collect(newDataPoints func() SeriesDataPoints) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := time.Now()
+	startTimestamp := pdata.NewTimestampFromTime(a.startTime)
+	timestamp := pdata.NewTimestampFromTime(now)
+
+	for _, s := range a.series {
+		dps := newDataPoints()
+		for _, sum := range []struct {
+			dp    pdata.NumberDataPoint
+			value int64
+		}{{dps.Calls, s.calls}, {dps.Errors, s.errors}} {
+			sum.dp.SetStartTimestamp(startTimestamp)
+			sum.dp.SetTimestamp(timestamp)
+			sum.dp.SetIntVal(sum.value)
+			s.dimensions.CopyTo(sum.dp.Attributes())
+		}
+
+		dp := dps.Latency
+		dp.SetStartTimestamp(startTimestamp)
+		dp.SetTimestamp(timestamp)
+		dp.SetExplicitBounds(a.settings.LatencyBounds)
+		dp.SetBucketCounts(append([]uint64(nil), s.latencyBucketCounts...))
+		dp.SetCount(s.latencyCount)
+		dp.SetSum(s.latencySum)
+		setExemplars(s.exemplars, timestamp, dp.Exemplars())
+		s.dimensions.CopyTo(dp.Attributes())
+		s.exemplars = nil
+	}
+
+	if a.settings.Temporality == pdata.MetricAggregationTemporalityDelta {
+		a.series = make(map[string]*series)
+		a.startTime = now
+	}
+}
+
+// Metrics writes the calls, errors and latency metrics of all the series into the given
+// instrumentation library metrics, see Collect.
+func (a *Aggregator) Metrics(ilm pdata.InstrumentationLibraryMetrics) {
+	metrics := pdata.NewMetricSlice()
+	calls := a.newSum(metrics, CallsMetricName)
+	errors := a.newSum(metrics, ErrorsMetricName)
+	latency := metrics.AppendEmpty()
+	latency.SetDataType(pdata.MetricDataTypeHistogram)
+	latency.SetName(a.settings.MetricNamePrefix + LatencyMetricName)
+	latency.SetUnit("ms")
+	latency.Histogram().SetAggregationTemporality(a.settings.Temporality)
+
+	a.Collect(func() SeriesDataPoints {
+		return SeriesDataPoints{
+			Calls:   calls.Sum().DataPoints().AppendEmpty(),
+			Errors:  errors.Sum().DataPoints().AppendEmpty(),
+			Latency: latency.Histogram().DataPoints().AppendEmpty(),
+		}
+	})
+
+	if latency.Histogram().DataPoints().Len() > 0 {
+		metrics.MoveAndAppendTo(ilm.Metrics())
+	}
+}
+
+func (a *Aggregator) newSum(metrics pdata.MetricSlice, name string) pdata.Metric {
+	m := metrics.AppendEmpty()
+	m.SetDataType(pdata.MetricDataTypeSum)
+	m.SetName(a.settings.MetricNamePrefix + name)
+	m.Sum().SetIsMonotonic(true)
+	m.Sum().SetAggregationTemporality(a.settings.Temporality)
+	return m
+}
+
+// setExemplars sets the histogram exemplars.
+func setExemplars(exemplarsData []exemplar, timestamp pdata.Timestamp, exemplars pdata.ExemplarSlice) {
+	es := pdata.NewExemplarSlice()
+	es.EnsureCapacity(len(exemplarsData))
+
+	for _, ed := range exemplarsData {
+		e := es.AppendEmpty()
+		e.SetDoubleVal(ed.value)
+		e.SetTimestamp(timestamp)
+		e.SetTraceID(ed.traceID)
+		e.SetSpanID(ed.spanID)
+		e.FilteredAttributes().Insert(traceIDKey, pdata.NewAttributeValueString(ed.traceID.HexString()))
+		if !ed.spanID.IsEmpty() {
+			e.FilteredAttributes().Insert(spanIDKey, pdata.NewAttributeValueString(ed.spanID.HexString()))
+		}
+	}
+
+	es.CopyTo(exemplars)
+}
@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func newTestTraces(serviceName string, spans ...func(pdata.Span)) pdata.Traces {
+	traces := pdata.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	if serviceName != "" {
+		rs.Resource().Attributes().InsertString(ServiceNameKey, serviceName)
+	}
+	ils := rs.InstrumentationLibrarySpans().AppendEmpty()
+	now := time.Now()
+	for _, fill := range spans {
+		span := ils.Spans().AppendEmpty()
+		span.SetStartTimestamp(pdata.NewTimestampFromTime(now))
+		span.SetEndTimestamp(pdata.NewTimestampFromTime(now.Add(5 * time.Millisecond)))
+		span.SetKind(pdata.SpanKindServer)
+		fill(span)
+	}
+	return traces
+}
+
+func withName(name string) func(pdata.Span) {
+	return func(span pdata.Span) {
+		span.SetName(name)
+	}
+}
+
+func withError(name string) func(pdata.Span) {
+	return func(span pdata.Span) {
+		span.SetName(name)
+		span.Status().SetCode(pdata.StatusCodeError)
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{
+		MetricNamePrefix: "spans.",
+		Dimensions:       []Dimension{{Name: "http.method"}},
+		Temporality:      pdata.MetricAggregationTemporalityCumulative,
+	})
+
+	dropped := a.Aggregate(newTestTraces("svc", withName("op1"), withName("op1"), withError("op1"), withName("op2")))
+	assert.Zero(t, dropped)
+	// Spans without a service name are ignored.
+	assert.Zero(t, a.Aggregate(newTestTraces("", withName("op1"))))
+
+	ilm := pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	require.Equal(t, 3, ilm.Metrics().Len())
+
+	calls := ilm.Metrics().At(0)
+	assert.Equal(t, "spans.calls_total", calls.Name())
+	assert.Equal(t, pdata.MetricAggregationTemporalityCumulative, calls.Sum().AggregationTemporality())
+	// op1 ok, op1 error and op2 ok.
+	assert.Equal(t, 3, calls.Sum().DataPoints().Len())
+	var totalCalls, totalErrors int64
+	for i := 0; i < calls.Sum().DataPoints().Len(); i++ {
+		totalCalls += calls.Sum().DataPoints().At(i).IntVal()
+	}
+	errorsMetric := ilm.Metrics().At(1)
+	assert.Equal(t, "spans.errors_total", errorsMetric.Name())
+	for i := 0; i < errorsMetric.Sum().DataPoints().Len(); i++ {
+		totalErrors += errorsMetric.Sum().DataPoints().At(i).IntVal()
+	}
+	assert.EqualValues(t, 4, totalCalls)
+	assert.EqualValues(t, 1, totalErrors)
+
+	latency := ilm.Metrics().At(2)
+	assert.Equal(t, "spans.latency", latency.Name())
+	require.Equal(t, 3, latency.Histogram().DataPoints().Len())
+	dp := latency.Histogram().DataPoints().At(0)
+	assert.Equal(t, DefaultLatencyHistogramBucketsMs, dp.ExplicitBounds())
+	svc, ok := dp.Attributes().Get(ServiceNameKey)
+	require.True(t, ok)
+	assert.Equal(t, "svc", svc.StringVal())
+	for _, key := range []string{OperationKey, SpanKindKey, StatusCodeKey} {
+		_, ok = dp.Attributes().Get(key)
+		assert.True(t, ok, key)
+	}
+
+	// Cumulative series are kept.
+	ilm = pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	assert.Equal(t, 3, ilm.Metrics().Len())
+}
+
+func TestAggregatorDelta(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{Temporality: pdata.MetricAggregationTemporalityDelta})
+	a.Aggregate(newTestTraces("svc", withName("op1")))
+
+	ilm := pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	assert.Equal(t, 3, ilm.Metrics().Len())
+
+	ilm = pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	assert.Zero(t, ilm.Metrics().Len())
+}
+
+func TestAggregatorMaxSeries(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{MaxSeries: 1})
+	dropped := a.Aggregate(newTestTraces("svc", withName("op1"), withName("op2"), withName("op1")))
+	assert.Equal(t, 1, dropped)
+
+	ilm := pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	require.Equal(t, 3, ilm.Metrics().Len())
+	require.Equal(t, 1, ilm.Metrics().At(0).Sum().DataPoints().Len())
+	assert.EqualValues(t, 2, ilm.Metrics().At(0).Sum().DataPoints().At(0).IntVal())
+}
+
+func TestAggregatorNormalizedDimensions(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{
+		LatencyBounds: DefaultLatencyHistogramBucketsMs,
+		Dimensions:    []Dimension{{Name: "http.url", Normalizer: NormalizerURLTemplate}},
+	})
+
+	withURL := func(url string) func(pdata.Span) {
+		return func(span pdata.Span) {
+			span.SetName("GET /users")
+			span.Attributes().InsertString("http.url", url)
+		}
+	}
+	a.Aggregate(newTestTraces("svc", withURL("/users/1"), withURL("/users/2"), withURL("/users/3?full=true")))
+
+	ilm := pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	calls := ilm.Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 1, calls.Len())
+	assert.EqualValues(t, 3, calls.At(0).IntVal())
+	url, ok := calls.At(0).Attributes().Get("http.url")
+	require.True(t, ok)
+	assert.Equal(t, "/users/{number}", url.StringVal())
+}
+
+func TestAggregatorCollect(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{Temporality: pdata.MetricAggregationTemporalityDelta})
+	a.Aggregate(newTestTraces("svc", withName("op1"), withError("op1")))
+
+	var dps []SeriesDataPoints
+	a.Collect(func() SeriesDataPoints {
+		p := SeriesDataPoints{
+			Calls:   pdata.NewNumberDataPoint(),
+			Errors:  pdata.NewNumberDataPoint(),
+			Latency: pdata.NewHistogramDataPoint(),
+		}
+		dps = append(dps, p)
+		return p
+	})
+	require.Len(t, dps, 2)
+	for _, p := range dps {
+		assert.EqualValues(t, 1, p.Calls.IntVal())
+		assert.EqualValues(t, 1, p.Latency.Count())
+		assert.NotZero(t, p.Latency.StartTimestamp())
+		assert.Equal(t, p.Calls.Timestamp(), p.Latency.Timestamp())
+		statusCode, ok := p.Calls.Attributes().Get(StatusCodeKey)
+		require.True(t, ok)
+		if statusCode.StringVal() == pdata.StatusCodeError.String() {
+			assert.EqualValues(t, 1, p.Errors.IntVal())
+		} else {
+			assert.Zero(t, p.Errors.IntVal())
+		}
+	}
+
+	// Delta series are reset.
+	a.Collect(func() SeriesDataPoints {
+		assert.Fail(t, "unexpected series")
+		return SeriesDataPoints{}
+	})
+}
+
+func TestAggregatorExemplars(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{
+		LatencyBounds:         []float64{10, MaxDurationMs},
+		MaxExemplarsPerBucket: 2,
+	})
+	withIDs := func(i byte) func(pdata.Span) {
+		return func(span pdata.Span) {
+			span.SetName("op1")
+			span.SetTraceID(pdata.NewTraceID([16]byte{i}))
+			span.SetSpanID(pdata.NewSpanID([8]byte{i}))
+		}
+	}
+	// The spans without trace ID have no exemplar.
+	a.Aggregate(newTestTraces("svc", withIDs(1), withName("op1"), withIDs(2), withIDs(3)))
+
+	collect := func() pdata.HistogramDataPoint {
+		latency := pdata.NewHistogramDataPoint()
+		a.Collect(func() SeriesDataPoints {
+			return SeriesDataPoints{
+				Calls:   pdata.NewNumberDataPoint(),
+				Errors:  pdata.NewNumberDataPoint(),
+				Latency: latency,
+			}
+		})
+		return latency
+	}
+
+	dp := collect()
+	require.Equal(t, 2, dp.Exemplars().Len())
+	for i := 0; i < dp.Exemplars().Len(); i++ {
+		e := dp.Exemplars().At(i)
+		id := byte(i + 1)
+		assert.Equal(t, pdata.NewTraceID([16]byte{id}), e.TraceID())
+		assert.Equal(t, pdata.NewSpanID([8]byte{id}), e.SpanID())
+		assert.Equal(t, float64(5), e.DoubleVal())
+		assert.Equal(t, dp.Timestamp(), e.Timestamp())
+		traceID, ok := e.FilteredAttributes().Get(traceIDKey)
+		require.True(t, ok)
+		assert.Equal(t, e.TraceID().HexString(), traceID.StringVal())
+		spanID, ok := e.FilteredAttributes().Get(spanIDKey)
+		require.True(t, ok)
+		assert.Equal(t, e.SpanID().HexString(), spanID.StringVal())
+	}
+
+	// The exemplars are reset after each collection, the cumulative values are kept.
+	dp = collect()
+	assert.EqualValues(t, 4, dp.Count())
+	assert.Zero(t, dp.Exemplars().Len())
+}
+
+func TestAggregatorExemplarsDisabled(t *testing.T) {
+	a := NewAggregator(AggregatorSettings{})
+	a.Aggregate(newTestTraces("svc", func(span pdata.Span) {
+		span.SetTraceID(pdata.NewTraceID([16]byte{1}))
+	}))
+
+	ilm := pdata.NewInstrumentationLibraryMetrics()
+	a.Metrics(ilm)
+	require.Equal(t, 3, ilm.Metrics().Len())
+	assert.Zero(t, ilm.Metrics().At(2).Histogram().DataPoints().At(0).Exemplars().Len())
+}
+
+func TestBuildKeySameServiceOperationCharSequence(t *testing.T) {
+	span0 := pdata.NewSpan()
+	span0.SetName("c")
+	k0 := BuildKey("ab", span0, nil, pdata.NewAttributeMap())
+
+	span1 := pdata.NewSpan()
+	span1.SetName("bc")
+	k1 := BuildKey("a", span1, nil, pdata.NewAttributeMap())
+
+	assert.NotEqual(t, k0, k1)
+	assert.Equal(t, "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET", k0)
+	assert.Equal(t, "a\u0000bc\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET", k1)
+}
+
+func TestBuildKeyWithDimensions(t *testing.T) {
+	defaultFoo := "bar"
+	for _, tc := range []struct {
+		name            string
+		optionalDims    []Dimension
+		resourceAttrMap map[string]pdata.AttributeValue
+		spanAttrMap     map[string]pdata.AttributeValue
+		wantKey         string
+	}{
+		{
+			name:    "nil optionalDims",
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET",
+		},
+		{
+			name: "neither span nor resource contains key, dim provides default",
+			optionalDims: []Dimension{
+				{Name: "foo", Default: &defaultFoo},
+			},
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u0000bar",
+		},
+		{
+			name: "neither span nor resource contains key, dim provides no default",
+			optionalDims: []Dimension{
+				{Name: "foo"},
+			},
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET",
+		},
+		{
+			name: "span attribute contains dimension",
+			optionalDims: []Dimension{
+				{Name: "foo"},
+			},
+			spanAttrMap: map[string]pdata.AttributeValue{
+				"foo": pdata.NewAttributeValueInt(99),
+			},
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u000099",
+		},
+		{
+			name: "resource attribute contains dimension",
+			optionalDims: []Dimension{
+				{Name: "foo"},
+			},
+			resourceAttrMap: map[string]pdata.AttributeValue{
+				"foo": pdata.NewAttributeValueInt(99),
+			},
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u000099",
+		},
+		{
+			name: "both span and resource attribute contains dimension, should prefer span attribute",
+			optionalDims: []Dimension{
+				{Name: "foo"},
+			},
+			spanAttrMap: map[string]pdata.AttributeValue{
+				"foo": pdata.NewAttributeValueInt(100),
+			},
+			resourceAttrMap: map[string]pdata.AttributeValue{
+				"foo": pdata.NewAttributeValueInt(99),
+			},
+			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u0000100",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resAttr := pdata.NewAttributeMapFromMap(tc.resourceAttrMap)
+			span0 := pdata.NewSpan()
+			pdata.NewAttributeMapFromMap(tc.spanAttrMap).CopyTo(span0.Attributes())
+			span0.SetName("c")
+			k := BuildKey("ab", span0, tc.optionalDims, resAttr)
+
+			assert.Equal(t, tc.wantKey, k)
+		})
+	}
+}
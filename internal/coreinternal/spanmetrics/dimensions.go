@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanmetrics provides the aggregator, dimensions and latency buckets
+// shared by the components generating request, error and duration (RED) metrics
+// from spans.
+package spanmetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
+
+import (
+	"math"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// The dimensions set on all the generated metrics.
+const (
+	ServiceNameKey = conventions.AttributeServiceName
+	OperationKey   = "operation"   // OpenTelemetry non-standard constant.
+	SpanKindKey    = "span.kind"   // OpenTelemetry non-standard constant.
+	StatusCodeKey  = "status.code" // OpenTelemetry non-standard constant.
+)
+
+var (
+	maxDuration = time.Duration(math.MaxInt64)
+
+	// MaxDurationMs is the upper bound of the "catch-all" latency bucket.
+	MaxDurationMs = DurationToMillis(maxDuration)
+
+	// DefaultLatencyHistogramBucketsMs are the latency histogram bounds used when none are configured.
+	DefaultLatencyHistogramBucketsMs = []float64{
+		2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000, MaxDurationMs,
+	}
+)
+
+// Dimension defines the dimension name and optional default value if the Dimension is missing from a span attribute.
+type Dimension struct {
+	Name    string  `mapstructure:"name"`
+	Default *string `mapstructure:"default"`
+
+	// Normalizer is the name of a built-in normalizer applied to the attribute value
+	// before aggregation, to limit the cardinality of the dimension. Optional.
+	Normalizer string `mapstructure:"normalizer"`
+}
+
+// DurationToMillis converts the given duration to the number of milliseconds it represents.
+// Note that this can return sub-millisecond (i.e. < 1ms) values as well.
+func DurationToMillis(d time.Duration) float64 {
+	return float64(d.Nanoseconds()) / float64(time.Millisecond.Nanoseconds())
+}
+
+// MapDurationsToMillis converts the given durations to milliseconds.
+func MapDurationsToMillis(vs []time.Duration) []float64 {
+	vsm := make([]float64, len(vs))
+	for i, v := range vs {
+		vsm[i] = DurationToMillis(v)
+	}
+	return vsm
+}
+
+// LatencyBounds returns the latency histogram bounds in milliseconds for the given buckets,
+// including the "catch-all" bucket. The default bounds are returned when no buckets are given.
+func LatencyBounds(buckets []time.Duration) []float64 {
+	if buckets == nil {
+		return DefaultLatencyHistogramBucketsMs
+	}
+
+	bounds := MapDurationsToMillis(buckets)
+	// "Catch-all" bucket.
+	if bounds[len(bounds)-1] != MaxDurationMs {
+		bounds = append(bounds, MaxDurationMs)
+	}
+	return bounds
+}
+
+// GetDimensionValue gets the dimension value for the given configured dimension.
+// It searches through the span's attributes first, being the more specific;
+// falling back to searching in resource attributes if it can't be found in the span.
+// Finally, falls back to the configured default value if provided.
+// The configured normalizer is applied to the values found in the attributes.
+//
+// The ok flag indicates if a dimension value was fetched in order to differentiate
+// an empty string value from a state where no value was found.
+func GetDimensionValue(d Dimension, spanAttr pdata.AttributeMap, resourceAttr pdata.AttributeMap) (v pdata.AttributeValue, ok bool) {
+	// The more specific span attribute should take precedence.
+	if attr, exists := spanAttr.Get(d.Name); exists {
+		return normalizeValue(d, attr), true
+	}
+	if attr, exists := resourceAttr.Get(d.Name); exists {
+		return normalizeValue(d, attr), true
+	}
+	// Set the default if configured, otherwise this metric will have no value set for the dimension.
+	if d.Default != nil {
+		return pdata.NewAttributeValueString(*d.Default), true
+	}
+	return v, ok
+}
+
+func normalizeValue(d Dimension, v pdata.AttributeValue) pdata.AttributeValue {
+	if d.Normalizer == "" {
+		return v
+	}
+	return pdata.NewAttributeValueString(normalize(d.Normalizer, v.AsString()))
+}
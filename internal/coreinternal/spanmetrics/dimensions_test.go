@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestLatencyBounds(t *testing.T) {
+	assert.Equal(t, DefaultLatencyHistogramBucketsMs, LatencyBounds(nil))
+	assert.Equal(t, []float64{1, 100, MaxDurationMs}, LatencyBounds([]time.Duration{time.Millisecond, 100 * time.Millisecond}))
+}
+
+func TestGetDimensionValue(t *testing.T) {
+	spanAttrs := pdata.NewAttributeMap()
+	spanAttrs.InsertString("http.method", "GET")
+	resourceAttrs := pdata.NewAttributeMap()
+	resourceAttrs.InsertString("http.method", "POST")
+	resourceAttrs.InsertString("region", "us-east")
+	def := "none"
+
+	v, ok := GetDimensionValue(Dimension{Name: "http.method"}, spanAttrs, resourceAttrs)
+	assert.True(t, ok)
+	assert.Equal(t, "GET", v.StringVal())
+
+	v, ok = GetDimensionValue(Dimension{Name: "region"}, spanAttrs, resourceAttrs)
+	assert.True(t, ok)
+	assert.Equal(t, "us-east", v.StringVal())
+
+	v, ok = GetDimensionValue(Dimension{Name: "missing", Default: &def}, spanAttrs, resourceAttrs)
+	assert.True(t, ok)
+	assert.Equal(t, "none", v.StringVal())
+
+	_, ok = GetDimensionValue(Dimension{Name: "missing"}, spanAttrs, resourceAttrs)
+	assert.False(t, ok)
+}
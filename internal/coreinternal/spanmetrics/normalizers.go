@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The built-in normalizers which can be applied to the value of a dimension before aggregation.
+const (
+	// NormalizerLowercase converts the value to lower case, e.g. for http.method.
+	NormalizerLowercase = "lowercase"
+
+	// NormalizerURLTemplate reduces a URL or path to its path, replacing the segments holding
+	// UUIDs, numbers or hexadecimal identifiers with placeholders, e.g. for http.url or http.target.
+	NormalizerURLTemplate = "url_template"
+)
+
+var (
+	normalizers = map[string]func(string) string{
+		NormalizerLowercase:   strings.ToLower,
+		NormalizerURLTemplate: urlTemplate,
+	}
+
+	uuidSegment   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	numberSegment = regexp.MustCompile(`^[0-9]+$`)
+	hexIDSegment  = regexp.MustCompile(`^[0-9a-fA-F]*[0-9][0-9a-fA-F]*$`)
+)
+
+// minHexIDLength is the minimum length of a hexadecimal path segment considered as an identifier,
+// so that short words made of hexadecimal letters are kept.
+const minHexIDLength = 16
+
+// ValidateDimensions checks that the normalizers of the given dimensions are known.
+func ValidateDimensions(dimensions []Dimension) error {
+	for _, d := range dimensions {
+		if d.Normalizer == "" {
+			continue
+		}
+		if _, ok := normalizers[d.Normalizer]; !ok {
+			return fmt.Errorf("dimension %q has unknown normalizer %q, must be one of %q", d.Name, d.Normalizer, normalizerNames())
+		}
+	}
+	return nil
+}
+
+func normalizerNames() []string {
+	names := make([]string, 0, len(normalizers))
+	for name := range normalizers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// normalize applies the named normalizer to the value. Unknown normalizers leave the value unchanged.
+func normalize(normalizer string, value string) string {
+	if fn, ok := normalizers[normalizer]; ok {
+		return fn(value)
+	}
+	return value
+}
+
+// urlTemplate returns the path of the given URL, or path, with its variable segments replaced
+// by placeholders, e.g. "https://host/users/42/orders?id=1" becomes "/users/{number}/orders".
+func urlTemplate(value string) string {
+	path := value
+	if u, err := url.Parse(value); err == nil {
+		path = u.Path
+	} else if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if path == "" {
+		return "/"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case uuidSegment.MatchString(segment):
+			segments[i] = "{uuid}"
+		case numberSegment.MatchString(segment):
+			segments[i] = "{number}"
+		case len(segment) >= minHexIDLength && hexIDSegment.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
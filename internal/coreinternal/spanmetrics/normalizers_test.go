@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package spanmetrics aggregates spans into request, error and duration (RED) metrics,
+package spanmetrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestURLTemplate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "https://example.com/users/42/orders?page=2", expected: "/users/{number}/orders"},
+		{value: "/users/42/orders#top", expected: "/users/{number}/orders"},
+		{value: "/carts/5f0e2c1c-8f3a-4b8e-9c1d-2a6b7e9f0a11/items", expected: "/carts/{uuid}/items"},
+		{value: "/objects/507f1f77bcf86cd799439011", expected: "/objects/{id}"},
+		{value: "/api/v1/feed/cafe", expected: "/api/v1/feed/cafe"},
+		{value: "https://example.com", expected: "/"},
+		{value: "/health/", expected: "/health/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.expected, urlTemplate(tt.value))
+		})
+	}
+}
+
+func TestValidateDimensions(t *testing.T) {
+	assert.NoError(t, ValidateDimensions([]Dimension{
+		{Name: "http.method", Normalizer: NormalizerLowercase},
+		{Name: "http.url", Normalizer: NormalizerURLTemplate},
+		{Name: "http.status_code"},
+	}))
+	assert.EqualError(t,
+		ValidateDimensions([]Dimension{{Name: "http.url", Normalizer: "invalid"}}),
+		`dimension "http.url" has unknown normalizer "invalid", must be one of ["lowercase" "url_template"]`)
+}
+
+func TestGetDimensionValueNormalized(t *testing.T) {
+	spanAttrs := pdata.NewAttributeMap()
+	spanAttrs.InsertString("http.method", "GET")
+	resourceAttrs := pdata.NewAttributeMap()
+	resourceAttrs.InsertString("http.url", "http://example.com/items/7")
+	defaultValue := "/Unknown/1"
+
+	v, ok := GetDimensionValue(Dimension{Name: "http.method", Normalizer: NormalizerLowercase}, spanAttrs, resourceAttrs)
+	require.True(t, ok)
+	assert.Equal(t, "get", v.StringVal())
+
+	v, ok = GetDimensionValue(Dimension{Name: "http.url", Normalizer: NormalizerURLTemplate}, spanAttrs, resourceAttrs)
+	require.True(t, ok)
+	assert.Equal(t, "/items/{number}", v.StringVal())
+
+	// The configured default is used as is.
+	v, ok = GetDimensionValue(Dimension{Name: "http.target", Default: &defaultValue, Normalizer: NormalizerURLTemplate}, spanAttrs, resourceAttrs)
+	require.True(t, ok)
+	assert.Equal(t, defaultValue, v.StringVal())
+}
@@ -0,0 +1,4 @@
+//go:build !windows
+// +build !windows
+
+package win_perf_counters // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters/third_party/telegraf/win_perf_counters"
@@ -25,6 +25,32 @@ const (
 	statusOk    = "OK"
 )
 
+// Jaeger process tags that carry the client debugging context.
+const (
+	tagJaegerVersion = "jaeger.version"
+	tagHostname      = "hostname"
+	tagIP            = "ip"
+	tagClientUUID    = "client-uuid"
+)
+
+// attributeJaegerWarnings is the span attribute holding the Jaeger span
+// warnings as an array of strings.
+const attributeJaegerWarnings = "jaeger.warnings"
+
+// Link attribute holding the type of the Jaeger reference of a span link, as
+// defined by the OpenTelemetry specification for the OpenTracing compatibility:
+// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.9.0/specification/trace/semantic_conventions/compatibility.md#opentracing
+const (
+	attributeRefType            = "opentracing.ref_type"
+	attributeRefTypeChildOf     = "child_of"
+	attributeRefTypeFollowsFrom = "follows_from"
+)
+
+// tagLinkPrefix prefixes the span tags holding the attributes and trace state
+// of a span link, which Jaeger references can't hold. It is followed by the
+// index of the link and the attribute key, e.g. "otel.link.0.w3c.tracestate".
+const tagLinkPrefix = "otel.link."
+
 var (
 	errZeroTraceID = errors.New("span has an all zeros trace ID")
 	errZeroSpanID  = errors.New("span has an all zeros span ID")
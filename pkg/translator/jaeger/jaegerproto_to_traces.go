@@ -16,10 +16,13 @@ package jaeger // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/jaegertracing/jaeger/model"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -39,61 +42,117 @@ func ProtoBatchesToInternalTraces(batches []*model.Batch) pdata.Traces {
 }
 
 // ProtoToTraces converts multiple Jaeger proto batches to internal traces
-func ProtoToTraces(batches []*model.Batch) (pdata.Traces, error) {
-	traceData := pdata.NewTraces()
+func ProtoToTraces(batches []*model.Batch, opts ...Option) (pdata.Traces, error) {
 	if len(batches) == 0 {
-		return traceData, nil
+		return pdata.NewTraces(), nil
 	}
 
-	rss := traceData.ResourceSpans()
-	rss.EnsureCapacity(len(batches))
-
+	t := translatorPool.Get().(*Translator)
+	defer translatorPool.Put(t)
+	t.cfg = newTranslateConfig(opts)
+	t.traces.ResourceSpans().EnsureCapacity(len(batches))
 	for _, batch := range batches {
-		if batch.GetProcess() == nil && len(batch.GetSpans()) == 0 {
-			continue
-		}
-
-		protoBatchToResourceSpans(*batch, rss.AppendEmpty())
+		t.AppendBatch(batch)
 	}
 
-	return traceData, nil
+	return t.Traces(), nil
 }
 
 // Deprecated: [0.45.0] use `jaeger.ProtoToTraces`
 func ProtoBatchToInternalTraces(batch model.Batch) pdata.Traces {
-	traceData := pdata.NewTraces()
+	td, _ := ProtoToTraces([]*model.Batch{&batch})
+	return td
+}
 
-	if batch.GetProcess() == nil && len(batch.GetSpans()) == 0 {
-		return traceData
-	}
+// translatorPool holds the translators used by ProtoToTraces, so that their
+// scratch space is reused across calls.
+var translatorPool = sync.Pool{
+	New: func() interface{} {
+		return NewTranslator()
+	},
+}
 
-	protoBatchToResourceSpans(batch, traceData.ResourceSpans().AppendEmpty())
+// Translator incrementally converts Jaeger proto batches to internal traces.
+// The batches appended to it are translated into a single pdata.Traces, which
+// is grown in place instead of being built and merged per batch, and the
+// scratch space used to group the spans by instrumentation library is reused
+// across batches.
+//
+// A Translator is not safe for concurrent use.
+type Translator struct {
+	cfg    translateConfig
+	traces pdata.Traces
+
+	// libraries holds the instrumentation library of each of the
+	// InstrumentationLibrarySpans of the batch being translated, in order.
+	libraries []instrumentationLibrary
+}
 
-	return traceData
+// NewTranslator creates a Translator configured with the given options.
+func NewTranslator(opts ...Option) *Translator {
+	return &Translator{
+		cfg:    newTranslateConfig(opts),
+		traces: pdata.NewTraces(),
+	}
 }
 
-func protoBatchToResourceSpans(batch model.Batch, dest pdata.ResourceSpans) {
-	jSpans := batch.GetSpans()
+// AppendBatch translates the batch and appends it to the traces built so far
+// as a new ResourceSpans. Batches with neither a process nor spans are skipped.
+func (t *Translator) AppendBatch(batch *model.Batch) {
+	if batch.GetProcess() == nil && len(batch.GetSpans()) == 0 {
+		return
+	}
+
+	dest := t.traces.ResourceSpans().AppendEmpty()
+	jProcessToInternalResource(batch.GetProcess(), dest.Resource(), t.cfg)
+	t.jSpansToInternal(batch.GetSpans(), dest.InstrumentationLibrarySpans())
+}
 
-	jProcessToInternalResource(batch.GetProcess(), dest.Resource())
+// Traces returns the traces translated from the batches appended since the
+// translator was created or Traces was last called, and starts a new one.
+func (t *Translator) Traces() pdata.Traces {
+	td := t.traces
+	t.traces = pdata.NewTraces()
+	return td
+}
 
-	if len(jSpans) == 0 {
-		return
+// jSpansToInternal translates the spans into dest, grouped by instrumentation
+// library in the order the libraries are first seen.
+func (t *Translator) jSpansToInternal(spans []*model.Span, dest pdata.InstrumentationLibrarySpansSlice) {
+	t.libraries = t.libraries[:0]
+	for _, span := range spans {
+		if isBlankJaegerProtoSpan(span) {
+			continue
+		}
+		jSpanToInternal(span, t.librarySpans(span, dest, len(spans)).AppendEmpty(), t.cfg)
 	}
+}
 
-	groupByLibrary := jSpansToInternal(jSpans)
-	ilss := dest.InstrumentationLibrarySpans()
-	for library, spans := range groupByLibrary {
-		ils := ilss.AppendEmpty()
-		if library.name != "" {
-			ils.InstrumentationLibrary().SetName(library.name)
-			ils.InstrumentationLibrary().SetVersion(library.version)
+// librarySpans returns the spans of the InstrumentationLibrarySpans of dest
+// matching the library of the span, appending it if there is none yet. The
+// libraries are looked up linearly since a batch seldom holds more than a few.
+func (t *Translator) librarySpans(span *model.Span, dest pdata.InstrumentationLibrarySpansSlice, spanCount int) pdata.SpanSlice {
+	il := getInstrumentationLibrary(span)
+	for i, library := range t.libraries {
+		if library == il {
+			return dest.At(i).Spans()
 		}
-		spans.MoveAndAppendTo(ils.Spans())
 	}
+
+	ils := dest.AppendEmpty()
+	if il.name != "" {
+		ils.InstrumentationLibrary().SetName(il.name)
+		ils.InstrumentationLibrary().SetVersion(il.version)
+	}
+	if len(t.libraries) == 0 {
+		// Most batches come from a single library, size it for all the spans.
+		ils.Spans().EnsureCapacity(spanCount)
+	}
+	t.libraries = append(t.libraries, il)
+	return ils.Spans()
 }
 
-func jProcessToInternalResource(process *model.Process, dest pdata.Resource) {
+func jProcessToInternalResource(process *model.Process, dest pdata.Resource, cfg translateConfig) {
 	if process == nil || process.ServiceName == tracetranslator.ResourceNoServiceName {
 		return
 	}
@@ -115,55 +174,98 @@ func jProcessToInternalResource(process *model.Process, dest pdata.Resource) {
 	jTagsToInternalAttributes(tags, attrs)
 
 	// Handle special keys translations.
+	translateProcessAttrs(attrs, cfg)
+}
+
+// translateProcessAttrs translates the special Jaeger process tags to their
+// OpenTelemetry counterparts, or drops the debug ones if configured so.
+func translateProcessAttrs(attrs pdata.AttributeMap, cfg translateConfig) {
 	translateHostnameAttr(attrs)
+	if cfg.dropDebugAttributes {
+		attrs.Delete(tagJaegerVersion)
+		attrs.Delete(tagIP)
+		attrs.Delete(tagClientUUID)
+		return
+	}
 	translateJaegerVersionAttr(attrs)
+	if cfg.translateProcessTags {
+		translateIPAttr(attrs)
+		translateClientUUIDAttr(attrs)
+	}
 }
 
 // translateHostnameAttr translates "hostname" atttribute
 func translateHostnameAttr(attrs pdata.AttributeMap) {
-	hostname, hostnameFound := attrs.Get("hostname")
+	hostname, hostnameFound := attrs.Get(tagHostname)
 	_, convHostNameFound := attrs.Get(conventions.AttributeHostName)
 	if hostnameFound && !convHostNameFound {
 		attrs.Insert(conventions.AttributeHostName, hostname)
-		attrs.Delete("hostname")
+		attrs.Delete(tagHostname)
 	}
 }
 
-// translateHostnameAttr translates "jaeger.version" atttribute
+// translateJaegerVersionAttr translates "jaeger.version" atttribute
 func translateJaegerVersionAttr(attrs pdata.AttributeMap) {
-	jaegerVersion, jaegerVersionFound := attrs.Get("jaeger.version")
+	jaegerVersion, jaegerVersionFound := attrs.Get(tagJaegerVersion)
 	_, exporterVersionFound := attrs.Get(occonventions.AttributeExporterVersion)
 	if jaegerVersionFound && !exporterVersionFound {
 		attrs.InsertString(occonventions.AttributeExporterVersion, "Jaeger-"+jaegerVersion.StringVal())
-		attrs.Delete("jaeger.version")
+		attrs.Delete(tagJaegerVersion)
+	}
+}
+
+// translateIPAttr translates "ip" attribute. Some Jaeger clients report the
+// IPv4 address as an integer, it is converted to its dotted representation.
+func translateIPAttr(attrs pdata.AttributeMap) {
+	ip, ipFound := attrs.Get(tagIP)
+	_, netHostIPFound := attrs.Get(conventions.AttributeNetHostIP)
+	if !ipFound || netHostIPFound {
+		return
+	}
+	switch ip.Type() {
+	case pdata.AttributeValueTypeString:
+		attrs.InsertString(conventions.AttributeNetHostIP, ip.StringVal())
+	case pdata.AttributeValueTypeInt:
+		attrs.InsertString(conventions.AttributeNetHostIP, int64ToIPv4(ip.IntVal()))
+	default:
+		return
 	}
+	attrs.Delete(tagIP)
 }
 
-func jSpansToInternal(spans []*model.Span) map[instrumentationLibrary]pdata.SpanSlice {
-	spansByLibrary := make(map[instrumentationLibrary]pdata.SpanSlice)
+// translateClientUUIDAttr translates "client-uuid" attribute
+func translateClientUUIDAttr(attrs pdata.AttributeMap) {
+	clientUUID, clientUUIDFound := attrs.Get(tagClientUUID)
+	_, instanceIDFound := attrs.Get(conventions.AttributeServiceInstanceID)
+	if clientUUIDFound && !instanceIDFound {
+		attrs.Insert(conventions.AttributeServiceInstanceID, clientUUID)
+		attrs.Delete(tagClientUUID)
+	}
+}
 
-	for _, span := range spans {
-		if span == nil || reflect.DeepEqual(span, blankJaegerProtoSpan) {
-			continue
-		}
-		jSpanToInternal(span, spansByLibrary)
+func int64ToIPv4(ip int64) string {
+	b := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(b, uint32(ip))
+	return b.String()
+}
+
+// isBlankJaegerProtoSpan reports whether the span is nil or has no field set.
+// The IDs are checked first to skip the costly deep comparison for most spans.
+func isBlankJaegerProtoSpan(span *model.Span) bool {
+	if span == nil {
+		return true
+	}
+	if span.SpanID != 0 || span.TraceID.Low != 0 || span.TraceID.High != 0 {
+		return false
 	}
-	return spansByLibrary
+	return reflect.DeepEqual(span, blankJaegerProtoSpan)
 }
 
 type instrumentationLibrary struct {
 	name, version string
 }
 
-func jSpanToInternal(span *model.Span, spansByLibrary map[instrumentationLibrary]pdata.SpanSlice) {
-	il := getInstrumentationLibrary(span)
-	ss, found := spansByLibrary[il]
-	if !found {
-		ss = pdata.NewSpanSlice()
-		spansByLibrary[il] = ss
-	}
-
-	dest := ss.AppendEmpty()
+func jSpanToInternal(span *model.Span, dest pdata.Span, cfg translateConfig) {
 	dest.SetTraceID(idutils.UInt64ToTraceID(span.TraceID.High, span.TraceID.Low))
 	dest.SetSpanID(idutils.UInt64ToSpanID(uint64(span.SpanID)))
 	dest.SetName(span.OperationName)
@@ -186,13 +288,32 @@ func jSpanToInternal(span *model.Span, spansByLibrary map[instrumentationLibrary
 
 	dest.SetTraceState(getTraceStateFromAttrs(attrs))
 
+	if !cfg.dropDebugAttributes {
+		jWarningsToInternalAttribute(span.Warnings, attrs)
+	}
+
+	jLogsToSpanEvents(span.Logs, dest.Events())
+	jReferencesToSpanLinks(span.References, span.TraceID, parentSpanID, dest.Links())
+	moveLinkAttributes(attrs, dest.Links())
+
 	// drop the attributes slice if all of them were replaced during translation
 	if attrs.Len() == 0 {
 		attrs.Clear()
 	}
+}
 
-	jLogsToSpanEvents(span.Logs, dest.Events())
-	jReferencesToSpanLinks(span.References, parentSpanID, dest.Links())
+// jWarningsToInternalAttribute stores the Jaeger span warnings as a string array attribute.
+func jWarningsToInternalAttribute(warnings []string, dest pdata.AttributeMap) {
+	if len(warnings) == 0 {
+		return
+	}
+	val := pdata.NewAttributeValueArray()
+	vals := val.SliceVal()
+	vals.EnsureCapacity(len(warnings))
+	for _, w := range warnings {
+		vals.AppendEmpty().SetStringVal(w)
+	}
+	dest.Upsert(attributeJaegerWarnings, val)
 }
 
 func jTagsToInternalAttributes(tags []model.KeyValue, dest pdata.AttributeMap) {
@@ -365,22 +486,84 @@ func jLogsToSpanEvents(logs []model.Log, dest pdata.SpanEventSlice) {
 	}
 }
 
-// jReferencesToSpanLinks sets internal span links based on jaeger span references skipping excludeParentID
-func jReferencesToSpanLinks(refs []model.SpanRef, excludeParentID model.SpanID, dest pdata.SpanLinkSlice) {
-	if len(refs) == 0 || len(refs) == 1 && refs[0].SpanID == excludeParentID && refs[0].RefType == model.ChildOf {
+// jReferencesToSpanLinks sets internal span links based on jaeger span references skipping the
+// parent reference, the first CHILD_OF reference to parentSpanID in the trace of the span.
+// The type of the references is kept in the "opentracing.ref_type" link attribute.
+func jReferencesToSpanLinks(refs []model.SpanRef, traceID model.TraceID, parentSpanID model.SpanID, dest pdata.SpanLinkSlice) {
+	if len(refs) == 0 || len(refs) == 1 && isParentReference(refs[0], traceID, parentSpanID) {
 		return
 	}
 
 	dest.EnsureCapacity(len(refs))
+	parentSkipped := false
 	for _, ref := range refs {
-		if ref.SpanID == excludeParentID && ref.RefType == model.ChildOf {
+		if !parentSkipped && isParentReference(ref, traceID, parentSpanID) {
+			parentSkipped = true
 			continue
 		}
 
 		link := dest.AppendEmpty()
 		link.SetTraceID(idutils.UInt64ToTraceID(ref.TraceID.High, ref.TraceID.Low))
 		link.SetSpanID(idutils.UInt64ToSpanID(uint64(ref.SpanID)))
+		if ref.RefType == model.ChildOf {
+			link.Attributes().InsertString(attributeRefType, attributeRefTypeChildOf)
+		} else {
+			link.Attributes().InsertString(attributeRefType, attributeRefTypeFollowsFrom)
+		}
+	}
+}
+
+// isParentReference returns whether ref is the reference to the parent span, as returned
+// by model.Span.ParentSpanID.
+func isParentReference(ref model.SpanRef, traceID model.TraceID, parentSpanID model.SpanID) bool {
+	return ref.RefType == model.ChildOf && ref.TraceID == traceID && ref.SpanID == parentSpanID
+}
+
+// moveLinkAttributes moves the attributes added to the span tags by the internal to Jaeger
+// translation, prefixed with "otel.link.<index>.", to the attributes and trace state of the
+// links. The attributes of links that don't exist are kept in the span.
+func moveLinkAttributes(attrs pdata.AttributeMap, links pdata.SpanLinkSlice) {
+	if links.Len() == 0 {
+		return
+	}
+
+	var keys []string
+	attrs.Range(func(key string, _ pdata.AttributeValue) bool {
+		if strings.HasPrefix(key, tagLinkPrefix) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+
+	for _, key := range keys {
+		index, linkKey, ok := parseLinkTagKey(key)
+		if !ok || index >= links.Len() {
+			continue
+		}
+		attr, _ := attrs.Get(key)
+		link := links.At(index)
+		if linkKey == tracetranslator.TagW3CTraceState {
+			link.SetTraceState(pdata.TraceState(attr.StringVal()))
+		} else {
+			link.Attributes().Upsert(linkKey, attr)
+		}
+		attrs.Delete(key)
+	}
+}
+
+// parseLinkTagKey splits the "otel.link.<index>.<key>" key of a link tag into the index
+// of the link and the key of the link attribute.
+func parseLinkTagKey(key string) (int, string, bool) {
+	rest := strings.TrimPrefix(key, tagLinkPrefix)
+	dot := strings.IndexByte(rest, '.')
+	if dot <= 0 || dot == len(rest)-1 {
+		return 0, "", false
+	}
+	index, err := strconv.Atoi(rest[:dot])
+	if err != nil || index < 0 {
+		return 0, "", false
 	}
+	return index, rest[dot+1:], true
 }
 
 func getTraceStateFromAttrs(attrs pdata.AttributeMap) pdata.TraceState {
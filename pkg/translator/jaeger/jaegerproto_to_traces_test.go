@@ -460,6 +460,185 @@ func TestProtoBatchesToInternalTraces(t *testing.T) {
 	assert.EqualValues(t, expected, got)
 }
 
+func TestTranslator(t *testing.T) {
+	translator := NewTranslator()
+	translator.AppendBatch(&model.Batch{
+		Process: generateProtoProcess(),
+		Spans: []*model.Span{
+			generateProtoSpan(),
+		},
+	})
+	// should be skipped
+	translator.AppendBatch(&model.Batch{Spans: []*model.Span{}})
+	translator.AppendBatch(&model.Batch{
+		Spans: []*model.Span{
+			generateProtoSpan(),
+			generateProtoChildSpan(),
+		},
+	})
+
+	expected := generateTracesOneSpanNoResource()
+	generateTracesResourceOnly().ResourceSpans().At(0).Resource().CopyTo(expected.ResourceSpans().At(0).Resource())
+	generateTracesTwoSpansChildParent().ResourceSpans().At(0).CopyTo(expected.ResourceSpans().AppendEmpty())
+	assert.EqualValues(t, expected, translator.Traces())
+
+	// The translator starts over once the traces are returned.
+	assert.EqualValues(t, pdata.NewTraces(), translator.Traces())
+	translator.AppendBatch(&model.Batch{
+		Process: &model.Process{
+			ServiceName: tracetranslator.ResourceNoServiceName,
+		},
+		Spans: []*model.Span{
+			generateProtoSpan(),
+			generateProtoFollowerSpan(),
+		},
+	})
+	assert.EqualValues(t, generateTracesTwoSpansWithFollower(), translator.Traces())
+}
+
+func TestTranslatorGroupsLibrariesInOrder(t *testing.T) {
+	translator := NewTranslator()
+	translator.AppendBatch(&model.Batch{
+		Spans: []*model.Span{
+			generateProtoSpanWithLibraryInfo("library2"),
+			generateProtoSpanWithLibraryInfo("library1"),
+			generateProtoSpanWithLibraryInfo("library2"),
+			generateProtoSpan(),
+		},
+	})
+
+	td := translator.Traces()
+	require.Equal(t, 1, td.ResourceSpans().Len())
+	ilss := td.ResourceSpans().At(0).InstrumentationLibrarySpans()
+	require.Equal(t, 3, ilss.Len())
+	assert.Equal(t, "library2", ilss.At(0).InstrumentationLibrary().Name())
+	assert.Equal(t, 2, ilss.At(0).Spans().Len())
+	assert.Equal(t, "library1", ilss.At(1).InstrumentationLibrary().Name())
+	assert.Equal(t, 1, ilss.At(1).Spans().Len())
+	assert.Equal(t, "", ilss.At(2).InstrumentationLibrary().Name())
+	assert.Equal(t, 1, ilss.At(2).Spans().Len())
+}
+
+func TestProtoToTracesDebugAttributes(t *testing.T) {
+	batch := func() []*model.Batch {
+		return []*model.Batch{
+			{
+				Process: &model.Process{
+					ServiceName: "service",
+					Tags: []model.KeyValue{
+						model.String("jaeger.version", "Go-2.30.0"),
+						model.Int64("ip", 167772161),
+						model.String("client-uuid", "6ecb5da4b1aa9e85"),
+						model.String("hostname", "host-1"),
+					},
+				},
+				Spans: []*model.Span{
+					{
+						TraceID:       model.NewTraceID(1, 1),
+						SpanID:        model.NewSpanID(1),
+						OperationName: "operation",
+						Warnings:      []string{"clock skew adjustment disabled", "invalid parent span IDs"},
+					},
+				},
+			},
+		}
+	}
+
+	warnings := pdata.NewAttributeValueArray()
+	warnings.SliceVal().AppendEmpty().SetStringVal("clock skew adjustment disabled")
+	warnings.SliceVal().AppendEmpty().SetStringVal("invalid parent span IDs")
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		resourceAttrs map[string]pdata.AttributeValue
+		spanAttrs     map[string]pdata.AttributeValue
+	}{
+		{
+			name: "default",
+			resourceAttrs: map[string]pdata.AttributeValue{
+				conventions.AttributeServiceName: pdata.NewAttributeValueString("service"),
+				conventions.AttributeHostName:    pdata.NewAttributeValueString("host-1"),
+				"ip":                             pdata.NewAttributeValueInt(167772161),
+				"client-uuid":                    pdata.NewAttributeValueString("6ecb5da4b1aa9e85"),
+				"opencensus.exporterversion":     pdata.NewAttributeValueString("Jaeger-Go-2.30.0"),
+			},
+			spanAttrs: map[string]pdata.AttributeValue{
+				"jaeger.warnings": warnings,
+			},
+		},
+		{
+			name: "mapped",
+			opts: []Option{WithProcessTagsTranslation()},
+			resourceAttrs: map[string]pdata.AttributeValue{
+				conventions.AttributeServiceName:       pdata.NewAttributeValueString("service"),
+				conventions.AttributeHostName:          pdata.NewAttributeValueString("host-1"),
+				conventions.AttributeNetHostIP:         pdata.NewAttributeValueString("10.0.0.1"),
+				conventions.AttributeServiceInstanceID: pdata.NewAttributeValueString("6ecb5da4b1aa9e85"),
+				"opencensus.exporterversion":           pdata.NewAttributeValueString("Jaeger-Go-2.30.0"),
+			},
+			spanAttrs: map[string]pdata.AttributeValue{
+				"jaeger.warnings": warnings,
+			},
+		},
+		{
+			name: "dropped",
+			opts: []Option{WithDropDebugAttributes()},
+			resourceAttrs: map[string]pdata.AttributeValue{
+				conventions.AttributeServiceName: pdata.NewAttributeValueString("service"),
+				conventions.AttributeHostName:    pdata.NewAttributeValueString("host-1"),
+			},
+			spanAttrs: map[string]pdata.AttributeValue{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td, err := ProtoToTraces(batch(), tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, 1, td.SpanCount())
+
+			rs := td.ResourceSpans().At(0)
+			assert.EqualValues(t, pdata.NewAttributeMapFromMap(tt.resourceAttrs).Sort(), rs.Resource().Attributes().Sort())
+			span := rs.InstrumentationLibrarySpans().At(0).Spans().At(0)
+			assert.EqualValues(t, pdata.NewAttributeMapFromMap(tt.spanAttrs).Sort(), span.Attributes().Sort())
+		})
+	}
+}
+
+func TestProtoToTracesKeepsExistingDebugAttributes(t *testing.T) {
+	batches := []*model.Batch{
+		{
+			Process: &model.Process{
+				ServiceName: "service",
+				Tags: []model.KeyValue{
+					model.String("ip", "10.0.0.1"),
+					model.String(conventions.AttributeNetHostIP, "10.0.0.2"),
+					model.String("client-uuid", "6ecb5da4b1aa9e85"),
+					model.String(conventions.AttributeServiceInstanceID, "instance-1"),
+				},
+			},
+		},
+	}
+
+	td, err := ProtoToTraces(batches, WithProcessTagsTranslation())
+	require.NoError(t, err)
+	attrs := td.ResourceSpans().At(0).Resource().Attributes()
+
+	ip, ok := attrs.Get("ip")
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.1", ip.StringVal())
+	netHostIP, ok := attrs.Get(conventions.AttributeNetHostIP)
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.2", netHostIP.StringVal())
+	clientUUID, ok := attrs.Get("client-uuid")
+	require.True(t, ok)
+	assert.Equal(t, "6ecb5da4b1aa9e85", clientUUID.StringVal())
+	instanceID, ok := attrs.Get(conventions.AttributeServiceInstanceID)
+	require.True(t, ok)
+	assert.Equal(t, "instance-1", instanceID.StringVal())
+}
+
 func TestJSpanKindToInternal(t *testing.T) {
 	tests := []struct {
 		jSpanKind    string
@@ -771,6 +950,7 @@ func generateTracesTwoSpansWithFollower() pdata.Traces {
 	link := span.Links().AppendEmpty()
 	link.SetTraceID(span.TraceID())
 	link.SetSpanID(spans.At(0).SpanID())
+	link.Attributes().InsertString(attributeRefType, attributeRefTypeFollowsFrom)
 	return td
 }
 
@@ -812,8 +992,8 @@ func generateProtoFollowerSpan() *model.Span {
 	}
 }
 
-func BenchmarkProtoBatchToInternalTraces(b *testing.B) {
-	jb := []*model.Batch{
+func generateBenchmarkProtoBatches() []*model.Batch {
+	return []*model.Batch{
 		{
 			Process: generateProtoProcess(),
 			Spans: []*model.Span{
@@ -821,7 +1001,12 @@ func BenchmarkProtoBatchToInternalTraces(b *testing.B) {
 				generateProtoChildSpan(),
 			},
 		}}
+}
 
+func BenchmarkProtoBatchToInternalTraces(b *testing.B) {
+	jb := generateBenchmarkProtoBatches()
+
+	b.ReportAllocs()
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		_, err := ProtoToTraces(jb)
@@ -829,6 +1014,20 @@ func BenchmarkProtoBatchToInternalTraces(b *testing.B) {
 	}
 }
 
+func BenchmarkTranslatorAppendBatch(b *testing.B) {
+	jb := generateBenchmarkProtoBatches()
+	translator := NewTranslator()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, batch := range jb {
+			translator.AppendBatch(batch)
+		}
+		translator.Traces()
+	}
+}
+
 func generateTracesTwoSpansFromTwoLibraries() pdata.Traces {
 	td := testdata.GenerateTracesOneEmptyResourceSpans()
 
@@ -36,7 +36,8 @@ func ThriftBatchToInternalTraces(batches *jaeger.Batch) pdata.Traces {
 }
 
 // ThriftToTraces transforms a Thrift trace batch into pdata.Traces.
-func ThriftToTraces(batches *jaeger.Batch) (pdata.Traces, error) {
+func ThriftToTraces(batches *jaeger.Batch, opts ...Option) (pdata.Traces, error) {
+	cfg := newTranslateConfig(opts)
 	traceData := pdata.NewTraces()
 	jProcess := batches.GetProcess()
 	jSpans := batches.GetSpans()
@@ -46,7 +47,7 @@ func ThriftToTraces(batches *jaeger.Batch) (pdata.Traces, error) {
 	}
 
 	rs := traceData.ResourceSpans().AppendEmpty()
-	jThriftProcessToInternalResource(jProcess, rs.Resource())
+	jThriftProcessToInternalResource(jProcess, rs.Resource(), cfg)
 
 	if len(jSpans) == 0 {
 		return traceData, nil
@@ -57,7 +58,7 @@ func ThriftToTraces(batches *jaeger.Batch) (pdata.Traces, error) {
 	return traceData, nil
 }
 
-func jThriftProcessToInternalResource(process *jaeger.Process, dest pdata.Resource) {
+func jThriftProcessToInternalResource(process *jaeger.Process, dest pdata.Resource, cfg translateConfig) {
 	if process == nil {
 		return
 	}
@@ -79,8 +80,7 @@ func jThriftProcessToInternalResource(process *jaeger.Process, dest pdata.Resour
 	jThriftTagsToInternalAttributes(tags, attrs)
 
 	// Handle special keys translations.
-	translateHostnameAttr(attrs)
-	translateJaegerVersionAttr(attrs)
+	translateProcessAttrs(attrs, cfg)
 }
 
 func jThriftSpansToInternal(spans []*jaeger.Span, dest pdata.SpanSlice) {
@@ -118,13 +118,16 @@ func jThriftSpanToInternal(span *jaeger.Span, dest pdata.Span) {
 		attrs.Delete(tracetranslator.TagSpanKind)
 	}
 
+	dest.SetTraceState(getTraceStateFromAttrs(attrs))
+
+	jThriftLogsToSpanEvents(span.Logs, dest.Events())
+	jThriftReferencesToSpanLinks(span, dest.Links())
+	moveLinkAttributes(attrs, dest.Links())
+
 	// drop the attributes slice if all of them were replaced during translation
 	if attrs.Len() == 0 {
 		attrs.Clear()
 	}
-
-	jThriftLogsToSpanEvents(span.Logs, dest.Events())
-	jThriftReferencesToSpanLinks(span.References, parentSpanID, dest.Links())
 }
 
 // jThriftTagsToInternalAttributes sets internal span links based on jaeger span references skipping excludeParentID
@@ -173,23 +176,40 @@ func jThriftLogsToSpanEvents(logs []*jaeger.Log, dest pdata.SpanEventSlice) {
 	}
 }
 
-func jThriftReferencesToSpanLinks(refs []*jaeger.SpanRef, excludeParentID int64, dest pdata.SpanLinkSlice) {
-	if len(refs) == 0 || len(refs) == 1 && refs[0].SpanId == excludeParentID && refs[0].RefType == jaeger.SpanRefType_CHILD_OF {
+// jThriftReferencesToSpanLinks sets internal span links based on jaeger span references skipping
+// the parent reference, the first CHILD_OF reference to the parent span in the trace of the span.
+// The type of the references is kept in the "opentracing.ref_type" link attribute.
+func jThriftReferencesToSpanLinks(span *jaeger.Span, dest pdata.SpanLinkSlice) {
+	refs := span.References
+	if len(refs) == 0 || len(refs) == 1 && isThriftParentReference(span, refs[0]) {
 		return
 	}
 
 	dest.EnsureCapacity(len(refs))
+	parentSkipped := false
 	for _, ref := range refs {
-		if ref.SpanId == excludeParentID && ref.RefType == jaeger.SpanRefType_CHILD_OF {
+		if !parentSkipped && isThriftParentReference(span, ref) {
+			parentSkipped = true
 			continue
 		}
 
 		link := dest.AppendEmpty()
 		link.SetTraceID(idutils.UInt64ToTraceID(uint64(ref.TraceIdHigh), uint64(ref.TraceIdLow)))
 		link.SetSpanID(idutils.UInt64ToSpanID(uint64(ref.SpanId)))
+		if ref.RefType == jaeger.SpanRefType_CHILD_OF {
+			link.Attributes().InsertString(attributeRefType, attributeRefTypeChildOf)
+		} else {
+			link.Attributes().InsertString(attributeRefType, attributeRefTypeFollowsFrom)
+		}
 	}
 }
 
+// isThriftParentReference returns whether ref is the reference to the parent span of span.
+func isThriftParentReference(span *jaeger.Span, ref *jaeger.SpanRef) bool {
+	return ref.RefType == jaeger.SpanRefType_CHILD_OF && ref.SpanId == span.ParentSpanId &&
+		ref.TraceIdHigh == span.TraceIdHigh && ref.TraceIdLow == span.TraceIdLow
+}
+
 // microsecondsToUnixNano converts epoch microseconds to pdata.Timestamp
 func microsecondsToUnixNano(ms int64) pdata.Timestamp {
 	return pdata.Timestamp(uint64(ms) * 1000)
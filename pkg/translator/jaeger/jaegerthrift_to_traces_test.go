@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
 )
@@ -136,6 +137,47 @@ func TestThriftBatchToInternalTraces(t *testing.T) {
 	}
 }
 
+func TestThriftReferencesToSpanLinks(t *testing.T) {
+	span := &jaeger.Span{
+		TraceIdLow:   1,
+		SpanId:       1,
+		ParentSpanId: 2,
+		References: []*jaeger.SpanRef{
+			{TraceIdLow: 1, SpanId: 2, RefType: jaeger.SpanRefType_CHILD_OF},
+			{TraceIdLow: 3, SpanId: 2, RefType: jaeger.SpanRefType_CHILD_OF},
+			{TraceIdLow: 1, SpanId: 2, RefType: jaeger.SpanRefType_CHILD_OF},
+			{TraceIdLow: 1, SpanId: 4, RefType: jaeger.SpanRefType_FOLLOWS_FROM},
+		},
+		Tags: []*jaeger.Tag{
+			{Key: "otel.link.2.w3c.tracestate", VType: jaeger.TagType_STRING, VStr: &[]string{"vendor=value"}[0]},
+		},
+	}
+
+	links := pdata.NewSpanLinkSlice()
+	attrs := pdata.NewAttributeMap()
+	jThriftReferencesToSpanLinks(span, links)
+	jThriftTagsToInternalAttributes(span.Tags, attrs)
+	moveLinkAttributes(attrs, links)
+
+	require.Equal(t, 3, links.Len())
+	assert.Equal(t, 0, attrs.Len())
+	for i, expected := range []struct {
+		traceID uint64
+		refType string
+	}{
+		{3, attributeRefTypeChildOf},
+		{1, attributeRefTypeChildOf},
+		{1, attributeRefTypeFollowsFrom},
+	} {
+		link := links.At(i)
+		assert.Equal(t, idutils.UInt64ToTraceID(0, expected.traceID), link.TraceID())
+		attr, ok := link.Attributes().Get(attributeRefType)
+		require.True(t, ok)
+		assert.Equal(t, expected.refType, attr.StringVal())
+	}
+	assert.Equal(t, pdata.TraceState("vendor=value"), links.At(2).TraceState())
+}
+
 func generateThriftProcess() *jaeger.Process {
 	attrVal := "resource-attr-val-1"
 	return &jaeger.Process{
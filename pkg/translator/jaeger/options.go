@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
+
+// Option configures the translation of Jaeger batches to internal traces.
+type Option func(*translateConfig)
+
+type translateConfig struct {
+	dropDebugAttributes  bool
+	translateProcessTags bool
+}
+
+// WithDropDebugAttributes drops the Jaeger span warnings and the "jaeger.version",
+// "ip" and "client-uuid" process tags instead of mapping them to OpenTelemetry
+// attributes.
+func WithDropDebugAttributes() Option {
+	return func(cfg *translateConfig) {
+		cfg.dropDebugAttributes = true
+	}
+}
+
+// WithProcessTagsTranslation maps the "ip" and "client-uuid" process tags to the
+// "net.host.ip" and "service.instance.id" resource attributes, unless these are
+// already set. By default, the process tags are kept as they are.
+func WithProcessTagsTranslation() Option {
+	return func(cfg *translateConfig) {
+		cfg.translateProcessTags = true
+	}
+}
+
+func newTranslateConfig(opts []Option) translateConfig {
+	cfg := translateConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
+
+import (
+	"github.com/jaegertracing/jaeger/model"
+	jsonconv "github.com/jaegertracing/jaeger/model/converter/json"
+	"github.com/jaegertracing/jaeger/model/json"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// JSONFromTraces translates internal trace data into the Jaeger UI JSON model,
+// the one of the traces returned by the HTTP API of the Jaeger query service.
+// The spans are grouped by trace ID, in the order of the first span of each
+// trace, and the processes of the spans of each trace are deduplicated.
+func JSONFromTraces(td pdata.Traces) ([]*json.Trace, error) {
+	batches, err := ProtoFromTraces(td)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*model.Trace
+	tracesByID := make(map[model.TraceID]*model.Trace)
+	for _, batch := range batches {
+		for _, span := range batch.Spans {
+			span.Process = batch.Process
+			trace, ok := tracesByID[span.TraceID]
+			if !ok {
+				trace = &model.Trace{}
+				tracesByID[span.TraceID] = trace
+				traces = append(traces, trace)
+			}
+			trace.Spans = append(trace.Spans, span)
+		}
+	}
+
+	jTraces := make([]*json.Trace, 0, len(traces))
+	for _, trace := range traces {
+		jTraces = append(jTraces, jsonconv.FromDomain(trace))
+	}
+	return jTraces, nil
+}
+
+// JSONSpansFromTraces translates internal trace data into Jaeger JSON spans with
+// an embedded process, the model of the spans of the Jaeger Elasticsearch and
+// archive storage, where the tag values are strings.
+func JSONSpansFromTraces(td pdata.Traces) ([]*json.Span, error) {
+	batches, err := ProtoFromTraces(td)
+	if err != nil {
+		return nil, err
+	}
+
+	var jSpans []*json.Span
+	for _, batch := range batches {
+		for _, span := range batch.Spans {
+			span.Process = batch.Process
+			jSpans = append(jSpans, jsonconv.FromDomainEmbedProcess(span))
+		}
+	}
+	return jSpans, nil
+}
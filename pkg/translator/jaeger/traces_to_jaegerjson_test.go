@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger
+
+import (
+	"testing"
+
+	"github.com/jaegertracing/jaeger/model/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
+)
+
+// generateTracesTwoServices returns the spans of generateTracesTwoSpansChildParent
+// and the spans of service-2, one in the same trace and one in another trace.
+func generateTracesTwoServices() pdata.Traces {
+	td := generateTracesTwoSpansChildParent()
+	traceID := td.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0).TraceID()
+
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString(conventions.AttributeServiceName, "service-2")
+	spans := rs.InstrumentationLibrarySpans().AppendEmpty().Spans()
+
+	span := spans.AppendEmpty()
+	span.SetName("operationC")
+	span.SetTraceID(traceID)
+	span.SetSpanID(pdata.NewSpanID([8]byte{0x2F, 0x2E, 0x2D, 0x2C, 0x2B, 0x2A, 0x29, 0x28}))
+	span.SetParentSpanID(pdata.NewSpanID([8]byte{0x1F, 0x1E, 0x1D, 0x1C, 0x1B, 0x1A, 0x19, 0x18}))
+
+	span = spans.AppendEmpty()
+	span.SetName("operationD")
+	span.SetTraceID(pdata.NewTraceID([16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}))
+	span.SetSpanID(pdata.NewSpanID([8]byte{0x3F, 0x3E, 0x3D, 0x3C, 0x3B, 0x3A, 0x39, 0x38}))
+	return td
+}
+
+func TestJSONFromTraces(t *testing.T) {
+	traces, err := JSONFromTraces(generateTracesTwoServices())
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+
+	trace := traces[0]
+	assert.Equal(t, json.TraceID("f1f2f3f4f5f6f7f8f9fafbfcfdfeff80"), trace.TraceID)
+	require.Len(t, trace.Spans, 3)
+	assert.Equal(t, map[json.ProcessID]json.Process{
+		"p1": {ServiceName: tracetranslator.ResourceNoServiceName, Tags: []json.KeyValue{}},
+		"p2": {ServiceName: "service-2", Tags: []json.KeyValue{}},
+	}, trace.Processes)
+
+	assert.Equal(t, json.SpanID("afaeadacabaaa9a8"), trace.Spans[0].SpanID)
+	assert.Equal(t, json.ProcessID("p1"), trace.Spans[0].ProcessID)
+	assert.Empty(t, trace.Spans[0].References)
+
+	assert.Equal(t, "operationB", trace.Spans[1].OperationName)
+	assert.Equal(t, json.ProcessID("p1"), trace.Spans[1].ProcessID)
+	assert.Equal(t, []json.Reference{
+		{RefType: json.ChildOf, TraceID: trace.TraceID, SpanID: "afaeadacabaaa9a8"},
+	}, trace.Spans[1].References)
+	assert.Contains(t, trace.Spans[1].Tags, json.KeyValue{Key: conventions.AttributeHTTPStatusCode, Type: json.Int64Type, Value: int64(404)})
+
+	assert.Equal(t, "operationC", trace.Spans[2].OperationName)
+	assert.Equal(t, json.ProcessID("p2"), trace.Spans[2].ProcessID)
+
+	trace = traces[1]
+	assert.Equal(t, json.TraceID("0102030405060708090a0b0c0d0e0f10"), trace.TraceID)
+	require.Len(t, trace.Spans, 1)
+	assert.Equal(t, "operationD", trace.Spans[0].OperationName)
+	assert.Equal(t, map[json.ProcessID]json.Process{
+		"p1": {ServiceName: "service-2", Tags: []json.KeyValue{}},
+	}, trace.Processes)
+}
+
+func TestJSONFromTracesEmpty(t *testing.T) {
+	traces, err := JSONFromTraces(pdata.NewTraces())
+	require.NoError(t, err)
+	assert.Empty(t, traces)
+
+	traces, err = JSONFromTraces(generateTracesResourceOnly())
+	require.NoError(t, err)
+	assert.Empty(t, traces)
+}
+
+func TestJSONSpansFromTraces(t *testing.T) {
+	spans, err := JSONSpansFromTraces(generateTracesTwoServices())
+	require.NoError(t, err)
+	require.Len(t, spans, 4)
+
+	assert.Equal(t, json.SpanID("1f1e1d1c1b1a1918"), spans[1].SpanID)
+	assert.Empty(t, spans[1].ProcessID)
+	assert.Equal(t, &json.Process{ServiceName: tracetranslator.ResourceNoServiceName, Tags: []json.KeyValue{}}, spans[1].Process)
+	assert.Contains(t, spans[1].Tags, json.KeyValue{Key: conventions.AttributeHTTPStatusCode, Type: json.Int64Type, Value: "404"})
+
+	assert.Equal(t, json.SpanID("3f3e3d3c3b3a3938"), spans[3].SpanID)
+	assert.Equal(t, &json.Process{ServiceName: "service-2", Tags: []json.KeyValue{}}, spans[3].Process)
+}
@@ -16,6 +16,7 @@ package jaeger // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/jaegertracing/jaeger/model"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -143,6 +144,17 @@ func appendTagsFromAttributes(dest []model.KeyValue, attrs pdata.AttributeMap) [
 	return dest
 }
 
+func appendTagsFromSpanAttributes(dest []model.KeyValue, attrs pdata.AttributeMap) []model.KeyValue {
+	attrs.Range(func(key string, attr pdata.AttributeValue) bool {
+		if key == attributeJaegerWarnings && attr.Type() == pdata.AttributeValueTypeArray {
+			return true
+		}
+		dest = append(dest, attributeToJaegerProtoTag(key, attr))
+		return true
+	})
+	return dest
+}
+
 func attributeToJaegerProtoTag(key string, attr pdata.AttributeValue) model.KeyValue {
 	tag := model.KeyValue{Key: key}
 	switch attr.Type() {
@@ -178,7 +190,7 @@ func spanToJaegerProto(span pdata.Span, libraryTags pdata.InstrumentationLibrary
 		return nil, err
 	}
 
-	jReferences, err := makeJaegerProtoReferences(span.Links(), span.ParentSpanID(), traceID)
+	jReferences, linkTags, err := makeJaegerProtoReferences(span.Links(), span.ParentSpanID(), traceID)
 	if err != nil {
 		return nil, fmt.Errorf("error converting span links to Jaeger references: %w", err)
 	}
@@ -192,11 +204,30 @@ func spanToJaegerProto(span pdata.Span, libraryTags pdata.InstrumentationLibrary
 		References:    jReferences,
 		StartTime:     startTime,
 		Duration:      span.EndTimestamp().AsTime().Sub(startTime),
-		Tags:          getJaegerProtoSpanTags(span, libraryTags),
+		Tags:          append(getJaegerProtoSpanTags(span, libraryTags), linkTags...),
 		Logs:          spanEventsToJaegerProtoLogs(span.Events()),
+		Warnings:      getWarningsFromAttrs(span.Attributes()),
 	}, nil
 }
 
+// getWarningsFromAttrs returns the Jaeger span warnings stored in the
+// "jaeger.warnings" attribute by the Jaeger to internal traces translation.
+func getWarningsFromAttrs(attrs pdata.AttributeMap) []string {
+	attr, ok := attrs.Get(attributeJaegerWarnings)
+	if !ok || attr.Type() != pdata.AttributeValueTypeArray {
+		return nil
+	}
+	vals := attr.SliceVal()
+	if vals.Len() == 0 {
+		return nil
+	}
+	warnings := make([]string, 0, vals.Len())
+	for i := 0; i < vals.Len(); i++ {
+		warnings = append(warnings, vals.At(i).AsString())
+	}
+	return warnings
+}
+
 func getJaegerProtoSpanTags(span pdata.Span, instrumentationLibrary pdata.InstrumentationLibrary) []model.KeyValue {
 	var spanKindTag, statusCodeTag, errorTag, statusMsgTag model.KeyValue
 	var spanKindTagFound, statusCodeTagFound, errorTagFound, statusMsgTagFound bool
@@ -204,6 +235,10 @@ func getJaegerProtoSpanTags(span pdata.Span, instrumentationLibrary pdata.Instru
 	libraryTags, libraryTagsFound := getTagsFromInstrumentationLibrary(instrumentationLibrary)
 
 	tagsCount := span.Attributes().Len() + len(libraryTags)
+	if attr, ok := span.Attributes().Get(attributeJaegerWarnings); ok && attr.Type() == pdata.AttributeValueTypeArray {
+		// Warnings are sent in the dedicated span field.
+		tagsCount--
+	}
 
 	spanKindTag, spanKindTagFound = getTagFromSpanKind(span.Kind())
 	if spanKindTagFound {
@@ -238,7 +273,7 @@ func getJaegerProtoSpanTags(span pdata.Span, instrumentationLibrary pdata.Instru
 	if libraryTagsFound {
 		tags = append(tags, libraryTags...)
 	}
-	tags = appendTagsFromAttributes(tags, span.Attributes())
+	tags = appendTagsFromSpanAttributes(tags, span.Attributes())
 	if spanKindTagFound {
 		tags = append(tags, spanKindTag)
 	}
@@ -276,15 +311,18 @@ func spanIDToJaegerProto(spanID pdata.SpanID) (model.SpanID, error) {
 	return model.SpanID(uSpanID), nil
 }
 
-// makeJaegerProtoReferences constructs jaeger span references based on parent span ID and span links
+// makeJaegerProtoReferences constructs jaeger span references based on parent span ID and span links.
+// The attributes and trace state of the links, which the references can't hold, are returned as span
+// tags prefixed with "otel.link.<index>.", the index of the link among the returned references
+// excluding the parent one.
 func makeJaegerProtoReferences(
 	links pdata.SpanLinkSlice,
 	parentSpanID pdata.SpanID,
 	traceID model.TraceID,
-) ([]model.SpanRef, error) {
+) ([]model.SpanRef, []model.KeyValue, error) {
 	parentSpanIDSet := !parentSpanID.IsEmpty()
 	if !parentSpanIDSet && links.Len() == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	refsCount := links.Len()
@@ -293,13 +331,14 @@ func makeJaegerProtoReferences(
 	}
 
 	refs := make([]model.SpanRef, 0, refsCount)
+	var linkTags []model.KeyValue
 
 	// Put parent span ID at the first place because usually backends look for it
 	// as the first CHILD_OF item in the model.SpanRef slice.
 	if parentSpanIDSet {
 		jParentSpanID, err := spanIDToJaegerProto(parentSpanID)
 		if err != nil {
-			return nil, fmt.Errorf("OC incorrect parent span ID: %v", err)
+			return nil, nil, fmt.Errorf("OC incorrect parent span ID: %v", err)
 		}
 
 		refs = append(refs, model.SpanRef{
@@ -309,6 +348,10 @@ func makeJaegerProtoReferences(
 		})
 	}
 
+	// Backends take the first CHILD_OF reference as the parent span: a link is only translated
+	// to a CHILD_OF reference if the span has no parent and no previous link was.
+	childOfSet := parentSpanIDSet
+	linkIndex := 0
 	for i := 0; i < links.Len(); i++ {
 		link := links.At(i)
 		traceID, err := traceIDToJaegerProto(link.TraceID())
@@ -321,18 +364,57 @@ func makeJaegerProtoReferences(
 			continue // skip invalid link
 		}
 
+		refType := refTypeFromLink(link)
+		if refType == model.SpanRefType_CHILD_OF {
+			if childOfSet {
+				refType = model.SpanRefType_FOLLOWS_FROM
+			}
+			childOfSet = true
+		}
 		refs = append(refs, model.SpanRef{
 			TraceID: traceID,
 			SpanID:  spanID,
-
-			// Since Jaeger RefType is not captured in internal data,
-			// use SpanRefType_FOLLOWS_FROM by default.
-			// SpanRefType_CHILD_OF supposed to be set only from parentSpanID.
-			RefType: model.SpanRefType_FOLLOWS_FROM,
+			RefType: refType,
 		})
+		linkTags = appendTagsFromLink(linkTags, link, linkIndex, refType)
+		linkIndex++
 	}
 
-	return refs, nil
+	return refs, linkTags, nil
+}
+
+// refTypeFromLink returns the reference type of the "opentracing.ref_type" link attribute,
+// SpanRefType_FOLLOWS_FROM by default.
+func refTypeFromLink(link pdata.SpanLink) model.SpanRefType {
+	if attr, ok := link.Attributes().Get(attributeRefType); ok && attr.StringVal() == attributeRefTypeChildOf {
+		return model.SpanRefType_CHILD_OF
+	}
+	return model.SpanRefType_FOLLOWS_FROM
+}
+
+// appendTagsFromLink appends the tags holding the attributes and trace state of the link at index,
+// except the "opentracing.ref_type" attribute matching the reference type the link is translated to.
+func appendTagsFromLink(dest []model.KeyValue, link pdata.SpanLink, index int, refType model.SpanRefType) []model.KeyValue {
+	refTypeValue := attributeRefTypeFollowsFrom
+	if refType == model.SpanRefType_CHILD_OF {
+		refTypeValue = attributeRefTypeChildOf
+	}
+	prefix := tagLinkPrefix + strconv.Itoa(index) + "."
+	link.Attributes().Range(func(key string, attr pdata.AttributeValue) bool {
+		if key == attributeRefType && attr.StringVal() == refTypeValue {
+			return true
+		}
+		dest = append(dest, attributeToJaegerProtoTag(prefix+key, attr))
+		return true
+	})
+	if link.TraceState() != pdata.TraceStateEmpty {
+		dest = append(dest, model.KeyValue{
+			Key:   prefix + tracetranslator.TagW3CTraceState,
+			VType: model.ValueType_STRING,
+			VStr:  string(link.TraceState()),
+		})
+	}
+	return dest
 }
 
 func spanEventsToJaegerProtoLogs(events pdata.SpanEventSlice) []model.Log {
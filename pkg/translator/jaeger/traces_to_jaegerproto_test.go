@@ -362,3 +362,170 @@ func BenchmarkInternalTracesToJaegerProto(b *testing.B) {
 		assert.NoError(b, err)
 	}
 }
+
+func TestJaegerProtoWarningsRoundTrip(t *testing.T) {
+	batches := []*model.Batch{
+		{
+			Process: &model.Process{ServiceName: "service"},
+			Spans: []*model.Span{
+				{
+					TraceID:       model.NewTraceID(1, 1),
+					SpanID:        model.NewSpanID(1),
+					OperationName: "operation",
+					Tags:          []model.KeyValue{model.String("key", "value")},
+					Warnings:      []string{"clock skew adjustment disabled"},
+				},
+			},
+		},
+	}
+
+	td, err := ProtoToTraces(batches)
+	require.NoError(t, err)
+	got, err := ProtoFromTraces(td)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].Spans, 1)
+	assert.Equal(t, []string{"clock skew adjustment disabled"}, got[0].Spans[0].Warnings)
+	assert.Equal(t, []model.KeyValue{model.String("key", "value")}, got[0].Spans[0].Tags)
+}
+
+func TestJaegerProtoReferencesRoundTrip(t *testing.T) {
+	traceID := model.NewTraceID(1, 1)
+	otherTraceID := model.NewTraceID(2, 2)
+	references := []model.SpanRef{
+		{TraceID: traceID, SpanID: model.NewSpanID(2), RefType: model.ChildOf},
+		{TraceID: traceID, SpanID: model.NewSpanID(3), RefType: model.ChildOf},
+		{TraceID: otherTraceID, SpanID: model.NewSpanID(4), RefType: model.FollowsFrom},
+		{TraceID: otherTraceID, SpanID: model.NewSpanID(2), RefType: model.ChildOf},
+		{TraceID: traceID, SpanID: model.NewSpanID(2), RefType: model.ChildOf},
+	}
+	batches := []*model.Batch{
+		{
+			Process: &model.Process{ServiceName: "service"},
+			Spans: []*model.Span{
+				{
+					TraceID:       traceID,
+					SpanID:        model.NewSpanID(1),
+					OperationName: "operation",
+					References:    references,
+				},
+			},
+		},
+	}
+
+	td, err := ProtoToTraces(batches)
+	require.NoError(t, err)
+	span := td.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+	assert.Equal(t, pdata.NewSpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 2}), span.ParentSpanID())
+	require.Equal(t, 4, span.Links().Len())
+	for i, refType := range []string{attributeRefTypeChildOf, attributeRefTypeFollowsFrom, attributeRefTypeChildOf, attributeRefTypeChildOf} {
+		attr, ok := span.Links().At(i).Attributes().Get(attributeRefType)
+		require.True(t, ok)
+		assert.Equal(t, refType, attr.StringVal())
+	}
+
+	// only the parent is translated back to a CHILD_OF reference, the reference type of the
+	// other links is kept in the tags.
+	got, err := ProtoFromTraces(td)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].Spans, 1)
+	assert.Equal(t, []model.SpanRef{
+		{TraceID: traceID, SpanID: model.NewSpanID(2), RefType: model.ChildOf},
+		{TraceID: traceID, SpanID: model.NewSpanID(3), RefType: model.FollowsFrom},
+		{TraceID: otherTraceID, SpanID: model.NewSpanID(4), RefType: model.FollowsFrom},
+		{TraceID: otherTraceID, SpanID: model.NewSpanID(2), RefType: model.FollowsFrom},
+		{TraceID: traceID, SpanID: model.NewSpanID(2), RefType: model.FollowsFrom},
+	}, got[0].Spans[0].References)
+	assert.ElementsMatch(t, []model.KeyValue{
+		model.String("otel.link.0.opentracing.ref_type", attributeRefTypeChildOf),
+		model.String("otel.link.2.opentracing.ref_type", attributeRefTypeChildOf),
+		model.String("otel.link.3.opentracing.ref_type", attributeRefTypeChildOf),
+	}, got[0].Spans[0].Tags)
+
+	tdFromProto, err := ProtoToTraces(got)
+	require.NoError(t, err)
+	assert.Equal(t, td, tdFromProto)
+}
+
+func TestJaegerProtoChildOfLinks(t *testing.T) {
+	td := generateTracesOneSpanNoResource()
+	span := td.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+	otherTraceID := pdata.NewTraceID([16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1})
+	for i := byte(1); i <= 2; i++ {
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(otherTraceID)
+		link.SetSpanID(pdata.NewSpanID([8]byte{i, i, i, i, i, i, i, i}))
+		link.Attributes().InsertString(attributeRefType, attributeRefTypeChildOf)
+	}
+
+	// the first link of a span without parent is translated to a CHILD_OF reference.
+	got, err := ProtoFromTraces(td)
+	require.NoError(t, err)
+	jSpan := got[0].Spans[0]
+	jOtherTraceID := model.NewTraceID(0x0101010101010101, 0x0101010101010101)
+	assert.Equal(t, []model.SpanRef{
+		{TraceID: jOtherTraceID, SpanID: model.NewSpanID(0x0101010101010101), RefType: model.ChildOf},
+		{TraceID: jOtherTraceID, SpanID: model.NewSpanID(0x0202020202020202), RefType: model.FollowsFrom},
+	}, jSpan.References)
+	assert.Contains(t, jSpan.Tags, model.String("otel.link.1.opentracing.ref_type", attributeRefTypeChildOf))
+	assert.NotContains(t, jSpan.Tags, model.String("otel.link.0.opentracing.ref_type", attributeRefTypeChildOf))
+}
+
+func TestSpanLinksRoundTrip(t *testing.T) {
+	td := generateTracesOneSpanNoResource()
+	span := td.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+	span.SetParentSpanID(pdata.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.Attributes().InsertString("otel.link.5.key", "not a link")
+
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(pdata.NewTraceID([16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}))
+	link.SetSpanID(pdata.NewSpanID([8]byte{1, 1, 1, 1, 1, 1, 1, 1}))
+	link.SetTraceState("vendor=value")
+	link.Attributes().InsertString(attributeRefType, attributeRefTypeChildOf)
+	link.Attributes().InsertString("link.key", "value")
+	link.Attributes().InsertInt("link.count", 3)
+
+	// an invalid link is skipped, the index of the next one in the tags is not shifted
+	span.Links().AppendEmpty()
+
+	link = span.Links().AppendEmpty()
+	link.SetTraceID(span.TraceID())
+	link.SetSpanID(pdata.NewSpanID([8]byte{2, 2, 2, 2, 2, 2, 2, 2}))
+	link.Attributes().InsertBool("link.flag", true)
+
+	got, err := ProtoFromTraces(td)
+	require.NoError(t, err)
+	jSpan := got[0].Spans[0]
+	assert.Equal(t, []model.SpanRef{
+		{TraceID: jSpan.TraceID, SpanID: model.NewSpanID(0x0102030405060708), RefType: model.ChildOf},
+		{TraceID: model.NewTraceID(0x0101010101010101, 0x0101010101010101), SpanID: model.NewSpanID(0x0101010101010101), RefType: model.FollowsFrom},
+		{TraceID: jSpan.TraceID, SpanID: model.NewSpanID(0x0202020202020202), RefType: model.FollowsFrom},
+	}, jSpan.References)
+	assert.Subset(t, jSpan.Tags, []model.KeyValue{
+		model.String("otel.link.0.opentracing.ref_type", attributeRefTypeChildOf),
+		model.String("otel.link.0.link.key", "value"),
+		model.Int64("otel.link.0.link.count", 3),
+		model.String("otel.link.0.w3c.tracestate", "vendor=value"),
+		model.Bool("otel.link.1.link.flag", true),
+		model.String("otel.link.5.key", "not a link"),
+	})
+
+	tdFromProto, err := ProtoToTraces(got)
+	require.NoError(t, err)
+	gotSpan := tdFromProto.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+
+	expectedLinks := pdata.NewSpanLinkSlice()
+	span.Links().CopyTo(expectedLinks)
+	expectedLinks.RemoveIf(func(link pdata.SpanLink) bool { return link.SpanID().IsEmpty() })
+	expectedLinks.At(1).Attributes().InsertString(attributeRefType, attributeRefTypeFollowsFrom)
+	expectedLinks.At(0).Attributes().Sort()
+	expectedLinks.At(1).Attributes().Sort()
+	gotSpan.Links().At(0).Attributes().Sort()
+	gotSpan.Links().At(1).Attributes().Sort()
+	assert.Equal(t, expectedLinks, gotSpan.Links())
+
+	attr, ok := gotSpan.Attributes().Get("otel.link.5.key")
+	require.True(t, ok)
+	assert.Equal(t, "not a link", attr.StringVal())
+}
@@ -238,6 +238,8 @@ func validateMetrics(metric pdata.Metric) bool {
 		return metric.Sum().DataPoints().Len() != 0 && metric.Sum().AggregationTemporality() == pdata.MetricAggregationTemporalityCumulative
 	case pdata.MetricDataTypeHistogram:
 		return metric.Histogram().DataPoints().Len() != 0 && metric.Histogram().AggregationTemporality() == pdata.MetricAggregationTemporalityCumulative
+	case pdata.MetricDataTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len() != 0 && metric.ExponentialHistogram().AggregationTemporality() == pdata.MetricAggregationTemporalityCumulative
 	case pdata.MetricDataTypeSummary:
 		return metric.Summary().DataPoints().Len() != 0
 	}
@@ -339,7 +341,12 @@ func addSingleHistogramDataPoint(pt pdata.HistogramDataPoint, resource pdata.Res
 	addExemplars(tsMap, promExemplars, bucketBounds)
 }
 
-func getPromExemplars(pt pdata.HistogramDataPoint) []prompb.Exemplar {
+// exemplarsDataPoint is a data point holding exemplars.
+type exemplarsDataPoint interface {
+	Exemplars() pdata.ExemplarSlice
+}
+
+func getPromExemplars(pt exemplarsDataPoint) []prompb.Exemplar {
 	var promExemplars []prompb.Exemplar
 
 	for i := 0; i < pt.Exemplars().Len(); i++ {
@@ -367,6 +374,72 @@ func getPromExemplars(pt pdata.HistogramDataPoint) []prompb.Exemplar {
 	return promExemplars
 }
 
+// addSingleExponentialHistogramDataPoint converts pt to the classic histogram
+// series, as the vendored prompb has no native histograms: a _sum and a
+// _count series, and a cumulative _bucket series for each of the negative,
+// zero and positive buckets of pt and for +Inf.
+func addSingleExponentialHistogramDataPoint(pt pdata.ExponentialHistogramDataPoint, resource pdata.Resource, metric pdata.Metric, settings Settings, tsMap map[string]*prompb.TimeSeries) {
+	time := convertTimeStamp(pt.Timestamp())
+	noRecordedValue := pt.Flags().HasFlag(pdata.MetricDataPointFlagNoRecordedValue)
+	sampleValue := func(v float64) float64 {
+		if noRecordedValue {
+			return math.Float64frombits(value.StaleNaN)
+		}
+		return v
+	}
+	baseName := getPromMetricName(metric, settings.Namespace)
+
+	sumlabels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nameStr, baseName+sumStr)
+	addSample(tsMap, &prompb.Sample{Value: sampleValue(pt.Sum()), Timestamp: time}, sumlabels, metric)
+
+	countlabels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nameStr, baseName+countStr)
+	addSample(tsMap, &prompb.Sample{Value: sampleValue(float64(pt.Count())), Timestamp: time}, countlabels, metric)
+
+	var cumulativeCount uint64
+	bucketBounds := make([]bucketBoundsData, 0)
+	addBucket := func(bound float64, count uint64) {
+		cumulativeCount += count
+		boundStr := strconv.FormatFloat(bound, 'f', -1, 64)
+		labels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nameStr, baseName+bucketStr, leStr, boundStr)
+		sig := addSample(tsMap, &prompb.Sample{Value: sampleValue(float64(cumulativeCount)), Timestamp: time}, labels, metric)
+		bucketBounds = append(bucketBounds, bucketBoundsData{sig: sig, bound: bound})
+	}
+
+	// The negative bucket of index i holds the values in [-base^(i+1), -base^i),
+	// they are visited from the lowest values up.
+	negative := pt.Negative()
+	negativeCounts := negative.BucketCounts()
+	for i := len(negativeCounts) - 1; i >= 0; i-- {
+		addBucket(-exponentialBucketLowerBound(pt.Scale(), negative.Offset()+int32(i)), negativeCounts[i])
+	}
+	if len(negativeCounts) > 0 || pt.ZeroCount() > 0 {
+		addBucket(0, pt.ZeroCount())
+	}
+
+	// The positive bucket of index i holds the values in (base^i, base^(i+1)].
+	positive := pt.Positive()
+	for i, count := range positive.BucketCounts() {
+		addBucket(exponentialBucketLowerBound(pt.Scale(), positive.Offset()+int32(i)+1), count)
+	}
+
+	// the +Inf bucket holds all the values
+	infLabels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nameStr, baseName+bucketStr, leStr, pInfStr)
+	sig := addSample(tsMap, &prompb.Sample{Value: sampleValue(float64(pt.Count())), Timestamp: time}, infLabels, metric)
+
+	bucketBounds = append(bucketBounds, bucketBoundsData{sig: sig, bound: math.Inf(1)})
+	addExemplars(tsMap, getPromExemplars(pt), bucketBounds)
+}
+
+// exponentialBucketLowerBound returns the lower bound of the exponential
+// histogram bucket of the given index, base^index with base = 2^(2^-scale).
+func exponentialBucketLowerBound(scale int32, index int32) float64 {
+	if scale <= 0 {
+		// the bound is an exact power of two
+		return math.Ldexp(1, int(index)<<-scale)
+	}
+	return math.Exp2(float64(index) / float64(int64(1)<<scale))
+}
+
 // addSingleSummaryDataPoint converts pt to len(QuantileValues) + 2 samples.
 func addSingleSummaryDataPoint(pt pdata.SummaryDataPoint, resource pdata.Resource, metric pdata.Metric, settings Settings,
 	tsMap map[string]*prompb.TimeSeries) {
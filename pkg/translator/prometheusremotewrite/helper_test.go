@@ -22,6 +22,7 @@ import (
 	"github.com/prometheus/prometheus/model/timestamp"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/model/pdata"
 )
 
@@ -425,3 +426,42 @@ func Test_getPromExemplars(t *testing.T) {
 		})
 	}
 }
+
+func Test_addSingleExponentialHistogramDataPoint(t *testing.T) {
+	// scale 0: base 2, the positive buckets are (1, 2] and (2, 4], the negative one is [-2, -1)
+	metric := getExponentialHistogramMetric(validExponentialHistogram, lbs1, time1, floatVal1, 5, 0, 1, []uint64{1, 2}, []uint64{1})
+	dp := metric.ExponentialHistogram().DataPoints().At(0)
+	tsMap := map[string]*prompb.TimeSeries{}
+	addSingleExponentialHistogramDataPoint(dp, pdata.NewResource(), metric, Settings{}, tsMap)
+
+	name := getPromMetricName(metric, "")
+	series := func(suffix string, extras ...string) *prompb.TimeSeries {
+		labels := createAttributes(pdata.NewResource(), lbs1, nil, append([]string{nameStr, name + suffix}, extras...)...)
+		return tsMap[timeSeriesSignature(metric, &labels)]
+	}
+	expected := []struct {
+		ts    *prompb.TimeSeries
+		value float64
+	}{
+		{series(sumStr), floatVal1},
+		{series(countStr), 5},
+		{series(bucketStr, leStr, "-1"), 1},
+		{series(bucketStr, leStr, "0"), 2},
+		{series(bucketStr, leStr, "2"), 3},
+		{series(bucketStr, leStr, "4"), 5},
+		{series(bucketStr, leStr, pInfStr), 5},
+	}
+	assert.Len(t, tsMap, len(expected))
+	for _, e := range expected {
+		require.NotNil(t, e.ts)
+		assert.Equal(t, []prompb.Sample{getSample(e.value, msTime1)}, e.ts.Samples)
+	}
+}
+
+func Test_exponentialBucketLowerBound(t *testing.T) {
+	assert.Equal(t, 2.0, exponentialBucketLowerBound(0, 1))
+	assert.Equal(t, 0.5, exponentialBucketLowerBound(0, -1))
+	assert.Equal(t, 16.0, exponentialBucketLowerBound(-1, 2))
+	assert.InDelta(t, math.Sqrt2, exponentialBucketLowerBound(1, 1), 1e-12)
+	assert.InDelta(t, 2.0, exponentialBucketLowerBound(3, 8), 1e-12)
+}
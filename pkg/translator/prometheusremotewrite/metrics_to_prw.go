@@ -83,6 +83,15 @@ func FromMetrics(md pdata.Metrics, settings Settings) (tsMap map[string]*prompb.
 					for x := 0; x < dataPoints.Len(); x++ {
 						addSingleHistogramDataPoint(dataPoints.At(x), resource, metric, settings, tsMap)
 					}
+				case pdata.MetricDataTypeExponentialHistogram:
+					// TODO: send native histograms, behind a setting, once prompb has the Histogram message.
+					dataPoints := metric.ExponentialHistogram().DataPoints()
+					if dataPoints.Len() == 0 {
+						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+					}
+					for x := 0; x < dataPoints.Len(); x++ {
+						addSingleExponentialHistogramDataPoint(dataPoints.At(x), resource, metric, settings, tsMap)
+					}
 				case pdata.MetricDataTypeSummary:
 					dataPoints := metric.Summary().DataPoints()
 					if dataPoints.Len() == 0 {
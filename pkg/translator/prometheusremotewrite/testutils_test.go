@@ -107,6 +107,8 @@ var (
 	validSummary     = "valid_Summary"
 	suffixedCounter  = "valid_IntSum_total"
 
+	validExponentialHistogram = "valid_ExponentialHistogram"
+
 	validIntGaugeDirty = "*valid_IntGauge$"
 
 	unmatchedBoundBucketHist = "unmatchedBoundBucketHist"
@@ -120,6 +122,8 @@ var (
 		validSum:         getSumMetric(validSum, lbs1, floatVal1, time1),
 		validHistogram:   getHistogramMetric(validHistogram, lbs1, time1, floatVal1, uint64(intVal1), bounds, buckets),
 		validSummary:     getSummaryMetric(validSummary, lbs1, time1, floatVal1, uint64(intVal1), quantiles),
+
+		validExponentialHistogram: getExponentialHistogramMetric(validExponentialHistogram, lbs1, time1, floatVal1, uint64(intVal1), 0, 0, buckets, nil),
 	}
 	validMetrics2 = map[string]pdata.Metric{
 		validIntGauge:            getIntGaugeMetric(validIntGauge, lbs2, intVal2, time2),
@@ -144,6 +148,8 @@ var (
 	emptyCumulativeSum       = "emptyCumulativeSum"
 	emptyCumulativeHistogram = "emptyCumulativeHistogram"
 
+	deltaExponentialHistogram = "deltaExponentialHistogram"
+
 	// different metrics that will not pass validate metrics and will cause the exporter to return an error
 	invalidMetrics = map[string]pdata.Metric{
 		empty:                    pdata.NewMetric(),
@@ -153,6 +159,8 @@ var (
 		emptySummary:             getEmptySummaryMetric(emptySummary),
 		emptyCumulativeSum:       getEmptyCumulativeSumMetric(emptyCumulativeSum),
 		emptyCumulativeHistogram: getEmptyCumulativeHistogramMetric(emptyCumulativeHistogram),
+
+		deltaExponentialHistogram: getDeltaExponentialHistogramMetric(deltaExponentialHistogram),
 	}
 )
 
@@ -366,6 +374,36 @@ func getHistogramMetric(name string, attributes pdata.AttributeMap, ts uint64, s
 	return metric
 }
 
+func getDeltaExponentialHistogramMetric(name string) pdata.Metric {
+	metric := pdata.NewMetric()
+	metric.SetName(name)
+	metric.SetDataType(pdata.MetricDataTypeExponentialHistogram)
+	metric.ExponentialHistogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityDelta)
+	metric.ExponentialHistogram().DataPoints().AppendEmpty()
+	return metric
+}
+
+func getExponentialHistogramMetric(name string, attributes pdata.AttributeMap, ts uint64, sum float64, count uint64, scale int32, zeroCount uint64, positive []uint64, negative []uint64) pdata.Metric {
+	metric := pdata.NewMetric()
+	metric.SetName(name)
+	metric.SetDataType(pdata.MetricDataTypeExponentialHistogram)
+	metric.ExponentialHistogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	dp := metric.ExponentialHistogram().DataPoints().AppendEmpty()
+	if strings.HasPrefix(name, "staleNaN") {
+		dp.SetFlags(1)
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetScale(scale)
+	dp.SetZeroCount(zeroCount)
+	dp.Positive().SetBucketCounts(positive)
+	dp.Negative().SetBucketCounts(negative)
+	attributes.CopyTo(dp.Attributes())
+
+	dp.SetTimestamp(pdata.Timestamp(ts))
+	return metric
+}
+
 func getEmptySummaryMetric(name string) pdata.Metric {
 	metric := pdata.NewMetric()
 	metric.SetName(name)
@@ -414,47 +414,33 @@ func parseZipkinV1Annotations(annotations []*annotation) *annotationParseResult
 
 func hexTraceIDToOCTraceID(hex string) ([]byte, error) {
 	// Per info at https://zipkin.io/zipkin-api/zipkin-api.yaml it should be 16 or 32 characters
-	hexLen := len(hex)
-	if hexLen != 16 && hexLen != 32 {
+	traceID, err := idutils.HexToTraceID(hex)
+	switch {
+	case errors.Is(err, idutils.ErrInvalidHexIDLength):
 		return nil, errHexTraceIDWrongLen
-	}
-
-	var high, low uint64
-	var err error
-	if hexLen == 32 {
-		if high, err = strconv.ParseUint(hex[:16], 16, 64); err != nil {
-			return nil, errHexTraceIDParsing
-		}
-	}
-
-	if low, err = strconv.ParseUint(hex[hexLen-16:], 16, 64); err != nil {
+	case err != nil:
 		return nil, errHexTraceIDParsing
-	}
-
-	if high == 0 && low == 0 {
+	case traceID.IsEmpty():
 		return nil, errHexTraceIDZero
 	}
 
-	tidBytes := idutils.UInt64ToTraceID(high, low).Bytes()
+	tidBytes := traceID.Bytes()
 	return tidBytes[:], nil
 }
 
 func hexIDToOCID(hex string) ([]byte, error) {
 	// Per info at https://zipkin.io/zipkin-api/zipkin-api.yaml it should be 16 characters
-	if len(hex) != 16 {
+	spanID, err := idutils.HexToSpanID(hex)
+	switch {
+	case errors.Is(err, idutils.ErrInvalidHexIDLength):
 		return nil, errHexIDWrongLen
-	}
-
-	idValue, err := strconv.ParseUint(hex, 16, 64)
-	if err != nil {
+	case err != nil:
 		return nil, errHexIDParsing
-	}
-
-	if idValue == 0 {
+	case spanID.IsEmpty():
 		return nil, errHexIDZero
 	}
 
-	idBytes := idutils.UInt64ToSpanID(idValue).Bytes()
+	idBytes := spanID.Bytes()
 	return idBytes[:], nil
 }
 
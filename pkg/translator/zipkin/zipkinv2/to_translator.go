@@ -15,9 +15,7 @@
 package zipkinv2 // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
 
 import (
-	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -210,21 +208,23 @@ func zTagsToSpanLinks(tags map[string]string, dest pdata.SpanLinkSlice) error {
 		}
 		link := dest.AppendEmpty()
 
-		// Convert trace id.
-		rawTrace := [16]byte{}
-		errTrace := unmarshalJSON(rawTrace[:], []byte(parts[0]))
-		if errTrace != nil {
-			return errTrace
+		// Convert trace id, an empty value being an invalid trace id.
+		if parts[0] != "" {
+			traceID, errTrace := idutils.HexToTraceID(parts[0])
+			if errTrace != nil {
+				return errTrace
+			}
+			link.SetTraceID(traceID)
 		}
-		link.SetTraceID(pdata.NewTraceID(rawTrace))
 
-		// Convert span id.
-		rawSpan := [8]byte{}
-		errSpan := unmarshalJSON(rawSpan[:], []byte(parts[1]))
-		if errSpan != nil {
-			return errSpan
+		// Convert span id, an empty value being an invalid span id.
+		if parts[1] != "" {
+			spanID, errSpan := idutils.HexToSpanID(parts[1])
+			if errSpan != nil {
+				return errSpan
+			}
+			link.SetSpanID(spanID)
 		}
-		link.SetSpanID(pdata.NewSpanID(rawSpan))
 
 		link.SetTraceState(pdata.TraceState(parts[2]))
 
@@ -448,28 +448,6 @@ func setTimestampsV2(zspan *zipkinmodel.SpanModel, dest pdata.Span, destAttrs pd
 	}
 }
 
-// unmarshalJSON inflates trace id from hex string, possibly enclosed in quotes.
-// TODO: Find a way to avoid this duplicate code. Consider to expose this in model/pdata.
-func unmarshalJSON(dst []byte, src []byte) error {
-	if l := len(src); l >= 2 && src[0] == '"' && src[l-1] == '"' {
-		src = src[1 : l-1]
-	}
-	nLen := len(src)
-	if nLen == 0 {
-		return nil
-	}
-
-	if len(dst) != hex.DecodedLen(nLen) {
-		return errors.New("invalid length for ID")
-	}
-
-	_, err := hex.Decode(dst, src)
-	if err != nil {
-		return fmt.Errorf("cannot unmarshal ID from string '%s': %w", string(src), err)
-	}
-	return nil
-}
-
 // TODO: Find a way to avoid this duplicate code. Consider to expose this in model/pdata.
 var statusCodeValue = map[string]int32{
 	"STATUS_CODE_UNSET": 0,
@@ -24,19 +24,23 @@ import (
 )
 
 type logAttributesProcessor struct {
-	attrProc *attraction.AttrProc
-	include  filterlog.Matcher
-	exclude  filterlog.Matcher
+	attrProc        *attraction.AttrProc
+	applyToRecord   bool
+	applyToResource bool
+	include         filterlog.Matcher
+	exclude         filterlog.Matcher
 }
 
 // newLogAttributesProcessor returns a processor that modifies attributes of a
 // log record. To construct the attributes processors, the use of the factory
 // methods are required in order to validate the inputs.
-func newLogAttributesProcessor(attrProc *attraction.AttrProc, include, exclude filterlog.Matcher) *logAttributesProcessor {
+func newLogAttributesProcessor(attrProc *attraction.AttrProc, applyToRecord, applyToResource bool, include, exclude filterlog.Matcher) *logAttributesProcessor {
 	return &logAttributesProcessor{
-		attrProc: attrProc,
-		include:  include,
-		exclude:  exclude,
+		attrProc:        attrProc,
+		applyToRecord:   applyToRecord,
+		applyToResource: applyToResource,
+		include:         include,
+		exclude:         exclude,
 	}
 }
 
@@ -46,6 +50,7 @@ func (a *logAttributesProcessor) processLogs(ctx context.Context, ld pdata.Logs)
 		rs := rls.At(i)
 		ilss := rs.InstrumentationLibraryLogs()
 		resource := rs.Resource()
+		resourceMatched := false
 		for j := 0; j < ilss.Len(); j++ {
 			ils := ilss.At(j)
 			logs := ils.LogRecords()
@@ -55,10 +60,18 @@ func (a *logAttributesProcessor) processLogs(ctx context.Context, ld pdata.Logs)
 				if a.skipLog(lr, resource, library) {
 					continue
 				}
+				resourceMatched = true
 
-				a.attrProc.Process(ctx, lr.Attributes())
+				if a.applyToRecord {
+					a.attrProc.Process(ctx, lr.Attributes())
+				}
 			}
 		}
+		// The resource is processed last so that all its log records are
+		// matched against its original attributes.
+		if a.applyToResource && resourceMatched {
+			a.attrProc.Process(ctx, resource.Attributes())
+		}
 	}
 	return ld, nil
 }
@@ -182,6 +182,58 @@ func TestAttributes_FilterLogs(t *testing.T) {
 	}
 }
 
+func TestLogAttributes_ApplyToResource(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Actions = []attraction.ActionKeyValue{
+		{Key: "env", Action: attraction.INSERT, Value: "prod"},
+	}
+	cfg.Include = &filterconfig.MatchProperties{
+		Attributes: []filterconfig.Attribute{{Key: "match", Value: true}},
+		Config:     *createConfig(filterset.Strict),
+	}
+	cfg.ApplyTo = []string{"resource"}
+	tp, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	ld := pdata.NewLogs()
+	for _, match := range []bool{true, false} {
+		lr := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Attributes().InsertBool("match", match)
+	}
+	require.NoError(t, tp.ConsumeLogs(context.Background(), ld))
+
+	matched := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{"env": "prod"}, matched.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"match": true}, matched.InstrumentationLibraryLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	assert.Equal(t, 0, ld.ResourceLogs().At(1).Resource().Attributes().Len())
+}
+
+func TestAttributes_FilterLogsBySeverity(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Actions = []attraction.ActionKeyValue{
+		{Key: "attribute1", Action: attraction.INSERT, Value: 123},
+	}
+	cfg.Include = &filterconfig.MatchProperties{
+		LogSeverityMin: "WARN",
+		Config:         *createConfig(filterset.Strict),
+	}
+	tp, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	ld := pdata.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords()
+	for _, sev := range []pdata.SeverityNumber{pdata.SeverityNumberINFO, pdata.SeverityNumberWARN, pdata.SeverityNumberERROR} {
+		lrs.AppendEmpty().SetSeverityNumber(sev)
+	}
+	require.NoError(t, tp.ConsumeLogs(context.Background(), ld))
+
+	assert.Equal(t, 0, lrs.At(0).Attributes().Len())
+	assert.Equal(t, map[string]interface{}{"attribute1": int64(123)}, lrs.At(1).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"attribute1": int64(123)}, lrs.At(2).Attributes().AsRaw())
+}
+
 func TestAttributes_FilterLogsByNameStrict(t *testing.T) {
 	testCases := []logTestCase{
 		{
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
+)
+
+type metricAttributesProcessor struct {
+	attrProc        *attraction.AttrProc
+	applyToRecord   bool
+	applyToResource bool
+	include         filtermetric.DataPointMatcher
+	exclude         filtermetric.DataPointMatcher
+}
+
+// newMetricAttributesProcessor returns a processor that modifies attributes of the
+// data points of a metric. To construct the attributes processors, the use of the
+// factory methods are required in order to validate the inputs.
+func newMetricAttributesProcessor(attrProc *attraction.AttrProc, applyToRecord, applyToResource bool, include, exclude filtermetric.DataPointMatcher) *metricAttributesProcessor {
+	return &metricAttributesProcessor{
+		attrProc:        attrProc,
+		applyToRecord:   applyToRecord,
+		applyToResource: applyToResource,
+		include:         include,
+		exclude:         exclude,
+	}
+}
+
+func (a *metricAttributesProcessor) processMetrics(ctx context.Context, md pdata.Metrics) (pdata.Metrics, error) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		ilms := rm.InstrumentationLibraryMetrics()
+		resource := rm.Resource()
+		resourceMatched := false
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			metrics := ilm.Metrics()
+			library := ilm.InstrumentationLibrary()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				for _, attrs := range dataPointAttributes(metric) {
+					if a.skipDataPoint(metric, attrs, resource, library) {
+						continue
+					}
+					resourceMatched = true
+
+					if a.applyToRecord {
+						a.attrProc.Process(ctx, attrs)
+					}
+				}
+			}
+		}
+		// The resource is processed last so that all its data points are
+		// matched against its original attributes.
+		if a.applyToResource && resourceMatched {
+			a.attrProc.Process(ctx, resource.Attributes())
+		}
+	}
+	return md, nil
+}
+
+// dataPointAttributes returns the attributes of all the data points of a metric.
+func dataPointAttributes(metric pdata.Metric) []pdata.AttributeMap {
+	var attrs []pdata.AttributeMap
+	switch metric.DataType() {
+	case pdata.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			attrs = append(attrs, dps.At(i).Attributes())
+		}
+	}
+	return attrs
+}
+
+// skipDataPoint determines if a data point should be processed.
+// True is returned when a data point should be skipped.
+// False is returned when a data point should not be skipped.
+// The logic determining if a data point should be processed is set
+// in the attribute configuration with the include and exclude settings.
+// Include properties are checked before exclude settings are checked.
+func (a *metricAttributesProcessor) skipDataPoint(metric pdata.Metric, attrs pdata.AttributeMap, resource pdata.Resource, library pdata.InstrumentationLibrary) bool {
+	if a.include != nil {
+		// A false returned in this case means the data point should not be processed.
+		if include := a.include.MatchDataPoint(metric, attrs, resource, library); !include {
+			return true
+		}
+	}
+
+	if a.exclude != nil {
+		// A true returned in this case means the data point should not be processed.
+		if exclude := a.exclude.MatchDataPoint(metric, attrs, resource, library); exclude {
+			return true
+		}
+	}
+
+	return false
+}
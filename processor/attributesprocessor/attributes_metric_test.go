@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributesprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+// generateMetricData returns metrics with one data point per metric name, each
+// data point having the given attributes.
+func generateMetricData(names []string, attrs map[string]pdata.AttributeValue) pdata.Metrics {
+	md := pdata.NewMetrics()
+	ilm := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
+	for _, name := range names {
+		m := ilm.Metrics().AppendEmpty()
+		m.SetName(name)
+		m.SetDataType(pdata.MetricDataTypeGauge)
+		dp := m.Gauge().DataPoints().AppendEmpty()
+		pdata.NewAttributeMapFromMap(attrs).CopyTo(dp.Attributes())
+	}
+	return md
+}
+
+func TestMetricProcessor_NilEmptyData(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "attribute1", Action: attraction.INSERT, Value: 123},
+	}
+	mp, err := factory.CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	md := pdata.NewMetrics()
+	assert.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, pdata.NewMetrics(), md)
+}
+
+func TestAttributes_FilterMetricsByName(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "attribute1", Action: attraction.INSERT, Value: 123},
+	}
+	oCfg.Include = &filterconfig.MatchProperties{
+		MetricNames: []string{"^http\\..*"},
+		Config:      *createConfig(filterset.Regexp),
+	}
+	oCfg.Exclude = &filterconfig.MatchProperties{
+		Attributes: []filterconfig.Attribute{
+			{Key: "NoModification", Value: true},
+		},
+		Config: *createConfig(filterset.Strict),
+	}
+	mp, err := factory.CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	md := generateMetricData([]string{"http.requests", "db.calls"}, nil)
+	excluded := generateMetricData([]string{"http.requests"}, map[string]pdata.AttributeValue{
+		"NoModification": pdata.NewAttributeValueBool(true),
+	})
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), excluded))
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]interface{}{"attribute1": int64(123)}, metrics.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, 0, metrics.At(1).Gauge().DataPoints().At(0).Attributes().Len())
+
+	dp := excluded.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0)
+	assert.Equal(t, map[string]interface{}{"NoModification": true}, dp.Attributes().AsRaw())
+}
+
+func TestAttributes_FilterMetricsByType(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "attribute1", Action: attraction.INSERT, Value: 123},
+	}
+	oCfg.Exclude = &filterconfig.MatchProperties{
+		MetricTypes: []string{"sum"},
+		Config:      *createConfig(filterset.Strict),
+	}
+	mp, err := factory.CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	md := generateMetricData([]string{"http.requests"}, nil)
+	sum := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().AppendEmpty()
+	sum.SetName("http.requests.total")
+	sum.SetDataType(pdata.MetricDataTypeSum)
+	sum.Sum().DataPoints().AppendEmpty()
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]interface{}{"attribute1": int64(123)}, metrics.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, 0, metrics.At(1).Sum().DataPoints().At(0).Attributes().Len())
+}
+
+func TestMetricAttributes_ApplyToResource(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Actions = []attraction.ActionKeyValue{
+		{Key: "env", Action: attraction.INSERT, Value: "prod"},
+	}
+	cfg.Include = &filterconfig.MatchProperties{
+		MetricNames: []string{"matched"},
+		Config:      *createConfig(filterset.Strict),
+	}
+	cfg.ApplyTo = []string{"resource"}
+	mp, err := factory.CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	md := pdata.NewMetrics()
+	generateMetricData([]string{"matched"}, nil).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	generateMetricData([]string{"other"}, nil).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+
+	matched := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{"env": "prod"}, matched.Resource().Attributes().AsRaw())
+	assert.Equal(t, 0, matched.InstrumentationLibraryMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).Attributes().Len())
+	assert.Equal(t, 0, md.ResourceMetrics().At(1).Resource().Attributes().Len())
+}
@@ -24,19 +24,23 @@ import (
 )
 
 type spanAttributesProcessor struct {
-	attrProc *attraction.AttrProc
-	include  filterspan.Matcher
-	exclude  filterspan.Matcher
+	attrProc        *attraction.AttrProc
+	applyToRecord   bool
+	applyToResource bool
+	include         filterspan.Matcher
+	exclude         filterspan.Matcher
 }
 
 // newTracesProcessor returns a processor that modifies attributes of a span.
 // To construct the attributes processors, the use of the factory methods are required
 // in order to validate the inputs.
-func newSpanAttributesProcessor(attrProc *attraction.AttrProc, include, exclude filterspan.Matcher) *spanAttributesProcessor {
+func newSpanAttributesProcessor(attrProc *attraction.AttrProc, applyToRecord, applyToResource bool, include, exclude filterspan.Matcher) *spanAttributesProcessor {
 	return &spanAttributesProcessor{
-		attrProc: attrProc,
-		include:  include,
-		exclude:  exclude,
+		attrProc:        attrProc,
+		applyToRecord:   applyToRecord,
+		applyToResource: applyToResource,
+		include:         include,
+		exclude:         exclude,
 	}
 }
 
@@ -45,6 +49,7 @@ func (a *spanAttributesProcessor) processTraces(ctx context.Context, td pdata.Tr
 	for i := 0; i < rss.Len(); i++ {
 		rs := rss.At(i)
 		resource := rs.Resource()
+		resourceMatched := false
 		ilss := rs.InstrumentationLibrarySpans()
 		for j := 0; j < ilss.Len(); j++ {
 			ils := ilss.At(j)
@@ -55,10 +60,18 @@ func (a *spanAttributesProcessor) processTraces(ctx context.Context, td pdata.Tr
 				if filterspan.SkipSpan(a.include, a.exclude, span, resource, library) {
 					continue
 				}
+				resourceMatched = true
 
-				a.attrProc.Process(ctx, span.Attributes())
+				if a.applyToRecord {
+					a.attrProc.Process(ctx, span.Attributes())
+				}
 			}
 		}
+		// The resource is processed last so that all its spans are matched
+		// against its original attributes.
+		if a.applyToResource && resourceMatched {
+			a.attrProc.Process(ctx, resource.Attributes())
+		}
 	}
 	return td, nil
 }
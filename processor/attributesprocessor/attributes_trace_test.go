@@ -193,6 +193,70 @@ func TestAttributes_FilterSpans(t *testing.T) {
 	}
 }
 
+func TestAttributes_ApplyTo(t *testing.T) {
+	testCases := []struct {
+		name             string
+		applyTo          []string
+		expectedResource map[string]interface{}
+		expectedSpan     map[string]interface{}
+	}{
+		{
+			name:             "default",
+			expectedResource: map[string]interface{}{"service.name": "svcA", "host.name": "host-1"},
+			expectedSpan:     map[string]interface{}{"env": "prod"},
+		},
+		{
+			name:             "resource",
+			applyTo:          []string{"resource"},
+			expectedResource: map[string]interface{}{"service.name": "svcA", "env": "prod"},
+			expectedSpan:     map[string]interface{}{"host.name": "host-1"},
+		},
+		{
+			name:             "record and resource",
+			applyTo:          []string{"record", "resource"},
+			expectedResource: map[string]interface{}{"service.name": "svcA", "env": "prod"},
+			expectedSpan:     map[string]interface{}{"env": "prod"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig().(*Config)
+			cfg.Actions = []attraction.ActionKeyValue{
+				{Key: "env", Action: attraction.INSERT, Value: "prod"},
+				{Key: "host.name", Action: attraction.DELETE},
+			}
+			cfg.Include = &filterconfig.MatchProperties{
+				Services: []string{"svcA"},
+				Config:   *createConfig(filterset.Strict),
+			}
+			cfg.ApplyTo = tt.applyTo
+			tp, err := factory.CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+
+			td := pdata.NewTraces()
+			for _, service := range []string{"svcA", "svcB"} {
+				rs := td.ResourceSpans().AppendEmpty()
+				rs.Resource().Attributes().InsertString("service.name", service)
+				rs.Resource().Attributes().InsertString("host.name", "host-1")
+				span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+				span.Attributes().InsertString("host.name", "host-1")
+			}
+			require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+			matched := td.ResourceSpans().At(0)
+			assert.Equal(t, tt.expectedResource, matched.Resource().Attributes().AsRaw())
+			assert.Equal(t, tt.expectedSpan, matched.InstrumentationLibrarySpans().At(0).Spans().At(0).Attributes().AsRaw())
+
+			// The resources without matching spans are left unchanged.
+			skipped := td.ResourceSpans().At(1)
+			assert.Equal(t, map[string]interface{}{"service.name": "svcB", "host.name": "host-1"}, skipped.Resource().Attributes().AsRaw())
+			assert.Equal(t, map[string]interface{}{"host.name": "host-1"}, skipped.InstrumentationLibrarySpans().At(0).Spans().At(0).Attributes().AsRaw())
+		})
+	}
+}
+
 func TestAttributes_FilterSpansByNameStrict(t *testing.T) {
 	testCases := []testCase{
 		{
@@ -15,6 +15,9 @@
 package attributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/config"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
@@ -34,14 +37,50 @@ type Config struct {
 	filterconfig.MatchConfig `mapstructure:",squash"`
 
 	// Specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, RENAME_PATTERN, CONVERT, REPLACE, MASK}.
 	// This is a required field.
 	attraction.Settings `mapstructure:",squash"`
+
+	// ApplyTo specifies the attributes the actions are applied to.
+	// The set of values are {record, resource}: "record" for the attributes of
+	// the spans, log records or metric data points, "resource" for the attributes
+	// of the resources having at least one of them matching the include/exclude properties.
+	// Default is [record].
+	ApplyTo []string `mapstructure:"apply_to"`
 }
 
+// The attributes the actions can be applied to.
+const (
+	applyToRecord   = "record"
+	applyToResource = "resource"
+	applyToScope    = "scope"
+)
+
 var _ config.Processor = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
+	for _, target := range cfg.ApplyTo {
+		switch target {
+		case applyToRecord, applyToResource:
+		case applyToScope:
+			return errors.New("\"apply_to\" can't contain \"scope\": instrumentation libraries don't have attributes in this version of the data model")
+		default:
+			return fmt.Errorf("unsupported \"apply_to\" value %q, must be one of record or resource", target)
+		}
+	}
 	return nil
 }
+
+// appliesTo reports whether the actions are applied to the target attributes.
+func (cfg *Config) appliesTo(target string) bool {
+	if len(cfg.ApplyTo) == 0 {
+		return target == applyToRecord
+	}
+	for _, t := range cfg.ApplyTo {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
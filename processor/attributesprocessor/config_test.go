@@ -93,6 +93,85 @@ func TestLoadingConfig(t *testing.T) {
 		},
 	})
 
+	scale := 100.0
+	pConvert := cfg.Processors[config.NewComponentIDWithName(typeStr, "convert")]
+	assert.Equal(t, pConvert, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "convert")),
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "http.status_code", ConvertedType: "int", Action: attraction.CONVERT},
+				{Key: "duration", ConvertedType: "double", FromUnit: "ms", ToUnit: "s", Action: attraction.CONVERT},
+				{Key: "percent", ConvertedType: "double", Scale: &scale, Action: attraction.CONVERT},
+			},
+		},
+	})
+
+	pReplace := cfg.Processors[config.NewComponentIDWithName(typeStr, "replace")]
+	assert.Equal(t, pReplace, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "replace")),
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "http.route", RegexPattern: "/[0-9]+", Replacement: "/{id}", Action: attraction.REPLACE},
+			},
+		},
+	})
+
+	pMask := cfg.Processors[config.NewComponentIDWithName(typeStr, "mask")]
+	assert.Equal(t, pMask, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "mask")),
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "user.phone", Action: attraction.MASK},
+				{Key: "payment.card", MaskStrategy: "credit_card", MaskChar: "#", Action: attraction.MASK},
+				{Key: "user.token", MaskStrategy: "fixed", Replacement: "[REDACTED]", Action: attraction.MASK},
+			},
+		},
+	})
+
+	pMetrics := cfg.Processors[config.NewComponentIDWithName(typeStr, "metrics")]
+	assert.Equal(t, pMetrics, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "metrics")),
+		MatchConfig: filterconfig.MatchConfig{
+			Include: &filterconfig.MatchProperties{
+				Config:      *createConfig(filterset.Regexp),
+				MetricNames: []string{"^http\\..*"},
+				MetricTypes: []string{"sum", "histogram"},
+			},
+		},
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "http.target", Action: attraction.DELETE},
+			},
+		},
+	})
+
+	pSeverity := cfg.Processors[config.NewComponentIDWithName(typeStr, "severity")]
+	assert.Equal(t, pSeverity, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "severity")),
+		MatchConfig: filterconfig.MatchConfig{
+			Include: &filterconfig.MatchProperties{
+				Config:         *createConfig(filterset.Strict),
+				LogSeverityMin: "WARN",
+			},
+		},
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "alert", Value: true, Action: attraction.INSERT},
+			},
+		},
+	})
+
+	pResource := cfg.Processors[config.NewComponentIDWithName(typeStr, "resource")]
+	assert.Equal(t, pResource, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "resource")),
+		Settings: attraction.Settings{
+			Actions: []attraction.ActionKeyValue{
+				{Key: "host.name", Action: attraction.DELETE},
+			},
+		},
+		ApplyTo: []string{"resource"},
+	})
+
 	p5 := cfg.Processors[config.NewComponentIDWithName(typeStr, "excludemulti")]
 	assert.Equal(t, p5, &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "excludemulti")),
@@ -202,3 +281,24 @@ func TestLoadingConfig(t *testing.T) {
 	})
 
 }
+
+func TestValidateApplyTo(t *testing.T) {
+	tests := []struct {
+		applyTo []string
+		err     string
+	}{
+		{applyTo: nil},
+		{applyTo: []string{"record", "resource"}},
+		{applyTo: []string{"scope"}, err: "\"apply_to\" can't contain \"scope\": instrumentation libraries don't have attributes in this version of the data model"},
+		{applyTo: []string{"span"}, err: "unsupported \"apply_to\" value \"span\", must be one of record or resource"},
+	}
+	for _, tt := range tests {
+		cfg := &Config{ApplyTo: tt.applyTo}
+		err := cfg.Validate()
+		if tt.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tt.err)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterlog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterspan"
 )
 
@@ -41,6 +42,7 @@ func NewFactory() component.ProcessorFactory {
 		typeStr,
 		createDefaultConfig,
 		processorhelper.WithTraces(createTracesProcessor),
+		processorhelper.WithMetrics(createMetricsProcessor),
 		processorhelper.WithLogs(createLogProcessor))
 }
 
@@ -77,7 +79,7 @@ func createTracesProcessor(
 	return processorhelper.NewTracesProcessor(
 		cfg,
 		nextConsumer,
-		newSpanAttributesProcessor(attrProc, include, exclude).processTraces,
+		newSpanAttributesProcessor(attrProc, oCfg.appliesTo(applyToRecord), oCfg.appliesTo(applyToResource), include, exclude).processTraces,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
 
@@ -112,6 +114,36 @@ func createLogProcessor(
 	return processorhelper.NewLogsProcessor(
 		cfg,
 		nextConsumer,
-		newLogAttributesProcessor(attrProc, include, exclude).processLogs,
+		newLogAttributesProcessor(attrProc, oCfg.appliesTo(applyToRecord), oCfg.appliesTo(applyToResource), include, exclude).processLogs,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
+
+func createMetricsProcessor(
+	_ context.Context,
+	_ component.ProcessorCreateSettings,
+	cfg config.Processor,
+	nextConsumer consumer.Metrics,
+) (component.MetricsProcessor, error) {
+	oCfg := cfg.(*Config)
+	if len(oCfg.Actions) == 0 {
+		return nil, fmt.Errorf("error creating \"attributes\" processor due to missing required field \"actions\" of processor %v", cfg.ID())
+	}
+	attrProc, err := attraction.NewAttrProc(&oCfg.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("error creating \"attributes\" processor: %w of processor %v", err, cfg.ID())
+	}
+	include, err := filtermetric.NewDataPointMatcher(oCfg.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := filtermetric.NewDataPointMatcher(oCfg.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	return processorhelper.NewMetricsProcessor(
+		cfg,
+		nextConsumer,
+		newMetricAttributesProcessor(attrProc, oCfg.appliesTo(applyToRecord), oCfg.appliesTo(applyToResource), include, exclude).processMetrics,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Metrics are the names of the gauge and sum metrics whose data points are buffered.
+	Metrics []string `mapstructure:"metrics"`
+
+	// WindowSize is the number of recent data points kept per series.
+	WindowSize int `mapstructure:"window_size"`
+
+	// MaxStaleness is the time after which a series that received no data point is forgotten.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
+
+	// RateOfChange emits the "<metric>.rate_of_change" gauge, the per second change between
+	// the last two data points of a series.
+	RateOfChange bool `mapstructure:"rate_of_change"`
+
+	// ZScore configures the z-score of the data points against the window of their series.
+	ZScore ZScoreConfig `mapstructure:"zscore"`
+}
+
+// ZScoreConfig defines how the z-score of the data points is computed.
+type ZScoreConfig struct {
+	// Enabled emits the "<metric>.zscore" gauge, the number of standard deviations between a
+	// data point and the mean of the previous data points of its series.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MinDataPoints is the number of previous data points required before the z-score is emitted.
+	MinDataPoints int `mapstructure:"min_data_points"`
+
+	// Threshold is the absolute z-score from which a data point is an anomaly. When positive, the
+	// "<metric>.anomaly" gauge is emitted with 1 for anomalies and 0 otherwise.
+	Threshold float64 `mapstructure:"threshold"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if len(config.Metrics) == 0 {
+		return fmt.Errorf("metric names are missing")
+	}
+	if config.WindowSize < 2 {
+		return fmt.Errorf("window size must be at least 2")
+	}
+	if config.MaxStaleness <= 0 {
+		return fmt.Errorf("max staleness must be positive")
+	}
+	if !config.RateOfChange && !config.ZScore.Enabled {
+		return fmt.Errorf("at least one of rate_of_change or zscore must be enabled")
+	}
+	if config.ZScore.Enabled {
+		if config.ZScore.MinDataPoints < 2 || config.ZScore.MinDataPoints > config.WindowSize {
+			return fmt.Errorf("zscore min data points must be at least 2 and not above the window size")
+		}
+		if config.ZScore.Threshold < 0 {
+			return fmt.Errorf("zscore threshold can't be negative")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/servicetest"
+)
+
+func TestLoadingFullConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_full.yaml"), factories)
+	assert.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Metrics:           []string{"metric1", "metric2"},
+		WindowSize:        60,
+		MaxStaleness:      10 * time.Minute,
+		RateOfChange:      false,
+		ZScore: ZScoreConfig{
+			Enabled:       true,
+			MinDataPoints: 20,
+			Threshold:     2.5,
+		},
+	}, cfg.Processors[config.NewComponentID(typeStr)])
+}
+
+func TestLoadingMissingNameConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	_, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_missing_name.yaml"), factories)
+	assert.EqualError(t, err, fmt.Sprintf("processor %q has invalid configuration: metric names are missing", typeStr))
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		modify       func(*Config)
+		errorMessage string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Config) {},
+		},
+		{
+			name:         "window too small",
+			modify:       func(cfg *Config) { cfg.WindowSize = 1 },
+			errorMessage: "window size must be at least 2",
+		},
+		{
+			name:         "no max staleness",
+			modify:       func(cfg *Config) { cfg.MaxStaleness = 0 },
+			errorMessage: "max staleness must be positive",
+		},
+		{
+			name: "nothing enabled",
+			modify: func(cfg *Config) {
+				cfg.RateOfChange = false
+				cfg.ZScore.Enabled = false
+			},
+			errorMessage: "at least one of rate_of_change or zscore must be enabled",
+		},
+		{
+			name:         "min data points above window",
+			modify:       func(cfg *Config) { cfg.ZScore.MinDataPoints = cfg.WindowSize + 1 },
+			errorMessage: "zscore min data points must be at least 2 and not above the window size",
+		},
+		{
+			name:         "negative threshold",
+			modify:       func(cfg *Config) { cfg.ZScore.Threshold = -1 },
+			errorMessage: "zscore threshold can't be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Metrics = []string{"metric1"}
+			test.modify(cfg)
+			err := cfg.Validate()
+			if test.errorMessage == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errorMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datapointhistorybufferprocessor implements a processor which keeps
+// a short window of recent data points per series and emits metrics derived
+// from it, such as the rate of change and the z-score of each data point.
+package datapointhistorybufferprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor"
@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor"
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+const (
+	rateOfChangeSuffix = ".rate_of_change"
+	zScoreSuffix       = ".zscore"
+	anomalySuffix      = ".anomaly"
+)
+
+type historyBufferProcessor struct {
+	config            *Config
+	configuredMetrics map[string]bool
+	logger            *zap.Logger
+	// For easier unit testing
+	now func() time.Time
+
+	mu sync.Mutex
+	// series are the windows of the buffered series keyed by seriesKey.
+	series    map[string]*window
+	lastSweep time.Time
+}
+
+func newHistoryBufferProcessor(config *Config, logger *zap.Logger) *historyBufferProcessor {
+	configuredMetrics := make(map[string]bool, len(config.Metrics))
+	for _, name := range config.Metrics {
+		configuredMetrics[name] = true
+	}
+
+	return &historyBufferProcessor{
+		config:            config,
+		configuredMetrics: configuredMetrics,
+		logger:            logger,
+		now:               time.Now,
+		series:            map[string]*window{},
+	}
+}
+
+// Start is invoked during service startup.
+func (p *historyBufferProcessor) Start(context.Context, component.Host) error {
+	return nil
+}
+
+// Shutdown is invoked during service shutdown.
+func (p *historyBufferProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// processMetrics implements the ProcessMetricsFunc type.
+func (p *historyBufferProcessor) processMetrics(_ context.Context, md pdata.Metrics) (pdata.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.evictStale(now)
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resourceKey := attributesKey(rm.Resource().Attributes())
+		ilms := rm.InstrumentationLibraryMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			metrics := ilms.At(j).Metrics()
+			derived := pdata.NewMetricSlice()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				if !p.configuredMetrics[metric.Name()] {
+					continue
+				}
+				var dps pdata.NumberDataPointSlice
+				switch metric.DataType() {
+				case pdata.MetricDataTypeGauge:
+					dps = metric.Gauge().DataPoints()
+				case pdata.MetricDataTypeSum:
+					dps = metric.Sum().DataPoints()
+				default:
+					p.logger.Debug("Configured metric is not a gauge or a sum", zap.String("metric", metric.Name()))
+					continue
+				}
+				p.processDataPoints(metric, dps, resourceKey, derived, now)
+			}
+			derived.MoveAndAppendTo(metrics)
+		}
+	}
+	return md, nil
+}
+
+// processDataPoints adds the data points to the windows of their series and
+// appends the metrics derived from them to dest.
+func (p *historyBufferProcessor) processDataPoints(metric pdata.Metric, dps pdata.NumberDataPointSlice, resourceKey string, dest pdata.MetricSlice, now time.Time) {
+	rateUnit := "1/s"
+	if metric.Unit() != "" {
+		rateUnit = metric.Unit() + "/s"
+	}
+	rates := &derivedGauge{
+		name:        metric.Name() + rateOfChangeSuffix,
+		description: "Per second rate of change of " + metric.Name() + ".",
+		unit:        rateUnit,
+	}
+	zScores := &derivedGauge{
+		name:        metric.Name() + zScoreSuffix,
+		description: "Z-score of " + metric.Name() + " against its recent data points.",
+		unit:        "1",
+	}
+	anomalies := &derivedGauge{
+		name:        metric.Name() + anomalySuffix,
+		description: "Whether " + metric.Name() + " is an anomaly (1) or not (0) according to its z-score.",
+		unit:        "1",
+	}
+
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		var value float64
+		switch dp.ValueType() {
+		case pdata.MetricValueTypeDouble:
+			value = dp.DoubleVal()
+		case pdata.MetricValueTypeInt:
+			value = float64(dp.IntVal())
+		default:
+			continue
+		}
+		if math.IsNaN(value) {
+			continue
+		}
+
+		key := seriesKey(metric.Name(), resourceKey, dp.Attributes())
+		w, ok := p.series[key]
+		if !ok {
+			w = newWindow(p.config.WindowSize)
+			p.series[key] = w
+		}
+		w.lastSeen = now
+
+		last, ok := w.last()
+		if ok && dp.Timestamp() <= last.timestamp {
+			// Out of order or duplicated data point.
+			continue
+		}
+
+		if ok && p.config.RateOfChange {
+			seconds := time.Duration(dp.Timestamp() - last.timestamp).Seconds()
+			rates.appendDataPoint(dest, dp, (value-last.value)/seconds)
+		}
+
+		if p.config.ZScore.Enabled && w.count >= p.config.ZScore.MinDataPoints {
+			if mean, stdDev := w.meanStdDev(); stdDev > 0 {
+				zScore := (value - mean) / stdDev
+				zScores.appendDataPoint(dest, dp, zScore)
+				if p.config.ZScore.Threshold > 0 {
+					var anomaly float64
+					if math.Abs(zScore) >= p.config.ZScore.Threshold {
+						anomaly = 1
+					}
+					anomalies.appendDataPoint(dest, dp, anomaly)
+				}
+			}
+		}
+
+		w.add(dataPoint{timestamp: dp.Timestamp(), value: value})
+	}
+}
+
+// evictStale forgets the series that received no data point for max_staleness.
+// The series are swept at most once per max_staleness.
+func (p *historyBufferProcessor) evictStale(now time.Time) {
+	if now.Sub(p.lastSweep) < p.config.MaxStaleness {
+		return
+	}
+	p.lastSweep = now
+	for key, w := range p.series {
+		if now.Sub(w.lastSeen) >= p.config.MaxStaleness {
+			delete(p.series, key)
+		}
+	}
+}
+
+// derivedGauge is a gauge derived from a buffered metric. It is only added to
+// the metrics once it has a data point.
+type derivedGauge struct {
+	name        string
+	description string
+	unit        string
+	dps         *pdata.NumberDataPointSlice
+}
+
+func (g *derivedGauge) appendDataPoint(dest pdata.MetricSlice, from pdata.NumberDataPoint, value float64) {
+	if g.dps == nil {
+		metric := dest.AppendEmpty()
+		metric.SetName(g.name)
+		metric.SetDescription(g.description)
+		metric.SetUnit(g.unit)
+		metric.SetDataType(pdata.MetricDataTypeGauge)
+		dps := metric.Gauge().DataPoints()
+		g.dps = &dps
+	}
+	dp := g.dps.AppendEmpty()
+	from.Attributes().CopyTo(dp.Attributes())
+	dp.SetTimestamp(from.Timestamp())
+	dp.SetDoubleVal(value)
+}
+
+// seriesKey identifies the series of a data point.
+func seriesKey(metricName string, resourceKey string, attrs pdata.AttributeMap) string {
+	return metricName + "\x00" + resourceKey + "\x00" + attributesKey(attrs)
+}
+
+// attributesKey returns a representation of the attributes that does not
+// depend on the attributes order.
+func attributesKey(attrs pdata.AttributeMap) string {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pdata.AttributeValue) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		b.WriteString(k)
+		b.WriteByte(0)
+		b.WriteString(v.AsString())
+		b.WriteByte(0)
+	}
+	return b.String()
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics = []string{"cpu.usage"}
+	cfg.WindowSize = 4
+	cfg.ZScore.MinDataPoints = 3
+	cfg.ZScore.Threshold = 2
+	return cfg
+}
+
+// gaugeMetrics returns a metrics holding one data point per series of the cpu.usage and memory.usage gauges.
+func gaugeMetrics(ts time.Duration, values map[string]float64) pdata.Metrics {
+	md := pdata.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("host.name", "host-1")
+	metrics := rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"cpu.usage", "memory.usage"} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		metric.SetUnit("By")
+		metric.SetDataType(pdata.MetricDataTypeGauge)
+		for cpu, value := range values {
+			dp := metric.Gauge().DataPoints().AppendEmpty()
+			dp.Attributes().InsertString("cpu", cpu)
+			dp.SetTimestamp(pdata.Timestamp(ts.Nanoseconds()))
+			dp.SetDoubleVal(value)
+		}
+	}
+	return md
+}
+
+// derivedValues returns the values of the data points of the metric, keyed by their cpu attribute.
+func derivedValues(md pdata.Metrics, name string) map[string]float64 {
+	var values map[string]float64
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+		values = map[string]float64{}
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			cpu, _ := dps.At(j).Attributes().Get("cpu")
+			values[cpu.StringVal()] = dps.At(j).DoubleVal()
+		}
+	}
+	return values
+}
+
+func TestProcessMetricsRateOfChange(t *testing.T) {
+	p := newHistoryBufferProcessor(testConfig(), zap.NewNop())
+
+	md, err := p.processMetrics(context.Background(), gaugeMetrics(10*time.Second, map[string]float64{"0": 10, "1": 100}))
+	require.NoError(t, err)
+	// The first data point of a series has no rate.
+	assert.Nil(t, derivedValues(md, "cpu.usage.rate_of_change"))
+
+	md, err = p.processMetrics(context.Background(), gaugeMetrics(20*time.Second, map[string]float64{"0": 30, "1": 50}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"0": 2, "1": -5}, derivedValues(md, "cpu.usage.rate_of_change"))
+	assert.Nil(t, derivedValues(md, "memory.usage.rate_of_change"))
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+	assert.Equal(t, "By/s", metrics.At(2).Unit())
+	assert.Equal(t, pdata.MetricDataTypeGauge, metrics.At(2).DataType())
+
+	// Out of order data points are ignored.
+	md, err = p.processMetrics(context.Background(), gaugeMetrics(15*time.Second, map[string]float64{"0": 0}))
+	require.NoError(t, err)
+	assert.Nil(t, derivedValues(md, "cpu.usage.rate_of_change"))
+}
+
+func TestProcessMetricsZScore(t *testing.T) {
+	p := newHistoryBufferProcessor(testConfig(), zap.NewNop())
+
+	for i, v := range []float64{10, 12, 14} {
+		md, err := p.processMetrics(context.Background(), gaugeMetrics(time.Duration(i+1)*time.Second, map[string]float64{"0": v}))
+		require.NoError(t, err)
+		assert.Nil(t, derivedValues(md, "cpu.usage.zscore"))
+	}
+
+	// mean 12, standard deviation sqrt(8/3)
+	md, err := p.processMetrics(context.Background(), gaugeMetrics(4*time.Second, map[string]float64{"0": 13}))
+	require.NoError(t, err)
+	assert.InDelta(t, 0.6124, derivedValues(md, "cpu.usage.zscore")["0"], 1e-4)
+	assert.Equal(t, map[string]float64{"0": 0}, derivedValues(md, "cpu.usage.anomaly"))
+
+	md, err = p.processMetrics(context.Background(), gaugeMetrics(5*time.Second, map[string]float64{"0": 40}))
+	require.NoError(t, err)
+	assert.Greater(t, derivedValues(md, "cpu.usage.zscore")["0"], 2.0)
+	assert.Equal(t, map[string]float64{"0": 1}, derivedValues(md, "cpu.usage.anomaly"))
+}
+
+func TestProcessMetricsEvictsStaleSeries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	p := newHistoryBufferProcessor(testConfig(), zap.NewNop())
+	p.now = func() time.Time { return now }
+
+	_, err := p.processMetrics(context.Background(), gaugeMetrics(time.Second, map[string]float64{"0": 1, "1": 1}))
+	require.NoError(t, err)
+	assert.Len(t, p.series, 2)
+
+	now = now.Add(p.config.MaxStaleness / 2)
+	_, err = p.processMetrics(context.Background(), gaugeMetrics(2*time.Second, map[string]float64{"0": 2}))
+	require.NoError(t, err)
+	assert.Len(t, p.series, 2)
+
+	now = now.Add(p.config.MaxStaleness / 2)
+	md, err := p.processMetrics(context.Background(), gaugeMetrics(3*time.Second, map[string]float64{"0": 3, "1": 3}))
+	require.NoError(t, err)
+	// The series of cpu 1 was forgotten, so it has no rate anymore.
+	assert.Equal(t, map[string]float64{"0": 1}, derivedValues(md, "cpu.usage.rate_of_change"))
+}
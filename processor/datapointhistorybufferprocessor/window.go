@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/datapointhistorybufferprocessor"
+
+import (
+	"math"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+type dataPoint struct {
+	timestamp pdata.Timestamp
+	value     float64
+}
+
+// window is a fixed size ring buffer holding the most recent data points of a series.
+type window struct {
+	points []dataPoint
+	// next is the index the next data point is written at.
+	next  int
+	count int
+	// lastSeen is when the series last received a data point.
+	lastSeen time.Time
+}
+
+func newWindow(size int) *window {
+	return &window{points: make([]dataPoint, size)}
+}
+
+// add appends a data point to the window, overwriting the oldest one when the window is full.
+func (w *window) add(dp dataPoint) {
+	w.points[w.next] = dp
+	w.next = (w.next + 1) % len(w.points)
+	if w.count < len(w.points) {
+		w.count++
+	}
+}
+
+// last returns the most recent data point of the window.
+func (w *window) last() (dataPoint, bool) {
+	if w.count == 0 {
+		return dataPoint{}, false
+	}
+	return w.points[(w.next-1+len(w.points))%len(w.points)], true
+}
+
+// meanStdDev returns the mean and the population standard deviation of the values of the window.
+func (w *window) meanStdDev() (mean float64, stdDev float64) {
+	if w.count == 0 {
+		return 0, 0
+	}
+	for i := 0; i < w.count; i++ {
+		mean += w.points[i].value
+	}
+	mean /= float64(w.count)
+	var variance float64
+	for i := 0; i < w.count; i++ {
+		d := w.points[i].value - mean
+		variance += d * d
+	}
+	return mean, math.Sqrt(variance / float64(w.count))
+}
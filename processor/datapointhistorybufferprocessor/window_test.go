@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapointhistorybufferprocessor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow(t *testing.T) {
+	w := newWindow(3)
+	_, ok := w.last()
+	assert.False(t, ok)
+
+	for i, v := range []float64{1, 2, 3, 4} {
+		w.add(dataPoint{timestamp: 1, value: v})
+		last, ok := w.last()
+		assert.True(t, ok)
+		assert.Equal(t, v, last.value)
+		assert.Equal(t, int(math.Min(float64(i+1), 3)), w.count)
+	}
+
+	// The oldest data point was overwritten, the window holds 2, 3 and 4.
+	mean, stdDev := w.meanStdDev()
+	assert.Equal(t, 3.0, mean)
+	assert.InDelta(t, math.Sqrt(2.0/3.0), stdDev, 1e-9)
+}
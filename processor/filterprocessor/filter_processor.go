@@ -54,22 +54,30 @@ func newFilterMetricProcessor(logger *zap.Logger, cfg *Config) (*filterMetricPro
 	var includeExpressions []string
 	var includeMetricNames []string
 	var includeResourceAttributes []filterconfig.Attribute
+	var includeMetricTypes []string
+	var includeAggregationTemporalities []string
 	if cfg.Metrics.Include != nil {
 		includeMatchType = string(cfg.Metrics.Include.MatchType)
 		includeExpressions = cfg.Metrics.Include.Expressions
 		includeMetricNames = cfg.Metrics.Include.MetricNames
 		includeResourceAttributes = cfg.Metrics.Include.ResourceAttributes
+		includeMetricTypes = cfg.Metrics.Include.MetricTypes
+		includeAggregationTemporalities = cfg.Metrics.Include.AggregationTemporalities
 	}
 
 	excludeMatchType := ""
 	var excludeExpressions []string
 	var excludeMetricNames []string
 	var excludeResourceAttributes []filterconfig.Attribute
+	var excludeMetricTypes []string
+	var excludeAggregationTemporalities []string
 	if cfg.Metrics.Exclude != nil {
 		excludeMatchType = string(cfg.Metrics.Exclude.MatchType)
 		excludeExpressions = cfg.Metrics.Exclude.Expressions
 		excludeMetricNames = cfg.Metrics.Exclude.MetricNames
 		excludeResourceAttributes = cfg.Metrics.Exclude.ResourceAttributes
+		excludeMetricTypes = cfg.Metrics.Exclude.MetricTypes
+		excludeAggregationTemporalities = cfg.Metrics.Exclude.AggregationTemporalities
 	}
 
 	checksMetrics := cfg.Metrics.Exclude.ChecksMetrics() || cfg.Metrics.Include.ChecksMetrics()
@@ -81,10 +89,14 @@ func newFilterMetricProcessor(logger *zap.Logger, cfg *Config) (*filterMetricPro
 		zap.Strings("include expressions", includeExpressions),
 		zap.Strings("include metric names", includeMetricNames),
 		zap.Any("include metrics with resource attributes", includeResourceAttributes),
+		zap.Strings("include metric types", includeMetricTypes),
+		zap.Strings("include aggregation temporalities", includeAggregationTemporalities),
 		zap.String("exclude match_type", excludeMatchType),
 		zap.Strings("exclude expressions", excludeExpressions),
 		zap.Strings("exclude metric names", excludeMetricNames),
 		zap.Any("exclude metrics with resource attributes", excludeResourceAttributes),
+		zap.Strings("exclude metric types", excludeMetricTypes),
+		zap.Strings("exclude aggregation temporalities", excludeAggregationTemporalities),
 		zap.Bool("checksMetrics", checksMetrics),
 		zap.Bool("checkResouces", checksResouces),
 	)
@@ -378,6 +378,111 @@ func TestFilterMetricProcessor(t *testing.T) {
 	}
 }
 
+func TestFilterMetricProcessorMetricTypes(t *testing.T) {
+	md := pdata.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetDataType(pdata.MetricDataTypeGauge)
+	deltaSum := ms.AppendEmpty()
+	deltaSum.SetName("delta_sum")
+	deltaSum.SetDataType(pdata.MetricDataTypeSum)
+	deltaSum.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityDelta)
+	cumulativeSum := ms.AppendEmpty()
+	cumulativeSum.SetName("cumulative_sum")
+	cumulativeSum.SetDataType(pdata.MetricDataTypeSum)
+	cumulativeSum.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	summary := ms.AppendEmpty()
+	summary.SetName("summary")
+	summary.SetDataType(pdata.MetricDataTypeSummary)
+	expHistogram := ms.AppendEmpty()
+	expHistogram.SetName("exponential_histogram")
+	expHistogram.SetDataType(pdata.MetricDataTypeExponentialHistogram)
+	expHistogram.ExponentialHistogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+
+	tests := []struct {
+		name  string
+		inc   *filtermetric.MatchProperties
+		exc   *filtermetric.MatchProperties
+		outMN []string
+	}{
+		{
+			name: "excludeMetricTypes",
+			exc: &filtermetric.MatchProperties{
+				MatchType:   filtermetric.Strict,
+				MetricTypes: []string{"summary", "exponential_histogram"},
+			},
+			outMN: []string{"gauge", "delta_sum", "cumulative_sum"},
+		},
+		{
+			name: "excludeDeltaTemporality",
+			exc: &filtermetric.MatchProperties{
+				MatchType:                filtermetric.Strict,
+				AggregationTemporalities: []string{"delta"},
+			},
+			outMN: []string{"gauge", "cumulative_sum", "summary", "exponential_histogram"},
+		},
+		{
+			name: "includeCumulativeSums",
+			inc: &filtermetric.MatchProperties{
+				MatchType:                filtermetric.Strict,
+				MetricTypes:              []string{"sum"},
+				AggregationTemporalities: []string{"cumulative"},
+			},
+			outMN: []string{"cumulative_sum"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Metrics: MetricFilters{
+					Include: test.inc,
+					Exclude: test.exc,
+				},
+			}
+			fmp, err := NewFactory().CreateMetricsProcessor(
+				context.Background(),
+				componenttest.NewNopProcessorCreateSettings(),
+				cfg,
+				next,
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, fmp.ConsumeMetrics(context.Background(), md.Clone()))
+
+			got := next.AllMetrics()
+			require.Equal(t, 1, len(got))
+			gotMetrics := got[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			require.Equal(t, len(test.outMN), gotMetrics.Len())
+			for i, name := range test.outMN {
+				assert.Equal(t, name, gotMetrics.At(i).Name())
+			}
+		})
+	}
+}
+
+func TestFilterMetricProcessorInvalidMetricType(t *testing.T) {
+	cfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Metrics: MetricFilters{
+			Exclude: &filtermetric.MatchProperties{
+				MatchType:   filtermetric.Strict,
+				MetricTypes: []string{"int_gauge"},
+			},
+		},
+	}
+	_, err := NewFactory().CreateMetricsProcessor(
+		context.Background(),
+		componenttest.NewNopProcessorCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	assert.Error(t, err)
+}
+
 func testResourceMetrics(mwrs []metricWithResource) pdata.Metrics {
 	md := pdata.NewMetrics()
 	now := time.Now()
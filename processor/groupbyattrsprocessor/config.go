@@ -25,4 +25,27 @@ type Config struct {
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Must include at least one attribute name.
 	GroupByKeys []string `mapstructure:"keys"`
+
+	// Ungroup inverts the processor behavior: the resource attributes listed in GroupByKeys
+	// are copied to each span, log record or metric data point of the resource, and removed
+	// from the resource. The resources that become identical are merged.
+	Ungroup bool `mapstructure:"ungroup"`
+
+	// MaxGroupsPerBatch limits the number of resource groups produced for each processed batch,
+	// so that a high cardinality grouping key can't create an unbounded number of resources.
+	// The records which would create a group beyond the limit keep their grouping attributes
+	// and are moved to a single overflow group instead, which counts toward the limit.
+	// Zero (default) means no limit.
+	MaxGroupsPerBatch int `mapstructure:"max_groups_per_batch"`
+
+	// PreserveInstrumentationLibrary keeps the records of each instrumentation library (name and
+	// version) separated within the produced resources. When disabled, all the records of a
+	// produced resource are collapsed into a single instrumentation library without name nor version.
+	// Enabled by default.
+	PreserveInstrumentationLibrary bool `mapstructure:"preserve_instrumentation_library"`
+
+	// RetainRecordAttributes keeps the grouping attributes on the spans, log records and metric
+	// data points in addition to setting them on the produced resource, instead of moving them.
+	// Ignored in ungroup mode.
+	RetainRecordAttributes bool `mapstructure:"retain_record_attributes"`
 }
@@ -46,5 +46,17 @@ func TestLoadConfig(t *testing.T) {
 		&Config{
 			ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "custom")),
 			GroupByKeys:       []string{"key1", "key2"},
+			MaxGroupsPerBatch: 100,
+
+			PreserveInstrumentationLibrary: true,
+			RetainRecordAttributes:         true,
+		})
+
+	conf = cfg.Processors[config.NewComponentIDWithName(typeStr, "ungroup")]
+	assert.Equal(t, conf,
+		&Config{
+			ProcessorSettings: config.NewProcessorSettings(config.NewComponentIDWithName(typeStr, "ungroup")),
+			GroupByKeys:       []string{"key1"},
+			Ungroup:           true,
 		})
 }
@@ -34,6 +34,7 @@ const (
 
 var (
 	errAtLeastOneAttributeNeeded = fmt.Errorf("option 'groupByKeys' must include at least one non-empty attribute name")
+	errNegativeMaxGroups         = fmt.Errorf("option 'max_groups_per_batch' must not be negative")
 	consumerCapabilities         = consumer.Capabilities{MutatesData: true}
 )
 
@@ -57,12 +58,13 @@ func NewFactory() component.ProcessorFactory {
 // createDefaultConfig creates the default configuration for the processor.
 func createDefaultConfig() config.Processor {
 	return &Config{
-		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
-		GroupByKeys:       []string{},
+		ProcessorSettings:              config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		GroupByKeys:                    []string{},
+		PreserveInstrumentationLibrary: true,
 	}
 }
 
-func createGroupByAttrsProcessor(logger *zap.Logger, attributes []string) (*groupByAttrsProcessor, error) {
+func createGroupByAttrsProcessor(logger *zap.Logger, attributes []string, ungroup bool, maxGroupsPerBatch int, preserveInstrumentationLibrary bool, retainRecordAttributes bool) (*groupByAttrsProcessor, error) {
 	var nonEmptyAttributes []string
 	presentAttributes := make(map[string]struct{})
 
@@ -82,7 +84,18 @@ func createGroupByAttrsProcessor(logger *zap.Logger, attributes []string) (*grou
 		return nil, errAtLeastOneAttributeNeeded
 	}
 
-	return &groupByAttrsProcessor{logger: logger, groupByKeys: nonEmptyAttributes}, nil
+	if maxGroupsPerBatch < 0 {
+		return nil, errNegativeMaxGroups
+	}
+
+	return &groupByAttrsProcessor{
+		logger:                         logger,
+		groupByKeys:                    nonEmptyAttributes,
+		ungroup:                        ungroup,
+		maxGroupsPerBatch:              maxGroupsPerBatch,
+		preserveInstrumentationLibrary: preserveInstrumentationLibrary,
+		retainRecordAttributes:         retainRecordAttributes,
+	}, nil
 }
 
 // createTracesProcessor creates a trace processor based on this config.
@@ -93,7 +106,7 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces) (component.TracesProcessor, error) {
 
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys)
+	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys, oCfg.Ungroup, oCfg.MaxGroupsPerBatch, oCfg.PreserveInstrumentationLibrary, oCfg.RetainRecordAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +126,7 @@ func createLogsProcessor(
 	nextConsumer consumer.Logs) (component.LogsProcessor, error) {
 
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys)
+	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys, oCfg.Ungroup, oCfg.MaxGroupsPerBatch, oCfg.PreserveInstrumentationLibrary, oCfg.RetainRecordAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +146,7 @@ func createMetricsProcessor(
 	nextConsumer consumer.Metrics) (component.MetricsProcessor, error) {
 
 	oCfg := cfg.(*Config)
-	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys)
+	gap, err := createGroupByAttrsProcessor(params.Logger, oCfg.GroupByKeys, oCfg.Ungroup, oCfg.MaxGroupsPerBatch, oCfg.PreserveInstrumentationLibrary, oCfg.RetainRecordAttributes)
 	if err != nil {
 		return nil, err
 	}
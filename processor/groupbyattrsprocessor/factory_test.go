@@ -53,13 +53,19 @@ func TestCreateTestProcessor(t *testing.T) {
 }
 
 func TestNoKeys(t *testing.T) {
-	gbap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{})
+	gbap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{}, false, 0, true, false)
 	assert.Error(t, err)
 	assert.Nil(t, gbap)
 }
 
+func TestNegativeMaxGroupsPerBatch(t *testing.T) {
+	gbap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"foo"}, false, -1, true, false)
+	assert.ErrorIs(t, err, errNegativeMaxGroups)
+	assert.Nil(t, gbap)
+}
+
 func TestDuplicateKeys(t *testing.T) {
-	gbap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"foo", "foo", ""})
+	gbap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"foo", "foo", ""}, false, 0, true, false)
 	assert.NoError(t, err)
 	assert.NotNil(t, gbap)
 	assert.EqualValues(t, []string{"foo"}, gbap.groupByKeys)
@@ -24,14 +24,20 @@ var (
 	mNumGroupedSpans    = stats.Int64("num_grouped_spans", "Number of spans that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedSpans = stats.Int64("num_non_grouped_spans", "Number of spans that did not have attributes grouped", stats.UnitDimensionless)
 	mDistSpanGroups     = stats.Int64("span_groups", "Distribution of groups extracted for spans", stats.UnitDimensionless)
+	mNumUngroupedSpans  = stats.Int64("num_ungrouped_spans", "Number of spans that had resource attributes ungrouped", stats.UnitDimensionless)
+	mNumOverflowSpans   = stats.Int64("num_overflow_spans", "Number of spans moved to the overflow group because of the max groups per batch limit", stats.UnitDimensionless)
 
 	mNumGroupedLogs    = stats.Int64("num_grouped_logs", "Number of logs that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedLogs = stats.Int64("num_non_grouped_logs", "Number of logs that did not have attributes grouped", stats.UnitDimensionless)
 	mDistLogGroups     = stats.Int64("log_groups", "Distribution of groups extracted for logs", stats.UnitDimensionless)
+	mNumUngroupedLogs  = stats.Int64("num_ungrouped_logs", "Number of logs that had resource attributes ungrouped", stats.UnitDimensionless)
+	mNumOverflowLogs   = stats.Int64("num_overflow_logs", "Number of logs moved to the overflow group because of the max groups per batch limit", stats.UnitDimensionless)
 
 	mNumGroupedMetrics    = stats.Int64("num_grouped_metrics", "Number of metrics that had attributes grouped", stats.UnitDimensionless)
 	mNumNonGroupedMetrics = stats.Int64("num_non_grouped_metrics", "Number of metrics that did not have attributes grouped", stats.UnitDimensionless)
 	mDistMetricGroups     = stats.Int64("metric_groups", "Distribution of groups extracted for metrics", stats.UnitDimensionless)
+	mNumUngroupedMetrics  = stats.Int64("num_ungrouped_metrics", "Number of metric data points that had resource attributes ungrouped", stats.UnitDimensionless)
+	mNumOverflowMetrics   = stats.Int64("num_overflow_metrics", "Number of metric data points moved to the overflow group because of the max groups per batch limit", stats.UnitDimensionless)
 )
 
 // MetricViews return the metrics views according to given telemetry level.
@@ -57,6 +63,18 @@ func MetricViews() []*view.View {
 			Description: mDistSpanGroups.Description(),
 			Aggregation: distributionGroups,
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumUngroupedSpans.Name()),
+			Measure:     mNumUngroupedSpans,
+			Description: mNumUngroupedSpans.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumOverflowSpans.Name()),
+			Measure:     mNumOverflowSpans,
+			Description: mNumOverflowSpans.Description(),
+			Aggregation: view.Sum(),
+		},
 
 		{
 			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumGroupedLogs.Name()),
@@ -76,6 +94,18 @@ func MetricViews() []*view.View {
 			Description: mDistLogGroups.Description(),
 			Aggregation: distributionGroups,
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumUngroupedLogs.Name()),
+			Measure:     mNumUngroupedLogs,
+			Description: mNumUngroupedLogs.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumOverflowLogs.Name()),
+			Measure:     mNumOverflowLogs,
+			Description: mNumOverflowLogs.Description(),
+			Aggregation: view.Sum(),
+		},
 
 		{
 			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumGroupedMetrics.Name()),
@@ -95,5 +125,17 @@ func MetricViews() []*view.View {
 			Description: mDistMetricGroups.Description(),
 			Aggregation: distributionGroups,
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumUngroupedMetrics.Name()),
+			Measure:     mNumUngroupedMetrics,
+			Description: mNumUngroupedMetrics.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mNumOverflowMetrics.Name()),
+			Measure:     mNumOverflowMetrics,
+			Description: mNumOverflowMetrics.Description(),
+			Aggregation: view.Sum(),
+		},
 	}
 }
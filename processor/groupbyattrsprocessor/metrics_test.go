@@ -25,12 +25,22 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor/groupbyattrs/num_grouped_spans",
 		"processor/groupbyattrs/num_non_grouped_spans",
 		"processor/groupbyattrs/span_groups",
+		"processor/groupbyattrs/num_ungrouped_spans",
+		"processor/groupbyattrs/num_overflow_spans",
 		"processor/groupbyattrs/num_grouped_logs",
 		"processor/groupbyattrs/num_non_grouped_logs",
 		"processor/groupbyattrs/log_groups",
+		"processor/groupbyattrs/num_ungrouped_logs",
+		"processor/groupbyattrs/num_overflow_logs",
+		"processor/groupbyattrs/num_grouped_metrics",
+		"processor/groupbyattrs/num_non_grouped_metrics",
+		"processor/groupbyattrs/metric_groups",
+		"processor/groupbyattrs/num_ungrouped_metrics",
+		"processor/groupbyattrs/num_overflow_metrics",
 	}
 
 	views := MetricViews()
+	assert.Len(t, views, len(expectedViewNames))
 	for i, viewName := range expectedViewNames {
 		assert.Equal(t, viewName, views[i].Name)
 	}
@@ -25,10 +25,26 @@ import (
 type groupByAttrsProcessor struct {
 	logger      *zap.Logger
 	groupByKeys []string
+	// ungroup moves the groupByKeys resource attributes to the records instead.
+	ungroup bool
+	// maxGroupsPerBatch limits the number of groups created per batch, zero means no limit.
+	maxGroupsPerBatch int
+	// preserveInstrumentationLibrary keeps the records of distinct instrumentation libraries separated.
+	preserveInstrumentationLibrary bool
+	// retainRecordAttributes copies the grouping attributes to the resource instead of moving them.
+	retainRecordAttributes bool
 }
 
+// overflowGroupValue is the value set to all the grouping keys of the group receiving
+// the records beyond the max_groups_per_batch limit.
+const overflowGroupValue = "__overflow__"
+
 // ProcessTraces process traces and groups traces by attribute.
 func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td pdata.Traces) (pdata.Traces, error) {
+	if gap.ungroup {
+		return gap.ungroupTraces(ctx, td), nil
+	}
+
 	rss := td.ResourceSpans()
 	groupedResourceSpans := newSpansGroupedByAttrs()
 
@@ -41,20 +57,36 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td pdata.Tr
 			for k := 0; k < ils.Spans().Len(); k++ {
 				span := ils.Spans().At(k)
 
+				originResource := rs.Resource()
 				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(span.Attributes())
-				if toBeGrouped {
+				overflow := false
+				if toBeGrouped && gap.maxGroupsReached(groupedResourceSpans.Len()) {
+					_, found := groupedResourceSpans.findResource(buildReferenceAttributes(rs.Resource(), requiredAttributes))
+					overflow = !found
+				}
+
+				switch {
+				case overflow:
+					stats.Record(ctx, mNumOverflowSpans.M(1))
+					// No more groups can be created: the span keeps its attributes
+					// and is moved to the overflow group, shared by all the origin resources
+					originResource = pdata.NewResource()
+					requiredAttributes = gap.overflowAttributes()
+				case toBeGrouped:
 					stats.Record(ctx, mNumGroupedSpans.M(1))
 					// Some attributes are going to be moved from span to resource level,
-					// so we can delete those on the record level
-					deleteAttributes(requiredAttributes, span.Attributes())
-				} else {
+					// so we can delete those on the record level, unless they are retained
+					if !gap.retainRecordAttributes {
+						deleteAttributes(requiredAttributes, span.Attributes())
+					}
+				default:
 					stats.Record(ctx, mNumNonGroupedSpans.M(1))
 				}
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedSpans := groupedResourceSpans.findOrCreateResource(rs.Resource(), requiredAttributes)
-				sp := matchingInstrumentationLibrarySpans(groupedSpans, ils.InstrumentationLibrary()).Spans().AppendEmpty()
+				groupedSpans := groupedResourceSpans.findOrCreateResource(originResource, requiredAttributes)
+				sp := matchingInstrumentationLibrarySpans(groupedSpans, gap.instrumentationLibrary(ils.InstrumentationLibrary())).Spans().AppendEmpty()
 				span.CopyTo(sp)
 			}
 		}
@@ -69,6 +101,10 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td pdata.Tr
 }
 
 func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld pdata.Logs) (pdata.Logs, error) {
+	if gap.ungroup {
+		return gap.ungroupLogs(ctx, ld), nil
+	}
+
 	rl := ld.ResourceLogs()
 	groupedResourceLogs := newLogsGroupedByAttrs()
 
@@ -81,20 +117,36 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld pdata.Logs
 			for k := 0; k < ill.LogRecords().Len(); k++ {
 				log := ill.LogRecords().At(k)
 
+				originResource := ls.Resource()
 				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(log.Attributes())
-				if toBeGrouped {
+				overflow := false
+				if toBeGrouped && gap.maxGroupsReached(groupedResourceLogs.Len()) {
+					_, found := groupedResourceLogs.findResource(buildReferenceAttributes(ls.Resource(), requiredAttributes))
+					overflow = !found
+				}
+
+				switch {
+				case overflow:
+					stats.Record(ctx, mNumOverflowLogs.M(1))
+					// No more groups can be created: the log record keeps its attributes
+					// and is moved to the overflow group, shared by all the origin resources
+					originResource = pdata.NewResource()
+					requiredAttributes = gap.overflowAttributes()
+				case toBeGrouped:
 					stats.Record(ctx, mNumGroupedLogs.M(1))
 					// Some attributes are going to be moved from log record to resource level,
-					// so we can delete those on the record level
-					deleteAttributes(requiredAttributes, log.Attributes())
-				} else {
+					// so we can delete those on the record level, unless they are retained
+					if !gap.retainRecordAttributes {
+						deleteAttributes(requiredAttributes, log.Attributes())
+					}
+				default:
 					stats.Record(ctx, mNumNonGroupedLogs.M(1))
 				}
 
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
-				groupedLogs := groupedResourceLogs.findResourceOrElseCreate(ls.Resource(), requiredAttributes)
-				lr := matchingInstrumentationLibraryLogs(groupedLogs, ill.InstrumentationLibrary()).LogRecords().AppendEmpty()
+				groupedLogs := groupedResourceLogs.findResourceOrElseCreate(originResource, requiredAttributes)
+				lr := matchingInstrumentationLibraryLogs(groupedLogs, gap.instrumentationLibrary(ill.InstrumentationLibrary())).LogRecords().AppendEmpty()
 				log.CopyTo(lr)
 			}
 		}
@@ -110,6 +162,10 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld pdata.Logs
 }
 
 func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pdata.Metrics) (pdata.Metrics, error) {
+	if gap.ungroup {
+		return gap.ungroupMetrics(ctx, md), nil
+	}
+
 	rms := md.ResourceMetrics()
 	groupedResourceMetrics := newMetricsGroupedByAttrs()
 
@@ -200,6 +256,31 @@ func (gap *groupByAttrsProcessor) extractGroupingAttributes(attrMap pdata.Attrib
 	return foundMatch, groupingAttributes
 }
 
+// maxGroupsReached checks whether the configured limit of groups per batch is reached.
+// The overflow group counts toward the limit, so the last group is kept for it
+func (gap *groupByAttrsProcessor) maxGroupsReached(groups int) bool {
+	return gap.maxGroupsPerBatch > 0 && groups >= gap.maxGroupsPerBatch-1
+}
+
+// overflowAttributes returns the attributes of the overflow group, which receives the
+// records beyond the max_groups_per_batch limit whatever their origin resource
+func (gap *groupByAttrsProcessor) overflowAttributes() pdata.AttributeMap {
+	overflowAttributes := pdata.NewAttributeMap()
+	for _, attrKey := range gap.groupByKeys {
+		overflowAttributes.InsertString(attrKey, overflowGroupValue)
+	}
+	return overflowAttributes
+}
+
+// instrumentationLibrary returns the InstrumentationLibrary the records of the specified one are
+// grouped into: the same one when preserving the instrumentation libraries, an empty one otherwise
+func (gap *groupByAttrsProcessor) instrumentationLibrary(library pdata.InstrumentationLibrary) pdata.InstrumentationLibrary {
+	if gap.preserveInstrumentationLibrary {
+		return library
+	}
+	return pdata.NewInstrumentationLibrary()
+}
+
 // Searches for metric with same name in the specified InstrumentationLibrary and returns it. If nothing is found, create it.
 func getMetricInInstrumentationLibrary(ilm pdata.InstrumentationLibraryMetrics, searchedMetric pdata.Metric) pdata.Metric {
 
@@ -232,21 +313,37 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	attributes pdata.AttributeMap,
 ) pdata.Metric {
 
+	originResource := originResourceMetrics.Resource()
 	toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(attributes)
-	if toBeGrouped {
+	overflow := false
+	if toBeGrouped && gap.maxGroupsReached(groupedResourceMetrics.Len()) {
+		_, found := groupedResourceMetrics.findResource(buildReferenceAttributes(originResource, requiredAttributes))
+		overflow = !found
+	}
+
+	switch {
+	case overflow:
+		stats.Record(ctx, mNumOverflowMetrics.M(1))
+		// No more groups can be created: the datapoint keeps its attributes
+		// and is moved to the overflow group, shared by all the origin resources
+		originResource = pdata.NewResource()
+		requiredAttributes = gap.overflowAttributes()
+	case toBeGrouped:
 		stats.Record(ctx, mNumGroupedMetrics.M(1))
 		// These attributes are going to be moved from datapoint to resource level,
-		// so we can delete those on the datapoint
-		deleteAttributes(requiredAttributes, attributes)
-	} else {
+		// so we can delete those on the datapoint, unless they are retained
+		if !gap.retainRecordAttributes {
+			deleteAttributes(requiredAttributes, attributes)
+		}
+	default:
 		stats.Record(ctx, mNumNonGroupedMetrics.M(1))
 	}
 
 	// Get the ResourceMetrics matching with these attributes
-	groupedResource := groupedResourceMetrics.findResourceOrElseCreate(originResourceMetrics.Resource(), requiredAttributes)
+	groupedResource := groupedResourceMetrics.findResourceOrElseCreate(originResource, requiredAttributes)
 
 	// Get the corresponding instrumentation library
-	groupedInstrumentationLibrary := matchingInstrumentationLibraryMetrics(groupedResource, ilm.InstrumentationLibrary())
+	groupedInstrumentationLibrary := matchingInstrumentationLibraryMetrics(groupedResource, gap.instrumentationLibrary(ilm.InstrumentationLibrary()))
 
 	// Return the metric in this resource
 	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric)
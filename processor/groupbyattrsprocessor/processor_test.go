@@ -194,7 +194,7 @@ func TestComplexAttributeGrouping(t *testing.T) {
 			inputTraces := someComplexTraces(tt.withResourceAttrIndex, tt.inputResourceCount, tt.inputInstrumentationLibraryCount)
 			inputMetrics := someComplexMetrics(tt.withResourceAttrIndex, tt.inputResourceCount, tt.inputInstrumentationLibraryCount, 2)
 
-			gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"commonGroupedAttr"})
+			gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"commonGroupedAttr"}, false, 0, true, false)
 			require.NoError(t, err)
 
 			processedLogs, err := gap.processLogs(context.Background(), inputLogs)
@@ -307,7 +307,7 @@ func TestAttributeGrouping(t *testing.T) {
 			histogramMetrics := someHistogramMetrics(attrMap, tt.count)
 			exponentialHistogramMetrics := someExponentialHistogramMetrics(attrMap, tt.count)
 
-			gap, err := createGroupByAttrsProcessor(zap.NewNop(), tt.groupByKeys)
+			gap, err := createGroupByAttrsProcessor(zap.NewNop(), tt.groupByKeys, false, 0, true, false)
 			require.NoError(t, err)
 
 			expectedResource := prepareResource(attrMap, tt.groupByKeys)
@@ -616,7 +616,7 @@ func TestMetricAdvancedGrouping(t *testing.T) {
 	datapoint.Attributes().UpsertString("id", "eth0")
 
 	// Perform the test
-	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"})
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, false, 0, true, false)
 	require.NoError(t, err)
 
 	processedMetrics, err := gap.processMetrics(context.Background(), metrics)
@@ -691,3 +691,184 @@ func retrieveMetric(metrics pdata.MetricSlice, name string, metricType pdata.Met
 	}
 	return pdata.Metric{}, false
 }
+
+func TestMaxGroupsPerBatch(t *testing.T) {
+	traces := pdata.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans()
+	metrics := pdata.NewMetrics()
+	metric := metrics.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("gauge")
+	metric.SetDataType(pdata.MetricDataTypeGauge)
+	for _, host := range []string{"host-A", "host-B", "host-A", "host-C", "host-D"} {
+		span := spans.AppendEmpty()
+		span.SetName("span-" + host)
+		span.Attributes().UpsertString("host.name", host)
+		metric.Gauge().DataPoints().AppendEmpty().Attributes().UpsertString("host.name", host)
+	}
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, false, 3, true, false)
+	require.NoError(t, err)
+
+	processedTraces, err := gap.processTraces(context.Background(), traces)
+	require.NoError(t, err)
+
+	// host-A and host-B fill up the groups but the one of the overflow group, which gets host-C and host-D
+	rss := processedTraces.ResourceSpans()
+	require.Equal(t, 3, rss.Len())
+	for i, expected := range []struct {
+		host  string
+		spans []string
+	}{
+		{host: "host-A", spans: []string{"span-host-A", "span-host-A"}},
+		{host: "host-B", spans: []string{"span-host-B"}},
+		{host: overflowGroupValue, spans: []string{"span-host-C", "span-host-D"}},
+	} {
+		hostName, found := rss.At(i).Resource().Attributes().Get("host.name")
+		require.True(t, found)
+		assert.Equal(t, expected.host, hostName.StringVal())
+		groupedSpans := rss.At(i).InstrumentationLibrarySpans().At(0).Spans()
+		require.Equal(t, len(expected.spans), groupedSpans.Len())
+		for j, name := range expected.spans {
+			assert.Equal(t, name, groupedSpans.At(j).Name())
+			// The overflowed spans keep their grouping attributes
+			_, found = groupedSpans.At(j).Attributes().Get("host.name")
+			assert.Equal(t, expected.host == overflowGroupValue, found)
+		}
+	}
+
+	processedMetrics, err := gap.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+	assert.Equal(t, 3, processedMetrics.ResourceMetrics().Len())
+	overflow, found := retrieveHostResource(processedMetrics.ResourceMetrics(), overflowGroupValue)
+	require.True(t, found)
+	dataPoints := overflow.InstrumentationLibraryMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 2, dataPoints.Len())
+	hostName, _ := dataPoints.At(0).Attributes().Get("host.name")
+	assert.Equal(t, "host-C", hostName.StringVal())
+	hostName, _ = dataPoints.At(1).Attributes().Get("host.name")
+	assert.Equal(t, "host-D", hostName.StringVal())
+}
+
+func TestMaxGroupsPerBatchSeveralResources(t *testing.T) {
+	logs := pdata.NewLogs()
+	for i, host := range []string{"host-A", "host-B", "host-C", "host-D"} {
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().UpsertString("service.name", fmt.Sprintf("service-%d", i))
+		lr := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Attributes().UpsertString("host.name", host)
+	}
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, false, 3, true, false)
+	require.NoError(t, err)
+
+	processedLogs, err := gap.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	// the records of host-C and host-D come from distinct resources, but share a single overflow group
+	rls := processedLogs.ResourceLogs()
+	require.Equal(t, 3, rls.Len())
+	overflow := rls.At(2)
+	assert.Equal(t, map[string]interface{}{"host.name": overflowGroupValue}, overflow.Resource().Attributes().AsRaw())
+	records := overflow.InstrumentationLibraryLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	hostName, _ := records.At(0).Attributes().Get("host.name")
+	assert.Equal(t, "host-C", hostName.StringVal())
+	hostName, _ = records.At(1).Attributes().Get("host.name")
+	assert.Equal(t, "host-D", hostName.StringVal())
+}
+
+func TestPreserveInstrumentationLibrary(t *testing.T) {
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	for _, library := range []struct {
+		name    string
+		version string
+	}{{"lib-1", "1.0"}, {"lib-1", "2.0"}, {"lib-2", "1.0"}} {
+		ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+		ill.InstrumentationLibrary().SetName(library.name)
+		ill.InstrumentationLibrary().SetVersion(library.version)
+		ill.LogRecords().AppendEmpty().Attributes().UpsertString("host.name", "host-A")
+	}
+
+	tests := []struct {
+		name      string
+		preserve  bool
+		libraries []string
+	}{
+		{
+			name:      "preserve",
+			preserve:  true,
+			libraries: []string{"lib-1/1.0", "lib-1/2.0", "lib-2/1.0"},
+		},
+		{
+			name:      "collapse",
+			preserve:  false,
+			libraries: []string{"/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := logs.Clone()
+
+			gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, false, 0, tt.preserve, false)
+			require.NoError(t, err)
+
+			processedLogs, err := gap.processLogs(context.Background(), ld)
+			require.NoError(t, err)
+			require.Equal(t, 1, processedLogs.ResourceLogs().Len())
+
+			ills := processedLogs.ResourceLogs().At(0).InstrumentationLibraryLogs()
+			var libraries []string
+			records := 0
+			for i := 0; i < ills.Len(); i++ {
+				library := ills.At(i).InstrumentationLibrary()
+				libraries = append(libraries, library.Name()+"/"+library.Version())
+				records += ills.At(i).LogRecords().Len()
+			}
+			assert.Equal(t, tt.libraries, libraries)
+			assert.Equal(t, 3, records)
+		})
+	}
+}
+
+func TestRetainRecordAttributes(t *testing.T) {
+	traces := pdata.NewTraces()
+	traces.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty().Attributes().UpsertString("host.name", "host-A")
+	logs := pdata.NewLogs()
+	logs.ResourceLogs().AppendEmpty().InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().UpsertString("host.name", "host-A")
+	metrics := pdata.NewMetrics()
+	metric := metrics.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("gauge")
+	metric.SetDataType(pdata.MetricDataTypeGauge)
+	metric.Gauge().DataPoints().AppendEmpty().Attributes().UpsertString("host.name", "host-A")
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, false, 0, true, true)
+	require.NoError(t, err)
+
+	assertHostName := func(attrs pdata.AttributeMap) {
+		hostName, found := attrs.Get("host.name")
+		require.True(t, found)
+		assert.Equal(t, "host-A", hostName.StringVal())
+	}
+
+	processedTraces, err := gap.processTraces(context.Background(), traces)
+	require.NoError(t, err)
+	require.Equal(t, 1, processedTraces.ResourceSpans().Len())
+	rs := processedTraces.ResourceSpans().At(0)
+	assertHostName(rs.Resource().Attributes())
+	assertHostName(rs.InstrumentationLibrarySpans().At(0).Spans().At(0).Attributes())
+
+	processedLogs, err := gap.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+	require.Equal(t, 1, processedLogs.ResourceLogs().Len())
+	rl := processedLogs.ResourceLogs().At(0)
+	assertHostName(rl.Resource().Attributes())
+	assertHostName(rl.InstrumentationLibraryLogs().At(0).LogRecords().At(0).Attributes())
+
+	processedMetrics, err := gap.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+	require.Equal(t, 1, processedMetrics.ResourceMetrics().Len())
+	rm := processedMetrics.ResourceMetrics().At(0)
+	assertHostName(rm.Resource().Attributes())
+	assertHostName(rm.InstrumentationLibraryMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).Attributes())
+}
@@ -0,0 +1,211 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// ungroupTraces moves the grouping attributes of the resources to their spans.
+func (gap *groupByAttrsProcessor) ungroupTraces(ctx context.Context, td pdata.Traces) pdata.Traces {
+	rss := td.ResourceSpans()
+	ungroupedResourceSpans := newSpansGroupedByAttrs()
+
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		toBeUngrouped, movedAttributes, resource := gap.extractUngroupingAttributes(rs.Resource())
+
+		// The resources left with the same attributes are merged
+		ungroupedSpans := ungroupedResourceSpans.findOrCreateResource(resource, pdata.NewAttributeMap())
+
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			ils := ilss.At(j)
+			for k := 0; k < ils.Spans().Len(); k++ {
+				sp := matchingInstrumentationLibrarySpans(ungroupedSpans, gap.instrumentationLibrary(ils.InstrumentationLibrary())).Spans().AppendEmpty()
+				ils.Spans().At(k).CopyTo(sp)
+				if toBeUngrouped {
+					stats.Record(ctx, mNumUngroupedSpans.M(1))
+					insertAttributes(movedAttributes, sp.Attributes())
+				}
+			}
+		}
+	}
+
+	ungroupedTraces := pdata.NewTraces()
+	ungroupedResourceSpans.MoveAndAppendTo(ungroupedTraces.ResourceSpans())
+	stats.Record(ctx, mDistSpanGroups.M(int64(ungroupedTraces.ResourceSpans().Len())))
+
+	return ungroupedTraces
+}
+
+// ungroupLogs moves the grouping attributes of the resources to their log records.
+func (gap *groupByAttrsProcessor) ungroupLogs(ctx context.Context, ld pdata.Logs) pdata.Logs {
+	rls := ld.ResourceLogs()
+	ungroupedResourceLogs := newLogsGroupedByAttrs()
+
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		toBeUngrouped, movedAttributes, resource := gap.extractUngroupingAttributes(rl.Resource())
+
+		// The resources left with the same attributes are merged
+		ungroupedLogs := ungroupedResourceLogs.findResourceOrElseCreate(resource, pdata.NewAttributeMap())
+
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			ill := ills.At(j)
+			for k := 0; k < ill.LogRecords().Len(); k++ {
+				lr := matchingInstrumentationLibraryLogs(ungroupedLogs, gap.instrumentationLibrary(ill.InstrumentationLibrary())).LogRecords().AppendEmpty()
+				ill.LogRecords().At(k).CopyTo(lr)
+				if toBeUngrouped {
+					stats.Record(ctx, mNumUngroupedLogs.M(1))
+					insertAttributes(movedAttributes, lr.Attributes())
+				}
+			}
+		}
+	}
+
+	ungroupedLogs := pdata.NewLogs()
+	ungroupedResourceLogs.MoveAndAppendTo(ungroupedLogs.ResourceLogs())
+	stats.Record(ctx, mDistLogGroups.M(int64(ungroupedLogs.ResourceLogs().Len())))
+
+	return ungroupedLogs
+}
+
+// ungroupMetrics moves the grouping attributes of the resources to their metric data points.
+func (gap *groupByAttrsProcessor) ungroupMetrics(ctx context.Context, md pdata.Metrics) pdata.Metrics {
+	rms := md.ResourceMetrics()
+	ungroupedResourceMetrics := newMetricsGroupedByAttrs()
+
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		toBeUngrouped, movedAttributes, resource := gap.extractUngroupingAttributes(rm.Resource())
+
+		// The resources left with the same attributes are merged
+		ungroupedResource := ungroupedResourceMetrics.findResourceOrElseCreate(resource, pdata.NewAttributeMap())
+
+		ilms := rm.InstrumentationLibraryMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			ungroupedInstrumentationLibrary := matchingInstrumentationLibraryMetrics(ungroupedResource, gap.instrumentationLibrary(ilm.InstrumentationLibrary()))
+			for k := 0; k < ilm.Metrics().Len(); k++ {
+				metric := ilm.Metrics().At(k)
+				ungroupedMetric := getMetricInInstrumentationLibrary(ungroupedInstrumentationLibrary, metric)
+				copyAggregation(metric, ungroupedMetric)
+				copied := copyDataPoints(metric, ungroupedMetric, movedAttributes)
+				if toBeUngrouped {
+					stats.Record(ctx, mNumUngroupedMetrics.M(int64(copied)))
+				}
+			}
+		}
+	}
+
+	ungroupedMetrics := pdata.NewMetrics()
+	ungroupedResourceMetrics.MoveAndAppendTo(ungroupedMetrics.ResourceMetrics())
+	stats.Record(ctx, mDistMetricGroups.M(int64(ungroupedMetrics.ResourceMetrics().Len())))
+
+	return ungroupedMetrics
+}
+
+// copyAggregation copies the aggregation temporality and monotonicity of the metric
+// to the target metric, so that the ungrouped data points keep their meaning.
+func copyAggregation(metric pdata.Metric, target pdata.Metric) {
+	switch metric.DataType() {
+	case pdata.MetricDataTypeSum:
+		target.Sum().SetAggregationTemporality(metric.Sum().AggregationTemporality())
+		target.Sum().SetIsMonotonic(metric.Sum().IsMonotonic())
+	case pdata.MetricDataTypeHistogram:
+		target.Histogram().SetAggregationTemporality(metric.Histogram().AggregationTemporality())
+	case pdata.MetricDataTypeExponentialHistogram:
+		target.ExponentialHistogram().SetAggregationTemporality(metric.ExponentialHistogram().AggregationTemporality())
+	}
+}
+
+// extractUngroupingAttributes extracts the grouping attributes of the specified Resource.
+// Returns whether any attribute matched, the extracted AttributeMap of matching keys and
+// their corresponding values, and a copy of the Resource without the matching attributes.
+func (gap *groupByAttrsProcessor) extractUngroupingAttributes(resource pdata.Resource) (bool, pdata.AttributeMap, pdata.Resource) {
+	toBeUngrouped, movedAttributes := gap.extractGroupingAttributes(resource.Attributes())
+
+	remaining := pdata.NewResource()
+	resource.CopyTo(remaining)
+	deleteAttributes(movedAttributes, remaining.Attributes())
+
+	return toBeUngrouped, movedAttributes, remaining
+}
+
+// insertAttributes inserts the specified attributes in the target attributes. The attributes
+// already present on the target are kept, as the record values are more specific.
+func insertAttributes(attrs, targetAttrs pdata.AttributeMap) {
+	attrs.Range(func(key string, value pdata.AttributeValue) bool {
+		targetAttrs.Insert(key, value)
+		return true
+	})
+}
+
+// copyDataPoints appends the data points of the metric to the target metric of the same type,
+// inserting the specified attributes in each of them. Returns the number of copied data points.
+func copyDataPoints(metric pdata.Metric, target pdata.Metric, attrs pdata.AttributeMap) int {
+	switch metric.DataType() {
+	case pdata.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := target.Gauge().DataPoints().AppendEmpty()
+			dps.At(i).CopyTo(dp)
+			insertAttributes(attrs, dp.Attributes())
+		}
+		return dps.Len()
+
+	case pdata.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := target.Sum().DataPoints().AppendEmpty()
+			dps.At(i).CopyTo(dp)
+			insertAttributes(attrs, dp.Attributes())
+		}
+		return dps.Len()
+
+	case pdata.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := target.Summary().DataPoints().AppendEmpty()
+			dps.At(i).CopyTo(dp)
+			insertAttributes(attrs, dp.Attributes())
+		}
+		return dps.Len()
+
+	case pdata.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := target.Histogram().DataPoints().AppendEmpty()
+			dps.At(i).CopyTo(dp)
+			insertAttributes(attrs, dp.Attributes())
+		}
+		return dps.Len()
+
+	case pdata.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := target.ExponentialHistogram().DataPoints().AppendEmpty()
+			dps.At(i).CopyTo(dp)
+			insertAttributes(attrs, dp.Attributes())
+		}
+		return dps.Len()
+	}
+	return 0
+}
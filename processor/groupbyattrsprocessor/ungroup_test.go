@@ -0,0 +1,132 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func TestUngroupTraces(t *testing.T) {
+	traces := pdata.NewTraces()
+	for _, host := range []string{"host-A", "host-B"} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().UpsertString("host.name", host)
+		rs.Resource().Attributes().UpsertString("service.name", "svc")
+		span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetName("span-" + host)
+	}
+	// The resource without the ungrouped attribute is merged with the other ones
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().UpsertString("service.name", "svc")
+	rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty().SetName("span-none")
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, true, 0, true, false)
+	require.NoError(t, err)
+
+	processedTraces, err := gap.processTraces(context.Background(), traces)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, processedTraces.ResourceSpans().Len())
+	resource := processedTraces.ResourceSpans().At(0)
+	assert.Equal(t, map[string]interface{}{"service.name": "svc"}, resource.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, resource.InstrumentationLibrarySpans().Len())
+	spans := resource.InstrumentationLibrarySpans().At(0).Spans()
+	require.Equal(t, 3, spans.Len())
+	for i, host := range []string{"host-A", "host-B"} {
+		assert.Equal(t, "span-"+host, spans.At(i).Name())
+		assert.Equal(t, map[string]interface{}{"host.name": host}, spans.At(i).Attributes().AsRaw())
+	}
+	assert.Equal(t, 0, spans.At(2).Attributes().Len())
+}
+
+func TestUngroupLogsKeepsRecordAttributes(t *testing.T) {
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().UpsertString("host.name", "host-A")
+	rl.Resource().Attributes().UpsertString("k8s.pod.name", "pod")
+	lrs := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Attributes().UpsertString("id", "1")
+	lrs.AppendEmpty().Attributes().UpsertString("host.name", "host-B")
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name", "k8s.pod.name"}, true, 0, true, false)
+	require.NoError(t, err)
+
+	processedLogs, err := gap.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, processedLogs.ResourceLogs().Len())
+	resource := processedLogs.ResourceLogs().At(0)
+	assert.Equal(t, 0, resource.Resource().Attributes().Len())
+	records := resource.InstrumentationLibraryLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	assert.Equal(t, map[string]interface{}{"id": "1", "host.name": "host-A", "k8s.pod.name": "pod"}, records.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"host.name": "host-B", "k8s.pod.name": "pod"}, records.At(1).Attributes().AsRaw())
+}
+
+func TestUngroupMetrics(t *testing.T) {
+	metrics := pdata.NewMetrics()
+	for _, host := range []string{"host-A", "host-B"} {
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().UpsertString("host.name", host)
+		rm.Resource().Attributes().UpsertString("source", "prom")
+		ilm := rm.InstrumentationLibraryMetrics().AppendEmpty()
+
+		sum := ilm.Metrics().AppendEmpty()
+		sum.SetName("sum")
+		sum.SetDataType(pdata.MetricDataTypeSum)
+		sum.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		sum.Sum().SetIsMonotonic(true)
+		sum.Sum().DataPoints().AppendEmpty().Attributes().UpsertString("id", "eth0")
+
+		histogram := ilm.Metrics().AppendEmpty()
+		histogram.SetName("histogram")
+		histogram.SetDataType(pdata.MetricDataTypeHistogram)
+		histogram.Histogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityDelta)
+		histogram.Histogram().DataPoints().AppendEmpty()
+	}
+
+	gap, err := createGroupByAttrsProcessor(zap.NewNop(), []string{"host.name"}, true, 0, true, false)
+	require.NoError(t, err)
+
+	processedMetrics, err := gap.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, processedMetrics.ResourceMetrics().Len())
+	resource := processedMetrics.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{"source": "prom"}, resource.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, resource.InstrumentationLibraryMetrics().Len())
+	ungroupedMetrics := resource.InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 2, ungroupedMetrics.Len())
+
+	sum, found := retrieveMetric(ungroupedMetrics, "sum", pdata.MetricDataTypeSum)
+	require.True(t, found)
+	assert.Equal(t, pdata.MetricAggregationTemporalityCumulative, sum.Sum().AggregationTemporality())
+	assert.True(t, sum.Sum().IsMonotonic())
+	require.Equal(t, 2, sum.Sum().DataPoints().Len())
+	assert.Equal(t, map[string]interface{}{"id": "eth0", "host.name": "host-A"}, sum.Sum().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"id": "eth0", "host.name": "host-B"}, sum.Sum().DataPoints().At(1).Attributes().AsRaw())
+
+	histogram, found := retrieveMetric(ungroupedMetrics, "histogram", pdata.MetricDataTypeHistogram)
+	require.True(t, found)
+	assert.Equal(t, pdata.MetricAggregationTemporalityDelta, histogram.Histogram().AggregationTemporality())
+	require.Equal(t, 2, histogram.Histogram().DataPoints().Len())
+	assert.Equal(t, map[string]interface{}{"host.name": "host-A"}, histogram.Histogram().DataPoints().At(0).Attributes().AsRaw())
+}
@@ -34,6 +34,9 @@ type fakeClient struct {
 	Informer          cache.SharedInformer
 	NamespaceInformer cache.SharedInformer
 	Namespaces        map[string]*kube.Namespace
+	Nodes             map[string]*kube.Node
+	Deployments       map[string]*kube.Workload
+	StatefulSets      map[string]*kube.Workload
 	StopCh            chan struct{}
 }
 
@@ -43,7 +46,7 @@ func selectors() (labels.Selector, fields.Selector) {
 }
 
 // newFakeClient instantiates a new FakeClient object and satisfies the ClientProvider type
-func newFakeClient(_ *zap.Logger, apiCfg k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, exclude kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace) (kube.Client, error) {
+func newFakeClient(_ *zap.Logger, apiCfg k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, exclude kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace, _ kube.InformerProviderNode, _ kube.InformerProviderDeployment, _ kube.InformerProviderStatefulSet) (kube.Client, error) {
 	cs := fake.NewSimpleClientset()
 
 	ls, fs := selectors()
@@ -54,6 +57,9 @@ func newFakeClient(_ *zap.Logger, apiCfg k8sconfig.APIConfig, rules kube.Extract
 		Associations:      associations,
 		Informer:          kube.NewFakeInformer(cs, "", ls, fs),
 		NamespaceInformer: kube.NewFakeInformer(cs, "", ls, fs),
+		Nodes:             map[string]*kube.Node{},
+		Deployments:       map[string]*kube.Workload{},
+		StatefulSets:      map[string]*kube.Workload{},
 		StopCh:            make(chan struct{}),
 	}, nil
 }
@@ -70,6 +76,21 @@ func (f *fakeClient) GetNamespace(namespace string) (*kube.Namespace, bool) {
 	return ns, ok
 }
 
+func (f *fakeClient) GetNode(name string) (*kube.Node, bool) {
+	node, ok := f.Nodes[name]
+	return node, ok
+}
+
+func (f *fakeClient) GetDeployment(namespace, name string) (*kube.Workload, bool) {
+	deployment, ok := f.Deployments[namespace+"/"+name]
+	return deployment, ok
+}
+
+func (f *fakeClient) GetStatefulSet(namespace, name string) (*kube.Workload, bool) {
+	statefulSet, ok := f.StatefulSets[namespace+"/"+name]
+	return statefulSet, ok
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() {
 	if f.Informer != nil {
@@ -87,8 +87,8 @@ type ExtractConfig struct {
 //
 // - tag_name represents the name of the tag that will be added to the span.
 //   When not specified a default tag name will be used of the format:
-//       k8s.pod.annotations.<annotation key>
-//       k8s.pod.labels.<label key>
+//       k8s.<from>.annotations.<annotation key>
+//       k8s.<from>.labels.<label key>
 //   For example, if tag_name is not specified and the key is git_sha,
 //   then the attribute name will be `k8s.pod.annotations.git_sha`.
 //
@@ -122,7 +122,8 @@ type FieldExtractConfig struct {
 	KeyRegex string `mapstructure:"key_regex"`
 	Regex    string `mapstructure:"regex"`
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace", "deployment", "statefulset" and "node". The default is pod.
+	// The deployment and statefulset are the ones owning the pod, the node is the one the pod is running on.
 	From string `mapstructure:"from"`
 }
 
@@ -23,6 +23,11 @@
 // Following rule types are available:
 //   from: "resource_attribute" - allows to specify the attribute name to lookup up in the list of attributes of the received Resource. The specified attribute, if it is present, identifies the Pod that is represented by the Resource.
 //     (the value can contain either IP address or Pod UID)
+//     If the name is "log.file.path", the value is expected to be the path of a pod log file written by kubelet
+//     (/var/log/pods/<namespace>_<pod_name>_<pod_uid>/<container_name>/<restart_count>.log) and the Pod is
+//     identified by the Pod UID in the path. For logs, the path is also taken from the log records if the resource
+//     doesn't have it: the records read from different files, which the filelog receiver puts under a single
+//     resource, are split into a copy of the resource per file. The path is not added to the resource.
 //   from: "connection" - takes the IP attribute from connection context (if available) and automatically
 //     associates it with "k8s.pod.ip" attribute
 // Pod association configuration.
@@ -62,13 +67,19 @@
 //   2. Container status attributes - in addition to pod identifier and `k8s.container.name` attribute, these attributes
 //     require identifier of a particular container run set as `k8s.container.restart_count` in resource attributes:
 //     - container.id
+//   Both `k8s.container.name` and `k8s.container.restart_count` are set from the log file path when the Pod is
+//   associated by "log.file.path", so logs tailed on the node get the container level attributes as well.
 
-//The k8sattributesprocessor can be used for automatic tagging of spans, metrics and logs with k8s labels and annotations from pods and namespaces.
+//The k8sattributesprocessor can be used for automatic tagging of spans, metrics and logs with k8s labels and annotations from pods, namespaces,
+//nodes and the deployments or statefulsets owning the pods.
 //The config for associating the data passing through the processor (spans, metrics and logs) with specific Pod/Namespace annotations/labels is configured via "annotations"  and "labels" keys.
-//This config represents a list of annotations/labels that are extracted from pods/namespaces and added to spans, metrics and logs.
+//This config represents a list of annotations/labels that are extracted from pods/namespaces/nodes/workloads and added to spans, metrics and logs.
 //Each item is specified as a config of tag_name (representing the tag name to tag the spans with),
 //key (representing the key used to extract value) and from (representing the kubernetes object used to extract the value).
-//The "from" field has only two possible values "pod" and "namespace" and defaults to "pod" if none is specified.
+//The "from" field has the possible values "pod", "namespace", "deployment", "statefulset" and "node" and defaults to "pod" if none is specified.
+//The "deployment" and "statefulset" values refer to the workload owning the pod, and "node" to the node the pod is running on.
+//When tag_name is not set, the tag name is k8s.<from>.labels.<key> or k8s.<from>.annotations.<key>, e.g. k8s.node.labels.topology.kubernetes.io/zone.
+//The objects are only watched when at least one rule uses them; when filter.node is set, only that node is watched.
 //
 //A few examples to use this config are as follows:
 //annotations:
@@ -87,11 +98,22 @@
 //	  key: label2
 //	  regex: field=(?P<value>.+)
 //	  from: pod
+//  - tag_name: team # extracts value of label from the deployment owning the pod with key `team`
+//	  key: team
+//	  from: deployment
+//  - key: topology.kubernetes.io/zone # extracts the zone of the node as `k8s.node.labels.topology.kubernetes.io/zone`
+//	  from: node
+//  - tag_name: instance.type # extracts the instance type of the node
+//	  key: node.kubernetes.io/instance-type
+//	  from: node
 
 // RBAC
 //
 // TODO: mention the required RBAC rules.
 //
+// Extracting labels/annotations from nodes requires get/list/watch permissions on "nodes", and from
+// deployments or statefulsets on "deployments" and "statefulsets" of the "apps" API group.
+//
 // Config
 //
 // TODO: example config.
@@ -23,7 +23,9 @@ import (
 
 	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
+	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -42,6 +44,9 @@ type WatchClient struct {
 	kc                kubernetes.Interface
 	informer          cache.SharedInformer
 	namespaceInformer cache.SharedInformer
+	nodeInformer      cache.SharedInformer
+	deployInformer    cache.SharedInformer
+	stsInformer       cache.SharedInformer
 	deploymentRegex   *regexp.Regexp
 	deleteQueue       []deleteRequest
 	stopCh            chan struct{}
@@ -57,6 +62,15 @@ type WatchClient struct {
 	// A map containing Namespace related data, used to associate them with resources.
 	// Key is namespace name
 	Namespaces map[string]*Namespace
+
+	// A map containing Node related data, used to associate them with resources.
+	// Key is node name
+	Nodes map[string]*Node
+
+	// Maps containing Deployment and StatefulSet related data, used to associate them with resources.
+	// Key is the namespace and the name of the workload, see workloadKey.
+	Deployments  map[string]*Workload
+	StatefulSets map[string]*Workload
 }
 
 // Extract deployment name from the pod name. Pod name is created using
@@ -64,7 +78,7 @@ type WatchClient struct {
 var dRegex = regexp.MustCompile(`^(.*)-[0-9a-zA-Z]*-[0-9a-zA-Z]*$`)
 
 // New initializes a new k8s Client.
-func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace) (Client, error) {
+func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace, newNodeInformer InformerProviderNode, newDeploymentInformer InformerProviderDeployment, newStatefulSetInformer InformerProviderStatefulSet) (Client, error) {
 	c := &WatchClient{
 		logger:          logger,
 		Rules:           rules,
@@ -78,6 +92,9 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 
 	c.Pods = map[PodIdentifier]*Pod{}
 	c.Namespaces = map[string]*Namespace{}
+	c.Nodes = map[string]*Node{}
+	c.Deployments = map[string]*Workload{}
+	c.StatefulSets = map[string]*Workload{}
 	if newClientSet == nil {
 		newClientSet = k8sconfig.MakeClient
 	}
@@ -105,12 +122,39 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 		newNamespaceInformer = newNamespaceSharedInformer
 	}
 
+	if newNodeInformer == nil {
+		newNodeInformer = newNodeSharedInformer
+	}
+
+	if newDeploymentInformer == nil {
+		newDeploymentInformer = newDeploymentSharedInformer
+	}
+
+	if newStatefulSetInformer == nil {
+		newStatefulSetInformer = newStatefulSetSharedInformer
+	}
+
 	c.informer = newInformer(c.kc, c.Filters.Namespace, labelSelector, fieldSelector)
 	if c.extractNamespaceLabelsAnnotations() {
 		c.namespaceInformer = newNamespaceInformer(c.kc)
 	} else {
 		c.namespaceInformer = NewNoOpInformer(c.kc)
 	}
+	if c.extractLabelsAnnotationsFrom(MetadataFromNode) {
+		c.nodeInformer = newNodeInformer(c.kc, c.Filters.Node)
+	} else {
+		c.nodeInformer = NewNoOpInformer(c.kc)
+	}
+	if c.extractLabelsAnnotationsFrom(MetadataFromDeployment) {
+		c.deployInformer = newDeploymentInformer(c.kc, c.Filters.Namespace)
+	} else {
+		c.deployInformer = NewNoOpInformer(c.kc)
+	}
+	if c.extractLabelsAnnotationsFrom(MetadataFromStatefulSet) {
+		c.stsInformer = newStatefulSetInformer(c.kc, c.Filters.Namespace)
+	} else {
+		c.stsInformer = NewNoOpInformer(c.kc)
+	}
 	return c, err
 }
 
@@ -128,6 +172,24 @@ func (c *WatchClient) Start() {
 		DeleteFunc: c.handleNamespaceDelete,
 	})
 	go c.namespaceInformer.Run(c.stopCh)
+	c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleNodeAdd,
+		UpdateFunc: c.handleNodeUpdate,
+		DeleteFunc: c.handleNodeDelete,
+	})
+	go c.nodeInformer.Run(c.stopCh)
+	c.deployInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleDeploymentAdd,
+		UpdateFunc: c.handleDeploymentUpdate,
+		DeleteFunc: c.handleDeploymentDelete,
+	})
+	go c.deployInformer.Run(c.stopCh)
+	c.stsInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleStatefulSetAdd,
+		UpdateFunc: c.handleStatefulSetUpdate,
+		DeleteFunc: c.handleStatefulSetDelete,
+	})
+	go c.stsInformer.Run(c.stopCh)
 }
 
 // Stop signals the the k8s watcher/informer to stop watching for new events.
@@ -203,6 +265,85 @@ func (c *WatchClient) handleNamespaceDelete(obj interface{}) {
 	}
 }
 
+func (c *WatchClient) handleNodeAdd(obj interface{}) {
+	if node, ok := obj.(*api_v1.Node); ok {
+		c.addOrUpdateNode(node)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleNodeUpdate(old, new interface{}) {
+	if node, ok := new.(*api_v1.Node); ok {
+		c.addOrUpdateNode(node)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleNodeDelete(obj interface{}) {
+	if node, ok := obj.(*api_v1.Node); ok {
+		// The pods of a deleted node are deleted as well, so there is no need for a grace period.
+		c.m.Lock()
+		delete(c.Nodes, node.Name)
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleDeploymentAdd(obj interface{}) {
+	if deployment, ok := obj.(*apps_v1.Deployment); ok {
+		c.addOrUpdateWorkload(c.Deployments, MetadataFromDeployment, &deployment.ObjectMeta)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.Deployment", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleDeploymentUpdate(old, new interface{}) {
+	if deployment, ok := new.(*apps_v1.Deployment); ok {
+		c.addOrUpdateWorkload(c.Deployments, MetadataFromDeployment, &deployment.ObjectMeta)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.Deployment", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleDeploymentDelete(obj interface{}) {
+	if deployment, ok := obj.(*apps_v1.Deployment); ok {
+		c.m.Lock()
+		delete(c.Deployments, workloadKey(deployment.Namespace, deployment.Name))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type apps_v1.Deployment", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleStatefulSetAdd(obj interface{}) {
+	if statefulSet, ok := obj.(*apps_v1.StatefulSet); ok {
+		c.addOrUpdateWorkload(c.StatefulSets, MetadataFromStatefulSet, &statefulSet.ObjectMeta)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.StatefulSet", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleStatefulSetUpdate(old, new interface{}) {
+	if statefulSet, ok := new.(*apps_v1.StatefulSet); ok {
+		c.addOrUpdateWorkload(c.StatefulSets, MetadataFromStatefulSet, &statefulSet.ObjectMeta)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.StatefulSet", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleStatefulSetDelete(obj interface{}) {
+	if statefulSet, ok := obj.(*apps_v1.StatefulSet); ok {
+		c.m.Lock()
+		delete(c.StatefulSets, workloadKey(statefulSet.Namespace, statefulSet.Name))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type apps_v1.StatefulSet", zap.Any("received", obj))
+	}
+}
+
 func (c *WatchClient) deleteLoop(interval time.Duration, gracePeriod time.Duration) {
 	// This loop runs after N seconds and deletes pods from cache.
 	// It iterates over the delete queue and deletes all that aren't
@@ -269,6 +410,30 @@ func (c *WatchClient) GetNamespace(namespace string) (*Namespace, bool) {
 	return nil, false
 }
 
+// GetNode takes a node name and returns the node object the name is associated with.
+func (c *WatchClient) GetNode(name string) (*Node, bool) {
+	c.m.RLock()
+	node, ok := c.Nodes[name]
+	c.m.RUnlock()
+	return node, ok
+}
+
+// GetDeployment takes a namespace and a deployment name and returns the deployment object they are associated with.
+func (c *WatchClient) GetDeployment(namespace, name string) (*Workload, bool) {
+	c.m.RLock()
+	deployment, ok := c.Deployments[workloadKey(namespace, name)]
+	c.m.RUnlock()
+	return deployment, ok
+}
+
+// GetStatefulSet takes a namespace and a statefulset name and returns the statefulset object they are associated with.
+func (c *WatchClient) GetStatefulSet(namespace, name string) (*Workload, bool) {
+	c.m.RLock()
+	statefulSet, ok := c.StatefulSets[workloadKey(namespace, name)]
+	c.m.RUnlock()
+	return statefulSet, ok
+}
+
 func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	tags := map[string]string{}
 	if c.Rules.PodName {
@@ -387,33 +552,39 @@ func (c *WatchClient) extractPodContainersAttributes(pod *api_v1.Pod) map[string
 }
 
 func (c *WatchClient) extractNamespaceAttributes(namespace *api_v1.Namespace) map[string]string {
+	return c.extractObjectAttributes(MetadataFromNamespace, &namespace.ObjectMeta)
+}
+
+// extractObjectAttributes extracts the labels and annotations of a namespace, node or workload
+// object according to the rules with the given From value.
+func (c *WatchClient) extractObjectAttributes(from string, meta *meta_v1.ObjectMeta) map[string]string {
 	tags := map[string]string{}
 
 	for _, r := range c.Rules.Labels {
-		if r.From == MetadataFromNamespace {
+		if r.From == from {
 			if r.KeyRegex != nil {
-				for k, v := range namespace.Labels {
+				for k, v := range meta.Labels {
 					if r.KeyRegex.MatchString(k) && v != "" {
-						name := fmt.Sprintf("k8s.namespace.labels.%s", k)
+						name := fmt.Sprintf("k8s.%s.labels.%s", from, k)
 						tags[name] = v
 					}
 				}
-			} else if v, ok := namespace.Labels[r.Key]; ok {
+			} else if v, ok := meta.Labels[r.Key]; ok {
 				tags[r.Name] = c.extractField(v, r)
 			}
 		}
 	}
 
 	for _, r := range c.Rules.Annotations {
-		if r.From == MetadataFromNamespace {
+		if r.From == from {
 			if r.KeyRegex != nil {
-				for k, v := range namespace.Annotations {
+				for k, v := range meta.Annotations {
 					if r.KeyRegex.MatchString(k) && v != "" {
-						name := fmt.Sprintf("k8s.namespace.annotations.%s", k)
+						name := fmt.Sprintf("k8s.%s.annotations.%s", from, k)
 						tags[name] = v
 					}
 				}
-			} else if v, ok := namespace.Annotations[r.Key]; ok {
+			} else if v, ok := meta.Annotations[r.Key]; ok {
 				tags[r.Name] = c.extractField(v, r)
 			}
 		}
@@ -443,7 +614,9 @@ func (c *WatchClient) addOrUpdatePod(pod *api_v1.Pod) {
 		Address:   pod.Status.PodIP,
 		PodUID:    string(pod.UID),
 		StartTime: pod.Status.StartTime,
+		NodeName:  pod.Spec.NodeName,
 	}
+	newPod.DeploymentName, newPod.StatefulSetName = podOwnerWorkload(pod)
 
 	if c.shouldIgnorePod(pod) {
 		newPod.Ignore = true
@@ -572,15 +745,71 @@ func (c *WatchClient) addOrUpdateNamespace(namespace *api_v1.Namespace) {
 	c.m.Unlock()
 }
 
+func (c *WatchClient) addOrUpdateNode(node *api_v1.Node) {
+	newNode := &Node{
+		Name:       node.Name,
+		NodeUID:    string(node.UID),
+		Attributes: c.extractObjectAttributes(MetadataFromNode, &node.ObjectMeta),
+	}
+
+	c.m.Lock()
+	if node.Name != "" {
+		c.Nodes[node.Name] = newNode
+	}
+	c.m.Unlock()
+}
+
+// addOrUpdateWorkload stores the deployment or statefulset with the given metadata in the given map.
+func (c *WatchClient) addOrUpdateWorkload(workloads map[string]*Workload, from string, meta *meta_v1.ObjectMeta) {
+	newWorkload := &Workload{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		WorkloadUID: string(meta.UID),
+		Attributes:  c.extractObjectAttributes(from, meta),
+	}
+
+	c.m.Lock()
+	if meta.Name != "" {
+		workloads[workloadKey(meta.Namespace, meta.Name)] = newWorkload
+	}
+	c.m.Unlock()
+}
+
+func workloadKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// podOwnerWorkload returns the name of the deployment or the statefulset owning the pod.
+// Pods of a deployment are owned by a replicaset named after the deployment and the
+// pod-template-hash label of the pod.
+func podOwnerWorkload(pod *api_v1.Pod) (deployment string, statefulSet string) {
+	for _, ref := range pod.OwnerReferences {
+		switch ref.Kind {
+		case "ReplicaSet":
+			if hash, ok := pod.Labels[apps_v1.DefaultDeploymentUniqueLabelKey]; ok && strings.HasSuffix(ref.Name, "-"+hash) {
+				deployment = strings.TrimSuffix(ref.Name, "-"+hash)
+			}
+		case "StatefulSet":
+			statefulSet = ref.Name
+		}
+	}
+	return deployment, statefulSet
+}
+
 func (c *WatchClient) extractNamespaceLabelsAnnotations() bool {
+	return c.extractLabelsAnnotationsFrom(MetadataFromNamespace)
+}
+
+// extractLabelsAnnotationsFrom returns true if any label or annotation rule applies to the given From value.
+func (c *WatchClient) extractLabelsAnnotationsFrom(from string) bool {
 	for _, r := range c.Rules.Labels {
-		if r.From == MetadataFromNamespace {
+		if r.From == from {
 			return true
 		}
 	}
 
 	for _, r := range c.Rules.Annotations {
-		if r.From == MetadataFromNamespace {
+		if r.From == from {
 			return true
 		}
 	}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
+	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -112,12 +113,12 @@ func namespaceAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj in
 }
 
 func TestDefaultClientset(t *testing.T) {
-	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil)
+	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "invalid authType for kubernetes: ", err.Error())
 	assert.Nil(t, c)
 
-	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil)
+	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
@@ -133,6 +134,9 @@ func TestBadFilters(t *testing.T) {
 		newFakeAPIClientset,
 		NewFakeInformer,
 		NewFakeNamespaceInformer,
+		NewFakeNodeInformer,
+		NewFakeWorkloadInformer,
+		NewFakeWorkloadInformer,
 	)
 	assert.Error(t, err)
 	assert.Nil(t, c)
@@ -169,7 +173,7 @@ func TestConstructorErrors(t *testing.T) {
 			gotAPIConfig = c
 			return nil, fmt.Errorf("error creating k8s client")
 		}
-		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer)
+		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, NewFakeNodeInformer, NewFakeWorkloadInformer, NewFakeWorkloadInformer)
 		assert.Nil(t, c)
 		assert.Error(t, err)
 		assert.Equal(t, err.Error(), "error creating k8s client")
@@ -648,6 +652,153 @@ func TestNamespaceExtractionRules(t *testing.T) {
 	}
 }
 
+func TestNodeExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+	c.Rules = ExtractionRules{
+		Labels: []FieldExtractionRule{{
+			Name: "zone",
+			Key:  "topology.kubernetes.io/zone",
+			From: MetadataFromNode,
+		}, {
+			KeyRegex: regexp.MustCompile("^node.kubernetes.io/"),
+			From:     MetadataFromNode,
+		}, {
+			Name: "l1",
+			Key:  "topology.kubernetes.io/zone",
+			From: MetadataFromPod,
+		}},
+	}
+
+	node := &api_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "node1",
+			UID:  "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			Labels: map[string]string{
+				"topology.kubernetes.io/zone":      "us-east-1a",
+				"node.kubernetes.io/instance-type": "m5.large",
+				"kubernetes.io/os":                 "linux",
+			},
+		},
+	}
+	c.handleNodeAdd(node)
+	got, ok := c.GetNode("node1")
+	require.True(t, ok)
+	assert.Equal(t, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", got.NodeUID)
+	assert.Equal(t, map[string]string{
+		"zone": "us-east-1a",
+		"k8s.node.labels.node.kubernetes.io/instance-type": "m5.large",
+	}, got.Attributes)
+
+	c.handleNodeDelete(node)
+	_, ok = c.GetNode("node1")
+	assert.False(t, ok)
+}
+
+func TestWorkloadExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+	c.Rules = ExtractionRules{
+		Labels: []FieldExtractionRule{{
+			Name: "k8s.deployment.labels.team",
+			Key:  "team",
+			From: MetadataFromDeployment,
+		}, {
+			Name: "k8s.statefulset.labels.team",
+			Key:  "team",
+			From: MetadataFromStatefulSet,
+		}},
+		Annotations: []FieldExtractionRule{{
+			KeyRegex: regexp.MustCompile("^owner$"),
+			From:     MetadataFromDeployment,
+		}},
+	}
+	meta := meta_v1.ObjectMeta{
+		Name:        "workload",
+		Namespace:   "ns",
+		Labels:      map[string]string{"team": "a"},
+		Annotations: map[string]string{"owner": "b"},
+	}
+
+	c.handleDeploymentAdd(&apps_v1.Deployment{ObjectMeta: meta})
+	c.handleStatefulSetAdd(&apps_v1.StatefulSet{ObjectMeta: meta})
+
+	deployment, ok := c.GetDeployment("ns", "workload")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"k8s.deployment.labels.team":       "a",
+		"k8s.deployment.annotations.owner": "b",
+	}, deployment.Attributes)
+
+	statefulSet, ok := c.GetStatefulSet("ns", "workload")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"k8s.statefulset.labels.team": "a"}, statefulSet.Attributes)
+
+	_, ok = c.GetDeployment("other", "workload")
+	assert.False(t, ok)
+
+	c.handleDeploymentDelete(&apps_v1.Deployment{ObjectMeta: meta})
+	_, ok = c.GetDeployment("ns", "workload")
+	assert.False(t, ok)
+	c.handleStatefulSetDelete(&apps_v1.StatefulSet{ObjectMeta: meta})
+	_, ok = c.GetStatefulSet("ns", "workload")
+	assert.False(t, ok)
+}
+
+func TestPodOwnerWorkload(t *testing.T) {
+	testCases := []struct {
+		name            string
+		labels          map[string]string
+		owners          []meta_v1.OwnerReference
+		wantDeployment  string
+		wantStatefulSet string
+	}{{
+		name: "no-owner",
+	}, {
+		name:           "deployment",
+		labels:         map[string]string{"pod-template-hash": "5d8f7c9b4"},
+		owners:         []meta_v1.OwnerReference{{Kind: "ReplicaSet", Name: "my-app-5d8f7c9b4"}},
+		wantDeployment: "my-app",
+	}, {
+		name:   "replicaset-without-deployment",
+		owners: []meta_v1.OwnerReference{{Kind: "ReplicaSet", Name: "my-app"}},
+	}, {
+		name:            "statefulset",
+		owners:          []meta_v1.OwnerReference{{Kind: "StatefulSet", Name: "db"}},
+		wantStatefulSet: "db",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &api_v1.Pod{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:            "pod",
+					Labels:          tc.labels,
+					OwnerReferences: tc.owners,
+				},
+			}
+			deployment, statefulSet := podOwnerWorkload(pod)
+			assert.Equal(t, tc.wantDeployment, deployment)
+			assert.Equal(t, tc.wantStatefulSet, statefulSet)
+		})
+	}
+}
+
+func TestOwnerInformersOnlyWithRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+	assert.IsType(t, &NoOpInformer{}, c.nodeInformer)
+	assert.IsType(t, &NoOpInformer{}, c.deployInformer)
+	assert.IsType(t, &NoOpInformer{}, c.stsInformer)
+
+	c, _ = newTestClientWithRulesAndFilters(t, ExtractionRules{
+		Labels: []FieldExtractionRule{{Key: "k1", From: MetadataFromNode}},
+		Annotations: []FieldExtractionRule{
+			{Key: "k2", From: MetadataFromDeployment},
+			{Key: "k3", From: MetadataFromStatefulSet},
+		},
+	}, Filters{})
+	assert.IsType(t, &FakeInformer{}, c.nodeInformer)
+	assert.IsType(t, &FakeInformer{}, c.deployInformer)
+	assert.IsType(t, &FakeInformer{}, c.stsInformer)
+}
+
 func TestFilters(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -1117,7 +1268,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, e ExtractionRules, f Filters
 			{Name: regexp.MustCompile(`jaeger-collector`)},
 		},
 	}
-	c, err := New(logger, k8sconfig.APIConfig{}, e, f, []Association{}, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer)
+	c, err := New(logger, k8sconfig.APIConfig{}, e, f, []Association{}, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeNodeInformer, NewFakeWorkloadInformer, NewFakeWorkloadInformer)
 	require.NoError(t, err)
 	return c.(*WatchClient), logs
 }
@@ -84,6 +84,27 @@ func (f *FakeNamespaceInformer) GetController() cache.Controller {
 	return f.FakeController
 }
 
+// NewFakeNodeInformer returns a fake informer for node objects.
+func NewFakeNodeInformer(
+	_ kubernetes.Interface,
+	_ string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
+// NewFakeWorkloadInformer returns a fake informer for deployment or statefulset objects.
+func NewFakeWorkloadInformer(
+	_ kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+		namespace:      namespace,
+	}
+}
+
 type FakeController struct {
 	sync.Mutex
 	stopped bool
@@ -44,6 +44,25 @@ func Test_newSharedNamespaceInformer(t *testing.T) {
 	assert.NotNil(t, informer)
 }
 
+func Test_newOwnerSharedInformers(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	assert.NotNil(t, newNodeSharedInformer(client, "node1"))
+	assert.NotNil(t, newDeploymentSharedInformer(client, "testns"))
+	assert.NotNil(t, newStatefulSetSharedInformer(client, "testns"))
+}
+
+func Test_nodeInformerListWatchFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	obj, err := nodeInformerListFunc(c, "node1")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	w, err := nodeInformerWatchFunc(c, "node1")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, w)
+}
+
 func Test_informerListFuncWithSelectors(t *testing.T) {
 	ls, fs, err := selectorsFromFilters(Filters{
 		Fields: []FieldFilter{
@@ -28,6 +28,7 @@ import (
 
 const (
 	podNodeField            = "spec.nodeName"
+	nodeNameField           = "metadata.name"
 	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
 	tagNodeName             = "k8s.node.name"
 	tagStartTime            = "k8s.pod.start_time"
@@ -35,6 +36,12 @@ const (
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
 	MetadataFromNamespace = "namespace"
+	// MetadataFromDeployment is used to specify to extract labels/annotations from the deployment owning the pod
+	MetadataFromDeployment = "deployment"
+	// MetadataFromStatefulSet is used to specify to extract labels/annotations from the statefulset owning the pod
+	MetadataFromStatefulSet = "statefulset"
+	// MetadataFromNode is used to specify to extract labels/annotations from the node the pod is running on
+	MetadataFromNode = "node"
 )
 
 // PodIdentifier is a custom type to represent IP Address or Pod UID
@@ -50,12 +57,15 @@ var (
 type Client interface {
 	GetPod(PodIdentifier) (*Pod, bool)
 	GetNamespace(string) (*Namespace, bool)
+	GetNode(string) (*Node, bool)
+	GetDeployment(namespace, name string) (*Workload, bool)
+	GetStatefulSet(namespace, name string) (*Workload, bool)
 	Start()
 	Stop()
 }
 
 // ClientProvider defines a func type that returns a new Client.
-type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformerProvider, InformerProviderNamespace) (Client, error)
+type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformerProvider, InformerProviderNamespace, InformerProviderNode, InformerProviderDeployment, InformerProviderStatefulSet) (Client, error)
 
 // APIClientsetProvider defines a func type that initializes and return a new kubernetes
 // Clientset object.
@@ -70,6 +80,11 @@ type Pod struct {
 	StartTime  *metav1.Time
 	Ignore     bool
 	Namespace  string
+	NodeName   string
+
+	// DeploymentName and StatefulSetName hold the name of the workload owning the pod, if any.
+	DeploymentName  string
+	StatefulSetName string
 
 	// Containers is a map of container name to Container struct.
 	Containers map[string]*Container
@@ -100,6 +115,21 @@ type Namespace struct {
 	DeletedAt    time.Time
 }
 
+// Node represents a kubernetes node.
+type Node struct {
+	Name       string
+	NodeUID    string
+	Attributes map[string]string
+}
+
+// Workload represents a kubernetes workload owning pods, such as a deployment or a statefulset.
+type Workload struct {
+	Name        string
+	Namespace   string
+	WorkloadUID string
+	Attributes  map[string]string
+}
+
 type deleteRequest struct {
 	// id is identifier (IP address or Pod UID) of pod to remove from pods map
 	id PodIdentifier
@@ -163,9 +193,12 @@ type FieldExtractionRule struct {
 	// Full value is extracted when no regexp is provided.
 	Regex *regexp.Regexp
 	// From determines the kubernetes object the field should be retrieved from.
-	// Currently only two values are supported,
+	// Currently the following values are supported,
 	//  - pod
 	//  - namespace
+	//  - deployment
+	//  - statefulset
+	//  - node
 	From string
 }
 
@@ -149,19 +149,14 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 		// By default if the From field is not set for labels and annotations we want to extract them from pod
 		case "", kube.MetadataFromPod:
 			a.From = kube.MetadataFromPod
-		case kube.MetadataFromNamespace:
-			a.From = kube.MetadataFromNamespace
+		case kube.MetadataFromNamespace, kube.MetadataFromDeployment, kube.MetadataFromStatefulSet, kube.MetadataFromNode:
 		default:
-			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace", a.From)
+			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, deployment, statefulset, node", a.From)
 		}
 
 		if name == "" && a.Key != "" {
 			// name for KeyRegex case is set at extraction time/runtime, skipped here
-			if a.From == kube.MetadataFromPod {
-				name = fmt.Sprintf("k8s.pod.%s.%s", fieldType, a.Key)
-			} else if a.From == kube.MetadataFromNamespace {
-				name = fmt.Sprintf("k8s.namespace.%s.%s", fieldType, a.Key)
-			}
+			name = fmt.Sprintf("k8s.%s.%s.%s", a.From, fieldType, a.Key)
 		}
 
 		var r *regexp.Regexp
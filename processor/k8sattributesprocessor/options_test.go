@@ -289,6 +289,69 @@ func TestWithExtractLabels(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-node-default-tag-name",
+			[]FieldExtractConfig{
+				{
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.node.labels.topology.kubernetes.io/zone",
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
+		{
+			"basic-deployment-default-tag-name",
+			[]FieldExtractConfig{
+				{
+					Key:  "team",
+					From: kube.MetadataFromDeployment,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.deployment.labels.team",
+					Key:  "team",
+					From: kube.MetadataFromDeployment,
+				},
+			},
+			"",
+		},
+		{
+			"basic-statefulset",
+			[]FieldExtractConfig{
+				{
+					TagName: "tag1",
+					Key:     "key1",
+					From:    kube.MetadataFromStatefulSet,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "tag1",
+					Key:  "key1",
+					From: kube.MetadataFromStatefulSet,
+				},
+			},
+			"",
+		},
+		{
+			"bad-from",
+			[]FieldExtractConfig{
+				{
+					Key:  "key1",
+					From: "replicaset",
+				},
+			},
+			[]kube.FieldExtractionRule{},
+			"replicaset is not a valid choice for From. Must be one of: pod, namespace, deployment, statefulset, node",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,6 +17,7 @@ package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetr
 import (
 	"context"
 	"net"
+	"regexp"
 	"strings"
 
 	"go.opentelemetry.io/collector/client"
@@ -26,6 +27,47 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 )
 
+// logFilePathAttributeName is the attribute the filelog receiver records the path of the tailed file in.
+const logFilePathAttributeName = "log.file.path"
+
+// podLogFilePathRegex matches the path kubelet writes container logs to:
+// /var/log/pods/<namespace>_<pod_name>_<pod_uid>/<container_name>/<restart_count>.log
+var podLogFilePathRegex = regexp.MustCompile(`^(?:.*/)?([^_/]+)_([^_/]+)_([^_/]+)/([^/]+)/([0-9]+)\.log$`)
+
+// logFilePathInfo holds the pod and container identifiers encoded in a pod log file path.
+type logFilePathInfo struct {
+	namespace     string
+	podName       string
+	podUID        string
+	containerName string
+	restartCount  string
+}
+
+// parseLogFilePath extracts the pod and container identifiers from a pod log file path.
+func parseLogFilePath(path string) (logFilePathInfo, bool) {
+	parts := podLogFilePathRegex.FindStringSubmatch(path)
+	if len(parts) != 6 {
+		return logFilePathInfo{}, false
+	}
+	return logFilePathInfo{
+		namespace:     parts[1],
+		podName:       parts[2],
+		podUID:        parts[3],
+		containerName: parts[4],
+		restartCount:  parts[5],
+	}, true
+}
+
+// hasLogFilePathAssociation checks if pods are associated by the log file path.
+func hasLogFilePathAssociation(associations []kube.Association) bool {
+	for _, asso := range associations {
+		if asso.From == "resource_attribute" && asso.Name == logFilePathAttributeName {
+			return true
+		}
+	}
+	return false
+}
+
 // extractPodIds extracts IP and pod UID from attributes or request context.
 // It returns a value pair containing configured label and IP Address and/or Pod UID.
 // If empty value in return it means that attributes does not contains configured label to match resources for Pod.
@@ -44,13 +86,19 @@ func extractPodID(ctx context.Context, attrs pdata.AttributeMap, associations []
 			return k8sIPLabelName, connectionIP
 		case asso.From == "resource_attribute":
 			// If association configured by resource_attribute
-			// In k8s environment, host.name label set to a pod IP address.
-			// If the value doesn't represent an IP address, we skip it.
-			if asso.Name == conventions.AttributeHostName {
+			switch asso.Name {
+			case conventions.AttributeHostName:
+				// In k8s environment, host.name label set to a pod IP address.
+				// If the value doesn't represent an IP address, we skip it.
 				if net.ParseIP(hostname) != nil {
 					return k8sIPLabelName, kube.PodIdentifier(hostname)
 				}
-			} else {
+			case logFilePathAttributeName:
+				// The log file path written by kubelet contains the pod UID.
+				if info, ok := parseLogFilePath(stringAttributeFromMap(attrs, logFilePathAttributeName)); ok {
+					return conventions.AttributeK8SPodUID, kube.PodIdentifier(info.podUID)
+				}
+			default:
 				// Extract values based on configured resource_attribute.
 				attributeValue := stringAttributeFromMap(attrs, asso.Name)
 				if attributeValue != "" {
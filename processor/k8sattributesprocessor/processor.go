@@ -49,7 +49,7 @@ func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kub
 		kubeClient = kube.New
 	}
 	if !kp.passthroughMode {
-		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, nil, nil)
+		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, nil, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -95,7 +95,15 @@ func (kp *kubernetesprocessor) processMetrics(ctx context.Context, md pdata.Metr
 // processLogs process logs and add k8s metadata using resource IP, hostname or incoming IP as pod origin.
 func (kp *kubernetesprocessor) processLogs(ctx context.Context, ld pdata.Logs) (pdata.Logs, error) {
 	rl := ld.ResourceLogs()
+	logFilePathAssociation := hasLogFilePathAssociation(kp.podAssociations)
+	if logFilePathAssociation {
+		splitResourceLogsByFilePath(rl)
+	}
 	for i := 0; i < rl.Len(); i++ {
+		if logFilePathAssociation {
+			kp.processResourceWithRecordsFilePath(ctx, rl.At(i))
+			continue
+		}
 		kp.processResource(ctx, rl.At(i).Resource())
 	}
 
@@ -108,6 +116,9 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pda
 	if podIdentifierKey != "" {
 		resource.Attributes().InsertString(podIdentifierKey, string(podIdentifierValue))
 	}
+	if hasLogFilePathAssociation(kp.podAssociations) {
+		addLogFilePathAttributes(resource.Attributes())
+	}
 
 	if kp.passthroughMode {
 		return
@@ -119,6 +130,7 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pda
 				resource.Attributes().InsertString(key, val)
 			}
 			kp.addContainerAttributes(resource.Attributes(), pod)
+			kp.addPodOwnerAttributes(resource.Attributes(), pod)
 		}
 	}
 
@@ -162,6 +174,121 @@ func (kp *kubernetesprocessor) addContainerAttributes(attrs pdata.AttributeMap,
 	}
 }
 
+// addPodOwnerAttributes adds the attributes extracted from the node the pod is running on
+// and from the deployment or statefulset owning the pod.
+func (kp *kubernetesprocessor) addPodOwnerAttributes(attrs pdata.AttributeMap, pod *kube.Pod) {
+	if pod.NodeName != "" {
+		if node, ok := kp.kc.GetNode(pod.NodeName); ok {
+			insertAttributes(attrs, node.Attributes)
+		}
+	}
+	if pod.DeploymentName != "" {
+		if deployment, ok := kp.kc.GetDeployment(pod.Namespace, pod.DeploymentName); ok {
+			insertAttributes(attrs, deployment.Attributes)
+		}
+	}
+	if pod.StatefulSetName != "" {
+		if statefulSet, ok := kp.kc.GetStatefulSet(pod.Namespace, pod.StatefulSetName); ok {
+			insertAttributes(attrs, statefulSet.Attributes)
+		}
+	}
+}
+
+func insertAttributes(attrs pdata.AttributeMap, values map[string]string) {
+	for key, val := range values {
+		attrs.InsertString(key, val)
+	}
+}
+
+// addLogFilePathAttributes adds the container identifiers encoded in the pod log file path,
+// so that the container attributes can be looked up for logs tailed from the node.
+func addLogFilePathAttributes(attrs pdata.AttributeMap) {
+	info, ok := parseLogFilePath(stringAttributeFromMap(attrs, logFilePathAttributeName))
+	if !ok {
+		return
+	}
+	attrs.InsertString(conventions.AttributeK8SContainerName, info.containerName)
+	attrs.InsertString(conventions.AttributeK8SContainerRestartCount, info.restartCount)
+}
+
+// processResourceWithRecordsFilePath processes the resource of the logs using the log file path of
+// their records when the resource doesn't have one. The path is not left on the resource.
+func (kp *kubernetesprocessor) processResourceWithRecordsFilePath(ctx context.Context, rl pdata.ResourceLogs) {
+	attrs := rl.Resource().Attributes()
+	if _, ok := attrs.Get(logFilePathAttributeName); !ok {
+		if paths := recordsLogFilePaths(rl); len(paths) == 1 && paths[0] != "" {
+			attrs.InsertString(logFilePathAttributeName, paths[0])
+			defer attrs.Delete(logFilePathAttributeName)
+		}
+	}
+	kp.processResource(ctx, rl.Resource())
+}
+
+// splitResourceLogsByFilePath moves the records of the resources without a log file path, which were
+// read from different files, to a copy of their resource per file, as the filelog receiver puts the
+// records of all the files under a single resource. The records without a path stay together.
+func splitResourceLogsByFilePath(rls pdata.ResourceLogsSlice) {
+	for i, n := 0, rls.Len(); i < n; i++ {
+		rl := rls.At(i)
+		if _, ok := rl.Resource().Attributes().Get(logFilePathAttributeName); ok {
+			continue
+		}
+		paths := recordsLogFilePaths(rl)
+		for j := 1; j < len(paths); j++ {
+			pathRl := rls.AppendEmpty()
+			rl.Resource().CopyTo(pathRl.Resource())
+			pathRl.SetSchemaUrl(rl.SchemaUrl())
+			moveRecordsWithFilePath(rl, pathRl, paths[j])
+		}
+	}
+}
+
+// recordsLogFilePaths returns the distinct log file paths of the records, in the order they are
+// first seen, an empty path standing for the records without one.
+func recordsLogFilePaths(rl pdata.ResourceLogs) []string {
+	var paths []string
+	seen := map[string]bool{}
+	ills := rl.InstrumentationLibraryLogs()
+	for i := 0; i < ills.Len(); i++ {
+		logs := ills.At(i).LogRecords()
+		for j := 0; j < logs.Len(); j++ {
+			path := stringAttributeFromMap(logs.At(j).Attributes(), logFilePathAttributeName)
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// moveRecordsWithFilePath moves the records read from the given file to the destination,
+// keeping their instrumentation library, and drops the instrumentation libraries left empty.
+func moveRecordsWithFilePath(src, dest pdata.ResourceLogs, path string) {
+	ills := src.InstrumentationLibraryLogs()
+	ills.RemoveIf(func(ill pdata.InstrumentationLibraryLogs) bool {
+		if ill.LogRecords().Len() == 0 {
+			return false
+		}
+		var destIll pdata.InstrumentationLibraryLogs
+		moved := false
+		ill.LogRecords().RemoveIf(func(lr pdata.LogRecord) bool {
+			if stringAttributeFromMap(lr.Attributes(), logFilePathAttributeName) != path {
+				return false
+			}
+			if !moved {
+				destIll = dest.InstrumentationLibraryLogs().AppendEmpty()
+				ill.InstrumentationLibrary().CopyTo(destIll.InstrumentationLibrary())
+				destIll.SetSchemaUrl(ill.SchemaUrl())
+				moved = true
+			}
+			lr.CopyTo(destIll.LogRecords().AppendEmpty())
+			return true
+		})
+		return ill.LogRecords().Len() == 0
+	})
+}
+
 func (kp *kubernetesprocessor) getAttributesForPodsNamespace(namespace string) map[string]string {
 	ns, ok := kp.kc.GetNamespace(namespace)
 	if !ok {
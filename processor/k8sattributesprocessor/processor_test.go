@@ -218,7 +218,7 @@ func TestProcessorBadConfig(t *testing.T) {
 }
 
 func TestProcessorBadClientProvider(t *testing.T) {
-	clientProvider := func(_ *zap.Logger, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace) (kube.Client, error) {
+	clientProvider := func(_ *zap.Logger, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace, _ kube.InformerProviderNode, _ kube.InformerProviderDeployment, _ kube.InformerProviderStatefulSet) (kube.Client, error) {
 		return nil, fmt.Errorf("bad client error")
 	}
 
@@ -296,6 +296,12 @@ func withContainerRunID(containerRunID string) generateResourceFunc {
 	}
 }
 
+func withLogFilePath(path string) generateResourceFunc {
+	return func(res pdata.Resource) {
+		res.Attributes().InsertString(logFilePathAttributeName, path)
+	}
+}
+
 type strAddr string
 
 func (s strAddr) String() string {
@@ -700,6 +706,74 @@ func TestProcessorAddLabels(t *testing.T) {
 	}
 }
 
+func TestProcessorAddPodOwnerAttributes(t *testing.T) {
+	m := newMultiTest(
+		t,
+		NewFactory().CreateDefaultConfig(),
+		nil,
+	)
+
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				From: "resource_attribute",
+				Name: "k8s.pod.uid",
+			},
+		}
+		kp.kc.(*fakeClient).Pods["ef10d10b-2da5-4030-812e-5f45c1531227"] = &kube.Pod{
+			Name:           "app-6d8b5c8f7d-x2k4z",
+			Namespace:      "default",
+			NodeName:       "node1",
+			DeploymentName: "app",
+			Attributes: map[string]string{
+				"k8s.pod.labels.team": "pod-team",
+			},
+		}
+		kp.kc.(*fakeClient).Nodes["node1"] = &kube.Node{
+			Name: "node1",
+			Attributes: map[string]string{
+				"k8s.node.labels.topology.kubernetes.io/zone": "us-east-1a",
+			},
+		}
+		kp.kc.(*fakeClient).Deployments["default/app"] = &kube.Workload{
+			Name:      "app",
+			Namespace: "default",
+			Attributes: map[string]string{
+				"k8s.deployment.labels.team": "deployment-team",
+				// Pod attributes take precedence over the workload ones.
+				"k8s.pod.labels.team": "deployment-team",
+			},
+		}
+		kp.kc.(*fakeClient).StatefulSets["default/app"] = &kube.Workload{
+			Name:      "app",
+			Namespace: "default",
+			Attributes: map[string]string{
+				"k8s.statefulset.labels.team": "statefulset-team",
+			},
+		}
+	})
+
+	m.testConsume(context.Background(),
+		generateTraces(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		generateMetrics(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		generateLogs(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227")),
+		nil)
+
+	m.assertBatchesLen(1)
+	m.assertResource(0, func(r pdata.Resource) {
+		wantAttrs := map[string]string{
+			"k8s.pod.uid":         "ef10d10b-2da5-4030-812e-5f45c1531227",
+			"k8s.pod.labels.team": "pod-team",
+			"k8s.node.labels.topology.kubernetes.io/zone": "us-east-1a",
+			"k8s.deployment.labels.team":                  "deployment-team",
+		}
+		require.Equal(t, len(wantAttrs), r.Attributes().Len())
+		for k, v := range wantAttrs {
+			assertResourceHasStringAttribute(t, r, k, v)
+		}
+	})
+}
+
 func TestProcessorAddContainerAttributes(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -840,6 +914,158 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 	}
 }
 
+func TestProcessorLogFilePathAssociation(t *testing.T) {
+	m := newMultiTest(
+		t,
+		NewFactory().CreateDefaultConfig(),
+		nil,
+	)
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				From: "resource_attribute",
+				Name: logFilePathAttributeName,
+			},
+		}
+		kp.kc.(*fakeClient).Pods[kube.PodIdentifier("19f651bc-73e4-410f-b3e9-f0241679d3b8")] = &kube.Pod{
+			Attributes: map[string]string{
+				conventions.AttributeK8SPodName: "app-6d8b5c8f7d-x2k4z",
+			},
+			Containers: map[string]*kube.Container{
+				"app": {
+					ImageName: "test/app",
+					ImageTag:  "1.0.1",
+					Statuses: map[int]kube.ContainerStatus{
+						2: {ContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"},
+					},
+				},
+			},
+		}
+	})
+
+	path := "/var/log/pods/default_app-6d8b5c8f7d-x2k4z_19f651bc-73e4-410f-b3e9-f0241679d3b8/app/2.log"
+	m.testConsume(context.Background(),
+		generateTraces(withLogFilePath(path)),
+		generateMetrics(withLogFilePath(path)),
+		generateLogs(withLogFilePath(path)),
+		nil,
+	)
+
+	m.assertBatchesLen(1)
+	m.assertResource(0, func(r pdata.Resource) {
+		wantAttrs := map[string]string{
+			logFilePathAttributeName:                      path,
+			conventions.AttributeK8SPodUID:                "19f651bc-73e4-410f-b3e9-f0241679d3b8",
+			conventions.AttributeK8SPodName:               "app-6d8b5c8f7d-x2k4z",
+			conventions.AttributeK8SContainerName:         "app",
+			conventions.AttributeK8SContainerRestartCount: "2",
+			conventions.AttributeContainerImageName:       "test/app",
+			conventions.AttributeContainerImageTag:        "1.0.1",
+			conventions.AttributeContainerID:              "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e",
+		}
+		require.Equal(t, len(wantAttrs), r.Attributes().Len())
+		for k, v := range wantAttrs {
+			assertResourceHasStringAttribute(t, r, k, v)
+		}
+	})
+}
+
+func TestLogsProcessorLogFilePathFromRecords(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	var kp *kubernetesprocessor
+	p, err := newLogsProcessor(NewFactory().CreateDefaultConfig(), next, withExtractKubernetesProcessorInto(&kp))
+	require.NoError(t, err)
+
+	kp.podAssociations = []kube.Association{
+		{
+			From: "resource_attribute",
+			Name: logFilePathAttributeName,
+		},
+	}
+	kp.kc.(*fakeClient).Pods[kube.PodIdentifier("19f651bc-73e4-410f-b3e9-f0241679d3b8")] = &kube.Pod{
+		Containers: map[string]*kube.Container{
+			"app": {
+				ImageName: "test/app",
+			},
+		},
+	}
+	kp.kc.(*fakeClient).Pods[kube.PodIdentifier("5c1b0a3e-9f7d-4c8e-8a55-0e2f6d4b7c11")] = &kube.Pod{
+		Containers: map[string]*kube.Container{
+			"db": {
+				ImageName: "test/db",
+			},
+		},
+	}
+
+	path := "/var/log/pods/default_app-6d8b5c8f7d-x2k4z_19f651bc-73e4-410f-b3e9-f0241679d3b8/app/0.log"
+	otherPath := "/var/log/pods/default_db-0_5c1b0a3e-9f7d-4c8e-8a55-0e2f6d4b7c11/db/0.log"
+
+	// The filelog receiver puts the records of all the tailed files under a single resource.
+	logs := pdata.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("host.name", "node-1")
+	ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+	ill.InstrumentationLibrary().SetName("filelog")
+	records := ill.LogRecords()
+	for _, recordPath := range []string{path, otherPath, path, ""} {
+		record := records.AppendEmpty()
+		record.SetName(recordPath)
+		if recordPath != "" {
+			record.Attributes().InsertString(logFilePathAttributeName, recordPath)
+		}
+	}
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), logs))
+	require.Len(t, next.AllLogs(), 1)
+	rls := next.AllLogs()[0].ResourceLogs()
+	require.Equal(t, 3, rls.Len())
+
+	expected := []struct {
+		records []string
+		attrs   map[string]interface{}
+	}{
+		{
+			records: []string{path, path},
+			attrs: map[string]interface{}{
+				"host.name":                                   "node-1",
+				conventions.AttributeK8SPodUID:                "19f651bc-73e4-410f-b3e9-f0241679d3b8",
+				conventions.AttributeK8SContainerName:         "app",
+				conventions.AttributeK8SContainerRestartCount: "0",
+				conventions.AttributeContainerImageName:       "test/app",
+			},
+		},
+		{
+			records: []string{otherPath},
+			attrs: map[string]interface{}{
+				"host.name":                                   "node-1",
+				conventions.AttributeK8SPodUID:                "5c1b0a3e-9f7d-4c8e-8a55-0e2f6d4b7c11",
+				conventions.AttributeK8SContainerName:         "db",
+				conventions.AttributeK8SContainerRestartCount: "0",
+				conventions.AttributeContainerImageName:       "test/db",
+			},
+		},
+		{
+			// Records without a path can't be associated with a pod.
+			records: []string{""},
+			attrs:   map[string]interface{}{"host.name": "node-1"},
+		},
+	}
+	for i, e := range expected {
+		rl := rls.At(i)
+		// The log file path is not left on the resource.
+		assert.Equal(t, e.attrs, rl.Resource().Attributes().AsRaw())
+
+		require.Equal(t, 1, rl.InstrumentationLibraryLogs().Len())
+		ill := rl.InstrumentationLibraryLogs().At(0)
+		assert.Equal(t, "filelog", ill.InstrumentationLibrary().Name())
+		var names []string
+		for j := 0; j < ill.LogRecords().Len(); j++ {
+			names = append(names, ill.LogRecords().At(j).Name())
+		}
+		assert.Equal(t, e.records, names)
+	}
+}
+
 func TestProcessorPicksUpPassthoughPodIp(t *testing.T) {
 	m := newMultiTest(
 		t,
@@ -1141,3 +1367,44 @@ func Test_intFromAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLogFilePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want logFilePathInfo
+		ok   bool
+	}{
+		{
+			name: "pod-log",
+			path: "/var/log/pods/kube-system_coredns-78fcd69978-kn2f6_b4b7a5f0-6c1e-4e1b-9d2c-3b2b1f0e7c55/coredns/3.log",
+			want: logFilePathInfo{
+				namespace:     "kube-system",
+				podName:       "coredns-78fcd69978-kn2f6",
+				podUID:        "b4b7a5f0-6c1e-4e1b-9d2c-3b2b1f0e7c55",
+				containerName: "coredns",
+				restartCount:  "3",
+			},
+			ok: true,
+		},
+		{
+			name: "container-symlink",
+			path: "/var/log/containers/coredns-78fcd69978-kn2f6_kube-system_coredns-1a2b3c.log",
+		},
+		{
+			name: "rotated-log",
+			path: "/var/log/pods/default_app_b4b7a5f0-6c1e-4e1b-9d2c-3b2b1f0e7c55/app/0.log.20220301-101010",
+		},
+		{
+			name: "empty",
+			path: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseLogFilePath(tt.path)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
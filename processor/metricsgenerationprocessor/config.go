@@ -17,6 +17,9 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 )
@@ -39,6 +42,21 @@ const (
 
 	// operationFieldName is the mapstructure field name for Operation field
 	operationFieldName = "operation"
+
+	// attributeFieldName is the mapstructure field name for Attribute field
+	attributeFieldName = "attribute"
+
+	// unitConversionsFieldName is the mapstructure field name for UnitConversions field
+	unitConversionsFieldName = "unit_conversions"
+
+	// maxStalenessFieldName is the mapstructure field name for MaxStaleness field
+	maxStalenessFieldName = "max_staleness"
+
+	// onMissingOperandFieldName is the mapstructure field name for OnMissingOperand field
+	onMissingOperandFieldName = "on_missing_operand"
+
+	// onZeroDenominatorFieldName is the mapstructure field name for OnZeroDenominator field
+	onZeroDenominatorFieldName = "on_zero_denominator"
 )
 
 // Config defines the configuration for the processor.
@@ -47,6 +65,21 @@ type Config struct {
 
 	// Set of rules for generating new metrics
 	Rules []Rule `mapstructure:"rules"`
+
+	// Conversion factors used to align the second operand with the unit of the first operand
+	// when the two metrics of a calculate rule are reported in different units.
+	UnitConversions []UnitConversion `mapstructure:"unit_conversions"`
+
+	// Duration after which the last point kept for a series by the rate and delta rules is dropped when
+	// no new point of that series is received. A point received after that duration starts the series over.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
+}
+
+// UnitConversion defines the factor by which a value in the From unit is multiplied to express it in the To unit.
+type UnitConversion struct {
+	From   string  `mapstructure:"from"`
+	To     string  `mapstructure:"to"`
+	Factor float64 `mapstructure:"factor"`
 }
 
 type Rule struct {
@@ -70,6 +103,17 @@ type Rule struct {
 
 	// A constant number by which the first operand will be scaled. A required field if the type is scale.
 	ScaleBy float64 `mapstructure:"scale_by"`
+
+	// Data point attribute of the first operand holding the value of the new metric. A required field if the type is attribute.
+	Attribute string `mapstructure:"attribute"`
+
+	// How a calculate rule handles the data points of the first operand without a matching second operand:
+	// "skip" (the default) drops them, "zero" uses 0 as second operand and "default(<value>)" uses the given value.
+	OnMissingOperand string `mapstructure:"on_missing_operand"`
+
+	// How a calculate rule with the divide or percent operation handles a second operand equal to 0:
+	// "skip" (the default) drops the data point, "zero" generates 0 and "nan" generates NaN.
+	OnZeroDenominator ZeroDenominatorPolicy `mapstructure:"on_zero_denominator"`
 }
 
 type GenerationType string
@@ -81,9 +125,18 @@ const (
 
 	// Generates a new metric scaling the value of s given metric with a provided constant
 	scale GenerationType = "scale"
+
+	// Generates a new metric from the numeric value of a data point attribute of a given metric
+	attribute GenerationType = "attribute"
+
+	// Generates a new gauge metric holding the per-second rate of change of a given cumulative sum metric
+	rate GenerationType = "rate"
+
+	// Generates a new delta sum metric holding the increase of a given cumulative sum metric between two points
+	delta GenerationType = "delta"
 )
 
-var generationTypes = map[GenerationType]struct{}{calculate: {}, scale: {}}
+var generationTypes = map[GenerationType]struct{}{calculate: {}, scale: {}, attribute: {}, rate: {}, delta: {}}
 
 func (gt GenerationType) isValid() bool {
 	_, ok := generationTypes[gt]
@@ -145,6 +198,67 @@ var operationTypeKeys = func() []string {
 	return ret
 }
 
+const (
+	// Drops the data points without a matching second operand
+	missingOperandSkip = "skip"
+
+	// Uses 0 as the missing second operand
+	missingOperandZero = "zero"
+
+	// Prefix of the policy using the given value as the missing second operand, e.g. "default(1)"
+	missingOperandDefaultPrefix = "default("
+)
+
+// parseMissingOperandPolicy returns whether a missing second operand is replaced, and by which value.
+func parseMissingOperandPolicy(policy string) (replace bool, value float64, err error) {
+	switch {
+	case policy == "" || policy == missingOperandSkip:
+		return false, 0, nil
+	case policy == missingOperandZero:
+		return true, 0, nil
+	case strings.HasPrefix(policy, missingOperandDefaultPrefix) && strings.HasSuffix(policy, ")"):
+		value, err = strconv.ParseFloat(strings.TrimSpace(policy[len(missingOperandDefaultPrefix):len(policy)-1]), 64)
+		if err == nil {
+			return true, value, nil
+		}
+	}
+	return false, 0, fmt.Errorf("%q must be one of \"skip\", \"zero\" or \"default(<value>)\"", onMissingOperandFieldName)
+}
+
+type ZeroDenominatorPolicy string
+
+const (
+
+	// Drops the data points whose second operand is 0
+	zeroDenominatorSkip ZeroDenominatorPolicy = "skip"
+
+	// Generates 0 for the data points whose second operand is 0
+	zeroDenominatorZero ZeroDenominatorPolicy = "zero"
+
+	// Generates NaN for the data points whose second operand is 0
+	zeroDenominatorNaN ZeroDenominatorPolicy = "nan"
+)
+
+var zeroDenominatorPolicies = map[ZeroDenominatorPolicy]struct{}{
+	zeroDenominatorSkip: {},
+	zeroDenominatorZero: {},
+	zeroDenominatorNaN:  {},
+}
+
+func (zdp ZeroDenominatorPolicy) isValid() bool {
+	_, ok := zeroDenominatorPolicies[zdp]
+	return ok
+}
+
+var zeroDenominatorPolicyKeys = func() []string {
+	ret := make([]string, 0, len(zeroDenominatorPolicies))
+	for k := range zeroDenominatorPolicies {
+		ret = append(ret, string(k))
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 // Validate checks whether the input configuration has all of the required fields for the processor.
 // An error is returned if there are any invalid inputs.
 func (config *Config) Validate() error {
@@ -173,9 +287,35 @@ func (config *Config) Validate() error {
 			return fmt.Errorf("field %q required to be greater than 0 for generation type %q", scaleByFieldName, scale)
 		}
 
+		if rule.Type == attribute && rule.Attribute == "" {
+			return fmt.Errorf("missing required field %q for generation type %q", attributeFieldName, attribute)
+		}
+
 		if rule.Operation != "" && !rule.Operation.isValid() {
 			return fmt.Errorf("%q must be in %q", operationFieldName, operationTypeKeys())
 		}
+
+		if _, _, err := parseMissingOperandPolicy(rule.OnMissingOperand); err != nil {
+			return err
+		}
+
+		if rule.OnZeroDenominator != "" && !rule.OnZeroDenominator.isValid() {
+			return fmt.Errorf("%q must be in %q", onZeroDenominatorFieldName, zeroDenominatorPolicyKeys())
+		}
+	}
+
+	if config.MaxStaleness < 0 {
+		return fmt.Errorf("field %q must not be negative", maxStalenessFieldName)
+	}
+
+	for _, conversion := range config.UnitConversions {
+		if conversion.From == "" || conversion.To == "" {
+			return fmt.Errorf("fields \"from\" and \"to\" are required for each entry of %q", unitConversionsFieldName)
+		}
+
+		if conversion.Factor <= 0 {
+			return fmt.Errorf("field \"factor\" required to be greater than 0 for each entry of %q", unitConversionsFieldName)
+		}
 	}
 	return nil
 }
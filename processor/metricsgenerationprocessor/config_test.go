@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,9 @@ func TestLoadingFullConfig(t *testing.T) {
 						Metric1:   "metric1",
 						Metric2:   "metric2",
 						Operation: "percent",
+
+						OnMissingOperand:  "default(100)",
+						OnZeroDenominator: "nan",
 					},
 					{
 						Name:      "new_metric",
@@ -52,7 +56,27 @@ func TestLoadingFullConfig(t *testing.T) {
 						ScaleBy:   1000,
 						Operation: "multiply",
 					},
+					{
+						Name:      "new_metric",
+						Unit:      "unit",
+						Type:      "attribute",
+						Metric1:   "metric1",
+						Attribute: "limit",
+					},
+					{
+						Name:    "new_metric",
+						Type:    "rate",
+						Metric1: "metric1",
+					},
+				},
+				UnitConversions: []UnitConversion{
+					{
+						From:   "MiBy",
+						To:     "By",
+						Factor: 1048576,
+					},
 				},
+				MaxStaleness: 10 * time.Minute,
 			},
 		},
 	}
@@ -74,6 +98,36 @@ func TestLoadingFullConfig(t *testing.T) {
 	}
 }
 
+func TestParseMissingOperandPolicy(t *testing.T) {
+	tests := []struct {
+		policy  string
+		replace bool
+		value   float64
+		wantErr bool
+	}{
+		{policy: ""},
+		{policy: "skip"},
+		{policy: "zero", replace: true},
+		{policy: "default(1.5)", replace: true, value: 1.5},
+		{policy: "default( -2 )", replace: true, value: -2},
+		{policy: "default()", wantErr: true},
+		{policy: "default(1", wantErr: true},
+		{policy: "nan", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.policy, func(t *testing.T) {
+			replace, value, err := parseMissingOperandPolicy(test.policy)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.replace, replace)
+			assert.Equal(t, test.value, value)
+		})
+	}
+}
+
 func TestValidateConfig(t *testing.T) {
 	tests := []struct {
 		configName   string
@@ -114,11 +168,36 @@ func TestValidateConfig(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("field %q required to be greater than 0 for generation type %q", scaleByFieldName, scale),
 		},
+		{
+			configName:   "config_missing_attribute.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("missing required field %q for generation type %q", attributeFieldName, attribute),
+		},
+		{
+			configName:   "config_invalid_unit_conversion_factor.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("field \"factor\" required to be greater than 0 for each entry of %q", unitConversionsFieldName),
+		},
+		{
+			configName:   "config_invalid_max_staleness.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("field %q must not be negative", maxStalenessFieldName),
+		},
 		{
 			configName:   "config_invalid_operation.yaml",
 			succeed:      false,
 			errorMessage: fmt.Sprintf("%q must be in %q", operationFieldName, operationTypeKeys()),
 		},
+		{
+			configName:   "config_invalid_on_missing_operand.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("%q must be one of \"skip\", \"zero\" or \"default(<value>)\"", onMissingOperandFieldName),
+		},
+		{
+			configName:   "config_invalid_on_zero_denominator.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("%q must be in %q", onZeroDenominatorFieldName, zeroDenominatorPolicyKeys()),
+		},
 	}
 
 	for _, test := range tests {
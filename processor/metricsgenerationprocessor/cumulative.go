@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsgenerationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// cumulativeState keeps the last point received for each series of the cumulative sums used by
+// the rate and delta rules, so that they can be derived across batches.
+type cumulativeState struct {
+	sync.Mutex
+	points       map[string]cumulativePoint
+	maxStaleness time.Duration
+	lastExpiry   time.Time
+	now          func() time.Time
+}
+
+type cumulativePoint struct {
+	value          float64
+	startTimestamp pdata.Timestamp
+	timestamp      pdata.Timestamp
+	lastSeen       time.Time
+}
+
+func newCumulativeState(maxStaleness time.Duration) *cumulativeState {
+	return &cumulativeState{
+		points:       make(map[string]cumulativePoint),
+		maxStaleness: maxStaleness,
+		lastExpiry:   time.Now(),
+		now:          time.Now,
+	}
+}
+
+// update stores the given data point as the last point of the series identified by key and returns the
+// point it replaces. No previous point is returned for a new or stale series. Data points older than the
+// last point of their series are ignored.
+func (s *cumulativeState) update(key string, dataPoint pdata.NumberDataPoint) (cumulativePoint, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.now()
+	prev, ok := s.points[key]
+	if ok && s.maxStaleness > 0 && now.Sub(prev.lastSeen) > s.maxStaleness {
+		ok = false
+	}
+	if ok && dataPoint.Timestamp() <= prev.timestamp {
+		return cumulativePoint{}, false
+	}
+
+	s.points[key] = cumulativePoint{
+		value:          getDataPointValue(dataPoint),
+		startTimestamp: dataPoint.StartTimestamp(),
+		timestamp:      dataPoint.Timestamp(),
+		lastSeen:       now,
+	}
+	return prev, ok
+}
+
+// expire drops the series which did not receive any point for longer than the maximum staleness.
+// The series are scanned at most once per maximum staleness interval.
+func (s *cumulativeState) expire() {
+	if s.maxStaleness <= 0 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastExpiry) < s.maxStaleness {
+		return
+	}
+	for key, point := range s.points {
+		if now.Sub(point.lastSeen) > s.maxStaleness {
+			delete(s.points, key)
+		}
+	}
+	s.lastExpiry = now
+}
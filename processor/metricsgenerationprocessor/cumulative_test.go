@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsgenerationprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestCumulativeState(t *testing.T) {
+	now := time.Now()
+	state := newCumulativeState(time.Minute)
+	state.now = func() time.Time { return now }
+
+	dataPoint := func(ts time.Duration, value float64) pdata.NumberDataPoint {
+		dp := pdata.NewNumberDataPoint()
+		dp.SetTimestamp(pdata.Timestamp(ts))
+		dp.SetDoubleVal(value)
+		return dp
+	}
+
+	_, ok := state.update("series", dataPoint(10*time.Second, 1))
+	assert.False(t, ok, "a new series has no previous point")
+
+	prev, ok := state.update("series", dataPoint(20*time.Second, 2))
+	require.True(t, ok)
+	assert.Equal(t, 1.0, prev.value)
+	assert.Equal(t, pdata.Timestamp(10*time.Second), prev.timestamp)
+
+	_, ok = state.update("series", dataPoint(15*time.Second, 3))
+	assert.False(t, ok, "an out of order point must be ignored")
+
+	prev, ok = state.update("series", dataPoint(30*time.Second, 4))
+	require.True(t, ok)
+	assert.Equal(t, 2.0, prev.value)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = state.update("series", dataPoint(40*time.Second, 5))
+	assert.False(t, ok, "a stale series must start over")
+
+	_, ok = state.update("other", dataPoint(40*time.Second, 5))
+	assert.False(t, ok)
+	now = now.Add(2 * time.Minute)
+	_, ok = state.update("other", dataPoint(50*time.Second, 6))
+	assert.False(t, ok)
+	state.expire()
+	assert.Len(t, state.points, 1)
+	assert.Contains(t, state.points, "other")
+}
@@ -17,7 +17,10 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
@@ -27,12 +30,22 @@ import (
 const (
 	// The value of "type" key in configuration.
 	typeStr = "experimental_metricsgeneration"
+
+	// The default duration after which the series tracked by the rate and delta rules expire.
+	defaultMaxStaleness = 5 * time.Minute
 )
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
+var once sync.Once
+
 // NewFactory returns a new factory for the Metrics Generation processor.
 func NewFactory() component.ProcessorFactory {
+	once.Do(func() {
+		// TODO: as with other -contrib factories registering metrics, this is causing the error being ignored
+		_ = view.Register(MetricViews()...)
+	})
+
 	return processorhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
@@ -42,6 +55,7 @@ func NewFactory() component.ProcessorFactory {
 func createDefaultConfig() config.Processor {
 	return &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		MaxStaleness:      defaultMaxStaleness,
 	}
 }
 
@@ -57,7 +71,12 @@ func createMetricsProcessor(
 	}
 
 	processorConfig.Validate()
-	metricsProcessor := newMetricsGenerationProcessor(buildInternalConfig(processorConfig), params.Logger)
+	metricsProcessor := newMetricsGenerationProcessor(
+		buildInternalConfig(processorConfig),
+		buildUnitConversions(processorConfig),
+		processorConfig.MaxStaleness,
+		params.Logger,
+	)
 
 	return processorhelper.NewMetricsProcessor(
 		cfg,
@@ -71,6 +90,11 @@ func buildInternalConfig(config *Config) []internalRule {
 	internalRules := make([]internalRule, len(config.Rules))
 
 	for i, rule := range config.Rules {
+		replaceMissingOperand, missingOperandValue, _ := parseMissingOperandPolicy(rule.OnMissingOperand)
+		zeroDenominator := rule.OnZeroDenominator
+		if zeroDenominator == "" {
+			zeroDenominator = zeroDenominatorSkip
+		}
 		customRule := internalRule{
 			name:      rule.Name,
 			unit:      rule.Unit,
@@ -79,8 +103,30 @@ func buildInternalConfig(config *Config) []internalRule {
 			metric2:   rule.Metric2,
 			operation: string(rule.Operation),
 			scaleBy:   rule.ScaleBy,
+			attribute: rule.Attribute,
+
+			replaceMissingOperand: replaceMissingOperand,
+			missingOperandValue:   missingOperandValue,
+			zeroDenominator:       string(zeroDenominator),
 		}
 		internalRules[i] = customRule
 	}
 	return internalRules
 }
+
+// buildUnitConversions constructs the lookup of conversion factors between two units. The inverse
+// of every configured conversion is added unless it is configured explicitly.
+func buildUnitConversions(config *Config) map[unitPair]float64 {
+	conversions := make(map[unitPair]float64, 2*len(config.UnitConversions))
+
+	for _, conversion := range config.UnitConversions {
+		conversions[unitPair{from: conversion.From, to: conversion.To}] = conversion.Factor
+	}
+	for _, conversion := range config.UnitConversions {
+		inverse := unitPair{from: conversion.To, to: conversion.From}
+		if _, ok := conversions[inverse]; !ok {
+			conversions[inverse] = 1 / conversion.Factor
+		}
+	}
+	return conversions
+}
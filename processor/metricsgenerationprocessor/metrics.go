@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsgenerationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/obsreport"
+)
+
+var (
+	tagRuleKey = tag.MustNewKey("rule")
+
+	mMissingOperands  = stats.Int64("missing_operands", "Number of data points of the first operand without a matching second operand", stats.UnitDimensionless)
+	mZeroDenominators = stats.Int64("zero_denominators", "Number of data points of the first operand divided by a second operand equal to zero", stats.UnitDimensionless)
+)
+
+// MetricViews returns the metrics views related to the metrics generation.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mMissingOperands.Name()),
+			Measure:     mMissingOperands,
+			Description: mMissingOperands.Description(),
+			TagKeys:     []tag.Key{tagRuleKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mZeroDenominators.Name()),
+			Measure:     mZeroDenominators,
+			Description: mZeroDenominators.Description(),
+			TagKeys:     []tag.Key{tagRuleKey},
+			Aggregation: view.Sum(),
+		},
+	}
+}
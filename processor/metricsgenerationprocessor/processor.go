@@ -16,6 +16,7 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -23,8 +24,10 @@ import (
 )
 
 type metricsGenerationProcessor struct {
-	rules  []internalRule
-	logger *zap.Logger
+	rules           []internalRule
+	unitConversions map[unitPair]float64
+	cumulatives     *cumulativeState
+	logger          *zap.Logger
 }
 
 type internalRule struct {
@@ -35,12 +38,25 @@ type internalRule struct {
 	metric2   string
 	operation string
 	scaleBy   float64
+	attribute string
+
+	// replaceMissingOperand states whether a missing second operand is replaced by missingOperandValue.
+	replaceMissingOperand bool
+	missingOperandValue   float64
+	zeroDenominator       string
+}
+
+type unitPair struct {
+	from string
+	to   string
 }
 
-func newMetricsGenerationProcessor(rules []internalRule, logger *zap.Logger) *metricsGenerationProcessor {
+func newMetricsGenerationProcessor(rules []internalRule, unitConversions map[unitPair]float64, maxStaleness time.Duration, logger *zap.Logger) *metricsGenerationProcessor {
 	return &metricsGenerationProcessor{
-		rules:  rules,
-		logger: logger,
+		rules:           rules,
+		unitConversions: unitConversions,
+		cumulatives:     newCumulativeState(maxStaleness),
+		logger:          logger,
 	}
 }
 
@@ -50,38 +66,67 @@ func (mgp *metricsGenerationProcessor) Start(context.Context, component.Host) er
 }
 
 // processMetrics implements the ProcessMetricsFunc type.
-func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pdata.Metrics) (pdata.Metrics, error) {
+func (mgp *metricsGenerationProcessor) processMetrics(ctx context.Context, md pdata.Metrics) (pdata.Metrics, error) {
 	resourceMetricsSlice := md.ResourceMetrics()
 
 	for i := 0; i < resourceMetricsSlice.Len(); i++ {
 		rm := resourceMetricsSlice.At(i)
 		nameToMetricMap := getNameToMetricMap(rm)
+		resourceKey := attributesKey(rm.Resource().Attributes())
 
 		for _, rule := range mgp.rules {
-			operand2 := float64(0)
-			_, ok := nameToMetricMap[rule.metric1]
+			metric1, ok := nameToMetricMap[rule.metric1]
 			if !ok {
 				mgp.logger.Debug("Missing first metric", zap.String("metric_name", rule.metric1))
 				continue
 			}
 
-			if rule.ruleType == string(calculate) {
+			switch rule.ruleType {
+			case string(attribute):
+				generateMetricsFromAttribute(rm, rule, mgp.logger)
+			case string(calculate):
 				metric2, ok := nameToMetricMap[rule.metric2]
 				if !ok {
 					mgp.logger.Debug("Missing second metric", zap.String("metric_name", rule.metric2))
-					continue
-				}
-				operand2 = getMetricValue(metric2)
-				if operand2 <= 0 {
-					continue
+					if !rule.replaceMissingOperand {
+						recordMissingOperands(ctx, rule, countDataPoints(metric1))
+						continue
+					}
+					// All the data points get the replacement value as second operand.
+					metric2 = pdata.NewMetric()
+					metric2.SetUnit(metric1.Unit())
 				}
+				operand2Values := getOperandValues(metric2)
 
-			} else if rule.ruleType == string(scale) {
-				operand2 = rule.scaleBy
+				unit1, unit2 := metric1.Unit(), metric2.Unit()
+				if unit1 != unit2 {
+					if factor, ok := mgp.unitConversions[unitPair{from: unit2, to: unit1}]; ok {
+						for key, value := range operand2Values {
+							operand2Values[key] = value * factor
+						}
+						unit2 = unit1
+					} else {
+						mgp.logger.Debug("Missing unit conversion between operand metrics",
+							zap.String("metric_name", rule.metric2),
+							zap.String("from", unit2),
+							zap.String("to", unit1))
+					}
+				}
+				if rule.unit == "" {
+					rule.unit = generatedUnit(rule.operation, unit1, unit2)
+				}
+				generateCalculatedMetrics(ctx, rm, operand2Values, rule, mgp.logger)
+			case string(scale):
+				generateMetrics(rm, rule.scaleBy, rule, mgp.logger)
+			case string(rate), string(delta):
+				if rule.unit == "" {
+					rule.unit = derivedUnit(rule.ruleType, metric1.Unit())
+				}
+				generateDerivedMetrics(rm, resourceKey, mgp.cumulatives, rule, mgp.logger)
 			}
-			generateMetrics(rm, operand2, rule, mgp.logger)
 		}
 	}
+	mgp.cumulatives.expire()
 	return md, nil
 }
 
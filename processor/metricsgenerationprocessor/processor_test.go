@@ -16,15 +16,18 @@ package metricsgenerationprocessor
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/obsreport"
 )
 
 type testMetric struct {
@@ -341,6 +344,390 @@ func TestMetricsGenerationProcessor(t *testing.T) {
 	}
 }
 
+func TestMetricsGenerationProcessorAttribute(t *testing.T) {
+	next := new(consumertest.MetricsSink)
+	cfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Rules: []Rule{
+			{
+				Name:      "container.cpu.limit",
+				Unit:      "1",
+				Type:      "attribute",
+				Metric1:   "container.cpu.usage",
+				Attribute: "cpu_limit",
+			},
+		},
+	}
+	factory := NewFactory()
+	mgp, err := factory.CreateMetricsProcessor(
+		context.Background(),
+		componenttest.NewNopProcessorCreateSettings(),
+		cfg,
+		next,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, mgp)
+
+	md := pdata.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	m := ms.AppendEmpty()
+	m.SetName("container.cpu.usage")
+	m.SetDataType(pdata.MetricDataTypeSum)
+	intDp := m.Sum().DataPoints().AppendEmpty()
+	intDp.SetDoubleVal(10)
+	intDp.Attributes().InsertString("container", "a")
+	intDp.Attributes().InsertInt("cpu_limit", 2)
+	stringDp := m.Sum().DataPoints().AppendEmpty()
+	stringDp.SetDoubleVal(20)
+	stringDp.Attributes().InsertString("container", "b")
+	stringDp.Attributes().InsertString("cpu_limit", "0.5")
+	invalidDp := m.Sum().DataPoints().AppendEmpty()
+	invalidDp.SetDoubleVal(30)
+	invalidDp.Attributes().InsertString("container", "c")
+	invalidDp.Attributes().InsertString("cpu_limit", "unlimited")
+	missingDp := m.Sum().DataPoints().AppendEmpty()
+	missingDp.SetDoubleVal(40)
+	missingDp.Attributes().InsertString("container", "d")
+
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+	got := next.AllMetrics()
+	require.Equal(t, 1, len(got))
+
+	metrics := got[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	generated := metrics.At(1)
+	assert.Equal(t, "container.cpu.limit", generated.Name())
+	assert.Equal(t, "1", generated.Unit())
+	require.Equal(t, pdata.MetricDataTypeGauge, generated.DataType())
+
+	dps := generated.Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	expected := map[string]float64{"a": 2, "b": 0.5}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		container, ok := dp.Attributes().Get("container")
+		require.True(t, ok)
+		_, ok = dp.Attributes().Get("cpu_limit")
+		assert.False(t, ok)
+		assert.Equal(t, pdata.MetricValueTypeDouble, dp.ValueType())
+		assert.Equal(t, expected[container.StringVal()], dp.DoubleVal())
+	}
+}
+
+func TestMetricsGenerationProcessorUnits(t *testing.T) {
+	tests := []struct {
+		name          string
+		rule          Rule
+		metric2Unit   string
+		expectedUnit  string
+		expectedValue float64
+	}{
+		{
+			name: "percent_with_unit_conversion",
+			rule: Rule{
+				Name:      "memory.utilization",
+				Type:      "calculate",
+				Metric1:   "memory.usage",
+				Metric2:   "memory.limit",
+				Operation: "percent",
+			},
+			metric2Unit:   "MiBy",
+			expectedUnit:  "%",
+			expectedValue: 50,
+		},
+		{
+			name: "ratio_with_unit_conversion",
+			rule: Rule{
+				Name:      "memory.ratio",
+				Type:      "calculate",
+				Metric1:   "memory.usage",
+				Metric2:   "memory.limit",
+				Operation: "divide",
+			},
+			metric2Unit:   "MiBy",
+			expectedUnit:  "1",
+			expectedValue: 0.5,
+		},
+		{
+			name: "ratio_without_unit_conversion",
+			rule: Rule{
+				Name:      "memory.ratio",
+				Type:      "calculate",
+				Metric1:   "memory.usage",
+				Metric2:   "memory.limit",
+				Operation: "divide",
+			},
+			metric2Unit:   "KiBy",
+			expectedUnit:  "",
+			expectedValue: 524288,
+		},
+		{
+			name: "configured_unit",
+			rule: Rule{
+				Name:      "memory.utilization",
+				Unit:      "percent",
+				Type:      "calculate",
+				Metric1:   "memory.usage",
+				Metric2:   "memory.limit",
+				Operation: "percent",
+			},
+			metric2Unit:   "By",
+			expectedUnit:  "percent",
+			expectedValue: 52428800,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Rules:             []Rule{test.rule},
+				UnitConversions:   []UnitConversion{{From: "By", To: "MiBy", Factor: 1.0 / 1048576}},
+			}
+			factory := NewFactory()
+			mgp, err := factory.CreateMetricsProcessor(
+				context.Background(),
+				componenttest.NewNopProcessorCreateSettings(),
+				cfg,
+				next,
+			)
+			require.NoError(t, err)
+
+			md := generateTestMetrics(testMetric{
+				metricNames:  []string{"memory.usage", "memory.limit"},
+				metricValues: [][]float64{{1048576}, {2}},
+			})
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			metrics.At(0).SetUnit("By")
+			metrics.At(1).SetUnit(test.metric2Unit)
+
+			require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+			got := next.AllMetrics()
+			require.Equal(t, 1, len(got))
+
+			metrics = got[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			require.Equal(t, 3, metrics.Len())
+			generated := metrics.At(2)
+			assert.Equal(t, test.rule.Name, generated.Name())
+			assert.Equal(t, test.expectedUnit, generated.Unit())
+			require.Equal(t, 1, generated.Gauge().DataPoints().Len())
+			assert.InDelta(t, test.expectedValue, generated.Gauge().DataPoints().At(0).DoubleVal(), 1e-9)
+		})
+	}
+}
+
+func TestMetricsGenerationProcessorMatchingAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		limits   map[string]float64
+		expected map[string]float64
+	}{
+		{
+			name:     "matching_attributes",
+			limits:   map[string]float64{"sda": 200, "sdb": 400, "sdc": 100},
+			expected: map[string]float64{"sda": 50, "sdb": 50},
+		},
+		{
+			name:     "missing_attributes",
+			limits:   map[string]float64{"sda": 200},
+			expected: map[string]float64{"sda": 50},
+		},
+		{
+			name:     "second_operand_without_attributes",
+			limits:   map[string]float64{"": 1000},
+			expected: map[string]float64{"sda": 10, "sdb": 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Rules: []Rule{
+					{
+						Name:      "disk.utilization",
+						Type:      "calculate",
+						Metric1:   "disk.usage",
+						Metric2:   "disk.limit",
+						Operation: "percent",
+					},
+				},
+			}
+			factory := NewFactory()
+			mgp, err := factory.CreateMetricsProcessor(
+				context.Background(),
+				componenttest.NewNopProcessorCreateSettings(),
+				cfg,
+				next,
+			)
+			require.NoError(t, err)
+
+			md := pdata.NewMetrics()
+			ms := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+			usage := ms.AppendEmpty()
+			usage.SetName("disk.usage")
+			usage.SetDataType(pdata.MetricDataTypeGauge)
+			for device, value := range map[string]float64{"sda": 100, "sdb": 200} {
+				dp := usage.Gauge().DataPoints().AppendEmpty()
+				dp.Attributes().InsertString("device", device)
+				dp.SetDoubleVal(value)
+			}
+			limit := ms.AppendEmpty()
+			limit.SetName("disk.limit")
+			limit.SetDataType(pdata.MetricDataTypeGauge)
+			for device, value := range test.limits {
+				dp := limit.Gauge().DataPoints().AppendEmpty()
+				if device != "" {
+					dp.Attributes().InsertString("device", device)
+				}
+				dp.SetDoubleVal(value)
+			}
+
+			require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+			got := next.AllMetrics()
+			require.Equal(t, 1, len(got))
+
+			metrics := got[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			require.Equal(t, 3, metrics.Len())
+			generated := metrics.At(2)
+			assert.Equal(t, "disk.utilization", generated.Name())
+
+			actual := make(map[string]float64)
+			dps := generated.Gauge().DataPoints()
+			for i := 0; i < dps.Len(); i++ {
+				device, ok := dps.At(i).Attributes().Get("device")
+				require.True(t, ok)
+				actual[device.StringVal()] = dps.At(i).DoubleVal()
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMetricsGenerationProcessorRateAndDelta(t *testing.T) {
+	tests := []struct {
+		name         string
+		rule         Rule
+		nonMonotonic bool
+		values       []int64
+		expectedUnit string
+		expected     []float64
+	}{
+		{
+			name: "rate",
+			rule: Rule{
+				Name:    "requests.rate",
+				Type:    "rate",
+				Metric1: "requests",
+			},
+			values:       []int64{100, 160, 190},
+			expectedUnit: "{requests}/s",
+			expected:     []float64{6, 3},
+		},
+		{
+			name: "rate_with_counter_reset",
+			rule: Rule{
+				Name:    "requests.rate",
+				Unit:    "1/s",
+				Type:    "rate",
+				Metric1: "requests",
+			},
+			values:       []int64{100, 160, 20},
+			expectedUnit: "1/s",
+			expected:     []float64{6, 2},
+		},
+		{
+			name: "delta",
+			rule: Rule{
+				Name:    "requests.delta",
+				Type:    "delta",
+				Metric1: "requests",
+			},
+			values:       []int64{100, 160, 190},
+			expectedUnit: "{requests}",
+			expected:     []float64{60, 30},
+		},
+		{
+			name: "delta_non_monotonic_decrease",
+			rule: Rule{
+				Name:    "requests.delta",
+				Type:    "delta",
+				Metric1: "requests",
+			},
+			nonMonotonic: true,
+			values:       []int64{100, 160, 130},
+			expectedUnit: "{requests}",
+			expected:     []float64{60, -30},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Rules:             []Rule{test.rule},
+				MaxStaleness:      time.Minute,
+			}
+			factory := NewFactory()
+			mgp, err := factory.CreateMetricsProcessor(
+				context.Background(),
+				componenttest.NewNopProcessorCreateSettings(),
+				cfg,
+				next,
+			)
+			require.NoError(t, err)
+
+			start := time.Now().Add(-time.Hour)
+			for i, value := range test.values {
+				md := pdata.NewMetrics()
+				rm := md.ResourceMetrics().AppendEmpty()
+				rm.Resource().Attributes().InsertString("host.name", "host")
+				m := rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+				m.SetName("requests")
+				m.SetUnit("{requests}")
+				m.SetDataType(pdata.MetricDataTypeSum)
+				m.Sum().SetIsMonotonic(!test.nonMonotonic)
+				m.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+				dp := m.Sum().DataPoints().AppendEmpty()
+				dp.SetStartTimestamp(pdata.NewTimestampFromTime(start))
+				dp.SetTimestamp(pdata.NewTimestampFromTime(start.Add(time.Duration(i+1) * 10 * time.Second)))
+				dp.SetIntVal(value)
+				require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+			}
+
+			got := next.AllMetrics()
+			require.Equal(t, len(test.values), len(got))
+			assert.Equal(t, 1, got[0].MetricCount(), "the first point of a series must not generate a metric")
+
+			for i, expected := range test.expected {
+				metrics := got[i+1].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+				require.Equal(t, 2, metrics.Len())
+				generated := metrics.At(1)
+				assert.Equal(t, test.rule.Name, generated.Name())
+				assert.Equal(t, test.expectedUnit, generated.Unit())
+
+				var dataPoints pdata.NumberDataPointSlice
+				if test.rule.Type == rate {
+					require.Equal(t, pdata.MetricDataTypeGauge, generated.DataType())
+					dataPoints = generated.Gauge().DataPoints()
+				} else {
+					require.Equal(t, pdata.MetricDataTypeSum, generated.DataType())
+					assert.Equal(t, pdata.MetricAggregationTemporalityDelta, generated.Sum().AggregationTemporality())
+					assert.Equal(t, !test.nonMonotonic, generated.Sum().IsMonotonic())
+					dataPoints = generated.Sum().DataPoints()
+					prevTimestamp := metrics.At(0).Sum().DataPoints().At(0).Timestamp() - pdata.Timestamp(10*time.Second)
+					assert.Equal(t, prevTimestamp, dataPoints.At(0).StartTimestamp())
+				}
+				require.Equal(t, 1, dataPoints.Len())
+				assert.InDelta(t, expected, dataPoints.At(0).DoubleVal(), 1e-9)
+			}
+		})
+	}
+}
+
 func generateTestMetrics(tm testMetric) pdata.Metrics {
 	md := pdata.NewMetrics()
 	now := time.Now()
@@ -395,3 +782,145 @@ func getOutputForIntGaugeTest() pdata.Metrics {
 
 	return intGaugeOutputMetrics
 }
+
+func TestMetricsGenerationProcessorMissingAndZeroOperands(t *testing.T) {
+	tests := []struct {
+		name              string
+		onMissingOperand  string
+		onZeroDenominator ZeroDenominatorPolicy
+		limits            map[string]float64
+		expected          map[string]float64
+		missingOperands   int64
+		zeroDenominators  int64
+	}{
+		{
+			name:            "missing_operand_skip",
+			limits:          map[string]float64{"sda": 200},
+			expected:        map[string]float64{"sda": 50},
+			missingOperands: 1,
+		},
+		{
+			name:             "missing_operand_default",
+			onMissingOperand: "default(1000)",
+			limits:           map[string]float64{"sda": 200},
+			expected:         map[string]float64{"sda": 50, "sdb": 20},
+			missingOperands:  1,
+		},
+		{
+			name:              "missing_operand_zero",
+			onMissingOperand:  "zero",
+			onZeroDenominator: "zero",
+			limits:            map[string]float64{"sda": 200},
+			expected:          map[string]float64{"sda": 50, "sdb": 0},
+			missingOperands:   1,
+			zeroDenominators:  1,
+		},
+		{
+			name:             "missing_second_metric_default",
+			onMissingOperand: "default(400)",
+			expected:         map[string]float64{"sda": 25, "sdb": 50},
+			missingOperands:  2,
+		},
+		{
+			name:             "zero_denominator_skip",
+			limits:           map[string]float64{"sda": 200, "sdb": 0},
+			expected:         map[string]float64{"sda": 50},
+			zeroDenominators: 1,
+		},
+		{
+			name:              "zero_denominator_nan",
+			onZeroDenominator: "nan",
+			limits:            map[string]float64{"sda": 200, "sdb": 0},
+			expected:          map[string]float64{"sda": 50, "sdb": math.NaN()},
+			zeroDenominators:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			ruleName := "disk.utilization." + test.name
+			cfg := &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Rules: []Rule{
+					{
+						Name:              ruleName,
+						Type:              "calculate",
+						Metric1:           "disk.usage",
+						Metric2:           "disk.limit",
+						Operation:         "percent",
+						OnMissingOperand:  test.onMissingOperand,
+						OnZeroDenominator: test.onZeroDenominator,
+					},
+				},
+			}
+			require.NoError(t, cfg.Validate())
+			mgp, err := NewFactory().CreateMetricsProcessor(
+				context.Background(),
+				componenttest.NewNopProcessorCreateSettings(),
+				cfg,
+				next,
+			)
+			require.NoError(t, err)
+
+			md := pdata.NewMetrics()
+			ms := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+			usage := ms.AppendEmpty()
+			usage.SetName("disk.usage")
+			usage.SetDataType(pdata.MetricDataTypeGauge)
+			for device, value := range map[string]float64{"sda": 100, "sdb": 200} {
+				dp := usage.Gauge().DataPoints().AppendEmpty()
+				dp.Attributes().InsertString("device", device)
+				dp.SetDoubleVal(value)
+			}
+			if test.limits != nil {
+				limit := ms.AppendEmpty()
+				limit.SetName("disk.limit")
+				limit.SetDataType(pdata.MetricDataTypeGauge)
+				for device, value := range test.limits {
+					dp := limit.Gauge().DataPoints().AppendEmpty()
+					dp.Attributes().InsertString("device", device)
+					dp.SetDoubleVal(value)
+				}
+			}
+
+			require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+			got := next.AllMetrics()
+			require.Equal(t, 1, len(got))
+
+			metrics := got[0].ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			generated := metrics.At(metrics.Len() - 1)
+			require.Equal(t, ruleName, generated.Name())
+
+			dps := generated.Gauge().DataPoints()
+			require.Equal(t, len(test.expected), dps.Len())
+			for i := 0; i < dps.Len(); i++ {
+				device, ok := dps.At(i).Attributes().Get("device")
+				require.True(t, ok)
+				expected := test.expected[device.StringVal()]
+				if math.IsNaN(expected) {
+					assert.True(t, math.IsNaN(dps.At(i).DoubleVal()))
+				} else {
+					assert.Equal(t, expected, dps.At(i).DoubleVal())
+				}
+			}
+
+			assert.Equal(t, test.missingOperands, recordedCount(t, mMissingOperands.Name(), ruleName))
+			assert.Equal(t, test.zeroDenominators, recordedCount(t, mZeroDenominators.Name(), ruleName))
+		})
+	}
+}
+
+// recordedCount returns the sum recorded by the given self-metric for the given rule.
+func recordedCount(t *testing.T, measure string, rule string) int64 {
+	rows, err := view.RetrieveData(obsreport.BuildProcessorCustomMetricName(typeStr, measure))
+	require.NoError(t, err)
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			if tag.Key == tagRuleKey && tag.Value == rule {
+				return int64(row.Data.(*view.SumData).Value)
+			}
+		}
+	}
+	return 0
+}
@@ -15,6 +15,15 @@
 package metricsgenerationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor"
 
 import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.uber.org/zap"
 )
@@ -34,23 +43,51 @@ func getNameToMetricMap(rm pdata.ResourceMetrics) map[string]pdata.Metric {
 	return metricMap
 }
 
-// getMetricValue returns the value of the first data point from the given metric.
-func getMetricValue(metric pdata.Metric) float64 {
-	if metric.DataType() == pdata.MetricDataTypeGauge {
-		dataPoints := metric.Gauge().DataPoints()
-		if dataPoints.Len() > 0 {
-			switch dataPoints.At(0).ValueType() {
-			case pdata.MetricValueTypeDouble:
-				return dataPoints.At(0).DoubleVal()
-			case pdata.MetricValueTypeInt:
-				return float64(dataPoints.At(0).IntVal())
-			}
-		}
-		return 0
+// getOperandValues returns the values of the data points of the given gauge metric, keyed by their attribute set.
+func getOperandValues(metric pdata.Metric) map[string]float64 {
+	values := make(map[string]float64)
+	if metric.DataType() != pdata.MetricDataTypeGauge {
+		return values
+	}
+	dataPoints := metric.Gauge().DataPoints()
+	for i := 0; i < dataPoints.Len(); i++ {
+		dataPoint := dataPoints.At(i)
+		values[attributesKey(dataPoint.Attributes())] = getDataPointValue(dataPoint)
+	}
+	return values
+}
+
+func getDataPointValue(dataPoint pdata.NumberDataPoint) float64 {
+	switch dataPoint.ValueType() {
+	case pdata.MetricValueTypeDouble:
+		return dataPoint.DoubleVal()
+	case pdata.MetricValueTypeInt:
+		return float64(dataPoint.IntVal())
 	}
 	return 0
 }
 
+// attributesKey builds a key identifying the given attribute set regardless of the order of its attributes.
+// The key of an empty attribute set is an empty string.
+func attributesKey(attrs pdata.AttributeMap) string {
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pdata.AttributeValue) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v, _ := attrs.Get(k)
+		b.WriteString(k)
+		b.WriteByte(0)
+		b.WriteString(v.AsString())
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
 // generateMetrics creates a new metric based on the given rule and add it to the Resource Metric.
 // The value for newly calculated metrics is always a floting point number and the dataType is set
 // as MetricDataTypeDoubleGauge.
@@ -70,6 +107,96 @@ func generateMetrics(rm pdata.ResourceMetrics, operand2 float64, rule internalRu
 	}
 }
 
+// generateCalculatedMetrics creates a new metric applying the rule's operation on the data points of the first
+// operand metric and the values of the second operand metric data points having the same attributes. A second
+// operand metric with a single data point without attributes is applied to all the data points of the first one.
+// Data points without a matching second operand are handled following the rule's missing operand policy, and
+// data points divided by zero following its zero denominator policy. Other non positive second operands are skipped.
+func generateCalculatedMetrics(ctx context.Context, rm pdata.ResourceMetrics, operand2Values map[string]float64, rule internalRule, logger *zap.Logger) {
+	scalarOperand2, hasScalarOperand2 := operand2Values[""]
+	hasScalarOperand2 = hasScalarOperand2 && len(operand2Values) == 1
+	isDivision := rule.operation == string(divide) || rule.operation == string(percent)
+	var missingOperands, zeroDenominators int64
+
+	ilms := rm.InstrumentationLibraryMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		ilm := ilms.At(i)
+		metricSlice := ilm.Metrics()
+		for j := 0; j < metricSlice.Len(); j++ {
+			metric := metricSlice.At(j)
+			if metric.Name() != rule.metric1 || metric.DataType() != pdata.MetricDataTypeGauge {
+				continue
+			}
+
+			newDataPoints := pdata.NewNumberDataPointSlice()
+			dataPoints := metric.Gauge().DataPoints()
+			for k := 0; k < dataPoints.Len(); k++ {
+				fromDataPoint := dataPoints.At(k)
+				operand2, ok := operand2Values[attributesKey(fromDataPoint.Attributes())]
+				if !ok && hasScalarOperand2 {
+					operand2, ok = scalarOperand2, true
+				}
+				if !ok {
+					missingOperands++
+					if !rule.replaceMissingOperand {
+						continue
+					}
+					operand2 = rule.missingOperandValue
+				} else if operand2 < 0 || (operand2 == 0 && !isDivision) {
+					continue
+				}
+
+				value := 0.0
+				if isDivision && operand2 == 0 {
+					zeroDenominators++
+					switch rule.zeroDenominator {
+					case string(zeroDenominatorZero):
+					case string(zeroDenominatorNaN):
+						value = math.NaN()
+					default:
+						continue
+					}
+				} else {
+					value = calculateValue(getDataPointValue(fromDataPoint), operand2, rule.operation, logger, rule.name)
+				}
+
+				newDataPoint := newDataPoints.AppendEmpty()
+				fromDataPoint.CopyTo(newDataPoint)
+				newDataPoint.SetDoubleVal(value)
+			}
+
+			if newDataPoints.Len() == 0 {
+				logger.Debug("No matching data points in second metric", zap.String("metric_name", rule.metric2))
+				continue
+			}
+			newMetric := appendMetric(ilm, rule.name, rule.unit)
+			newMetric.SetDataType(pdata.MetricDataTypeGauge)
+			newDataPoints.MoveAndAppendTo(newMetric.Gauge().DataPoints())
+		}
+	}
+
+	recordMissingOperands(ctx, rule, missingOperands)
+	if zeroDenominators > 0 {
+		logger.Debug("Divide by zero was attempted while calculating metric", zap.String("metric_name", rule.name))
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagRuleKey, rule.name)}, mZeroDenominators.M(zeroDenominators))
+	}
+}
+
+// recordMissingOperands counts the data points of the first operand of the rule without a second operand.
+func recordMissingOperands(ctx context.Context, rule internalRule, count int64) {
+	if count > 0 {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagRuleKey, rule.name)}, mMissingOperands.M(count))
+	}
+}
+
+// countDataPoints returns the number of data points of the given gauge metric.
+func countDataPoints(metric pdata.Metric) int64 {
+	if metric.DataType() != pdata.MetricDataTypeGauge {
+		return 0
+	}
+	return int64(metric.Gauge().DataPoints().Len())
+}
+
 func addDoubleGaugeDataPoints(from pdata.Metric, to pdata.Metric, operand2 float64, operation string, logger *zap.Logger) {
 	dataPoints := from.Gauge().DataPoints()
 	for i := 0; i < dataPoints.Len(); i++ {
@@ -89,6 +216,166 @@ func addDoubleGaugeDataPoints(from pdata.Metric, to pdata.Metric, operand2 float
 	}
 }
 
+// generateMetricsFromAttribute creates a new gauge metric from the numeric value of the rule's attribute
+// on the data points of the first operand metric. Data points missing the attribute or holding a non
+// numeric value are skipped, and the attribute itself is dropped from the generated data points.
+func generateMetricsFromAttribute(rm pdata.ResourceMetrics, rule internalRule, logger *zap.Logger) {
+	ilms := rm.InstrumentationLibraryMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		ilm := ilms.At(i)
+		metricSlice := ilm.Metrics()
+		for j := 0; j < metricSlice.Len(); j++ {
+			metric := metricSlice.At(j)
+			if metric.Name() != rule.metric1 {
+				continue
+			}
+
+			var dataPoints pdata.NumberDataPointSlice
+			switch metric.DataType() {
+			case pdata.MetricDataTypeGauge:
+				dataPoints = metric.Gauge().DataPoints()
+			case pdata.MetricDataTypeSum:
+				dataPoints = metric.Sum().DataPoints()
+			default:
+				logger.Debug("Unsupported data type for attribute generation", zap.String("metric_name", metric.Name()))
+				continue
+			}
+
+			newDataPoints := pdata.NewNumberDataPointSlice()
+			for k := 0; k < dataPoints.Len(); k++ {
+				fromDataPoint := dataPoints.At(k)
+				value, ok := getAttributeValue(fromDataPoint.Attributes(), rule.attribute)
+				if !ok {
+					continue
+				}
+				newDataPoint := newDataPoints.AppendEmpty()
+				fromDataPoint.CopyTo(newDataPoint)
+				newDataPoint.Attributes().Delete(rule.attribute)
+				newDataPoint.SetDoubleVal(value)
+			}
+
+			if newDataPoints.Len() == 0 {
+				logger.Debug("Missing numeric attribute", zap.String("metric_name", metric.Name()), zap.String("attribute", rule.attribute))
+				continue
+			}
+			newMetric := appendMetric(ilm, rule.name, rule.unit)
+			newMetric.SetDataType(pdata.MetricDataTypeGauge)
+			newDataPoints.MoveAndAppendTo(newMetric.Gauge().DataPoints())
+		}
+	}
+}
+
+// getAttributeValue returns the numeric value of the given attribute. String values are parsed as
+// floating point numbers.
+func getAttributeValue(attrs pdata.AttributeMap, key string) (float64, bool) {
+	attr, ok := attrs.Get(key)
+	if !ok {
+		return 0, false
+	}
+	switch attr.Type() {
+	case pdata.AttributeValueTypeDouble:
+		return attr.DoubleVal(), true
+	case pdata.AttributeValueTypeInt:
+		return float64(attr.IntVal()), true
+	case pdata.AttributeValueTypeString:
+		value, err := strconv.ParseFloat(attr.StringVal(), 64)
+		return value, err == nil
+	}
+	return 0, false
+}
+
+// generateDerivedMetrics creates a new metric from the change of the cumulative sum data points of the first
+// operand metric since the previous point of their series, which may have been received in an earlier batch.
+// The rate rules generate a gauge holding the per-second rate of change, the delta rules a delta sum holding
+// the increase. A new start timestamp is handled as a reset, and so is a decreasing value of a monotonic sum,
+// while the non-monotonic sums may decrease. The first point of a series is only stored.
+func generateDerivedMetrics(rm pdata.ResourceMetrics, resourceKey string, state *cumulativeState, rule internalRule, logger *zap.Logger) {
+	ilms := rm.InstrumentationLibraryMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		ilm := ilms.At(i)
+		metricSlice := ilm.Metrics()
+		for j := 0; j < metricSlice.Len(); j++ {
+			metric := metricSlice.At(j)
+			if metric.Name() != rule.metric1 {
+				continue
+			}
+			if metric.DataType() != pdata.MetricDataTypeSum ||
+				metric.Sum().AggregationTemporality() != pdata.MetricAggregationTemporalityCumulative {
+				logger.Debug("Metric is not a cumulative sum", zap.String("metric_name", rule.metric1))
+				continue
+			}
+
+			newDataPoints := pdata.NewNumberDataPointSlice()
+			dataPoints := metric.Sum().DataPoints()
+			for k := 0; k < dataPoints.Len(); k++ {
+				fromDataPoint := dataPoints.At(k)
+				key := rule.name + "\x00" + resourceKey + "\x00" + attributesKey(fromDataPoint.Attributes())
+				prev, ok := state.update(key, fromDataPoint)
+				if !ok {
+					continue
+				}
+
+				value := getDataPointValue(fromDataPoint)
+				increase := value - prev.value
+				decreased := metric.Sum().IsMonotonic() && value < prev.value
+				if decreased || (prev.startTimestamp != 0 && fromDataPoint.StartTimestamp() > prev.startTimestamp) {
+					increase = value
+				}
+
+				newDataPoint := newDataPoints.AppendEmpty()
+				fromDataPoint.CopyTo(newDataPoint)
+				if rule.ruleType == string(rate) {
+					elapsed := time.Duration(fromDataPoint.Timestamp() - prev.timestamp)
+					newDataPoint.SetDoubleVal(increase / elapsed.Seconds())
+				} else {
+					newDataPoint.SetStartTimestamp(prev.timestamp)
+					newDataPoint.SetDoubleVal(increase)
+				}
+			}
+
+			if newDataPoints.Len() == 0 {
+				continue
+			}
+			newMetric := appendMetric(ilm, rule.name, rule.unit)
+			if rule.ruleType == string(rate) {
+				newMetric.SetDataType(pdata.MetricDataTypeGauge)
+				newDataPoints.MoveAndAppendTo(newMetric.Gauge().DataPoints())
+			} else {
+				newMetric.SetDataType(pdata.MetricDataTypeSum)
+				newMetric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityDelta)
+				newMetric.Sum().SetIsMonotonic(metric.Sum().IsMonotonic())
+				newDataPoints.MoveAndAppendTo(newMetric.Sum().DataPoints())
+			}
+		}
+	}
+}
+
+// derivedUnit returns the unit of a rate or delta metric for which no unit is configured.
+func derivedUnit(ruleType string, unit string) string {
+	if ruleType == string(rate) && unit != "" {
+		return unit + "/s"
+	}
+	return unit
+}
+
+// generatedUnit returns the unit of a calculated metric for which no unit is configured, based on
+// the operation and the units of both operands.
+func generatedUnit(operation string, unit1, unit2 string) string {
+	switch operation {
+	case string(percent):
+		return "%"
+	case string(divide):
+		if unit1 == unit2 {
+			return "1"
+		}
+	case string(add), string(subtract):
+		if unit1 == unit2 {
+			return unit1
+		}
+	}
+	return ""
+}
+
 func appendMetric(ilm pdata.InstrumentationLibraryMetrics, name, unit string) pdata.Metric {
 	metric := ilm.Metrics().AppendEmpty()
 	metric.SetName(name)
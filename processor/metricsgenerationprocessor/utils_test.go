@@ -48,7 +48,7 @@ func TestCalculateValue(t *testing.T) {
 	require.Equal(t, 0.0, value)
 }
 
-func TestGetMetricValueWithNoDataPoint(t *testing.T) {
+func TestGetOperandValuesWithNoDataPoint(t *testing.T) {
 	md := pdata.NewMetrics()
 
 	rm := md.ResourceMetrics().AppendEmpty()
@@ -57,6 +57,22 @@ func TestGetMetricValueWithNoDataPoint(t *testing.T) {
 	m.SetName("metric_1")
 	m.SetDataType(pdata.MetricDataTypeGauge)
 
-	value := getMetricValue(md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0))
-	require.Equal(t, 0.0, value)
+	values := getOperandValues(md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics().At(0))
+	require.Empty(t, values)
+}
+
+func TestAttributesKey(t *testing.T) {
+	attrs1 := pdata.NewAttributeMap()
+	attrs1.InsertString("device", "sda")
+	attrs1.InsertInt("cpu", 1)
+	attrs2 := pdata.NewAttributeMap()
+	attrs2.InsertInt("cpu", 1)
+	attrs2.InsertString("device", "sda")
+	attrs3 := pdata.NewAttributeMap()
+	attrs3.InsertString("device", "sdb")
+	attrs3.InsertInt("cpu", 1)
+
+	require.Equal(t, attributesKey(attrs1), attributesKey(attrs2))
+	require.NotEqual(t, attributesKey(attrs1), attributesKey(attrs3))
+	require.Equal(t, "", attributesKey(pdata.NewAttributeMap()))
 }
@@ -54,6 +54,9 @@ const (
 
 	// SubmatchCaseFieldName is the mapstructure field name for SubmatchCase field
 	SubmatchCaseFieldName = "submatch_case"
+
+	// DroppedResourceAttributesFieldName is the mapstructure field name for DroppedResourceAttributes field
+	DroppedResourceAttributesFieldName = "dropped_resource_attributes"
 )
 
 // Config defines configuration for Resource processor.
@@ -151,6 +154,10 @@ type Operation struct {
 
 	// LabelValue identifies the exact label value to operate on
 	LabelValue string `mapstructure:"label_value"`
+
+	// DroppedResourceAttributes is a list of resource attributes to remove before the metric is
+	// aggregated across resources.
+	DroppedResourceAttributes []string `mapstructure:"dropped_resource_attributes"`
 }
 
 // ValueAction renames label values.
@@ -217,9 +224,14 @@ const (
 	// AggregateLabelValues aggregates away the values in Operation.AggregatedValues
 	// by the method indicated by Operation.AggregationType.
 	AggregateLabelValues OperationAction = "aggregate_label_values"
+
+	// AggregateResources merges the data points of the metric across all the resources of the batch
+	// that share the same attributes once Operation.DroppedResourceAttributes are removed,
+	// by the method indicated by Operation.AggregationType.
+	AggregateResources OperationAction = "aggregate_resources"
 )
 
-var operationActions = []OperationAction{AddLabel, UpdateLabel, DeleteLabelValue, ToggleScalarDataType, ScaleValue, AggregateLabels, AggregateLabelValues}
+var operationActions = []OperationAction{AddLabel, UpdateLabel, DeleteLabelValue, ToggleScalarDataType, ScaleValue, AggregateLabels, AggregateLabelValues, AggregateResources}
 
 func (oa OperationAction) isValid() bool {
 	for _, operationAction := range operationActions {
@@ -151,6 +151,20 @@ func TestLoadingFullConfig(t *testing.T) {
 						Action:              "group",
 						GroupResourceLabels: map[string]string{"metric_group": "2"},
 					},
+					{
+						MetricIncludeFilter: FilterConfig{
+							Include:   "name4",
+							MatchType: "strict",
+						},
+						Action: "update",
+						Operations: []Operation{
+							{
+								Action:                    "aggregate_resources",
+								DroppedResourceAttributes: []string{"host.name", "k8s.pod.name"},
+								AggregationType:           "sum",
+							},
+						},
+					},
 				},
 			},
 		},
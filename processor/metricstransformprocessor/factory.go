@@ -135,6 +135,18 @@ func validateConfiguration(config *Config) error {
 			if op.AggregationType != "" && !op.AggregationType.isValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, AggregationTypeFieldName, aggregationTypes)
 			}
+
+			if op.Action == AggregateResources {
+				if op.AggregationType == "" {
+					return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, AggregationTypeFieldName, ActionFieldName, AggregateResources)
+				}
+				if transform.Action == Group {
+					return fmt.Errorf("operation %v: %q cannot be %v while the transform %q is %v", i+1, ActionFieldName, AggregateResources, ActionFieldName, Group)
+				}
+				if i != len(transform.Operations)-1 {
+					return fmt.Errorf("operation %v: %v must be the last operation of the transform", i+1, AggregateResources)
+				}
+			}
 		}
 	}
 	return nil
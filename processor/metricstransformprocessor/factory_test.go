@@ -114,6 +114,16 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("operation %v: %q must be in %q", 1, AggregationTypeFieldName, aggregationTypes),
 		},
+		{
+			configName:   "config_invalid_aggregate_resources.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: missing required field %q while %q is %v", 1, AggregationTypeFieldName, ActionFieldName, AggregateResources),
+		},
+		{
+			configName:   "config_invalid_aggregate_resources_order.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %v must be the last operation of the transform", 1, AggregateResources),
+		},
 		{
 			configName:   "config_invalid_submatchcase.yaml",
 			succeed:      false,
@@ -213,11 +213,17 @@ func newMetricsTransformProcessor(logger *zap.Logger, internalTransforms []inter
 func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pdata.Metrics) (pdata.Metrics, error) {
 	rms := md.ResourceMetrics()
 	groupedMds := make([]*agentmetricspb.ExportMetricsServiceRequest, 0)
+	aggregations := newResourceAggregations()
+
+	// the resources are converted back once the aggregations across resources are done, as the metrics
+	// that cannot be aggregated are put back on their resource
+	resourceMds := make([]*agentmetricspb.ExportMetricsServiceRequest, 0, rms.Len())
 
 	out := pdata.NewMetrics()
 
 	for i := 0; i < rms.Len(); i++ {
 		node, resource, metrics := internaldata.ResourceMetricsToOC(rms.At(i))
+		resourceMd := &agentmetricspb.ExportMetricsServiceRequest{Node: node, Resource: resource}
 
 		nameToMetricMapping := newMetricNameMapping(metrics)
 		for _, transform := range mtp.transforms {
@@ -261,15 +267,36 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pdata
 					nameToMetricMapping.add(match.metric.MetricDescriptor.Name, match.metric)
 				}
 			}
+
+			// metrics aggregated across resources are moved out of their resource, and merged once the
+			// whole batch has been processed
+			if op, ok := transform.aggregateResourcesOperation(); ok && len(matchedMetrics) > 0 {
+				mtp.collectForResourceAggregation(aggregations, resourceMd, op, matchedMetrics)
+				metrics = mtp.removeMatchedMetrics(metrics, matchedMetrics)
+				for _, match := range matchedMetrics {
+					nameToMetricMapping.remove(match.metric.MetricDescriptor.Name, match.metric)
+				}
+			}
 		}
 
-		internaldata.OCToMetrics(node, resource, metrics).ResourceMetrics().MoveAndAppendTo(out.ResourceMetrics())
+		resourceMd.Metrics = metrics
+		resourceMds = append(resourceMds, resourceMd)
+	}
+
+	aggregatedMds := mtp.aggregateResources(aggregations)
+
+	for i := range resourceMds {
+		internaldata.OCToMetrics(resourceMds[i].Node, resourceMds[i].Resource, resourceMds[i].Metrics).ResourceMetrics().MoveAndAppendTo(out.ResourceMetrics())
 	}
 
 	for i := range groupedMds {
 		internaldata.OCToMetrics(groupedMds[i].Node, groupedMds[i].Resource, groupedMds[i].Metrics).ResourceMetrics().MoveAndAppendTo(out.ResourceMetrics())
 	}
 
+	for _, nData := range aggregatedMds {
+		internaldata.OCToMetrics(nData.Node, nData.Resource, nData.Metrics).ResourceMetrics().MoveAndAppendTo(out.ResourceMetrics())
+	}
+
 	return out, nil
 }
 
@@ -442,6 +469,8 @@ func (mtp *metricsTransformProcessor) update(match *match, transform internalTra
 			mtp.addLabelOp(match.metric, op)
 		case DeleteLabelValue:
 			mtp.deleteLabelValueOp(match.metric, op)
+		case AggregateResources:
+			// merged across resources by aggregateResources once the whole batch has been processed
 		}
 	}
 }
@@ -0,0 +1,330 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricstransformprocessor
+
+import (
+	"context"
+	"testing"
+
+	agentmetricspb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/metrics/v1"
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
+)
+
+type metricsAggregateResourcesTest struct {
+	name       string // test name
+	transforms []internalTransform
+	in         []*agentmetricspb.ExportMetricsServiceRequest
+	out        []*agentmetricspb.ExportMetricsServiceRequest
+}
+
+func aggregateResourcesTransform(include string, aggrType AggregationType, droppedAttributes ...string) internalTransform {
+	return internalTransform{
+		MetricIncludeFilter: internalFilterStrict{include: include},
+		Action:              Update,
+		Operations: []internalOperation{
+			{
+				configOperation: Operation{
+					Action:                    AggregateResources,
+					AggregationType:           aggrType,
+					DroppedResourceAttributes: droppedAttributes,
+				},
+			},
+		},
+	}
+}
+
+var (
+	aggregateResourcesTests = []metricsAggregateResourcesTest{
+		{
+			name:       "aggregate_resources_sum",
+			transforms: []internalTransform{aggregateResourcesTransform("pods/running", Sum, "instance")},
+			in: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").setLabels([]string{"phase"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, []string{"ready"}).addInt64Point(0, 1, 10).
+							build(),
+						metricBuilder().setName("other/metric").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 5, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").setLabels([]string{"phase"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, []string{"ready"}).addInt64Point(0, 2, 10).
+							build(),
+					},
+				},
+			},
+			out: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("other/metric").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 5, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").setLabels([]string{"phase"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, []string{"ready"}).addInt64Point(0, 3, 10).
+							build(),
+					},
+				},
+			},
+		},
+		{
+			name:       "aggregate_resources_mean_unordered_labels",
+			transforms: []internalTransform{aggregateResourcesTransform("cpu/utilization", Mean, "host.name")},
+			in: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"host.name": "h1", "cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("cpu/utilization").setLabels([]string{"cpu", "state"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_DOUBLE).
+							addTimeseries(0, []string{"cpu0", "user"}).addDoublePoint(0, 1, 10).
+							addTimeseries(0, []string{"cpu0", "system"}).addDoublePoint(1, 4, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"host.name": "h2", "cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("cpu/utilization").setLabels([]string{"state", "cpu"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_DOUBLE).
+							addTimeseries(0, []string{"user", "cpu0"}).addDoublePoint(0, 3, 10).
+							build(),
+					},
+				},
+			},
+			out: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("cpu/utilization").setLabels([]string{"cpu", "state"}).
+							setDataType(metricspb.MetricDescriptor_GAUGE_DOUBLE).
+							addTimeseries(0, []string{"cpu0", "system"}).addDoublePoint(0, 4, 10).
+							addTimeseries(0, []string{"cpu0", "user"}).addDoublePoint(1, 2, 10).
+							build(),
+					},
+				},
+			},
+		},
+		{
+			name:       "aggregate_resources_cumulative_keeps_earliest_start",
+			transforms: []internalTransform{aggregateResourcesTransform("requests/count", Sum, "instance")},
+			in: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/count").
+							setDataType(metricspb.MetricDescriptor_CUMULATIVE_INT64).
+							addTimeseries(5, nil).addInt64Point(0, 10, 20).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/count").
+							setDataType(metricspb.MetricDescriptor_CUMULATIVE_INT64).
+							addTimeseries(2, nil).addInt64Point(0, 7, 20).
+							build(),
+					},
+				},
+			},
+			out: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/count").
+							setDataType(metricspb.MetricDescriptor_CUMULATIVE_INT64).
+							addTimeseries(2, nil).addInt64Point(0, 17, 20).
+							build(),
+					},
+				},
+			},
+		},
+		{
+			name:       "aggregate_resources_keeps_distinct_resources",
+			transforms: []internalTransform{aggregateResourcesTransform("pods/running", Sum, "instance")},
+			in: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 1, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c2", "instance": "p2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 2, 10).
+							build(),
+					},
+				},
+			},
+			out: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c2", "instance": "p2"}},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 1, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("pods/running").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 2, 10).
+							build(),
+					},
+				},
+			},
+		},
+		{
+			name:       "aggregate_resources_different_units_kept_on_resources",
+			transforms: []internalTransform{aggregateResourcesTransform("requests/duration", Sum, "instance")},
+			in: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/duration").setUnit("ms").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 1, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/duration").setUnit("s").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 2, 10).
+							build(),
+					},
+				},
+			},
+			out: []*agentmetricspb.ExportMetricsServiceRequest{
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p1"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/duration").setUnit("ms").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 1, 10).
+							build(),
+					},
+				},
+				{
+					Resource: &resourcepb.Resource{Labels: map[string]string{"cluster": "c1", "instance": "p2"}},
+					Metrics: []*metricspb.Metric{
+						metricBuilder().setName("requests/duration").setUnit("s").
+							setDataType(metricspb.MetricDescriptor_GAUGE_INT64).
+							addTimeseries(0, nil).addInt64Point(0, 2, 10).
+							build(),
+					},
+				},
+			},
+		},
+	}
+)
+
+func TestMetricsAggregateResources(t *testing.T) {
+	for _, test := range aggregateResourcesTests {
+		t.Run(test.name, func(t *testing.T) {
+			next := new(consumertest.MetricsSink)
+			p := newMetricsTransformProcessor(zap.NewExample(), test.transforms)
+
+			mtp, err := processorhelper.NewMetricsProcessor(&Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+			}, next, p.processMetrics, processorhelper.WithCapabilities(consumerCapabilities))
+			require.NoError(t, err)
+
+			// process
+			md := pdata.NewMetrics()
+			for _, in := range test.in {
+				internaldata.OCToMetrics(in.Node, in.Resource, in.Metrics).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+			}
+			assert.NoError(t, mtp.ConsumeMetrics(context.Background(), md))
+
+			// get and check results
+			got := next.AllMetrics()
+			require.Equal(t, 1, len(got))
+			require.Equal(t, len(test.out), got[0].ResourceMetrics().Len())
+
+			for idx, out := range test.out {
+				_, resource, metrics := internaldata.ResourceMetricsToOC(got[0].ResourceMetrics().At(idx))
+				if diff := cmp.Diff(out.Resource, resource, protocmp.Transform()); diff != "" {
+					t.Errorf("Unexpected difference in resource labels:\n%v", diff)
+				}
+				if diff := cmp.Diff(out.Metrics, metrics, protocmp.Transform()); diff != "" {
+					t.Errorf("Unexpected difference in Metrics:\n%v", diff)
+				}
+			}
+
+			assert.NoError(t, mtp.Shutdown(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"fmt"
+	"sort"
+
+	commonpb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/common/v1"
+	agentmetricspb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/metrics/v1"
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	"go.opentelemetry.io/collector/model/pdata"
+	"google.golang.org/protobuf/proto"
+
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
+)
+
+// resourceAggregations collects the metrics to aggregate across resources, keyed by the
+// attributes left on their resource once the dropped ones are removed
+type resourceAggregations struct {
+	keys  []string
+	byKey map[string]*resourceAggregation
+}
+
+// resourceAggregation holds the metrics collected from all the resources sharing the same remaining attributes
+type resourceAggregation struct {
+	node     *commonpb.Node
+	resource *resourcepb.Resource
+	names    []string
+	byName   map[string]*metricAggregation
+}
+
+// metricAggregation holds all the metrics with the same name that will be merged into a single metric,
+// along with the resource each of them was collected from
+type metricAggregation struct {
+	aggregationType AggregationType
+	matches         []*match
+	origins         []*agentmetricspb.ExportMetricsServiceRequest
+}
+
+func newResourceAggregations() *resourceAggregations {
+	return &resourceAggregations{byKey: make(map[string]*resourceAggregation)}
+}
+
+// aggregateResourcesOperation returns the aggregate_resources operation of the transform, if any.
+// Validation guarantees it is the last operation.
+func (t internalTransform) aggregateResourcesOperation() (internalOperation, bool) {
+	if len(t.Operations) == 0 {
+		return internalOperation{}, false
+	}
+	op := t.Operations[len(t.Operations)-1]
+	return op, op.configOperation.Action == AggregateResources
+}
+
+// collectForResourceAggregation adds the matched metrics of the origin resource to the aggregation of the
+// resource they belong to once the dropped attributes are removed
+func (mtp *metricsTransformProcessor) collectForResourceAggregation(aggregations *resourceAggregations,
+	origin *agentmetricspb.ExportMetricsServiceRequest, mtpOp internalOperation, matchedMetrics []*match) {
+	newNode, newResource, key := mtp.dropResourceAttributes(origin.Node, origin.Resource, mtpOp.configOperation.DroppedResourceAttributes)
+
+	ra, ok := aggregations.byKey[key]
+	if !ok {
+		ra = &resourceAggregation{
+			node:     newNode,
+			resource: newResource,
+			byName:   make(map[string]*metricAggregation),
+		}
+		aggregations.byKey[key] = ra
+		aggregations.keys = append(aggregations.keys, key)
+	}
+
+	for _, match := range matchedMetrics {
+		name := match.metric.MetricDescriptor.Name
+		ma, ok := ra.byName[name]
+		if !ok {
+			ma = &metricAggregation{aggregationType: mtpOp.configOperation.AggregationType}
+			ra.byName[name] = ma
+			ra.names = append(ra.names, name)
+		}
+		ma.matches = append(ma.matches, match)
+		ma.origins = append(ma.origins, origin)
+	}
+}
+
+// aggregateResources merges the collected metrics of each aggregation into a single metric per name
+// Metrics that cannot be combined are put back on the resource they were collected from
+// Returns one MetricsData per aggregated resource, in the order they were first seen
+func (mtp *metricsTransformProcessor) aggregateResources(aggregations *resourceAggregations) []*agentmetricspb.ExportMetricsServiceRequest {
+	nDatas := make([]*agentmetricspb.ExportMetricsServiceRequest, 0, len(aggregations.keys))
+	for _, key := range aggregations.keys {
+		ra := aggregations.byKey[key]
+		nData := &agentmetricspb.ExportMetricsServiceRequest{
+			Node:     ra.node,
+			Resource: ra.resource,
+			Metrics:  make([]*metricspb.Metric, 0, len(ra.names)),
+		}
+		for _, name := range ra.names {
+			ma := ra.byName[name]
+			if err := mtp.canBeCombined(ma.matches); err != nil {
+				// TODO: report via trace / metric instead
+				mtp.logger.Warn(err.Error())
+				for i, match := range ma.matches {
+					ma.origins[i].Metrics = append(ma.origins[i].Metrics, match.metric)
+				}
+				continue
+			}
+			nData.Metrics = append(nData.Metrics, mtp.mergeAcrossResources(ma.matches, ma.aggregationType))
+		}
+		if len(nData.Metrics) > 0 {
+			nDatas = append(nDatas, nData)
+		}
+	}
+	return nDatas
+}
+
+// mergeAcrossResources merges the timeseries of metrics coming from different resources
+// Returns the merged metric
+func (mtp *metricsTransformProcessor) mergeAcrossResources(matchedMetrics []*match, aggrType AggregationType) *metricspb.Metric {
+	mergedMetric := &metricspb.Metric{}
+	mergedMetric.MetricDescriptor = proto.Clone(matchedMetrics[0].metric.MetricDescriptor).(*metricspb.MetricDescriptor)
+	labelKeys := mergedMetric.MetricDescriptor.LabelKeys
+
+	var allTimeseries []*metricspb.TimeSeries
+	for _, match := range matchedMetrics {
+		allTimeseries = append(allTimeseries, mtp.alignLabelValues(match.metric, labelKeys)...)
+	}
+
+	// each resource reports its own start timestamp, so timeseries are grouped by label values only
+	// and the merged timeseries keeps the earliest start timestamp of its group
+	mtp.sortTimeseries(allTimeseries)
+	labelIdxs := make([]int, len(labelKeys))
+	for i := range labelIdxs {
+		labelIdxs[i] = i
+	}
+	groupedTimeseries := make(map[string]*timeseriesAndLabelValues)
+	for _, ts := range allTimeseries {
+		key, labelValues := mtp.selectedLabelsAsKey(labelIdxs, ts)
+		if tlv, ok := groupedTimeseries[key]; ok {
+			tlv.timeseries = append(tlv.timeseries, ts)
+			continue
+		}
+		groupedTimeseries[key] = &timeseriesAndLabelValues{
+			timeseries:  []*metricspb.TimeSeries{ts},
+			labelValues: labelValues,
+		}
+	}
+
+	aggregatedTimeseries := mtp.mergeTimeseries(groupedTimeseries, aggrType, mergedMetric.MetricDescriptor.Type)
+
+	// sort by start timestamp, then by label values so that the output does not depend on map ordering
+	sort.Slice(aggregatedTimeseries, func(i, j int) bool {
+		ti, tj := aggregatedTimeseries[i], aggregatedTimeseries[j]
+		if mtp.compareTimestamps(ti.StartTimestamp, tj.StartTimestamp) {
+			return true
+		}
+		if mtp.compareTimestamps(tj.StartTimestamp, ti.StartTimestamp) {
+			return false
+		}
+		keyI, _ := mtp.selectedLabelsAsKey(labelIdxs, ti)
+		keyJ, _ := mtp.selectedLabelsAsKey(labelIdxs, tj)
+		return keyI < keyJ
+	})
+	mergedMetric.Timeseries = aggregatedTimeseries
+	return mergedMetric
+}
+
+// alignLabelValues reorders the label values of the metric timeseries to follow the order of labelKeys
+// Returns the timeseries of the metric
+func (mtp *metricsTransformProcessor) alignLabelValues(metric *metricspb.Metric, labelKeys []*metricspb.LabelKey) []*metricspb.TimeSeries {
+	labelIdxs := make(map[string]int, len(metric.MetricDescriptor.LabelKeys))
+	for idx, label := range metric.MetricDescriptor.LabelKeys {
+		labelIdxs[label.Key] = idx
+	}
+	for _, ts := range metric.Timeseries {
+		labelValues := make([]*metricspb.LabelValue, len(labelKeys))
+		for i, label := range labelKeys {
+			labelValues[i] = ts.LabelValues[labelIdxs[label.Key]]
+		}
+		ts.LabelValues = labelValues
+	}
+	return metric.Timeseries
+}
+
+// dropResourceAttributes removes the given attributes from a copy of the resource
+// Returns the new node and resource, and a key identifying the remaining attributes
+func (mtp *metricsTransformProcessor) dropResourceAttributes(node *commonpb.Node, resource *resourcepb.Resource, attributes []string) (*commonpb.Node, *resourcepb.Resource, string) {
+	rm := pdata.NewResourceMetrics()
+	if rms := internaldata.OCToMetrics(node, resource, nil).ResourceMetrics(); rms.Len() > 0 {
+		rms.At(0).Resource().CopyTo(rm.Resource())
+	}
+
+	attrs := rm.Resource().Attributes()
+	for _, attribute := range attributes {
+		attrs.Delete(attribute)
+	}
+
+	var key string
+	attrs.Sort().Range(func(k string, v pdata.AttributeValue) bool {
+		key += fmt.Sprintf("%v=%v;", k, v.AsString())
+		return true
+	})
+
+	newNode, newResource, _ := internaldata.ResourceMetricsToOC(rm)
+	return newNode, newResource, key
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appservice // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/azure/appservice"
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "app_service"
+
+	// Environment variables set by the App Service runtime, see
+	// https://docs.microsoft.com/en-us/azure/app-service/reference-app-settings
+	siteNameEnvVar      = "WEBSITE_SITE_NAME"
+	regionNameEnvVar    = "REGION_NAME"
+	ownerNameEnvVar     = "WEBSITE_OWNER_NAME"
+	resourceGroupEnvVar = "WEBSITE_RESOURCE_GROUP"
+	instanceIDEnvVar    = "WEBSITE_INSTANCE_ID"
+	slotNameEnvVar      = "WEBSITE_SLOT_NAME"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector is an Azure App Service detector
+type Detector struct{}
+
+// NewDetector creates a new Azure App Service detector
+func NewDetector(component.ProcessorCreateSettings, internal.DetectorConfig) (internal.Detector, error) {
+	return &Detector{}, nil
+}
+
+// Detect detects the App Service metadata from the environment and returns a resource with the available ones
+func (d *Detector) Detect(context.Context) (resource pdata.Resource, schemaURL string, err error) {
+	res := pdata.NewResource()
+
+	siteName := os.Getenv(siteNameEnvVar)
+	if siteName == "" {
+		// not running in App Service
+		return res, "", nil
+	}
+
+	attrs := res.Attributes()
+	attrs.InsertString(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAzure)
+	attrs.InsertString(conventions.AttributeCloudPlatform, conventions.AttributeCloudPlatformAzureAppService)
+	attrs.InsertString(conventions.AttributeServiceName, siteName)
+	insertFromEnv(attrs, conventions.AttributeCloudRegion, regionNameEnvVar)
+	insertFromEnv(attrs, conventions.AttributeServiceInstanceID, instanceIDEnvVar)
+	insertFromEnv(attrs, "azure.resourcegroup.name", resourceGroupEnvVar)
+	insertFromEnv(attrs, "azure.app_service.slot.name", slotNameEnvVar)
+	if subscriptionID := subscriptionID(os.Getenv(ownerNameEnvVar)); subscriptionID != "" {
+		attrs.InsertString(conventions.AttributeCloudAccountID, subscriptionID)
+	}
+
+	return res, conventions.SchemaURL, nil
+}
+
+func insertFromEnv(attrs pdata.AttributeMap, key, envVar string) {
+	if value := os.Getenv(envVar); value != "" {
+		attrs.InsertString(key, value)
+	}
+}
+
+// subscriptionID extracts the subscription ID from the App Service owner name,
+// which has the format <subscription id>+<resource group>-<region>webspace.
+func subscriptionID(ownerName string) string {
+	if i := strings.Index(ownerName, "+"); i >= 0 {
+		return ownerName[:i]
+	}
+	return ownerName
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appservice
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+func TestNewDetector(t *testing.T) {
+	d, err := NewDetector(componenttest.NewNopProcessorCreateSettings(), nil)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetector_Detect_AppService(t *testing.T) {
+	os.Clearenv()
+	setEnv(t, map[string]string{
+		"WEBSITE_SITE_NAME":      "my-site",
+		"REGION_NAME":            "West Europe",
+		"WEBSITE_OWNER_NAME":     "subscription-id+my-group-WestEuropewebspace",
+		"WEBSITE_RESOURCE_GROUP": "my-group",
+		"WEBSITE_INSTANCE_ID":    "instance-id",
+		"WEBSITE_SLOT_NAME":      "staging",
+	})
+
+	detector := &Detector{}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":              "azure",
+		"cloud.platform":              "azure_app_service",
+		"cloud.region":                "West Europe",
+		"cloud.account.id":            "subscription-id",
+		"service.name":                "my-site",
+		"service.instance.id":         "instance-id",
+		"azure.resourcegroup.name":    "my-group",
+		"azure.app_service.slot.name": "staging",
+	}, internal.AttributesToMap(res.Attributes()), "Resource attrs returned are incorrect")
+}
+
+func TestDetector_Detect_AppService_Partial(t *testing.T) {
+	os.Clearenv()
+	setEnv(t, map[string]string{
+		"WEBSITE_SITE_NAME": "my-site",
+	})
+
+	detector := &Detector{}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider": "azure",
+		"cloud.platform": "azure_app_service",
+		"service.name":   "my-site",
+	}, internal.AttributesToMap(res.Attributes()), "Resource attrs returned are incorrect")
+}
+
+func TestDetector_Detect_NonAppService(t *testing.T) {
+	os.Clearenv()
+	detector := &Detector{}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, schemaURL)
+	assert.Equal(t, 0, res.Attributes().Len())
+}
+
+func TestSubscriptionID(t *testing.T) {
+	assert.Equal(t, "subscription-id", subscriptionID("subscription-id+my-group-WestEuropewebspace"))
+	assert.Equal(t, "subscription-id", subscriptionID("subscription-id"))
+	assert.Equal(t, "", subscriptionID(""))
+}
+
+func setEnv(t *testing.T, env map[string]string) {
+	for k, v := range env {
+		require.NoError(t, os.Setenv(k, v))
+	}
+}
@@ -16,6 +16,7 @@ package azure // import "github.com/open-telemetry/opentelemetry-collector-contr
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/model/pdata"
@@ -66,7 +67,24 @@ func (d *Detector) Detect(ctx context.Context) (resource pdata.Resource, schemaU
 	attrs.InsertString(conventions.AttributeCloudAccountID, compute.SubscriptionID)
 	attrs.InsertString("azure.vm.size", compute.VMSize)
 	attrs.InsertString("azure.vm.scaleset.name", compute.VMScaleSetName)
+	if instanceID, ok := scaleSetInstanceID(compute); ok {
+		attrs.InsertString("azure.vm.scaleset.instance_id", instanceID)
+	}
 	attrs.InsertString("azure.resourcegroup.name", compute.ResourceGroupName)
 
 	return res, conventions.SchemaURL, nil
 }
+
+// scaleSetInstanceID returns the instance ID of a virtual machine that is part
+// of a scale set. The IMDS does not report it directly, but names the scale set
+// instances as <scale set name>_<instance id>.
+func scaleSetInstanceID(compute *ComputeMetadata) (string, bool) {
+	if compute.VMScaleSetName == "" {
+		return "", false
+	}
+	instanceID := strings.TrimPrefix(compute.Name, compute.VMScaleSetName+"_")
+	if instanceID == compute.Name || instanceID == "" {
+		return "", false
+	}
+	return instanceID, true
+}
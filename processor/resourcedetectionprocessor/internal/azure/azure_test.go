@@ -69,6 +69,63 @@ func TestDetectAzureAvailable(t *testing.T) {
 	assert.Equal(t, expected, res)
 }
 
+func TestDetectAzureScaleSetInstance(t *testing.T) {
+	mp := &MockProvider{}
+	mp.On("Metadata").Return(&ComputeMetadata{
+		Location:          "location",
+		Name:              "myScaleset_3",
+		VMID:              "vmID",
+		VMSize:            "vmSize",
+		SubscriptionID:    "subscriptionID",
+		ResourceGroupName: "resourceGroup",
+		VMScaleSetName:    "myScaleset",
+	}, nil)
+
+	detector := &Detector{provider: mp}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+
+	attrs := internal.AttributesToMap(res.Attributes())
+	assert.Equal(t, "myScaleset", attrs["azure.vm.scaleset.name"])
+	assert.Equal(t, "3", attrs["azure.vm.scaleset.instance_id"])
+	assert.Equal(t, "myScaleset_3", attrs[conventions.AttributeHostName])
+}
+
+func TestScaleSetInstanceID(t *testing.T) {
+	tests := []struct {
+		name       string
+		compute    *ComputeMetadata
+		instanceID string
+		ok         bool
+	}{
+		{
+			name:    "no scale set",
+			compute: &ComputeMetadata{Name: "vm_1"},
+		},
+		{
+			name:    "name without scale set prefix",
+			compute: &ComputeMetadata{Name: "vm", VMScaleSetName: "myScaleset"},
+		},
+		{
+			name:    "empty instance id",
+			compute: &ComputeMetadata{Name: "myScaleset_", VMScaleSetName: "myScaleset"},
+		},
+		{
+			name:       "scale set instance",
+			compute:    &ComputeMetadata{Name: "myScaleset_12", VMScaleSetName: "myScaleset"},
+			instanceID: "12",
+			ok:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instanceID, ok := scaleSetInstanceID(tt.compute)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.instanceID, instanceID)
+		})
+	}
+}
+
 func TestDetectError(t *testing.T) {
 	mp := &MockProvider{}
 	mp.On("Metadata").Return(&ComputeMetadata{}, fmt.Errorf("mock error"))
@@ -61,6 +61,21 @@ func (c *Config) Validate() error {
 		if len(item.Exporters) == 0 {
 			return fmt.Errorf("invalid route %s: %w", item.Value, errNoExporters)
 		}
+
+		if item.SamplingPercentage < 0 || item.SamplingPercentage > 100 {
+			return fmt.Errorf("invalid route %s: %w", item.Value, errInvalidSamplingPercentage)
+		}
+	}
+
+	// routes sharing the same value are merged, so they must agree on how the
+	// matching data is routed
+	routes := make(map[string]RoutingTableItem, len(c.Table))
+	for _, item := range c.Table {
+		if other, ok := routes[item.Value]; ok &&
+			(other.Duplicate != item.Duplicate || other.SamplingPercentage != item.SamplingPercentage) {
+			return fmt.Errorf("invalid route %s: %w", item.Value, errConflictingRoutes)
+		}
+		routes[item.Value] = item
 	}
 
 	// validate that there's at least one item in the table
@@ -98,4 +113,17 @@ type RoutingTableItem struct {
 	// The routing processor will fail upon the first failure from these exporters.
 	// Optional.
 	Exporters []string `mapstructure:"exporters"`
+
+	// Duplicate makes the matching data to be sent to the exporters of this route
+	// in addition to the ones specified under DefaultExporters, instead of only
+	// to the exporters of this route.
+	// Optional.
+	Duplicate bool `mapstructure:"duplicate"`
+
+	// SamplingPercentage is the percentage (0-100] of the matching data sent to
+	// the exporters of this route. The data which isn't sampled is routed as if
+	// this route didn't match, that is, to the exporters specified under
+	// DefaultExporters. The sampling decision is made per routed batch.
+	// Optional, the default value of 0 sends all the matching data to this route.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
 }
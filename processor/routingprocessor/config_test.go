@@ -103,8 +103,10 @@ func TestLoadConfig(t *testing.T) {
 						Exporters: []string{"logging/acme"},
 					},
 					{
-						Value:     "globex",
-						Exporters: []string{"logging/globex"},
+						Value:              "globex",
+						Exporters:          []string{"logging/globex"},
+						Duplicate:          true,
+						SamplingPercentage: 50,
 					},
 				},
 			},
@@ -95,6 +95,42 @@ func TestProcessorFailsToBeCreatedWhenNoRoutesExist(t *testing.T) {
 	assert.ErrorIs(t, cfg.Validate(), errNoTableItems)
 }
 
+func TestProcessorFailsWithInvalidSamplingPercentage(t *testing.T) {
+	cfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		DefaultExporters:  []string{"otlp"},
+		FromAttribute:     "X-Tenant",
+		Table: []RoutingTableItem{
+			{
+				Value:              "acme",
+				Exporters:          []string{"otlp/2"},
+				SamplingPercentage: 101,
+			},
+		},
+	}
+	assert.ErrorIs(t, cfg.Validate(), errInvalidSamplingPercentage)
+}
+
+func TestProcessorFailsWithConflictingRoutes(t *testing.T) {
+	cfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		DefaultExporters:  []string{"otlp"},
+		FromAttribute:     "X-Tenant",
+		Table: []RoutingTableItem{
+			{
+				Value:     "acme",
+				Exporters: []string{"otlp/2"},
+				Duplicate: true,
+			},
+			{
+				Value:     "acme",
+				Exporters: []string{"otlp/3"},
+			},
+		},
+	}
+	assert.ErrorIs(t, cfg.Validate(), errConflictingRoutes)
+}
+
 func TestProcessorFailsWithNoFromAttribute(t *testing.T) {
 	cfg := &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
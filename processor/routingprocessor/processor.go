@@ -30,6 +30,8 @@ var (
 	errNoExporters                  = errors.New("no exporters defined for the route")
 	errNoTableItems                 = errors.New("the routing table is empty")
 	errNoMissingFromAttribute       = errors.New("the FromAttribute property is empty")
+	errInvalidSamplingPercentage    = errors.New("the sampling percentage must be between 0 and 100")
+	errConflictingRoutes            = errors.New("routes with the same value must have the same duplicate and sampling settings")
 	errDefaultExporterNotFound      = errors.New("default exporter not found")
 	errExporterNotFound             = errors.New("exporter not found")
 	errNoExportersAfterRegistration = errors.New("provided configuration resulted in no exporter available to accept data")
@@ -617,6 +617,115 @@ func TestLogs_RoutingWorks_Context(t *testing.T) {
 	})
 }
 
+func TestLogs_RoutingWorks_Duplicate(t *testing.T) {
+	defaultExp := &mockLogsExporter{}
+	alertingExp := &mockLogsExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.LogsDataType: {
+					config.NewComponentID("otlp"):          defaultExp,
+					config.NewComponentID("otlp/alerting"): alertingExp,
+				},
+			}
+		},
+	}
+
+	exp := newProcessor(zap.NewNop(), &Config{
+		FromAttribute:    "severity",
+		AttributeSource:  resourceAttributeSource,
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Value:     "error",
+				Exporters: []string{"otlp/alerting", "otlp"},
+				Duplicate: true,
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	l := pdata.NewLogs()
+	rl := l.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("severity", "error")
+
+	t.Run("matching logs are sent to the route and the default exporters", func(t *testing.T) {
+		assert.NoError(t, exp.ConsumeLogs(context.Background(), l))
+		assert.Equal(t, 1, defaultExp.getLogCount(),
+			"log should be routed once to default exporter",
+		)
+		assert.Equal(t, 1, alertingExp.getLogCount(),
+			"log should be routed to alerting exporter",
+		)
+	})
+
+	t.Run("non matching logs are sent to the default exporters only", func(t *testing.T) {
+		l := pdata.NewLogs()
+		l.ResourceLogs().AppendEmpty().Resource().Attributes().InsertString("severity", "info")
+
+		assert.NoError(t, exp.ConsumeLogs(context.Background(), l))
+		assert.Equal(t, 2, defaultExp.getLogCount(),
+			"log should be routed to default exporter",
+		)
+		assert.Equal(t, 1, alertingExp.getLogCount(),
+			"log should not be routed to alerting exporter",
+		)
+	})
+}
+
+func TestTraces_RoutingWorks_Sampling(t *testing.T) {
+	defaultExp := &mockTracesExporter{}
+	tExp := &mockTracesExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.TracesDataType: {
+					config.NewComponentID("otlp"):   defaultExp,
+					config.NewComponentID("otlp/2"): tExp,
+				},
+			}
+		},
+	}
+
+	exp := newProcessor(zap.NewNop(), &Config{
+		FromAttribute:    "X-Tenant",
+		AttributeSource:  contextAttributeSource,
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Value:              "acme",
+				Exporters:          []string{"otlp/2"},
+				SamplingPercentage: 25,
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"X-Tenant": "acme",
+	}))
+	tr := pdata.NewTraces()
+	tr.ResourceSpans().AppendEmpty()
+
+	t.Run("sampled traces are sent to the route exporters", func(t *testing.T) {
+		exp.router.sample = func() float64 { return 0.1 }
+		assert.NoError(t, exp.ConsumeTraces(ctx, tr))
+		assert.Equal(t, 0, defaultExp.getTraceCount())
+		assert.Equal(t, 1, tExp.getTraceCount())
+	})
+
+	t.Run("non sampled traces are sent to the default exporters", func(t *testing.T) {
+		exp.router.sample = func() float64 { return 0.5 }
+		assert.NoError(t, exp.ConsumeTraces(ctx, tr))
+		assert.Equal(t, 1, defaultExp.getTraceCount())
+		assert.Equal(t, 1, tExp.getTraceCount())
+	})
+}
+
 func TestLogs_RoutingWorks_ResourceAttribute(t *testing.T) {
 	defaultExp := &mockLogsExporter{}
 	lExp := &mockLogsExporter{}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -35,6 +36,10 @@ type router struct {
 	config    Config
 	logger    *zap.Logger
 	extractor extractor
+	// routes contains the routing table items keyed by their value.
+	routes map[string]RoutingTableItem
+	// sample returns a random number in [0.0,1.0) used for the per route sampling.
+	sample func() float64
 
 	defaultLogsExporters    []component.LogsExporter
 	logsExporters           map[string][]component.LogsExporter
@@ -45,10 +50,17 @@ type router struct {
 }
 
 func newRouter(config Config, logger *zap.Logger) *router {
+	routes := make(map[string]RoutingTableItem, len(config.Table))
+	for _, item := range config.Table {
+		routes[item.Value] = item
+	}
+
 	return &router{
 		config:           config,
 		logger:           logger,
 		extractor:        newExtractor(config.FromAttribute, logger),
+		routes:           routes,
+		sample:           rand.Float64,
 		logsExporters:    make(map[string][]component.LogsExporter),
 		metricsExporters: make(map[string][]component.MetricsExporter),
 		tracesExporters:  make(map[string][]component.TracesExporter),
@@ -88,12 +100,6 @@ func (r *router) routeMetricsForResource(_ context.Context, tm pdata.Metrics) []
 		resMetrics := resMetricsSlice.At(i)
 
 		attrValue := r.extractor.extractAttrFromResource(resMetrics.Resource())
-		exp := r.defaultMetricsExporters
-		// If we have an exporter list defined for that attribute value then use it.
-		if e, ok := r.metricsExporters[attrValue]; ok {
-			exp = e
-		}
-
 		if rEntry, ok := routingMap[attrValue]; ok {
 			resMetrics.CopyTo(rEntry.resMetrics.AppendEmpty())
 		} else {
@@ -101,7 +107,7 @@ func (r *router) routeMetricsForResource(_ context.Context, tm pdata.Metrics) []
 			resMetrics.CopyTo(new.AppendEmpty())
 
 			routingMap[attrValue] = routingEntry{
-				exporters:  exp,
+				exporters:  r.metricsExportersFor(attrValue),
 				resMetrics: new,
 			}
 		}
@@ -127,17 +133,9 @@ func (r *router) routeMetricsForResource(_ context.Context, tm pdata.Metrics) []
 func (r *router) routeMetricsForContext(ctx context.Context, tm pdata.Metrics) routedMetrics {
 	value := r.extractor.extractFromContext(ctx)
 
-	exp, ok := r.metricsExporters[value]
-	if !ok {
-		return routedMetrics{
-			metrics:   tm,
-			exporters: r.defaultMetricsExporters,
-		}
-	}
-
 	return routedMetrics{
 		metrics:   tm,
-		exporters: exp,
+		exporters: r.metricsExportersFor(value),
 	}
 }
 
@@ -174,12 +172,6 @@ func (r *router) routeTracesForResource(_ context.Context, tr pdata.Traces) []ro
 		resSpans := resSpansSlice.At(i)
 
 		attrValue := r.extractor.extractAttrFromResource(resSpans.Resource())
-		exp := r.defaultTracesExporters
-		// If we have an exporter list defined for that attribute value then use it.
-		if e, ok := r.tracesExporters[attrValue]; ok {
-			exp = e
-		}
-
 		if rEntry, ok := routingMap[attrValue]; ok {
 			resSpans.CopyTo(rEntry.resSpans.AppendEmpty())
 		} else {
@@ -187,7 +179,7 @@ func (r *router) routeTracesForResource(_ context.Context, tr pdata.Traces) []ro
 			resSpans.CopyTo(new.AppendEmpty())
 
 			routingMap[attrValue] = routingEntry{
-				exporters: exp,
+				exporters: r.tracesExportersFor(attrValue),
 				resSpans:  new,
 			}
 		}
@@ -213,17 +205,9 @@ func (r *router) routeTracesForResource(_ context.Context, tr pdata.Traces) []ro
 func (r *router) routeTracesForContext(ctx context.Context, tr pdata.Traces) routedTraces {
 	value := r.extractor.extractFromContext(ctx)
 
-	exp, ok := r.tracesExporters[value]
-	if !ok {
-		return routedTraces{
-			traces:    tr,
-			exporters: r.defaultTracesExporters,
-		}
-	}
-
 	return routedTraces{
 		traces:    tr,
-		exporters: exp,
+		exporters: r.tracesExportersFor(value),
 	}
 }
 
@@ -260,12 +244,6 @@ func (r *router) routeLogsForResource(_ context.Context, tl pdata.Logs) []routed
 		resLogs := resLogsSlice.At(i)
 
 		attrValue := r.extractor.extractAttrFromResource(resLogs.Resource())
-		exp := r.defaultLogsExporters
-		// If we have an exporter list defined for that attribute value then use it.
-		if e, ok := r.logsExporters[attrValue]; ok {
-			exp = e
-		}
-
 		if rEntry, ok := routingMap[attrValue]; ok {
 			resLogs.CopyTo(rEntry.resLogs.AppendEmpty())
 		} else {
@@ -273,7 +251,7 @@ func (r *router) routeLogsForResource(_ context.Context, tl pdata.Logs) []routed
 			resLogs.CopyTo(new.AppendEmpty())
 
 			routingMap[attrValue] = routingEntry{
-				exporters: exp,
+				exporters: r.logsExportersFor(attrValue),
 				resLogs:   new,
 			}
 		}
@@ -299,17 +277,128 @@ func (r *router) routeLogsForResource(_ context.Context, tl pdata.Logs) []routed
 func (r *router) routeLogsForContext(ctx context.Context, tl pdata.Logs) routedLogs {
 	value := r.extractor.extractFromContext(ctx)
 
-	exp, ok := r.logsExporters[value]
+	return routedLogs{
+		logs:      tl,
+		exporters: r.logsExportersFor(value),
+	}
+}
+
+// routeDecision describes where the data matching a route is sent to.
+type routeDecision int
+
+const (
+	// routeToDefault sends the data to the default exporters only.
+	routeToDefault routeDecision = iota
+	// routeToRoute sends the data to the route exporters only.
+	routeToRoute
+	// routeToBoth sends the data to both the default and the route exporters.
+	routeToBoth
+)
+
+// decide determines where the data with the given route value is sent to,
+// taking into account the route duplicate and sampling settings.
+func (r *router) decide(value string) routeDecision {
+	item, ok := r.routes[value]
+	if !ok {
+		return routeToDefault
+	}
+	if item.SamplingPercentage > 0 && item.SamplingPercentage < 100 &&
+		r.sample()*100 >= item.SamplingPercentage {
+		return routeToDefault
+	}
+	if item.Duplicate {
+		return routeToBoth
+	}
+	return routeToRoute
+}
+
+// metricsExportersFor returns the metrics exporters the data with the given
+// route value is sent to.
+func (r *router) metricsExportersFor(value string) []component.MetricsExporter {
+	exp, ok := r.metricsExporters[value]
 	if !ok {
-		return routedLogs{
-			logs:      tl,
-			exporters: r.defaultLogsExporters,
+		return r.defaultMetricsExporters
+	}
+
+	switch r.decide(value) {
+	case routeToRoute:
+		return exp
+	case routeToBoth:
+		ret := make([]component.MetricsExporter, 0, len(r.defaultMetricsExporters)+len(exp))
+		ret = append(ret, r.defaultMetricsExporters...)
+	exporters:
+		for _, e := range exp {
+			// don't send the same data twice to an exporter
+			for _, d := range r.defaultMetricsExporters {
+				if d == e {
+					continue exporters
+				}
+			}
+			ret = append(ret, e)
 		}
+		return ret
+	default:
+		return r.defaultMetricsExporters
 	}
+}
 
-	return routedLogs{
-		logs:      tl,
-		exporters: exp,
+// tracesExportersFor returns the traces exporters the data with the given
+// route value is sent to.
+func (r *router) tracesExportersFor(value string) []component.TracesExporter {
+	exp, ok := r.tracesExporters[value]
+	if !ok {
+		return r.defaultTracesExporters
+	}
+
+	switch r.decide(value) {
+	case routeToRoute:
+		return exp
+	case routeToBoth:
+		ret := make([]component.TracesExporter, 0, len(r.defaultTracesExporters)+len(exp))
+		ret = append(ret, r.defaultTracesExporters...)
+	exporters:
+		for _, e := range exp {
+			// don't send the same data twice to an exporter
+			for _, d := range r.defaultTracesExporters {
+				if d == e {
+					continue exporters
+				}
+			}
+			ret = append(ret, e)
+		}
+		return ret
+	default:
+		return r.defaultTracesExporters
+	}
+}
+
+// logsExportersFor returns the logs exporters the data with the given route
+// value is sent to.
+func (r *router) logsExportersFor(value string) []component.LogsExporter {
+	exp, ok := r.logsExporters[value]
+	if !ok {
+		return r.defaultLogsExporters
+	}
+
+	switch r.decide(value) {
+	case routeToRoute:
+		return exp
+	case routeToBoth:
+		ret := make([]component.LogsExporter, 0, len(r.defaultLogsExporters)+len(exp))
+		ret = append(ret, r.defaultLogsExporters...)
+	exporters:
+		for _, e := range exp {
+			// don't send the same data twice to an exporter
+			for _, d := range r.defaultLogsExporters {
+				if d == e {
+					continue exporters
+				}
+			}
+			ret = append(ret, e)
+		}
+		return ret
+	default:
+		return r.defaultLogsExporters
 	}
 }
 
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconvprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Datadog configures the translation of the Datadog tags, e.g. env, version or kube_namespace.
+	Datadog TranslationConfig `mapstructure:"datadog"`
+
+	// Splunk configures the translation of the Splunk fields, e.g. sf_environment or kubernetes_pod_name.
+	Splunk TranslationConfig `mapstructure:"splunk"`
+
+	// ECS configures the translation of the Elastic Common Schema fields, e.g. service.environment or url.full.
+	ECS TranslationConfig `mapstructure:"ecs"`
+
+	// KeepOriginal keeps the translated attributes next to their semantic conventions counterparts
+	// instead of removing them.
+	KeepOriginal bool `mapstructure:"keep_original"`
+}
+
+// TranslationConfig defines whether the attributes of a vendor scheme are translated.
+type TranslationConfig struct {
+	// Enabled translates the attributes of the vendor scheme.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if !config.Datadog.Enabled && !config.Splunk.Enabled && !config.ECS.Enabled {
+		return fmt.Errorf("at least one of datadog, splunk or ecs must be enabled")
+	}
+	return nil
+}
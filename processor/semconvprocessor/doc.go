@@ -0,0 +1,19 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semconvprocessor implements a processor which translates the
+// attributes of common vendor schemes, such as the Datadog tags, the Splunk
+// fields or the Elastic Common Schema fields, into their OpenTelemetry
+// semantic conventions counterparts.
+package semconvprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor"
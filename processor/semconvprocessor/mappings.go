@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconvprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor"
+
+import (
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// attributeMapping maps an attribute of a vendor scheme to its semantic conventions counterpart.
+type attributeMapping struct {
+	from string
+	to   string
+}
+
+// datadogMappings are the reserved and unified service tagging tags of Datadog, and the span
+// tags set by its tracing libraries and Agent.
+var datadogMappings = []attributeMapping{
+	{from: "env", to: conventions.AttributeDeploymentEnvironment},
+	{from: "service", to: conventions.AttributeServiceName},
+	{from: "version", to: conventions.AttributeServiceVersion},
+	{from: "host", to: conventions.AttributeHostName},
+	{from: "container_id", to: conventions.AttributeContainerID},
+	{from: "container_name", to: conventions.AttributeContainerName},
+	{from: "image_name", to: conventions.AttributeContainerImageName},
+	{from: "image_tag", to: conventions.AttributeContainerImageTag},
+	{from: "kube_cluster_name", to: conventions.AttributeK8SClusterName},
+	{from: "kube_namespace", to: conventions.AttributeK8SNamespaceName},
+	{from: "kube_deployment", to: conventions.AttributeK8SDeploymentName},
+	{from: "kube_container_name", to: conventions.AttributeK8SContainerName},
+	{from: "pod_name", to: conventions.AttributeK8SPodName},
+	{from: "http.useragent", to: conventions.AttributeHTTPUserAgent},
+	{from: "db.type", to: conventions.AttributeDBSystem},
+	{from: "db.instance", to: conventions.AttributeDBName},
+	{from: "sql.query", to: conventions.AttributeDBStatement},
+	{from: "out.host", to: conventions.AttributeNetPeerName},
+	{from: "out.port", to: conventions.AttributeNetPeerPort},
+	{from: "error.msg", to: conventions.AttributeExceptionMessage},
+	{from: "error.type", to: conventions.AttributeExceptionType},
+	{from: "error.stack", to: conventions.AttributeExceptionStacktrace},
+}
+
+// splunkMappings are the Splunk Observability Cloud dimensions and the fields set by the Splunk
+// Connect for Kubernetes and the SignalFx Smart Agent.
+var splunkMappings = []attributeMapping{
+	{from: "sf_environment", to: conventions.AttributeDeploymentEnvironment},
+	{from: "environment", to: conventions.AttributeDeploymentEnvironment},
+	{from: "sf_service", to: conventions.AttributeServiceName},
+	{from: "host", to: conventions.AttributeHostName},
+	{from: "container_id", to: conventions.AttributeContainerID},
+	{from: "container_name", to: conventions.AttributeContainerName},
+	{from: "container_image", to: conventions.AttributeContainerImageName},
+	{from: "kubernetes_cluster", to: conventions.AttributeK8SClusterName},
+	{from: "kubernetes_namespace", to: conventions.AttributeK8SNamespaceName},
+	{from: "kubernetes_node", to: conventions.AttributeK8SNodeName},
+	{from: "kubernetes_pod_name", to: conventions.AttributeK8SPodName},
+	{from: "kubernetes_pod_uid", to: conventions.AttributeK8SPodUID},
+}
+
+// ecsMappings are the Elastic Common Schema fields which have a different name in the semantic
+// conventions. The fields sharing their name and meaning, such as host.name, are left untouched.
+var ecsMappings = []attributeMapping{
+	{from: "service.environment", to: conventions.AttributeDeploymentEnvironment},
+	{from: "service.node.name", to: conventions.AttributeServiceInstanceID},
+	{from: "host.hostname", to: conventions.AttributeHostName},
+	{from: "cloud.instance.id", to: conventions.AttributeHostID},
+	{from: "cloud.machine.type", to: conventions.AttributeHostType},
+	{from: "orchestrator.cluster.name", to: conventions.AttributeK8SClusterName},
+	{from: "kubernetes.namespace", to: conventions.AttributeK8SNamespaceName},
+	{from: "kubernetes.node.name", to: conventions.AttributeK8SNodeName},
+	{from: "kubernetes.pod.name", to: conventions.AttributeK8SPodName},
+	{from: "kubernetes.pod.uid", to: conventions.AttributeK8SPodUID},
+	{from: "kubernetes.deployment.name", to: conventions.AttributeK8SDeploymentName},
+	{from: "kubernetes.container.name", to: conventions.AttributeK8SContainerName},
+	{from: "process.executable", to: conventions.AttributeProcessExecutablePath},
+	{from: "process.name", to: conventions.AttributeProcessExecutableName},
+	{from: "url.full", to: conventions.AttributeHTTPURL},
+	{from: "http.request.method", to: conventions.AttributeHTTPMethod},
+	{from: "http.response.status_code", to: conventions.AttributeHTTPStatusCode},
+	{from: "user_agent.original", to: conventions.AttributeHTTPUserAgent},
+	{from: "error.message", to: conventions.AttributeExceptionMessage},
+	{from: "error.type", to: conventions.AttributeExceptionType},
+	{from: "error.stack_trace", to: conventions.AttributeExceptionStacktrace},
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconvprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/semconvprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+type semconvProcessor struct {
+	mappings     []attributeMapping
+	keepOriginal bool
+}
+
+func newSemconvProcessor(config *Config) *semconvProcessor {
+	var families [][]attributeMapping
+	if config.Datadog.Enabled {
+		families = append(families, datadogMappings)
+	}
+	if config.Splunk.Enabled {
+		families = append(families, splunkMappings)
+	}
+	if config.ECS.Enabled {
+		families = append(families, ecsMappings)
+	}
+
+	// An attribute shared by several families, such as host, is translated by the first one.
+	var mappings []attributeMapping
+	seen := make(map[string]struct{})
+	for _, family := range families {
+		for _, mapping := range family {
+			if _, ok := seen[mapping.from]; ok {
+				continue
+			}
+			seen[mapping.from] = struct{}{}
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	return &semconvProcessor{
+		mappings:     mappings,
+		keepOriginal: config.KeepOriginal,
+	}
+}
+
+func (sp *semconvProcessor) processTraces(_ context.Context, td pdata.Traces) (pdata.Traces, error) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sp.translate(rs.Resource().Attributes())
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				sp.translate(span.Attributes())
+				events := span.Events()
+				for l := 0; l < events.Len(); l++ {
+					sp.translate(events.At(l).Attributes())
+				}
+			}
+		}
+	}
+	return td, nil
+}
+
+func (sp *semconvProcessor) processMetrics(_ context.Context, md pdata.Metrics) (pdata.Metrics, error) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sp.translate(rm.Resource().Attributes())
+		ilms := rm.InstrumentationLibraryMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			metrics := ilms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				sp.translateDataPoints(metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+func (sp *semconvProcessor) processLogs(_ context.Context, ld pdata.Logs) (pdata.Logs, error) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sp.translate(rl.Resource().Attributes())
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).Logs()
+			for k := 0; k < logs.Len(); k++ {
+				sp.translate(logs.At(k).Attributes())
+			}
+		}
+	}
+	return ld, nil
+}
+
+func (sp *semconvProcessor) translateDataPoints(metric pdata.Metric) {
+	switch metric.DataType() {
+	case pdata.MetricDataTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			sp.translate(dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			sp.translate(dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			sp.translate(dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			sp.translate(dps.At(i).Attributes())
+		}
+	case pdata.MetricDataTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			sp.translate(dps.At(i).Attributes())
+		}
+	}
+}
+
+// translate renames the vendor attributes of the map to their semantic conventions counterparts.
+// An existing semantic conventions attribute is never overwritten and the vendor attribute is then
+// left untouched, otherwise the vendor attribute is removed unless the original attributes are kept.
+func (sp *semconvProcessor) translate(attrs pdata.AttributeMap) {
+	for _, mapping := range sp.mappings {
+		value, ok := attrs.Get(mapping.from)
+		if !ok {
+			continue
+		}
+		if _, exists := attrs.Get(mapping.to); exists {
+			continue
+		}
+		attrs.Insert(mapping.to, value)
+		if !sp.keepOriginal {
+			attrs.Delete(mapping.from)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconvprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		attrs    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:   "datadog",
+			config: Config{Datadog: TranslationConfig{Enabled: true}},
+			attrs: map[string]interface{}{
+				"env":            "prod",
+				"service":        "checkout",
+				"kube_namespace": "shop",
+				"url.full":       "https://shop.example.com/cart",
+			},
+			expected: map[string]interface{}{
+				"deployment.environment": "prod",
+				"service.name":           "checkout",
+				"k8s.namespace.name":     "shop",
+				"url.full":               "https://shop.example.com/cart",
+			},
+		},
+		{
+			name:   "splunk",
+			config: Config{Splunk: TranslationConfig{Enabled: true}},
+			attrs: map[string]interface{}{
+				"sf_environment":      "prod",
+				"kubernetes_pod_name": "checkout-7d9f",
+				"env":                 "staging",
+			},
+			expected: map[string]interface{}{
+				"deployment.environment": "prod",
+				"k8s.pod.name":           "checkout-7d9f",
+				"env":                    "staging",
+			},
+		},
+		{
+			name:   "ecs",
+			config: Config{ECS: TranslationConfig{Enabled: true}},
+			attrs: map[string]interface{}{
+				"url.full":                  "https://shop.example.com/cart",
+				"http.response.status_code": int64(200),
+				"host.name":                 "node-1",
+			},
+			expected: map[string]interface{}{
+				"http.url":         "https://shop.example.com/cart",
+				"http.status_code": int64(200),
+				"host.name":        "node-1",
+			},
+		},
+		{
+			name:   "existing_attribute_is_kept",
+			config: Config{Datadog: TranslationConfig{Enabled: true}},
+			attrs: map[string]interface{}{
+				"env":                    "staging",
+				"deployment.environment": "prod",
+			},
+			expected: map[string]interface{}{
+				"env":                    "staging",
+				"deployment.environment": "prod",
+			},
+		},
+		{
+			name:   "keep_original",
+			config: Config{Datadog: TranslationConfig{Enabled: true}, KeepOriginal: true},
+			attrs: map[string]interface{}{
+				"version": "1.2.3",
+			},
+			expected: map[string]interface{}{
+				"version":         "1.2.3",
+				"service.version": "1.2.3",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sp := newSemconvProcessor(&test.config)
+			attrs := pdata.NewAttributeMapFromMap(attributeValues(test.attrs))
+			sp.translate(attrs)
+			assert.Equal(t, test.expected, attrs.AsRaw())
+		})
+	}
+}
+
+func TestProcessTraces(t *testing.T) {
+	sp := newSemconvProcessor(&Config{Datadog: TranslationConfig{Enabled: true}})
+
+	td := pdata.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("env", "prod")
+	span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().InsertString("sql.query", "SELECT 1")
+	span.Events().AppendEmpty().Attributes().InsertString("error.msg", "timeout")
+
+	td, err := sp.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	rs = td.ResourceSpans().At(0)
+	assert.Equal(t, map[string]interface{}{"deployment.environment": "prod"}, rs.Resource().Attributes().AsRaw())
+	span = rs.InstrumentationLibrarySpans().At(0).Spans().At(0)
+	assert.Equal(t, map[string]interface{}{"db.statement": "SELECT 1"}, span.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"exception.message": "timeout"}, span.Events().At(0).Attributes().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	sp := newSemconvProcessor(&Config{Splunk: TranslationConfig{Enabled: true}})
+
+	md := pdata.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("host", "node-1")
+	metric := rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetDataType(pdata.MetricDataTypeHistogram)
+	metric.Histogram().DataPoints().AppendEmpty().Attributes().InsertString("kubernetes_namespace", "shop")
+
+	md, err := sp.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+
+	rm = md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{"host.name": "node-1"}, rm.Resource().Attributes().AsRaw())
+	dp := rm.InstrumentationLibraryMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, map[string]interface{}{"k8s.namespace.name": "shop"}, dp.Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	sp := newSemconvProcessor(&Config{ECS: TranslationConfig{Enabled: true}})
+
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().InsertString("service.environment", "prod")
+	rl.InstrumentationLibraryLogs().AppendEmpty().Logs().AppendEmpty().Attributes().InsertString("error.stack_trace", "panic: boom")
+
+	ld, err := sp.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	rl = ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{"deployment.environment": "prod"}, rl.Resource().Attributes().AsRaw())
+	lr := rl.InstrumentationLibraryLogs().At(0).Logs().At(0)
+	assert.Equal(t, map[string]interface{}{"exception.stacktrace": "panic: boom"}, lr.Attributes().AsRaw())
+}
+
+func attributeValues(raw map[string]interface{}) map[string]pdata.AttributeValue {
+	values := make(map[string]pdata.AttributeValue, len(raw))
+	for k, v := range raw {
+		switch v := v.(type) {
+		case string:
+			values[k] = pdata.NewAttributeValueString(v)
+		case int64:
+			values[k] = pdata.NewAttributeValueInt(v)
+		}
+	}
+	return values
+}
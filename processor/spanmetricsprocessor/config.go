@@ -19,6 +19,8 @@ import (
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
 )
 
 const (
@@ -27,10 +29,7 @@ const (
 )
 
 // Dimension defines the dimension name and optional default value if the Dimension is missing from a span attribute.
-type Dimension struct {
-	Name    string  `mapstructure:"name"`
-	Default *string `mapstructure:"default"`
-}
+type Dimension = spanmetrics.Dimension
 
 // Config defines the configuration options for spanmetricsprocessor.
 type Config struct {
@@ -40,7 +39,7 @@ type Config struct {
 	MetricsExporter string `mapstructure:"metrics_exporter"`
 
 	// LatencyHistogramBuckets is the list of durations representing latency histogram buckets.
-	// See defaultLatencyHistogramBucketsMs in processor.go for the default value.
+	// See DefaultLatencyHistogramBucketsMs in internal/coreinternal/spanmetrics for the default value.
 	LatencyHistogramBuckets []time.Duration `mapstructure:"latency_histogram_buckets"`
 
 	// Dimensions defines the list of additional dimensions on top of the provided:
@@ -52,12 +51,17 @@ type Config struct {
 	// https://github.com/open-telemetry/opentelemetry-collector/blob/main/model/semconv/opentelemetry.go.
 	Dimensions []Dimension `mapstructure:"dimensions"`
 
-	// DimensionsCacheSize defines the size of cache for storing Dimensions, which helps to avoid cache memory growing
-	// indefinitely over the lifetime of the collector.
+	// DimensionsCacheSize defines the maximum number of series, unique sets of Dimensions, aggregated, which helps to
+	// avoid memory growing indefinitely over the lifetime of the collector. The spans of new series are dropped once reached.
 	// Optional. See defaultDimensionsCacheSize in processor.go for the default value.
 	DimensionsCacheSize int `mapstructure:"dimensions_cache_size"`
 
 	AggregationTemporality string `mapstructure:"aggregation_temporality"`
+
+	// MaxExemplarsPerBucket defines the maximum number of exemplars, holding the trace and span IDs of the
+	// first spans seen, attached to each latency histogram bucket per exported batch. Set to 0 to disable exemplars.
+	// Optional. See defaultMaxExemplarsPerBucket in processor.go for the default value.
+	MaxExemplarsPerBucket int `mapstructure:"max_exemplars_per_bucket"`
 }
 
 // GetAggregationTemporality converts the string value given in the config into a MetricAggregationTemporality.
@@ -43,18 +43,21 @@ func TestLoadConfig(t *testing.T) {
 		wantDimensions              []Dimension
 		wantDimensionsCacheSize     int
 		wantAggregationTemporality  string
+		wantMaxExemplarsPerBucket   int
 	}{
 		{
 			configFile:                 "config-2-pipelines.yaml",
 			wantMetricsExporter:        "prometheus",
 			wantAggregationTemporality: cumulative,
 			wantDimensionsCacheSize:    500,
+			wantMaxExemplarsPerBucket:  defaultMaxExemplarsPerBucket,
 		},
 		{
 			configFile:                 "config-3-pipelines.yaml",
 			wantMetricsExporter:        "otlp/spanmetrics",
 			wantAggregationTemporality: cumulative,
 			wantDimensionsCacheSize:    defaultDimensionsCacheSize,
+			wantMaxExemplarsPerBucket:  defaultMaxExemplarsPerBucket,
 		},
 		{
 			configFile:          "config-full.yaml",
@@ -69,11 +72,13 @@ func TestLoadConfig(t *testing.T) {
 				250 * time.Millisecond,
 			},
 			wantDimensions: []Dimension{
-				{"http.method", &defaultMethod},
-				{"http.status_code", nil},
+				{Name: "http.method", Default: &defaultMethod},
+				{Name: "http.status_code"},
+				{Name: "http.url", Normalizer: "url_template"},
 			},
 			wantDimensionsCacheSize:    1500,
 			wantAggregationTemporality: delta,
+			wantMaxExemplarsPerBucket:  3,
 		},
 	}
 	for _, tc := range testcases {
@@ -106,6 +111,7 @@ func TestLoadConfig(t *testing.T) {
 					Dimensions:              tc.wantDimensions,
 					DimensionsCacheSize:     tc.wantDimensionsCacheSize,
 					AggregationTemporality:  tc.wantAggregationTemporality,
+					MaxExemplarsPerBucket:   tc.wantMaxExemplarsPerBucket,
 				},
 				cfg.Processors[config.NewComponentID(typeStr)],
 			)
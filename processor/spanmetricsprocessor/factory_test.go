@@ -47,13 +47,13 @@ func TestNewProcessor(t *testing.T) {
 			name:                    "full config with no catch-all bucket and check the catch-all bucket is inserted",
 			latencyHistogramBuckets: []time.Duration{2 * time.Millisecond},
 			dimensions: []Dimension{
-				{"http.method", &defaultMethod},
-				{"http.status_code", nil},
+				{Name: "http.method", Default: &defaultMethod},
+				{Name: "http.status_code"},
 			},
 			wantLatencyHistogramBuckets: []float64{2, maxDurationMs},
 			wantDimensions: []Dimension{
-				{"http.method", &defaultMethod},
-				{"http.status_code", nil},
+				{Name: "http.method", Default: &defaultMethod},
+				{Name: "http.status_code"},
 			},
 		},
 	} {
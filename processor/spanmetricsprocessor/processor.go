@@ -18,9 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"sort"
 	"strings"
-	"sync"
 	"time"
 	"unicode"
 
@@ -28,41 +26,29 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/model/pdata"
-	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor/internal/cache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
 )
 
 const (
-	serviceNameKey     = conventions.AttributeServiceName
-	operationKey       = "operation"   // OpenTelemetry non-standard constant.
-	spanKindKey        = "span.kind"   // OpenTelemetry non-standard constant.
-	statusCodeKey      = "status.code" // OpenTelemetry non-standard constant.
-	metricKeySeparator = string(byte(0))
-	traceIDKey         = "trace_id"
-
-	defaultDimensionsCacheSize = 1000
+	serviceNameKey = spanmetrics.ServiceNameKey
+	operationKey   = spanmetrics.OperationKey
+	spanKindKey    = spanmetrics.SpanKindKey
+	statusCodeKey  = spanmetrics.StatusCodeKey
+
+	defaultDimensionsCacheSize   = 1000
+	defaultMaxExemplarsPerBucket = 1
 )
 
 var (
 	maxDuration   = time.Duration(math.MaxInt64)
-	maxDurationMs = durationToMillis(maxDuration)
+	maxDurationMs = spanmetrics.MaxDurationMs
 
-	defaultLatencyHistogramBucketsMs = []float64{
-		2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000, maxDurationMs,
-	}
+	defaultLatencyHistogramBucketsMs = spanmetrics.DefaultLatencyHistogramBucketsMs
 )
 
-type exemplarData struct {
-	traceID pdata.TraceID
-	value   float64
-}
-
-type metricKey string
-
 type processorImp struct {
-	lock   sync.RWMutex
 	logger *zap.Logger
 	config Config
 
@@ -72,37 +58,18 @@ type processorImp struct {
 	// Additional dimensions to add to metrics.
 	dimensions []Dimension
 
-	// The starting time of the data points.
-	startTime time.Time
-
-	// Call & Error counts.
-	callSum map[metricKey]int64
+	// The latency histogram bounds, in milliseconds.
+	latencyBounds []float64
 
-	// Latency histogram.
-	latencyCount         map[metricKey]uint64
-	latencySum           map[metricKey]float64
-	latencyBucketCounts  map[metricKey][]uint64
-	latencyBounds        []float64
-	latencyExemplarsData map[metricKey][]exemplarData
-
-	// An LRU cache of dimension key-value maps keyed by a unique identifier formed by a concatenation of its values:
-	// e.g. { "foo/barOK": { "serviceName": "foo", "operation": "/bar", "status_code": "OK" }}
-	metricKeyToDimensions *cache.Cache
+	// aggregator aggregates the calls and latency of the spans per dimensions.
+	aggregator *spanmetrics.Aggregator
 }
 
 func newProcessor(logger *zap.Logger, config config.Processor, nextConsumer consumer.Traces) (*processorImp, error) {
 	logger.Info("Building spanmetricsprocessor")
 	pConfig := config.(*Config)
 
-	bounds := defaultLatencyHistogramBucketsMs
-	if pConfig.LatencyHistogramBuckets != nil {
-		bounds = mapDurationsToMillis(pConfig.LatencyHistogramBuckets)
-
-		// "Catch-all" bucket.
-		if bounds[len(bounds)-1] != maxDurationMs {
-			bounds = append(bounds, maxDurationMs)
-		}
-	}
+	bounds := spanmetrics.LatencyBounds(pConfig.LatencyHistogramBuckets)
 
 	if err := validateDimensions(pConfig.Dimensions); err != nil {
 		return nil, err
@@ -114,41 +81,29 @@ func newProcessor(logger *zap.Logger, config config.Processor, nextConsumer cons
 			pConfig.DimensionsCacheSize,
 		)
 	}
-	metricKeyToDimensionsCache, err := cache.NewCache(pConfig.DimensionsCacheSize)
-	if err != nil {
-		return nil, err
+	if pConfig.MaxExemplarsPerBucket < 0 {
+		return nil, fmt.Errorf(
+			"invalid max exemplars per bucket: %v, the maximum number of exemplars per bucket should not be negative",
+			pConfig.MaxExemplarsPerBucket,
+		)
 	}
 
 	return &processorImp{
-		logger:                logger,
-		config:                *pConfig,
-		startTime:             time.Now(),
-		callSum:               make(map[metricKey]int64),
-		latencyBounds:         bounds,
-		latencySum:            make(map[metricKey]float64),
-		latencyCount:          make(map[metricKey]uint64),
-		latencyBucketCounts:   make(map[metricKey][]uint64),
-		latencyExemplarsData:  make(map[metricKey][]exemplarData),
-		nextConsumer:          nextConsumer,
-		dimensions:            pConfig.Dimensions,
-		metricKeyToDimensions: metricKeyToDimensionsCache,
+		logger:        logger,
+		config:        *pConfig,
+		nextConsumer:  nextConsumer,
+		dimensions:    pConfig.Dimensions,
+		latencyBounds: bounds,
+		aggregator: spanmetrics.NewAggregator(spanmetrics.AggregatorSettings{
+			LatencyBounds:         bounds,
+			Dimensions:            pConfig.Dimensions,
+			MaxSeries:             pConfig.DimensionsCacheSize,
+			MaxExemplarsPerBucket: pConfig.MaxExemplarsPerBucket,
+			Temporality:           pConfig.GetAggregationTemporality(),
+		}),
 	}, nil
 }
 
-// durationToMillis converts the given duration to the number of milliseconds it represents.
-// Note that this can return sub-millisecond (i.e. < 1ms) values as well.
-func durationToMillis(d time.Duration) float64 {
-	return float64(d.Nanoseconds()) / float64(time.Millisecond.Nanoseconds())
-}
-
-func mapDurationsToMillis(vs []time.Duration) []float64 {
-	vsm := make([]float64, len(vs))
-	for i, v := range vs {
-		vsm[i] = durationToMillis(v)
-	}
-	return vsm
-}
-
 // validateDimensions checks duplicates for reserved dimensions and additional dimensions. Considering
 // the usage of Prometheus related exporters, we also validate the dimensions after sanitization.
 func validateDimensions(dimensions []Dimension) error {
@@ -175,7 +130,7 @@ func validateDimensions(dimensions []Dimension) error {
 		labelNames[sanitizedName] = struct{}{}
 	}
 
-	return nil
+	return spanmetrics.ValidateDimensions(dimensions)
 }
 
 // Start implements the component.Component interface.
@@ -227,290 +182,51 @@ func (p *processorImp) Capabilities() consumer.Capabilities {
 // It aggregates the trace data to generate metrics, forwarding these metrics to the discovered metrics exporter.
 // The original input trace data will be forwarded to the next consumer, unmodified.
 func (p *processorImp) ConsumeTraces(ctx context.Context, traces pdata.Traces) error {
-	p.aggregateMetrics(traces)
-
-	m, err := p.buildMetrics()
-	if err != nil {
-		return err
+	if dropped := p.aggregator.Aggregate(traces); dropped > 0 {
+		p.logger.Debug("Dimensions cache size reached, spans not aggregated", zap.Int("dropped_spans", dropped))
 	}
 
 	// Firstly, export metrics to avoid being impacted by downstream trace processor errors/latency.
-	if err := p.metricsExporter.ConsumeMetrics(ctx, *m); err != nil {
+	if err := p.metricsExporter.ConsumeMetrics(ctx, p.buildMetrics()); err != nil {
 		return err
 	}
 
-	p.resetExemplarData()
-
 	// Forward trace data unmodified.
 	return p.nextConsumer.ConsumeTraces(ctx, traces)
 }
 
-// buildMetrics collects the computed raw metrics data, builds the metrics object and
-// writes the raw metrics data into the metrics object.
-func (p *processorImp) buildMetrics() (*pdata.Metrics, error) {
+// buildMetrics collects the aggregated data into a call count and a latency
+// metric per set of dimensions, the call counts first.
+func (p *processorImp) buildMetrics() pdata.Metrics {
 	m := pdata.NewMetrics()
 	ilm := m.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
 	ilm.InstrumentationLibrary().SetName("spanmetricsprocessor")
 
-	// Obtain write lock to reset data
-	p.lock.Lock()
-
-	if err := p.collectCallMetrics(ilm); err != nil {
-		p.lock.Unlock()
-		return nil, err
-	}
-
-	if err := p.collectLatencyMetrics(ilm); err != nil {
-		p.lock.Unlock()
-		return nil, err
-	}
-
-	p.metricKeyToDimensions.RemoveEvictedItems()
-
-	// If delta metrics, reset accumulated data
-	if p.config.GetAggregationTemporality() == pdata.MetricAggregationTemporalityDelta {
-		p.resetAccumulatedMetrics()
-	}
-	p.resetExemplarData()
-
-	p.lock.Unlock()
-
-	return &m, nil
-}
-
-// collectLatencyMetrics collects the raw latency metrics, writing the data
-// into the given instrumentation library metrics.
-func (p *processorImp) collectLatencyMetrics(ilm pdata.InstrumentationLibraryMetrics) error {
-	for key := range p.latencyCount {
-		mLatency := ilm.Metrics().AppendEmpty()
-		mLatency.SetDataType(pdata.MetricDataTypeHistogram)
-		mLatency.SetName("latency")
-		mLatency.Histogram().SetAggregationTemporality(p.config.GetAggregationTemporality())
-
-		timestamp := pdata.NewTimestampFromTime(time.Now())
-
-		dpLatency := mLatency.Histogram().DataPoints().AppendEmpty()
-		dpLatency.SetStartTimestamp(pdata.NewTimestampFromTime(p.startTime))
-		dpLatency.SetTimestamp(timestamp)
-		dpLatency.SetExplicitBounds(p.latencyBounds)
-		dpLatency.SetBucketCounts(p.latencyBucketCounts[key])
-		dpLatency.SetCount(p.latencyCount[key])
-		dpLatency.SetSum(p.latencySum[key])
-
-		setLatencyExemplars(p.latencyExemplarsData[key], timestamp, dpLatency.Exemplars())
-
-		dimensions, err := p.getDimensionsByMetricKey(key)
-		if err != nil {
-			p.logger.Error(err.Error())
-			return err
-		}
-
-		dimensions.CopyTo(dpLatency.Attributes())
-	}
-	return nil
-}
-
-// collectCallMetrics collects the raw call count metrics, writing the data
-// into the given instrumentation library metrics.
-func (p *processorImp) collectCallMetrics(ilm pdata.InstrumentationLibraryMetrics) error {
-	for key := range p.callSum {
-		mCalls := ilm.Metrics().AppendEmpty()
+	temporality := p.config.GetAggregationTemporality()
+	calls := pdata.NewMetricSlice()
+	latencies := pdata.NewMetricSlice()
+	p.aggregator.Collect(func() spanmetrics.SeriesDataPoints {
+		mCalls := calls.AppendEmpty()
 		mCalls.SetDataType(pdata.MetricDataTypeSum)
-		mCalls.SetName("calls_total")
+		mCalls.SetName(spanmetrics.CallsMetricName)
 		mCalls.Sum().SetIsMonotonic(true)
-		mCalls.Sum().SetAggregationTemporality(p.config.GetAggregationTemporality())
-
-		dpCalls := mCalls.Sum().DataPoints().AppendEmpty()
-		dpCalls.SetStartTimestamp(pdata.NewTimestampFromTime(p.startTime))
-		dpCalls.SetTimestamp(pdata.NewTimestampFromTime(time.Now()))
-		dpCalls.SetIntVal(p.callSum[key])
-
-		dimensions, err := p.getDimensionsByMetricKey(key)
-		if err != nil {
-			p.logger.Error(err.Error())
-			return err
-		}
-
-		dimensions.CopyTo(dpCalls.Attributes())
-	}
-	return nil
-}
-
-// getDimensionsByMetricKey gets dimensions from `metricKeyToDimensions` cache.
-func (p *processorImp) getDimensionsByMetricKey(k metricKey) (*pdata.AttributeMap, error) {
-	if item, ok := p.metricKeyToDimensions.Get(k); ok {
-		if attributeMap, ok := item.(pdata.AttributeMap); ok {
-			return &attributeMap, nil
-		}
-		return nil, fmt.Errorf("type assertion of metricKeyToDimensions attributes failed, the key is %q", k)
-	}
-
-	return nil, fmt.Errorf("value not found in metricKeyToDimensions cache by key %q", k)
-}
-
-// aggregateMetrics aggregates the raw metrics from the input trace data.
-// Each metric is identified by a key that is built from the service name
-// and span metadata such as operation, kind, status_code and any additional
-// dimensions the user has configured.
-func (p *processorImp) aggregateMetrics(traces pdata.Traces) {
-	for i := 0; i < traces.ResourceSpans().Len(); i++ {
-		rspans := traces.ResourceSpans().At(i)
-		r := rspans.Resource()
+		mCalls.Sum().SetAggregationTemporality(temporality)
 
-		attr, ok := r.Attributes().Get(conventions.AttributeServiceName)
-		if !ok {
-			continue
-		}
-		serviceName := attr.StringVal()
-		p.aggregateMetricsForServiceSpans(rspans, serviceName)
-	}
-}
-
-func (p *processorImp) aggregateMetricsForServiceSpans(rspans pdata.ResourceSpans, serviceName string) {
-	ilsSlice := rspans.InstrumentationLibrarySpans()
-	for j := 0; j < ilsSlice.Len(); j++ {
-		ils := ilsSlice.At(j)
-		spans := ils.Spans()
-		for k := 0; k < spans.Len(); k++ {
-			span := spans.At(k)
-			p.aggregateMetricsForSpan(serviceName, span, rspans.Resource().Attributes())
-		}
-	}
-}
-
-func (p *processorImp) aggregateMetricsForSpan(serviceName string, span pdata.Span, resourceAttr pdata.AttributeMap) {
-	latencyInMilliseconds := float64(span.EndTimestamp()-span.StartTimestamp()) / float64(time.Millisecond.Nanoseconds())
-
-	// Binary search to find the latencyInMilliseconds bucket index.
-	index := sort.SearchFloat64s(p.latencyBounds, latencyInMilliseconds)
-
-	key := buildKey(serviceName, span, p.dimensions, resourceAttr)
-
-	p.lock.Lock()
-	p.cache(serviceName, span, key, resourceAttr)
-	p.updateCallMetrics(key)
-	p.updateLatencyMetrics(key, latencyInMilliseconds, index)
-	p.updateLatencyExemplars(key, latencyInMilliseconds, span.TraceID())
-	p.lock.Unlock()
-}
-
-// updateCallMetrics increments the call count for the given metric key.
-func (p *processorImp) updateCallMetrics(key metricKey) {
-	p.callSum[key]++
-}
-
-// resetAccumulatedMetrics resets the internal maps used to store created metric data. Also purge the cache for
-// metricKeyToDimensions.
-func (p *processorImp) resetAccumulatedMetrics() {
-	p.callSum = make(map[metricKey]int64)
-	p.latencyCount = make(map[metricKey]uint64)
-	p.latencySum = make(map[metricKey]float64)
-	p.latencyBucketCounts = make(map[metricKey][]uint64)
-	p.metricKeyToDimensions.Purge()
-}
-
-// updateLatencyExemplars sets the histogram exemplars for the given metric key and append the exemplar data.
-func (p *processorImp) updateLatencyExemplars(key metricKey, value float64, traceID pdata.TraceID) {
-	if _, ok := p.latencyExemplarsData[key]; !ok {
-		p.latencyExemplarsData[key] = []exemplarData{}
-	}
-
-	e := exemplarData{
-		traceID: traceID,
-		value:   value,
-	}
-	p.latencyExemplarsData[key] = append(p.latencyExemplarsData[key], e)
-}
-
-// resetExemplarData resets the entire exemplars map so the next trace will recreate all
-// the data structure. An exemplar is a punctual value that exists at specific moment in time
-// and should be not considered like a metrics that persist over time.
-func (p *processorImp) resetExemplarData() {
-	p.latencyExemplarsData = make(map[metricKey][]exemplarData)
-}
-
-// updateLatencyMetrics increments the histogram counts for the given metric key and bucket index.
-func (p *processorImp) updateLatencyMetrics(key metricKey, latency float64, index int) {
-	if _, ok := p.latencyBucketCounts[key]; !ok {
-		p.latencyBucketCounts[key] = make([]uint64, len(p.latencyBounds))
-	}
-	p.latencySum[key] += latency
-	p.latencyCount[key]++
-	p.latencyBucketCounts[key][index]++
-}
-
-func (p *processorImp) buildDimensionKVs(serviceName string, span pdata.Span, optionalDims []Dimension, resourceAttrs pdata.AttributeMap) pdata.AttributeMap {
-	dims := pdata.NewAttributeMap()
-	dims.UpsertString(serviceNameKey, serviceName)
-	dims.UpsertString(operationKey, span.Name())
-	dims.UpsertString(spanKindKey, span.Kind().String())
-	dims.UpsertString(statusCodeKey, span.Status().Code().String())
-	for _, d := range optionalDims {
-		if v, ok := getDimensionValue(d, span.Attributes(), resourceAttrs); ok {
-			dims.Upsert(d.Name, v)
-		}
-	}
-	return dims
-}
-
-func concatDimensionValue(metricKeyBuilder *strings.Builder, value string, prefixSep bool) {
-	// It's worth noting that from pprof benchmarks, WriteString is the most expensive operation of this processor.
-	// Specifically, the need to grow the underlying []byte slice to make room for the appended string.
-	if prefixSep {
-		metricKeyBuilder.WriteString(metricKeySeparator)
-	}
-	metricKeyBuilder.WriteString(value)
-}
-
-// buildKey builds the metric key from the service name and span metadata such as operation, kind, status_code and
-// will attempt to add any additional dimensions the user has configured that match the span's attributes
-// or resource attributes. If the dimension exists in both, the span's attributes, being the most specific, takes precedence.
-//
-// The metric key is a simple concatenation of dimension values, delimited by a null character.
-func buildKey(serviceName string, span pdata.Span, optionalDims []Dimension, resourceAttrs pdata.AttributeMap) metricKey {
-	var metricKeyBuilder strings.Builder
-	concatDimensionValue(&metricKeyBuilder, serviceName, false)
-	concatDimensionValue(&metricKeyBuilder, span.Name(), true)
-	concatDimensionValue(&metricKeyBuilder, span.Kind().String(), true)
-	concatDimensionValue(&metricKeyBuilder, span.Status().Code().String(), true)
-
-	for _, d := range optionalDims {
-		if v, ok := getDimensionValue(d, span.Attributes(), resourceAttrs); ok {
-			concatDimensionValue(&metricKeyBuilder, v.AsString(), true)
+		mLatency := latencies.AppendEmpty()
+		mLatency.SetDataType(pdata.MetricDataTypeHistogram)
+		mLatency.SetName(spanmetrics.LatencyMetricName)
+		mLatency.Histogram().SetAggregationTemporality(temporality)
+
+		return spanmetrics.SeriesDataPoints{
+			Calls: mCalls.Sum().DataPoints().AppendEmpty(),
+			// The error counts are not exported, the status code being a dimension.
+			Errors:  pdata.NewNumberDataPoint(),
+			Latency: mLatency.Histogram().DataPoints().AppendEmpty(),
 		}
-	}
-
-	k := metricKey(metricKeyBuilder.String())
-	return k
-}
-
-// getDimensionValue gets the dimension value for the given configured dimension.
-// It searches through the span's attributes first, being the more specific;
-// falling back to searching in resource attributes if it can't be found in the span.
-// Finally, falls back to the configured default value if provided.
-//
-// The ok flag indicates if a dimension value was fetched in order to differentiate
-// an empty string value from a state where no value was found.
-func getDimensionValue(d Dimension, spanAttr pdata.AttributeMap, resourceAttr pdata.AttributeMap) (v pdata.AttributeValue, ok bool) {
-	// The more specific span attribute should take precedence.
-	if attr, exists := spanAttr.Get(d.Name); exists {
-		return attr, true
-	}
-	if attr, exists := resourceAttr.Get(d.Name); exists {
-		return attr, true
-	}
-	// Set the default if configured, otherwise this metric will have no value set for the dimension.
-	if d.Default != nil {
-		return pdata.NewAttributeValueString(*d.Default), true
-	}
-	return v, ok
-}
-
-// cache the dimension key-value map for the metricKey if there is a cache miss.
-// This enables a lookup of the dimension key-value map when constructing the metric like so:
-//   LabelsMap().InitFromMap(p.metricKeyToDimensions[key])
-func (p *processorImp) cache(serviceName string, span pdata.Span, k metricKey, resourceAttrs pdata.AttributeMap) {
-	p.metricKeyToDimensions.ContainsOrAdd(k, p.buildDimensionKVs(serviceName, span, p.dimensions, resourceAttrs))
+	})
+	calls.MoveAndAppendTo(ilm.Metrics())
+	latencies.MoveAndAppendTo(ilm.Metrics())
+	return m
 }
 
 // copied from prometheus-go-metric-exporter
@@ -542,26 +258,3 @@ func sanitizeRune(r rune) rune {
 	// Everything else turns into an underscore
 	return '_'
 }
-
-// setLatencyExemplars sets the histogram exemplars.
-func setLatencyExemplars(exemplarsData []exemplarData, timestamp pdata.Timestamp, exemplars pdata.ExemplarSlice) {
-	es := pdata.NewExemplarSlice()
-	es.EnsureCapacity(len(exemplarsData))
-
-	for _, ed := range exemplarsData {
-		value := ed.value
-		traceID := ed.traceID
-
-		exemplar := es.AppendEmpty()
-
-		if traceID.IsEmpty() {
-			continue
-		}
-
-		exemplar.SetDoubleVal(value)
-		exemplar.SetTimestamp(timestamp)
-		exemplar.FilteredAttributes().Insert(traceIDKey, pdata.NewAttributeValueString(traceID.HexString()))
-	}
-
-	es.CopyTo(exemplars)
-}
@@ -34,7 +34,7 @@ import (
 	"go.uber.org/zap/zaptest"
 	"google.golang.org/grpc/metadata"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor/internal/cache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/spanmetrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor/mocks"
 )
 
@@ -282,36 +282,27 @@ func TestProcessorConsumeTraces(t *testing.T) {
 	}
 }
 
-func TestMetricKeyCache(t *testing.T) {
+func TestProcessorDimensionsCacheSize(t *testing.T) {
 	mexp := &mocks.MetricsExporter{}
 	tcon := &mocks.TracesConsumer{}
 
-	mexp.On("ConsumeMetrics", mock.Anything, mock.Anything).Return(nil)
+	// The spans of the series beyond the cache size are dropped.
+	mexp.On("ConsumeMetrics", mock.Anything, mock.MatchedBy(func(input pdata.Metrics) bool {
+		return input.MetricCount() == 2*DimensionsCacheSize
+	})).Return(nil)
 	tcon.On("ConsumeTraces", mock.Anything, mock.Anything).Return(nil)
 
-	defaultNullValue := "defaultNullValue"
-	p := newProcessorImp(mexp, tcon, &defaultNullValue, cumulative, t)
-
-	traces := buildSampleTrace()
-
-	// Test
-	ctx := metadata.NewIncomingContext(context.Background(), nil)
-
-	// 0 key was cached at beginning
-	assert.Empty(t, p.metricKeyToDimensions.Keys())
-
-	err := p.ConsumeTraces(ctx, traces)
-	// Validate
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.DimensionsCacheSize = DimensionsCacheSize
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, tcon)
 	require.NoError(t, err)
-	// 2 key was cached, 1 key was evicted and cleaned after the processing
-	assert.Len(t, p.metricKeyToDimensions.Keys(), DimensionsCacheSize)
-
-	// consume another batch of traces
-	err = p.ConsumeTraces(ctx, traces)
-	// 2 key was cached, other keys were evicted and cleaned after the processing
-	assert.Len(t, p.metricKeyToDimensions.Keys(), DimensionsCacheSize)
+	p.metricsExporter = mexp
 
-	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), nil)
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	mexp.AssertNumberOfCalls(t, "ConsumeMetrics", 2)
 }
 
 func BenchmarkProcessorConsumeTraces(b *testing.B) {
@@ -336,41 +327,37 @@ func BenchmarkProcessorConsumeTraces(b *testing.B) {
 
 func newProcessorImp(mexp *mocks.MetricsExporter, tcon *mocks.TracesConsumer, defaultNullValue *string, temporality string, tb testing.TB) *processorImp {
 	defaultNotInSpanAttrVal := "defaultNotInSpanAttrVal"
-	// use size 2 for LRU cache for testing purpose
-	metricKeyToDimensions, err := cache.NewCache(DimensionsCacheSize)
-	if err != nil {
-		panic(err)
+	config := Config{AggregationTemporality: temporality}
+	dimensions := []Dimension{
+		// Set nil defaults to force a lookup for the attribute in the span.
+		{Name: stringAttrName},
+		{Name: intAttrName},
+		{Name: doubleAttrName},
+		{Name: boolAttrName},
+		{Name: mapAttrName},
+		{Name: arrayAttrName},
+		{Name: nullAttrName, Default: defaultNullValue},
+		// Add a default value for an attribute that doesn't exist in a span
+		{Name: notInSpanAttrName0, Default: &defaultNotInSpanAttrVal},
+		// Leave the default value unset to test that this dimension should not be added to the metric.
+		{Name: notInSpanAttrName1},
+		// Add a resource attribute to test "process" attributes like IP, host, region, cluster, etc.
+		{Name: regionResourceAttrName},
 	}
 	return &processorImp{
 		logger:          zaptest.NewLogger(tb),
-		config:          Config{AggregationTemporality: temporality},
+		config:          config,
 		metricsExporter: mexp,
 		nextConsumer:    tcon,
 
-		startTime:            time.Now(),
-		callSum:              make(map[metricKey]int64),
-		latencySum:           make(map[metricKey]float64),
-		latencyCount:         make(map[metricKey]uint64),
-		latencyBucketCounts:  make(map[metricKey][]uint64),
-		latencyBounds:        defaultLatencyHistogramBucketsMs,
-		latencyExemplarsData: make(map[metricKey][]exemplarData),
-		dimensions: []Dimension{
-			// Set nil defaults to force a lookup for the attribute in the span.
-			{stringAttrName, nil},
-			{intAttrName, nil},
-			{doubleAttrName, nil},
-			{boolAttrName, nil},
-			{mapAttrName, nil},
-			{arrayAttrName, nil},
-			{nullAttrName, defaultNullValue},
-			// Add a default value for an attribute that doesn't exist in a span
-			{notInSpanAttrName0, &defaultNotInSpanAttrVal},
-			// Leave the default value unset to test that this dimension should not be added to the metric.
-			{notInSpanAttrName1, nil},
-			// Add a resource attribute to test "process" attributes like IP, host, region, cluster, etc.
-			{regionResourceAttrName, nil},
-		},
-		metricKeyToDimensions: metricKeyToDimensions,
+		dimensions:    dimensions,
+		latencyBounds: defaultLatencyHistogramBucketsMs,
+		aggregator: spanmetrics.NewAggregator(spanmetrics.AggregatorSettings{
+			LatencyBounds:         defaultLatencyHistogramBucketsMs,
+			Dimensions:            dimensions,
+			MaxExemplarsPerBucket: defaultMaxExemplarsPerBucket,
+			Temporality:           config.GetAggregationTemporality(),
+		}),
 	}
 }
 
@@ -593,93 +580,6 @@ func newOTLPExporters(t *testing.T) (*otlpexporter.Config, component.MetricsExpo
 	return otlpConfig, mexp, texp
 }
 
-func TestBuildKeySameServiceOperationCharSequence(t *testing.T) {
-	span0 := pdata.NewSpan()
-	span0.SetName("c")
-	k0 := buildKey("ab", span0, nil, pdata.NewAttributeMap())
-
-	span1 := pdata.NewSpan()
-	span1.SetName("bc")
-	k1 := buildKey("a", span1, nil, pdata.NewAttributeMap())
-
-	assert.NotEqual(t, k0, k1)
-	assert.Equal(t, metricKey("ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET"), k0)
-	assert.Equal(t, metricKey("a\u0000bc\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET"), k1)
-}
-
-func TestBuildKeyWithDimensions(t *testing.T) {
-	defaultFoo := "bar"
-	for _, tc := range []struct {
-		name            string
-		optionalDims    []Dimension
-		resourceAttrMap map[string]pdata.AttributeValue
-		spanAttrMap     map[string]pdata.AttributeValue
-		wantKey         string
-	}{
-		{
-			name:    "nil optionalDims",
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET",
-		},
-		{
-			name: "neither span nor resource contains key, dim provides default",
-			optionalDims: []Dimension{
-				{Name: "foo", Default: &defaultFoo},
-			},
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u0000bar",
-		},
-		{
-			name: "neither span nor resource contains key, dim provides no default",
-			optionalDims: []Dimension{
-				{Name: "foo"},
-			},
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET",
-		},
-		{
-			name: "span attribute contains dimension",
-			optionalDims: []Dimension{
-				{Name: "foo"},
-			},
-			spanAttrMap: map[string]pdata.AttributeValue{
-				"foo": pdata.NewAttributeValueInt(99),
-			},
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u000099",
-		},
-		{
-			name: "resource attribute contains dimension",
-			optionalDims: []Dimension{
-				{Name: "foo"},
-			},
-			resourceAttrMap: map[string]pdata.AttributeValue{
-				"foo": pdata.NewAttributeValueInt(99),
-			},
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u000099",
-		},
-		{
-			name: "both span and resource attribute contains dimension, should prefer span attribute",
-			optionalDims: []Dimension{
-				{Name: "foo"},
-			},
-			spanAttrMap: map[string]pdata.AttributeValue{
-				"foo": pdata.NewAttributeValueInt(100),
-			},
-			resourceAttrMap: map[string]pdata.AttributeValue{
-				"foo": pdata.NewAttributeValueInt(99),
-			},
-			wantKey: "ab\u0000c\u0000SPAN_KIND_UNSPECIFIED\u0000STATUS_CODE_UNSET\u0000100",
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			resAttr := pdata.NewAttributeMapFromMap(tc.resourceAttrMap)
-			span0 := pdata.NewSpan()
-			pdata.NewAttributeMapFromMap(tc.spanAttrMap).CopyTo(span0.Attributes())
-			span0.SetName("c")
-			k := buildKey("ab", span0, tc.optionalDims, resAttr)
-
-			assert.Equal(t, metricKey(tc.wantKey), k)
-		})
-	}
-}
-
 func TestProcessorDuplicateDimensions(t *testing.T) {
 	// Prepare
 	factory := NewFactory()
@@ -696,6 +596,19 @@ func TestProcessorDuplicateDimensions(t *testing.T) {
 	assert.Nil(t, p)
 }
 
+func TestProcessorNegativeMaxExemplarsPerBucket(t *testing.T) {
+	// Prepare
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.MaxExemplarsPerBucket = -1
+
+	// Test
+	next := new(consumertest.TracesSink)
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, next)
+	assert.Error(t, err)
+	assert.Nil(t, p)
+}
+
 func TestValidateDimensions(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -749,6 +662,13 @@ func TestValidateDimensions(t *testing.T) {
 				{Name: "http_status_code"},
 			},
 		},
+		{
+			name: "unknown normalizer",
+			dimensions: []Dimension{
+				{Name: "http.url", Normalizer: "path"},
+			},
+			expectedErr: `dimension "http.url" has unknown normalizer "path", must be one of ["lowercase" "url_template"]`,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := validateDimensions(tc.dimensions)
@@ -769,62 +689,40 @@ func TestSanitize(t *testing.T) {
 	require.Equal(t, "test__", sanitize("test_/"))
 }
 
-func TestSetLatencyExemplars(t *testing.T) {
-	// ----- conditions -------------------------------------------------------
-	traces := buildSampleTrace()
-	traceID := traces.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0).TraceID()
-	exemplarSlice := pdata.NewExemplarSlice()
-	timestamp := pdata.NewTimestampFromTime(time.Now())
-	value := float64(42)
-
-	ed := []exemplarData{{traceID: traceID, value: value}}
-
-	// ----- call -------------------------------------------------------------
-	setLatencyExemplars(ed, timestamp, exemplarSlice)
-
-	// ----- verify -----------------------------------------------------------
-	traceIDValue, exist := exemplarSlice.At(0).FilteredAttributes().Get(traceIDKey)
-
-	assert.NotEmpty(t, exemplarSlice)
-	assert.True(t, exist)
-	assert.Equal(t, traceIDValue.AsString(), traceID.HexString())
-	assert.Equal(t, exemplarSlice.At(0).Timestamp(), timestamp)
-	assert.Equal(t, exemplarSlice.At(0).DoubleVal(), value)
-}
-
-func TestProcessorUpdateLatencyExemplars(t *testing.T) {
-	// ----- conditions -------------------------------------------------------
-	factory := NewFactory()
-	cfg := factory.CreateDefaultConfig().(*Config)
-	traces := buildSampleTrace()
-	traceID := traces.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0).TraceID()
-	key := metricKey("metricKey")
-	next := new(consumertest.TracesSink)
-	p, err := newProcessor(zaptest.NewLogger(t), cfg, next)
-	value := float64(42)
-
-	// ----- call -------------------------------------------------------------
-	p.updateLatencyExemplars(key, value, traceID)
-
-	// ----- verify -----------------------------------------------------------
-	assert.NoError(t, err)
-	assert.NotEmpty(t, p.latencyExemplarsData[key])
-	assert.Equal(t, p.latencyExemplarsData[key][0], exemplarData{traceID: traceID, value: value})
-}
-
-func TestProcessorResetExemplarData(t *testing.T) {
-	// ----- conditions -------------------------------------------------------
-	factory := NewFactory()
-	cfg := factory.CreateDefaultConfig().(*Config)
-
-	key := metricKey("metricKey")
-	next := new(consumertest.TracesSink)
-	p, err := newProcessor(zaptest.NewLogger(t), cfg, next)
-
-	// ----- call -------------------------------------------------------------
-	p.resetExemplarData()
+func TestProcessorLatencyExemplars(t *testing.T) {
+	for _, tc := range []struct {
+		name                  string
+		maxExemplarsPerBucket int
+		wantExemplars         int
+	}{
+		{name: "default", maxExemplarsPerBucket: defaultMaxExemplarsPerBucket, wantExemplars: 1},
+		{name: "disabled", maxExemplarsPerBucket: 0, wantExemplars: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig().(*Config)
+			cfg.MaxExemplarsPerBucket = tc.maxExemplarsPerBucket
+			p, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+			require.NoError(t, err)
 
-	// ----- verify -----------------------------------------------------------
-	assert.NoError(t, err)
-	assert.Empty(t, p.latencyExemplarsData[key])
+			traces := buildSampleTrace()
+			traceID := traces.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0).TraceID()
+			p.aggregator.Aggregate(traces)
+
+			// The exemplars are only attached to the metrics of the batch.
+			for _, want := range []int{tc.wantExemplars, 0} {
+				m := p.buildMetrics()
+				metrics := m.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+				require.Equal(t, 6, metrics.Len())
+				for i := 3; i < metrics.Len(); i++ {
+					exemplars := metrics.At(i).Histogram().DataPoints().At(0).Exemplars()
+					require.Equal(t, want, exemplars.Len())
+					for j := 0; j < exemplars.Len(); j++ {
+						assert.Equal(t, traceID, exemplars.At(j).TraceID())
+						assert.Equal(t, sampleLatency, exemplars.At(j).DoubleVal())
+					}
+				}
+			}
+		})
+	}
 }
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanstatusprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanstatusprocessor"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	statusCodeUnset = "Unset"
+	statusCodeError = "Error"
+	statusCodeOk    = "Ok"
+)
+
+var spanKinds = map[string]struct{}{
+	"internal": {},
+	"server":   {},
+	"client":   {},
+	"producer": {},
+	"consumer": {},
+}
+
+// Config defines the configuration for the processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Rules are evaluated in order for each span, the first matching rule
+	// setting the span status.
+	Rules []Rule `mapstructure:"rules"`
+
+	// OverrideStatus applies the rules to the spans whose status is already
+	// set to Ok or Error. By default, only the Unset statuses are changed.
+	OverrideStatus bool `mapstructure:"override_status"`
+}
+
+// Rule sets the status of the spans with an attribute matching one of its
+// values or within its range.
+type Rule struct {
+	// Attribute is the key of the span attribute to match, e.g. http.status_code.
+	Attribute string `mapstructure:"attribute"`
+
+	// Values matches the attribute values equal to one of them. The numeric
+	// and boolean values are compared by their string representation.
+	Values []string `mapstructure:"values"`
+
+	// Min and Max match the numeric attribute values, including the numeric
+	// strings, within the inclusive range. Either bound can be omitted.
+	Min *float64 `mapstructure:"min"`
+	Max *float64 `mapstructure:"max"`
+
+	// SpanKinds restricts the rule to the spans of the given kinds, one of
+	// internal, server, client, producer or consumer. Defaults to all kinds.
+	SpanKinds []string `mapstructure:"span_kinds"`
+
+	// Code is the status set on the matching spans, one of Unset, Error or Ok.
+	Code string `mapstructure:"code"`
+
+	// Description is the status message set on the spans whose status is set
+	// to Error.
+	Description string `mapstructure:"description"`
+
+	// Attributes are set on the matching spans, e.g. error.type to classify
+	// the errors.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if len(config.Rules) == 0 {
+		return errors.New("at least one rule must be specified")
+	}
+	for i, rule := range config.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) validate() error {
+	if rule.Attribute == "" {
+		return errors.New("attribute must be specified")
+	}
+	hasRange := rule.Min != nil || rule.Max != nil
+	if len(rule.Values) == 0 && !hasRange {
+		return errors.New("values or min/max must be specified")
+	}
+	if len(rule.Values) > 0 && hasRange {
+		return errors.New("values and min/max cannot be both specified")
+	}
+	if rule.Min != nil && rule.Max != nil && *rule.Min > *rule.Max {
+		return errors.New("min must not be greater than max")
+	}
+	for _, kind := range rule.SpanKinds {
+		if _, ok := spanKinds[kind]; !ok {
+			return fmt.Errorf("invalid span kind %q, must be one of internal, server, client, producer or consumer", kind)
+		}
+	}
+	switch rule.Code {
+	case statusCodeUnset, statusCodeError, statusCodeOk:
+	default:
+		return fmt.Errorf("invalid code %q, must be one of Unset, Error or Ok", rule.Code)
+	}
+	return nil
+}
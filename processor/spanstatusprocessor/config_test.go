@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanstatusprocessor
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/servicetest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	assert.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	serverErrorMin := 500.0
+	assert.Equal(t, &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		OverrideStatus:    true,
+		Rules: []Rule{
+			{
+				Attribute:   "http.status_code",
+				Min:         &serverErrorMin,
+				SpanKinds:   []string{"server"},
+				Code:        statusCodeError,
+				Description: "server error",
+			},
+			{
+				Attribute:  "app.result_code",
+				Values:     []string{"DECLINED", "OUT_OF_STOCK"},
+				Code:       statusCodeOk,
+				Attributes: map[string]string{"business.outcome": "rejected"},
+			},
+		},
+	}, cfg.Processors[config.NewComponentID(typeStr)])
+}
+
+func TestLoadInvalidConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[typeStr] = factory
+	_, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_invalid.yaml"), factories)
+	assert.EqualError(t, err, fmt.Sprintf("processor %q has invalid configuration: %s", typeStr, `rule 0: invalid code "Failed", must be one of Unset, Error or Ok`))
+}
+
+func TestValidate(t *testing.T) {
+	low, high := 500.0, 400.0
+	tests := []struct {
+		name string
+		cfg  Config
+		err  string
+	}{
+		{
+			name: "no rules",
+			cfg:  Config{},
+			err:  "at least one rule must be specified",
+		},
+		{
+			name: "no attribute",
+			cfg:  Config{Rules: []Rule{{Values: []string{"1"}, Code: statusCodeOk}}},
+			err:  "rule 0: attribute must be specified",
+		},
+		{
+			name: "no condition",
+			cfg:  Config{Rules: []Rule{{Attribute: "http.status_code", Code: statusCodeError}}},
+			err:  "rule 0: values or min/max must be specified",
+		},
+		{
+			name: "values and range",
+			cfg:  Config{Rules: []Rule{{Attribute: "http.status_code", Values: []string{"503"}, Min: &low, Code: statusCodeError}}},
+			err:  "rule 0: values and min/max cannot be both specified",
+		},
+		{
+			name: "inverted range",
+			cfg:  Config{Rules: []Rule{{Attribute: "http.status_code", Min: &low, Max: &high, Code: statusCodeError}}},
+			err:  "rule 0: min must not be greater than max",
+		},
+		{
+			name: "invalid span kind",
+			cfg:  Config{Rules: []Rule{{Attribute: "http.status_code", Min: &low, SpanKinds: []string{"SERVER"}, Code: statusCodeError}}},
+			err:  `rule 0: invalid span kind "SERVER", must be one of internal, server, client, producer or consumer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.err)
+		})
+	}
+}
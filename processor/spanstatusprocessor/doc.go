@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanstatusprocessor implements a processor which sets the status of
+// the spans from rules on their attributes, e.g. to Error for the HTTP server
+// spans with a 5xx status code, as many instrumentations leave it Unset.
+package spanstatusprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanstatusprocessor"
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanstatusprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanstatusprocessor"
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+var spanKindValues = map[string]pdata.SpanKind{
+	"internal": pdata.SpanKindInternal,
+	"server":   pdata.SpanKindServer,
+	"client":   pdata.SpanKindClient,
+	"producer": pdata.SpanKindProducer,
+	"consumer": pdata.SpanKindConsumer,
+}
+
+var statusCodeValues = map[string]pdata.StatusCode{
+	statusCodeUnset: pdata.StatusCodeUnset,
+	statusCodeError: pdata.StatusCodeError,
+	statusCodeOk:    pdata.StatusCodeOk,
+}
+
+type spanStatusProcessor struct {
+	rules          []statusRule
+	overrideStatus bool
+}
+
+// statusRule is a Rule with its values and span kinds indexed.
+type statusRule struct {
+	attribute   string
+	values      map[string]struct{}
+	min, max    *float64
+	spanKinds   map[pdata.SpanKind]struct{}
+	code        pdata.StatusCode
+	description string
+	attributes  map[string]string
+}
+
+func newSpanStatusProcessor(config *Config) *spanStatusProcessor {
+	rules := make([]statusRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		r := statusRule{
+			attribute:  rule.Attribute,
+			min:        rule.Min,
+			max:        rule.Max,
+			code:       statusCodeValues[rule.Code],
+			attributes: rule.Attributes,
+		}
+		// The description is only meaningful for the Error status.
+		if r.code == pdata.StatusCodeError {
+			r.description = rule.Description
+		}
+		if len(rule.Values) > 0 {
+			r.values = make(map[string]struct{}, len(rule.Values))
+			for _, value := range rule.Values {
+				r.values[value] = struct{}{}
+			}
+		}
+		if len(rule.SpanKinds) > 0 {
+			r.spanKinds = make(map[pdata.SpanKind]struct{}, len(rule.SpanKinds))
+			for _, kind := range rule.SpanKinds {
+				r.spanKinds[spanKindValues[kind]] = struct{}{}
+			}
+		}
+		rules = append(rules, r)
+	}
+
+	return &spanStatusProcessor{
+		rules:          rules,
+		overrideStatus: config.OverrideStatus,
+	}
+}
+
+func (sp *spanStatusProcessor) processTraces(_ context.Context, td pdata.Traces) (pdata.Traces, error) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		ilss := rss.At(i).InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				sp.processSpan(spans.At(k))
+			}
+		}
+	}
+	return td, nil
+}
+
+// processSpan applies the first rule matching the span.
+func (sp *spanStatusProcessor) processSpan(span pdata.Span) {
+	if !sp.overrideStatus && span.Status().Code() != pdata.StatusCodeUnset {
+		return
+	}
+	for _, rule := range sp.rules {
+		if !rule.matches(span) {
+			continue
+		}
+		span.Status().SetCode(rule.code)
+		span.Status().SetMessage(rule.description)
+		for key, value := range rule.attributes {
+			span.Attributes().UpsertString(key, value)
+		}
+		return
+	}
+}
+
+func (r *statusRule) matches(span pdata.Span) bool {
+	if r.spanKinds != nil {
+		if _, ok := r.spanKinds[span.Kind()]; !ok {
+			return false
+		}
+	}
+	value, ok := span.Attributes().Get(r.attribute)
+	if !ok {
+		return false
+	}
+	if r.values != nil {
+		_, ok := r.values[value.AsString()]
+		return ok
+	}
+
+	number, ok := numericValue(value)
+	if !ok {
+		return false
+	}
+	return (r.min == nil || number >= *r.min) && (r.max == nil || number <= *r.max)
+}
+
+// numericValue returns the value of the numeric attributes, and of the string
+// attributes holding a number, such as an HTTP status code recorded as "503".
+func numericValue(value pdata.AttributeValue) (float64, bool) {
+	switch value.Type() {
+	case pdata.AttributeValueTypeInt:
+		return float64(value.IntVal()), true
+	case pdata.AttributeValueTypeDouble:
+		return value.DoubleVal(), true
+	case pdata.AttributeValueTypeString:
+		number, err := strconv.ParseFloat(value.StringVal(), 64)
+		return number, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanstatusprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestProcessTraces(t *testing.T) {
+	serverErrorMin, clientErrorMin, clientErrorMax := 500.0, 400.0, 499.0
+	rules := []Rule{
+		{
+			Attribute:   "http.status_code",
+			Min:         &serverErrorMin,
+			Code:        statusCodeError,
+			Description: "server error",
+			Attributes:  map[string]string{"error.type": "http"},
+		},
+		{
+			Attribute: "http.status_code",
+			Min:       &clientErrorMin,
+			Max:       &clientErrorMax,
+			SpanKinds: []string{"client"},
+			Code:      statusCodeError,
+		},
+		{
+			Attribute: "app.result_code",
+			Values:    []string{"DECLINED", "42"},
+			Code:      statusCodeOk,
+		},
+	}
+
+	tests := []struct {
+		name                string
+		overrideStatus      bool
+		kind                pdata.SpanKind
+		status              pdata.StatusCode
+		attrs               map[string]interface{}
+		expectedStatus      pdata.StatusCode
+		expectedDescription string
+		expectedAttrs       map[string]interface{}
+	}{
+		{
+			name:                "int range",
+			kind:                pdata.SpanKindServer,
+			attrs:               map[string]interface{}{"http.status_code": 503},
+			expectedStatus:      pdata.StatusCodeError,
+			expectedDescription: "server error",
+			expectedAttrs:       map[string]interface{}{"http.status_code": int64(503), "error.type": "http"},
+		},
+		{
+			name:                "numeric string range",
+			kind:                pdata.SpanKindServer,
+			attrs:               map[string]interface{}{"http.status_code": "500"},
+			expectedStatus:      pdata.StatusCodeError,
+			expectedDescription: "server error",
+			expectedAttrs:       map[string]interface{}{"http.status_code": "500", "error.type": "http"},
+		},
+		{
+			name:           "out of range",
+			kind:           pdata.SpanKindServer,
+			attrs:          map[string]interface{}{"http.status_code": 404},
+			expectedStatus: pdata.StatusCodeUnset,
+			expectedAttrs:  map[string]interface{}{"http.status_code": int64(404)},
+		},
+		{
+			name:           "span kind",
+			kind:           pdata.SpanKindClient,
+			attrs:          map[string]interface{}{"http.status_code": 404},
+			expectedStatus: pdata.StatusCodeError,
+			expectedAttrs:  map[string]interface{}{"http.status_code": int64(404)},
+		},
+		{
+			name:           "not numeric",
+			kind:           pdata.SpanKindServer,
+			attrs:          map[string]interface{}{"http.status_code": "unavailable"},
+			expectedStatus: pdata.StatusCodeUnset,
+			expectedAttrs:  map[string]interface{}{"http.status_code": "unavailable"},
+		},
+		{
+			name:           "values",
+			kind:           pdata.SpanKindInternal,
+			attrs:          map[string]interface{}{"app.result_code": "DECLINED"},
+			expectedStatus: pdata.StatusCodeOk,
+			expectedAttrs:  map[string]interface{}{"app.result_code": "DECLINED"},
+		},
+		{
+			name:           "int values",
+			kind:           pdata.SpanKindInternal,
+			attrs:          map[string]interface{}{"app.result_code": 42},
+			expectedStatus: pdata.StatusCodeOk,
+			expectedAttrs:  map[string]interface{}{"app.result_code": int64(42)},
+		},
+		{
+			name:                "first matching rule",
+			kind:                pdata.SpanKindServer,
+			attrs:               map[string]interface{}{"app.result_code": "DECLINED", "http.status_code": 502},
+			expectedStatus:      pdata.StatusCodeError,
+			expectedDescription: "server error",
+			expectedAttrs:       map[string]interface{}{"app.result_code": "DECLINED", "http.status_code": int64(502), "error.type": "http"},
+		},
+		{
+			name:           "status set",
+			kind:           pdata.SpanKindServer,
+			status:         pdata.StatusCodeOk,
+			attrs:          map[string]interface{}{"http.status_code": 503},
+			expectedStatus: pdata.StatusCodeOk,
+			expectedAttrs:  map[string]interface{}{"http.status_code": int64(503)},
+		},
+		{
+			name:                "status overridden",
+			overrideStatus:      true,
+			kind:                pdata.SpanKindServer,
+			status:              pdata.StatusCodeOk,
+			attrs:               map[string]interface{}{"http.status_code": 503},
+			expectedStatus:      pdata.StatusCodeError,
+			expectedDescription: "server error",
+			expectedAttrs:       map[string]interface{}{"http.status_code": int64(503), "error.type": "http"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := newSpanStatusProcessor(&Config{Rules: rules, OverrideStatus: tt.overrideStatus})
+
+			td := pdata.NewTraces()
+			span := td.ResourceSpans().AppendEmpty().InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+			span.SetKind(tt.kind)
+			span.Status().SetCode(tt.status)
+			pdata.NewAttributeMapFromMap(attributeValues(tt.attrs)).CopyTo(span.Attributes())
+
+			td, err := sp.processTraces(context.Background(), td)
+			require.NoError(t, err)
+
+			span = td.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+			assert.Equal(t, tt.expectedStatus, span.Status().Code())
+			assert.Equal(t, tt.expectedDescription, span.Status().Message())
+			assert.Equal(t, tt.expectedAttrs, span.Attributes().AsRaw())
+		})
+	}
+}
+
+func attributeValues(attrs map[string]interface{}) map[string]pdata.AttributeValue {
+	values := make(map[string]pdata.AttributeValue, len(attrs))
+	for key, value := range attrs {
+		switch v := value.(type) {
+		case int:
+			values[key] = pdata.NewAttributeValueInt(int64(v))
+		case string:
+			values[key] = pdata.NewAttributeValueString(v)
+		}
+	}
+	return values
+}
@@ -23,13 +23,31 @@ import (
 )
 
 func getNewAndPolicy(logger *zap.Logger, config AndCfg) (sampling.PolicyEvaluator, error) {
+	subPolicyEvaluators, err := getAndSubPolicyEvaluators(logger, config.SubPolicyCfg)
+	if err != nil {
+		return nil, err
+	}
+	return sampling.NewAnd(logger, subPolicyEvaluators), nil
+}
+
+func getNewDropPolicy(logger *zap.Logger, config DropCfg) (sampling.PolicyEvaluator, error) {
+	subPolicyEvaluators, err := getAndSubPolicyEvaluators(logger, config.SubPolicyCfg)
+	if err != nil {
+		return nil, err
+	}
+	return sampling.NewDrop(logger, subPolicyEvaluators), nil
+}
+
+func getAndSubPolicyEvaluators(logger *zap.Logger, cfgs []AndSubPolicyCfg) ([]sampling.PolicyEvaluator, error) {
 	var subPolicyEvaluators []sampling.PolicyEvaluator
-	for i := range config.SubPolicyCfg {
-		policyCfg := config.SubPolicyCfg[i]
-		policy, _ := getAndSubPolicyEvaluator(logger, &policyCfg)
+	for i := range cfgs {
+		policy, err := getAndSubPolicyEvaluator(logger, &cfgs[i])
+		if err != nil {
+			return nil, err
+		}
 		subPolicyEvaluators = append(subPolicyEvaluators, policy)
 	}
-	return sampling.NewAnd(logger, subPolicyEvaluators), nil
+	return subPolicyEvaluators, nil
 }
 
 // Return instance of and sub-policy
@@ -77,6 +77,10 @@ func getSubPolicyEvaluator(logger *zap.Logger, cfg *SubPolicyCfg) (sampling.Poli
 	case RateLimiting:
 		rlfCfg := cfg.RateLimitingCfg
 		return sampling.NewRateLimiting(logger, rlfCfg.SpansPerSecond), nil
+	case ServiceRateLimiting:
+		srlCfg := cfg.ServiceRateLimitingCfg
+		return sampling.NewServiceRateLimiting(logger, srlCfg.SpansPerSecond, srlCfg.DefaultSpansPerSecond,
+			srlCfg.FallbackSamplingPercentage, srlCfg.HashSalt, sampling.MonotonicClock{})
 	case And:
 		return getNewAndPolicy(logger, cfg.AndCfg)
 	default:
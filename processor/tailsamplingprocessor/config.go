@@ -40,10 +40,16 @@ const (
 	StringAttribute PolicyType = "string_attribute"
 	// RateLimiting allows all traces until the specified limits are satisfied.
 	RateLimiting PolicyType = "rate_limiting"
+	// ServiceRateLimiting samples the traces of each service within its spans per second budget, and a
+	// percentage of the traces of the services over budget.
+	ServiceRateLimiting PolicyType = "service_rate_limiting"
 	// Composite allows defining a composite policy, combining the other policies in one
 	Composite PolicyType = "composite"
 	// And allows defining a And policy, combining the other policies in one
 	And PolicyType = "and"
+	// Drop allows defining a Drop policy, never sampling the traces matched by all of its sub-policies,
+	// regardless of the other policies.
+	Drop PolicyType = "drop"
 )
 
 // SubPolicyCfg holds the common configuration to all policies under composite policy.
@@ -64,6 +70,8 @@ type SubPolicyCfg struct {
 	StringAttributeCfg StringAttributeCfg `mapstructure:"string_attribute"`
 	// Configs for rate limiting filter sampling policy evaluator.
 	RateLimitingCfg RateLimitingCfg `mapstructure:"rate_limiting"`
+	// Configs for service rate limiting sampling policy evaluator.
+	ServiceRateLimitingCfg ServiceRateLimitingCfg `mapstructure:"service_rate_limiting"`
 	// Configs for and policy evaluator.
 	AndCfg AndCfg `mapstructure:"and"`
 }
@@ -91,6 +99,12 @@ type AndCfg struct {
 	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"and_sub_policy"`
 }
 
+// DropCfg holds the configurable settings to create a drop sampling policy evaluator.
+// A trace is dropped when all the sub-policies sample it.
+type DropCfg struct {
+	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"drop_sub_policy"`
+}
+
 // CompositeCfg holds the configurable settings to create a composite
 // sampling policy evaluator.
 type CompositeCfg struct {
@@ -124,10 +138,14 @@ type PolicyCfg struct {
 	StringAttributeCfg StringAttributeCfg `mapstructure:"string_attribute"`
 	// Configs for rate limiting filter sampling policy evaluator.
 	RateLimitingCfg RateLimitingCfg `mapstructure:"rate_limiting"`
+	// Configs for service rate limiting sampling policy evaluator.
+	ServiceRateLimitingCfg ServiceRateLimitingCfg `mapstructure:"service_rate_limiting"`
 	// Configs for defining composite policy
 	CompositeCfg CompositeCfg `mapstructure:"composite"`
 	// Configs for defining and policy
 	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for defining drop policy
+	DropCfg DropCfg `mapstructure:"drop"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -193,6 +211,21 @@ type RateLimitingCfg struct {
 	SpansPerSecond int64 `mapstructure:"spans_per_second"`
 }
 
+// ServiceRateLimitingCfg holds the configurable settings to create a service rate limiting
+// sampling policy evaluator.
+type ServiceRateLimitingCfg struct {
+	// SpansPerSecond sets the spans per second budget of each service.name.
+	SpansPerSecond map[string]int64 `mapstructure:"spans_per_second"`
+	// DefaultSpansPerSecond sets the spans per second budget of each service not in SpansPerSecond. Defaults to
+	// zero, i.e.: the traces of these services are only sampled by the fallback.
+	DefaultSpansPerSecond int64 `mapstructure:"default_spans_per_second"`
+	// FallbackSamplingPercentage is the percentage of the traces sampled when their service is over budget.
+	// Defaults to zero, i.e.: no traces over budget are sampled.
+	FallbackSamplingPercentage float64 `mapstructure:"fallback_sampling_percentage"`
+	// HashSalt is the salt of the hash of the trace IDs used by the fallback, see ProbabilisticCfg.
+	HashSalt string `mapstructure:"hash_salt"`
+}
+
 // Config holds the configuration for tail-based sampling.
 type Config struct {
 	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
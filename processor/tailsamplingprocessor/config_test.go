@@ -78,6 +78,16 @@ func TestLoadConfig(t *testing.T) {
 					Type:            RateLimiting,
 					RateLimitingCfg: RateLimitingCfg{SpansPerSecond: 35},
 				},
+				{
+					Name: "test-policy-8",
+					Type: ServiceRateLimiting,
+					ServiceRateLimitingCfg: ServiceRateLimitingCfg{
+						SpansPerSecond:             map[string]int64{"checkout": 200, "frontend": 50},
+						DefaultSpansPerSecond:      10,
+						FallbackSamplingPercentage: 5,
+						HashSalt:                   "salt",
+					},
+				},
 				{
 					Name: "and-policy-1",
 					Type: And,
@@ -96,6 +106,19 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: "drop-policy-1",
+					Type: Drop,
+					DropCfg: DropCfg{
+						SubPolicyCfg: []AndSubPolicyCfg{
+							{
+								Name:               "test-drop-policy-1",
+								Type:               StringAttribute,
+								StringAttributeCfg: StringAttributeCfg{Key: "http.target", Values: []string{"/health", "/ready"}},
+							},
+						},
+					},
+				},
 				{
 					Name: "composite-policy-1",
 					Type: Composite,
@@ -39,13 +39,13 @@ func NewAnd(
 // Evaluate looks at the trace data and returns a corresponding SamplingDecision.
 func (c *And) Evaluate(traceID pdata.TraceID, trace *TraceData) (Decision, error) {
 	// The policy iterates over all sub-policies and returns Sampled if all sub-policies returned a Sampled Decision.
-	// If any subpolicy returns NotSampled, it returns NotSampled Decision.
+	// If any subpolicy returns NotSampled or InvertNotSampled, it returns NotSampled Decision.
 	for _, sub := range c.subpolicies {
 		decision, err := sub.Evaluate(traceID, trace)
 		if err != nil {
 			return Unspecified, err
 		}
-		if decision == NotSampled {
+		if decision == NotSampled || decision == InvertNotSampled {
 			return NotSampled, nil
 		}
 
@@ -77,3 +77,22 @@ func TestAndEvaluatorSampled(t *testing.T) {
 	assert.Equal(t, decision, Sampled)
 
 }
+
+func TestAndEvaluatorInvertNotSampled(t *testing.T) {
+	// Keep errors unless they are health checks.
+	n1 := NewStringAttributeFilter(zap.NewNop(), "http.target", []string{"/health"}, false, 0, true)
+	n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
+	if err != nil {
+		t.FailNow()
+	}
+
+	and := NewAnd(zap.NewNop(), []PolicyEvaluator{n1, n2})
+
+	decision, err := and.Evaluate(traceID, newDropTestTrace("/health"))
+	require.NoError(t, err, "Failed to evaluate and policy: %v", err)
+	assert.Equal(t, decision, NotSampled)
+
+	decision, err = and.Evaluate(traceID, newDropTestTrace("/checkout"))
+	require.NoError(t, err, "Failed to evaluate and policy: %v", err)
+	assert.Equal(t, decision, Sampled)
+}
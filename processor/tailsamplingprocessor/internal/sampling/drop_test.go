@@ -0,0 +1,86 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func newDropTestTrace(target string) *TraceData {
+	traces := pdata.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	ils := rs.InstrumentationLibrarySpans().AppendEmpty()
+
+	span := ils.Spans().AppendEmpty()
+	span.Attributes().InsertString("http.target", target)
+	span.Status().SetCode(pdata.StatusCodeError)
+	span.SetTraceID(pdata.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pdata.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+
+	return &TraceData{
+		ReceivedBatches: []pdata.Traces{traces},
+	}
+}
+
+func TestDropEvaluator(t *testing.T) {
+	cases := []struct {
+		name     string
+		target   string
+		invert   bool
+		decision Decision
+	}{
+		{
+			name:     "all sub-policies match",
+			target:   "/health",
+			decision: Dropped,
+		},
+		{
+			name:     "one sub-policy does not match",
+			target:   "/checkout",
+			decision: NotSampled,
+		},
+		{
+			name:     "inverted sub-policy matches",
+			target:   "/checkout",
+			invert:   true,
+			decision: Dropped,
+		},
+		{
+			name:     "inverted sub-policy does not match",
+			target:   "/health",
+			invert:   true,
+			decision: NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n1 := NewStringAttributeFilter(zap.NewNop(), "http.target", []string{"/health"}, false, 0, c.invert)
+			n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
+			require.NoError(t, err)
+
+			drop := NewDrop(zap.NewNop(), []PolicyEvaluator{n1, n2})
+
+			decision, err := drop.Evaluate(traceID, newDropTestTrace(c.target))
+			require.NoError(t, err, "Failed to evaluate drop policy: %v", err)
+			assert.Equal(t, c.decision, decision)
+		})
+	}
+}
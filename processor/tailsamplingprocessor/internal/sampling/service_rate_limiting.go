@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.uber.org/zap"
+)
+
+// serviceBudget tracks the spans sampled for a service in the current second.
+type serviceBudget struct {
+	spansPerSecond       int64
+	currentSecond        int64
+	spansInCurrentSecond int64
+}
+
+type serviceRateLimiting struct {
+	logger *zap.Logger
+	// budgets are the budgets of the configured services.
+	budgets map[string]*serviceBudget
+	// defaultBudgets are the budgets of the other services when defaultSpansPerSecond
+	// is set. Service names are unbounded, so they only hold the services seen in
+	// defaultBudgetsSecond and are reset every second.
+	defaultBudgets        map[string]*serviceBudget
+	defaultBudgetsSecond  int64
+	defaultSpansPerSecond int64
+	// fallbackThreshold is the hash threshold of the traces sampled when
+	// their service is over budget.
+	fallbackThreshold uint64
+	hashSalt          string
+	timeProvider      TimeProvider
+}
+
+var _ PolicyEvaluator = (*serviceRateLimiting)(nil)
+
+// NewServiceRateLimiting creates a policy evaluator sampling the traces of each
+// service until the spans per second budget of the service is spent, and a
+// percentage of the traces of the services over budget. The service of a trace
+// is the service of its root span, or of its first span when the root span
+// was not received. Services without a budget use defaultSpansPerSecond.
+func NewServiceRateLimiting(
+	logger *zap.Logger,
+	spansPerSecond map[string]int64,
+	defaultSpansPerSecond int64,
+	fallbackSamplingPercentage float64,
+	hashSalt string,
+	timeProvider TimeProvider,
+) (PolicyEvaluator, error) {
+	if defaultSpansPerSecond < 0 {
+		return nil, fmt.Errorf("default spans per second must not be negative, got %d", defaultSpansPerSecond)
+	}
+	if fallbackSamplingPercentage < 0 || fallbackSamplingPercentage > 100 {
+		return nil, fmt.Errorf("fallback sampling percentage must be between 0 and 100, got %v", fallbackSamplingPercentage)
+	}
+
+	budgets := make(map[string]*serviceBudget, len(spansPerSecond))
+	for service, sps := range spansPerSecond {
+		if sps < 0 {
+			return nil, fmt.Errorf("spans per second of service %q must not be negative, got %d", service, sps)
+		}
+		budgets[service] = &serviceBudget{spansPerSecond: sps}
+	}
+	if hashSalt == "" {
+		hashSalt = defaultHashSalt
+	}
+
+	return &serviceRateLimiting{
+		logger:                logger,
+		budgets:               budgets,
+		defaultBudgets:        make(map[string]*serviceBudget),
+		defaultSpansPerSecond: defaultSpansPerSecond,
+		fallbackThreshold:     calculateThreshold(fallbackSamplingPercentage / 100),
+		hashSalt:              hashSalt,
+		timeProvider:          timeProvider,
+	}, nil
+}
+
+func (s *serviceRateLimiting) OnLateArrivingSpans(Decision, []*pdata.Span) error {
+	s.logger.Debug("Triggering action for late arriving spans in service rate-limiting filter")
+	return nil
+}
+
+func (s *serviceRateLimiting) Evaluate(traceID pdata.TraceID, trace *TraceData) (Decision, error) {
+	s.logger.Debug("Evaluating spans in service rate-limiting filter")
+
+	trace.Lock()
+	service := traceService(trace.ReceivedBatches)
+	spanCount := trace.SpanCount
+	trace.Unlock()
+
+	currSecond := s.timeProvider.getCurSecond()
+	budget, ok := s.budgets[service]
+	if !ok && s.defaultSpansPerSecond > 0 {
+		budget = s.defaultBudget(service, currSecond)
+	}
+
+	if budget != nil {
+		if budget.currentSecond != currSecond {
+			budget.currentSecond = currSecond
+			budget.spansInCurrentSecond = 0
+		}
+		if spansInSecondIfSampled := budget.spansInCurrentSecond + spanCount; spansInSecondIfSampled <= budget.spansPerSecond {
+			budget.spansInCurrentSecond = spansInSecondIfSampled
+			return Sampled, nil
+		}
+	}
+
+	traceIDBytes := traceID.Bytes()
+	if s.fallbackThreshold > 0 && hashTraceID(s.hashSalt, traceIDBytes[:]) <= s.fallbackThreshold {
+		return Sampled, nil
+	}
+	return NotSampled, nil
+}
+
+// defaultBudget returns the budget of a service without a configured budget in
+// the current second, dropping the budgets of the previous seconds.
+func (s *serviceRateLimiting) defaultBudget(service string, currSecond int64) *serviceBudget {
+	if s.defaultBudgetsSecond != currSecond {
+		s.defaultBudgetsSecond = currSecond
+		s.defaultBudgets = make(map[string]*serviceBudget)
+	}
+	budget, ok := s.defaultBudgets[service]
+	if !ok {
+		budget = &serviceBudget{spansPerSecond: s.defaultSpansPerSecond, currentSecond: currSecond}
+		s.defaultBudgets[service] = budget
+	}
+	return budget
+}
+
+// traceService returns the service.name of the resource of the root span of
+// the trace, or of the first span when the root span is missing.
+func traceService(batches []pdata.Traces) string {
+	first := ""
+	for _, batch := range batches {
+		rspans := batch.ResourceSpans()
+		for i := 0; i < rspans.Len(); i++ {
+			rs := rspans.At(i)
+			service := ""
+			if v, ok := rs.Resource().Attributes().Get(conventions.AttributeServiceName); ok {
+				service = v.StringVal()
+			}
+			if first == "" {
+				first = service
+			}
+
+			isRoot := hasInstrumentationLibrarySpanWithCondition(rs.InstrumentationLibrarySpans(), func(span pdata.Span) bool {
+				return span.ParentSpanID().IsEmpty()
+			})
+			if isRoot {
+				return service
+			}
+		}
+	}
+	return first
+}
@@ -0,0 +1,171 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.uber.org/zap"
+)
+
+// newServiceTrace creates a trace with a span per service, the first one being the root span.
+func newServiceTrace(spanCount int64, services ...string) *TraceData {
+	traces := pdata.NewTraces()
+	for i, service := range services {
+		rs := traces.ResourceSpans().AppendEmpty()
+		if service != "" {
+			rs.Resource().Attributes().InsertString(conventions.AttributeServiceName, service)
+		}
+		span := rs.InstrumentationLibrarySpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetSpanID(pdata.NewSpanID([8]byte{byte(i + 1)}))
+		if i > 0 {
+			span.SetParentSpanID(pdata.NewSpanID([8]byte{1}))
+		}
+	}
+	return &TraceData{
+		SpanCount:       spanCount,
+		ReceivedBatches: []pdata.Traces{traces},
+	}
+}
+
+func TestServiceRateLimiting(t *testing.T) {
+	timeProvider := &FakeTimeProvider{second: 1}
+	policy, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"checkout": 10, "noisy": 5}, 0, 0, "", timeProvider)
+	require.NoError(t, err)
+
+	evaluate := func(trace *TraceData) Decision {
+		decision, err := policy.Evaluate(traceID, trace)
+		require.NoError(t, err)
+		return decision
+	}
+
+	// the noisy service spends its budget without affecting the others
+	assert.Equal(t, Sampled, evaluate(newServiceTrace(5, "noisy")))
+	assert.Equal(t, NotSampled, evaluate(newServiceTrace(1, "noisy")))
+	assert.Equal(t, Sampled, evaluate(newServiceTrace(6, "checkout")))
+	assert.Equal(t, Sampled, evaluate(newServiceTrace(4, "checkout")))
+	assert.Equal(t, NotSampled, evaluate(newServiceTrace(1, "checkout")))
+
+	// services without a budget are only sampled by the fallback
+	assert.Equal(t, NotSampled, evaluate(newServiceTrace(1, "unknown")))
+
+	// the budgets are renewed every second
+	timeProvider.second = 2
+	assert.Equal(t, Sampled, evaluate(newServiceTrace(5, "noisy")))
+}
+
+func TestServiceRateLimitingTraceService(t *testing.T) {
+	policy, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"frontend": 10}, 0, 0, "", &FakeTimeProvider{})
+	require.NoError(t, err)
+
+	// the service of the root span is charged
+	decision, err := policy.Evaluate(traceID, newServiceTrace(10, "frontend", "backend"))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+
+	decision, err = policy.Evaluate(traceID, newServiceTrace(1, "backend", "frontend"))
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+
+	// without a root span, the service of the first span is charged
+	trace := newServiceTrace(1, "backend", "frontend")
+	span := trace.ReceivedBatches[0].ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+	span.SetParentSpanID(pdata.NewSpanID([8]byte{9}))
+	assert.Equal(t, "backend", traceService(trace.ReceivedBatches))
+}
+
+func TestServiceRateLimitingDefaultBudget(t *testing.T) {
+	policy, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"checkout": 10}, 3, 0, "", &FakeTimeProvider{})
+	require.NoError(t, err)
+
+	// each service without a budget gets its own default budget
+	for _, service := range []string{"a", "b", ""} {
+		decision, err := policy.Evaluate(traceID, newServiceTrace(3, service))
+		require.NoError(t, err)
+		assert.Equal(t, Sampled, decision, service)
+		decision, err = policy.Evaluate(traceID, newServiceTrace(1, service))
+		require.NoError(t, err)
+		assert.Equal(t, NotSampled, decision, service)
+	}
+}
+
+func TestServiceRateLimitingDefaultBudgetsReset(t *testing.T) {
+	timeProvider := &FakeTimeProvider{second: 1}
+	policy, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"checkout": 10}, 3, 0, "", timeProvider)
+	require.NoError(t, err)
+
+	for _, service := range []string{"a", "b", "c"} {
+		decision, err := policy.Evaluate(traceID, newServiceTrace(3, service))
+		require.NoError(t, err)
+		assert.Equal(t, Sampled, decision, service)
+	}
+	assert.Len(t, policy.(*serviceRateLimiting).defaultBudgets, 3)
+
+	// the default budgets of the previous seconds are dropped
+	timeProvider.second = 2
+	decision, err := policy.Evaluate(traceID, newServiceTrace(3, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+	assert.Len(t, policy.(*serviceRateLimiting).defaultBudgets, 1)
+	assert.Len(t, policy.(*serviceRateLimiting).budgets, 1)
+}
+
+func TestServiceRateLimitingFallback(t *testing.T) {
+	policy, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"noisy": 0}, 0, 30, "", &FakeTimeProvider{})
+	require.NoError(t, err)
+
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		id := pdata.NewTraceID([16]byte{byte(i), byte(i >> 8), 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+		decision, err := policy.Evaluate(id, newServiceTrace(1, "noisy"))
+		require.NoError(t, err)
+		if decision == Sampled {
+			sampled++
+		}
+	}
+	assert.InDelta(t, 300, sampled, 60)
+
+	// the fallback matches the probabilistic policy with the same percentage and salt
+	probabilistic := NewProbabilisticSampler(zap.NewNop(), "", 30)
+	for i := 0; i < 100; i++ {
+		id := pdata.NewTraceID([16]byte{byte(i), 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+		expected, err := probabilistic.Evaluate(id, nil)
+		require.NoError(t, err)
+		decision, err := policy.Evaluate(id, newServiceTrace(1, "noisy"))
+		require.NoError(t, err)
+		assert.Equal(t, expected, decision)
+	}
+}
+
+func TestNewServiceRateLimitingErrors(t *testing.T) {
+	_, err := NewServiceRateLimiting(zap.NewNop(), map[string]int64{"checkout": -1}, 0, 0, "", MonotonicClock{})
+	assert.EqualError(t, err, `spans per second of service "checkout" must not be negative, got -1`)
+
+	_, err = NewServiceRateLimiting(zap.NewNop(), nil, -1, 0, "", MonotonicClock{})
+	assert.EqualError(t, err, "default spans per second must not be negative, got -1")
+
+	_, err = NewServiceRateLimiting(zap.NewNop(), nil, 0, 101, "", MonotonicClock{})
+	assert.EqualError(t, err, "fallback sampling percentage must be between 0 and 100, got 101")
+}
+
+func TestOnLateArrivingSpans_ServiceRateLimiting(t *testing.T) {
+	policy, err := NewServiceRateLimiting(zap.NewNop(), nil, 0, 0, "", MonotonicClock{})
+	require.NoError(t, err)
+	assert.Nil(t, policy.OnLateArrivingSpans(NotSampled, nil))
+}
@@ -136,12 +136,18 @@ func getPolicyEvaluator(logger *zap.Logger, cfg *PolicyCfg) (sampling.PolicyEval
 	case RateLimiting:
 		rlfCfg := cfg.RateLimitingCfg
 		return sampling.NewRateLimiting(logger, rlfCfg.SpansPerSecond), nil
+	case ServiceRateLimiting:
+		srlCfg := cfg.ServiceRateLimitingCfg
+		return sampling.NewServiceRateLimiting(logger, srlCfg.SpansPerSecond, srlCfg.DefaultSpansPerSecond,
+			srlCfg.FallbackSamplingPercentage, srlCfg.HashSalt, sampling.MonotonicClock{})
 	case Composite:
 		rlfCfg := cfg.CompositeCfg
 		return getNewCompositePolicy(logger, rlfCfg)
 	case And:
 		andCfg := cfg.AndCfg
 		return getNewAndPolicy(logger, andCfg)
+	case Drop:
+		return getNewDropPolicy(logger, cfg.DropCfg)
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
 	}
@@ -213,6 +219,7 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pdata.TraceID, trace *samp
 		sampling.NotSampled:       false,
 		sampling.InvertSampled:    false,
 		sampling.InvertNotSampled: false,
+		sampling.Dropped:          false,
 	}
 
 	// Check all policies before making a final decision
@@ -245,12 +252,16 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pdata.TraceID, trace *samp
 			case sampling.InvertNotSampled:
 				samplingDecision[sampling.InvertNotSampled] = true
 				trace.Decisions[i] = sampling.NotSampled
+
+			case sampling.Dropped:
+				samplingDecision[sampling.Dropped] = true
+				trace.Decisions[i] = sampling.NotSampled
 			}
 		}
 	}
 
-	// InvertNotSampled takes precedence over any other decision
-	if samplingDecision[sampling.InvertNotSampled] {
+	// Dropped and InvertNotSampled take precedence over any other decision
+	if samplingDecision[sampling.Dropped] || samplingDecision[sampling.InvertNotSampled] {
 		finalDecision = sampling.NotSampled
 	} else if samplingDecision[sampling.Sampled] {
 		finalDecision = sampling.Sampled
@@ -258,6 +269,16 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pdata.TraceID, trace *samp
 		finalDecision = sampling.Sampled
 	}
 
+	// Late arriving spans are forwarded when any policy sampled the trace, so the
+	// sampled decisions overridden by a drop or invert match are reset.
+	if finalDecision == sampling.NotSampled {
+		for i := range trace.Decisions {
+			if trace.Decisions[i] == sampling.Sampled {
+				trace.Decisions[i] = sampling.NotSampled
+			}
+		}
+	}
+
 	for _, p := range tsp.policies {
 		switch finalDecision {
 		case sampling.Sampled:
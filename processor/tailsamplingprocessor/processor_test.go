@@ -495,6 +495,75 @@ func TestSamplingPolicyDecisionInvertNotSampled(t *testing.T) {
 	require.Equal(t, 2, mpe.LateArrivingSpanCount, "policy was not notified of the late span")
 }
 
+func TestSamplingPolicyDecisionDropped(t *testing.T) {
+	const maxSize = 100
+	const decisionWaitSeconds = 5
+	// For this test explicitly control the timer calls and batcher, and set mock
+	// sampling policy evaluators.
+	msp := new(consumertest.TracesSink)
+	mpe1 := &mockPolicyEvaluator{}
+	mpe2 := &mockPolicyEvaluator{}
+	mtt := &manualTTicker{}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    msp,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
+		policies: []*policy{
+			{
+				name: "policy-1", evaluator: mpe1, ctx: context.TODO(),
+			},
+			{
+				name: "drop-policy", evaluator: mpe2, ctx: context.TODO(),
+			}},
+		deleteChan:      make(chan pdata.TraceID, maxSize),
+		policyTicker:    mtt,
+		tickerFrequency: 100 * time.Millisecond,
+	}
+	tsp.Start(context.Background(), componenttest.NewNopHost())
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	_, batches := generateIdsAndBatches(210)
+	currItem := 0
+	numSpansPerBatchWindow := 10
+	for evalNum := 0; evalNum < decisionWaitSeconds; evalNum++ {
+		for ; currItem < numSpansPerBatchWindow*(evalNum+1); currItem++ {
+			tsp.ConsumeTraces(context.Background(), batches[currItem])
+		}
+		tsp.samplingPolicyOnTick()
+	}
+
+	// The drop policy takes precedence over the policy deciding to sample.
+	mpe1.NextDecision = sampling.Sampled
+	mpe2.NextDecision = sampling.Dropped
+	tsp.samplingPolicyOnTick()
+	require.EqualValues(t, 0, msp.SpanCount(), "exporter should have received zero spans")
+	require.EqualValues(t, 4, mpe2.EvaluationCount, "drop policy should have been evaluated 4 times")
+
+	// Late span of a dropped trace should be ignored
+	tsp.ConsumeTraces(context.Background(), batches[0])
+	require.Equal(t, 0, msp.SpanCount())
+
+	// Traces not matched by the drop policy are sampled by the other policies.
+	mpe2.NextDecision = sampling.NotSampled
+	tsp.samplingPolicyOnTick()
+	require.NotZero(t, msp.SpanCount(), "exporter should have received the spans of the next traces")
+}
+
+func TestDropPolicyWithUnknownSubPolicy(t *testing.T) {
+	_, err := getPolicyEvaluator(zap.NewNop(), &PolicyCfg{
+		Name: "drop-policy",
+		Type: Drop,
+		DropCfg: DropCfg{
+			SubPolicyCfg: []AndSubPolicyCfg{{Name: "unknown", Type: "unknown"}},
+		},
+	})
+	require.EqualError(t, err, "unknown sampling policy type unknown")
+}
+
 func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	const maxSize = 100
 	const decisionWaitSeconds = 1
@@ -0,0 +1,209 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package activemqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/models"
+)
+
+// jolokiaPath is the path to the Jolokia endpoint of the ActiveMQ web console
+const jolokiaPath = "/api/jolokia"
+
+// destinationMBean is the pattern matching the MBeans of all the queues and topics
+const destinationMBean = "org.apache.activemq:type=Broker,brokerName=*,destinationType=*,destinationName=*"
+
+// destinationAttributes are the attributes read from the destination MBeans
+var destinationAttributes = []string{
+	"QueueSize",
+	"ConsumerCount",
+	"ProducerCount",
+	"EnqueueCount",
+	"DequeueCount",
+	"ExpiredCount",
+	"MemoryUsageByteCount",
+	"MemoryPercentUsage",
+}
+
+type client interface {
+	// GetDestinations reads the destination MBeans through Jolokia to get the list of queues and topics of the broker
+	GetDestinations(ctx context.Context) ([]*models.Destination, error)
+}
+
+var _ client = (*activemqClient)(nil)
+
+type activemqClient struct {
+	client       *http.Client
+	hostEndpoint string
+	creds        activemqCredentials
+	logger       *zap.Logger
+}
+
+type activemqCredentials struct {
+	username string
+	password string
+}
+
+// jolokiaRequest is a Jolokia read request
+type jolokiaRequest struct {
+	Type      string   `json:"type"`
+	MBean     string   `json:"mbean"`
+	Attribute []string `json:"attribute"`
+}
+
+// jolokiaResponse is the response to a Jolokia read request of an MBean pattern,
+// its value is keyed by the names of the matching MBeans.
+type jolokiaResponse struct {
+	Status int                                `json:"status"`
+	Error  string                             `json:"error"`
+	Value  map[string]models.DestinationStats `json:"value"`
+}
+
+func newClient(cfg *Config, host component.Host, settings component.TelemetrySettings, logger *zap.Logger) (client, error) {
+	httpClient, err := cfg.ToClient(host.GetExtensions(), settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+	}
+
+	return &activemqClient{
+		client:       httpClient,
+		hostEndpoint: cfg.Endpoint,
+		creds: activemqCredentials{
+			username: cfg.Username,
+			password: cfg.Password,
+		},
+		logger: logger,
+	}, nil
+}
+
+func (c *activemqClient) GetDestinations(ctx context.Context) ([]*models.Destination, error) {
+	var resp jolokiaResponse
+
+	if err := c.post(ctx, jolokiaPath, &jolokiaRequest{Type: "read", MBean: destinationMBean, Attribute: destinationAttributes}, &resp); err != nil {
+		c.logger.Debug("Failed to retrieve destinations", zap.Error(err))
+		return nil, err
+	}
+
+	switch resp.Status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// Jolokia reports a pattern matching no MBean as not found
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("jolokia request failed with status %d: %s", resp.Status, resp.Error)
+	}
+
+	destinations := make([]*models.Destination, 0, len(resp.Value))
+	for name, stats := range resp.Value {
+		properties := parseObjectNameProperties(name)
+		destinations = append(destinations, &models.Destination{
+			BrokerName: properties["brokerName"],
+			Name:       properties["destinationName"],
+			Type:       properties["destinationType"],
+			Stats:      stats,
+		})
+	}
+
+	// Sort the destinations as the MBeans are not returned in a stable order
+	sort.Slice(destinations, func(i, j int) bool {
+		if destinations[i].BrokerName != destinations[j].BrokerName {
+			return destinations[i].BrokerName < destinations[j].BrokerName
+		}
+		if destinations[i].Type != destinations[j].Type {
+			return destinations[i].Type < destinations[j].Type
+		}
+		return destinations[i].Name < destinations[j].Name
+	})
+
+	return destinations, nil
+}
+
+// parseObjectNameProperties returns the key properties of an MBean object name,
+// e.g. "org.apache.activemq:type=Broker,brokerName=localhost".
+func parseObjectNameProperties(name string) map[string]string {
+	properties := make(map[string]string)
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	for _, property := range strings.Split(name, ",") {
+		if kv := strings.SplitN(property, "=", 2); len(kv) == 2 {
+			properties[kv[0]] = kv[1]
+		}
+	}
+	return properties
+}
+
+func (c *activemqClient) post(ctx context.Context, path string, reqObj interface{}, respObj interface{}) error {
+	body, err := json.Marshal(reqObj)
+	if err != nil {
+		return fmt.Errorf("failed to encode request payload: %w", err)
+	}
+
+	// Construct endpoint and create request
+	url := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create post request for path %s: %w", path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set user/pass authentication
+	req.SetBasicAuth(c.creds.username, c.creds.password)
+
+	// Make request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make http request: %w", err)
+	}
+
+	// Defer body close
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Warn("failed to close response body", zap.Error(closeErr))
+		}
+	}()
+
+	// Check for OK status code
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Debug("ActiveMQ Jolokia API non-200", zap.Int("status_code", resp.StatusCode))
+
+		// Attempt to extract the error payload
+		payloadData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.logger.Debug("failed to read payload error message", zap.Error(err))
+		} else {
+			c.logger.Debug("ActiveMQ Jolokia API Error", zap.ByteString("api_error", payloadData))
+		}
+
+		return fmt.Errorf("non 200 code returned %d", resp.StatusCode)
+	}
+
+	// Decode the payload into the passed in response object
+	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
+		return fmt.Errorf("failed to decode response payload: %w", err)
+	}
+
+	return nil
+}
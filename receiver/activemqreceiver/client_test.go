@@ -0,0 +1,277 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activemqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/models"
+)
+
+const (
+	destinationsAPIResponseFile = "read_destinations_response.json"
+)
+
+func TestNewClient(t *testing.T) {
+	testCase := []struct {
+		desc        string
+		cfg         *Config
+		host        component.Host
+		settings    component.TelemetrySettings
+		logger      *zap.Logger
+		expectError error
+	}{
+		{
+			desc: "Invalid HTTP config",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+					TLSSetting: configtls.TLSClientSetting{
+						TLSSetting: configtls.TLSSetting{
+							CAFile: "/non/existent",
+						},
+					},
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: errors.New("failed to create HTTP Client"),
+		},
+		{
+			desc: "Valid Configuration",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					TLSSetting: configtls.TLSClientSetting{},
+					Endpoint:   defaultEndpoint,
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.desc, func(t *testing.T) {
+			ac, err := newClient(tc.cfg, tc.host, tc.settings, tc.logger)
+			if tc.expectError != nil {
+				require.Nil(t, ac)
+				require.Contains(t, err.Error(), tc.expectError.Error())
+			} else {
+				require.NoError(t, err)
+
+				actualClient, ok := ac.(*activemqClient)
+				require.True(t, ok)
+
+				require.Equal(t, tc.cfg.Username, actualClient.creds.username)
+				require.Equal(t, tc.cfg.Password, actualClient.creds.password)
+				require.Equal(t, tc.cfg.Endpoint, actualClient.hostEndpoint)
+				require.Equal(t, tc.logger, actualClient.logger)
+				require.NotNil(t, actualClient.client)
+			}
+		})
+	}
+}
+
+func TestGetDestinations(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				destinations, err := tc.GetDestinations(context.Background())
+				require.Nil(t, destinations)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Bad payload returned",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte("[]"))
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				destinations, err := tc.GetDestinations(context.Background())
+				require.Nil(t, destinations)
+				require.Contains(t, err.Error(), "failed to decode response payload")
+			},
+		},
+		{
+			desc: "Jolokia error returned",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte(`{"status":403,"error":"java.lang.Exception : Reading attribute QueueSize is forbidden"}`))
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				destinations, err := tc.GetDestinations(context.Background())
+				require.Nil(t, destinations)
+				require.EqualError(t, err, "jolokia request failed with status 403: java.lang.Exception : Reading attribute QueueSize is forbidden")
+			},
+		},
+		{
+			desc: "No destination found",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte(`{"status":404,"error":"javax.management.InstanceNotFoundException"}`))
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				destinations, err := tc.GetDestinations(context.Background())
+				require.NoError(t, err)
+				require.Empty(t, destinations)
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, destinationsAPIResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, jolokiaPath, r.URL.Path)
+
+					var req jolokiaRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					require.Equal(t, jolokiaRequest{Type: "read", MBean: destinationMBean, Attribute: destinationAttributes}, req)
+
+					username, password, ok := r.BasicAuth()
+					require.True(t, ok)
+					require.Equal(t, "otelu", username)
+					require.Equal(t, "otelp", password)
+
+					w.Write(data)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				expected := []*models.Destination{
+					{
+						BrokerName: "localhost",
+						Name:       "orders",
+						Type:       "Queue",
+						Stats: models.DestinationStats{
+							QueueSize:            12,
+							ConsumerCount:        2,
+							ProducerCount:        1,
+							EnqueueCount:         1500,
+							DequeueCount:         1488,
+							ExpiredCount:         3,
+							MemoryUsageByteCount: 24576,
+							MemoryPercentUsage:   25,
+						},
+					},
+					{
+						BrokerName: "localhost",
+						Name:       "ActiveMQ.Advisory.Queue",
+						Type:       "Topic",
+						Stats: models.DestinationStats{
+							EnqueueCount: 4,
+						},
+					},
+					{
+						BrokerName: "localhost",
+						Name:       "events",
+						Type:       "Topic",
+						Stats: models.DestinationStats{
+							ConsumerCount: 4,
+							ProducerCount: 2,
+							EnqueueCount:  320,
+							DequeueCount:  1280,
+						},
+					},
+				}
+
+				destinations, err := tc.GetDestinations(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, destinations)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestParseObjectNameProperties(t *testing.T) {
+	require.Equal(t, map[string]string{
+		"type":            "Broker",
+		"brokerName":      "localhost",
+		"destinationType": "Queue",
+		"destinationName": "orders",
+	}, parseObjectNameProperties("org.apache.activemq:type=Broker,brokerName=localhost,destinationType=Queue,destinationName=orders"))
+	require.Empty(t, parseObjectNameProperties("org.apache.activemq"))
+}
+
+func createTestClient(t *testing.T, baseEndpoint string) client {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = baseEndpoint
+	cfg.Username = "otelu"
+	cfg.Password = "otelp"
+
+	testClient, err := newClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+	require.NoError(t, err)
+	return testClient
+}
+
+func loadAPIResponseData(t *testing.T, fileName string) []byte {
+	t.Helper()
+	fullPath := filepath.Join("testdata", "apiresponses", fileName)
+
+	data, err := ioutil.ReadFile(fullPath)
+	require.NoError(t, err)
+
+	return data
+}
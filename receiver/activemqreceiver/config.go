@@ -0,0 +1,76 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package activemqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingUsername = errors.New(`"username" not specified in config`)
+	errMissingPassword = errors.New(`"password" not specified in config`)
+
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+)
+
+const defaultEndpoint = "http://localhost:8161"
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+	Username                                string `mapstructure:"username"`
+	Password                                string `mapstructure:"password"`
+	// Destinations is the allowlist of regular expressions matched against
+	// the names of the queues and topics to scrape. All the destinations
+	// but the advisory topics are scraped when empty.
+	Destinations []string                 `mapstructure:"destinations"`
+	Metrics      metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+	if cfg.Username == "" {
+		err = multierr.Append(err, errMissingUsername)
+	}
+
+	if cfg.Password == "" {
+		err = multierr.Append(err, errMissingPassword)
+	}
+
+	_, parseErr := url.Parse(cfg.Endpoint)
+	if parseErr != nil {
+		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
+		err = multierr.Append(err, wrappedErr)
+	}
+
+	for _, destination := range cfg.Destinations {
+		if _, reErr := regexp.Compile(destination); reErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid destination regular expression %q: %w", destination, reErr))
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,36 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/models"
+
+// Destination represents the statistics of a queue or a topic read from
+// the destination MBean of the broker.
+type Destination struct {
+	BrokerName string
+	Name       string
+	// Type is either Queue or Topic
+	Type  string
+	Stats DestinationStats
+}
+
+// DestinationStats represents the attributes read from a destination MBean
+type DestinationStats struct {
+	QueueSize            int64 `json:"QueueSize"`
+	ConsumerCount        int64 `json:"ConsumerCount"`
+	ProducerCount        int64 `json:"ProducerCount"`
+	EnqueueCount         int64 `json:"EnqueueCount"`
+	DequeueCount         int64 `json:"DequeueCount"`
+	ExpiredCount         int64 `json:"ExpiredCount"`
+	MemoryUsageByteCount int64 `json:"MemoryUsageByteCount"`
+	MemoryPercentUsage   int64 `json:"MemoryPercentUsage"`
+}
@@ -0,0 +1,136 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package activemqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver"
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/models"
+)
+
+const instrumentationLibraryName = "otelcol/activemq"
+
+// advisoryTopicPrefix is the prefix of the topics the broker publishes its advisory messages to
+const advisoryTopicPrefix = "ActiveMQ.Advisory."
+
+var errClientNotInit = errors.New("client not initialized")
+
+// activemqScraper handles scraping of ActiveMQ metrics
+type activemqScraper struct {
+	client       client
+	logger       *zap.Logger
+	cfg          *Config
+	settings     component.TelemetrySettings
+	mb           *metadata.MetricsBuilder
+	destinations []*regexp.Regexp
+}
+
+// newScraper creates a new scraper
+func newScraper(logger *zap.Logger, cfg *Config, settings component.TelemetrySettings) *activemqScraper {
+	return &activemqScraper{
+		logger:   logger,
+		cfg:      cfg,
+		settings: settings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics),
+	}
+}
+
+// start starts the scraper by compiling the destination filters and creating a new HTTP Client on the scraper
+func (a *activemqScraper) start(ctx context.Context, host component.Host) (err error) {
+	a.destinations = make([]*regexp.Regexp, 0, len(a.cfg.Destinations))
+	for _, destination := range a.cfg.Destinations {
+		re, err := regexp.Compile(destination)
+		if err != nil {
+			return err
+		}
+		a.destinations = append(a.destinations, re)
+	}
+
+	a.client, err = newClient(a.cfg, host, a.settings, a.logger)
+	return
+}
+
+// scrape collects metrics from the ActiveMQ Jolokia API
+func (a *activemqScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	metrics := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+	rms := metrics.ResourceMetrics()
+
+	// Validate we don't attempt to scrape without initializing the client
+	if a.client == nil {
+		return metrics, errClientNotInit
+	}
+
+	// Get destinations for processing
+	destinations, err := a.client.GetDestinations(ctx)
+	if err != nil {
+		return metrics, err
+	}
+
+	// Collect metrics for each allowed destination
+	for _, destination := range destinations {
+		if !a.scrapeDestination(destination.Name) {
+			continue
+		}
+		a.collectDestination(destination, now, rms)
+	}
+
+	return metrics, nil
+}
+
+// scrapeDestination returns whether the destination is allowed by the configured filters
+func (a *activemqScraper) scrapeDestination(name string) bool {
+	if len(a.destinations) == 0 {
+		return !strings.HasPrefix(name, advisoryTopicPrefix)
+	}
+	for _, re := range a.destinations {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectDestination collects metrics
+func (a *activemqScraper) collectDestination(destination *models.Destination, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	resourceMetric := rms.AppendEmpty()
+	resourceAttrs := resourceMetric.Resource().Attributes()
+	resourceAttrs.InsertString(metadata.A.ActivemqBrokerName, destination.BrokerName)
+	resourceAttrs.InsertString(metadata.A.ActivemqDestinationName, destination.Name)
+	resourceAttrs.InsertString(metadata.A.ActivemqDestinationType, destination.Type)
+
+	ilms := resourceMetric.InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+
+	stats := destination.Stats
+	a.mb.RecordActivemqDestinationSizeDataPoint(now, stats.QueueSize)
+	a.mb.RecordActivemqConsumerCountDataPoint(now, stats.ConsumerCount)
+	a.mb.RecordActivemqProducerCountDataPoint(now, stats.ProducerCount)
+	a.mb.RecordActivemqMessageEnqueuedDataPoint(now, stats.EnqueueCount)
+	a.mb.RecordActivemqMessageDequeuedDataPoint(now, stats.DequeueCount)
+	a.mb.RecordActivemqMessageExpiredDataPoint(now, stats.ExpiredCount)
+	a.mb.RecordActivemqMemoryUsageDataPoint(now, stats.MemoryUsageByteCount)
+	a.mb.RecordActivemqMemoryUtilizationDataPoint(now, float64(stats.MemoryPercentUsage)/100)
+
+	a.mb.Emit(ilms.Metrics())
+}
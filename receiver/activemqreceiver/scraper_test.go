@@ -0,0 +1,233 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package activemqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver"
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activemqreceiver/internal/models"
+)
+
+type fakeClient struct {
+	destinations []*models.Destination
+	err          error
+}
+
+func (c *fakeClient) GetDestinations(context.Context) ([]*models.Destination, error) {
+	return c.destinations, c.err
+}
+
+func testDestinations() []*models.Destination {
+	return []*models.Destination{
+		{
+			BrokerName: "localhost",
+			Name:       "orders",
+			Type:       "Queue",
+			Stats: models.DestinationStats{
+				QueueSize:            12,
+				ConsumerCount:        2,
+				ProducerCount:        1,
+				EnqueueCount:         1500,
+				DequeueCount:         1488,
+				ExpiredCount:         3,
+				MemoryUsageByteCount: 24576,
+				MemoryPercentUsage:   25,
+			},
+		},
+		{
+			BrokerName: "localhost",
+			Name:       "ActiveMQ.Advisory.Queue",
+			Type:       "Topic",
+		},
+		{
+			BrokerName: "localhost",
+			Name:       "events",
+			Type:       "Topic",
+		},
+	}
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		scraper     *activemqScraper
+		expectError bool
+	}{
+		{
+			desc: "Bad Config",
+			scraper: &activemqScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+						TLSSetting: configtls.TLSClientSetting{
+							TLSSetting: configtls.TLSSetting{
+								CAFile: "/non/existent",
+							},
+						},
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Bad destination regular expression",
+			scraper: &activemqScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+					},
+					Destinations: []string{"events("},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid Config",
+			scraper: &activemqScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						TLSSetting: configtls.TLSClientSetting{},
+						Endpoint:   defaultEndpoint,
+					},
+					Destinations: []string{"^orders$"},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrapeErrors(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+
+	scraper.client = &fakeClient{err: errors.New("some api error")}
+	metrics, err = scraper.scrape(context.Background())
+	require.EqualError(t, err, "some api error")
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc                 string
+		destinations         []string
+		expectedDestinations []string
+	}{
+		{
+			desc:                 "All but advisory topics",
+			expectedDestinations: []string{"orders", "events"},
+		},
+		{
+			desc:                 "Allowlist",
+			destinations:         []string{"^ord", "^ActiveMQ\\.Advisory\\."},
+			expectedDestinations: []string{"orders", "ActiveMQ.Advisory.Queue"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Destinations = tc.destinations
+			scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			scraper.client = &fakeClient{destinations: testDestinations()}
+
+			metrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			rms := metrics.ResourceMetrics()
+			require.Equal(t, len(tc.expectedDestinations), rms.Len())
+			for i, name := range tc.expectedDestinations {
+				destination, ok := rms.At(i).Resource().Attributes().Get("activemq.destination.name")
+				require.True(t, ok)
+				require.Equal(t, name, destination.StringVal())
+
+				ilms := rms.At(i).InstrumentationLibraryMetrics()
+				require.Equal(t, 1, ilms.Len())
+				require.Equal(t, instrumentationLibraryName, ilms.At(0).InstrumentationLibrary().Name())
+				require.Equal(t, 8, ilms.At(0).Metrics().Len())
+			}
+		})
+	}
+}
+
+func TestScraperScrapeValues(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+	scraper.client = &fakeClient{destinations: testDestinations()[:1]}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+
+	rm := metrics.ResourceMetrics().At(0)
+	attrs := rm.Resource().Attributes()
+	for k, expected := range map[string]string{
+		"activemq.broker.name":      "localhost",
+		"activemq.destination.name": "orders",
+		"activemq.destination.type": "Queue",
+	} {
+		v, ok := attrs.Get(k)
+		require.True(t, ok)
+		require.Equal(t, expected, v.StringVal())
+	}
+
+	expected := map[string]int64{
+		"activemq.destination.size": 12,
+		"activemq.consumer.count":   2,
+		"activemq.producer.count":   1,
+		"activemq.message.enqueued": 1500,
+		"activemq.message.dequeued": 1488,
+		"activemq.message.expired":  3,
+		"activemq.memory.usage":     24576,
+	}
+	ms := rm.InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		if m.Name() == "activemq.memory.utilization" {
+			require.Equal(t, pdata.MetricDataTypeGauge, m.DataType())
+			require.Equal(t, 0.25, m.Gauge().DataPoints().At(0).DoubleVal())
+			continue
+		}
+		require.Equal(t, pdata.MetricDataTypeSum, m.DataType(), m.Name())
+		require.Equal(t, expected[m.Name()], m.Sum().DataPoints().At(0).IntVal(), m.Name())
+		delete(expected, m.Name())
+	}
+	require.Empty(t, expected)
+}
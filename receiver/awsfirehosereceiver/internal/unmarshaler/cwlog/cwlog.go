@@ -0,0 +1,47 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+
+// The cWLog is the format for the CloudWatch Logs subscription records.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/SubscriptionFilters.html
+type cWLog struct {
+	// MessageType is DATA_MESSAGE for log events and CONTROL_MESSAGE
+	// for the messages used to check that the destination is reachable.
+	MessageType string `json:"messageType"`
+	// Owner is the AWS account ID of the originating log data.
+	Owner string `json:"owner"`
+	// LogGroup is the log group name of the originating log data.
+	LogGroup string `json:"logGroup"`
+	// LogStream is the log stream name of the originating log data.
+	LogStream string `json:"logStream"`
+	// SubscriptionFilters are the names of the subscription filters
+	// that matched the originating log data.
+	SubscriptionFilters []string `json:"subscriptionFilters"`
+	// LogEvents are the log events of the record.
+	LogEvents []cWLogEvent `json:"logEvents"`
+}
+
+// The cWLogEvent is an individual log event within the cWLog.
+type cWLogEvent struct {
+	// ID is the unique identifier of the log event.
+	ID string `json:"id"`
+	// Timestamp is the milliseconds since epoch for
+	// the log event.
+	Timestamp int64 `json:"timestamp"`
+	// Message is the log message.
+	Message string `json:"message"`
+}
@@ -0,0 +1,88 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+const (
+	attributeAWSCloudWatchLogEventID = "aws.cloudwatch.log_event_id"
+)
+
+// resourceAttributes are the CloudWatch log attributes that define a
+// unique resource.
+type resourceAttributes struct {
+	// owner is the AWS account ID.
+	owner string
+	// logGroup is the log group name.
+	logGroup string
+	// logStream is the log stream name.
+	logStream string
+}
+
+// The resourceLogsBuilder is used to aggregate log records for the
+// same resourceAttributes.
+type resourceLogsBuilder struct {
+	resourceAttributes
+	// logs is the slice of log records within the same
+	// resource group.
+	logs pdata.LogRecordSlice
+}
+
+// newResourceLogsBuilder creates a resourceLogsBuilder with the
+// resourceAttributes.
+func newResourceLogsBuilder(attrs resourceAttributes) *resourceLogsBuilder {
+	return &resourceLogsBuilder{
+		resourceAttributes: attrs,
+		logs:               pdata.NewLogRecordSlice(),
+	}
+}
+
+// AddLog adds a log record for the log event.
+func (rlb *resourceLogsBuilder) AddLog(event cWLogEvent) {
+	lr := rlb.logs.AppendEmpty()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(time.UnixMilli(event.Timestamp)))
+	lr.Body().SetStringVal(event.Message)
+	if event.ID != "" {
+		lr.Attributes().InsertString(attributeAWSCloudWatchLogEventID, event.ID)
+	}
+}
+
+// Build updates the passed in pdata.ResourceLogs with the log records in
+// the builder.
+func (rlb *resourceLogsBuilder) Build(rl pdata.ResourceLogs) {
+	rlb.setAttributes(rl.Resource())
+	rlb.logs.MoveAndAppendTo(rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords())
+}
+
+// setAttributes creates a pdata.Resource from the fields in the resourceLogsBuilder.
+func (rlb *resourceLogsBuilder) setAttributes(resource pdata.Resource) {
+	attributes := resource.Attributes()
+	attributes.InsertString(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
+	attributes.InsertString(conventions.AttributeCloudAccountID, rlb.owner)
+	attributes.Insert(conventions.AttributeAWSLogGroupNames, stringArray(rlb.logGroup))
+	attributes.Insert(conventions.AttributeAWSLogStreamNames, stringArray(rlb.logStream))
+}
+
+// stringArray creates an array attribute value holding a single string.
+func stringArray(value string) pdata.AttributeValue {
+	av := pdata.NewAttributeValueArray()
+	av.SliceVal().AppendEmpty().SetStringVal(value)
+	return av
+}
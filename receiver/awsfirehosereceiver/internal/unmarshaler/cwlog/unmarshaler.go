@@ -0,0 +1,157 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+)
+
+const (
+	TypeStr = "cwlogs"
+
+	messageTypeData = "DATA_MESSAGE"
+)
+
+var (
+	errInvalidRecords = errors.New("record format invalid")
+
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// Unmarshaler for the CloudWatch Logs subscription record format.
+// The records are gzip compressed by CloudWatch Logs, unless the
+// delivery stream decompresses them.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/SubscriptionFilters.html#FirehoseExample
+type Unmarshaler struct {
+	logger *zap.Logger
+}
+
+var _ unmarshaler.LogsUnmarshaler = (*Unmarshaler)(nil)
+
+// NewUnmarshaler creates a new instance of the Unmarshaler.
+func NewUnmarshaler(logger *zap.Logger) *Unmarshaler {
+	return &Unmarshaler{logger}
+}
+
+// Unmarshal deserializes the records into cWLogs and uses the
+// resourceLogsBuilder to group them into a single pdata.Logs.
+// Skips invalid cWLogs and the control messages received in the records.
+func (u Unmarshaler) Unmarshal(records [][]byte) (pdata.Logs, error) {
+	builders := make(map[resourceAttributes]*resourceLogsBuilder)
+	var controlMessages int
+	for recordIndex, record := range records {
+		reader, err := u.newReader(record)
+		if err != nil {
+			u.logger.Error(
+				"Unable to decompress record",
+				zap.Error(err),
+				zap.Int("record_index", recordIndex),
+			)
+			continue
+		}
+		// Decompressed records may hold several concatenated messages.
+		decoder := json.NewDecoder(reader)
+		for datumIndex := 0; ; datumIndex++ {
+			var log cWLog
+			if err = decoder.Decode(&log); err != nil {
+				if !errors.Is(err, io.EOF) {
+					u.logger.Error(
+						"Unable to unmarshal input",
+						zap.Error(err),
+						zap.Int("datum_index", datumIndex),
+						zap.Int("record_index", recordIndex),
+					)
+				}
+				break
+			}
+			if log.MessageType != messageTypeData {
+				controlMessages++
+				u.logger.Debug(
+					"Skipping message",
+					zap.String("message_type", log.MessageType),
+					zap.Int("datum_index", datumIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			if !u.isValid(log) {
+				u.logger.Error(
+					"Invalid log",
+					zap.Int("datum_index", datumIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			attrs := resourceAttributes{
+				owner:     log.Owner,
+				logGroup:  log.LogGroup,
+				logStream: log.LogStream,
+			}
+			lb, ok := builders[attrs]
+			if !ok {
+				lb = newResourceLogsBuilder(attrs)
+				builders[attrs] = lb
+			}
+			for _, event := range log.LogEvents {
+				lb.AddLog(event)
+			}
+		}
+	}
+
+	if len(builders) == 0 {
+		// Control messages are only sent to check that the destination
+		// is reachable, so they should not be rejected.
+		if controlMessages > 0 {
+			return pdata.NewLogs(), nil
+		}
+		return pdata.NewLogs(), errInvalidRecords
+	}
+
+	ld := pdata.NewLogs()
+	for _, builder := range builders {
+		builder.Build(ld.ResourceLogs().AppendEmpty())
+	}
+
+	return ld, nil
+}
+
+// newReader returns a reader of the record, decompressing it if needed.
+func (u Unmarshaler) newReader(record []byte) (io.Reader, error) {
+	if bytes.HasPrefix(record, gzipMagic) {
+		return gzip.NewReader(bytes.NewReader(record))
+	}
+	return bytes.NewReader(record), nil
+}
+
+// isValid validates that the cWLog has been unmarshalled correctly.
+func (u Unmarshaler) isValid(log cWLog) bool {
+	return log.Owner != "" && log.LogGroup != "" && log.LogStream != ""
+}
+
+// Type of the serialized messages.
+func (u Unmarshaler) Type() string {
+	return TypeStr
+}
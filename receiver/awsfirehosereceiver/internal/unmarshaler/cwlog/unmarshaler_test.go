@@ -0,0 +1,125 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+func TestType(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	require.Equal(t, TypeStr, unmarshaler.Type())
+}
+
+func TestUnmarshal(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	testCases := map[string]struct {
+		filename          string
+		wantResourceCount int
+		wantLogCount      int
+		wantErr           error
+	}{
+		"WithMultipleRecords": {
+			filename:          "multiple_records",
+			wantResourceCount: 4,
+			wantLogCount:      15,
+		},
+		"WithSingleRecord": {
+			filename:          "single_record",
+			wantResourceCount: 1,
+			wantLogCount:      1,
+		},
+		"WithInvalidRecords": {
+			filename: "invalid_records",
+			wantErr:  errInvalidRecords,
+		},
+		"WithSomeInvalidRecords": {
+			filename:          "some_invalid_records",
+			wantResourceCount: 2,
+			wantLogCount:      5,
+		},
+		"WithControlMessage": {
+			filename:          "control_message",
+			wantResourceCount: 0,
+			wantLogCount:      0,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			record, err := os.ReadFile(filepath.Join(".", "testdata", testCase.filename))
+			require.NoError(t, err)
+
+			for _, compressed := range []bool{false, true} {
+				records := [][]byte{record}
+				if compressed {
+					records = [][]byte{gzipRecord(t, record)}
+				}
+
+				got, err := unmarshaler.Unmarshal(records)
+				if testCase.wantErr != nil {
+					require.Error(t, err)
+					require.Equal(t, testCase.wantErr, err)
+				} else {
+					require.NoError(t, err)
+					require.NotNil(t, got)
+					require.Equal(t, testCase.wantResourceCount, got.ResourceLogs().Len())
+					require.Equal(t, testCase.wantLogCount, got.LogRecordCount())
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalAttributes(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	record, err := os.ReadFile(filepath.Join(".", "testdata", "single_record"))
+	require.NoError(t, err)
+
+	got, err := unmarshaler.Unmarshal([][]byte{gzipRecord(t, record)})
+	require.NoError(t, err)
+	require.Equal(t, 1, got.ResourceLogs().Len())
+
+	rl := got.ResourceLogs().At(0)
+	require.Equal(t, map[string]interface{}{
+		"cloud.provider":       "aws",
+		"cloud.account.id":     "123456789012",
+		"aws.log.group.names":  []interface{}{"/aws/lambda/checkout"},
+		"aws.log.stream.names": []interface{}{"2022/02/01/[$LATEST]a1b2c3"},
+	}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+	require.Equal(t, pdata.Timestamp(1611929698000*1e6), lr.Timestamp())
+	require.Equal(t, "[INFO] request 0 completed in 10ms", lr.Body().StringVal())
+	require.Equal(t, map[string]interface{}{
+		attributeAWSCloudWatchLogEventID: "361951845751987398000",
+	}, lr.Attributes().AsRaw())
+}
+
+func gzipRecord(t *testing.T, record []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(record)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
@@ -26,3 +26,12 @@ type MetricsUnmarshaler interface {
 	// Type of the serialized messages.
 	Type() string
 }
+
+// LogsUnmarshaler deserializes the message body
+type LogsUnmarshaler interface {
+	// Unmarshal deserializes the records into logs.
+	Unmarshal(records [][]byte) (pdata.Logs, error)
+
+	// Type of the serialized messages.
+	Type() string
+}
@@ -58,3 +58,40 @@ func (u *NopMetricsUnmarshaler) Unmarshal([][]byte) (pdata.Metrics, error) {
 func (u *NopMetricsUnmarshaler) Type() string {
 	return typeStr
 }
+
+// NopLogsUnmarshaler is a LogsUnmarshaler that doesn't do anything
+// with the inputs and just returns the logs and error passed in.
+type NopLogsUnmarshaler struct {
+	logs pdata.Logs
+	err  error
+}
+
+var _ unmarshaler.LogsUnmarshaler = (*NopLogsUnmarshaler)(nil)
+
+// NewNopLogs provides a nop logs unmarshaler with the default
+// pdata.Logs and no error.
+func NewNopLogs() *NopLogsUnmarshaler {
+	return &NopLogsUnmarshaler{}
+}
+
+// NewWithLogs provides a nop logs unmarshaler with the passed
+// in logs as the result of the Unmarshal and no error.
+func NewWithLogs(logs pdata.Logs) *NopLogsUnmarshaler {
+	return &NopLogsUnmarshaler{logs: logs}
+}
+
+// NewErrLogs provides a nop logs unmarshaler with the passed
+// in error as the Unmarshal error.
+func NewErrLogs(err error) *NopLogsUnmarshaler {
+	return &NopLogsUnmarshaler{err: err}
+}
+
+// Unmarshal deserializes the records into logs.
+func (u *NopLogsUnmarshaler) Unmarshal([][]byte) (pdata.Logs, error) {
+	return u.logs, u.err
+}
+
+// Type of the serialized messages.
+func (u *NopLogsUnmarshaler) Type() string {
+	return typeStr
+}
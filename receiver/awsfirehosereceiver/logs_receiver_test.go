@@ -0,0 +1,149 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsfirehosereceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenterror"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/unmarshalertest"
+)
+
+func TestNewLogsReceiver(t *testing.T) {
+	testCases := map[string]struct {
+		consumer   consumer.Logs
+		recordType string
+		wantErr    error
+	}{
+		"WithNilConsumer": {
+			wantErr: componenterror.ErrNilNextConsumer,
+		},
+		"WithInvalidRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: "test",
+			wantErr:    errUnrecognizedRecordType,
+		},
+		"WithMetricsRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: defaultRecordType,
+			wantErr:    errUnrecognizedRecordType,
+		},
+		"WithLogsRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: cwlog.TypeStr,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.RecordType = testCase.recordType
+			got, err := newLogsReceiver(
+				cfg,
+				componenttest.NewNopReceiverCreateSettings(),
+				defaultLogsUnmarshalers(zap.NewNop()),
+				testCase.consumer,
+			)
+			require.Equal(t, testCase.wantErr, err)
+			if testCase.wantErr == nil {
+				require.NotNil(t, got)
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestLogsConsumer(t *testing.T) {
+	testErr := errors.New("test error")
+	testCases := map[string]struct {
+		unmarshalerErr error
+		consumerErr    error
+		wantStatus     int
+		wantErr        error
+	}{
+		"WithUnmarshalerError": {
+			unmarshalerErr: testErr,
+			wantStatus:     http.StatusBadRequest,
+			wantErr:        testErr,
+		},
+		"WithConsumerError": {
+			consumerErr: testErr,
+			wantStatus:  http.StatusInternalServerError,
+			wantErr:     testErr,
+		},
+		"WithNoError": {
+			wantStatus: http.StatusOK,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			base := pdata.NewLogs()
+			base.ResourceLogs().AppendEmpty()
+			u := unmarshalertest.NewWithLogs(base)
+			if testCase.unmarshalerErr != nil {
+				u = unmarshalertest.NewErrLogs(testCase.unmarshalerErr)
+			}
+			lc := &logsConsumer{
+				unmarshaler: u,
+				consumer:    consumertest.NewErr(testCase.consumerErr),
+			}
+			gotStatus, gotErr := lc.Consume(context.TODO(), nil, nil)
+			require.Equal(t, testCase.wantStatus, gotStatus)
+			require.Equal(t, testCase.wantErr, gotErr)
+		})
+	}
+
+	t.Run("WithNoLogs", func(t *testing.T) {
+		sink := new(consumertest.LogsSink)
+		lc := &logsConsumer{
+			unmarshaler: unmarshalertest.NewWithLogs(pdata.NewLogs()),
+			consumer:    sink,
+		}
+		gotStatus, gotErr := lc.Consume(context.TODO(), nil, nil)
+		require.Equal(t, http.StatusOK, gotStatus)
+		require.NoError(t, gotErr)
+		require.Empty(t, sink.AllLogs())
+	})
+
+	t.Run("WithCommonAttributes", func(t *testing.T) {
+		base := pdata.NewLogs()
+		base.ResourceLogs().AppendEmpty()
+		sink := new(consumertest.LogsSink)
+		lc := &logsConsumer{
+			unmarshaler: unmarshalertest.NewWithLogs(base),
+			consumer:    sink,
+		}
+		gotStatus, gotErr := lc.Consume(context.TODO(), nil, map[string]string{
+			"CommonAttributes": "Test",
+		})
+		require.Equal(t, http.StatusOK, gotStatus)
+		require.NoError(t, gotErr)
+		require.Len(t, sink.AllLogs(), 1)
+		gotRls := sink.AllLogs()[0].ResourceLogs()
+		require.Equal(t, 1, gotRls.Len())
+		require.Equal(t, 1, gotRls.At(0).Resource().Attributes().Len())
+	})
+}
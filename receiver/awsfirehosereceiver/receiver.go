@@ -16,6 +16,7 @@ package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -240,9 +241,14 @@ func (fmr *firehoseReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // validate checks the Firehose access key in the header against
-// the one passed into the Config
+// the one passed into the Config. Requests without an access key are
+// rejected when one is configured.
 func (fmr *firehoseReceiver) validate(r *http.Request) (int, error) {
-	if accessKey := r.Header.Get(headerFirehoseAccessKey); accessKey != "" && accessKey != fmr.config.AccessKey {
+	if fmr.config.AccessKey == "" {
+		return http.StatusAccepted, nil
+	}
+	accessKey := r.Header.Get(headerFirehoseAccessKey)
+	if subtle.ConstantTimeCompare([]byte(accessKey), []byte(fmr.config.AccessKey)) != 1 {
 		return http.StatusUnauthorized, errInvalidAccessKey
 	}
 	return http.StatusAccepted, nil
@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+)
+
+const (
+	loginPath          = "/mgmt/shared/authn/login"
+	virtualServersPath = "/mgmt/tm/ltm/virtual/stats"
+	poolsPath          = "/mgmt/tm/ltm/pool/stats"
+	nodesPath          = "/mgmt/tm/ltm/node/stats"
+
+	authTokenHeader = "X-F5-Auth-Token"
+
+	// tokenExpiryMargin is subtracted from the token timeout so that it is
+	// renewed before it expires on the BIG-IP.
+	tokenExpiryMargin = 30 * time.Second
+)
+
+var errUnauthorized = errors.New("unauthorized")
+
+type client interface {
+	// GetVirtualServers returns the statistics of the LTM virtual servers
+	GetVirtualServers(ctx context.Context) ([]*models.Stats, error)
+	// GetPools returns the statistics of the LTM pools
+	GetPools(ctx context.Context) ([]*models.Stats, error)
+	// GetNodes returns the statistics of the LTM nodes
+	GetNodes(ctx context.Context) ([]*models.Stats, error)
+}
+
+var _ client = (*bigipClient)(nil)
+
+type bigipClient struct {
+	client       *http.Client
+	hostEndpoint string
+	creds        bigipCredentials
+	logger       *zap.Logger
+
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
+	now         func() time.Time
+}
+
+type bigipCredentials struct {
+	username string
+	password string
+}
+
+type loginRequest struct {
+	Username          string `json:"username"`
+	Password          string `json:"password"`
+	LoginProviderName string `json:"loginProviderName"`
+}
+
+type loginResponse struct {
+	Token struct {
+		Token string `json:"token"`
+		// Timeout is the lifetime of the token in seconds
+		Timeout int64 `json:"timeout"`
+	} `json:"token"`
+}
+
+func newClient(cfg *Config, host component.Host, settings component.TelemetrySettings, logger *zap.Logger) (client, error) {
+	httpClient, err := cfg.ToClient(host.GetExtensions(), settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+	}
+
+	return &bigipClient{
+		client:       httpClient,
+		hostEndpoint: cfg.Endpoint,
+		creds: bigipCredentials{
+			username: cfg.Username,
+			password: cfg.Password,
+		},
+		logger: logger,
+		now:    time.Now,
+	}, nil
+}
+
+func (c *bigipClient) GetVirtualServers(ctx context.Context) ([]*models.Stats, error) {
+	return c.getStats(ctx, virtualServersPath)
+}
+
+func (c *bigipClient) GetPools(ctx context.Context) ([]*models.Stats, error) {
+	return c.getStats(ctx, poolsPath)
+}
+
+func (c *bigipClient) GetNodes(ctx context.Context) ([]*models.Stats, error) {
+	return c.getStats(ctx, nodesPath)
+}
+
+func (c *bigipClient) getStats(ctx context.Context, path string) ([]*models.Stats, error) {
+	var resp models.StatsResponse
+
+	if err := c.getAuthenticated(ctx, path, &resp); err != nil {
+		c.logger.Debug("Failed to retrieve stats", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+
+	stats := make([]*models.Stats, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		s := &models.Stats{
+			Values:       make(map[string]int64),
+			Descriptions: make(map[string]string),
+		}
+		for name, value := range entry.NestedStats.Entries {
+			if value.Value != nil {
+				s.Values[name] = *value.Value
+			} else {
+				s.Descriptions[name] = value.Description
+			}
+		}
+		s.Name = s.Descriptions["tmName"]
+		stats = append(stats, s)
+	}
+
+	// Sort the objects as the entries are keyed by their self links
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+
+	return stats, nil
+}
+
+// getAuthenticated makes a request with an authentication token, logging in
+// again once when the token was rejected, e.g. after a BIG-IP restart.
+func (c *bigipClient) getAuthenticated(ctx context.Context, path string, respObj interface{}) error {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.get(ctx, path, token, respObj)
+	if !errors.Is(err, errUnauthorized) {
+		return err
+	}
+
+	c.invalidateToken(token)
+	token, err = c.getToken(ctx)
+	if err != nil {
+		return err
+	}
+	return c.get(ctx, path, token, respObj)
+}
+
+// getToken returns the current authentication token, logging in to get a new
+// one when there is none or it is about to expire.
+func (c *bigipClient) getToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != "" && c.now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	var resp loginResponse
+	err := c.do(ctx, http.MethodPost, loginPath, "", &loginRequest{
+		Username:          c.creds.username,
+		Password:          c.creds.password,
+		LoginProviderName: "tmos",
+	}, &resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in: %w", err)
+	}
+	if resp.Token.Token == "" {
+		return "", errors.New("failed to log in: no token returned")
+	}
+
+	c.token = resp.Token.Token
+	c.tokenExpiry = c.now().Add(time.Duration(resp.Token.Timeout)*time.Second - tokenExpiryMargin)
+	return c.token, nil
+}
+
+func (c *bigipClient) invalidateToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+func (c *bigipClient) get(ctx context.Context, path, token string, respObj interface{}) error {
+	return c.do(ctx, http.MethodGet, path, token, nil, respObj)
+}
+
+func (c *bigipClient) do(ctx context.Context, method, path, token string, reqObj interface{}, respObj interface{}) error {
+	var body io.Reader
+	if reqObj != nil {
+		payload, err := json.Marshal(reqObj)
+		if err != nil {
+			return fmt.Errorf("failed to encode request payload: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	// Construct endpoint and create request
+	url := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request for path %s: %w", path, err)
+	}
+	if reqObj != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// Set token authentication
+	if token != "" {
+		req.Header.Set(authTokenHeader, token)
+	}
+
+	// Make request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make http request: %w", err)
+	}
+
+	// Defer body close
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Warn("failed to close response body", zap.Error(closeErr))
+		}
+	}()
+
+	// Check for OK status code
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Debug("BIG-IP iControl REST API non-200", zap.Int("status_code", resp.StatusCode))
+
+		// Attempt to extract the error payload
+		payloadData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.logger.Debug("failed to read payload error message", zap.Error(err))
+		} else {
+			c.logger.Debug("BIG-IP iControl REST API Error", zap.ByteString("api_error", payloadData))
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && token != "" {
+			return errUnauthorized
+		}
+		return fmt.Errorf("non 200 code returned %d", resp.StatusCode)
+	}
+
+	// Decode the payload into the passed in response object
+	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
+		return fmt.Errorf("failed to decode response payload: %w", err)
+	}
+
+	return nil
+}
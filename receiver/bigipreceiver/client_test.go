@@ -0,0 +1,304 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+)
+
+const (
+	loginResponseFile          = "login_response.json"
+	virtualServersResponseFile = "get_virtual_servers_response.json"
+	poolsResponseFile          = "get_pools_response.json"
+	nodesResponseFile          = "get_nodes_response.json"
+)
+
+func TestNewClient(t *testing.T) {
+	testCase := []struct {
+		desc        string
+		cfg         *Config
+		host        component.Host
+		settings    component.TelemetrySettings
+		logger      *zap.Logger
+		expectError error
+	}{
+		{
+			desc: "Invalid HTTP config",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+					TLSSetting: configtls.TLSClientSetting{
+						TLSSetting: configtls.TLSSetting{
+							CAFile: "/non/existent",
+						},
+					},
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: errors.New("failed to create HTTP Client"),
+		},
+		{
+			desc: "Valid Configuration",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					TLSSetting: configtls.TLSClientSetting{},
+					Endpoint:   defaultEndpoint,
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.desc, func(t *testing.T) {
+			bc, err := newClient(tc.cfg, tc.host, tc.settings, tc.logger)
+			if tc.expectError != nil {
+				require.Nil(t, bc)
+				require.Contains(t, err.Error(), tc.expectError.Error())
+			} else {
+				require.NoError(t, err)
+
+				actualClient, ok := bc.(*bigipClient)
+				require.True(t, ok)
+
+				require.Equal(t, tc.cfg.Username, actualClient.creds.username)
+				require.Equal(t, tc.cfg.Password, actualClient.creds.password)
+				require.Equal(t, tc.cfg.Endpoint, actualClient.hostEndpoint)
+				require.Equal(t, tc.logger, actualClient.logger)
+				require.NotNil(t, actualClient.client)
+			}
+		})
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Login failure",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetPools(context.Background())
+				require.Nil(t, pools)
+				require.EqualError(t, err, "failed to log in: non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+				})
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetPools(context.Background())
+				require.Nil(t, pools)
+				require.EqualError(t, err, "non 200 code returned 403")
+			},
+		},
+		{
+			desc: "Bad payload returned",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte("[]"))
+				})
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				pools, err := tc.GetPools(context.Background())
+				require.Nil(t, pools)
+				require.Contains(t, err.Error(), "failed to decode response payload")
+			},
+		},
+		{
+			desc: "Successful calls",
+			testFunc: func(t *testing.T) {
+				responses := map[string][]byte{
+					virtualServersPath: loadAPIResponseData(t, virtualServersResponseFile),
+					poolsPath:          loadAPIResponseData(t, poolsResponseFile),
+					nodesPath:          loadAPIResponseData(t, nodesResponseFile),
+				}
+
+				// Setup test server
+				ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodGet, r.Method)
+					w.Write(responses[r.URL.Path])
+				})
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				virtualServers, err := tc.GetVirtualServers(context.Background())
+				require.NoError(t, err)
+				require.Len(t, virtualServers, 2)
+				require.Equal(t, &models.Stats{
+					Name: "/Common/api",
+					Values: map[string]int64{
+						"clientside.bitsIn":   0,
+						"clientside.bitsOut":  0,
+						"clientside.curConns": 0,
+						"clientside.pktsIn":   0,
+						"clientside.pktsOut":  0,
+						"totRequests":         0,
+					},
+					Descriptions: map[string]string{
+						"destination":              "10.0.0.11:8443",
+						"status.availabilityState": "offline",
+						"status.enabledState":      "disabled",
+						"tmName":                   "/Common/api",
+					},
+				}, virtualServers[0])
+				require.Equal(t, "/Common/web", virtualServers[1].Name)
+				require.Equal(t, int64(12), virtualServers[1].Values["clientside.curConns"])
+
+				pools, err := tc.GetPools(context.Background())
+				require.NoError(t, err)
+				require.Len(t, pools, 1)
+				require.Equal(t, "/Common/web_pool", pools[0].Name)
+				require.Equal(t, int64(2), pools[0].Values["activeMemberCnt"])
+
+				nodes, err := tc.GetNodes(context.Background())
+				require.NoError(t, err)
+				require.Len(t, nodes, 2)
+				require.Equal(t, "/Common/web-1", nodes[0].Name)
+				require.Equal(t, "10.0.1.21", nodes[0].Descriptions["addr"])
+				require.Equal(t, "/Common/web-2", nodes[1].Name)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestTokenRenewal(t *testing.T) {
+	logins := 0
+	validToken := ""
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == loginPath {
+			var req loginRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, loginRequest{Username: "otelu", Password: "otelp", LoginProviderName: "tmos"}, req)
+
+			logins++
+			validToken = "TOKEN" + string(rune('0'+logins))
+			w.Write([]byte(`{"token":{"token":"` + validToken + `","timeout":1200}}`))
+			return
+		}
+		if r.Header.Get(authTokenHeader) != validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"entries":{}}`))
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL).(*bigipClient)
+	now := time.Now()
+	tc.now = func() time.Time { return now }
+
+	// The token is reused while it is valid
+	for i := 0; i < 2; i++ {
+		_, err := tc.GetNodes(context.Background())
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, logins)
+
+	// A new token is requested before the token expires
+	now = now.Add(1200*time.Second - tokenExpiryMargin)
+	_, err := tc.GetNodes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, logins)
+
+	// A rejected token is renewed once
+	validToken = "REVOKED"
+	_, err = tc.GetNodes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, logins)
+}
+
+// newTestServer returns a server accepting the logins, and handling the
+// authenticated requests with handler.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	loginResponse := loadAPIResponseData(t, loginResponseFile)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == loginPath {
+			require.Equal(t, http.MethodPost, r.Method)
+			w.Write(loginResponse)
+			return
+		}
+		require.Equal(t, "TESTTOKEN", r.Header.Get(authTokenHeader))
+		handler(w, r)
+	}))
+}
+
+func createTestClient(t *testing.T, baseEndpoint string) client {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = baseEndpoint
+	cfg.Username = "otelu"
+	cfg.Password = "otelp"
+
+	testClient, err := newClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+	require.NoError(t, err)
+	return testClient
+}
+
+func loadAPIResponseData(t *testing.T, fileName string) []byte {
+	t.Helper()
+	fullPath := filepath.Join("testdata", "apiresponses", fileName)
+
+	data, err := ioutil.ReadFile(fullPath)
+	require.NoError(t, err)
+
+	return data
+}
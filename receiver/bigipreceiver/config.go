@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/metadata"
+)
+
+var (
+	errMissingUsername = errors.New(`"username" not specified in config`)
+	errMissingPassword = errors.New(`"password" not specified in config`)
+
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+)
+
+const defaultEndpoint = "https://localhost:443"
+
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+	Username                                string `mapstructure:"username"`
+	Password                                string `mapstructure:"password"`
+	// VirtualServers, Pools and Nodes are the allowlists of regular expressions
+	// matched against the full names, such as /Common/web, of the objects to
+	// scrape. All the objects are scraped when empty.
+	VirtualServers []string                 `mapstructure:"virtual_servers"`
+	Pools          []string                 `mapstructure:"pools"`
+	Nodes          []string                 `mapstructure:"nodes"`
+	Metrics        metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+	if cfg.Username == "" {
+		err = multierr.Append(err, errMissingUsername)
+	}
+
+	if cfg.Password == "" {
+		err = multierr.Append(err, errMissingPassword)
+	}
+
+	u, parseErr := url.Parse(cfg.Endpoint)
+	if parseErr != nil {
+		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
+		err = multierr.Append(err, wrappedErr)
+	} else if u.Scheme == "" || u.Host == "" {
+		err = multierr.Append(err, errInvalidEndpoint)
+	}
+
+	for _, filter := range [][]string{cfg.VirtualServers, cfg.Pools, cfg.Nodes} {
+		if _, filterErr := compileFilters(filter); filterErr != nil {
+			err = multierr.Append(err, filterErr)
+		}
+	}
+
+	return err
+}
+
+func compileFilters(filters []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(filters))
+	for _, filter := range filters {
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter regular expression %q: %w", filter, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
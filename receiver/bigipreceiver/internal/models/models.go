@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// Stats are the statistics of a virtual server, pool or node
+type Stats struct {
+	// Name is the full name of the object, including its partition, such as /Common/web
+	Name string
+	// Values are the numeric statistics, such as clientside.curConns
+	Values map[string]int64
+	// Descriptions are the textual statistics, such as status.availabilityState
+	Descriptions map[string]string
+}
+
+// StatsResponse is the response of the iControl REST stats endpoints
+type StatsResponse struct {
+	Entries map[string]StatsEntry `json:"entries"`
+}
+
+type StatsEntry struct {
+	NestedStats NestedStats `json:"nestedStats"`
+}
+
+type NestedStats struct {
+	Entries map[string]StatValue `json:"entries"`
+}
+
+// StatValue holds either a numeric value or a description
+type StatValue struct {
+	Value       *int64 `json:"value"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,258 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+)
+
+const instrumentationLibraryName = "otelcol/bigip"
+
+const (
+	virtualServerMetricsLen = 6
+	poolMetricsLen          = 7
+	nodeMetricsLen          = 7
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+type bigipScraper struct {
+	client         client
+	logger         *zap.Logger
+	cfg            *Config
+	settings       component.TelemetrySettings
+	mb             *metadata.MetricsBuilder
+	virtualServers []*regexp.Regexp
+	pools          []*regexp.Regexp
+	nodes          []*regexp.Regexp
+}
+
+func newScraper(logger *zap.Logger, cfg *Config, settings component.TelemetrySettings) *bigipScraper {
+	return &bigipScraper{
+		logger:   logger,
+		cfg:      cfg,
+		settings: settings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics),
+	}
+}
+
+func (b *bigipScraper) start(ctx context.Context, host component.Host) (err error) {
+	if b.virtualServers, err = compileFilters(b.cfg.VirtualServers); err != nil {
+		return err
+	}
+	if b.pools, err = compileFilters(b.cfg.Pools); err != nil {
+		return err
+	}
+	if b.nodes, err = compileFilters(b.cfg.Nodes); err != nil {
+		return err
+	}
+
+	b.client, err = newClient(b.cfg, host, b.settings, b.logger)
+	return
+}
+
+func (b *bigipScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	metrics := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+	rms := metrics.ResourceMetrics()
+
+	// Validate we don't attempt to scrape without initializing the client
+	if b.client == nil {
+		return metrics, errClientNotInit
+	}
+
+	var errs scrapererror.ScrapeErrors
+
+	virtualServers, err := b.client.GetVirtualServers(ctx)
+	if err != nil {
+		errs.AddPartial(virtualServerMetricsLen, err)
+	}
+	for _, virtualServer := range virtualServers {
+		if matchesFilters(b.virtualServers, virtualServer.Name) {
+			b.collectVirtualServer(virtualServer, now, rms)
+		}
+	}
+
+	pools, err := b.client.GetPools(ctx)
+	if err != nil {
+		errs.AddPartial(poolMetricsLen, err)
+	}
+	for _, pool := range pools {
+		if matchesFilters(b.pools, pool.Name) {
+			b.collectPool(pool, now, rms)
+		}
+	}
+
+	nodes, err := b.client.GetNodes(ctx)
+	if err != nil {
+		errs.AddPartial(nodeMetricsLen, err)
+	}
+	for _, node := range nodes {
+		if matchesFilters(b.nodes, node.Name) {
+			b.collectNode(node, now, rms)
+		}
+	}
+
+	return metrics, errs.Combine()
+}
+
+func matchesFilters(filters []*regexp.Regexp, name string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, re := range filters {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bigipScraper) collectVirtualServer(stats *models.Stats, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	ilms := appendResourceMetrics(rms, map[string]string{
+		metadata.A.BigipVirtualServerName:        stats.Name,
+		metadata.A.BigipVirtualServerDestination: stats.Descriptions["destination"],
+	})
+
+	v := stats.Values
+	b.mb.RecordBigipVirtualServerDataTransmittedDataPoint(now, v["clientside.bitsOut"]/8, metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipVirtualServerDataTransmittedDataPoint(now, v["clientside.bitsIn"]/8, metadata.AttributeDirection.Received)
+	b.mb.RecordBigipVirtualServerPacketCountDataPoint(now, v["clientside.pktsOut"], metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipVirtualServerPacketCountDataPoint(now, v["clientside.pktsIn"], metadata.AttributeDirection.Received)
+	b.mb.RecordBigipVirtualServerConnectionCountDataPoint(now, v["clientside.curConns"])
+	b.mb.RecordBigipVirtualServerRequestCountDataPoint(now, v["totRequests"])
+	for _, status := range availabilityStatuses {
+		b.mb.RecordBigipVirtualServerAvailabilityDataPoint(now, boolToInt64(availabilityStatus(stats) == status), status)
+	}
+	for _, state := range enabledStates {
+		b.mb.RecordBigipVirtualServerEnabledDataPoint(now, boolToInt64(enabledState(stats) == state), state)
+	}
+
+	b.mb.Emit(ilms.Metrics())
+}
+
+func (b *bigipScraper) collectPool(stats *models.Stats, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	ilms := appendResourceMetrics(rms, map[string]string{
+		metadata.A.BigipPoolName: stats.Name,
+	})
+
+	v := stats.Values
+	b.mb.RecordBigipPoolDataTransmittedDataPoint(now, v["serverside.bitsOut"]/8, metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipPoolDataTransmittedDataPoint(now, v["serverside.bitsIn"]/8, metadata.AttributeDirection.Received)
+	b.mb.RecordBigipPoolPacketCountDataPoint(now, v["serverside.pktsOut"], metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipPoolPacketCountDataPoint(now, v["serverside.pktsIn"], metadata.AttributeDirection.Received)
+	b.mb.RecordBigipPoolConnectionCountDataPoint(now, v["serverside.curConns"])
+	b.mb.RecordBigipPoolRequestCountDataPoint(now, v["totRequests"])
+	for _, status := range availabilityStatuses {
+		b.mb.RecordBigipPoolAvailabilityDataPoint(now, boolToInt64(availabilityStatus(stats) == status), status)
+	}
+	for _, state := range enabledStates {
+		b.mb.RecordBigipPoolEnabledDataPoint(now, boolToInt64(enabledState(stats) == state), state)
+	}
+	b.mb.RecordBigipPoolMemberCountDataPoint(now, v["activeMemberCnt"], metadata.AttributeActiveStatus.Active)
+	b.mb.RecordBigipPoolMemberCountDataPoint(now, v["memberCnt"]-v["activeMemberCnt"], metadata.AttributeActiveStatus.Inactive)
+
+	b.mb.Emit(ilms.Metrics())
+}
+
+func (b *bigipScraper) collectNode(stats *models.Stats, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	ilms := appendResourceMetrics(rms, map[string]string{
+		metadata.A.BigipNodeName:      stats.Name,
+		metadata.A.BigipNodeIPAddress: stats.Descriptions["addr"],
+	})
+
+	v := stats.Values
+	b.mb.RecordBigipNodeDataTransmittedDataPoint(now, v["serverside.bitsOut"]/8, metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipNodeDataTransmittedDataPoint(now, v["serverside.bitsIn"]/8, metadata.AttributeDirection.Received)
+	b.mb.RecordBigipNodePacketCountDataPoint(now, v["serverside.pktsOut"], metadata.AttributeDirection.Sent)
+	b.mb.RecordBigipNodePacketCountDataPoint(now, v["serverside.pktsIn"], metadata.AttributeDirection.Received)
+	b.mb.RecordBigipNodeConnectionCountDataPoint(now, v["serverside.curConns"])
+	b.mb.RecordBigipNodeRequestCountDataPoint(now, v["totRequests"])
+	for _, status := range availabilityStatuses {
+		b.mb.RecordBigipNodeAvailabilityDataPoint(now, boolToInt64(availabilityStatus(stats) == status), status)
+	}
+	for _, state := range enabledStates {
+		b.mb.RecordBigipNodeEnabledDataPoint(now, boolToInt64(enabledState(stats) == state), state)
+	}
+	b.mb.RecordBigipNodeSessionCountDataPoint(now, v["curSessions"])
+
+	b.mb.Emit(ilms.Metrics())
+}
+
+func appendResourceMetrics(rms pdata.ResourceMetricsSlice, attributes map[string]string) pdata.InstrumentationLibraryMetrics {
+	resourceMetric := rms.AppendEmpty()
+	resourceAttrs := resourceMetric.Resource().Attributes()
+	for key, value := range attributes {
+		if value != "" {
+			resourceAttrs.InsertString(key, value)
+		}
+	}
+
+	ilms := resourceMetric.InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+	return ilms
+}
+
+var availabilityStatuses = []string{
+	metadata.AttributeStatus.Offline,
+	metadata.AttributeStatus.Unknown,
+	metadata.AttributeStatus.Available,
+}
+
+// availabilityStatus maps the status.availabilityState of an object, which
+// can also be unavailable, to one of the availabilityStatuses.
+func availabilityStatus(stats *models.Stats) string {
+	switch stats.Descriptions["status.availabilityState"] {
+	case "available":
+		return metadata.AttributeStatus.Available
+	case "offline", "unavailable":
+		return metadata.AttributeStatus.Offline
+	default:
+		return metadata.AttributeStatus.Unknown
+	}
+}
+
+var enabledStates = []string{
+	metadata.AttributeEnabled.Disabled,
+	metadata.AttributeEnabled.Enabled,
+}
+
+// enabledState maps the status.enabledState of an object, which can also be
+// disabled-by-parent, to one of the enabledStates.
+func enabledState(stats *models.Stats) string {
+	if strings.HasPrefix(stats.Descriptions["status.enabledState"], "disabled") {
+		return metadata.AttributeEnabled.Disabled
+	}
+	return metadata.AttributeEnabled.Enabled
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+)
+
+type fakeClient struct {
+	virtualServers    []*models.Stats
+	pools             []*models.Stats
+	nodes             []*models.Stats
+	virtualServersErr error
+	poolsErr          error
+	nodesErr          error
+}
+
+func (c *fakeClient) GetVirtualServers(context.Context) ([]*models.Stats, error) {
+	return c.virtualServers, c.virtualServersErr
+}
+
+func (c *fakeClient) GetPools(context.Context) ([]*models.Stats, error) {
+	return c.pools, c.poolsErr
+}
+
+func (c *fakeClient) GetNodes(context.Context) ([]*models.Stats, error) {
+	return c.nodes, c.nodesErr
+}
+
+func testClient() *fakeClient {
+	return &fakeClient{
+		virtualServers: []*models.Stats{
+			{
+				Name: "/Common/api",
+				Values: map[string]int64{
+					"clientside.curConns": 0,
+				},
+				Descriptions: map[string]string{
+					"destination":              "10.0.0.11:8443",
+					"status.availabilityState": "offline",
+					"status.enabledState":      "disabled",
+				},
+			},
+			{
+				Name: "/Common/web",
+				Values: map[string]int64{
+					"clientside.bitsIn":   81920,
+					"clientside.bitsOut":  163840,
+					"clientside.curConns": 12,
+					"clientside.pktsIn":   150,
+					"clientside.pktsOut":  210,
+					"totRequests":         1250,
+				},
+				Descriptions: map[string]string{
+					"destination":              "10.0.0.10:443",
+					"status.availabilityState": "available",
+					"status.enabledState":      "enabled",
+				},
+			},
+		},
+		pools: []*models.Stats{
+			{
+				Name: "/Common/web_pool",
+				Values: map[string]int64{
+					"activeMemberCnt":     2,
+					"memberCnt":           3,
+					"serverside.bitsIn":   40960,
+					"serverside.bitsOut":  20480,
+					"serverside.curConns": 8,
+					"serverside.pktsIn":   90,
+					"serverside.pktsOut":  70,
+					"totRequests":         1100,
+				},
+				Descriptions: map[string]string{
+					"status.availabilityState": "available",
+					"status.enabledState":      "enabled",
+				},
+			},
+		},
+		nodes: []*models.Stats{
+			{
+				Name: "/Common/web-1",
+				Values: map[string]int64{
+					"curSessions":         5,
+					"serverside.bitsIn":   16384,
+					"serverside.bitsOut":  8192,
+					"serverside.curConns": 4,
+					"serverside.pktsIn":   40,
+					"serverside.pktsOut":  30,
+					"totRequests":         600,
+				},
+				Descriptions: map[string]string{
+					"addr":                     "10.0.1.21",
+					"status.availabilityState": "unknown",
+					"status.enabledState":      "disabled-by-parent",
+				},
+			},
+		},
+	}
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		scraper     *bigipScraper
+		expectError bool
+	}{
+		{
+			desc: "Bad Config",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+						TLSSetting: configtls.TLSClientSetting{
+							TLSSetting: configtls.TLSSetting{
+								CAFile: "/non/existent",
+							},
+						},
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Bad pool regular expression",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+					},
+					Pools: []string{"web("},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid Config",
+			scraper: &bigipScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						TLSSetting: configtls.TLSClientSetting{},
+						Endpoint:   defaultEndpoint,
+					},
+					VirtualServers: []string{"^/Common/web$"},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrapeErrors(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+
+	client := testClient()
+	client.virtualServers = nil
+	client.virtualServersErr = errors.New("some api error")
+	scraper.client = client
+	metrics, err = scraper.scrape(context.Background())
+	require.EqualError(t, err, "some api error")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, virtualServerMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		virtualServers []string
+		pools          []string
+		nodes          []string
+		expectedNames  []string
+	}{
+		{
+			desc:          "All objects",
+			expectedNames: []string{"/Common/api", "/Common/web", "/Common/web_pool", "/Common/web-1"},
+		},
+		{
+			desc:           "Allowlists",
+			virtualServers: []string{"^/Common/web$"},
+			pools:          []string{"^/Other/"},
+			expectedNames:  []string{"/Common/web", "/Common/web-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.VirtualServers = tc.virtualServers
+			cfg.Pools = tc.pools
+			cfg.Nodes = tc.nodes
+			scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			scraper.client = testClient()
+
+			metrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			rms := metrics.ResourceMetrics()
+			require.Equal(t, len(tc.expectedNames), rms.Len())
+			for i, name := range tc.expectedNames {
+				attrs := rms.At(i).Resource().Attributes().AsRaw()
+				require.Contains(t, []interface{}{attrs["bigip.virtual_server.name"], attrs["bigip.pool.name"], attrs["bigip.node.name"]}, name)
+
+				ilms := rms.At(i).InstrumentationLibraryMetrics()
+				require.Equal(t, 1, ilms.Len())
+				require.Equal(t, instrumentationLibraryName, ilms.At(0).InstrumentationLibrary().Name())
+			}
+		})
+	}
+}
+
+func TestScraperScrapeValues(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+	scraper.client = testClient()
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	rms := metrics.ResourceMetrics()
+	require.Equal(t, 4, rms.Len())
+
+	require.Equal(t, map[string]interface{}{
+		"bigip.virtual_server.name":        "/Common/web",
+		"bigip.virtual_server.destination": "10.0.0.10:443",
+	}, rms.At(1).Resource().Attributes().AsRaw())
+	require.Equal(t, map[string]map[string]int64{
+		"bigip.virtual_server.data.transmitted": {"sent": 20480, "received": 10240},
+		"bigip.virtual_server.packet.count":     {"sent": 210, "received": 150},
+		"bigip.virtual_server.connection.count": {"": 12},
+		"bigip.virtual_server.request.count":    {"": 1250},
+		"bigip.virtual_server.availability":     {"offline": 0, "unknown": 0, "available": 1},
+		"bigip.virtual_server.enabled":          {"disabled": 0, "enabled": 1},
+	}, dataPointValues(t, rms.At(1)))
+
+	require.Equal(t, map[string]interface{}{
+		"bigip.pool.name": "/Common/web_pool",
+	}, rms.At(2).Resource().Attributes().AsRaw())
+	require.Equal(t, map[string]map[string]int64{
+		"bigip.pool.data.transmitted": {"sent": 2560, "received": 5120},
+		"bigip.pool.packet.count":     {"sent": 70, "received": 90},
+		"bigip.pool.connection.count": {"": 8},
+		"bigip.pool.request.count":    {"": 1100},
+		"bigip.pool.availability":     {"offline": 0, "unknown": 0, "available": 1},
+		"bigip.pool.enabled":          {"disabled": 0, "enabled": 1},
+		"bigip.pool.member.count":     {"active": 2, "inactive": 1},
+	}, dataPointValues(t, rms.At(2)))
+
+	require.Equal(t, map[string]interface{}{
+		"bigip.node.name":       "/Common/web-1",
+		"bigip.node.ip_address": "10.0.1.21",
+	}, rms.At(3).Resource().Attributes().AsRaw())
+	require.Equal(t, map[string]map[string]int64{
+		"bigip.node.data.transmitted": {"sent": 1024, "received": 2048},
+		"bigip.node.packet.count":     {"sent": 30, "received": 40},
+		"bigip.node.connection.count": {"": 4},
+		"bigip.node.request.count":    {"": 600},
+		"bigip.node.availability":     {"offline": 0, "unknown": 1, "available": 0},
+		"bigip.node.enabled":          {"disabled": 1, "enabled": 0},
+		"bigip.node.session.count":    {"": 5},
+	}, dataPointValues(t, rms.At(3)))
+}
+
+// dataPointValues returns the values of the data points of the metrics keyed
+// by the metric name and the value of their attribute, if any.
+func dataPointValues(t *testing.T, rm pdata.ResourceMetrics) map[string]map[string]int64 {
+	values := make(map[string]map[string]int64)
+	ms := rm.InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		require.Equal(t, pdata.MetricDataTypeSum, m.DataType(), m.Name())
+		values[m.Name()] = make(map[string]int64)
+		dps := m.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attribute := ""
+			dps.At(j).Attributes().Range(func(_ string, v pdata.AttributeValue) bool {
+				attribute = v.StringVal()
+				return false
+			})
+			values[m.Name()][attribute] = dps.At(j).IntVal()
+		}
+	}
+	return values
+}
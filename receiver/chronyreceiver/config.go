@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chronyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/metadata"
+)
+
+var (
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of unix://<path> or udp://<hostname>:<port>`)
+	errInvalidTimeout  = errors.New(`"timeout" must be positive`)
+)
+
+const defaultEndpoint = "unix:///var/run/chrony/chronyd.sock"
+
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	// Endpoint is the UNIX domain socket, unix://<path>, or the UDP address,
+	// udp://<hostname>:<port>, chronyd listens to for the commands.
+	Endpoint string `mapstructure:"endpoint"`
+	// Timeout is the time to wait for each of the reports of chronyd.
+	Timeout time.Duration            `mapstructure:"timeout"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+	if _, _, parseErr := chrony.ParseEndpoint(cfg.Endpoint); parseErr != nil {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
+	}
+
+	if cfg.Timeout <= 0 {
+		err = multierr.Append(err, errInvalidTimeout)
+	}
+
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chronyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc: "invalid endpoint and timeout",
+			cfg: &Config{
+				Endpoint: "tcp://localhost:323",
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`unsupported scheme "tcp", must be unix or udp`)),
+				errInvalidTimeout,
+			),
+		},
+		{
+			desc: "endpoint without socket path",
+			cfg: &Config{
+				Endpoint: "unix://",
+				Timeout:  time.Second,
+			},
+			expectedErr: fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`missing socket path in "unix://"`)),
+		},
+		{
+			desc: "valid UNIX domain socket",
+			cfg: &Config{
+				Endpoint: defaultEndpoint,
+				Timeout:  time.Second,
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "valid UDP address",
+			cfg: &Config{
+				Endpoint: "udp://localhost:323",
+				Timeout:  time.Second,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
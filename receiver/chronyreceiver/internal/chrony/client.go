@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrony // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultPort is the port chronyd listens to for the commands over UDP.
+const DefaultPort = "323"
+
+var errNoSuchSource = errors.New("no such source")
+
+// Client reads the reports of chronyd.
+type Client interface {
+	// Tracking returns the report about the system clock.
+	Tracking(ctx context.Context) (*Tracking, error)
+	// Sources returns the reports about the time sources.
+	Sources(ctx context.Context) ([]*Source, error)
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	network  string
+	address  string
+	timeout  time.Duration
+	sequence uint32
+}
+
+// socketID makes the names of the local sockets of the client unique.
+var socketID uint32
+
+// ParseEndpoint parses the endpoint of chronyd, either unix:///path/to/chronyd.sock
+// for its UNIX domain socket or udp://host:port for its UDP port, and returns
+// the network and address to connect to.
+func ParseEndpoint(endpoint string) (network, address string, err error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return "", "", fmt.Errorf("missing socket path in %q", endpoint)
+		}
+		return "unixgram", u.Path, nil
+	case "udp":
+		if u.Hostname() == "" {
+			return "", "", fmt.Errorf("missing host in %q", endpoint)
+		}
+		port := u.Port()
+		if port == "" {
+			port = DefaultPort
+		}
+		return "udp", net.JoinHostPort(u.Hostname(), port), nil
+	default:
+		return "", "", fmt.Errorf("unsupported scheme %q, must be unix or udp", u.Scheme)
+	}
+}
+
+// New returns a client of the chronyd listening on endpoint, waiting at most
+// timeout for each of its reports.
+func New(endpoint string, timeout time.Duration) (Client, error) {
+	network, address, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		network:  network,
+		address:  address,
+		timeout:  timeout,
+		sequence: rand.Uint32(), // #nosec G404 the sequence only matches the replies to the requests
+	}, nil
+}
+
+func (c *client) Tracking(ctx context.Context) (*Tracking, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var reply trackingReply
+	if err := c.do(conn, reqTracking, nil, rpyTracking, &reply); err != nil {
+		return nil, fmt.Errorf("failed to get tracking: %w", err)
+	}
+	return reply.tracking(), nil
+}
+
+func (c *client) Sources(ctx context.Context) ([]*Source, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var n nSourcesReply
+	if err := c.do(conn, reqNSources, nil, rpyNSources, &n); err != nil {
+		return nil, fmt.Errorf("failed to get the number of sources: %w", err)
+	}
+
+	sources := make([]*Source, 0, n.NSources)
+	for i := int32(0); i < int32(n.NSources); i++ {
+		var reply sourceDataReply
+		err := c.do(conn, reqSourceData, &sourceDataRequest{Index: i}, rpySourceData, &reply)
+		if errors.Is(err, errNoSuchSource) {
+			// The source was removed since the number of sources was read.
+			break
+		}
+		if err != nil {
+			return sources, fmt.Errorf("failed to get source %d: %w", i, err)
+		}
+		sources = append(sources, reply.source())
+	}
+	return sources, nil
+}
+
+func (c *client) dial(ctx context.Context) (net.Conn, error) {
+	var conn net.Conn
+	if c.network == "unixgram" {
+		unixConn, err := dialUnix(c.address)
+		if err != nil {
+			return nil, err
+		}
+		conn = unixConn
+	} else {
+		var d net.Dialer
+		udpConn, err := d.DialContext(ctx, c.network, c.address)
+		if err != nil {
+			return nil, err
+		}
+		conn = udpConn
+	}
+
+	deadline := time.Now().Add(c.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// unixConn removes its local socket when closed.
+type unixConn struct {
+	*net.UnixConn
+	local string
+}
+
+func (c *unixConn) Close() error {
+	err := c.UnixConn.Close()
+	_ = os.Remove(c.local)
+	return err
+}
+
+// dialUnix connects to the socket of chronyd from a local socket, which
+// chronyd sends its replies to.
+func dialUnix(address string) (*unixConn, error) {
+	local := filepath.Join(os.TempDir(), fmt.Sprintf("otelcol-chrony.%d.%d.sock", os.Getpid(), atomic.AddUint32(&socketID, 1)))
+	conn, err := net.DialUnix("unixgram", &net.UnixAddr{Name: local, Net: "unixgram"}, &net.UnixAddr{Name: address, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+
+	// chronyd drops its root privileges, it must be allowed to write its
+	// replies to the local socket.
+	if err := os.Chmod(local, 0666); err != nil { // #nosec G302
+		conn.Close()
+		_ = os.Remove(local)
+		return nil, err
+	}
+	return &unixConn{UnixConn: conn, local: local}, nil
+}
+
+// do sends a request and decodes the data of its reply into reply.
+func (c *client) do(conn net.Conn, command uint16, request interface{}, replyCode uint16, reply interface{}) error {
+	sequence := atomic.AddUint32(&c.sequence, 1)
+	if _, err := conn.Write(encodeRequest(command, sequence, request)); err != nil {
+		return err
+	}
+
+	buf := make([]byte, maxReplyLength)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return err
+		}
+
+		r := bytes.NewReader(buf[:n])
+		var head replyHead
+		if err := binary.Read(r, binary.BigEndian, &head); err != nil {
+			return fmt.Errorf("invalid reply: %w", err)
+		}
+		// Skip the late replies to the earlier requests.
+		if head.PktType != pktTypeReply || head.Sequence != sequence {
+			continue
+		}
+
+		switch {
+		case head.Version != protocolVersion:
+			return fmt.Errorf("unsupported protocol version %d", head.Version)
+		case head.Status == sttNoSuchSource:
+			return errNoSuchSource
+		case head.Status != sttSuccess:
+			return fmt.Errorf("request failed with status %d", head.Status)
+		case head.Reply != replyCode:
+			return fmt.Errorf("unexpected reply %d", head.Reply)
+		}
+
+		if err := binary.Read(r, binary.BigEndian, reply); err != nil {
+			return fmt.Errorf("invalid reply: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chrony
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"net"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChronyFloat(t *testing.T) {
+	testCases := []struct {
+		f        chronyFloat
+		expected float64
+	}{
+		{f: 0, expected: 0},
+		{f: 0x04800000, expected: 1},
+		{f: 0x03000000, expected: -1},
+		{f: toChronyFloat(0.000123), expected: 0.000123},
+		{f: toChronyFloat(-12.5), expected: -12.5},
+	}
+
+	for _, tc := range testCases {
+		assert.InDelta(t, tc.expected, tc.f.float64(), math.Abs(tc.expected)*1e-6)
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	testCases := []struct {
+		endpoint        string
+		expectedNetwork string
+		expectedAddress string
+		expectedErr     string
+	}{
+		{endpoint: "unix:///var/run/chrony/chronyd.sock", expectedNetwork: "unixgram", expectedAddress: "/var/run/chrony/chronyd.sock"},
+		{endpoint: "udp://localhost:323", expectedNetwork: "udp", expectedAddress: "localhost:323"},
+		{endpoint: "udp://[::1]", expectedNetwork: "udp", expectedAddress: "[::1]:323"},
+		{endpoint: "unix://", expectedErr: `missing socket path in "unix://"`},
+		{endpoint: "udp://:323", expectedErr: `missing host in "udp://:323"`},
+		{endpoint: "tcp://localhost:323", expectedErr: `unsupported scheme "tcp", must be unix or udp`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.endpoint, func(t *testing.T) {
+			network, address, err := ParseEndpoint(tc.endpoint)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNetwork, network)
+			assert.Equal(t, tc.expectedAddress, address)
+		})
+	}
+}
+
+func TestClientTracking(t *testing.T) {
+	endpoint := fakeChronyd(t, func(command uint16, _ []byte) (uint16, uint16, interface{}) {
+		require.EqualValues(t, reqTracking, command)
+		return sttSuccess, rpyTracking, &trackingReply{
+			RefID:             0xc0a80001,
+			Stratum:           3,
+			LeapStatus:        uint16(LeapStatusInsertSecond),
+			CurrentCorrection: toChronyFloat(0.000012),
+			LastOffset:        toChronyFloat(-0.000034),
+			RMSOffset:         toChronyFloat(0.000056),
+			FreqPPM:           toChronyFloat(-7.5),
+			SkewPPM:           toChronyFloat(0.125),
+			RootDelay:         toChronyFloat(0.0215),
+			RootDispersion:    toChronyFloat(0.0011),
+		}
+	})
+
+	c, err := New(endpoint, time.Second)
+	require.NoError(t, err)
+
+	tracking, err := c.Tracking(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 0xc0a80001, tracking.RefID)
+	assert.EqualValues(t, 3, tracking.Stratum)
+	assert.Equal(t, LeapStatusInsertSecond, tracking.LeapStatus)
+	assert.InEpsilon(t, 0.000012, tracking.CurrentCorrection, 1e-6)
+	assert.InEpsilon(t, -0.000034, tracking.LastOffset, 1e-6)
+	assert.InEpsilon(t, 0.000056, tracking.RMSOffset, 1e-6)
+	assert.InEpsilon(t, -7.5, tracking.FreqPPM, 1e-6)
+	assert.InEpsilon(t, 0.125, tracking.SkewPPM, 1e-6)
+	assert.InEpsilon(t, 0.0215, tracking.RootDelay, 1e-6)
+	assert.InEpsilon(t, 0.0011, tracking.RootDispersion, 1e-6)
+}
+
+func TestClientSources(t *testing.T) {
+	server := ipAddr{Family: ipAddrInet4}
+	copy(server.Addr[:], net.ParseIP("192.168.0.1").To4())
+	refclock := ipAddr{Family: ipAddrInet4}
+	copy(refclock.Addr[:], "GPS")
+
+	sources := []sourceDataReply{
+		{
+			IPAddr:        server,
+			Poll:          6,
+			Stratum:       2,
+			State:         uint16(SourceStateSelected),
+			Mode:          uint16(SourceModeServer),
+			Reachability:  0xfb,
+			SinceSample:   35,
+			LatestMeas:    toChronyFloat(0.00025),
+			LatestMeasErr: toChronyFloat(0.0125),
+		},
+		{
+			IPAddr:       refclock,
+			Poll:         4,
+			State:        uint16(SourceStateFalseticker),
+			Mode:         uint16(SourceModeRefclock),
+			Reachability: 0x0f,
+		},
+	}
+
+	endpoint := fakeChronyd(t, func(command uint16, data []byte) (uint16, uint16, interface{}) {
+		switch command {
+		case reqNSources:
+			// A third source is removed before it is read.
+			return sttSuccess, rpyNSources, &nSourcesReply{NSources: 3}
+		case reqSourceData:
+			index := int(binary.BigEndian.Uint32(data))
+			if index >= len(sources) {
+				return sttNoSuchSource, rpySourceData, nil
+			}
+			return sttSuccess, rpySourceData, &sources[index]
+		}
+		t.Fatalf("unexpected command %d", command)
+		return 0, 0, nil
+	})
+
+	c, err := New(endpoint, time.Second)
+	require.NoError(t, err)
+
+	actual, err := c.Sources(context.Background())
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	assert.Equal(t, "192.168.0.1", actual[0].Name)
+	assert.EqualValues(t, 6, actual[0].Poll)
+	assert.EqualValues(t, 2, actual[0].Stratum)
+	assert.Equal(t, SourceStateSelected, actual[0].State)
+	assert.Equal(t, SourceModeServer, actual[0].Mode)
+	assert.EqualValues(t, 0xfb, actual[0].Reachability)
+	assert.EqualValues(t, 35, actual[0].SinceSample)
+	assert.InEpsilon(t, 0.00025, actual[0].LatestMeas, 1e-6)
+	assert.InEpsilon(t, 0.0125, actual[0].LatestMeasErr, 1e-6)
+
+	assert.Equal(t, "GPS", actual[1].Name)
+	assert.Equal(t, SourceStateFalseticker, actual[1].State)
+	assert.Equal(t, SourceModeRefclock, actual[1].Mode)
+}
+
+func TestClientErrors(t *testing.T) {
+	t.Run("failed status", func(t *testing.T) {
+		endpoint := fakeChronyd(t, func(uint16, []byte) (uint16, uint16, interface{}) {
+			return 2, rpyTracking, nil
+		})
+		c, err := New(endpoint, time.Second)
+		require.NoError(t, err)
+
+		_, err = c.Tracking(context.Background())
+		require.EqualError(t, err, "failed to get tracking: request failed with status 2")
+	})
+
+	t.Run("unexpected reply", func(t *testing.T) {
+		endpoint := fakeChronyd(t, func(uint16, []byte) (uint16, uint16, interface{}) {
+			return sttSuccess, rpySourceData, nil
+		})
+		c, err := New(endpoint, time.Second)
+		require.NoError(t, err)
+
+		_, err = c.Sources(context.Background())
+		require.EqualError(t, err, "failed to get the number of sources: unexpected reply 3")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		endpoint := fakeChronyd(t, nil)
+		c, err := New(endpoint, 10*time.Millisecond)
+		require.NoError(t, err)
+
+		_, err = c.Tracking(context.Background())
+		var netErr net.Error
+		require.ErrorAs(t, err, &netErr)
+		assert.True(t, netErr.Timeout())
+	})
+
+	t.Run("no chronyd", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("chronyd is not supported on Windows")
+		}
+		c, err := New("unix://"+filepath.Join(t.TempDir(), "chronyd.sock"), time.Second)
+		require.NoError(t, err)
+
+		_, err = c.Tracking(context.Background())
+		require.Error(t, err)
+	})
+}
+
+// fakeChronyd serves the requests to a UNIX domain socket with handle, which
+// returns the status, reply code and data of the reply to a command. The
+// requests are left unanswered when handle is nil.
+func fakeChronyd(t *testing.T, handle func(command uint16, data []byte) (uint16, uint16, interface{})) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("chronyd is not supported on Windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "chronyd.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, maxReplyLength)
+		for {
+			n, addr, err := conn.ReadFromUnix(buf)
+			if err != nil {
+				return
+			}
+			if handle == nil {
+				continue
+			}
+
+			// chronyd drops the requests shorter than their reply.
+			if n != requestLength {
+				continue
+			}
+			var head requestHead
+			if binary.Read(bytes.NewReader(buf[:n]), binary.BigEndian, &head) != nil {
+				continue
+			}
+			status, replyCode, data := handle(head.Command, buf[binary.Size(head):n])
+
+			reply := bytes.NewBuffer(nil)
+			_ = binary.Write(reply, binary.BigEndian, replyHead{
+				Version:  protocolVersion,
+				PktType:  pktTypeReply,
+				Command:  head.Command,
+				Reply:    replyCode,
+				Status:   status,
+				Sequence: head.Sequence,
+			})
+			if data != nil {
+				_ = binary.Write(reply, binary.BigEndian, data)
+			}
+			_, _ = conn.WriteToUnix(reply.Bytes(), addr)
+		}
+	}()
+
+	return "unix://" + path
+}
+
+// toChronyFloat encodes x as UTI_FloatHostToNetwork of chronyd does.
+func toChronyFloat(x float64) chronyFloat {
+	const (
+		expMin  = -(1 << (floatExpBits - 1))
+		expMax  = -expMin - 1
+		coefMax = 1<<(floatCoefBits-1) - 1
+	)
+
+	var neg int32
+	if x < 0 {
+		x = -x
+		neg = 1
+	}
+
+	var exp, coef int32
+	if x >= 1e-100 {
+		exp = int32(math.Log(x)/math.Log(2) + 1)
+		coef = int32(x*math.Pow(2, float64(-exp+floatCoefBits)) + 0.5)
+		for coef > coefMax+neg {
+			coef >>= 1
+			exp++
+		}
+		if exp < expMin {
+			coef >>= expMin - exp
+			exp = expMin
+		}
+	}
+
+	if neg == 1 {
+		coef = int32(uint32(-coef) << floatExpBits >> floatExpBits)
+	}
+	return chronyFloat(uint32(exp)<<floatCoefBits | uint32(coef))
+}
@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chrony implements the client side of the command and monitoring
+// protocol of chronyd, used by chronyc, to read its tracking and sources
+// reports.
+package chrony // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+)
+
+const (
+	protocolVersion = 6
+
+	pktTypeRequest = 1
+	pktTypeReply   = 2
+
+	reqNSources   = 14
+	reqSourceData = 15
+	reqTracking   = 33
+
+	rpyNSources   = 2
+	rpySourceData = 3
+	rpyTracking   = 5
+
+	sttSuccess      = 0
+	sttNoSuchSource = 4
+
+	ipAddrInet4 = 1
+	ipAddrInet6 = 2
+	ipAddrID    = 3
+
+	// requestLength is the length the requests are padded to. chronyd drops
+	// the requests shorter than their reply, so that it can't be used to
+	// amplify traffic.
+	requestLength = 416
+	// maxReplyLength is larger than any of the replies handled by the client.
+	maxReplyLength = 1024
+)
+
+// LeapStatus is the leap status of the system clock.
+type LeapStatus uint16
+
+const (
+	LeapStatusNormal LeapStatus = iota
+	LeapStatusInsertSecond
+	LeapStatusDeleteSecond
+	LeapStatusUnsynchronised
+)
+
+// SourceMode is the mode of a time source.
+type SourceMode uint16
+
+const (
+	SourceModeServer SourceMode = iota
+	SourceModePeer
+	SourceModeRefclock
+)
+
+// SourceState is the selection state of a time source.
+type SourceState uint16
+
+const (
+	SourceStateSelected SourceState = iota
+	SourceStateNonselectable
+	SourceStateFalseticker
+	SourceStateJittery
+	SourceStateUnselected
+	SourceStateSelectable
+)
+
+// Tracking is the report of chronyd about the system clock, as shown by
+// chronyc tracking. The offsets and delays are in seconds and the frequencies
+// in parts per million.
+type Tracking struct {
+	RefID              uint32
+	Stratum            uint16
+	LeapStatus         LeapStatus
+	CurrentCorrection  float64
+	LastOffset         float64
+	RMSOffset          float64
+	FreqPPM            float64
+	ResidFreqPPM       float64
+	SkewPPM            float64
+	RootDelay          float64
+	RootDispersion     float64
+	LastUpdateInterval float64
+}
+
+// Source is the report of chronyd about a time source, as shown by chronyc
+// sources. The measurements are in seconds.
+type Source struct {
+	// Name is the address of the source, or the reference ID of a reference
+	// clock.
+	Name    string
+	Poll    int16
+	Stratum uint16
+	State   SourceState
+	Mode    SourceMode
+	// Reachability is the register of the last 8 polls of the source, with a
+	// bit set for each poll which received a valid reply.
+	Reachability   uint8
+	SinceSample    uint32
+	OrigLatestMeas float64
+	LatestMeas     float64
+	LatestMeasErr  float64
+}
+
+type requestHead struct {
+	Version  uint8
+	PktType  uint8
+	Res1     uint8
+	Res2     uint8
+	Command  uint16
+	Attempt  uint16
+	Sequence uint32
+	Pad1     uint32
+	Pad2     uint32
+}
+
+type replyHead struct {
+	Version  uint8
+	PktType  uint8
+	Res1     uint8
+	Res2     uint8
+	Command  uint16
+	Reply    uint16
+	Status   uint16
+	Pad1     uint16
+	Pad2     uint16
+	Pad3     uint16
+	Sequence uint32
+	Pad4     uint32
+	Pad5     uint32
+}
+
+type ipAddr struct {
+	Addr   [16]byte
+	Family uint16
+	Pad    uint16
+}
+
+type timespec struct {
+	SecHigh uint32
+	SecLow  uint32
+	Nsec    uint32
+}
+
+// chronyFloat is the 32 bits floating point format of the protocol, with a 7
+// bits exponent and a 25 bits coefficient.
+type chronyFloat uint32
+
+type trackingReply struct {
+	RefID              uint32
+	IPAddr             ipAddr
+	Stratum            uint16
+	LeapStatus         uint16
+	RefTime            timespec
+	CurrentCorrection  chronyFloat
+	LastOffset         chronyFloat
+	RMSOffset          chronyFloat
+	FreqPPM            chronyFloat
+	ResidFreqPPM       chronyFloat
+	SkewPPM            chronyFloat
+	RootDelay          chronyFloat
+	RootDispersion     chronyFloat
+	LastUpdateInterval chronyFloat
+}
+
+type nSourcesReply struct {
+	NSources uint32
+}
+
+type sourceDataRequest struct {
+	Index int32
+}
+
+type sourceDataReply struct {
+	IPAddr         ipAddr
+	Poll           int16
+	Stratum        uint16
+	State          uint16
+	Mode           uint16
+	Flags          uint16
+	Reachability   uint16
+	SinceSample    uint32
+	OrigLatestMeas chronyFloat
+	LatestMeas     chronyFloat
+	LatestMeasErr  chronyFloat
+}
+
+const (
+	floatExpBits  = 7
+	floatCoefBits = 32 - floatExpBits
+)
+
+func (f chronyFloat) float64() float64 {
+	exp := int32(f >> floatCoefBits)
+	if exp >= 1<<(floatExpBits-1) {
+		exp -= 1 << floatExpBits
+	}
+	exp -= floatCoefBits
+
+	coef := int32(f % (1 << floatCoefBits))
+	if coef >= 1<<(floatCoefBits-1) {
+		coef -= 1 << floatCoefBits
+	}
+
+	return float64(coef) * math.Pow(2, float64(exp))
+}
+
+func (a ipAddr) String() string {
+	switch a.Family {
+	case ipAddrInet4:
+		return net.IP(a.Addr[:4]).String()
+	case ipAddrInet6:
+		return net.IP(a.Addr[:]).String()
+	case ipAddrID:
+		return fmt.Sprintf("ID#%010d", binary.BigEndian.Uint32(a.Addr[:4]))
+	default:
+		return ""
+	}
+}
+
+// refIDString returns the printable characters of a reference ID, such as
+// GPS or PPS for the reference clocks.
+func refIDString(refID []byte) string {
+	var b strings.Builder
+	for _, c := range refID {
+		if c == 0 {
+			break
+		}
+		if c >= ' ' && c <= '~' {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func encodeRequest(command uint16, sequence uint32, data interface{}) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, requestLength))
+	// Writing to a bytes.Buffer fixed size values doesn't fail.
+	_ = binary.Write(buf, binary.BigEndian, requestHead{
+		Version:  protocolVersion,
+		PktType:  pktTypeRequest,
+		Command:  command,
+		Sequence: sequence,
+	})
+	if data != nil {
+		_ = binary.Write(buf, binary.BigEndian, data)
+	}
+	buf.Write(make([]byte, requestLength-buf.Len()))
+	return buf.Bytes()
+}
+
+func (r *trackingReply) tracking() *Tracking {
+	return &Tracking{
+		RefID:              r.RefID,
+		Stratum:            r.Stratum,
+		LeapStatus:         LeapStatus(r.LeapStatus),
+		CurrentCorrection:  r.CurrentCorrection.float64(),
+		LastOffset:         r.LastOffset.float64(),
+		RMSOffset:          r.RMSOffset.float64(),
+		FreqPPM:            r.FreqPPM.float64(),
+		ResidFreqPPM:       r.ResidFreqPPM.float64(),
+		SkewPPM:            r.SkewPPM.float64(),
+		RootDelay:          r.RootDelay.float64(),
+		RootDispersion:     r.RootDispersion.float64(),
+		LastUpdateInterval: r.LastUpdateInterval.float64(),
+	}
+}
+
+func (r *sourceDataReply) source() *Source {
+	src := &Source{
+		Name:           r.IPAddr.String(),
+		Poll:           r.Poll,
+		Stratum:        r.Stratum,
+		State:          SourceState(r.State),
+		Mode:           SourceMode(r.Mode),
+		Reachability:   uint8(r.Reachability),
+		SinceSample:    r.SinceSample,
+		OrigLatestMeas: r.OrigLatestMeas.float64(),
+		LatestMeas:     r.LatestMeas.float64(),
+		LatestMeasErr:  r.LatestMeasErr.float64(),
+	}
+	// chronyd reports the reference ID of the reference clocks in place of
+	// their IPv4 address.
+	if src.Mode == SourceModeRefclock {
+		src.Name = refIDString(r.IPAddr.Addr[:4])
+	}
+	return src
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chronyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/bits"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/metadata"
+)
+
+const instrumentationLibraryName = "otelcol/chrony"
+
+const (
+	trackingMetricsLen = 9
+	sourceMetricsLen   = 7
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+type chronyScraper struct {
+	client chrony.Client
+	logger *zap.Logger
+	cfg    *Config
+	mb     *metadata.MetricsBuilder
+}
+
+func newScraper(logger *zap.Logger, cfg *Config) *chronyScraper {
+	return &chronyScraper{
+		logger: logger,
+		cfg:    cfg,
+		mb:     metadata.NewMetricsBuilder(cfg.Metrics),
+	}
+}
+
+func (s *chronyScraper) start(_ context.Context, _ component.Host) (err error) {
+	s.client, err = chrony.New(s.cfg.Endpoint, s.cfg.Timeout)
+	return
+}
+
+func (s *chronyScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	metrics := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+	ilms := metrics.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+
+	// Validate we don't attempt to scrape without initializing the client
+	if s.client == nil {
+		return metrics, errClientNotInit
+	}
+
+	var errs scrapererror.ScrapeErrors
+
+	tracking, err := s.client.Tracking(ctx)
+	if err != nil {
+		errs.AddPartial(trackingMetricsLen, err)
+	} else {
+		s.recordTracking(now, tracking)
+	}
+
+	sources, err := s.client.Sources(ctx)
+	if err != nil {
+		errs.AddPartial(sourceMetricsLen, err)
+	}
+	for _, source := range sources {
+		s.recordSource(now, source)
+	}
+
+	s.mb.Emit(ilms.Metrics())
+	return metrics, errs.Combine()
+}
+
+func (s *chronyScraper) recordTracking(now pdata.Timestamp, tracking *chrony.Tracking) {
+	s.mb.RecordChronyStratumDataPoint(now, int64(tracking.Stratum))
+	for status, name := range leapStatuses {
+		s.mb.RecordChronyLeapStatusDataPoint(now, boolToInt64(tracking.LeapStatus == chrony.LeapStatus(status)), name)
+	}
+	s.mb.RecordChronyTimeOffsetDataPoint(now, tracking.LastOffset)
+	s.mb.RecordChronyTimeCorrectionDataPoint(now, tracking.CurrentCorrection)
+	s.mb.RecordChronyTimeRmsOffsetDataPoint(now, tracking.RMSOffset)
+	s.mb.RecordChronyFrequencyOffsetDataPoint(now, tracking.FreqPPM)
+	s.mb.RecordChronyFrequencySkewDataPoint(now, tracking.SkewPPM)
+	s.mb.RecordChronyRootDelayDataPoint(now, tracking.RootDelay)
+	s.mb.RecordChronyRootDispersionDataPoint(now, tracking.RootDispersion)
+}
+
+func (s *chronyScraper) recordSource(now pdata.Timestamp, source *chrony.Source) {
+	if int(source.Mode) >= len(sourceModes) {
+		s.logger.Debug("Skipping source with unknown mode", zap.String("source", source.Name), zap.Uint16("mode", uint16(source.Mode)))
+		return
+	}
+	mode := sourceModes[source.Mode]
+
+	s.mb.RecordChronySourceStratumDataPoint(now, int64(source.Stratum), source.Name, mode)
+	s.mb.RecordChronySourceReachabilityDataPoint(now, int64(bits.OnesCount8(source.Reachability)), source.Name, mode)
+	for state, name := range sourceStates {
+		s.mb.RecordChronySourceStateDataPoint(now, boolToInt64(source.State == chrony.SourceState(state)), source.Name, mode, name)
+	}
+	s.mb.RecordChronySourceOffsetDataPoint(now, source.LatestMeas, source.Name, mode)
+	s.mb.RecordChronySourceOffsetErrorDataPoint(now, source.LatestMeasErr, source.Name, mode)
+	s.mb.RecordChronySourcePollIntervalDataPoint(now, math.Pow(2, float64(source.Poll)), source.Name, mode)
+	s.mb.RecordChronySourceLastSampleAgeDataPoint(now, int64(source.SinceSample), source.Name, mode)
+}
+
+// leapStatuses, sourceModes and sourceStates are indexed by the values of
+// the protocol.
+var leapStatuses = []string{
+	metadata.AttributeLeapStatus.Normal,
+	metadata.AttributeLeapStatus.InsertSecond,
+	metadata.AttributeLeapStatus.DeleteSecond,
+	metadata.AttributeLeapStatus.Unsynchronised,
+}
+
+var sourceModes = []string{
+	metadata.AttributeMode.Server,
+	metadata.AttributeMode.Peer,
+	metadata.AttributeMode.Refclock,
+}
+
+var sourceStates = []string{
+	metadata.AttributeState.Selected,
+	metadata.AttributeState.Nonselectable,
+	metadata.AttributeState.Falseticker,
+	metadata.AttributeState.Jittery,
+	metadata.AttributeState.Unselected,
+	metadata.AttributeState.Selectable,
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chronyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+)
+
+type fakeClient struct {
+	tracking    *chrony.Tracking
+	sources     []*chrony.Source
+	trackingErr error
+	sourcesErr  error
+}
+
+func (c *fakeClient) Tracking(context.Context) (*chrony.Tracking, error) {
+	return c.tracking, c.trackingErr
+}
+
+func (c *fakeClient) Sources(context.Context) ([]*chrony.Source, error) {
+	return c.sources, c.sourcesErr
+}
+
+func testClient() *fakeClient {
+	return &fakeClient{
+		tracking: &chrony.Tracking{
+			Stratum:           3,
+			LeapStatus:        chrony.LeapStatusNormal,
+			CurrentCorrection: 0.25,
+			LastOffset:        -0.5,
+			RMSOffset:         0.125,
+			FreqPPM:           -7.5,
+			SkewPPM:           0.0625,
+			RootDelay:         0.03125,
+			RootDispersion:    0.015625,
+		},
+		sources: []*chrony.Source{
+			{
+				Name:          "192.168.0.1",
+				Poll:          6,
+				Stratum:       2,
+				State:         chrony.SourceStateSelected,
+				Mode:          chrony.SourceModeServer,
+				Reachability:  0xfb,
+				SinceSample:   35,
+				LatestMeas:    0.5,
+				LatestMeasErr: 0.25,
+			},
+			{
+				Name:         "GPS",
+				Poll:         -1,
+				State:        chrony.SourceStateFalseticker,
+				Mode:         chrony.SourceModeRefclock,
+				Reachability: 0x0f,
+				SinceSample:  1,
+			},
+		},
+	}
+}
+
+func TestScraperStart(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), &Config{Endpoint: "tcp://localhost:323"})
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	scraper = newScraper(zap.NewNop(), createDefaultConfig().(*Config))
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	require.NotNil(t, scraper.client)
+}
+
+func TestScraperScrapeErrors(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config))
+
+	_, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+
+	client := testClient()
+	client.tracking = nil
+	client.trackingErr = errors.New("request failed with status 2")
+	scraper.client = client
+	metrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "request failed with status 2")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, trackingMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, 7, metrics.MetricCount())
+
+	client = testClient()
+	client.sources = client.sources[:1]
+	client.sourcesErr = errors.New("i/o timeout")
+	scraper.client = client
+	metrics, err = scraper.scrape(context.Background())
+	require.EqualError(t, err, "i/o timeout")
+	require.Equal(t, sourceMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, trackingMetricsLen+sourceMetricsLen, metrics.MetricCount())
+}
+
+func TestScraperScrape(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config))
+	scraper.client = testClient()
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	rms := metrics.ResourceMetrics()
+	require.Equal(t, 1, rms.Len())
+	ilms := rms.At(0).InstrumentationLibraryMetrics()
+	require.Equal(t, 1, ilms.Len())
+	require.Equal(t, instrumentationLibraryName, ilms.At(0).InstrumentationLibrary().Name())
+
+	require.Equal(t, map[string]map[string]float64{
+		"chrony.stratum":             {"": 3},
+		"chrony.leap.status":         {"normal": 1, "insert_second": 0, "delete_second": 0, "unsynchronised": 0},
+		"chrony.time.offset":         {"": -0.5},
+		"chrony.time.correction":     {"": 0.25},
+		"chrony.time.rms_offset":     {"": 0.125},
+		"chrony.frequency.offset":    {"": -7.5},
+		"chrony.frequency.skew":      {"": 0.0625},
+		"chrony.root.delay":          {"": 0.03125},
+		"chrony.root.dispersion":     {"": 0.015625},
+		"chrony.source.stratum":      {"192.168.0.1/server": 2, "GPS/refclock": 0},
+		"chrony.source.reachability": {"192.168.0.1/server": 7, "GPS/refclock": 4},
+		"chrony.source.state": {
+			"192.168.0.1/server/selected":      1,
+			"192.168.0.1/server/nonselectable": 0,
+			"192.168.0.1/server/falseticker":   0,
+			"192.168.0.1/server/jittery":       0,
+			"192.168.0.1/server/unselected":    0,
+			"192.168.0.1/server/selectable":    0,
+			"GPS/refclock/selected":            0,
+			"GPS/refclock/nonselectable":       0,
+			"GPS/refclock/falseticker":         1,
+			"GPS/refclock/jittery":             0,
+			"GPS/refclock/unselected":          0,
+			"GPS/refclock/selectable":          0,
+		},
+		"chrony.source.offset":          {"192.168.0.1/server": 0.5, "GPS/refclock": 0},
+		"chrony.source.offset.error":    {"192.168.0.1/server": 0.25, "GPS/refclock": 0},
+		"chrony.source.poll_interval":   {"192.168.0.1/server": 64, "GPS/refclock": 0.5},
+		"chrony.source.last_sample.age": {"192.168.0.1/server": 35, "GPS/refclock": 1},
+	}, dataPointValues(t, ilms.At(0).Metrics()))
+}
+
+// dataPointValues returns the values of the data points of the gauges keyed
+// by the metric name and the values of their attributes joined with slashes.
+func dataPointValues(t *testing.T, ms pdata.MetricSlice) map[string]map[string]float64 {
+	values := make(map[string]map[string]float64)
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		require.Equal(t, pdata.MetricDataTypeGauge, m.DataType(), m.Name())
+		values[m.Name()] = make(map[string]float64)
+		dps := m.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			var attributes []string
+			dp.Attributes().Range(func(_ string, v pdata.AttributeValue) bool {
+				attributes = append(attributes, v.StringVal())
+				return true
+			})
+			value := dp.DoubleVal()
+			if dp.ValueType() == pdata.MetricValueTypeInt {
+				value = float64(dp.IntVal())
+			}
+			values[m.Name()][strings.Join(attributes, "/")] = value
+		}
+	}
+	return values
+}
@@ -53,6 +53,26 @@ func convertEnvelopeToMetrics(envelope *loggregator_v2.Envelope, metricSlice pda
 	}
 }
 
+func convertEnvelopeToLogs(envelope *loggregator_v2.Envelope, logSlice pdata.LogRecordSlice) {
+	message, ok := envelope.Message.(*loggregator_v2.Envelope_Log)
+	if !ok {
+		return
+	}
+
+	logRecord := logSlice.AppendEmpty()
+	logRecord.SetTimestamp(pdata.Timestamp(envelope.GetTimestamp()))
+	logRecord.Body().SetStringVal(string(message.Log.GetPayload()))
+	switch message.Log.GetType() {
+	case loggregator_v2.Log_OUT:
+		logRecord.SetSeverityNumber(pdata.SeverityNumberINFO)
+		logRecord.SetSeverityText("INFO")
+	case loggregator_v2.Log_ERR:
+		logRecord.SetSeverityNumber(pdata.SeverityNumberERROR)
+		logRecord.SetSeverityText("ERROR")
+	}
+	copyEnvelopeAttributes(logRecord.Attributes(), envelope)
+}
+
 func copyEnvelopeAttributes(attributes pdata.AttributeMap, envelope *loggregator_v2.Envelope) {
 	for key, value := range envelope.Tags {
 		attributes.InsertString(attributeNamePrefix+key, value)
@@ -152,6 +152,66 @@ func TestConvertGaugeEnvelope(t *testing.T) {
 	assertAttributes(t, dataPoint.Attributes(), expectedAttributes)
 }
 
+func TestConvertLogEnvelope(t *testing.T) {
+	now := time.Now()
+
+	envelope := loggregator_v2.Envelope{
+		Timestamp:  now.UnixNano(),
+		SourceId:   "df4bd3ce-f2b9-4cc6-8a0d-3d3c8ae8a39f",
+		InstanceId: "0",
+		Tags: map[string]string{
+			"origin":            "rep",
+			"source_type":       "APP/PROC/WEB",
+			"app_id":            "df4bd3ce-f2b9-4cc6-8a0d-3d3c8ae8a39f",
+			"app_name":          "shop",
+			"space_name":        "production",
+			"organization_name": "acme",
+		},
+		Message: &loggregator_v2.Envelope_Log{
+			Log: &loggregator_v2.Log{
+				Payload: []byte("GET /cart 200"),
+				Type:    loggregator_v2.Log_ERR,
+			},
+		},
+	}
+
+	logSlice := pdata.NewLogRecordSlice()
+
+	convertEnvelopeToLogs(&envelope, logSlice)
+
+	require.Equal(t, 1, logSlice.Len())
+
+	logRecord := logSlice.At(0)
+	assert.Equal(t, pdata.NewTimestampFromTime(now), logRecord.Timestamp())
+	assert.Equal(t, "GET /cart 200", logRecord.Body().StringVal())
+	assert.Equal(t, pdata.SeverityNumberERROR, logRecord.SeverityNumber())
+	assert.Equal(t, "ERROR", logRecord.SeverityText())
+	assertAttributes(t, logRecord.Attributes(), map[string]string{
+		"org.cloudfoundry.source_id":         "df4bd3ce-f2b9-4cc6-8a0d-3d3c8ae8a39f",
+		"org.cloudfoundry.instance_id":       "0",
+		"org.cloudfoundry.origin":            "rep",
+		"org.cloudfoundry.source_type":       "APP/PROC/WEB",
+		"org.cloudfoundry.app_id":            "df4bd3ce-f2b9-4cc6-8a0d-3d3c8ae8a39f",
+		"org.cloudfoundry.app_name":          "shop",
+		"org.cloudfoundry.space_name":        "production",
+		"org.cloudfoundry.organization_name": "acme",
+	})
+}
+
+func TestConvertNonLogEnvelopeToLogs(t *testing.T) {
+	envelope := loggregator_v2.Envelope{
+		Message: &loggregator_v2.Envelope_Counter{
+			Counter: &loggregator_v2.Counter{Name: "bad_gateways", Total: 10},
+		},
+	}
+
+	logSlice := pdata.NewLogRecordSlice()
+
+	convertEnvelopeToLogs(&envelope, logSlice)
+
+	assert.Equal(t, 0, logSlice.Len())
+}
+
 func assertAttributes(t *testing.T, attributes pdata.AttributeMap, expected map[string]string) {
 	assert.Equal(t, len(expected), attributes.Len())
 
@@ -13,7 +13,7 @@
 // limitations under the License.
 
 // Package cloudfoundryreceiver implements a receiver that can be used by the
-// Opentelemetry collector to receive Cloud Foundry metrics via its Reverse
+// Opentelemetry collector to receive Cloud Foundry metrics and logs via its Reverse
 // Log Proxy (RLP) Gateway component. The protocol is handled by the
 // go-loggregator library, which uses HTTP to connect to the gateway and receive
 // JSON-protobuf encoded v2 Envelope messages as documented by loggregator-api.
@@ -39,7 +39,8 @@ func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiverhelper.WithMetrics(createMetricsReceiver))
+		receiverhelper.WithMetrics(createMetricsReceiver),
+		receiverhelper.WithLogs(createLogsReceiver))
 }
 
 func createDefaultConfig() config.Receiver {
@@ -75,3 +76,13 @@ func createMetricsReceiver(
 	c := cfg.(*Config)
 	return newCloudFoundryReceiver(params, *c, nextConsumer)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	cfg config.Receiver,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	c := cfg.(*Config)
+	return newCloudFoundryLogsReceiver(params, *c, nextConsumer)
+}
@@ -36,13 +36,15 @@ const (
 )
 
 var _ component.MetricsReceiver = (*cloudFoundryReceiver)(nil)
+var _ component.LogsReceiver = (*cloudFoundryReceiver)(nil)
 
-// newCloudFoundryReceiver implements the component.MetricsReceiver for Cloud Foundry protocol.
+// newCloudFoundryReceiver implements the component.MetricsReceiver and component.LogsReceiver for Cloud Foundry protocol.
 type cloudFoundryReceiver struct {
 	settings          component.TelemetrySettings
 	cancel            context.CancelFunc
 	config            Config
-	nextConsumer      consumer.Metrics
+	nextMetrics       consumer.Metrics
+	nextLogs          consumer.Logs
 	obsrecv           *obsreport.Receiver
 	goroutines        sync.WaitGroup
 	receiverStartTime time.Time
@@ -58,17 +60,37 @@ func newCloudFoundryReceiver(
 		return nil, componenterror.ErrNilNextConsumer
 	}
 
+	receiver := newReceiver(settings, config)
+	receiver.nextMetrics = nextConsumer
+	return receiver, nil
+}
+
+// newCloudFoundryLogsReceiver creates the Cloud Foundry logs receiver with the given parameters.
+func newCloudFoundryLogsReceiver(
+	settings component.ReceiverCreateSettings,
+	config Config,
+	nextConsumer consumer.Logs) (component.LogsReceiver, error) {
+
+	if nextConsumer == nil {
+		return nil, componenterror.ErrNilNextConsumer
+	}
+
+	receiver := newReceiver(settings, config)
+	receiver.nextLogs = nextConsumer
+	return receiver, nil
+}
+
+func newReceiver(settings component.ReceiverCreateSettings, config Config) *cloudFoundryReceiver {
 	return &cloudFoundryReceiver{
-		settings:     settings.TelemetrySettings,
-		config:       config,
-		nextConsumer: nextConsumer,
+		settings: settings.TelemetrySettings,
+		config:   config,
 		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
 			ReceiverID:             config.ID(),
 			Transport:              transport,
 			ReceiverCreateSettings: settings,
 		}),
 		receiverStartTime: time.Now(),
-	}, nil
+	}
 }
 
 func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host) error {
@@ -102,6 +124,18 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 			return
 		}
 
+		if cfr.nextLogs != nil {
+			envelopeStream, err := streamFactory.CreateLogsStream(innerCtx, cfr.config.RLPGateway.ShardID)
+			if err != nil {
+				host.ReportFatalError(fmt.Errorf("creating RLP gateway log envelope stream: %v", err))
+				return
+			}
+
+			cfr.streamLogs(innerCtx, envelopeStream, host)
+			cfr.settings.Logger.Debug("cloudfoundry logs streamer stopped")
+			return
+		}
+
 		envelopeStream, err := streamFactory.CreateStream(innerCtx, cfr.config.RLPGateway.ShardID)
 		if err != nil {
 			host.ReportFatalError(fmt.Errorf("creating RLP gateway envelope stream: %v", err))
@@ -151,12 +185,46 @@ func (cfr *cloudFoundryReceiver) streamMetrics(
 
 		if libraryMetrics.Len() > 0 {
 			obsCtx := cfr.obsrecv.StartMetricsOp(ctx)
-			err := cfr.nextConsumer.ConsumeMetrics(ctx, metrics)
+			err := cfr.nextMetrics.ConsumeMetrics(ctx, metrics)
 			cfr.obsrecv.EndMetricsOp(obsCtx, dataFormat, metrics.DataPointCount(), err)
 		}
 	}
 }
 
+func (cfr *cloudFoundryReceiver) streamLogs(
+	ctx context.Context,
+	stream loggregator.EnvelopeStream,
+	host component.Host) {
+
+	for {
+		// Blocks until non-empty result or context is cancelled (returns nil in that case)
+		envelopes := stream()
+		if envelopes == nil {
+			// If context has not been cancelled, then nil means the shutdown was due to an error within stream
+			if ctx.Err() == nil {
+				host.ReportFatalError(errors.New("RLP gateway log streamer shut down due to an error"))
+			}
+
+			break
+		}
+
+		logs := pdata.NewLogs()
+		libraryLogs := createLibraryLogsSlice(logs)
+
+		for _, envelope := range envelopes {
+			if envelope != nil {
+				convertEnvelopeToLogs(envelope, libraryLogs)
+			}
+		}
+
+		if libraryLogs.Len() > 0 {
+			obsCtx := cfr.obsrecv.StartLogsOp(ctx)
+			err := cfr.nextLogs.ConsumeLogs(ctx, logs)
+			cfr.obsrecv.EndLogsOp(obsCtx, dataFormat, logs.LogRecordCount(), err)
+		}
+	}
+}
+
 func createLibraryMetricsSlice(metrics pdata.Metrics) pdata.MetricSlice {
 	resourceMetrics := metrics.ResourceMetrics()
 	resourceMetric := resourceMetrics.AppendEmpty()
@@ -166,3 +234,10 @@ func createLibraryMetricsSlice(metrics pdata.Metrics) pdata.MetricSlice {
 	libraryMetrics.InstrumentationLibrary().SetName(instrumentationLibName)
 	return libraryMetrics.Metrics()
 }
+
+func createLibraryLogsSlice(logs pdata.Logs) pdata.LogRecordSlice {
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	libraryLogs := resourceLogs.InstrumentationLibraryLogs().AppendEmpty()
+	libraryLogs.InstrumentationLibrary().SetName(instrumentationLibName)
+	return libraryLogs.LogRecords()
+}
@@ -48,6 +48,31 @@ func TestDefaultValidReceiver(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// Test to make sure a new logs receiver can be created properly, started and shutdown with the default config
+func TestDefaultValidLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	params := componenttest.NewNopReceiverCreateSettings()
+
+	receiver, err := newCloudFoundryLogsReceiver(
+		params,
+		*cfg,
+		consumertest.NewNop(),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, receiver, "receiver creation failed")
+
+	// Test start
+	ctx := context.Background()
+	err = receiver.Start(ctx, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	// Test shutdown
+	err = receiver.Shutdown(ctx)
+	require.NoError(t, err)
+}
+
 // Test to make sure start fails with invalid consumer
 func TestInvalidConsumer(t *testing.T) {
 	factory := NewFactory()
@@ -63,3 +88,19 @@ func TestInvalidConsumer(t *testing.T) {
 	require.EqualError(t, err, "nil nextConsumer")
 	require.Nil(t, receiver, "receiver creation failed")
 }
+
+// Test to make sure logs receiver creation fails with invalid consumer
+func TestInvalidLogsConsumer(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	params := componenttest.NewNopReceiverCreateSettings()
+
+	receiver, err := newCloudFoundryLogsReceiver(
+		params,
+		*cfg,
+		nil,
+	)
+
+	require.EqualError(t, err, "nil nextConsumer")
+	require.Nil(t, receiver, "receiver creation failed")
+}
@@ -28,6 +28,8 @@ import (
 	"go.uber.org/zap"
 )
 
+const logsShardIDSuffix = "-logs"
+
 type EnvelopeStreamFactory struct {
 	rlpGatewayClient *loggregator.RLPGatewayClient
 }
@@ -59,25 +61,52 @@ func (rgc *EnvelopeStreamFactory) CreateStream(
 	ctx context.Context,
 	shardID string) (loggregator.EnvelopeStream, error) {
 
+	return rgc.createStream(ctx, shardID, []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Counter{
+				Counter: &loggregator_v2.CounterSelector{},
+			},
+		},
+		{
+			Message: &loggregator_v2.Selector_Gauge{
+				Gauge: &loggregator_v2.GaugeSelector{},
+			},
+		},
+	})
+}
+
+// CreateLogsStream creates a stream of log envelopes. Logs are load balanced among the streams using
+// the same shard ID, which is suffixed so metrics and logs streams are not balanced against each other.
+func (rgc *EnvelopeStreamFactory) CreateLogsStream(
+	ctx context.Context,
+	shardID string) (loggregator.EnvelopeStream, error) {
+
 	if strings.TrimSpace(shardID) == "" {
 		return nil, errors.New("shardID cannot be empty")
 	}
 
-	stream := rgc.rlpGatewayClient.Stream(ctx, &loggregator_v2.EgressBatchRequest{
-		ShardId: shardID,
-		Selectors: []*loggregator_v2.Selector{
-			{
-				Message: &loggregator_v2.Selector_Counter{
-					Counter: &loggregator_v2.CounterSelector{},
-				},
-			},
-			{
-				Message: &loggregator_v2.Selector_Gauge{
-					Gauge: &loggregator_v2.GaugeSelector{},
-				},
+	return rgc.createStream(ctx, shardID+logsShardIDSuffix, []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Log{
+				Log: &loggregator_v2.LogSelector{},
 			},
 		},
 	})
+}
+
+func (rgc *EnvelopeStreamFactory) createStream(
+	ctx context.Context,
+	shardID string,
+	selectors []*loggregator_v2.Selector) (loggregator.EnvelopeStream, error) {
+
+	if strings.TrimSpace(shardID) == "" {
+		return nil, errors.New("shardID cannot be empty")
+	}
+
+	stream := rgc.rlpGatewayClient.Stream(ctx, &loggregator_v2.EgressBatchRequest{
+		ShardId:   shardID,
+		Selectors: selectors,
+	})
 
 	return stream, nil
 }
@@ -58,6 +58,49 @@ func TestValidStream(t *testing.T) {
 	cancel()
 }
 
+// Ensure logs stream create works as expected
+func TestValidLogsStream(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+
+	uaa, err := newUAATokenProvider(
+		zap.NewNop(),
+		cfg.UAA.LimitedHTTPClientSettings,
+		cfg.UAA.Username,
+		cfg.UAA.Password)
+
+	require.NoError(t, err)
+	require.NotNil(t, uaa)
+
+	streamFactory, streamErr := newEnvelopeStreamFactory(
+		componenttest.NewNopTelemetrySettings(),
+		uaa,
+		cfg.RLPGateway.HTTPClientSettings,
+		componenttest.NewNopHost())
+
+	require.NoError(t, streamErr)
+	require.NotNil(t, streamFactory)
+
+	innerCtx, cancel := context.WithCancel(context.Background())
+
+	envelopeStream, createErr := streamFactory.CreateLogsStream(
+		innerCtx,
+		cfg.RLPGateway.ShardID)
+
+	require.NoError(t, createErr)
+	require.NotNil(t, envelopeStream)
+
+	// Stream create should fail if given empty shard ID
+	envelopeStream, createErr = streamFactory.CreateLogsStream(
+		innerCtx,
+		"")
+
+	require.EqualError(t, createErr, "shardID cannot be empty")
+	require.Nil(t, envelopeStream)
+
+	cancel()
+}
+
 // Ensure stream create fails when it should
 func TestInvalidStream(t *testing.T) {
 
@@ -25,8 +25,13 @@ import (
 	"go.uber.org/zap"
 )
 
-// clusterPath is the path to cluster endpoint
-const clusterPath = "/pools/default"
+const (
+	// clusterPath is the path to cluster endpoint
+	clusterPath = "/pools/default"
+
+	// tasksPath is the path to the cluster tasks endpoint
+	tasksPath = "/pools/default/tasks"
+)
 
 type client interface {
 	// GetClusterDetails calls "/pools/default" endpoint to get the cluster details
@@ -39,6 +44,10 @@ type client interface {
 	// GetBucketStats retrieves the stats for the bucket at the given path.
 	// The path should be extraced from bucket.StatsInfo.URI
 	GetBucketStats(ctx context.Context, path string) (*bucketStats, error)
+
+	// GetTasks calls "/pools/default/tasks" endpoint to get the tasks running in the cluster,
+	// including the XDCR replications
+	GetTasks(ctx context.Context) ([]*task, error)
 }
 
 var _ client = (*couchbaseClient)(nil)
@@ -103,6 +112,16 @@ func (c *couchbaseClient) GetBucketStats(ctx context.Context, path string) (*buc
 	return &stats, nil
 }
 
+func (c *couchbaseClient) GetTasks(ctx context.Context) ([]*task, error) {
+	tasks := make([]*task, 0)
+
+	if err := c.get(ctx, tasksPath, &tasks); err != nil {
+		c.logger.Debug("Failed to retrieve tasks", zap.Error(err))
+		return nil, err
+	}
+	return tasks, nil
+}
+
 func (c *couchbaseClient) get(ctx context.Context, path string, respObj interface{}) error {
 	// Construct endpoint and create request
 	url := c.hostEndpoint + path
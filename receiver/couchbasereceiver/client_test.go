@@ -35,6 +35,7 @@ const (
 	clusterAPIResponseFile       = "get_clusters_response.json"
 	clusterBucketAPIResponseFile = "get_cluster_bucket_info_response.json"
 	bucketStatsAPIResponseFile   = "get_bucket_stats.json"
+	tasksAPIResponseFile         = "get_tasks_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -298,6 +299,60 @@ func TestGetBucketStats(t *testing.T) {
 	}
 }
 
+func TestGetTasks(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				tasks, err := tc.GetTasks(context.Background())
+				require.Nil(t, tasks)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, tasksAPIResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, tasksPath, r.URL.Path)
+					w.Write(data)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected []*task
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				tasks, err := tc.GetTasks(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, tasks)
+				require.Equal(t, xdcrTaskType, tasks[1].Type)
+				require.EqualValues(t, 42, *tasks[1].ChangesLeft)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
@@ -22,6 +22,8 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver/internal/metadata"
 )
 
 // Predefined error responses for configuration validation failures
@@ -38,8 +40,10 @@ const defaultEndpoint = "http://localhost:8901"
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
-	Username                                string `mapstructure:"username"`
-	Password                                string `mapstructure:"password"`
+	Username                                string                   `mapstructure:"username"`
+	Password                                string                   `mapstructure:"password"`
+	Buckets                                 []string                 `mapstructure:"buckets"`
+	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
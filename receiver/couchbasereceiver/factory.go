@@ -16,6 +16,7 @@ package couchbasereceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -24,10 +25,14 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver/internal/metadata"
 )
 
 const typeStr = "couchbase"
 
+var errConfigNotCouchbase = errors.New("config was not a Couchbase receiver config")
+
 // NewFactory creates a new receiver factory
 func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
@@ -46,9 +51,21 @@ func createDefaultConfig() config.Receiver {
 			Endpoint: defaultEndpoint,
 			Timeout:  10 * time.Second,
 		},
+		Metrics: metadata.DefaultMetricsSettings(),
 	}
 }
 
 func createMetricsReceiver(ctx context.Context, params component.ReceiverCreateSettings, rConf config.Receiver, consumer consumer.Metrics) (component.MetricsReceiver, error) {
-	return nil, nil
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotCouchbase
+	}
+
+	couchbaseScraper := newCouchbaseScraper(params.Logger, cfg, params.TelemetrySettings)
+	scraper, err := scraperhelper.NewScraper(typeStr, couchbaseScraper.scrape, scraperhelper.WithStart(couchbaseScraper.start))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(&cfg.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
 }
@@ -15,16 +15,681 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for couchbasereceiver metrics.
 type MetricsSettings struct {
+	CouchbaseBucketCacheMissRate          MetricSettings `mapstructure:"couchbase.bucket.cache.miss_rate"`
+	CouchbaseBucketDataUsage              MetricSettings `mapstructure:"couchbase.bucket.data.usage"`
+	CouchbaseBucketDiskUsage              MetricSettings `mapstructure:"couchbase.bucket.disk.usage"`
+	CouchbaseBucketDocumentFragmentation  MetricSettings `mapstructure:"couchbase.bucket.document.fragmentation"`
+	CouchbaseBucketItemCount              MetricSettings `mapstructure:"couchbase.bucket.item.count"`
+	CouchbaseBucketMemoryQuotaUtilization MetricSettings `mapstructure:"couchbase.bucket.memory.quota.utilization"`
+	CouchbaseBucketMemoryUsage            MetricSettings `mapstructure:"couchbase.bucket.memory.usage"`
+	CouchbaseBucketOperationRate          MetricSettings `mapstructure:"couchbase.bucket.operation.rate"`
+	CouchbaseBucketXdcrChangesLeft        MetricSettings `mapstructure:"couchbase.bucket.xdcr.changes_left"`
+	CouchbaseNodeCPUUtilization           MetricSettings `mapstructure:"couchbase.node.cpu.utilization"`
+	CouchbaseNodeHealth                   MetricSettings `mapstructure:"couchbase.node.health"`
+	CouchbaseNodeMemoryUsage              MetricSettings `mapstructure:"couchbase.node.memory.usage"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
-	return MetricsSettings{}
+	return MetricsSettings{
+		CouchbaseBucketCacheMissRate: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketDataUsage: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketDiskUsage: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketDocumentFragmentation: MetricSettings{
+			Enabled: false,
+		},
+		CouchbaseBucketItemCount: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketMemoryQuotaUtilization: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketOperationRate: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseBucketXdcrChangesLeft: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseNodeCPUUtilization: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseNodeHealth: MetricSettings{
+			Enabled: true,
+		},
+		CouchbaseNodeMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricCouchbaseBucketCacheMissRate struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.cache.miss_rate metric with initial data.
+func (m *metricCouchbaseBucketCacheMissRate) init() {
+	m.data.SetName("couchbase.bucket.cache.miss_rate")
+	m.data.SetDescription("The percentage of reads from the bucket that were not served from memory.")
+	m.data.SetUnit("%")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricCouchbaseBucketCacheMissRate) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketCacheMissRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketCacheMissRate) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketCacheMissRate(settings MetricSettings) metricCouchbaseBucketCacheMissRate {
+	m := metricCouchbaseBucketCacheMissRate{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketDataUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.data.usage metric with initial data.
+func (m *metricCouchbaseBucketDataUsage) init() {
+	m.data.SetName("couchbase.bucket.data.usage")
+	m.data.SetDescription("The amount of data stored in the bucket, excluding the disk overhead.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricCouchbaseBucketDataUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketDataUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketDataUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketDataUsage(settings MetricSettings) metricCouchbaseBucketDataUsage {
+	m := metricCouchbaseBucketDataUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketDiskUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.disk.usage metric with initial data.
+func (m *metricCouchbaseBucketDiskUsage) init() {
+	m.data.SetName("couchbase.bucket.disk.usage")
+	m.data.SetDescription("The amount of disk space used by the bucket.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricCouchbaseBucketDiskUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketDiskUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketDiskUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketDiskUsage(settings MetricSettings) metricCouchbaseBucketDiskUsage {
+	m := metricCouchbaseBucketDiskUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketDocumentFragmentation struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.document.fragmentation metric with initial data.
+func (m *metricCouchbaseBucketDocumentFragmentation) init() {
+	m.data.SetName("couchbase.bucket.document.fragmentation")
+	m.data.SetDescription("The percentage of fragmentation of the bucket data files.")
+	m.data.SetUnit("%")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricCouchbaseBucketDocumentFragmentation) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketDocumentFragmentation) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketDocumentFragmentation) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketDocumentFragmentation(settings MetricSettings) metricCouchbaseBucketDocumentFragmentation {
+	m := metricCouchbaseBucketDocumentFragmentation{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketItemCount struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.item.count metric with initial data.
+func (m *metricCouchbaseBucketItemCount) init() {
+	m.data.SetName("couchbase.bucket.item.count")
+	m.data.SetDescription("The number of items stored in the bucket.")
+	m.data.SetUnit("{items}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricCouchbaseBucketItemCount) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketItemCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketItemCount) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketItemCount(settings MetricSettings) metricCouchbaseBucketItemCount {
+	m := metricCouchbaseBucketItemCount{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketMemoryQuotaUtilization struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.memory.quota.utilization metric with initial data.
+func (m *metricCouchbaseBucketMemoryQuotaUtilization) init() {
+	m.data.SetName("couchbase.bucket.memory.quota.utilization")
+	m.data.SetDescription("The percentage of the bucket memory quota in use.")
+	m.data.SetUnit("%")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricCouchbaseBucketMemoryQuotaUtilization) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketMemoryQuotaUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketMemoryQuotaUtilization) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketMemoryQuotaUtilization(settings MetricSettings) metricCouchbaseBucketMemoryQuotaUtilization {
+	m := metricCouchbaseBucketMemoryQuotaUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketMemoryUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.memory.usage metric with initial data.
+func (m *metricCouchbaseBucketMemoryUsage) init() {
+	m.data.SetName("couchbase.bucket.memory.usage")
+	m.data.SetDescription("The amount of memory used by the bucket.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricCouchbaseBucketMemoryUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketMemoryUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketMemoryUsage(settings MetricSettings) metricCouchbaseBucketMemoryUsage {
+	m := metricCouchbaseBucketMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketOperationRate struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.operation.rate metric with initial data.
+func (m *metricCouchbaseBucketOperationRate) init() {
+	m.data.SetName("couchbase.bucket.operation.rate")
+	m.data.SetDescription("The number of operations performed on the bucket per second.")
+	m.data.SetUnit("{operations}/s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricCouchbaseBucketOperationRate) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketOperationRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketOperationRate) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketOperationRate(settings MetricSettings) metricCouchbaseBucketOperationRate {
+	m := metricCouchbaseBucketOperationRate{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseBucketXdcrChangesLeft struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.bucket.xdcr.changes_left metric with initial data.
+func (m *metricCouchbaseBucketXdcrChangesLeft) init() {
+	m.data.SetName("couchbase.bucket.xdcr.changes_left")
+	m.data.SetDescription("The number of mutations of the bucket not replicated yet to the target of an XDCR replication.")
+	m.data.SetUnit("{mutations}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchbaseBucketXdcrChangesLeft) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, replicationTargetAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ReplicationTarget, pdata.NewAttributeValueString(replicationTargetAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseBucketXdcrChangesLeft) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseBucketXdcrChangesLeft) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseBucketXdcrChangesLeft(settings MetricSettings) metricCouchbaseBucketXdcrChangesLeft {
+	m := metricCouchbaseBucketXdcrChangesLeft{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseNodeCPUUtilization struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.node.cpu.utilization metric with initial data.
+func (m *metricCouchbaseNodeCPUUtilization) init() {
+	m.data.SetName("couchbase.node.cpu.utilization")
+	m.data.SetDescription("The CPU utilization of the node.")
+	m.data.SetUnit("%")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricCouchbaseNodeCPUUtilization) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseNodeCPUUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseNodeCPUUtilization) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseNodeCPUUtilization(settings MetricSettings) metricCouchbaseNodeCPUUtilization {
+	m := metricCouchbaseNodeCPUUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseNodeHealth struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.node.health metric with initial data.
+func (m *metricCouchbaseNodeHealth) init() {
+	m.data.SetName("couchbase.node.health")
+	m.data.SetDescription("The health status of the node, 1 for the current status and 0 for the others.")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchbaseNodeHealth) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, nodeStatusAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.NodeStatus, pdata.NewAttributeValueString(nodeStatusAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseNodeHealth) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseNodeHealth) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseNodeHealth(settings MetricSettings) metricCouchbaseNodeHealth {
+	m := metricCouchbaseNodeHealth{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCouchbaseNodeMemoryUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchbase.node.memory.usage metric with initial data.
+func (m *metricCouchbaseNodeMemoryUsage) init() {
+	m.data.SetName("couchbase.node.memory.usage")
+	m.data.SetDescription("The amount of memory used on the node.")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricCouchbaseNodeMemoryUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchbaseNodeMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchbaseNodeMemoryUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchbaseNodeMemoryUsage(settings MetricSettings) metricCouchbaseNodeMemoryUsage {
+	m := metricCouchbaseNodeMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
 }
 
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime pdata.Timestamp
+	startTime                                   pdata.Timestamp
+	metricCouchbaseBucketCacheMissRate          metricCouchbaseBucketCacheMissRate
+	metricCouchbaseBucketDataUsage              metricCouchbaseBucketDataUsage
+	metricCouchbaseBucketDiskUsage              metricCouchbaseBucketDiskUsage
+	metricCouchbaseBucketDocumentFragmentation  metricCouchbaseBucketDocumentFragmentation
+	metricCouchbaseBucketItemCount              metricCouchbaseBucketItemCount
+	metricCouchbaseBucketMemoryQuotaUtilization metricCouchbaseBucketMemoryQuotaUtilization
+	metricCouchbaseBucketMemoryUsage            metricCouchbaseBucketMemoryUsage
+	metricCouchbaseBucketOperationRate          metricCouchbaseBucketOperationRate
+	metricCouchbaseBucketXdcrChangesLeft        metricCouchbaseBucketXdcrChangesLeft
+	metricCouchbaseNodeCPUUtilization           metricCouchbaseNodeCPUUtilization
+	metricCouchbaseNodeHealth                   metricCouchbaseNodeHealth
+	metricCouchbaseNodeMemoryUsage              metricCouchbaseNodeMemoryUsage
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -39,7 +704,19 @@ func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime: pdata.NewTimestampFromTime(time.Now()),
+		startTime:                                   pdata.NewTimestampFromTime(time.Now()),
+		metricCouchbaseBucketCacheMissRate:          newMetricCouchbaseBucketCacheMissRate(settings.CouchbaseBucketCacheMissRate),
+		metricCouchbaseBucketDataUsage:              newMetricCouchbaseBucketDataUsage(settings.CouchbaseBucketDataUsage),
+		metricCouchbaseBucketDiskUsage:              newMetricCouchbaseBucketDiskUsage(settings.CouchbaseBucketDiskUsage),
+		metricCouchbaseBucketDocumentFragmentation:  newMetricCouchbaseBucketDocumentFragmentation(settings.CouchbaseBucketDocumentFragmentation),
+		metricCouchbaseBucketItemCount:              newMetricCouchbaseBucketItemCount(settings.CouchbaseBucketItemCount),
+		metricCouchbaseBucketMemoryQuotaUtilization: newMetricCouchbaseBucketMemoryQuotaUtilization(settings.CouchbaseBucketMemoryQuotaUtilization),
+		metricCouchbaseBucketMemoryUsage:            newMetricCouchbaseBucketMemoryUsage(settings.CouchbaseBucketMemoryUsage),
+		metricCouchbaseBucketOperationRate:          newMetricCouchbaseBucketOperationRate(settings.CouchbaseBucketOperationRate),
+		metricCouchbaseBucketXdcrChangesLeft:        newMetricCouchbaseBucketXdcrChangesLeft(settings.CouchbaseBucketXdcrChangesLeft),
+		metricCouchbaseNodeCPUUtilization:           newMetricCouchbaseNodeCPUUtilization(settings.CouchbaseNodeCPUUtilization),
+		metricCouchbaseNodeHealth:                   newMetricCouchbaseNodeHealth(settings.CouchbaseNodeHealth),
+		metricCouchbaseNodeMemoryUsage:              newMetricCouchbaseNodeMemoryUsage(settings.CouchbaseNodeMemoryUsage),
 	}
 	for _, op := range options {
 		op(mb)
@@ -51,6 +728,78 @@ func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption)
 // another set of data points. This function will be doing all transformations required to produce metric representation
 // defined in metadata and user settings, e.g. delta/cumulative translation.
 func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricCouchbaseBucketCacheMissRate.emit(metrics)
+	mb.metricCouchbaseBucketDataUsage.emit(metrics)
+	mb.metricCouchbaseBucketDiskUsage.emit(metrics)
+	mb.metricCouchbaseBucketDocumentFragmentation.emit(metrics)
+	mb.metricCouchbaseBucketItemCount.emit(metrics)
+	mb.metricCouchbaseBucketMemoryQuotaUtilization.emit(metrics)
+	mb.metricCouchbaseBucketMemoryUsage.emit(metrics)
+	mb.metricCouchbaseBucketOperationRate.emit(metrics)
+	mb.metricCouchbaseBucketXdcrChangesLeft.emit(metrics)
+	mb.metricCouchbaseNodeCPUUtilization.emit(metrics)
+	mb.metricCouchbaseNodeHealth.emit(metrics)
+	mb.metricCouchbaseNodeMemoryUsage.emit(metrics)
+}
+
+// RecordCouchbaseBucketCacheMissRateDataPoint adds a data point to couchbase.bucket.cache.miss_rate metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketCacheMissRateDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricCouchbaseBucketCacheMissRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketDataUsageDataPoint adds a data point to couchbase.bucket.data.usage metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketDataUsageDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricCouchbaseBucketDataUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketDiskUsageDataPoint adds a data point to couchbase.bucket.disk.usage metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketDiskUsageDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricCouchbaseBucketDiskUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketDocumentFragmentationDataPoint adds a data point to couchbase.bucket.document.fragmentation metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketDocumentFragmentationDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricCouchbaseBucketDocumentFragmentation.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketItemCountDataPoint adds a data point to couchbase.bucket.item.count metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketItemCountDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricCouchbaseBucketItemCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketMemoryQuotaUtilizationDataPoint adds a data point to couchbase.bucket.memory.quota.utilization metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketMemoryQuotaUtilizationDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricCouchbaseBucketMemoryQuotaUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketMemoryUsageDataPoint adds a data point to couchbase.bucket.memory.usage metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketMemoryUsageDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricCouchbaseBucketMemoryUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketOperationRateDataPoint adds a data point to couchbase.bucket.operation.rate metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketOperationRateDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricCouchbaseBucketOperationRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseBucketXdcrChangesLeftDataPoint adds a data point to couchbase.bucket.xdcr.changes_left metric.
+func (mb *MetricsBuilder) RecordCouchbaseBucketXdcrChangesLeftDataPoint(ts pdata.Timestamp, val int64, replicationTargetAttributeValue string) {
+	mb.metricCouchbaseBucketXdcrChangesLeft.recordDataPoint(mb.startTime, ts, val, replicationTargetAttributeValue)
+}
+
+// RecordCouchbaseNodeCPUUtilizationDataPoint adds a data point to couchbase.node.cpu.utilization metric.
+func (mb *MetricsBuilder) RecordCouchbaseNodeCPUUtilizationDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricCouchbaseNodeCPUUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordCouchbaseNodeHealthDataPoint adds a data point to couchbase.node.health metric.
+func (mb *MetricsBuilder) RecordCouchbaseNodeHealthDataPoint(ts pdata.Timestamp, val int64, nodeStatusAttributeValue string) {
+	mb.metricCouchbaseNodeHealth.recordDataPoint(mb.startTime, ts, val, nodeStatusAttributeValue)
+}
+
+// RecordCouchbaseNodeMemoryUsageDataPoint adds a data point to couchbase.node.memory.usage metric.
+func (mb *MetricsBuilder) RecordCouchbaseNodeMemoryUsageDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricCouchbaseNodeMemoryUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
@@ -64,7 +813,31 @@ func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
 
 // Attributes contains the possible metric attributes that can be used.
 var Attributes = struct {
-}{}
+	// CouchbaseBucketName (The name of the Couchbase bucket.)
+	CouchbaseBucketName string
+	// CouchbaseNodeHostname (The hostname of the Couchbase node.)
+	CouchbaseNodeHostname string
+	// NodeStatus (The health status of a node.)
+	NodeStatus string
+	// ReplicationTarget (The remote cluster and bucket targeted by an XDCR replication.)
+	ReplicationTarget string
+}{
+	"couchbase.bucket.name",
+	"couchbase.node.hostname",
+	"status",
+	"target",
+}
 
 // A is an alias for Attributes.
 var A = Attributes
+
+// AttributeNodeStatus are the possible values that the attribute "node.status" can have.
+var AttributeNodeStatus = struct {
+	Healthy   string
+	Unhealthy string
+	Warmup    string
+}{
+	"healthy",
+	"unhealthy",
+	"warmup",
+}
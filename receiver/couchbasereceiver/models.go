@@ -52,8 +52,17 @@ type clusterBuckets struct {
 // Node specific models
 
 type node struct {
-	Hostname         string               `json:"hostname"`
-	InterestingStats nodeInterestingStats `json:"interestingStats"`
+	Hostname          string               `json:"hostname"`
+	Status            string               `json:"status"`
+	ClusterMembership string               `json:"clusterMembership"`
+	MemoryTotal       *int64               `json:"memoryTotal"`
+	MemoryFree        *int64               `json:"memoryFree"`
+	SystemStats       nodeSystemStats      `json:"systemStats"`
+	InterestingStats  nodeInterestingStats `json:"interestingStats"`
+}
+
+type nodeSystemStats struct {
+	CPUUtilizationRate *float64 `json:"cpu_utilization_rate"`
 }
 
 type nodeInterestingStats struct {
@@ -68,8 +77,18 @@ type nodeInterestingStats struct {
 // Bucket specific models
 
 type bucket struct {
-	Name      string          `json:"name"`
-	StatsInfo bucketStatsInfo `json:"stats"`
+	Name       string           `json:"name"`
+	StatsInfo  bucketStatsInfo  `json:"stats"`
+	BasicStats bucketBasicStats `json:"basicStats"`
+}
+
+type bucketBasicStats struct {
+	QuotaPercentUsed *float64 `json:"quotaPercentUsed"`
+	OpsPerSec        *float64 `json:"opsPerSec"`
+	ItemCount        *int64   `json:"itemCount"`
+	DiskUsed         *int64   `json:"diskUsed"`
+	DataUsed         *int64   `json:"dataUsed"`
+	MemUsed          *int64   `json:"memUsed"`
 }
 
 type bucketStatsInfo struct {
@@ -84,3 +103,17 @@ type bucketStatsOp struct {
 	LastTimeStamp int64                    `json:"lastTStamp"`
 	Samples       map[string][]interface{} `json:"samples"`
 }
+
+// Task specific models
+
+// xdcrTaskType is the type of the tasks describing XDCR replications
+const xdcrTaskType = "xdcr"
+
+type task struct {
+	Type        string `json:"type"`
+	ID          string `json:"id"`
+	Source      string `json:"source"`
+	Target      string `json:"target"`
+	Status      string `json:"status"`
+	ChangesLeft *int64 `json:"changesLeft"`
+}
@@ -0,0 +1,221 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package couchbasereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver/internal/metadata"
+)
+
+const instrumentationLibraryName = "otelcol/couchbase"
+
+var errClientNotInit = errors.New("client not initialized")
+
+// Bucket stats samples collected from the bucket stats endpoint
+const (
+	cacheMissRateSample         = "ep_cache_miss_rate"
+	documentFragmentationSample = "couch_docs_fragmentation"
+)
+
+type couchbaseScraper struct {
+	client   client
+	logger   *zap.Logger
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+	// buckets is the set of buckets to collect, all the buckets are collected when empty
+	buckets map[string]struct{}
+}
+
+func newCouchbaseScraper(logger *zap.Logger, cfg *Config, settings component.TelemetrySettings) *couchbaseScraper {
+	buckets := make(map[string]struct{}, len(cfg.Buckets))
+	for _, b := range cfg.Buckets {
+		buckets[b] = struct{}{}
+	}
+
+	return &couchbaseScraper{
+		logger:   logger,
+		cfg:      cfg,
+		settings: settings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics),
+		buckets:  buckets,
+	}
+}
+
+func (c *couchbaseScraper) start(ctx context.Context, host component.Host) (err error) {
+	c.client, err = newClient(c.cfg, host, c.settings)
+	return
+}
+
+func (c *couchbaseScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	metrics := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+	rms := metrics.ResourceMetrics()
+
+	// Validate we don't attempt to scrape without initializing the client
+	if c.client == nil {
+		return metrics, errClientNotInit
+	}
+
+	clusterInfo, err := c.client.GetClusterDetails(ctx)
+	if err != nil {
+		return metrics, err
+	}
+
+	var scrapeErrors scrapererror.ScrapeErrors
+
+	for _, n := range clusterInfo.Nodes {
+		c.collectNode(n, now, rms)
+	}
+
+	buckets, err := c.client.GetBuckets(ctx, clusterInfo.BucketsInfo.URI)
+	if err != nil {
+		scrapeErrors.AddPartial(1, err)
+		return metrics, scrapeErrors.Combine()
+	}
+
+	// The XDCR replications are reported along with their source bucket
+	replications := make(map[string][]*task)
+	tasks, err := c.client.GetTasks(ctx)
+	if err != nil {
+		scrapeErrors.AddPartial(1, err)
+	}
+	for _, t := range tasks {
+		if t.Type == xdcrTaskType {
+			replications[t.Source] = append(replications[t.Source], t)
+		}
+	}
+
+	for _, b := range buckets {
+		if !c.shouldCollectBucket(b.Name) {
+			continue
+		}
+
+		var stats *bucketStats
+		if c.cfg.Metrics.CouchbaseBucketCacheMissRate.Enabled || c.cfg.Metrics.CouchbaseBucketDocumentFragmentation.Enabled {
+			stats, err = c.client.GetBucketStats(ctx, b.StatsInfo.URI)
+			if err != nil {
+				scrapeErrors.AddPartial(2, err)
+			}
+		}
+
+		c.collectBucket(b, stats, replications[b.Name], now, rms)
+	}
+
+	return metrics, scrapeErrors.Combine()
+}
+
+// shouldCollectBucket checks whether the bucket is part of the configured buckets
+func (c *couchbaseScraper) shouldCollectBucket(name string) bool {
+	if len(c.buckets) == 0 {
+		return true
+	}
+	_, ok := c.buckets[name]
+	return ok
+}
+
+func (c *couchbaseScraper) collectNode(n node, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	resourceMetric := rms.AppendEmpty()
+	resourceMetric.Resource().Attributes().InsertString(metadata.A.CouchbaseNodeHostname, n.Hostname)
+
+	ilms := resourceMetric.InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+
+	for _, status := range []string{
+		metadata.AttributeNodeStatus.Healthy,
+		metadata.AttributeNodeStatus.Unhealthy,
+		metadata.AttributeNodeStatus.Warmup,
+	} {
+		var val int64
+		if n.Status == status {
+			val = 1
+		}
+		c.mb.RecordCouchbaseNodeHealthDataPoint(now, val, status)
+	}
+
+	if n.MemoryTotal != nil && n.MemoryFree != nil {
+		c.mb.RecordCouchbaseNodeMemoryUsageDataPoint(now, *n.MemoryTotal-*n.MemoryFree)
+	}
+	if n.SystemStats.CPUUtilizationRate != nil {
+		c.mb.RecordCouchbaseNodeCPUUtilizationDataPoint(now, *n.SystemStats.CPUUtilizationRate)
+	}
+
+	c.mb.Emit(ilms.Metrics())
+}
+
+func (c *couchbaseScraper) collectBucket(b *bucket, stats *bucketStats, replications []*task, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	resourceMetric := rms.AppendEmpty()
+	resourceMetric.Resource().Attributes().InsertString(metadata.A.CouchbaseBucketName, b.Name)
+
+	ilms := resourceMetric.InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+
+	basicStats := b.BasicStats
+	if basicStats.OpsPerSec != nil {
+		c.mb.RecordCouchbaseBucketOperationRateDataPoint(now, *basicStats.OpsPerSec)
+	}
+	if basicStats.ItemCount != nil {
+		c.mb.RecordCouchbaseBucketItemCountDataPoint(now, *basicStats.ItemCount)
+	}
+	if basicStats.MemUsed != nil {
+		c.mb.RecordCouchbaseBucketMemoryUsageDataPoint(now, *basicStats.MemUsed)
+	}
+	if basicStats.QuotaPercentUsed != nil {
+		c.mb.RecordCouchbaseBucketMemoryQuotaUtilizationDataPoint(now, *basicStats.QuotaPercentUsed)
+	}
+	if basicStats.DiskUsed != nil {
+		c.mb.RecordCouchbaseBucketDiskUsageDataPoint(now, *basicStats.DiskUsed)
+	}
+	if basicStats.DataUsed != nil {
+		c.mb.RecordCouchbaseBucketDataUsageDataPoint(now, *basicStats.DataUsed)
+	}
+
+	if stats != nil {
+		if val, ok := lastSample(stats, cacheMissRateSample); ok {
+			c.mb.RecordCouchbaseBucketCacheMissRateDataPoint(now, val)
+		}
+		if val, ok := lastSample(stats, documentFragmentationSample); ok {
+			c.mb.RecordCouchbaseBucketDocumentFragmentationDataPoint(now, val)
+		}
+	}
+
+	for _, r := range replications {
+		if r.ChangesLeft != nil {
+			c.mb.RecordCouchbaseBucketXdcrChangesLeftDataPoint(now, *r.ChangesLeft, r.Target)
+		}
+	}
+
+	c.mb.Emit(ilms.Metrics())
+}
+
+// lastSample returns the most recent value of the given bucket stats sample.
+// The samples unmarshal as float64s.
+func lastSample(stats *bucketStats, name string) (float64, bool) {
+	samples := stats.Op.Samples[name]
+	if len(samples) == 0 {
+		return 0, false
+	}
+
+	val, ok := samples[len(samples)-1].(float64)
+	return val, ok
+}
@@ -0,0 +1,203 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package couchbasereceiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchbasereceiver/internal/metadata"
+)
+
+// fakeClient serves the recorded API responses
+type fakeClient struct {
+	t        *testing.T
+	tasksErr error
+}
+
+var _ client = (*fakeClient)(nil)
+
+func (f *fakeClient) GetClusterDetails(context.Context) (*clusterResponse, error) {
+	var cluster clusterResponse
+	require.NoError(f.t, json.Unmarshal(loadAPIResponseData(f.t, clusterAPIResponseFile), &cluster))
+	return &cluster, nil
+}
+
+func (f *fakeClient) GetBuckets(context.Context, string) ([]*bucket, error) {
+	var buckets []*bucket
+	require.NoError(f.t, json.Unmarshal(loadAPIResponseData(f.t, clusterBucketAPIResponseFile), &buckets))
+	return buckets, nil
+}
+
+func (f *fakeClient) GetBucketStats(context.Context, string) (*bucketStats, error) {
+	var stats bucketStats
+	require.NoError(f.t, json.Unmarshal(loadAPIResponseData(f.t, bucketStatsAPIResponseFile), &stats))
+	return &stats, nil
+}
+
+func (f *fakeClient) GetTasks(context.Context) ([]*task, error) {
+	if f.tasksErr != nil {
+		return nil, f.tasksErr
+	}
+	var tasks []*task
+	require.NoError(f.t, json.Unmarshal(loadAPIResponseData(f.t, tasksAPIResponseFile), &tasks))
+	return tasks, nil
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		scraper     *couchbaseScraper
+		expectError bool
+	}{
+		{
+			desc: "Bad Config",
+			scraper: &couchbaseScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+						TLSSetting: configtls.TLSClientSetting{
+							TLSSetting: configtls.TLSSetting{
+								CAFile: "/non/existent",
+							},
+						},
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid Config",
+			scraper: &couchbaseScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						TLSSetting: configtls.TLSClientSetting{},
+						Endpoint:   defaultEndpoint,
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrape(t *testing.T) {
+	t.Run("Nil client", func(t *testing.T) {
+		scraper := newCouchbaseScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+		_, err := scraper.scrape(context.Background())
+		require.ErrorIs(t, err, errClientNotInit)
+	})
+
+	t.Run("Successful Collection", func(t *testing.T) {
+		scraper := newCouchbaseScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+		scraper.client = &fakeClient{t: t}
+
+		metrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		// 3 nodes and 2 buckets
+		rms := metrics.ResourceMetrics()
+		require.Equal(t, 5, rms.Len())
+
+		node := rms.At(0)
+		hostname, _ := node.Resource().Attributes().Get(metadata.A.CouchbaseNodeHostname)
+		require.Equal(t, "172.17.0.2:8091", hostname.StringVal())
+		nodeMetrics := metricsByName(node)
+		health := nodeMetrics["couchbase.node.health"].Gauge().DataPoints()
+		require.Equal(t, 3, health.Len())
+		for i := 0; i < health.Len(); i++ {
+			status, _ := health.At(i).Attributes().Get(metadata.A.NodeStatus)
+			if status.StringVal() == metadata.AttributeNodeStatus.Healthy {
+				require.EqualValues(t, 1, health.At(i).IntVal())
+			} else {
+				require.EqualValues(t, 0, health.At(i).IntVal())
+			}
+		}
+		require.EqualValues(t, 4136570880-1115889664, nodeMetrics["couchbase.node.memory.usage"].Sum().DataPoints().At(0).IntVal())
+
+		bucket := rms.At(3)
+		name, _ := bucket.Resource().Attributes().Get(metadata.A.CouchbaseBucketName)
+		require.Equal(t, "otelb", name.StringVal())
+		bucketMetrics := metricsByName(bucket)
+		require.EqualValues(t, 61770024, bucketMetrics["couchbase.bucket.memory.usage"].Sum().DataPoints().At(0).IntVal())
+		require.EqualValues(t, 30647082, bucketMetrics["couchbase.bucket.disk.usage"].Sum().DataPoints().At(0).IntVal())
+		require.InDelta(t, 7.67, bucketMetrics["couchbase.bucket.memory.quota.utilization"].Gauge().DataPoints().At(0).DoubleVal(), 0.01)
+		require.Contains(t, bucketMetrics, "couchbase.bucket.cache.miss_rate")
+		require.NotContains(t, bucketMetrics, "couchbase.bucket.document.fragmentation")
+		changesLeft := bucketMetrics["couchbase.bucket.xdcr.changes_left"].Sum().DataPoints()
+		require.Equal(t, 1, changesLeft.Len())
+		require.EqualValues(t, 42, changesLeft.At(0).IntVal())
+		target, _ := changesLeft.At(0).Attributes().Get(metadata.A.ReplicationTarget)
+		require.Equal(t, "/remoteClusters/e65468352e51e1f29b3fee22265ba6fa/buckets/otelb", target.StringVal())
+
+		// No replication is defined for this bucket
+		require.NotContains(t, metricsByName(rms.At(4)), "couchbase.bucket.xdcr.changes_left")
+	})
+
+	t.Run("Bucket filtering", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Buckets = []string{"test_bucket"}
+		scraper := newCouchbaseScraper(zap.NewNop(), cfg, componenttest.NewNopTelemetrySettings())
+		scraper.client = &fakeClient{t: t}
+
+		metrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		rms := metrics.ResourceMetrics()
+		require.Equal(t, 4, rms.Len())
+		name, _ := rms.At(3).Resource().Attributes().Get(metadata.A.CouchbaseBucketName)
+		require.Equal(t, "test_bucket", name.StringVal())
+	})
+
+	t.Run("Partial failure", func(t *testing.T) {
+		scraper := newCouchbaseScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+		scraper.client = &fakeClient{t: t, tasksErr: errors.New("some api error")}
+
+		metrics, err := scraper.scrape(context.Background())
+		require.EqualError(t, err, "some api error")
+		require.Equal(t, 5, metrics.ResourceMetrics().Len())
+	})
+}
+
+func metricsByName(rm pdata.ResourceMetrics) map[string]pdata.Metric {
+	metrics := make(map[string]pdata.Metric)
+	ms := rm.InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	return metrics
+}
@@ -63,7 +63,21 @@ func (f ReceiverType) BaseConfig(cfg config.Receiver) stanza.BaseConfig {
 // FileLogConfig defines configuration for the filelog receiver
 type FileLogConfig struct {
 	stanza.BaseConfig `mapstructure:",squash"`
-	Input             stanza.InputConfig `mapstructure:",remain"`
+	// Presets are the names of the built-in multiline patterns joining the
+	// continuation lines of the logs of common runtimes to their entry
+	Presets []string           `mapstructure:"presets"`
+	Input   stanza.InputConfig `mapstructure:",remain"`
+}
+
+// Validate checks that the presets exist, and that the input doesn't set multiline
+func (cfg *FileLogConfig) Validate() error {
+	if _, err := presetsLineStartPattern(cfg.Presets); err != nil {
+		return err
+	}
+	if len(cfg.Presets) > 0 {
+		return validatePresetsInput(cfg.Input)
+	}
+	return nil
 }
 
 // DecodeInputConfig unmarshals the input operator
@@ -74,5 +88,9 @@ func (f ReceiverType) DecodeInputConfig(cfg config.Receiver) (*operator.Config,
 	if err := yaml.Unmarshal(yamlBytes, &inputCfg); err != nil {
 		return nil, err
 	}
+	// unknown presets are reported by Validate
+	if pattern, _ := presetsLineStartPattern(logConfig.Presets); pattern != "" {
+		inputCfg.Splitter.Multiline.LineStartPattern = pattern
+	}
 	return &operator.Config{Builder: inputCfg}, nil
 }
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filelogreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza"
+)
+
+// preset is a named set of patterns matching the continuation lines of the
+// logs of a common runtime, the lines appended to the log entry before them.
+type preset struct {
+	name          string
+	continuations []string
+}
+
+// presets are the available presets.
+var presets = []preset{
+	{
+		// the exception lines, stack frames, "... n more" and "Caused by:"
+		// lines of a Java stack trace.
+		name: "java_stacktrace",
+		continuations: []string{
+			`[ \t].*`,
+			`Caused by: .*`,
+			`[\w$.]+(?:Exception|Error|Throwable)(?:: .*)?`,
+		},
+	},
+	{
+		// the lines of a Python traceback, and of the tracebacks chained to it.
+		name: "python_traceback",
+		continuations: []string{
+			`[ \t].*`,
+			`Traceback \(most recent call last\):`,
+			`During handling of the above exception.*`,
+			`The above exception was the direct cause.*`,
+			`[\w.]+(?:Error|Exception|Warning|Exit|Interrupt|Iteration)(?:: .*)?`,
+		},
+	},
+}
+
+// presetsLineStartPattern returns the multiline line_start_pattern of the file
+// input joining the continuation lines of the selected presets to the entry
+// before them. Go regular expressions have no lookahead, so the pattern matches
+// a whole entry: the file input only uses the start of the matches to split
+// the entries, per file. Empty lines are joined when a continuation line
+// follows them, and an unterminated last line is kept in the match, so that
+// the entry is not split before the line is known to start a new one.
+func presetsLineStartPattern(names []string) (string, error) {
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		if selected[name] {
+			return "", fmt.Errorf("preset %q is selected more than once", name)
+		}
+		selected[name] = true
+	}
+
+	var continuations []string
+	for _, p := range presets {
+		if selected[p.name] {
+			continuations = append(continuations, p.continuations...)
+			delete(selected, p.name)
+		}
+	}
+	for _, name := range names {
+		if selected[name] {
+			return "", fmt.Errorf("unknown preset %q", name)
+		}
+	}
+	if len(continuations) == 0 {
+		return "", nil
+	}
+	return `^\S.*(?:\n+(?:` + strings.Join(continuations, "|") + `)$)*(?:\n+.+\z)?`, nil
+}
+
+// validatePresetsInput checks that the input doesn't split the entries itself.
+func validatePresetsInput(input stanza.InputConfig) error {
+	if _, ok := input["multiline"]; ok {
+		return errors.New("presets can't be used along with multiline")
+	}
+	return nil
+}
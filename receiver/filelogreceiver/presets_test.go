@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filelogreceiver
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-log-collection/operator/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza"
+)
+
+func TestPresetsLineStartPattern(t *testing.T) {
+	pattern, err := presetsLineStartPattern(nil)
+	require.NoError(t, err)
+	assert.Empty(t, pattern)
+
+	pattern, err = presetsLineStartPattern([]string{"python_traceback", "java_stacktrace"})
+	require.NoError(t, err)
+	assert.Contains(t, pattern, "Caused by: ")
+	assert.Contains(t, pattern, "Traceback")
+
+	_, err = presetsLineStartPattern([]string{"java_stacktrace", "java_stacktrace"})
+	assert.EqualError(t, err, `preset "java_stacktrace" is selected more than once`)
+
+	_, err = presetsLineStartPattern([]string{"ruby"})
+	assert.EqualError(t, err, `unknown preset "ruby"`)
+}
+
+func TestPresetsSplit(t *testing.T) {
+	pattern, err := presetsLineStartPattern([]string{"java_stacktrace"})
+	require.NoError(t, err)
+	splitFunc, err := helper.MultilineConfig{LineStartPattern: pattern}.Build(unicode.UTF8, true, nil, 1024)
+	require.NoError(t, err)
+
+	lines := "INFO starting\n" +
+		"ERROR request failed\n" +
+		"java.lang.IllegalStateException: boom\n" +
+		"\tat com.example.Service.handle(Service.java:42)\n" +
+		"Caused by: java.io.IOException\n" +
+		"\t... 2 more\n" +
+		"INFO done\n"
+
+	// the entries are not split at the end of a partial line, whatever the
+	// size of the reads
+	scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(lines)))
+	scanner.Split(splitFunc)
+	var entries []string
+	for scanner.Scan() {
+		entries = append(entries, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{
+		"INFO starting",
+		"ERROR request failed\n" +
+			"java.lang.IllegalStateException: boom\n" +
+			"\tat com.example.Service.handle(Service.java:42)\n" +
+			"Caused by: java.io.IOException\n" +
+			"\t... 2 more",
+		"INFO done",
+	}, entries)
+}
+
+func TestValidatePresets(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.Input["include"] = []interface{}{"/var/log/pods/*/*/*.log"}
+	cfg.Presets = []string{"python_traceback"}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Presets = []string{"ruby"}
+	assert.Error(t, cfg.Validate())
+
+	// the presets set the multiline pattern of the input
+	cfg.Presets = []string{"python_traceback"}
+	cfg.Input["multiline"] = map[string]interface{}{"line_start_pattern": "^\\S"}
+	assert.EqualError(t, cfg.Validate(), "presets can't be used along with multiline")
+
+	cfg.Presets = nil
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestPresets(t *testing.T) {
+	tests := []struct {
+		name     string
+		presets  []string
+		lines    string
+		expected []interface{}
+	}{
+		{
+			name:    "java_stacktrace",
+			presets: []string{"java_stacktrace"},
+			lines: "2022-02-14 10:00:00 ERROR request failed\n" +
+				"java.lang.IllegalStateException: boom\n" +
+				"\tat com.example.Service.handle(Service.java:42)\n" +
+				"\tat com.example.Server.run(Server.java:7)\n" +
+				"Caused by: java.io.IOException: closed\n" +
+				"\t... 2 more\n" +
+				"2022-02-14 10:00:01 INFO recovered\n" +
+				"2022-02-14 10:00:02 INFO done\n",
+			expected: []interface{}{
+				"2022-02-14 10:00:00 ERROR request failed\n" +
+					"java.lang.IllegalStateException: boom\n" +
+					"\tat com.example.Service.handle(Service.java:42)\n" +
+					"\tat com.example.Server.run(Server.java:7)\n" +
+					"Caused by: java.io.IOException: closed\n" +
+					"\t... 2 more",
+				"2022-02-14 10:00:01 INFO recovered",
+			},
+		},
+		{
+			name:    "python_traceback",
+			presets: []string{"python_traceback"},
+			lines: "ERROR:root:request failed\n" +
+				"Traceback (most recent call last):\n" +
+				"  File \"app.py\", line 3, in <module>\n" +
+				"    handle()\n" +
+				"ValueError: boom\n" +
+				"\n" +
+				"During handling of the above exception, another exception occurred:\n" +
+				"\n" +
+				"Traceback (most recent call last):\n" +
+				"  File \"app.py\", line 5, in <module>\n" +
+				"    report()\n" +
+				"RuntimeError: failed\n" +
+				"INFO:root:recovered\n" +
+				"INFO:root:done\n",
+			expected: []interface{}{
+				"ERROR:root:request failed\n" +
+					"Traceback (most recent call last):\n" +
+					"  File \"app.py\", line 3, in <module>\n" +
+					"    handle()\n" +
+					"ValueError: boom\n" +
+					"\n" +
+					"During handling of the above exception, another exception occurred:\n" +
+					"\n" +
+					"Traceback (most recent call last):\n" +
+					"  File \"app.py\", line 5, in <module>\n" +
+					"    report()\n" +
+					"RuntimeError: failed",
+				"INFO:root:recovered",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the entries are split per file, so several files can be read,
+			// starting with different lines not to share their fingerprint
+			tempDir := t.TempDir()
+			require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "app-1.log"), []byte("app-1\n"+tt.lines), 0600))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(tempDir, "app-2.log"), []byte("app-2\n"+tt.lines), 0600))
+
+			cfg := &FileLogConfig{
+				BaseConfig: stanza.BaseConfig{
+					ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					Converter: stanza.ConverterConfig{
+						MaxFlushCount: 1,
+						FlushInterval: time.Millisecond,
+					},
+				},
+				Presets: tt.presets,
+				Input: stanza.InputConfig{
+					"include":       []interface{}{filepath.Join(tempDir, "*.log")},
+					"start_at":      "beginning",
+					"poll_interval": "10ms",
+				},
+			}
+			require.NoError(t, cfg.Validate())
+
+			sink := new(consumertest.LogsSink)
+			rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, rcvr.Shutdown(context.Background()))
+			}()
+
+			require.Eventually(t, func() bool { return sink.LogRecordCount() >= 2+2*len(tt.expected) }, 3*time.Second, 5*time.Millisecond)
+			var bodies []interface{}
+			for _, logs := range sink.AllLogs() {
+				records := logs.ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords()
+				for i := 0; i < records.Len(); i++ {
+					bodies = append(bodies, records.At(i).Body().AsString())
+				}
+			}
+			for _, expected := range tt.expected {
+				count := 0
+				for _, body := range bodies {
+					if body == expected {
+						count++
+					}
+				}
+				assert.Equal(t, 2, count, expected)
+			}
+		})
+	}
+}
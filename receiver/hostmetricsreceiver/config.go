@@ -42,6 +42,11 @@ func (cfg *Config) Validate() error {
 	if len(cfg.Scrapers) == 0 {
 		return errors.New("must specify at least one scraper when using hostmetrics receiver")
 	}
+	for key, scraperCfg := range cfg.Scrapers {
+		if internal.CollectionInterval(scraperCfg) < 0 {
+			return fmt.Errorf("collection interval of scraper %q can't be negative", key)
+		}
+	}
 
 	return nil
 }
@@ -88,6 +88,7 @@ func TestLoadConfig(t *testing.T) {
 			pagingscraper.TypeStr:    &pagingscraper.Config{},
 			processscraper.TypeStr: (func() internal.Config {
 				cfg := (&processscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*processscraper.Config).CollectionInterval = 2 * time.Minute
 				cfg.(*processscraper.Config).Include = processscraper.MatchConfig{
 					Names:  []string{"test2", "test3"},
 					Config: filterset.Config{MatchType: "regexp"},
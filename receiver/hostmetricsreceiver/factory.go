@@ -17,6 +17,8 @@ package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -29,15 +31,22 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/dotnetclrscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/iisscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/netstatscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/sensorsscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
 )
 
 // This file implements Factory for HostMetrics receiver.
@@ -49,15 +58,22 @@ const (
 
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
+		cgroupscraper.TypeStr:     &cgroupscraper.Factory{},
 		cpuscraper.TypeStr:        &cpuscraper.Factory{},
 		diskscraper.TypeStr:       &diskscraper.Factory{},
+		dotnetclrscraper.TypeStr:  &dotnetclrscraper.Factory{},
 		loadscraper.TypeStr:       &loadscraper.Factory{},
 		filesystemscraper.TypeStr: &filesystemscraper.Factory{},
+		gpuscraper.TypeStr:        &gpuscraper.Factory{},
+		iisscraper.TypeStr:        &iisscraper.Factory{},
 		memoryscraper.TypeStr:     &memoryscraper.Factory{},
+		netstatscraper.TypeStr:    &netstatscraper.Factory{},
 		networkscraper.TypeStr:    &networkscraper.Factory{},
 		pagingscraper.TypeStr:     &pagingscraper.Factory{},
 		processesscraper.TypeStr:  &processesscraper.Factory{},
 		processscraper.TypeStr:    &processscraper.Factory{},
+		sensorsscraper.TypeStr:    &sensorsscraper.Factory{},
+		smartscraper.TypeStr:      &smartscraper.Factory{},
 	}
 )
 
@@ -91,22 +107,97 @@ func createMetricsReceiver(
 ) (component.MetricsReceiver, error) {
 	oCfg := cfg.(*Config)
 
-	addScraperOptions, err := createAddScraperOptions(ctx, set.Logger, oCfg, scraperFactories)
+	schemaURLSetterConsumer, err := wrapBySchemaURLSetterConsumer(consumer)
 	if err != nil {
 		return nil, err
 	}
 
-	schemaURLSetterConsumer, err := wrapBySchemaURLSetterConsumer(consumer)
-	if err != nil {
-		return nil, err
+	// the scrapers collected at different intervals are run by different
+	// scraper controllers.
+	intervalConfigs := splitByCollectionInterval(oCfg)
+	receivers := make(multiIntervalReceiver, 0, len(intervalConfigs))
+	for _, intervalCfg := range intervalConfigs {
+		addScraperOptions, err := createAddScraperOptions(ctx, set.Logger, intervalCfg, scraperFactories)
+		if err != nil {
+			return nil, err
+		}
+
+		receiver, err := scraperhelper.NewScraperControllerReceiver(
+			&intervalCfg.ScraperControllerSettings,
+			set,
+			schemaURLSetterConsumer,
+			addScraperOptions...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	if len(receivers) == 1 {
+		return receivers[0], nil
 	}
+	return receivers, nil
+}
 
-	return scraperhelper.NewScraperControllerReceiver(
-		&oCfg.ScraperControllerSettings,
-		set,
-		schemaURLSetterConsumer,
-		addScraperOptions...,
-	)
+// splitByCollectionInterval returns one config per collection interval of the
+// scrapers, holding the scrapers collected at that interval, sorted by
+// interval. The scrapers not overriding the collection interval are collected
+// at the receiver interval.
+func splitByCollectionInterval(cfg *Config) []*Config {
+	scrapers := map[time.Duration]map[string]internal.Config{}
+	for key, scraperCfg := range cfg.Scrapers {
+		interval := internal.CollectionInterval(scraperCfg)
+		if interval == 0 {
+			interval = cfg.CollectionInterval
+		}
+		if scrapers[interval] == nil {
+			scrapers[interval] = map[string]internal.Config{}
+		}
+		scrapers[interval][key] = scraperCfg
+	}
+	if len(scrapers) <= 1 && (len(scrapers) == 0 || scrapers[cfg.CollectionInterval] != nil) {
+		return []*Config{cfg}
+	}
+
+	intervals := make([]time.Duration, 0, len(scrapers))
+	for interval := range scrapers {
+		intervals = append(intervals, interval)
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+
+	configs := make([]*Config, 0, len(intervals))
+	for _, interval := range intervals {
+		intervalCfg := *cfg
+		intervalCfg.CollectionInterval = interval
+		intervalCfg.Scrapers = scrapers[interval]
+		configs = append(configs, &intervalCfg)
+	}
+	return configs
+}
+
+// multiIntervalReceiver runs the scraper controllers of the scrapers
+// collected at different intervals.
+type multiIntervalReceiver []component.MetricsReceiver
+
+func (r multiIntervalReceiver) Start(ctx context.Context, host component.Host) error {
+	for _, receiver := range r {
+		if err := receiver.Start(ctx, host); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts all the scraper controllers down, returning the first error.
+func (r multiIntervalReceiver) Shutdown(ctx context.Context) error {
+	var firstErr error
+	for _, receiver := range r {
+		if err := receiver.Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
 
 // This function wraps the consumer and returns a new consumer such that the schema URL
@@ -18,14 +18,19 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenterror"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
 )
 
 var creationSet = componenttest.NewNopReceiverCreateSettings()
@@ -63,3 +68,44 @@ func TestCreateReceiver_ScraperKeyConfigError(t *testing.T) {
 	_, err := factory.CreateMetricsReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
 	assert.EqualError(t, err, fmt.Sprintf("host metrics scraper factory not found for key: %q", errorKey))
 }
+
+func TestSplitByCollectionInterval(t *testing.T) {
+	cpuCfg := (&cpuscraper.Factory{}).CreateDefaultConfig()
+	memoryCfg := (&memoryscraper.Factory{}).CreateDefaultConfig()
+	memoryCfg.(*memoryscraper.Config).CollectionInterval = 10 * time.Second
+	processCfg := (&processscraper.Factory{}).CreateDefaultConfig()
+	processCfg.(*processscraper.Config).CollectionInterval = 2 * time.Minute
+
+	cfg := &Config{
+		ScraperControllerSettings: scraperhelper.DefaultScraperControllerSettings(typeStr),
+		Scrapers: map[string]internal.Config{
+			cpuscraper.TypeStr:     cpuCfg,
+			memoryscraper.TypeStr:  memoryCfg,
+			processscraper.TypeStr: processCfg,
+		},
+	}
+	configs := splitByCollectionInterval(cfg)
+	assert.Len(t, configs, 3)
+	assert.Equal(t, 10*time.Second, configs[0].CollectionInterval)
+	assert.Equal(t, map[string]internal.Config{memoryscraper.TypeStr: memoryCfg}, configs[0].Scrapers)
+	assert.Equal(t, time.Minute, configs[1].CollectionInterval)
+	assert.Equal(t, map[string]internal.Config{cpuscraper.TypeStr: cpuCfg}, configs[1].Scrapers)
+	assert.Equal(t, 2*time.Minute, configs[2].CollectionInterval)
+	assert.Equal(t, map[string]internal.Config{processscraper.TypeStr: processCfg}, configs[2].Scrapers)
+
+	receiver, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.Len(t, receiver, 3)
+
+	// scrapers overriding the interval with the receiver interval share its controller.
+	processCfg.(*processscraper.Config).CollectionInterval = time.Minute
+	delete(cfg.Scrapers, memoryscraper.TypeStr)
+	assert.Equal(t, []*Config{cfg}, splitByCollectionInterval(cfg))
+}
+
+func TestValidateNegativeScraperCollectionInterval(t *testing.T) {
+	cpuCfg := (&cpuscraper.Factory{}).CreateDefaultConfig()
+	cpuCfg.(*cpuscraper.Config).CollectionInterval = -time.Second
+	cfg := &Config{Scrapers: map[string]internal.Config{cpuscraper.TypeStr: cpuCfg}}
+	assert.EqualError(t, cfg.Validate(), `collection interval of scraper "cpu" can't be negative`)
+}
@@ -75,10 +75,10 @@ var resourceMetrics = []string{
 }
 
 var systemSpecificMetrics = map[string][]string{
-	"linux":   {"system.disk.merged", "system.disk.weighted_io_time", "system.filesystem.inodes.usage", "system.paging.faults", "system.processes.created", "system.processes.count"},
-	"darwin":  {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.count"},
-	"freebsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.count"},
-	"openbsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.created", "system.processes.count"},
+	"linux":   {"system.disk.merged", "system.disk.weighted_io_time", "system.filesystem.inodes.usage", "system.paging.faults", "system.processes.created", "system.processes.count", "system.processes.zombies"},
+	"darwin":  {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.count", "system.processes.zombies"},
+	"freebsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.count", "system.processes.zombies"},
+	"openbsd": {"system.filesystem.inodes.usage", "system.paging.faults", "system.processes.created", "system.processes.count", "system.processes.zombies"},
 	"solaris": {"system.filesystem.inodes.usage", "system.paging.faults"},
 }
 
@@ -0,0 +1,390 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup reads the CPU and memory usage and limits of the cgroup of the
+// collector from the cgroup v1 or v2 filesystem, so that the scrapers can report
+// the resources allocated to the container the collector runs in.
+package cgroup // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// unlimited is the content of the cgroup v2 limit files when no limit is set.
+const unlimited = "max"
+
+// userHZ is the unit of the CPU times of cgroup v1 cpuacct.stat, fixed to 100 by the kernel ABI.
+const userHZ = 100
+
+// unlimitedMemoryV1 is the cgroup v1 memory limit above which the memory is considered
+// unlimited: the kernel reports the largest page aligned int64 when no limit is set.
+const unlimitedMemoryV1 = math.MaxInt64 &^ 0xfff
+
+// CPUStats are the CPU times used by a cgroup and its CPU limit.
+type CPUStats struct {
+	// User and System are the CPU seconds used by the cgroup in user and kernel mode.
+	User   float64
+	System float64
+	// Limit is the number of CPUs the cgroup is allowed to use, zero when unlimited.
+	Limit float64
+}
+
+// MemoryStats are the memory used by a cgroup and its memory limit, in bytes.
+type MemoryStats struct {
+	// Usage is the memory charged to the cgroup, including the page cache.
+	Usage uint64
+	// InactiveFile is the inactive page cache, which the kernel reclaims first
+	// when the cgroup reaches its limit.
+	InactiveFile uint64
+	// Limit is the memory limit of the cgroup, zero when unlimited.
+	Limit uint64
+}
+
+// Root returns the mount point of the cgroup filesystem, honoring the HOST_SYS
+// environment variable like gopsutil.
+func Root() string {
+	if path := os.Getenv("HOST_SYS"); path != "" {
+		return filepath.Join(path, "fs", "cgroup")
+	}
+	return "/sys/fs/cgroup"
+}
+
+// Cgroup locates a cgroup in the cgroup filesystem.
+type Cgroup struct {
+	root string
+	v2   bool
+	// paths are the paths of the cgroup in the hierarchy of each cgroup v1 controller,
+	// or in the unified hierarchy under the "" key for cgroup v2.
+	paths map[string]string
+}
+
+// Self returns the cgroup of the collector process in the cgroup filesystem mounted at root.
+func Self(root string) (*Cgroup, error) {
+	f, err := os.Open("/proc/self/cgroup")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return newCgroup(root, f)
+}
+
+// SelfPath returns the path of the cgroup of the collector process in the cgroup v2
+// unified hierarchy.
+func SelfPath() (string, error) {
+	f, err := os.Open("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return unifiedPath(f)
+}
+
+// newCgroup returns the cgroup described by the content of a /proc/<pid>/cgroup file.
+func newCgroup(root string, procCgroup io.Reader) (*Cgroup, error) {
+	paths, err := parseProcCgroup(procCgroup)
+	if err != nil {
+		return nil, err
+	}
+	// cgroup.controllers only exists in the cgroup v2 unified hierarchy.
+	_, err = os.Stat(filepath.Join(root, "cgroup.controllers"))
+	return &Cgroup{root: root, v2: err == nil, paths: paths}, nil
+}
+
+// unifiedPath returns the cgroup v2 path given by the "0::<path>" entry of the
+// content of a /proc/<pid>/cgroup file.
+func unifiedPath(procCgroup io.Reader) (string, error) {
+	paths, err := parseProcCgroup(procCgroup)
+	if err != nil {
+		return "", err
+	}
+	path, ok := paths[""]
+	if !ok {
+		return "", errors.New("no cgroup v2 hierarchy found")
+	}
+	return path, nil
+}
+
+// parseProcCgroup parses the content of a /proc/<pid>/cgroup file, holding a
+// "hierarchy-ID:controller-list:cgroup-path" line per hierarchy, and returns the
+// cgroup path of each cgroup v1 controller, and of the unified hierarchy under the "" key.
+func parseProcCgroup(procCgroup io.Reader) (map[string]string, error) {
+	paths := map[string]string{}
+	scanner := bufio.NewScanner(procCgroup)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			paths[""] = fields[2]
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			paths[controller] = fields[2]
+		}
+	}
+	return paths, scanner.Err()
+}
+
+// dir returns the directory of the cgroup in the hierarchy of the cgroup v1 controller,
+// or in the unified hierarchy for cgroup v2. Without a cgroup namespace, containers
+// usually have their own cgroup mounted at the root of the hierarchy, while their
+// cgroup path is the one in the host hierarchy: the root is used when the path doesn't exist.
+func (c *Cgroup) dir(controller string) string {
+	root := c.root
+	if c.v2 {
+		controller = ""
+	} else {
+		root = filepath.Join(root, controller)
+	}
+	if path, ok := c.paths[controller]; ok {
+		if dir := filepath.Join(root, path); dir != root {
+			if _, err := os.Stat(dir); err == nil {
+				return dir
+			}
+		}
+	}
+	return root
+}
+
+// CPUStats reads the CPU stats of the cgroup.
+func (c *Cgroup) CPUStats() (*CPUStats, error) {
+	if c.v2 {
+		return readCPUStatsV2(c.dir(""))
+	}
+	return readCPUStatsV1(c.dir("cpuacct"), c.dir("cpu"))
+}
+
+// MemoryStats reads the memory stats of the cgroup.
+func (c *Cgroup) MemoryStats() (*MemoryStats, error) {
+	if c.v2 {
+		return readMemoryStatsV2(c.dir(""))
+	}
+	return readMemoryStatsV1(c.dir("memory"))
+}
+
+func readCPUStatsV2(dir string) (*CPUStats, error) {
+	stat, err := ReadFlatKeyed(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return nil, err
+	}
+	stats := &CPUStats{
+		User:   float64(stat["user_usec"]) / 1e6,
+		System: float64(stat["system_usec"]) / 1e6,
+	}
+
+	// The cpu.max file doesn't exist in the root cgroup.
+	limit, limited, err := ReadCPUMax(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	case limited:
+		stats.Limit = limit
+	}
+	return stats, nil
+}
+
+func readCPUStatsV1(cpuacctDir, cpuDir string) (*CPUStats, error) {
+	stat, err := ReadFlatKeyed(filepath.Join(cpuacctDir, "cpuacct.stat"))
+	if err != nil {
+		return nil, err
+	}
+	stats := &CPUStats{
+		User:   float64(stat["user"]) / userHZ,
+		System: float64(stat["system"]) / userHZ,
+	}
+
+	// cpu.cfs_quota_us is -1 when unlimited.
+	quota, err := readFields(filepath.Join(cpuDir, "cpu.cfs_quota_us"))
+	if errors.Is(err, os.ErrNotExist) {
+		return stats, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(quota) != 1 || strings.HasPrefix(quota[0], "-") {
+		return stats, nil
+	}
+	period, err := readFields(filepath.Join(cpuDir, "cpu.cfs_period_us"))
+	if err != nil {
+		return nil, err
+	}
+	if len(period) != 1 {
+		return nil, fmt.Errorf("invalid cpu.cfs_period_us content: %q", strings.Join(period, " "))
+	}
+	stats.Limit, err = ratio(quota[0], period[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cpu.cfs_quota_us or cpu.cfs_period_us content: %w", err)
+	}
+	return stats, nil
+}
+
+func readMemoryStatsV2(dir string) (*MemoryStats, error) {
+	usage, err := ReadUint(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return nil, err
+	}
+	stat, err := ReadFlatKeyed(filepath.Join(dir, "memory.stat"))
+	if err != nil {
+		return nil, err
+	}
+	stats := &MemoryStats{Usage: usage, InactiveFile: stat["inactive_file"]}
+
+	// The memory.max file doesn't exist in the root cgroup.
+	limit, limited, err := ReadLimit(filepath.Join(dir, "memory.max"))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	case limited:
+		stats.Limit = limit
+	}
+	return stats, nil
+}
+
+func readMemoryStatsV1(dir string) (*MemoryStats, error) {
+	usage, err := ReadUint(filepath.Join(dir, "memory.usage_in_bytes"))
+	if err != nil {
+		return nil, err
+	}
+	stat, err := ReadFlatKeyed(filepath.Join(dir, "memory.stat"))
+	if err != nil {
+		return nil, err
+	}
+	limit, err := ReadUint(filepath.Join(dir, "memory.limit_in_bytes"))
+	if err != nil {
+		return nil, err
+	}
+	if limit >= unlimitedMemoryV1 {
+		limit = 0
+	}
+	return &MemoryStats{Usage: usage, InactiveFile: stat["total_inactive_file"], Limit: limit}, nil
+}
+
+// readFields returns the whitespace separated fields of the file.
+func readFields(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(content)), nil
+}
+
+// ReadCPUMax reads the cpu.max file of a cgroup v2 directory, holding "$MAX $PERIOD",
+// and returns the number of CPUs the cgroup may use, and false if it has no CPU quota.
+func ReadCPUMax(dir string) (float64, bool, error) {
+	fields, err := readFields(filepath.Join(dir, "cpu.max"))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(fields) != 2 {
+		return 0, false, fmt.Errorf("invalid cpu.max content: %q", strings.Join(fields, " "))
+	}
+	if fields[0] == unlimited {
+		return 0, false, nil
+	}
+	limit, err := ratio(fields[0], fields[1])
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid cpu.max content: %w", err)
+	}
+	return limit, true, nil
+}
+
+// ReadLimit reads a cgroup v2 limit file such as memory.max, holding a single unsigned
+// integer or "max", and returns false if no limit is set.
+func ReadLimit(path string) (uint64, bool, error) {
+	fields, err := readFields(path)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(fields) == 1 && fields[0] == unlimited {
+		return 0, false, nil
+	}
+	value, err := parseUint(path, fields)
+	if err != nil {
+		return 0, false, err
+	}
+	return value, true, nil
+}
+
+// ReadUint reads a file holding a single unsigned integer, such as memory.current.
+func ReadUint(path string) (uint64, error) {
+	fields, err := readFields(path)
+	if err != nil {
+		return 0, err
+	}
+	return parseUint(path, fields)
+}
+
+// parseUint parses the fields of a file holding a single unsigned integer.
+func parseUint(path string, fields []string) (uint64, error) {
+	if len(fields) != 1 {
+		return 0, fmt.Errorf("invalid %s content: %q", filepath.Base(path), strings.Join(fields, " "))
+	}
+	value, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s content: %w", filepath.Base(path), err)
+	}
+	return value, nil
+}
+
+// ReadFlatKeyed reads a flat keyed file such as cpu.stat, holding a key and an unsigned
+// integer per line.
+func ReadFlatKeyed(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value of %s: %w", filepath.Base(path), fields[0], err)
+		}
+		values[fields[0]] = value
+	}
+	return values, scanner.Err()
+}
+
+// ratio parses two unsigned integers and returns their ratio.
+func ratio(numerator, denominator string) (float64, error) {
+	n, err := strconv.ParseUint(numerator, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	d, err := strconv.ParseUint(denominator, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if d == 0 {
+		return 0, errors.New("zero period")
+	}
+	return float64(n) / float64(d), nil
+}
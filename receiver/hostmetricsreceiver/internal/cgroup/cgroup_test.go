@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	procCgroupV1 = `12:pids:/
+4:cpu,cpuacct:%s
+3:memory:%s
+1:name=systemd:/init.scope
+0::/init.scope
+`
+	procCgroupV2 = `0::%s
+`
+)
+
+func newTestCgroup(t *testing.T, root, procCgroup, path string) *Cgroup {
+	c, err := newCgroup(filepath.Join("testdata", root), strings.NewReader(strings.ReplaceAll(procCgroup, "%s", path)))
+	require.NoError(t, err)
+	return c
+}
+
+func TestCPUStats(t *testing.T) {
+	tests := []struct {
+		name       string
+		root       string
+		procCgroup string
+		path       string
+		expected   CPUStats
+	}{
+		{
+			name:       "v2",
+			root:       "v2",
+			procCgroup: procCgroupV2,
+			path:       "/",
+			expected:   CPUStats{User: 3, System: 1.5, Limit: 1.5},
+		},
+		{
+			name:       "v2 unlimited",
+			root:       "v2",
+			procCgroup: procCgroupV2,
+			path:       "/unlimited",
+			expected:   CPUStats{User: 3, System: 1.5},
+		},
+		{
+			name:       "v2 path not mounted",
+			root:       "v2",
+			procCgroup: procCgroupV2,
+			path:       "/system.slice/docker-0123456789ab.scope",
+			expected:   CPUStats{User: 3, System: 1.5, Limit: 1.5},
+		},
+		{
+			name:       "v1",
+			root:       "v1",
+			procCgroup: procCgroupV1,
+			path:       "/",
+			expected:   CPUStats{User: 2.5, System: 0.5, Limit: 2},
+		},
+		{
+			name:       "v1 unlimited",
+			root:       "v1",
+			procCgroup: procCgroupV1,
+			path:       "/unlimited",
+			expected:   CPUStats{User: 2.5, System: 0.5},
+		},
+		{
+			name:       "v1 path not mounted",
+			root:       "v1",
+			procCgroup: procCgroupV1,
+			path:       "/docker/0123456789ab",
+			expected:   CPUStats{User: 2.5, System: 0.5, Limit: 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats, err := newTestCgroup(t, test.root, test.procCgroup, test.path).CPUStats()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, *stats)
+		})
+	}
+}
+
+func TestMemoryStats(t *testing.T) {
+	tests := []struct {
+		name       string
+		root       string
+		procCgroup string
+		path       string
+		expected   MemoryStats
+	}{
+		{
+			name:       "v2",
+			root:       "v2",
+			procCgroup: procCgroupV2,
+			path:       "/",
+			expected:   MemoryStats{Usage: 256 << 20, InactiveFile: 64 << 20, Limit: 512 << 20},
+		},
+		{
+			name:       "v2 unlimited",
+			root:       "v2",
+			procCgroup: procCgroupV2,
+			path:       "/unlimited",
+			expected:   MemoryStats{Usage: 256 << 20, InactiveFile: 64 << 20},
+		},
+		{
+			name:       "v1",
+			root:       "v1",
+			procCgroup: procCgroupV1,
+			path:       "/",
+			expected:   MemoryStats{Usage: 128 << 20, InactiveFile: 16 << 20, Limit: 1 << 30},
+		},
+		{
+			name:       "v1 unlimited",
+			root:       "v1",
+			procCgroup: procCgroupV1,
+			path:       "/unlimited",
+			expected:   MemoryStats{Usage: 128 << 20, InactiveFile: 16 << 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats, err := newTestCgroup(t, test.root, test.procCgroup, test.path).MemoryStats()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, *stats)
+		})
+	}
+}
+
+func TestStatsMissingCgroup(t *testing.T) {
+	c := newTestCgroup(t, "missing", procCgroupV2, "/")
+	_, err := c.CPUStats()
+	assert.Error(t, err)
+	_, err = c.MemoryStats()
+	assert.Error(t, err)
+}
+
+func TestUnifiedPath(t *testing.T) {
+	path, err := unifiedPath(strings.NewReader("0::/system.slice/otelcol.service\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "/system.slice/otelcol.service", path)
+
+	// hybrid hierarchy
+	path, err = unifiedPath(strings.NewReader("12:memory:/docker/abc\n1:name=systemd:/docker/abc\n0::/docker/abc\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "/docker/abc", path)
+
+	// cgroup v1 only
+	_, err = unifiedPath(strings.NewReader("12:memory:/docker/abc\n1:name=systemd:/docker/abc\n"))
+	assert.Error(t, err)
+}
+
+func TestReadCPUMax(t *testing.T) {
+	dir := t.TempDir()
+	for _, content := range []string{"", "100000", "abc 100000", "100000 0"} {
+		writeFile(t, dir, "cpu.max", content)
+		_, _, err := ReadCPUMax(dir)
+		assert.Error(t, err, content)
+	}
+
+	writeFile(t, dir, "cpu.max", "max 100000\n")
+	_, limited, err := ReadCPUMax(dir)
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	writeFile(t, dir, "cpu.max", "50000 100000\n")
+	limit, limited, err := ReadCPUMax(dir)
+	require.NoError(t, err)
+	assert.True(t, limited)
+	assert.Equal(t, 0.5, limit)
+}
+
+func TestReadLimit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "memory.max")
+	writeFile(t, dir, "memory.max", "invalid")
+	_, _, err := ReadLimit(path)
+	assert.Error(t, err)
+
+	writeFile(t, dir, "memory.max", "max\n")
+	_, limited, err := ReadLimit(path)
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	writeFile(t, dir, "memory.max", "1024\n")
+	limit, limited, err := ReadLimit(path)
+	require.NoError(t, err)
+	assert.True(t, limited)
+	assert.Equal(t, uint64(1024), limit)
+}
+
+func TestRoot(t *testing.T) {
+	t.Setenv("HOST_SYS", "")
+	assert.Equal(t, "/sys/fs/cgroup", Root())
+	t.Setenv("HOST_SYS", "/hostfs/sys")
+	assert.Equal(t, filepath.Join("/hostfs/sys", "fs", "cgroup"), Root())
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+}
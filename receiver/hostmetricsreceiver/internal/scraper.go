@@ -16,6 +16,7 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
@@ -37,4 +38,22 @@ type Config interface {
 
 // ConfigSettings provides common settings for scraper configuration.
 type ConfigSettings struct {
+	// CollectionInterval overrides the collection interval of the receiver
+	// for this scraper. Zero uses the receiver collection interval.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+}
+
+// ScraperCollectionInterval returns the collection interval overriding the
+// receiver one, zero if not overridden.
+func (cs ConfigSettings) ScraperCollectionInterval() time.Duration {
+	return cs.CollectionInterval
+}
+
+// CollectionInterval returns the collection interval set in the scraper
+// configuration, zero if the scraper uses the receiver collection interval.
+func CollectionInterval(cfg Config) time.Duration {
+	if c, ok := cfg.(interface{ ScraperCollectionInterval() time.Duration }); ok {
+		return c.ScraperCollectionInterval()
+	}
+	return 0
 }
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const (
+	cpuLimitMetricsLen = 1
+	cpuStatMetricsLen  = 4
+	memoryMetricsLen   = 3
+)
+
+// scraper for cgroup Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+	// directory of the scraped cgroup.
+	dir string
+
+	// for mocking
+	bootTime   func() (uint64, error)
+	cgroupPath func() (string, error)
+}
+
+// newCgroupScraper creates a cgroup Scraper
+func newCgroupScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, bootTime: host.BootTime, cgroupPath: cgroup.SelfPath}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	path := s.config.Path
+	if path == "" {
+		if path, err = s.cgroupPath(); err != nil {
+			return fmt.Errorf("failed to find the collector cgroup: %w", err)
+		}
+	}
+	s.dir = filepath.Join(s.config.MountPoint, path)
+
+	// cgroup.controllers only exists in the cgroup v2 unified hierarchy.
+	if _, err = os.Stat(filepath.Join(s.dir, "cgroup.controllers")); err != nil {
+		return fmt.Errorf("no cgroup v2 hierarchy found at %q: %w", s.dir, err)
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	var errors scrapererror.ScrapeErrors
+
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	if err := s.recordCPULimitMetric(now); err != nil {
+		errors.AddPartial(cpuLimitMetricsLen, err)
+	}
+
+	if err := s.recordCPUStatMetrics(now); err != nil {
+		errors.AddPartial(cpuStatMetricsLen, err)
+	}
+
+	if err := s.recordMemoryMetrics(now); err != nil {
+		errors.AddPartial(memoryMetricsLen, err)
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+func (s *scraper) recordCPULimitMetric(now pdata.Timestamp) error {
+	limit, limited, err := cgroup.ReadCPUMax(s.dir)
+	if err != nil {
+		return err
+	}
+	if limited {
+		s.mb.RecordSystemCgroupCPULimitDataPoint(now, limit)
+	}
+	return nil
+}
+
+func (s *scraper) recordCPUStatMetrics(now pdata.Timestamp) error {
+	stat, err := cgroup.ReadFlatKeyed(filepath.Join(s.dir, "cpu.stat"))
+	if err != nil {
+		return err
+	}
+	s.mb.RecordSystemCgroupCPUUsageDataPoint(now, float64(stat["usage_usec"])/1e6)
+	// The throttling statistics are only reported when the cpu controller is enabled.
+	if periods, ok := stat["nr_periods"]; ok {
+		s.mb.RecordSystemCgroupCPUPeriodsDataPoint(now, int64(periods))
+		s.mb.RecordSystemCgroupCPUThrottledPeriodsDataPoint(now, int64(stat["nr_throttled"]))
+		s.mb.RecordSystemCgroupCPUThrottledTimeDataPoint(now, float64(stat["throttled_usec"])/1e6)
+	}
+	return nil
+}
+
+func (s *scraper) recordMemoryMetrics(now pdata.Timestamp) error {
+	usage, err := cgroup.ReadUint(filepath.Join(s.dir, "memory.current"))
+	if err != nil {
+		return err
+	}
+	s.mb.RecordSystemCgroupMemoryUsageDataPoint(now, int64(usage))
+
+	limit, limited, err := cgroup.ReadLimit(filepath.Join(s.dir, "memory.max"))
+	if err != nil {
+		return err
+	}
+	if limited {
+		s.mb.RecordSystemCgroupMemoryLimitDataPoint(now, int64(limit))
+		if limit > 0 {
+			s.mb.RecordSystemCgroupMemoryUtilizationDataPoint(now, float64(usage)/float64(limit))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const bootTime = 100
+
+func TestScrape(t *testing.T) {
+	allMetrics := metadata.DefaultMetricsSettings()
+	allMetrics.SystemCgroupMemoryUtilization.Enabled = true
+
+	type testCase struct {
+		name     string
+		path     string
+		metrics  metadata.MetricsSettings
+		expected map[string]float64
+	}
+
+	testCases := []testCase{
+		{
+			name:    "Limited cgroup",
+			path:    "limited",
+			metrics: allMetrics,
+			expected: map[string]float64{
+				"system.cgroup.cpu.limit":             1.5,
+				"system.cgroup.cpu.usage":             2.5,
+				"system.cgroup.cpu.periods":           120,
+				"system.cgroup.cpu.throttled.periods": 12,
+				"system.cgroup.cpu.throttled.time":    0.3,
+				"system.cgroup.memory.limit":          536870912,
+				"system.cgroup.memory.usage":          134217728,
+				"system.cgroup.memory.utilization":    0.25,
+			},
+		},
+		{
+			name:    "Unlimited cgroup",
+			path:    "unlimited",
+			metrics: allMetrics,
+			expected: map[string]float64{
+				"system.cgroup.cpu.usage":    1,
+				"system.cgroup.memory.usage": 67108864,
+			},
+		},
+		{
+			name:    "Default metrics",
+			path:    "limited",
+			metrics: metadata.DefaultMetricsSettings(),
+			expected: map[string]float64{
+				"system.cgroup.cpu.limit":             1.5,
+				"system.cgroup.cpu.usage":             2.5,
+				"system.cgroup.cpu.periods":           120,
+				"system.cgroup.cpu.throttled.periods": 12,
+				"system.cgroup.cpu.throttled.time":    0.3,
+				"system.cgroup.memory.limit":          536870912,
+				"system.cgroup.memory.usage":          134217728,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper := newCgroupScraper(context.Background(), &Config{MountPoint: "testdata", Path: test.path, Metrics: test.metrics})
+			scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize cgroup scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			assert.Equal(t, test.expected, metricValues(t, metrics))
+			internal.AssertSameTimeStampForAllMetrics(t, metrics)
+		})
+	}
+}
+
+func TestScrapeCollectorCgroup(t *testing.T) {
+	scraper := newCgroupScraper(context.Background(), &Config{MountPoint: "testdata", Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	scraper.cgroupPath = func() (string, error) { return "/unlimited", nil }
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, md.MetricCount())
+}
+
+func TestStartErrors(t *testing.T) {
+	scraper := newCgroupScraper(context.Background(), &Config{MountPoint: "testdata", Path: "missing", Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	assert.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	scraper = newCgroupScraper(context.Background(), &Config{MountPoint: "testdata", Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	scraper.cgroupPath = func() (string, error) { return "", errors.New("err1") }
+	assert.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestScrapePartialError(t *testing.T) {
+	scraper := newCgroupScraper(context.Background(), &Config{MountPoint: "testdata", Path: "limited", Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// the cgroup was removed after the scraper started.
+	scraper.dir = "testdata/missing"
+	_, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, cpuLimitMetricsLen+cpuStatMetricsLen+memoryMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+}
+
+func metricValues(t *testing.T, metrics pdata.MetricSlice) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dps pdata.NumberDataPointSlice
+		switch metric.DataType() {
+		case pdata.MetricDataTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pdata.MetricDataTypeSum:
+			dps = metric.Sum().DataPoints()
+		}
+		require.Equal(t, 1, dps.Len(), metric.Name())
+		assert.Equal(t, pdata.Timestamp(bootTime*1e9), dps.At(0).StartTimestamp())
+		switch dps.At(0).Type() {
+		case pdata.MetricValueTypeInt:
+			values[metric.Name()] = float64(dps.At(0).IntVal())
+		case pdata.MetricValueTypeDouble:
+			values[metric.Name()] = dps.At(0).DoubleVal()
+		}
+	}
+	return values
+}
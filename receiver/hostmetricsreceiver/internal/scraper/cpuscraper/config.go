@@ -23,4 +23,10 @@ import (
 type Config struct {
 	internal.ConfigSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 	Metrics                 metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// RespectCgroupLimits reports the CPU time used by the cgroup of the collector, and its
+	// utilization of the CPU limit of the cgroup, instead of the CPU times of the host, so that
+	// the reported utilization reflects the allocation of the container the collector runs in.
+	// Only supported on Linux.
+	RespectCgroupLimits bool `mapstructure:"respect_cgroup_limits"`
 }
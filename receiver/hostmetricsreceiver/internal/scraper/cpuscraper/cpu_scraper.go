@@ -16,6 +16,8 @@ package cpuscraper // import "github.com/open-telemetry/opentelemetry-collector-
 
 import (
 	"context"
+	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/cpu"
@@ -24,24 +26,51 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 )
 
 const metricsLen = 1
 
+// cpuTotal is the cpu attribute value of the CPU times of the cgroup, which are not per CPU.
+const cpuTotal = "cpu-total"
+
 // scraper for CPU Metrics
 type scraper struct {
 	config *Config
 	mb     *metadata.MetricsBuilder
 
+	// prevCgroupStats are the cgroup CPU stats at the previous scrape time, to compute
+	// the CPU utilization of the cgroup.
+	prevCgroupStats *cgroup.CPUStats
+	prevScrapeTime  time.Time
+
 	// for mocking
-	bootTime func() (uint64, error)
-	times    func(bool) ([]cpu.TimesStat, error)
+	bootTime       func() (uint64, error)
+	times          func(bool) ([]cpu.TimesStat, error)
+	cgroupCPUStats func() (*cgroup.CPUStats, error)
+	numCPU         func() int
+	now            func() time.Time
 }
 
 // newCPUScraper creates a set of CPU related metrics
 func newCPUScraper(_ context.Context, cfg *Config) *scraper {
-	return &scraper{config: cfg, bootTime: host.BootTime, times: cpu.Times}
+	return &scraper{
+		config:         cfg,
+		bootTime:       host.BootTime,
+		times:          cpu.Times,
+		cgroupCPUStats: readCgroupCPUStats,
+		numCPU:         runtime.NumCPU,
+		now:            time.Now,
+	}
+}
+
+func readCgroupCPUStats() (*cgroup.CPUStats, error) {
+	c, err := cgroup.Self(cgroup.Root())
+	if err != nil {
+		return nil, err
+	}
+	return c.CPUStats()
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
@@ -57,7 +86,14 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	md := pdata.NewMetrics()
 	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
 
-	now := pdata.NewTimestampFromTime(time.Now())
+	scrapeTime := s.now()
+	now := pdata.NewTimestampFromTime(scrapeTime)
+	if s.config.RespectCgroupLimits {
+		err := s.scrapeCgroupCPUMetrics(now, scrapeTime)
+		s.mb.Emit(metrics)
+		return md, err
+	}
+
 	cpuTimes, err := s.times( /*percpu=*/ true)
 	if err != nil {
 		return md, scrapererror.NewPartialScrapeError(err, metricsLen)
@@ -69,3 +105,37 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	s.mb.Emit(metrics)
 	return md, nil
 }
+
+// scrapeCgroupCPUMetrics records the CPU times used by the cgroup of the collector and
+// their share of the CPU time allocated to the cgroup since the previous scrape, the
+// cgroup being allocated all the CPUs available to the collector when not limited.
+func (s *scraper) scrapeCgroupCPUMetrics(now pdata.Timestamp, scrapeTime time.Time) error {
+	stats, err := s.cgroupCPUStats()
+	if err != nil {
+		return scrapererror.NewPartialScrapeError(fmt.Errorf("error reading cgroup cpu stats: %w", err), metricsLen)
+	}
+
+	s.mb.RecordSystemCPUTimeDataPoint(now, stats.User, cpuTotal, metadata.AttributeState.User)
+	s.mb.RecordSystemCPUTimeDataPoint(now, stats.System, cpuTotal, metadata.AttributeState.System)
+
+	if s.prevCgroupStats != nil && s.config.Metrics.SystemCPUUtilization.Enabled {
+		limit := stats.Limit
+		if limit == 0 {
+			limit = float64(s.numCPU())
+		}
+		if allocated := scrapeTime.Sub(s.prevScrapeTime).Seconds() * limit; allocated > 0 {
+			user := (stats.User - s.prevCgroupStats.User) / allocated
+			system := (stats.System - s.prevCgroupStats.System) / allocated
+			idle := 1 - user - system
+			if idle < 0 {
+				idle = 0
+			}
+			s.mb.RecordSystemCPUUtilizationDataPoint(now, user, cpuTotal, metadata.AttributeState.User)
+			s.mb.RecordSystemCPUUtilizationDataPoint(now, system, cpuTotal, metadata.AttributeState.System)
+			s.mb.RecordSystemCPUUtilizationDataPoint(now, idle, cpuTotal, metadata.AttributeState.Idle)
+		}
+	}
+	s.prevCgroupStats = stats
+	s.prevScrapeTime = scrapeTime
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 )
 
@@ -128,6 +130,77 @@ func TestScrape(t *testing.T) {
 	}
 }
 
+func TestScrape_CgroupLimits(t *testing.T) {
+	type testCase struct {
+		name                string
+		limit               float64
+		expectedUtilization []float64
+	}
+	testCases := []testCase{
+		{
+			name:                "Limited",
+			limit:               2,
+			expectedUtilization: []float64{0.4, 0.1, 0.5},
+		},
+		{
+			name:                "Unlimited",
+			expectedUtilization: []float64{0.2, 0.05, 0.75},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			metricsConfig := metadata.DefaultMetricsSettings()
+			metricsConfig.SystemCPUUtilization.Enabled = true
+			scraper := newCPUScraper(context.Background(), &Config{Metrics: metricsConfig, RespectCgroupLimits: true})
+			scraper.times = func(bool) ([]cpu.TimesStat, error) { return nil, errors.New("host cpu times read") }
+			scraper.numCPU = func() int { return 4 }
+			stats := &cgroup.CPUStats{User: 20, System: 10, Limit: test.limit}
+			scraper.cgroupCPUStats = func() (*cgroup.CPUStats, error) { return stats, nil }
+			scrapeTime := time.Unix(1000, 0)
+			scraper.now = func() time.Time { return scrapeTime }
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, md.MetricCount())
+
+			// 10s later, the cgroup used 8s of user and 2s of system CPU time
+			stats = &cgroup.CPUStats{User: 28, System: 12, Limit: test.limit}
+			scrapeTime = scrapeTime.Add(10 * time.Second)
+			md, err = scraper.scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 2, md.MetricCount())
+
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			cpuTime := metrics.At(0)
+			assert.Equal(t, "system.cpu.time", cpuTime.Name())
+			require.Equal(t, 2, cpuTime.Sum().DataPoints().Len())
+			internal.AssertSumMetricHasAttributeValue(t, cpuTime, 0, metadata.Attributes.Cpu, pdata.NewAttributeValueString(cpuTotal))
+			internal.AssertSumMetricHasAttributeValue(t, cpuTime, 0, metadata.Attributes.State, pdata.NewAttributeValueString(metadata.AttributeState.User))
+			assert.Equal(t, 28.0, cpuTime.Sum().DataPoints().At(0).DoubleVal())
+			internal.AssertSumMetricHasAttributeValue(t, cpuTime, 1, metadata.Attributes.State, pdata.NewAttributeValueString(metadata.AttributeState.System))
+			assert.Equal(t, 12.0, cpuTime.Sum().DataPoints().At(1).DoubleVal())
+
+			utilization := metrics.At(1)
+			assert.Equal(t, "system.cpu.utilization", utilization.Name())
+			states := []string{metadata.AttributeState.User, metadata.AttributeState.System, metadata.AttributeState.Idle}
+			require.Equal(t, len(states), utilization.Gauge().DataPoints().Len())
+			for i, state := range states {
+				internal.AssertGaugeMetricHasAttributeValue(t, utilization, i, metadata.Attributes.State, pdata.NewAttributeValueString(state))
+				assert.InDelta(t, test.expectedUtilization[i], utilization.Gauge().DataPoints().At(i).DoubleVal(), 1e-9)
+			}
+		})
+	}
+
+	scraper := newCPUScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings(), RespectCgroupLimits: true})
+	scraper.cgroupCPUStats = func() (*cgroup.CPUStats, error) { return nil, errors.New("err1") }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	_, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "error reading cgroup cpu stats: err1")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
 func assertCPUMetricValid(t *testing.T, metric pdata.Metric, startTime pdata.Timestamp) {
 	expected := pdata.NewMetric()
 	expected.SetName("system.cpu.time")
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package dotnetclrscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/dotnetclrscraper"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// scraper is not implemented on the other operating systems,
+// the factory only creates it on Windows.
+type scraper struct{}
+
+func newDotNetCLRScraper(context.Context, *Config) *scraper {
+	return &scraper{}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	return nil
+}
+
+func (s *scraper) scrape(context.Context) (pdata.Metrics, error) {
+	return pdata.NewMetrics(), nil
+}
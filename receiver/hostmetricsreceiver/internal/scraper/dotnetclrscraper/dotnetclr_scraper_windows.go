@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package dotnetclrscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/dotnetclrscraper"
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/dotnetclrscraper/internal/metadata"
+)
+
+const (
+	memoryMetricsLen     = 2
+	exceptionsMetricsLen = 1
+
+	clrMemory     = ".NET CLR Memory"
+	clrExceptions = ".NET CLR Exceptions"
+
+	// globalInstanceName is the instance aggregating all the .NET processes
+	globalInstanceName = "_Global_"
+
+	gen0Collections  = "# Gen 0 Collections"
+	gen1Collections  = "# Gen 1 Collections"
+	gen2Collections  = "# Gen 2 Collections"
+	bytesInAllHeaps  = "# Bytes in all Heaps"
+	exceptionsThrown = "# of Exceps Thrown"
+)
+
+// scraper for .NET CLR Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+
+	perfCounterScraper perfcounters.PerfCounterScraper
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newDotNetCLRScraper creates a .NET CLR Scraper
+func newDotNetCLRScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, perfCounterScraper: &perfcounters.PerfLibScraper{}, bootTime: host.BootTime}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return s.perfCounterScraper.Initialize(clrMemory, clrExceptions)
+}
+
+func (s *scraper) scrape(context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+
+	var errors scrapererror.ScrapeErrors
+
+	counters, err := s.perfCounterScraper.Scrape()
+	if err != nil {
+		errors.AddPartial(memoryMetricsLen+exceptionsMetricsLen, err)
+		return md, errors.Combine()
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	err = s.scrapeMemory(now, counters)
+	if err != nil {
+		errors.AddPartial(memoryMetricsLen, err)
+	}
+
+	err = s.scrapeExceptions(now, counters)
+	if err != nil {
+		errors.AddPartial(exceptionsMetricsLen, err)
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+// scrapeMemory records the garbage collections and the heap size of each .NET process.
+func (s *scraper) scrapeMemory(now pdata.Timestamp, counters perfcounters.PerfDataCollection) error {
+	memoryObject, err := counters.GetObject(clrMemory)
+	if err != nil {
+		return err
+	}
+
+	memoryCounterValues, err := memoryObject.GetValues(gen0Collections, gen1Collections, gen2Collections, bytesInAllHeaps)
+	if err != nil {
+		return err
+	}
+
+	for _, process := range memoryCounterValues {
+		if process.InstanceName == globalInstanceName {
+			continue
+		}
+		s.mb.RecordDotnetGcCollectionsDataPoint(now, process.Values[gen0Collections], process.InstanceName, metadata.AttributeGeneration.Gen0)
+		s.mb.RecordDotnetGcCollectionsDataPoint(now, process.Values[gen1Collections], process.InstanceName, metadata.AttributeGeneration.Gen1)
+		s.mb.RecordDotnetGcCollectionsDataPoint(now, process.Values[gen2Collections], process.InstanceName, metadata.AttributeGeneration.Gen2)
+		s.mb.RecordDotnetGcHeapSizeDataPoint(now, process.Values[bytesInAllHeaps], process.InstanceName)
+	}
+
+	return nil
+}
+
+// scrapeExceptions records the exceptions thrown by each .NET process.
+func (s *scraper) scrapeExceptions(now pdata.Timestamp, counters perfcounters.PerfDataCollection) error {
+	exceptionsObject, err := counters.GetObject(clrExceptions)
+	if err != nil {
+		return err
+	}
+
+	exceptionsCounterValues, err := exceptionsObject.GetValues(exceptionsThrown)
+	if err != nil {
+		return err
+	}
+
+	for _, process := range exceptionsCounterValues {
+		if process.InstanceName == globalInstanceName {
+			continue
+		}
+		s.mb.RecordDotnetExceptionsDataPoint(now, process.Values[exceptionsThrown], process.InstanceName)
+	}
+
+	return nil
+}
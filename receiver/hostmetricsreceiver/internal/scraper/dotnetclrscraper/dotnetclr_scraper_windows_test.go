@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package dotnetclrscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/dotnetclrscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	scraper := newDotNetCLRScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+	scraper.perfCounterScraper = perfcounters.NewMockPerfCounterScraper(map[string]map[string][]int64{
+		clrMemory: {
+			gen0Collections: {10},
+			gen1Collections: {5},
+			gen2Collections: {1},
+			bytesInAllHeaps: {4096},
+		},
+		clrExceptions: {
+			exceptionsThrown: {2},
+		},
+	})
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize .NET CLR scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, memoryMetricsLen+exceptionsMetricsLen, metrics.Len())
+
+	values := map[string]int64{}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			assert.Equal(t, pdata.Timestamp(100*1e9), dp.StartTimestamp())
+			key := metric.Name()
+			if generation, ok := dp.Attributes().Get(metadata.Attributes.Generation); ok {
+				key += "/" + generation.StringVal()
+			}
+			values[key] = dp.IntVal()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"dotnet.gc.collections/gen0": 10,
+		"dotnet.gc.collections/gen1": 5,
+		"dotnet.gc.collections/gen2": 1,
+		"dotnet.gc.heap.size":        4096,
+		"dotnet.exceptions":          2,
+	}, values)
+}
+
+func TestScrape_Errors(t *testing.T) {
+	testCases := []struct {
+		name             string
+		scrapeErr        error
+		getObjectErr     error
+		getValuesErr     error
+		expectedErr      string
+		expectedErrCount int
+	}{
+		{
+			name:             "scrapeError",
+			scrapeErr:        errors.New("err1"),
+			expectedErr:      "err1",
+			expectedErrCount: memoryMetricsLen + exceptionsMetricsLen,
+		},
+		{
+			name:             "getObjectErr",
+			getObjectErr:     errors.New("err1"),
+			expectedErr:      "[err1; err1]",
+			expectedErrCount: memoryMetricsLen + exceptionsMetricsLen,
+		},
+		{
+			name:             "getValuesErr",
+			getValuesErr:     errors.New("err1"),
+			expectedErr:      "[err1; err1]",
+			expectedErrCount: memoryMetricsLen + exceptionsMetricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper := newDotNetCLRScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+			scraper.perfCounterScraper = perfcounters.NewMockPerfCounterScraperError(test.scrapeErr, test.getObjectErr, test.getValuesErr)
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize .NET CLR scraper: %v", err)
+
+			_, err = scraper.scrape(context.Background())
+			assert.EqualError(t, err, test.expectedErr)
+
+			isPartial := scrapererror.IsPartialScrapeError(err)
+			assert.True(t, isPartial)
+			if isPartial {
+				assert.Equal(t, test.expectedErrCount, err.(scrapererror.PartialScrapeError).Failed)
+			}
+		})
+	}
+}
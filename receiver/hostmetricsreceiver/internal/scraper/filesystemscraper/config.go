@@ -43,6 +43,25 @@ type Config struct {
 	IncludeMountPoints MountPointMatchConfig `mapstructure:"include_mount_points"`
 	// ExcludeMountPoints specifies a filter on the mount points that should be excluded from the generated metrics.
 	ExcludeMountPoints MountPointMatchConfig `mapstructure:"exclude_mount_points"`
+
+	// ExcludeVirtual excludes the virtual and pseudo filesystems, such as tmpfs, overlay or squashfs, in
+	// addition to the filesystem types excluded by ExcludeFSTypes.
+	ExcludeVirtual bool `mapstructure:"exclude_virtual"`
+
+	// AddDeviceUUID adds the UUID of the filesystem device as a resource attribute, reporting each
+	// filesystem in its own resource.
+	AddDeviceUUID bool `mapstructure:"add_device_uuid"`
+	// AddDeviceLabel adds the label of the filesystem device as a resource attribute, reporting each
+	// filesystem in its own resource.
+	AddDeviceLabel bool `mapstructure:"add_device_label"`
+}
+
+// virtualFSTypes are the filesystem types excluded by ExcludeVirtual.
+var virtualFSTypes = []string{
+	"aufs", "autofs", "binfmt_misc", "bpf", "cgroup", "cgroup2", "configfs", "debugfs", "devfs", "devpts",
+	"devtmpfs", "efivarfs", "fdescfs", "fuse.lxcfs", "fusectl", "hugetlbfs", "mqueue", "nsfs", "nullfs",
+	"overlay", "proc", "procfs", "pstore", "ramfs", "rpc_pipefs", "securityfs", "selinuxfs", "shm", "squashfs",
+	"sysfs", "tmpfs", "tracefs",
 }
 
 type DeviceMatchConfig struct {
@@ -70,6 +89,7 @@ type fsFilter struct {
 	excludeFSTypeFilter     filterset.FilterSet
 	includeMountPointFilter filterset.FilterSet
 	excludeMountPointFilter filterset.FilterSet
+	excludeVirtualFilter    filterset.FilterSet
 	filtersExist            bool
 }
 
@@ -107,13 +127,20 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 		return nil, err
 	}
 
+	if cfg.ExcludeVirtual {
+		filter.excludeVirtualFilter, err = filterset.CreateFilterSet(virtualFSTypes, &filterset.Config{MatchType: filterset.Strict})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	filter.setFiltersExist()
 	return &filter, nil
 }
 
 func (f *fsFilter) setFiltersExist() {
 	f.filtersExist = f.includeMountPointFilter != nil || f.excludeMountPointFilter != nil ||
-		f.includeFSTypeFilter != nil || f.excludeFSTypeFilter != nil ||
+		f.includeFSTypeFilter != nil || f.excludeFSTypeFilter != nil || f.excludeVirtualFilter != nil ||
 		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil
 }
 
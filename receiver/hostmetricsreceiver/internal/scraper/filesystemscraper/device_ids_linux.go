@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package filesystemscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+const deviceIDsSupported = true
+
+func getDeviceIDs(partitions []disk.PartitionStat) (map[string]deviceIDs, error) {
+	return readDeviceIDs(hostDev(), partitions)
+}
+
+func hostDev() string {
+	if dev := os.Getenv("HOST_DEV"); dev != "" {
+		return dev
+	}
+	return "/dev"
+}
+
+// readDeviceIDs returns the UUID and label of the partitions devices, from the symbolic links to the
+// block devices maintained by udev in the disk/by-uuid and disk/by-label directories of devPath.
+func readDeviceIDs(devPath string, partitions []disk.PartitionStat) (map[string]deviceIDs, error) {
+	uuids, err := readDeviceLinks(filepath.Join(devPath, "disk", "by-uuid"))
+	if err != nil {
+		return nil, err
+	}
+	labels, err := readDeviceLinks(filepath.Join(devPath, "disk", "by-label"))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]deviceIDs, len(partitions))
+	for _, partition := range partitions {
+		blockDevice := resolveBlockDevice(devPath, partition.Device)
+		id := deviceIDs{uuid: uuids[blockDevice], label: labels[blockDevice]}
+		if id.uuid != "" || id.label != "" {
+			ids[partition.Device] = id
+		}
+	}
+	return ids, nil
+}
+
+// readDeviceLinks maps the names of the block devices to the names of the links in dir pointing to them.
+func readDeviceLinks(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		// udev does not create the directory when no device has a UUID or a label.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		target, err := os.Readlink(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		links[filepath.Base(target)] = unescapeDeviceLink(entry.Name())
+	}
+	return links, nil
+}
+
+// resolveBlockDevice returns the name of the block device of a partition device, following the
+// symbolic links such as the /dev/mapper ones.
+func resolveBlockDevice(devPath, device string) string {
+	if strings.HasPrefix(device, "/dev/") {
+		resolved, err := filepath.EvalSymlinks(filepath.Join(devPath, strings.TrimPrefix(device, "/dev/")))
+		if err == nil {
+			return filepath.Base(resolved)
+		}
+	}
+	return filepath.Base(device)
+}
+
+// unescapeDeviceLink decodes the \xHH sequences udev uses to escape the characters, such as the
+// spaces and slashes, that are not allowed in the links names.
+func unescapeDeviceLink(name string) string {
+	if !strings.Contains(name, `\x`) {
+		return name
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+3 < len(name) && name[i+1] == 'x' {
+			if c, err := strconv.ParseUint(name[i+2:i+4], 16, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(name[i])
+	}
+	return b.String()
+}
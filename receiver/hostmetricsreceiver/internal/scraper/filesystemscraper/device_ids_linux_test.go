@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package filesystemscraper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDeviceIDs(t *testing.T) {
+	devPath := t.TempDir()
+	for _, dir := range []string{"disk/by-uuid", "disk/by-label", "mapper"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(devPath, dir), 0700))
+	}
+	for _, device := range []string{"sda1", "sdb1", "dm-0"} {
+		require.NoError(t, os.WriteFile(filepath.Join(devPath, device), nil, 0600))
+	}
+	links := map[string]string{
+		"disk/by-uuid/3e6be9de-8139-11d1-9106-a43f08d823a6": "../../sda1",
+		"disk/by-uuid/5a2f-1c9e":                            "../../sdb1",
+		"disk/by-uuid/b2c4a1f0-5e2d-4c7b-9a3e-8f1d2c3b4a5e": "../../dm-0",
+		`disk/by-label/data\x20disk`:                        "../../sdb1",
+		"mapper/vg-root":                                    "../dm-0",
+	}
+	for link, target := range links {
+		require.NoError(t, os.Symlink(target, filepath.Join(devPath, link)))
+	}
+
+	ids, err := readDeviceIDs(devPath, []disk.PartitionStat{
+		{Device: "/dev/sda1"},
+		{Device: "/dev/sdb1"},
+		{Device: "/dev/mapper/vg-root"},
+		{Device: "/dev/sdc1"},
+		{Device: "tmpfs"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]deviceIDs{
+		"/dev/sda1":           {uuid: "3e6be9de-8139-11d1-9106-a43f08d823a6"},
+		"/dev/sdb1":           {uuid: "5a2f-1c9e", label: "data disk"},
+		"/dev/mapper/vg-root": {uuid: "b2c4a1f0-5e2d-4c7b-9a3e-8f1d2c3b4a5e"},
+	}, ids)
+}
+
+func TestReadDeviceIDsWithoutLinks(t *testing.T) {
+	ids, err := readDeviceIDs(t.TempDir(), []disk.PartitionStat{{Device: "/dev/sda1"}})
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestUnescapeDeviceLink(t *testing.T) {
+	assert.Equal(t, "data", unescapeDeviceLink("data"))
+	assert.Equal(t, "data disk/1", unescapeDeviceLink(`data\x20disk\x2f1`))
+	assert.Equal(t, `data\x2`, unescapeDeviceLink(`data\x2`))
+	assert.Equal(t, `data\xzz`, unescapeDeviceLink(`data\xzz`))
+}
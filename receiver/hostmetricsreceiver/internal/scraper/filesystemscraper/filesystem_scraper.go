@@ -16,6 +16,7 @@ package filesystemscraper // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/disk"
@@ -30,6 +31,9 @@ import (
 const (
 	standardMetricsLen = 1
 	metricsLen         = standardMetricsLen + systemSpecificMetricsLen
+
+	deviceUUIDAttribute  = "device.uuid"
+	deviceLabelAttribute = "device.label"
 )
 
 // scraper for FileSystem Metrics
@@ -42,6 +46,7 @@ type scraper struct {
 	bootTime   func() (uint64, error)
 	partitions func(bool) ([]disk.PartitionStat, error)
 	usage      func(string) (*disk.UsageStat, error)
+	deviceIDs  func([]disk.PartitionStat) (map[string]deviceIDs, error)
 }
 
 type deviceUsage struct {
@@ -49,6 +54,12 @@ type deviceUsage struct {
 	usage     *disk.UsageStat
 }
 
+// deviceIDs identifies the device of a filesystem.
+type deviceIDs struct {
+	uuid  string
+	label string
+}
+
 // newFileSystemScraper creates a FileSystem Scraper
 func newFileSystemScraper(_ context.Context, cfg *Config) (*scraper, error) {
 	fsFilter, err := cfg.createFilter()
@@ -56,7 +67,11 @@ func newFileSystemScraper(_ context.Context, cfg *Config) (*scraper, error) {
 		return nil, err
 	}
 
-	scraper := &scraper{config: cfg, bootTime: host.BootTime, partitions: disk.Partitions, usage: disk.Usage, fsFilter: *fsFilter}
+	if (cfg.AddDeviceUUID || cfg.AddDeviceLabel) && !deviceIDsSupported {
+		return nil, errors.New("add_device_uuid and add_device_label are not supported on this platform")
+	}
+
+	scraper := &scraper{config: cfg, bootTime: host.BootTime, partitions: disk.Partitions, usage: disk.Usage, deviceIDs: getDeviceIDs, fsFilter: *fsFilter}
 	return scraper, nil
 }
 
@@ -82,7 +97,7 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		return md, scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
-	var errors scrapererror.ScrapeErrors
+	var scrapeErrors scrapererror.ScrapeErrors
 	usages := make([]*deviceUsage, 0, len(partitions))
 	for _, partition := range partitions {
 		if !s.fsFilter.includePartition(partition) {
@@ -90,21 +105,26 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		}
 		usage, usageErr := s.usage(partition.Mountpoint)
 		if usageErr != nil {
-			errors.AddPartial(0, usageErr)
+			scrapeErrors.AddPartial(0, usageErr)
 			continue
 		}
 
 		usages = append(usages, &deviceUsage{partition, usage})
 	}
 
-	if len(usages) > 0 {
+	if len(usages) > 0 && (s.config.AddDeviceUUID || s.config.AddDeviceLabel) {
+		err = s.recordPerDeviceMetrics(md, now, usages)
+		if err != nil {
+			scrapeErrors.AddPartial(0, err)
+		}
+	} else if len(usages) > 0 {
 		metrics.EnsureCapacity(metricsLen)
 		s.recordFileSystemUsageMetric(now, usages)
 		s.recordSystemSpecificMetrics(now, usages)
 		s.mb.Emit(metrics)
 	}
 
-	err = errors.Combine()
+	err = scrapeErrors.Combine()
 	if err != nil && len(usages) == 0 {
 		err = scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
@@ -112,6 +132,41 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	return md, err
 }
 
+// recordPerDeviceMetrics reports the metrics of each filesystem in its own resource, identified by
+// the UUID and label of its device.
+func (s *scraper) recordPerDeviceMetrics(md pdata.Metrics, now pdata.Timestamp, usages []*deviceUsage) error {
+	partitions := make([]disk.PartitionStat, 0, len(usages))
+	for _, usage := range usages {
+		partitions = append(partitions, usage.partition)
+	}
+	// The metrics are still reported without the device attributes when they cannot be read.
+	ids, err := s.deviceIDs(partitions)
+
+	for i, usage := range usages {
+		// The first resource, created by scrape, is the one of the first filesystem.
+		rm := md.ResourceMetrics().At(0)
+		metrics := rm.InstrumentationLibraryMetrics().At(0).Metrics()
+		if i > 0 {
+			rm = md.ResourceMetrics().AppendEmpty()
+			metrics = rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+		}
+
+		id := ids[usage.partition.Device]
+		if s.config.AddDeviceUUID && id.uuid != "" {
+			rm.Resource().Attributes().UpsertString(deviceUUIDAttribute, id.uuid)
+		}
+		if s.config.AddDeviceLabel && id.label != "" {
+			rm.Resource().Attributes().UpsertString(deviceLabelAttribute, id.label)
+		}
+
+		metrics.EnsureCapacity(metricsLen)
+		s.recordFileSystemUsageMetric(now, []*deviceUsage{usage})
+		s.recordSystemSpecificMetrics(now, []*deviceUsage{usage})
+		s.mb.Emit(metrics)
+	}
+	return err
+}
+
 func getMountMode(opts []string) string {
 	if exists(opts, "rw") {
 		return "rw"
@@ -147,7 +202,8 @@ func (f *fsFilter) includeDevice(deviceName string) bool {
 
 func (f *fsFilter) includeFSType(fsType string) bool {
 	return (f.includeFSTypeFilter == nil || f.includeFSTypeFilter.Matches(fsType)) &&
-		(f.excludeFSTypeFilter == nil || !f.excludeFSTypeFilter.Matches(fsType))
+		(f.excludeFSTypeFilter == nil || !f.excludeFSTypeFilter.Matches(fsType)) &&
+		(f.excludeVirtualFilter == nil || !f.excludeVirtualFilter.Matches(fsType))
 }
 
 func (f *fsFilter) includeMountPoint(mountPoint string) bool {
@@ -146,6 +146,46 @@ func TestScrape(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Exclude virtual filesystems and regexp mount points",
+			config: Config{
+				Metrics:        metadata.DefaultMetricsSettings(),
+				ExcludeVirtual: true,
+				ExcludeMountPoints: MountPointMatchConfig{
+					Config: filterset.Config{
+						MatchType: filterset.Regexp,
+					},
+					MountPoints: []string{"^/var/lib/docker/.+"},
+				},
+			},
+			usageFunc: func(s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"},
+					{Device: "tmpfs", Mountpoint: "/run", Fstype: "tmpfs"},
+					{Device: "/dev/loop0", Mountpoint: "/snap/core/1", Fstype: "squashfs"},
+					{Device: "/dev/sdb1", Mountpoint: "/var/lib/docker", Fstype: "xfs"},
+					{Device: "overlay", Mountpoint: "/var/lib/docker/overlay2/0/merged", Fstype: "overlay"},
+					{Device: "/dev/sdc1", Mountpoint: "/var/lib/docker/volumes", Fstype: "ext4"},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 2,
+			expectedDeviceAttributes: []map[string]pdata.AttributeValue{
+				{
+					"device":     pdata.NewAttributeValueString("/dev/sda1"),
+					"mountpoint": pdata.NewAttributeValueString("/"),
+					"type":       pdata.NewAttributeValueString("ext4"),
+				},
+				{
+					"device":     pdata.NewAttributeValueString("/dev/sdb1"),
+					"mountpoint": pdata.NewAttributeValueString("/var/lib/docker"),
+					"type":       pdata.NewAttributeValueString("xfs"),
+				},
+			},
+		},
 		{
 			name: "Invalid Include Device Filter",
 			config: Config{
@@ -281,6 +321,91 @@ func TestScrape(t *testing.T) {
 	}
 }
 
+func TestScrapeDeviceIDs(t *testing.T) {
+	if !deviceIDsSupported {
+		t.Skip("devices UUID and label are not supported on this platform")
+	}
+
+	scraper, err := newFileSystemScraper(context.Background(), &Config{
+		Metrics:        metadata.DefaultMetricsSettings(),
+		AddDeviceUUID:  true,
+		AddDeviceLabel: true,
+	})
+	require.NoError(t, err, "Failed to create file system scraper: %v", err)
+
+	scraper.partitions = func(bool) ([]disk.PartitionStat, error) {
+		return []disk.PartitionStat{
+			{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"},
+			{Device: "/dev/sdb1", Mountpoint: "/data", Fstype: "xfs"},
+			{Device: "/dev/sdc1", Mountpoint: "/backup", Fstype: "ext4"},
+		}, nil
+	}
+	scraper.usage = func(string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{}, nil
+	}
+	scraper.deviceIDs = func([]disk.PartitionStat) (map[string]deviceIDs, error) {
+		return map[string]deviceIDs{
+			"/dev/sda1": {uuid: "0a1b2c3d-0000-4000-8000-000000000001"},
+			"/dev/sdb1": {uuid: "0a1b2c3d-0000-4000-8000-000000000002", label: "data disk"},
+		}, nil
+	}
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	rms := md.ResourceMetrics()
+	require.Equal(t, 3, rms.Len())
+	assert.Equal(t, map[string]interface{}{
+		"device.uuid": "0a1b2c3d-0000-4000-8000-000000000001",
+	}, rms.At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"device.uuid":  "0a1b2c3d-0000-4000-8000-000000000002",
+		"device.label": "data disk",
+	}, rms.At(1).Resource().Attributes().AsRaw())
+	assert.Equal(t, 0, rms.At(2).Resource().Attributes().Len())
+
+	for i, mountPoint := range []string{"/", "/data", "/backup"} {
+		metrics := rms.At(i).InstrumentationLibraryMetrics().At(0).Metrics()
+		m, err := findMetricByName(metrics, "system.filesystem.usage")
+		require.NoError(t, err)
+		assertFileSystemUsageMetricValid(t, m, fileSystemStatesLen, []map[string]pdata.AttributeValue{
+			{"mountpoint": pdata.NewAttributeValueString(mountPoint)},
+		})
+	}
+}
+
+func TestScrapeDeviceIDsError(t *testing.T) {
+	if !deviceIDsSupported {
+		t.Skip("devices UUID and label are not supported on this platform")
+	}
+
+	scraper, err := newFileSystemScraper(context.Background(), &Config{
+		Metrics:       metadata.DefaultMetricsSettings(),
+		AddDeviceUUID: true,
+	})
+	require.NoError(t, err, "Failed to create file system scraper: %v", err)
+
+	scraper.partitions = func(bool) ([]disk.PartitionStat, error) {
+		return []disk.PartitionStat{{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"}}, nil
+	}
+	scraper.usage = func(string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{}, nil
+	}
+	scraper.deviceIDs = func([]disk.PartitionStat) (map[string]deviceIDs, error) {
+		return nil, errors.New("err1")
+	}
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, 0, md.ResourceMetrics().At(0).Resource().Attributes().Len())
+	assert.GreaterOrEqual(t, md.MetricCount(), 1)
+}
+
 func findMetricByName(metrics pdata.MetricSlice, name string) (pdata.Metric, error) {
 	for i := 0; i < metrics.Len(); i++ {
 		if metrics.At(i).Name() == name {
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	vendorNvidia = "nvidia"
+	vendorAMD    = "amd"
+
+	// amdVendorID is the PCI vendor ID of the AMD GPUs.
+	amdVendorID = "0x1002"
+)
+
+// nvidiaSMIQuery are the fields queried from nvidia-smi, in the order of the
+// CSV columns of its output.
+var nvidiaSMIQuery = []string{
+	"index",
+	"uuid",
+	"name",
+	"utilization.gpu",
+	"memory.used",
+	"memory.free",
+	"temperature.gpu",
+	"power.draw",
+}
+
+// drmCardRegexp matches the DRM cards, and not their connectors such as card0-DP-1.
+var drmCardRegexp = regexp.MustCompile(`^card\d+$`)
+
+// device holds the identity and the readings of a GPU. The readings the GPU
+// does not support are nil.
+type device struct {
+	vendor string
+	id     string
+	uuid   string
+	model  string
+
+	utilization *float64
+	memoryUsed  *int64
+	memoryFree  *int64
+	temperature *float64
+	power       *float64
+}
+
+// hostSys returns the path of sysfs, honoring the HOST_SYS environment
+// variable used when running in a container.
+func hostSys() string {
+	if path := os.Getenv("HOST_SYS"); path != "" {
+		return path
+	}
+	return "/sys"
+}
+
+// runNvidiaSMI runs nvidia-smi with the given arguments and returns its output.
+// A missing nvidia-smi executable is reported with exec.ErrNotFound.
+func runNvidiaSMI(ctx context.Context, path string, args ...string) ([]byte, error) {
+	out, err := exec.CommandContext(ctx, path, args...).Output()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("nvidia-smi timed out: %w", ctx.Err())
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		// nvidia-smi reports its errors, e.g. a driver not loaded, on stdout.
+		return nil, fmt.Errorf("nvidia-smi failed (exit status %d): %s", exitErr.ExitCode(), strings.TrimSpace(string(out)))
+	}
+	return out, err
+}
+
+// parseNvidiaSMIOutput parses the CSV output of nvidia-smi run with the
+// nvidiaSMIQuery fields, without header nor units.
+func parseNvidiaSMIOutput(out []byte) ([]device, error) {
+	r := csv.NewReader(strings.NewReader(string(out)))
+	r.FieldsPerRecord = len(nvidiaSMIQuery)
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse nvidia-smi output: %w", err)
+	}
+
+	devices := make([]device, 0, len(records))
+	for _, record := range records {
+		d := device{
+			vendor: vendorNvidia,
+			id:     record[0],
+			uuid:   record[1],
+			model:  record[2],
+		}
+		if utilization, ok := parseNvidiaSMIValue(record[3]); ok {
+			utilization /= 100
+			d.utilization = &utilization
+		}
+		// The memory is reported in MiB.
+		if used, ok := parseNvidiaSMIValue(record[4]); ok {
+			bytes := int64(used) << 20
+			d.memoryUsed = &bytes
+		}
+		if free, ok := parseNvidiaSMIValue(record[5]); ok {
+			bytes := int64(free) << 20
+			d.memoryFree = &bytes
+		}
+		if temperature, ok := parseNvidiaSMIValue(record[6]); ok {
+			d.temperature = &temperature
+		}
+		if power, ok := parseNvidiaSMIValue(record[7]); ok {
+			d.power = &power
+		}
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
+
+// parseNvidiaSMIValue parses a numeric nvidia-smi value. The values the GPU
+// does not support are reported as [N/A] or [Not Supported].
+func parseNvidiaSMIValue(value string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return v, err == nil
+}
+
+// readAMDDevices reads the AMD GPUs from the amdgpu driver sysfs files of the
+// DRM cards. No devices are returned when there are no DRM cards.
+func readAMDDevices(sysPath string) ([]device, error) {
+	drmPath := filepath.Join(sysPath, "class", "drm")
+	entries, err := ioutil.ReadDir(drmPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []device
+	for _, entry := range entries {
+		if !drmCardRegexp.MatchString(entry.Name()) {
+			continue
+		}
+		devicePath := filepath.Join(drmPath, entry.Name(), "device")
+		if vendor, err := readString(devicePath, "vendor"); err != nil || vendor != amdVendorID {
+			continue
+		}
+		devices = append(devices, readAMDDevice(entry.Name(), devicePath))
+	}
+	return devices, nil
+}
+
+func readAMDDevice(card, devicePath string) device {
+	d := device{vendor: vendorAMD, id: card}
+	d.uuid, _ = readString(devicePath, "unique_id")
+	d.model, _ = readString(devicePath, "product_name")
+
+	if busy, err := readInt(devicePath, "gpu_busy_percent"); err == nil {
+		utilization := float64(busy) / 100
+		d.utilization = &utilization
+	}
+	used, usedErr := readInt(devicePath, "mem_info_vram_used")
+	total, totalErr := readInt(devicePath, "mem_info_vram_total")
+	if usedErr == nil && totalErr == nil {
+		free := total - used
+		d.memoryUsed = &used
+		d.memoryFree = &free
+	}
+
+	hwmons, _ := filepath.Glob(filepath.Join(devicePath, "hwmon", "hwmon*"))
+	if len(hwmons) == 0 {
+		return d
+	}
+	// The temperature is reported in millidegrees Celsius.
+	if millidegrees, err := readInt(hwmons[0], "temp1_input"); err == nil {
+		temperature := float64(millidegrees) / 1e3
+		d.temperature = &temperature
+	}
+	// The power is reported in microwatts, averaged by most GPUs and
+	// instantaneous for the most recent ones.
+	microwatts, err := readInt(hwmons[0], "power1_average")
+	if err != nil {
+		microwatts, err = readInt(hwmons[0], "power1_input")
+	}
+	if err == nil {
+		power := float64(microwatts) / 1e6
+		d.power = &power
+	}
+	return d
+}
+
+func readString(dir, name string) (string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func readInt(dir, name string) (int64, error) {
+	content, err := readString(dir, name)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(content, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s: %w", name, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const deviceMetricsLen = 4
+
+// Resource attributes identifying the GPU of the scraped metrics.
+const (
+	gpuVendorAttribute = "gpu.vendor"
+	gpuIDAttribute     = "gpu.id"
+	gpuUUIDAttribute   = "gpu.uuid"
+	gpuModelAttribute  = "gpu.model"
+)
+
+// scraper for GPU Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+
+	// for mocking
+	nvidiaSMI func(ctx context.Context, path string, args ...string) ([]byte, error)
+	sysPath   string
+}
+
+// newGPUScraper creates a GPU Scraper
+func newGPUScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, nvidiaSMI: runNvidiaSMI, sysPath: hostSys()}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics)
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	var errors scrapererror.ScrapeErrors
+
+	nvidiaDevices, err := s.nvidiaDevices(ctx)
+	if err != nil {
+		errors.AddPartial(deviceMetricsLen, err)
+	}
+	amdDevices, err := readAMDDevices(s.sysPath)
+	if err != nil {
+		errors.AddPartial(deviceMetricsLen, err)
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	for _, d := range append(nvidiaDevices, amdDevices...) {
+		rm := md.ResourceMetrics().AppendEmpty()
+		d.setResourceAttributes(rm.Resource().Attributes())
+		s.recordDeviceMetrics(now, d)
+		s.mb.Emit(rm.InstrumentationLibraryMetrics().AppendEmpty().Metrics())
+	}
+
+	return md, errors.Combine()
+}
+
+// nvidiaDevices returns the NVIDIA GPUs reported by nvidia-smi, or none when
+// nvidia-smi is not installed.
+func (s *scraper) nvidiaDevices(ctx context.Context) ([]device, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	out, err := s.nvidiaSMI(ctx, s.config.NvidiaSMIPath,
+		"--query-gpu="+strings.Join(nvidiaSMIQuery, ","), "--format=csv,noheader,nounits")
+	if errors.Is(err, exec.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseNvidiaSMIOutput(out)
+}
+
+func (s *scraper) recordDeviceMetrics(now pdata.Timestamp, d device) {
+	if d.utilization != nil {
+		s.mb.RecordSystemGpuUtilizationDataPoint(now, *d.utilization)
+	}
+	if d.memoryUsed != nil {
+		s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *d.memoryUsed, metadata.AttributeState.Used)
+	}
+	if d.memoryFree != nil {
+		s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *d.memoryFree, metadata.AttributeState.Free)
+	}
+	if d.temperature != nil {
+		s.mb.RecordSystemGpuTemperatureDataPoint(now, *d.temperature)
+	}
+	if d.power != nil {
+		s.mb.RecordSystemGpuPowerUsageDataPoint(now, *d.power)
+	}
+}
+
+func (d device) setResourceAttributes(attrs pdata.AttributeMap) {
+	attrs.InsertString(gpuVendorAttribute, d.vendor)
+	attrs.InsertString(gpuIDAttribute, d.id)
+	if d.uuid != "" {
+		attrs.InsertString(gpuUUIDAttribute, d.uuid)
+	}
+	if d.model != "" {
+		attrs.InsertString(gpuModelAttribute, d.model)
+	}
+}
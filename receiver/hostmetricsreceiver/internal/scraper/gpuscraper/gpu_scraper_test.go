@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuscraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+func fakeNvidiaSMI(context.Context, string, ...string) ([]byte, error) {
+	return os.ReadFile(filepath.Join("testdata", "nvidia-smi.csv"))
+}
+
+func missingNvidiaSMI(_ context.Context, path string, _ ...string) ([]byte, error) {
+	return nil, &exec.Error{Name: path, Err: exec.ErrNotFound}
+}
+
+func newTestScraper(t *testing.T, nvidiaSMI func(context.Context, string, ...string) ([]byte, error), sysPath string) *scraper {
+	scraper := newGPUScraper(context.Background(), &Config{NvidiaSMIPath: "nvidia-smi", Timeout: time.Second, Metrics: metadata.DefaultMetricsSettings()})
+	scraper.nvidiaSMI = nvidiaSMI
+	scraper.sysPath = sysPath
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScrape(t *testing.T) {
+	scraper := newTestScraper(t, fakeNvidiaSMI, filepath.Join("testdata", "sys"))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	rms := md.ResourceMetrics()
+	require.Equal(t, 3, rms.Len())
+	assert.Equal(t, map[string]interface{}{
+		gpuVendorAttribute: "nvidia",
+		gpuIDAttribute:     "0",
+		gpuUUIDAttribute:   "GPU-8a4f2d6e-1c3b-4f0e-9d2a-5b7c6e8f9a01",
+		gpuModelAttribute:  "NVIDIA A100-SXM4-40GB",
+	}, rms.At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		gpuVendorAttribute: "amd",
+		gpuIDAttribute:     "card0",
+		gpuUUIDAttribute:   "9f2b5e3c1a7d4086",
+	}, rms.At(2).Resource().Attributes().AsRaw())
+
+	expected := []map[string]float64{
+		{
+			"system.gpu.utilization":       0.35,
+			"system.gpu.memory.usage used": 1024 << 20,
+			"system.gpu.memory.usage free": 39512 << 20,
+			"system.gpu.temperature":       41,
+			"system.gpu.power.usage":       63.12,
+		},
+		{
+			"system.gpu.utilization":       0,
+			"system.gpu.memory.usage used": 0,
+			"system.gpu.memory.usage free": 40536 << 20,
+			"system.gpu.temperature":       33,
+		},
+		{
+			"system.gpu.utilization":       0.62,
+			"system.gpu.memory.usage used": 4294967296,
+			"system.gpu.memory.usage free": 12868124672,
+			"system.gpu.temperature":       54,
+			"system.gpu.power.usage":       118,
+		},
+	}
+	for i, values := range expected {
+		metrics := rms.At(i).InstrumentationLibraryMetrics().At(0).Metrics()
+		assert.Equal(t, values, metricValues(metrics), fmt.Sprintf("device %d", i))
+		internal.AssertSameTimeStampForAllMetrics(t, metrics)
+	}
+}
+
+func TestScrapeWithoutGPU(t *testing.T) {
+	scraper := newTestScraper(t, missingNvidiaSMI, filepath.Join("testdata", "missing"))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestScrapePartialError(t *testing.T) {
+	scraper := newTestScraper(t, func(context.Context, string, ...string) ([]byte, error) {
+		return nil, errors.New("nvidia-smi failed (exit status 9): NVIDIA-SMI has failed because it couldn't communicate with the NVIDIA driver")
+	}, filepath.Join("testdata", "sys"))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, deviceMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, 4, md.MetricCount())
+}
+
+func TestParseNvidiaSMIOutputInvalid(t *testing.T) {
+	_, err := parseNvidiaSMIOutput([]byte("0, GPU-8a4f2d6e-1c3b-4f0e-9d2a-5b7c6e8f9a01, 35\n"))
+	assert.Error(t, err)
+}
+
+func TestRunNvidiaSMINotFound(t *testing.T) {
+	_, err := runNvidiaSMI(context.Background(), "nvidia-smi-not-installed")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, exec.ErrNotFound)
+}
+
+func metricValues(metrics pdata.MetricSlice) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dps pdata.NumberDataPointSlice
+		if metric.DataType() == pdata.MetricDataTypeSum {
+			dps = metric.Sum().DataPoints()
+		} else {
+			dps = metric.Gauge().DataPoints()
+		}
+		for j := 0; j < dps.Len(); j++ {
+			key := metric.Name()
+			if state, ok := dps.At(j).Attributes().Get(metadata.A.State); ok {
+				key += " " + state.StringVal()
+			}
+			if dps.At(j).ValueType() == pdata.MetricValueTypeInt {
+				values[key] = float64(dps.At(j).IntVal())
+			} else {
+				values[key] = dps.At(j).DoubleVal()
+			}
+		}
+	}
+	return values
+}
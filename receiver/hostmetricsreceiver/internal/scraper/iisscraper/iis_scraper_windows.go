@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package iisscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/iisscraper"
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/iisscraper/internal/metadata"
+)
+
+const (
+	iisMetricsLen = 3
+
+	httpServiceRequestQueues = "HTTP Service Request Queues"
+
+	currentQueueSize = "CurrentQueueSize"
+	maxQueueItemAge  = "MaxQueueItemAge"
+	rejectedRequests = "RejectedRequests"
+)
+
+// scraper for IIS Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+
+	perfCounterScraper perfcounters.PerfCounterScraper
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newIISScraper creates an IIS Scraper
+func newIISScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, perfCounterScraper: &perfcounters.PerfLibScraper{}, bootTime: host.BootTime}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return s.perfCounterScraper.Initialize(httpServiceRequestQueues)
+}
+
+func (s *scraper) scrape(context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+
+	var errors scrapererror.ScrapeErrors
+
+	err := s.scrapeRequestQueues()
+	if err != nil {
+		errors.AddPartial(iisMetricsLen, err)
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+// scrapeRequestQueues records the counters of the request queue of each application pool.
+func (s *scraper) scrapeRequestQueues() error {
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	counters, err := s.perfCounterScraper.Scrape()
+	if err != nil {
+		return err
+	}
+
+	queuesObject, err := counters.GetObject(httpServiceRequestQueues)
+	if err != nil {
+		return err
+	}
+
+	// only report the application pools, not their total
+	queuesObject.Filter(nil, nil, false)
+	queueCounterValues, err := queuesObject.GetValues(currentQueueSize, maxQueueItemAge, rejectedRequests)
+	if err != nil {
+		return err
+	}
+
+	for _, queue := range queueCounterValues {
+		s.mb.RecordIisRequestQueueCountDataPoint(now, queue.Values[currentQueueSize], queue.InstanceName)
+		s.mb.RecordIisRequestQueueAgeMaxDataPoint(now, queue.Values[maxQueueItemAge], queue.InstanceName)
+		s.mb.RecordIisRequestRejectedDataPoint(now, queue.Values[rejectedRequests], queue.InstanceName)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package iisscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/perfcounters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/iisscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	scraper := newIISScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+	scraper.perfCounterScraper = perfcounters.NewMockPerfCounterScraper(map[string]map[string][]int64{
+		httpServiceRequestQueues: {
+			currentQueueSize: {3},
+			maxQueueItemAge:  {250},
+			rejectedRequests: {7},
+		},
+	})
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize iis scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, iisMetricsLen, metrics.Len())
+
+	values := map[string]int64{}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dp pdata.NumberDataPoint
+		if metric.DataType() == pdata.MetricDataTypeGauge {
+			dp = metric.Gauge().DataPoints().At(0)
+		} else {
+			dp = metric.Sum().DataPoints().At(0)
+			assert.Equal(t, pdata.Timestamp(100*1e9), dp.StartTimestamp())
+		}
+		values[metric.Name()] = dp.IntVal()
+	}
+	assert.Equal(t, map[string]int64{
+		"iis.request.queue.count":   3,
+		"iis.request.queue.age.max": 250,
+		"iis.request.rejected":      7,
+	}, values)
+}
+
+func TestScrape_Errors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		scrapeErr    error
+		getObjectErr error
+		getValuesErr error
+	}{
+		{name: "scrapeError", scrapeErr: errors.New("err1")},
+		{name: "getObjectErr", getObjectErr: errors.New("err1")},
+		{name: "getValuesErr", getValuesErr: errors.New("err1")},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper := newIISScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+			scraper.perfCounterScraper = perfcounters.NewMockPerfCounterScraperError(test.scrapeErr, test.getObjectErr, test.getValuesErr)
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize iis scraper: %v", err)
+
+			_, err = scraper.scrape(context.Background())
+			assert.EqualError(t, err, "err1")
+
+			isPartial := scrapererror.IsPartialScrapeError(err)
+			assert.True(t, isPartial)
+			if isPartial {
+				assert.Equal(t, iisMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+			}
+		})
+	}
+}
@@ -23,4 +23,9 @@ import (
 type Config struct {
 	internal.ConfigSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 	Metrics                 metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// RespectCgroupLimits reports the memory usage of the cgroup of the collector against its
+	// memory limit, instead of the memory usage of the host, so that the reported utilization
+	// reflects the allocation of the container the collector runs in. Only supported on Linux.
+	RespectCgroupLimits bool `mapstructure:"respect_cgroup_limits"`
 }
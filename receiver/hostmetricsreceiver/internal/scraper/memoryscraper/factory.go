@@ -16,6 +16,8 @@ package memoryscraper // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"fmt"
+	"runtime"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
@@ -49,6 +51,9 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraperhelper.Scraper, error) {
 	cfg := config.(*Config)
+	if cfg.RespectCgroupLimits && runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("respect_cgroup_limits is not supported on %s", runtime.GOOS)
+	}
 	s := newMemoryScraper(ctx, cfg)
 
 	return scraperhelper.NewScraper(
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
@@ -39,13 +40,22 @@ type scraper struct {
 	mb     *metadata.MetricsBuilder
 
 	// for mocking gopsutil mem.VirtualMemory
-	bootTime      func() (uint64, error)
-	virtualMemory func() (*mem.VirtualMemoryStat, error)
+	bootTime          func() (uint64, error)
+	virtualMemory     func() (*mem.VirtualMemoryStat, error)
+	cgroupMemoryStats func() (*cgroup.MemoryStats, error)
 }
 
 // newMemoryScraper creates a Memory Scraper
 func newMemoryScraper(_ context.Context, cfg *Config) *scraper {
-	return &scraper{config: cfg, bootTime: host.BootTime, virtualMemory: mem.VirtualMemory}
+	return &scraper{config: cfg, bootTime: host.BootTime, virtualMemory: mem.VirtualMemory, cgroupMemoryStats: readCgroupMemoryStats}
+}
+
+func readCgroupMemoryStats() (*cgroup.MemoryStats, error) {
+	c, err := cgroup.Self(cgroup.Root())
+	if err != nil {
+		return nil, err
+	}
+	return c.MemoryStats()
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
@@ -63,6 +73,12 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
 
 	now := pdata.NewTimestampFromTime(time.Now())
+	if s.config.RespectCgroupLimits {
+		err := s.scrapeCgroupMemoryMetrics(now)
+		s.mb.Emit(metrics)
+		return md, err
+	}
+
 	memInfo, err := s.virtualMemory()
 	if err != nil {
 		return md, scrapererror.NewPartialScrapeError(err, metricsLen)
@@ -79,3 +95,44 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	s.mb.Emit(metrics)
 	return md, nil
 }
+
+// scrapeCgroupMemoryMetrics records the memory usage of the cgroup of the collector against
+// its memory limit, or against the memory of the host when the cgroup is not limited.
+func (s *scraper) scrapeCgroupMemoryMetrics(now pdata.Timestamp) error {
+	stats, err := s.cgroupMemoryStats()
+	if err != nil {
+		return scrapererror.NewPartialScrapeError(fmt.Errorf("error reading cgroup memory stats: %w", err), metricsLen)
+	}
+
+	limit := stats.Limit
+	if limit == 0 {
+		memInfo, err := s.virtualMemory()
+		if err != nil {
+			return scrapererror.NewPartialScrapeError(err, metricsLen)
+		}
+		limit = memInfo.Total
+	}
+
+	// the inactive page cache is reclaimed before the cgroup runs out of memory, so it
+	// is reported as cached rather than used.
+	cached := stats.InactiveFile
+	if cached > stats.Usage {
+		cached = stats.Usage
+	}
+	used := stats.Usage - cached
+	var free uint64
+	if limit > stats.Usage {
+		free = limit - stats.Usage
+	}
+
+	s.mb.RecordSystemMemoryUsageDataPoint(now, int64(used), metadata.AttributeState.Used)
+	s.mb.RecordSystemMemoryUsageDataPoint(now, int64(free), metadata.AttributeState.Free)
+	s.mb.RecordSystemMemoryUsageDataPoint(now, int64(cached), metadata.AttributeState.Cached)
+	if limit == 0 {
+		return scrapererror.NewPartialScrapeError(fmt.Errorf("%w: %d", ErrInvalidTotalMem, limit), metricsLen)
+	}
+	s.mb.RecordSystemMemoryUtilizationDataPoint(now, float64(used)/float64(limit), metadata.AttributeState.Used)
+	s.mb.RecordSystemMemoryUtilizationDataPoint(now, float64(free)/float64(limit), metadata.AttributeState.Free)
+	s.mb.RecordSystemMemoryUtilizationDataPoint(now, float64(cached)/float64(limit), metadata.AttributeState.Cached)
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/cgroup"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
@@ -185,6 +186,80 @@ func TestScrape_MemoryUtilization(t *testing.T) {
 	}
 }
 
+func TestScrape_CgroupLimits(t *testing.T) {
+	type testCase struct {
+		name                string
+		cgroupMemoryStats   func() (*cgroup.MemoryStats, error)
+		expectedUsage       []int64
+		expectedUtilization []float64
+		expectedErr         string
+	}
+	testCases := []testCase{
+		{
+			name: "Limited",
+			cgroupMemoryStats: func() (*cgroup.MemoryStats, error) {
+				return &cgroup.MemoryStats{Usage: 256 << 20, InactiveFile: 64 << 20, Limit: 512 << 20}, nil
+			},
+			expectedUsage:       []int64{192 << 20, 256 << 20, 64 << 20},
+			expectedUtilization: []float64{0.375, 0.5, 0.125},
+		},
+		{
+			name: "Unlimited",
+			cgroupMemoryStats: func() (*cgroup.MemoryStats, error) {
+				return &cgroup.MemoryStats{Usage: 256 << 20, InactiveFile: 64 << 20}, nil
+			},
+			expectedUsage:       []int64{192 << 20, 768 << 20, 64 << 20},
+			expectedUtilization: []float64{0.1875, 0.75, 0.0625},
+		},
+		{
+			name:              "Error",
+			cgroupMemoryStats: func() (*cgroup.MemoryStats, error) { return nil, errors.New("err1") },
+			expectedErr:       "error reading cgroup memory stats: err1",
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraperConfig := Config{
+				Metrics: metadata.MetricsSettings{
+					SystemMemoryUsage:       metadata.MetricSettings{Enabled: true},
+					SystemMemoryUtilization: metadata.MetricSettings{Enabled: true},
+				},
+				RespectCgroupLimits: true,
+			}
+			scraper := newMemoryScraper(context.Background(), &scraperConfig)
+			scraper.cgroupMemoryStats = test.cgroupMemoryStats
+			scraper.virtualMemory = func() (*mem.VirtualMemoryStat, error) { return &mem.VirtualMemoryStat{Total: 1 << 30}, nil }
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize memory scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				assert.True(t, scrapererror.IsPartialScrapeError(err))
+				return
+			}
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			require.Equal(t, 2, metrics.Len())
+			states := []string{metadata.AttributeState.Used, metadata.AttributeState.Free, metadata.AttributeState.Cached}
+			usage := metrics.At(0)
+			assert.Equal(t, "system.memory.usage", usage.Name())
+			require.Equal(t, len(states), usage.Sum().DataPoints().Len())
+			utilization := metrics.At(1)
+			assert.Equal(t, "system.memory.utilization", utilization.Name())
+			require.Equal(t, len(states), utilization.Gauge().DataPoints().Len())
+			for i, state := range states {
+				internal.AssertSumMetricHasAttributeValue(t, usage, i, metadata.Attributes.State, pdata.NewAttributeValueString(state))
+				assert.Equal(t, test.expectedUsage[i], usage.Sum().DataPoints().At(i).IntVal())
+				internal.AssertGaugeMetricHasAttributeValue(t, utilization, i, metadata.Attributes.State, pdata.NewAttributeValueString(state))
+				assert.InDelta(t, test.expectedUtilization[i], utilization.Gauge().DataPoints().At(i).DoubleVal(), 1e-9)
+			}
+		})
+	}
+}
+
 func assertMemoryUsageMetricValid(t *testing.T, metric pdata.Metric, expectedName string) {
 	assert.Equal(t, expectedName, metric.Name())
 	assert.GreaterOrEqual(t, metric.Sum().DataPoints().Len(), 2)
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstatscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/netstatscraper"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// hostProc returns the path of procfs, honoring the HOST_PROC environment
+// variable used by the other scrapers when running in a container.
+func hostProc() string {
+	if path := os.Getenv("HOST_PROC"); path != "" {
+		return path
+	}
+	return "/proc"
+}
+
+// readUint parses a procfs file holding a single unsigned integer, such as
+// sys/net/netfilter/nf_conntrack_count.
+func readUint(procPath, name string) (uint64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(procPath, name))
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s: %w", name, err)
+	}
+	return value, nil
+}
+
+// readSockstat parses a socket statistics file such as net/sockstat, made of
+// lines like "TCP: inuse 27 orphan 1 tw 4 alloc 31 mem 6", into the values of
+// each field per lower cased protocol.
+func readSockstat(procPath, name string) (map[string]map[string]int64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(procPath, name))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]map[string]int64)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields)%2 != 1 || !strings.HasSuffix(fields[0], ":") {
+			return nil, fmt.Errorf("unexpected %s line %q", name, line)
+		}
+
+		protocol := strings.ToLower(strings.TrimSuffix(fields[0], ":"))
+		values := make(map[string]int64, len(fields)/2)
+		for i := 1; i < len(fields); i += 2 {
+			v, err := strconv.ParseInt(fields[i+1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q of %q in %s: %w", fields[i], protocol, name, err)
+			}
+			values[fields[i]] = v
+		}
+		stats[protocol] = values
+	}
+	return stats, nil
+}
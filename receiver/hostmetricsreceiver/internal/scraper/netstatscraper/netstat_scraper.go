@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstatscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/netstatscraper"
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/netstatscraper/internal/metadata"
+)
+
+const (
+	conntrackMetricsLen = 3
+	socketsMetricsLen   = 3
+
+	conntrackCountFile = "sys/net/netfilter/nf_conntrack_count"
+	conntrackMaxFile   = "sys/net/netfilter/nf_conntrack_max"
+	sockstatFile       = "net/sockstat"
+	sockstat6File      = "net/sockstat6"
+)
+
+// sockstatStates maps the sockstat fields to the state attribute values.
+var sockstatStates = map[string]string{
+	"inuse":  metadata.AttributeState.InUse,
+	"orphan": metadata.AttributeState.Orphan,
+	"tw":     metadata.AttributeState.TimeWait,
+	"alloc":  metadata.AttributeState.Allocated,
+}
+
+// scraper for netstat Metrics
+type scraper struct {
+	config   *Config
+	mb       *metadata.MetricsBuilder
+	procPath string
+	pageSize int64
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newNetstatScraper creates a netstat Scraper
+func newNetstatScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, procPath: hostProc(), pageSize: int64(os.Getpagesize()), bootTime: host.BootTime}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, metadata.WithStartTime(pdata.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	var errors scrapererror.ScrapeErrors
+
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	if err := s.recordConntrackMetrics(now); err != nil {
+		errors.AddPartial(conntrackMetricsLen, err)
+	}
+
+	if err := s.recordSocketsMetrics(now); err != nil {
+		errors.AddPartial(socketsMetricsLen, err)
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+func (s *scraper) recordConntrackMetrics(now pdata.Timestamp) error {
+	count, err := readUint(s.procPath, conntrackCountFile)
+	if os.IsNotExist(err) {
+		// The nf_conntrack module is not loaded, there is no connection tracking.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.mb.RecordSystemConntrackCountDataPoint(now, int64(count))
+
+	limit, err := readUint(s.procPath, conntrackMaxFile)
+	if err != nil {
+		return err
+	}
+	s.mb.RecordSystemConntrackMaxDataPoint(now, int64(limit))
+	if limit > 0 {
+		s.mb.RecordSystemConntrackUtilizationDataPoint(now, float64(count)/float64(limit))
+	}
+	return nil
+}
+
+func (s *scraper) recordSocketsMetrics(now pdata.Timestamp) error {
+	stats, err := readSockstat(s.procPath, sockstatFile)
+	if err != nil {
+		return err
+	}
+	// sockstat6 is missing when IPv6 is disabled.
+	stats6, err := readSockstat(s.procPath, sockstat6File)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for protocol, values := range stats6 {
+		stats[protocol] = values
+	}
+
+	for protocol, values := range stats {
+		if protocol == "sockets" {
+			s.mb.RecordSystemSocketsUsedDataPoint(now, values["used"])
+			continue
+		}
+		for field, value := range values {
+			if state, ok := sockstatStates[field]; ok {
+				s.mb.RecordSystemSocketsCountDataPoint(now, value, protocol, state)
+			}
+		}
+		// mem is reported in pages, while the memory of the fragment queues is in bytes.
+		if pages, ok := values["mem"]; ok {
+			s.mb.RecordSystemSocketsMemoryDataPoint(now, pages*s.pageSize, protocol)
+		}
+		if bytes, ok := values["memory"]; ok {
+			s.mb.RecordSystemSocketsMemoryDataPoint(now, bytes, protocol)
+		}
+	}
+	return nil
+}
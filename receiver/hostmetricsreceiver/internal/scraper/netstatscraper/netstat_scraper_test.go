@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstatscraper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/netstatscraper/internal/metadata"
+)
+
+const (
+	bootTime = 100
+	pageSize = 4096
+)
+
+func TestScrape(t *testing.T) {
+	allMetrics := metadata.DefaultMetricsSettings()
+	allMetrics.SystemConntrackUtilization.Enabled = true
+
+	scraper := newNetstatScraper(context.Background(), &Config{Metrics: allMetrics})
+	scraper.procPath = "testdata/proc"
+	scraper.pageSize = pageSize
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize netstat scraper: %v", err)
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]float64{
+		"system.conntrack.count":                1024,
+		"system.conntrack.max":                  262144,
+		"system.conntrack.utilization":          1024.0 / 262144,
+		"system.sockets.used":                   290,
+		"system.sockets.count{tcp,in_use}":      27,
+		"system.sockets.count{tcp,orphan}":      1,
+		"system.sockets.count{tcp,time_wait}":   4,
+		"system.sockets.count{tcp,allocated}":   31,
+		"system.sockets.count{udp,in_use}":      12,
+		"system.sockets.count{udplite,in_use}":  0,
+		"system.sockets.count{raw,in_use}":      0,
+		"system.sockets.count{frag,in_use}":     0,
+		"system.sockets.count{tcp6,in_use}":     6,
+		"system.sockets.count{udp6,in_use}":     5,
+		"system.sockets.count{udplite6,in_use}": 0,
+		"system.sockets.count{raw6,in_use}":     1,
+		"system.sockets.count{frag6,in_use}":    0,
+		"system.sockets.memory{tcp}":            6 * pageSize,
+		"system.sockets.memory{udp}":            3 * pageSize,
+		"system.sockets.memory{frag}":           0,
+		"system.sockets.memory{frag6}":          0,
+	}, metricValues(t, metrics))
+	internal.AssertSameTimeStampForAllMetrics(t, metrics)
+}
+
+func TestScrapeWithoutConntrackAndIPv6(t *testing.T) {
+	scraper := newNetstatScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.procPath = "testdata/proc_ipv4"
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	values := metricValues(t, md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics())
+	assert.NotContains(t, values, "system.conntrack.count")
+	assert.NotContains(t, values, "system.sockets.count{tcp6,in_use}")
+	assert.Equal(t, float64(27), values["system.sockets.count{tcp,in_use}"])
+	assert.Equal(t, float64(290), values["system.sockets.used"])
+}
+
+func TestScrapePartialError(t *testing.T) {
+	scraper := newNetstatScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.bootTime = func() (uint64, error) { return bootTime, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	scraper.procPath = "testdata/missing"
+	_, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, socketsMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+}
+
+func TestReadSockstatInvalid(t *testing.T) {
+	_, err := readSockstat("testdata/proc_invalid", sockstatFile)
+	assert.EqualError(t, err, `unexpected net/sockstat line "TCP: inuse"`)
+}
+
+// metricValues returns the values of the data points keyed by the metric
+// name followed by the attribute values.
+func metricValues(t *testing.T, metrics pdata.MetricSlice) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dps pdata.NumberDataPointSlice
+		switch metric.DataType() {
+		case pdata.MetricDataTypeGauge:
+			dps = metric.Gauge().DataPoints()
+		case pdata.MetricDataTypeSum:
+			dps = metric.Sum().DataPoints()
+		}
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			assert.Equal(t, pdata.Timestamp(bootTime*1e9), dp.StartTimestamp())
+			key := metric.Name()
+			if protocol, ok := dp.Attributes().Get(metadata.A.Protocol); ok {
+				key += "{" + protocol.StringVal()
+				if state, ok := dp.Attributes().Get(metadata.A.State); ok {
+					key += "," + state.StringVal()
+				}
+				key += "}"
+			}
+			require.NotContains(t, values, key, fmt.Sprintf("duplicate data point %s", key))
+			switch dp.ValueType() {
+			case pdata.MetricValueTypeInt:
+				values[key] = float64(dp.IntVal())
+			case pdata.MetricValueTypeDouble:
+				values[key] = dp.DoubleVal()
+			}
+		}
+	}
+	return values
+}
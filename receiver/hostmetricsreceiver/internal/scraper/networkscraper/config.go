@@ -28,6 +28,8 @@ type Config struct {
 	Include MatchConfig `mapstructure:"include"`
 	// Exclude specifies a filter on the network interfaces that should be excluded from the generated metrics.
 	Exclude MatchConfig `mapstructure:"exclude"`
+	// Protocols lists the protocols, tcp or udp, for which statistics are reported.
+	Protocols []string `mapstructure:"protocols"`
 }
 
 type MatchConfig struct {
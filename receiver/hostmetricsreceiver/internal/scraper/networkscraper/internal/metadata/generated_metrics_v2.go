@@ -15,11 +15,15 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for network metrics.
 type MetricsSettings struct {
-	SystemNetworkConnections MetricSettings `mapstructure:"system.network.connections"`
-	SystemNetworkDropped     MetricSettings `mapstructure:"system.network.dropped"`
-	SystemNetworkErrors      MetricSettings `mapstructure:"system.network.errors"`
-	SystemNetworkIo          MetricSettings `mapstructure:"system.network.io"`
-	SystemNetworkPackets     MetricSettings `mapstructure:"system.network.packets"`
+	SystemNetworkConnections        MetricSettings `mapstructure:"system.network.connections"`
+	SystemNetworkDropped            MetricSettings `mapstructure:"system.network.dropped"`
+	SystemNetworkErrors             MetricSettings `mapstructure:"system.network.errors"`
+	SystemNetworkIo                 MetricSettings `mapstructure:"system.network.io"`
+	SystemNetworkPackets            MetricSettings `mapstructure:"system.network.packets"`
+	SystemNetworkTCPListenOverflows MetricSettings `mapstructure:"system.network.tcp.listen_overflows"`
+	SystemNetworkTCPRetransmits     MetricSettings `mapstructure:"system.network.tcp.retransmits"`
+	SystemNetworkTCPSegments        MetricSettings `mapstructure:"system.network.tcp.segments"`
+	SystemNetworkUDPDatagrams       MetricSettings `mapstructure:"system.network.udp.datagrams"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -39,6 +43,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		SystemNetworkPackets: MetricSettings{
 			Enabled: true,
 		},
+		SystemNetworkTCPListenOverflows: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkTCPRetransmits: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkTCPSegments: MetricSettings{
+			Enabled: true,
+		},
+		SystemNetworkUDPDatagrams: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -312,15 +328,227 @@ func newMetricSystemNetworkPackets(settings MetricSettings) metricSystemNetworkP
 	return m
 }
 
+type metricSystemNetworkTCPListenOverflows struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.listen_overflows metric with initial data.
+func (m *metricSystemNetworkTCPListenOverflows) init() {
+	m.data.SetName("system.network.tcp.listen_overflows")
+	m.data.SetDescription("The number of times the accept queue of a listening TCP socket overflowed, reported on Linux when tcp is in the protocols.")
+	m.data.SetUnit("{overflows}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNetworkTCPListenOverflows) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTCPListenOverflows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTCPListenOverflows) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTCPListenOverflows(settings MetricSettings) metricSystemNetworkTCPListenOverflows {
+	m := metricSystemNetworkTCPListenOverflows{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkTCPRetransmits struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.retransmits metric with initial data.
+func (m *metricSystemNetworkTCPRetransmits) init() {
+	m.data.SetName("system.network.tcp.retransmits")
+	m.data.SetDescription("The number of TCP segments retransmitted, reported when tcp is in the protocols.")
+	m.data.SetUnit("{segments}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricSystemNetworkTCPRetransmits) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTCPRetransmits) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTCPRetransmits) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTCPRetransmits(settings MetricSettings) metricSystemNetworkTCPRetransmits {
+	m := metricSystemNetworkTCPRetransmits{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkTCPSegments struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.tcp.segments metric with initial data.
+func (m *metricSystemNetworkTCPSegments) init() {
+	m.data.SetName("system.network.tcp.segments")
+	m.data.SetDescription("The number of TCP segments received and sent, reported when tcp is in the protocols.")
+	m.data.SetUnit("{segments}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkTCPSegments) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkTCPSegments) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkTCPSegments) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkTCPSegments(settings MetricSettings) metricSystemNetworkTCPSegments {
+	m := metricSystemNetworkTCPSegments{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemNetworkUDPDatagrams struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.network.udp.datagrams metric with initial data.
+func (m *metricSystemNetworkUDPDatagrams) init() {
+	m.data.SetName("system.network.udp.datagrams")
+	m.data.SetDescription("The number of UDP datagrams received and sent, reported when udp is in the protocols.")
+	m.data.SetUnit("{datagrams}")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemNetworkUDPDatagrams) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Direction, pdata.NewAttributeValueString(directionAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemNetworkUDPDatagrams) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemNetworkUDPDatagrams) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemNetworkUDPDatagrams(settings MetricSettings) metricSystemNetworkUDPDatagrams {
+	m := metricSystemNetworkUDPDatagrams{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                      pdata.Timestamp
-	metricSystemNetworkConnections metricSystemNetworkConnections
-	metricSystemNetworkDropped     metricSystemNetworkDropped
-	metricSystemNetworkErrors      metricSystemNetworkErrors
-	metricSystemNetworkIo          metricSystemNetworkIo
-	metricSystemNetworkPackets     metricSystemNetworkPackets
+	startTime                             pdata.Timestamp
+	metricSystemNetworkConnections        metricSystemNetworkConnections
+	metricSystemNetworkDropped            metricSystemNetworkDropped
+	metricSystemNetworkErrors             metricSystemNetworkErrors
+	metricSystemNetworkIo                 metricSystemNetworkIo
+	metricSystemNetworkPackets            metricSystemNetworkPackets
+	metricSystemNetworkTCPListenOverflows metricSystemNetworkTCPListenOverflows
+	metricSystemNetworkTCPRetransmits     metricSystemNetworkTCPRetransmits
+	metricSystemNetworkTCPSegments        metricSystemNetworkTCPSegments
+	metricSystemNetworkUDPDatagrams       metricSystemNetworkUDPDatagrams
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -335,12 +563,16 @@ func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                      pdata.NewTimestampFromTime(time.Now()),
-		metricSystemNetworkConnections: newMetricSystemNetworkConnections(settings.SystemNetworkConnections),
-		metricSystemNetworkDropped:     newMetricSystemNetworkDropped(settings.SystemNetworkDropped),
-		metricSystemNetworkErrors:      newMetricSystemNetworkErrors(settings.SystemNetworkErrors),
-		metricSystemNetworkIo:          newMetricSystemNetworkIo(settings.SystemNetworkIo),
-		metricSystemNetworkPackets:     newMetricSystemNetworkPackets(settings.SystemNetworkPackets),
+		startTime:                             pdata.NewTimestampFromTime(time.Now()),
+		metricSystemNetworkConnections:        newMetricSystemNetworkConnections(settings.SystemNetworkConnections),
+		metricSystemNetworkDropped:            newMetricSystemNetworkDropped(settings.SystemNetworkDropped),
+		metricSystemNetworkErrors:             newMetricSystemNetworkErrors(settings.SystemNetworkErrors),
+		metricSystemNetworkIo:                 newMetricSystemNetworkIo(settings.SystemNetworkIo),
+		metricSystemNetworkPackets:            newMetricSystemNetworkPackets(settings.SystemNetworkPackets),
+		metricSystemNetworkTCPListenOverflows: newMetricSystemNetworkTCPListenOverflows(settings.SystemNetworkTCPListenOverflows),
+		metricSystemNetworkTCPRetransmits:     newMetricSystemNetworkTCPRetransmits(settings.SystemNetworkTCPRetransmits),
+		metricSystemNetworkTCPSegments:        newMetricSystemNetworkTCPSegments(settings.SystemNetworkTCPSegments),
+		metricSystemNetworkUDPDatagrams:       newMetricSystemNetworkUDPDatagrams(settings.SystemNetworkUDPDatagrams),
 	}
 	for _, op := range options {
 		op(mb)
@@ -357,6 +589,10 @@ func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
 	mb.metricSystemNetworkErrors.emit(metrics)
 	mb.metricSystemNetworkIo.emit(metrics)
 	mb.metricSystemNetworkPackets.emit(metrics)
+	mb.metricSystemNetworkTCPListenOverflows.emit(metrics)
+	mb.metricSystemNetworkTCPRetransmits.emit(metrics)
+	mb.metricSystemNetworkTCPSegments.emit(metrics)
+	mb.metricSystemNetworkUDPDatagrams.emit(metrics)
 }
 
 // RecordSystemNetworkConnectionsDataPoint adds a data point to system.network.connections metric.
@@ -384,6 +620,26 @@ func (mb *MetricsBuilder) RecordSystemNetworkPacketsDataPoint(ts pdata.Timestamp
 	mb.metricSystemNetworkPackets.recordDataPoint(mb.startTime, ts, val, deviceAttributeValue, directionAttributeValue)
 }
 
+// RecordSystemNetworkTCPListenOverflowsDataPoint adds a data point to system.network.tcp.listen_overflows metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTCPListenOverflowsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNetworkTCPListenOverflows.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNetworkTCPRetransmitsDataPoint adds a data point to system.network.tcp.retransmits metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTCPRetransmitsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricSystemNetworkTCPRetransmits.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemNetworkTCPSegmentsDataPoint adds a data point to system.network.tcp.segments metric.
+func (mb *MetricsBuilder) RecordSystemNetworkTCPSegmentsDataPoint(ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	mb.metricSystemNetworkTCPSegments.recordDataPoint(mb.startTime, ts, val, directionAttributeValue)
+}
+
+// RecordSystemNetworkUDPDatagramsDataPoint adds a data point to system.network.udp.datagrams metric.
+func (mb *MetricsBuilder) RecordSystemNetworkUDPDatagramsDataPoint(ts pdata.Timestamp, val int64, directionAttributeValue string) {
+	mb.metricSystemNetworkUDPDatagrams.recordDataPoint(mb.startTime, ts, val, directionAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
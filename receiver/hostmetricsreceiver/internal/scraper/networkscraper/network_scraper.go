@@ -16,6 +16,7 @@ package networkscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -32,6 +33,8 @@ import (
 const (
 	networkMetricsLen     = 4
 	connectionsMetricsLen = 1
+	tcpMetricsLen         = 3
+	udpMetricsLen         = 1
 )
 
 // scraper for Network Metrics
@@ -41,19 +44,36 @@ type scraper struct {
 	startTime pdata.Timestamp
 	includeFS filterset.FilterSet
 	excludeFS filterset.FilterSet
+	tcp       bool
+	udp       bool
 
 	// for mocking
-	bootTime    func() (uint64, error)
-	ioCounters  func(bool) ([]net.IOCountersStat, error)
-	connections func(string) ([]net.ConnectionStat, error)
+	bootTime      func() (uint64, error)
+	ioCounters    func(bool) ([]net.IOCountersStat, error)
+	connections   func(string) ([]net.ConnectionStat, error)
+	protocolStats func() (protocolStats, error)
 }
 
 // newNetworkScraper creates a set of Network related metrics
 func newNetworkScraper(_ context.Context, cfg *Config) (*scraper, error) {
-	scraper := &scraper{config: cfg, bootTime: host.BootTime, ioCounters: net.IOCounters, connections: net.Connections}
+	scraper := &scraper{config: cfg, bootTime: host.BootTime, ioCounters: net.IOCounters, connections: net.Connections, protocolStats: getProtocolStats}
 
 	var err error
 
+	for _, protocol := range cfg.Protocols {
+		switch protocol {
+		case protocolTCP:
+			scraper.tcp = true
+		case protocolUDP:
+			scraper.udp = true
+		default:
+			return nil, fmt.Errorf("unsupported protocol %q, must be %q or %q", protocol, protocolTCP, protocolUDP)
+		}
+	}
+	if len(cfg.Protocols) > 0 && !protocolStatsSupported {
+		return nil, errors.New("per protocol statistics are not supported on this platform")
+	}
+
 	if len(cfg.Include.Interfaces) > 0 {
 		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Interfaces, &cfg.Include.Config)
 		if err != nil {
@@ -96,6 +116,13 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	if err != nil {
 		errors.AddPartial(connectionsMetricsLen, err)
 	}
+
+	if s.tcp || s.udp {
+		err = s.recordProtocolMetrics()
+		if err != nil {
+			errors.AddPartial(s.protocolMetricsLen(), err)
+		}
+	}
 	s.mb.Emit(metrics)
 	return md, errors.Combine()
 }
@@ -187,6 +214,40 @@ func (s *scraper) recordNetworkConnectionsMetric(now pdata.Timestamp, connection
 	}
 }
 
+func (s *scraper) recordProtocolMetrics() error {
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	stats, err := s.protocolStats()
+	if err != nil {
+		return err
+	}
+
+	if s.tcp {
+		s.mb.RecordSystemNetworkTCPSegmentsDataPoint(now, stats.tcpInSegs, metadata.AttributeDirection.Receive)
+		s.mb.RecordSystemNetworkTCPSegmentsDataPoint(now, stats.tcpOutSegs, metadata.AttributeDirection.Transmit)
+		s.mb.RecordSystemNetworkTCPRetransmitsDataPoint(now, stats.tcpRetransSegs)
+		if stats.tcpListenOverflows != nil {
+			s.mb.RecordSystemNetworkTCPListenOverflowsDataPoint(now, *stats.tcpListenOverflows)
+		}
+	}
+	if s.udp {
+		s.mb.RecordSystemNetworkUDPDatagramsDataPoint(now, stats.udpInDatagrams, metadata.AttributeDirection.Receive)
+		s.mb.RecordSystemNetworkUDPDatagramsDataPoint(now, stats.udpOutDatagrams, metadata.AttributeDirection.Transmit)
+	}
+	return nil
+}
+
+func (s *scraper) protocolMetricsLen() int {
+	metricsLen := 0
+	if s.tcp {
+		metricsLen += tcpMetricsLen
+	}
+	if s.udp {
+		metricsLen += udpMetricsLen
+	}
+	return metricsLen
+}
+
 func (s *scraper) filterByInterface(ioCounters []net.IOCountersStat) []net.IOCountersStat {
 	if s.includeFS == nil && s.excludeFS == nil {
 		return ioCounters
@@ -187,3 +187,124 @@ func assertNetworkConnectionsMetricValid(t *testing.T, metric pdata.Metric) {
 	internal.AssertSumMetricHasAttribute(t, metric, 0, "state")
 	assert.Equal(t, 12, metric.Sum().DataPoints().Len())
 }
+
+func TestScrapeProtocolStats(t *testing.T) {
+	overflows := int64(17)
+	stats := protocolStats{
+		tcpInSegs:          100,
+		tcpOutSegs:         200,
+		tcpRetransSegs:     3,
+		tcpListenOverflows: &overflows,
+		udpInDatagrams:     40,
+		udpOutDatagrams:    50,
+	}
+
+	type testCase struct {
+		name              string
+		protocols         []string
+		protocolStatsFunc func() (protocolStats, error)
+		expectedMetrics   map[string]int
+		newErrRegex       string
+		expectedErr       string
+		expectedErrCount  int
+	}
+
+	testCases := []testCase{
+		{
+			name:      "TCP",
+			protocols: []string{"tcp"},
+			expectedMetrics: map[string]int{
+				"system.network.tcp.segments":         2,
+				"system.network.tcp.retransmits":      1,
+				"system.network.tcp.listen_overflows": 1,
+			},
+		},
+		{
+			name:      "UDP",
+			protocols: []string{"udp"},
+			expectedMetrics: map[string]int{
+				"system.network.udp.datagrams": 2,
+			},
+		},
+		{
+			name:      "TCP and UDP without listen overflows",
+			protocols: []string{"tcp", "udp"},
+			protocolStatsFunc: func() (protocolStats, error) {
+				withoutOverflows := stats
+				withoutOverflows.tcpListenOverflows = nil
+				return withoutOverflows, nil
+			},
+			expectedMetrics: map[string]int{
+				"system.network.tcp.segments":    2,
+				"system.network.tcp.retransmits": 1,
+				"system.network.udp.datagrams":   2,
+			},
+		},
+		{
+			name:        "Unsupported protocol",
+			protocols:   []string{"sctp"},
+			newErrRegex: `^unsupported protocol "sctp"`,
+		},
+		{
+			name:              "Protocol statistics error",
+			protocols:         []string{"tcp", "udp"},
+			protocolStatsFunc: func() (protocolStats, error) { return protocolStats{}, errors.New("err1") },
+			expectedErr:       "err1",
+			expectedErrCount:  tcpMetricsLen + udpMetricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			if !protocolStatsSupported && test.newErrRegex == "" {
+				t.Skip("per protocol statistics are not supported on this platform")
+			}
+
+			scraper, err := newNetworkScraper(context.Background(), &Config{
+				Metrics:   metadata.DefaultMetricsSettings(),
+				Protocols: test.protocols,
+			})
+			if test.newErrRegex != "" {
+				require.Error(t, err)
+				require.Regexp(t, test.newErrRegex, err)
+				return
+			}
+			require.NoError(t, err, "Failed to create network scraper: %v", err)
+
+			scraper.ioCounters = func(bool) ([]net.IOCountersStat, error) { return nil, nil }
+			scraper.connections = func(string) ([]net.ConnectionStat, error) { return nil, nil }
+			scraper.protocolStats = func() (protocolStats, error) { return stats, nil }
+			if test.protocolStatsFunc != nil {
+				scraper.protocolStats = test.protocolStatsFunc
+			}
+
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+
+				isPartial := scrapererror.IsPartialScrapeError(err)
+				assert.True(t, isPartial)
+				if isPartial {
+					assert.Equal(t, test.expectedErrCount, err.(scrapererror.PartialScrapeError).Failed)
+				}
+
+				return
+			}
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			dataPoints := map[string]int{}
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				if metric.Name() == "system.network.connections" {
+					continue
+				}
+				assert.True(t, metric.Sum().IsMonotonic())
+				dataPoints[metric.Name()] = metric.Sum().DataPoints().Len()
+			}
+			assert.Equal(t, test.expectedMetrics, dataPoints)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+
+const (
+	protocolTCP = "tcp"
+	protocolUDP = "udp"
+)
+
+// protocolStats holds the cumulative counters of the TCP and UDP protocols of
+// the host, all IP versions combined.
+type protocolStats struct {
+	tcpInSegs      int64
+	tcpOutSegs     int64
+	tcpRetransSegs int64
+	// tcpListenOverflows is nil when the platform does not report it.
+	tcpListenOverflows *int64
+
+	udpInDatagrams  int64
+	udpOutDatagrams int64
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const protocolStatsSupported = true
+
+func getProtocolStats() (protocolStats, error) {
+	return readProtocolStats(hostProc())
+}
+
+// hostProc returns the path of procfs, honoring the HOST_PROC environment
+// variable used when running in a container.
+func hostProc() string {
+	if path := os.Getenv("HOST_PROC"); path != "" {
+		return path
+	}
+	return "/proc"
+}
+
+// readProtocolStats reads the protocol counters from /proc/net/snmp, the UDP
+// over IPv6 counters from /proc/net/snmp6 and the TCP listen overflows from
+// /proc/net/netstat. The files of IPv6, when disabled, and of the TCP
+// extensions are optional.
+func readProtocolStats(procPath string) (protocolStats, error) {
+	var stats protocolStats
+
+	snmp, err := readProcNetStats(filepath.Join(procPath, "net", "snmp"))
+	if err != nil {
+		return stats, err
+	}
+	stats.tcpInSegs = snmp["Tcp"]["InSegs"]
+	stats.tcpOutSegs = snmp["Tcp"]["OutSegs"]
+	stats.tcpRetransSegs = snmp["Tcp"]["RetransSegs"]
+	stats.udpInDatagrams = snmp["Udp"]["InDatagrams"]
+	stats.udpOutDatagrams = snmp["Udp"]["OutDatagrams"]
+
+	snmp6, err := readProcNetSnmp6(filepath.Join(procPath, "net", "snmp6"))
+	if err != nil && !os.IsNotExist(err) {
+		return stats, err
+	}
+	stats.udpInDatagrams += snmp6["Udp6InDatagrams"]
+	stats.udpOutDatagrams += snmp6["Udp6OutDatagrams"]
+
+	netstat, err := readProcNetStats(filepath.Join(procPath, "net", "netstat"))
+	if err != nil && !os.IsNotExist(err) {
+		return stats, err
+	}
+	if overflows, ok := netstat["TcpExt"]["ListenOverflows"]; ok {
+		stats.tcpListenOverflows = &overflows
+	}
+	return stats, nil
+}
+
+// readProcNetStats reads a /proc/net/snmp like file, where each protocol has a
+// line with the names of its counters followed by a line with their values,
+// both prefixed by the protocol, e.g.:
+//
+//	Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens
+//	Tcp: 1 200 120000 -1 27040
+func readProcNetStats(path string) (map[string]map[string]int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stats := make(map[string]map[string]int64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		names := strings.Fields(scanner.Text())
+		if len(names) == 0 {
+			continue
+		}
+		if !scanner.Scan() {
+			return nil, fmt.Errorf("missing %s values in %s", names[0], path)
+		}
+		values := strings.Fields(scanner.Text())
+		if len(values) != len(names) || values[0] != names[0] {
+			return nil, fmt.Errorf("mismatched %s values in %s", names[0], path)
+		}
+
+		protocol := strings.TrimSuffix(names[0], ":")
+		counters := make(map[string]int64, len(names)-1)
+		for i := 1; i < len(names); i++ {
+			value, err := strconv.ParseInt(values[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %s value in %s: %w", protocol, names[i], path, err)
+			}
+			counters[names[i]] = value
+		}
+		stats[protocol] = counters
+	}
+	return stats, scanner.Err()
+}
+
+// readProcNetSnmp6 reads the /proc/net/snmp6 file, with one counter name and
+// value per line.
+func readProcNetSnmp6(path string) (map[string]int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stats := make(map[string]int64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value in %s: %w", fields[0], path, err)
+		}
+		stats[fields[0]] = value
+	}
+	return stats, scanner.Err()
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package networkscraper
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProtocolStats(t *testing.T) {
+	stats, err := readProtocolStats(filepath.Join("testdata", "proc"))
+	require.NoError(t, err)
+
+	overflows := int64(17)
+	assert.Equal(t, protocolStats{
+		tcpInSegs:          2395170,
+		tcpOutSegs:         2512830,
+		tcpRetransSegs:     1876,
+		tcpListenOverflows: &overflows,
+		udpInDatagrams:     21590 + 410,
+		udpOutDatagrams:    21688 + 312,
+	}, stats)
+}
+
+func TestReadProtocolStatsWithoutIPv6AndTCPExtensions(t *testing.T) {
+	stats, err := readProtocolStats(filepath.Join("testdata", "proc_ipv4_only"))
+	require.NoError(t, err)
+
+	assert.Equal(t, protocolStats{
+		tcpInSegs:       2395170,
+		tcpOutSegs:      2512830,
+		tcpRetransSegs:  1876,
+		udpInDatagrams:  21590,
+		udpOutDatagrams: 21688,
+	}, stats)
+}
+
+func TestReadProtocolStatsMissing(t *testing.T) {
+	_, err := readProtocolStats(filepath.Join("testdata", "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package networkscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const protocolStatsSupported = true
+
+var (
+	modIphlpapi = windows.NewLazySystemDLL("iphlpapi.dll")
+
+	procGetTCPStatisticsEx = modIphlpapi.NewProc("GetTcpStatisticsEx")
+	procGetUDPStatisticsEx = modIphlpapi.NewProc("GetUdpStatisticsEx")
+)
+
+// system type as defined in https://docs.microsoft.com/en-us/windows/win32/api/tcpmib/ns-tcpmib-mib_tcpstats_lh
+type mibTCPStats struct {
+	rtoAlgorithm uint32
+	rtoMin       uint32
+	rtoMax       uint32
+	maxConn      uint32
+	activeOpens  uint32
+	passiveOpens uint32
+	attemptFails uint32
+	estabResets  uint32
+	currEstab    uint32
+	inSegs       uint32
+	outSegs      uint32
+	retransSegs  uint32
+	inErrs       uint32
+	outRsts      uint32
+	numConns     uint32
+}
+
+// system type as defined in https://docs.microsoft.com/en-us/windows/win32/api/udpmib/ns-udpmib-mib_udpstats
+type mibUDPStats struct {
+	inDatagrams  uint32
+	noPorts      uint32
+	inErrors     uint32
+	outDatagrams uint32
+	numAddrs     uint32
+}
+
+// getProtocolStats sums the IPv4 and IPv6 statistics of the IP Helper API.
+// The counters are 32 bits, and wrap around on busy hosts. The TCP listen
+// overflows are not reported.
+func getProtocolStats() (protocolStats, error) {
+	var stats protocolStats
+	for _, family := range []uint32{windows.AF_INET, windows.AF_INET6} {
+		var tcp mibTCPStats
+		// see https://docs.microsoft.com/en-us/windows/win32/api/iphlpapi/nf-iphlpapi-gettcpstatisticsex
+		if result, _, _ := procGetTCPStatisticsEx.Call(uintptr(unsafe.Pointer(&tcp)), uintptr(family)); result != 0 {
+			return stats, syscall.Errno(result)
+		}
+		stats.tcpInSegs += int64(tcp.inSegs)
+		stats.tcpOutSegs += int64(tcp.outSegs)
+		stats.tcpRetransSegs += int64(tcp.retransSegs)
+
+		var udp mibUDPStats
+		// see https://docs.microsoft.com/en-us/windows/win32/api/iphlpapi/nf-iphlpapi-getudpstatisticsex
+		if result, _, _ := procGetUDPStatisticsEx.Call(uintptr(unsafe.Pointer(&udp)), uintptr(family)); result != 0 {
+			return stats, syscall.Errno(result)
+		}
+		stats.udpInDatagrams += int64(udp.inDatagrams)
+		stats.udpOutDatagrams += int64(udp.outDatagrams)
+	}
+	return stats, nil
+}
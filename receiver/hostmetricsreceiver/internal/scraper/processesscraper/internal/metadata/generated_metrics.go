@@ -43,6 +43,7 @@ func (m *metricImpl) Init(metric pdata.Metric) {
 type metricStruct struct {
 	SystemProcessesCount   MetricIntf
 	SystemProcessesCreated MetricIntf
+	SystemProcessesZombies MetricIntf
 }
 
 // Names returns a list of all the metric name strings.
@@ -50,12 +51,14 @@ func (m *metricStruct) Names() []string {
 	return []string{
 		"system.processes.count",
 		"system.processes.created",
+		"system.processes.zombies",
 	}
 }
 
 var metricsByName = map[string]MetricIntf{
 	"system.processes.count":   Metrics.SystemProcessesCount,
 	"system.processes.created": Metrics.SystemProcessesCreated,
+	"system.processes.zombies": Metrics.SystemProcessesZombies,
 }
 
 func (m *metricStruct) ByName(n string) MetricIntf {
@@ -87,6 +90,17 @@ var Metrics = &metricStruct{
 			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 		},
 	},
+	&metricImpl{
+		"system.processes.zombies",
+		func(metric pdata.Metric) {
+			metric.SetName("system.processes.zombies")
+			metric.SetDescription("Number of zombie processes, which have exited but have not been reaped by their parent.")
+			metric.SetUnit("{processes}")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(false)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
 }
 
 // M contains a set of methods for each metric that help with
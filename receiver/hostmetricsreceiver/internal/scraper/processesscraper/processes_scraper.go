@@ -36,6 +36,9 @@ var metricsLength = func() int {
 	if enableProcessesCreated {
 		n++
 	}
+	if enableProcessesZombies {
+		n++
+	}
 	return n
 }()
 
@@ -57,6 +60,7 @@ type proc interface {
 type processesMetadata struct {
 	countByStatus    map[string]int64 // ignored if enableProcessesCount is false
 	processesCreated *int64           // ignored if enableProcessesCreated is false
+	zombies          *int64           // ignored if enableProcessesZombies is false
 }
 
 // newProcessesScraper creates a set of Processes related metrics
@@ -105,6 +109,10 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		setProcessesCreatedMetric(metrics.AppendEmpty(), s.startTime, now, *processMetadata.processesCreated)
 	}
 
+	if enableProcessesZombies && processMetadata.zombies != nil {
+		setProcessesZombiesMetric(metrics.AppendEmpty(), s.startTime, now, *processMetadata.zombies)
+	}
+
 	return md, err
 }
 
@@ -131,3 +139,11 @@ func setProcessesCreatedMetric(metric pdata.Metric, startTime, now pdata.Timesta
 	ddp.SetTimestamp(now)
 	ddp.SetIntVal(value)
 }
+
+func setProcessesZombiesMetric(metric pdata.Metric, startTime, now pdata.Timestamp, value int64) {
+	metadata.Metrics.SystemProcessesZombies.Init(metric)
+	ddp := metric.Sum().DataPoints().AppendEmpty()
+	ddp.SetStartTimestamp(startTime)
+	ddp.SetTimestamp(now)
+	ddp.SetIntVal(value)
+}
@@ -19,6 +19,7 @@ package processesscraper // import "github.com/open-telemetry/opentelemetry-coll
 
 const enableProcessesCount = false
 const enableProcessesCreated = false
+const enableProcessesZombies = false
 
 func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	return processesMetadata{}, nil
@@ -34,6 +34,7 @@ import (
 var (
 	expectProcessesCountMetric   = runtime.GOOS == "linux" || runtime.GOOS == "openbsd" || runtime.GOOS == "darwin" || runtime.GOOS == "freebsd" || runtime.GOOS == "solaris"
 	expectProcessesCreatedMetric = runtime.GOOS == "linux" || runtime.GOOS == "openbsd"
+	expectProcessesZombiesMetric = runtime.GOOS == "linux" || runtime.GOOS == "openbsd" || runtime.GOOS == "darwin" || runtime.GOOS == "freebsd"
 )
 
 const startTime = 100 * 1e9
@@ -66,6 +67,11 @@ func TestScrape(t *testing.T) {
 	}, {
 		name:         "ErrorFromProcessShouldBeIgnored",
 		getProcesses: func() ([]proc, error) { return []proc{errProcess{}}, nil },
+	}, {
+		name:         "DetailedStatusesWithoutProcesses",
+		getMiscStats: func() (*load.MiscStat, error) { return &load.MiscStat{}, nil },
+		getProcesses: func() ([]proc, error) { return []proc{fakeProcess(process.Idle)}, nil },
+		validate:     validateDetailedStatuses,
 	}, {
 		name:     "Validate Start Time",
 		validate: validateStartTime,
@@ -96,8 +102,11 @@ func TestScrape(t *testing.T) {
 			if expectProcessesCreatedMetric {
 				expectedMetricCount++
 			}
+			if expectProcessesZombiesMetric {
+				expectedMetricCount++
+			}
 
-			if (expectProcessesCountMetric || expectProcessesCreatedMetric) && test.expectedErr != "" {
+			if (expectProcessesCountMetric || expectProcessesCreatedMetric || expectProcessesZombiesMetric) && test.expectedErr != "" {
 				assert.EqualError(err, test.expectedErr)
 
 				isPartial := scrapererror.IsPartialScrapeError(err)
@@ -150,12 +159,20 @@ func validateRealData(t *testing.T, metrics pdata.MetricSlice) {
 
 	if expectProcessesCreatedMetric {
 		createdMetric := metrics.At(metricIndex)
+		metricIndex++
 		internal.AssertDescriptorEqual(t, metadata.Metrics.SystemProcessesCreated.New(), createdMetric)
 		createdMetric = metrics.At(1)
 		internal.AssertDescriptorEqual(t, metadata.Metrics.SystemProcessesCreated.New(), createdMetric)
 		assert.Equal(1, createdMetric.Sum().DataPoints().Len())
 		assert.Equal(0, createdMetric.Sum().DataPoints().At(0).Attributes().Len())
 	}
+
+	if expectProcessesZombiesMetric {
+		zombiesMetric := metrics.At(metricIndex)
+		internal.AssertDescriptorEqual(t, metadata.Metrics.SystemProcessesZombies.New(), zombiesMetric)
+		assert.Equal(1, zombiesMetric.Sum().DataPoints().Len())
+		assert.GreaterOrEqual(zombiesMetric.Sum().DataPoints().At(0).IntVal(), int64(0))
+	}
 }
 
 func validateStartTime(t *testing.T, metrics pdata.MetricSlice) {
@@ -223,8 +240,38 @@ func validateFakeData(t *testing.T, metrics pdata.MetricSlice) {
 
 	if expectProcessesCreatedMetric {
 		createdMetric := metrics.At(metricIndex)
+		metricIndex++
 		internal.AssertDescriptorEqual(t, metadata.Metrics.SystemProcessesCreated.New(), createdMetric)
 		assert.Equal(1, createdMetric.Sum().DataPoints().Len())
 		assert.Equal(0, createdMetric.Sum().DataPoints().At(0).Attributes().Len())
 	}
+
+	if expectProcessesZombiesMetric {
+		zombiesMetric := metrics.At(metricIndex)
+		internal.AssertDescriptorEqual(t, metadata.Metrics.SystemProcessesZombies.New(), zombiesMetric)
+		assert.Equal(1, zombiesMetric.Sum().DataPoints().Len())
+		assert.Equal(int64(6), zombiesMetric.Sum().DataPoints().At(0).IntVal())
+	}
+}
+
+func validateDetailedStatuses(t *testing.T, metrics pdata.MetricSlice) {
+	if !expectProcessesCountMetric {
+		return
+	}
+	points := metrics.At(0).Sum().DataPoints()
+	attrs := map[string]int64{}
+	for i := 0; i < points.Len(); i++ {
+		val, _ := points.At(i).Attributes().Get(metadata.A.Status)
+		attrs[val.StringVal()] = points.At(i).IntVal()
+	}
+
+	ls := metadata.AttributeStatus
+	assert.Equal(t, map[string]int64{
+		ls.Blocked:  0,
+		ls.Idle:     1,
+		ls.Running:  0,
+		ls.Sleeping: 0,
+		ls.Stopped:  0,
+		ls.Zombies:  0,
+	}, attrs)
 }
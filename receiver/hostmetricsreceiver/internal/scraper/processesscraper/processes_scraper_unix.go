@@ -27,6 +27,18 @@ import (
 
 const enableProcessesCount = true
 const enableProcessesCreated = runtime.GOOS == "openbsd" || runtime.GOOS == "linux"
+const enableProcessesZombies = true
+
+// detailedStatuses are always reported, as zero when no process is in them,
+// so that the breakdown has the same series on every supported OS. Processes
+// in uninterruptible disk wait are reported as blocked.
+var detailedStatuses = []string{
+	metadata.AttributeStatus.Running,
+	metadata.AttributeStatus.Sleeping,
+	metadata.AttributeStatus.Blocked,
+	metadata.AttributeStatus.Zombies,
+	metadata.AttributeStatus.Stopped,
+}
 
 func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	processes, err := s.getProcesses()
@@ -34,7 +46,10 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 		return processesMetadata{}, err
 	}
 
-	countByStatus := map[string]int64{}
+	countByStatus := make(map[string]int64, len(detailedStatuses))
+	for _, status := range detailedStatuses {
+		countByStatus[status] = 0
+	}
 	for _, process := range processes {
 		var status []string
 		status, err = process.Status()
@@ -68,6 +83,8 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	countByStatus[metadata.AttributeStatus.Blocked] = int64(miscStat.ProcsBlocked)
 	countByStatus[metadata.AttributeStatus.Running] = int64(miscStat.ProcsRunning)
 
+	zombies := countByStatus[metadata.AttributeStatus.Zombies]
+
 	totalKnown := int64(0)
 	for _, count := range countByStatus {
 		totalKnown += count
@@ -79,6 +96,7 @@ func (s *scraper) getProcessesMetadata() (processesMetadata, error) {
 	return processesMetadata{
 		countByStatus:    countByStatus,
 		processesCreated: procsCreated,
+		zombies:          &zombies,
 	}, nil
 }
 
@@ -26,20 +26,32 @@ type Config struct {
 
 	// Metrics allows to customize scraped metrics representation.
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
-	// Include specifies a filter on the process names that should be included from the generated metrics.
-	// Exclude specifies a filter on the process names that should be excluded from the generated metrics.
+	// Include specifies a filter on the process names and command lines that should be included from the generated metrics.
+	// Exclude specifies a filter on the process names and command lines that should be excluded from the generated metrics.
 	// If neither `include` or `exclude` are set, process metrics will be generated for all processes.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
 
+	// TopNByCPU limits the generated metrics to the N processes that used the most CPU time
+	// since the previous scrape, among the filtered processes. Zero disables the limit.
+	TopNByCPU int `mapstructure:"top_n_by_cpu"`
+	// TopNByMemory limits the generated metrics to the N processes with the largest resident
+	// memory, among the filtered processes. Zero disables the limit.
+	// When both TopNByCPU and TopNByMemory are set, the processes selected by either are kept.
+	TopNByMemory int `mapstructure:"top_n_by_memory"`
+
 	// MuteProcessNameError is a flag that will mute the error encountered when trying to read a process the
 	// collector does not have permission for.
 	// See https://github.com/open-telemetry/opentelemetry-collector/issues/3004 for more information.
 	MuteProcessNameError bool `mapstructure:"mute_process_name_error,omitempty"`
 }
 
+// MatchConfig matches the processes by executable name and command line. A process
+// matches if its name matches one of Names and its command line matches one of
+// CommandLines, an empty list matching all the processes.
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
-	Names []string `mapstructure:"names"`
+	Names        []string `mapstructure:"names"`
+	CommandLines []string `mapstructure:"command_lines"`
 }
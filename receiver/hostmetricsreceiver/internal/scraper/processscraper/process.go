@@ -46,6 +46,15 @@ type commandMetadata struct {
 	commandLineSlice []string
 }
 
+// fullCommandLine returns the command line of the process, joining its
+// arguments if they were read separately.
+func (c *commandMetadata) fullCommandLine() string {
+	if c.commandLineSlice != nil {
+		return strings.Join(c.commandLineSlice, " ")
+	}
+	return c.commandLine
+}
+
 func (m *processMetadata) initializeResource(resource pdata.Resource) {
 	attr := resource.Attributes()
 	attr.EnsureCapacity(6)
@@ -54,13 +63,9 @@ func (m *processMetadata) initializeResource(resource pdata.Resource) {
 	attr.InsertString(conventions.AttributeProcessExecutablePath, m.executable.path)
 	if m.command != nil {
 		attr.InsertString(conventions.AttributeProcessCommand, m.command.command)
-		if m.command.commandLineSlice != nil {
-			// TODO insert slice here once this is supported by the data model
-			// (see https://github.com/open-telemetry/opentelemetry-collector/pull/1142)
-			attr.InsertString(conventions.AttributeProcessCommandLine, strings.Join(m.command.commandLineSlice, " "))
-		} else {
-			attr.InsertString(conventions.AttributeProcessCommandLine, m.command.commandLine)
-		}
+		// TODO insert slice here once this is supported by the data model
+		// (see https://github.com/open-telemetry/opentelemetry-collector/pull/1142)
+		attr.InsertString(conventions.AttributeProcessCommandLine, m.command.fullCommandLine())
 	}
 	if m.username != "" {
 		attr.InsertString(conventions.AttributeProcessOwner, m.username)
@@ -85,6 +90,9 @@ type processHandle interface {
 	Times() (*cpu.TimesStat, error)
 	MemoryInfo() (*process.MemoryInfoStat, error)
 	IOCounters() (*process.IOCountersStat, error)
+	NumFDs() (int32, error)
+	NumThreads() (int32, error)
+	NumCtxSwitches() (*process.NumCtxSwitchesStat, error)
 }
 
 type gopsProcessHandles struct {
@@ -16,7 +16,10 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime"
+	"sort"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/host"
@@ -35,6 +38,11 @@ const (
 	diskMetricsLen   = 1
 
 	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen
+
+	// the optional metrics, disabled by default
+	fileDescriptorMetricsLen = 1
+	threadMetricsLen         = 1
+	contextSwitchMetricsLen  = 1
 )
 
 // scraper for Process Metrics
@@ -43,6 +51,14 @@ type scraper struct {
 	mb        *metadata.MetricsBuilder
 	includeFS filterset.FilterSet
 	excludeFS filterset.FilterSet
+	// includeCommandLineFS and excludeCommandLineFS filter the processes
+	// by command line, in addition to the name filters.
+	includeCommandLineFS filterset.FilterSet
+	excludeCommandLineFS filterset.FilterSet
+
+	// cpuTimes are the CPU times of the processes at the previous scrape,
+	// by pid, to select the processes using the most CPU.
+	cpuTimes map[int32]float64
 
 	// for mocking
 	bootTime          func() (uint64, error)
@@ -53,6 +69,18 @@ type scraper struct {
 func newProcessScraper(cfg *Config) (*scraper, error) {
 	scraper := &scraper{config: cfg, bootTime: host.BootTime, getProcessHandles: getProcessHandlesInternal}
 
+	if cfg.TopNByCPU < 0 || cfg.TopNByMemory < 0 {
+		return nil, errors.New("top_n_by_cpu and top_n_by_memory can't be negative")
+	}
+
+	if !openFileDescriptorsSupported && cfg.Metrics.ProcessOpenFileDescriptors.Enabled {
+		return nil, fmt.Errorf("process.open_file_descriptors metric is not supported on %s", runtime.GOOS)
+	}
+
+	if !contextSwitchesSupported && cfg.Metrics.ProcessContextSwitches.Enabled {
+		return nil, fmt.Errorf("process.context_switches metric is not supported on %s", runtime.GOOS)
+	}
+
 	var err error
 
 	if len(cfg.Include.Names) > 0 {
@@ -69,6 +97,20 @@ func newProcessScraper(cfg *Config) (*scraper, error) {
 		}
 	}
 
+	if len(cfg.Include.CommandLines) > 0 {
+		scraper.includeCommandLineFS, err = filterset.CreateFilterSet(cfg.Include.CommandLines, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating process include command line filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.CommandLines) > 0 {
+		scraper.excludeCommandLineFS, err = filterset.CreateFilterSet(cfg.Exclude.CommandLines, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating process exclude command line filters: %w", err)
+		}
+	}
+
 	return scraper, nil
 }
 
@@ -98,6 +140,10 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		errs.AddPartial(partialErr.Failed, partialErr)
 	}
 
+	if s.config.TopNByCPU > 0 || s.config.TopNByMemory > 0 {
+		metadata = s.topProcesses(metadata)
+	}
+
 	rms.EnsureCapacity(len(metadata))
 	for _, md := range metadata {
 		rm := rms.AppendEmpty()
@@ -118,6 +164,18 @@ func (s *scraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		if err = s.scrapeAndAppendDiskIOMetric(now, md.handle); err != nil {
 			errs.AddPartial(diskMetricsLen, fmt.Errorf("error reading disk usage for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
+
+		if err = s.scrapeAndAppendOpenFileDescriptorsMetric(now, md.handle); err != nil {
+			errs.AddPartial(fileDescriptorMetricsLen, fmt.Errorf("error reading open file descriptor count for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendThreadsMetric(now, md.handle); err != nil {
+			errs.AddPartial(threadMetricsLen, fmt.Errorf("error reading thread count for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
+		if err = s.scrapeAndAppendContextSwitchesMetric(now, md.handle); err != nil {
+			errs.AddPartial(contextSwitchMetricsLen, fmt.Errorf("error reading context switches for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
 		s.mb.Emit(metrics)
 	}
 
@@ -149,15 +207,23 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 			continue
 		}
 
-		// filter processes by name
-		if (s.includeFS != nil && !s.includeFS.Matches(executable.name)) ||
-			(s.excludeFS != nil && s.excludeFS.Matches(executable.name)) {
+		// filter processes by name, and then by command line
+		if s.includeFS != nil && !s.includeFS.Matches(executable.name) {
+			continue
+		}
+		excludedName := (s.excludeFS != nil || s.excludeCommandLineFS != nil) &&
+			(s.excludeFS == nil || s.excludeFS.Matches(executable.name))
+		if excludedName && s.excludeCommandLineFS == nil {
 			continue
 		}
 
-		command, err := getProcessCommand(handle)
-		if err != nil {
-			errs.AddPartial(0, fmt.Errorf("error reading command for process %q (pid %v): %w", executable.name, pid, err))
+		command, commandErr := getProcessCommand(handle)
+		if (s.includeCommandLineFS != nil && !matchesCommandLine(s.includeCommandLineFS, command)) ||
+			(excludedName && matchesCommandLine(s.excludeCommandLineFS, command)) {
+			continue
+		}
+		if commandErr != nil {
+			errs.AddPartial(0, fmt.Errorf("error reading command for process %q (pid %v): %w", executable.name, pid, commandErr))
 		}
 
 		username, err := handle.Username()
@@ -179,6 +245,68 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 	return metadata, errs.Combine()
 }
 
+// matchesCommandLine returns whether the command line of the process matches
+// the filter, false if the command could not be read.
+func matchesCommandLine(fs filterset.FilterSet, command *commandMetadata) bool {
+	return command != nil && fs.Matches(command.fullCommandLine())
+}
+
+// topProcesses returns the processes among the top N by CPU time used since
+// the previous scrape and the top N by resident memory, in their original
+// order. The processes whose usage can't be read rank last.
+func (s *scraper) topProcesses(metadata []*processMetadata) []*processMetadata {
+	cpuUsage := make([]float64, len(metadata))
+	memoryUsage := make([]uint64, len(metadata))
+	cpuTimes := make(map[int32]float64, len(metadata))
+	for i, md := range metadata {
+		if s.config.TopNByCPU > 0 {
+			if times, err := md.handle.Times(); err == nil {
+				// the processes started since the previous scrape
+				// used all their CPU time since then.
+				total := times.User + times.System
+				cpuTimes[md.pid] = total
+				cpuUsage[i] = total - s.cpuTimes[md.pid]
+			}
+		}
+		if s.config.TopNByMemory > 0 {
+			if mem, err := md.handle.MemoryInfo(); err == nil {
+				memoryUsage[i] = mem.RSS
+			}
+		}
+	}
+	s.cpuTimes = cpuTimes
+
+	selected := make([]bool, len(metadata))
+	selectTop(selected, s.config.TopNByCPU, func(i, j int) bool { return cpuUsage[i] > cpuUsage[j] })
+	selectTop(selected, s.config.TopNByMemory, func(i, j int) bool { return memoryUsage[i] > memoryUsage[j] })
+
+	top := make([]*processMetadata, 0, len(metadata))
+	for i, md := range metadata {
+		if selected[i] {
+			top = append(top, md)
+		}
+	}
+	return top
+}
+
+// selectTop marks the n greatest indexes of selected according to greater.
+func selectTop(selected []bool, n int, greater func(i, j int) bool) {
+	if n <= 0 {
+		return
+	}
+	indexes := make([]int, len(selected))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool { return greater(indexes[a], indexes[b]) })
+	if n > len(indexes) {
+		n = len(indexes)
+	}
+	for _, i := range indexes[:n] {
+		selected[i] = true
+	}
+}
+
 func (s *scraper) scrapeAndAppendCPUTimeMetric(now pdata.Timestamp, handle processHandle) error {
 	times, err := handle.Times()
 	if err != nil {
@@ -210,3 +338,46 @@ func (s *scraper) scrapeAndAppendDiskIOMetric(now pdata.Timestamp, handle proces
 	s.mb.RecordProcessDiskIoDataPoint(now, int64(io.WriteBytes), metadata.AttributeDirection.Write)
 	return nil
 }
+
+func (s *scraper) scrapeAndAppendOpenFileDescriptorsMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessOpenFileDescriptors.Enabled {
+		return nil
+	}
+
+	fds, err := handle.NumFDs()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessOpenFileDescriptorsDataPoint(now, int64(fds))
+	return nil
+}
+
+func (s *scraper) scrapeAndAppendThreadsMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessThreads.Enabled {
+		return nil
+	}
+
+	threads, err := handle.NumThreads()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessThreadsDataPoint(now, int64(threads))
+	return nil
+}
+
+func (s *scraper) scrapeAndAppendContextSwitchesMetric(now pdata.Timestamp, handle processHandle) error {
+	if !s.config.Metrics.ProcessContextSwitches.Enabled {
+		return nil
+	}
+
+	ctxSwitches, err := handle.NumCtxSwitches()
+	if err != nil {
+		return err
+	}
+
+	s.mb.RecordProcessContextSwitchesDataPoint(now, ctxSwitches.Involuntary, metadata.AttributeContextSwitchType.Involuntary)
+	s.mb.RecordProcessContextSwitchesDataPoint(now, ctxSwitches.Voluntary, metadata.AttributeContextSwitchType.Voluntary)
+	return nil
+}
@@ -22,6 +22,11 @@ import (
 	"go.opentelemetry.io/collector/model/pdata"
 )
 
+const (
+	openFileDescriptorsSupported = false
+	contextSwitchesSupported     = false
+)
+
 func (s *scraper) recordCPUTimeMetric(now pdata.Timestamp, cpuTime *cpu.TimesStat) {}
 
 func getProcessExecutable(processHandle) (*executableMetadata, error) {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/shirou/gopsutil/v3/cpu"
@@ -195,8 +196,8 @@ type processHandlesMock struct {
 	handles []*processHandleMock
 }
 
-func (p *processHandlesMock) Pid(int) int32 {
-	return 1
+func (p *processHandlesMock) Pid(index int) int32 {
+	return int32(index + 1)
 }
 
 func (p *processHandlesMock) At(index int) processHandle {
@@ -251,6 +252,21 @@ func (p *processHandleMock) IOCounters() (*process.IOCountersStat, error) {
 	return args.Get(0).(*process.IOCountersStat), args.Error(1)
 }
 
+func (p *processHandleMock) NumFDs() (int32, error) {
+	args := p.MethodCalled("NumFDs")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) NumThreads() (int32, error) {
+	args := p.MethodCalled("NumThreads")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) NumCtxSwitches() (*process.NumCtxSwitchesStat, error) {
+	args := p.MethodCalled("NumCtxSwitches")
+	return args.Get(0).(*process.NumCtxSwitchesStat), args.Error(1)
+}
+
 func newDefaultHandleMock() *processHandleMock {
 	handleMock := &processHandleMock{}
 	handleMock.On("Username").Return("username", nil)
@@ -356,6 +372,180 @@ func TestScrapeMetrics_Filtered(t *testing.T) {
 	}
 }
 
+func newUsageHandleMock(name string, cmdline []string, cpuTime float64, rss uint64) *processHandleMock {
+	handleMock := &processHandleMock{}
+	handleMock.On("Name").Return(name, nil)
+	handleMock.On("Exe").Return(name, nil)
+	handleMock.On("Username").Return("username", nil)
+	handleMock.On("Cmdline").Return(strings.Join(cmdline, " "), nil)
+	handleMock.On("CmdlineSlice").Return(cmdline, nil)
+	handleMock.On("Times").Return(&cpu.TimesStat{User: cpuTime}, nil)
+	handleMock.On("MemoryInfo").Return(&process.MemoryInfoStat{RSS: rss}, nil)
+	handleMock.On("IOCounters").Return(&process.IOCountersStat{}, nil)
+	return handleMock
+}
+
+func scrapedProcessNames(t *testing.T, scraper *scraper, handles []*processHandleMock) []string {
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: handles}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	names := []string{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		name, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+		names = append(names, name.StringVal())
+	}
+	return names
+}
+
+func TestScrapeMetrics_FilteredByCommandLine(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	handles := func() []*processHandleMock {
+		return []*processHandleMock{
+			newUsageHandleMock("java", []string{"java", "-jar", "app.jar"}, 0, 0),
+			newUsageHandleMock("java", []string{"java", "-jar", "batch.jar"}, 0, 0),
+			newUsageHandleMock("python", []string{"python", "app.py"}, 0, 0),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		include       MatchConfig
+		exclude       MatchConfig
+		expectedNames []string
+	}{
+		{
+			name:          "Include Command Line",
+			include:       MatchConfig{CommandLines: []string{"app"}},
+			expectedNames: []string{"java", "python"},
+		},
+		{
+			name:          "Include Name And Command Line",
+			include:       MatchConfig{Names: []string{"java"}, CommandLines: []string{"app"}},
+			expectedNames: []string{"java"},
+		},
+		{
+			name:          "Exclude Command Line",
+			exclude:       MatchConfig{CommandLines: []string{`\.jar`}},
+			expectedNames: []string{"python"},
+		},
+		{
+			name:          "Exclude Name And Command Line",
+			exclude:       MatchConfig{Names: []string{"java"}, CommandLines: []string{"batch"}},
+			expectedNames: []string{"java", "python"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.include.Config = filterset.Config{MatchType: filterset.Regexp}
+			test.exclude.Config = filterset.Config{MatchType: filterset.Regexp}
+			scraper, err := newProcessScraper(&Config{
+				Metrics: metadata.DefaultMetricsSettings(),
+				Include: test.include,
+				Exclude: test.exclude,
+			})
+			require.NoError(t, err, "Failed to create process scraper: %v", err)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			assert.Equal(t, test.expectedNames, scrapedProcessNames(t, scraper, handles()))
+		})
+	}
+}
+
+func TestScrapeMetrics_TopN(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	scraper, err := newProcessScraper(&Config{Metrics: metadata.DefaultMetricsSettings(), TopNByCPU: 1, TopNByMemory: 1})
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	names := scrapedProcessNames(t, scraper, []*processHandleMock{
+		newUsageHandleMock("idle", nil, 1, 100),
+		newUsageHandleMock("busy", nil, 50, 200),
+		newUsageHandleMock("large", nil, 10, 5000),
+	})
+	assert.Equal(t, []string{"busy", "large"}, names)
+
+	// the CPU time is ranked by the time used since the previous scrape.
+	names = scrapedProcessNames(t, scraper, []*processHandleMock{
+		newUsageHandleMock("idle", nil, 30, 100),
+		newUsageHandleMock("busy", nil, 51, 200),
+		newUsageHandleMock("large", nil, 10, 5000),
+	})
+	assert.Equal(t, []string{"idle", "large"}, names)
+
+	_, err = newProcessScraper(&Config{TopNByCPU: -1})
+	assert.Error(t, err)
+}
+
+func optionalMetricsSettings() metadata.MetricsSettings {
+	settings := metadata.DefaultMetricsSettings()
+	settings.ProcessOpenFileDescriptors.Enabled = true
+	settings.ProcessThreads.Enabled = true
+	settings.ProcessContextSwitches.Enabled = true
+	return settings
+}
+
+func TestScrapeMetrics_OptionalMetrics(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		_, err := newProcessScraper(&Config{Metrics: optionalMetricsSettings()})
+		assert.Error(t, err)
+		t.Skipf("skipping test on %v", runtime.GOOS)
+	}
+
+	scraper, err := newProcessScraper(&Config{Metrics: optionalMetricsSettings()})
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	handleMock := newDefaultHandleMock()
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("NumFDs").Return(int32(12), nil)
+	handleMock.On("NumThreads").Return(int32(4), nil)
+	handleMock.On("NumCtxSwitches").Return(&process.NumCtxSwitchesStat{Voluntary: 30, Involuntary: 7}, nil)
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, metricsLen+fileDescriptorMetricsLen+threadMetricsLen+contextSwitchMetricsLen, md.MetricCount())
+
+	fdMetric := getMetric(t, "process.open_file_descriptors", md.ResourceMetrics())
+	assert.EqualValues(t, 12, fdMetric.Sum().DataPoints().At(0).IntVal())
+	threadsMetric := getMetric(t, "process.threads", md.ResourceMetrics())
+	assert.EqualValues(t, 4, threadsMetric.Sum().DataPoints().At(0).IntVal())
+	ctxSwitchesMetric := getMetric(t, "process.context_switches", md.ResourceMetrics())
+	assert.True(t, ctxSwitchesMetric.Sum().IsMonotonic())
+	internal.AssertSumMetricHasAttributeValue(t, ctxSwitchesMetric, 0, "type", pdata.NewAttributeValueString(metadata.AttributeContextSwitchType.Involuntary))
+	assert.EqualValues(t, 7, ctxSwitchesMetric.Sum().DataPoints().At(0).IntVal())
+	internal.AssertSumMetricHasAttributeValue(t, ctxSwitchesMetric, 1, "type", pdata.NewAttributeValueString(metadata.AttributeContextSwitchType.Voluntary))
+	assert.EqualValues(t, 30, ctxSwitchesMetric.Sum().DataPoints().At(1).IntVal())
+
+	// the optional metrics that can't be read are reported as partial errors.
+	handleMock = newDefaultHandleMock()
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("NumFDs").Return(int32(0), errors.New("err1"))
+	handleMock.On("NumThreads").Return(int32(4), nil)
+	handleMock.On("NumCtxSwitches").Return((*process.NumCtxSwitchesStat)(nil), errors.New("err2"))
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err = scraper.scrape(context.Background())
+	assert.EqualError(t, err, `error reading open file descriptor count for process "test" (pid 1): err1; `+
+		`error reading context switches for process "test" (pid 1): err2`)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, fileDescriptorMetricsLen+contextSwitchMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Equal(t, metricsLen+threadMetricsLen, md.MetricCount())
+}
+
 func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 	skipTestOnUnsupportedOS(t)
 
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensorsscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/sensorsscraper"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// hwmonInputRegexp matches the temperature and fan input files of a hwmon
+// chip, capturing the channel name, e.g. temp1.
+var hwmonInputRegexp = regexp.MustCompile(`^((temp|fan)\d+)_input$`)
+
+// reading is the value of a temperature or fan sensor.
+type reading struct {
+	chip   string
+	sensor string
+	// fan is true for a fan speed in RPM, false for a temperature in degrees
+	// Celsius.
+	fan   bool
+	value float64
+}
+
+// hostSys returns the path of sysfs, honoring the HOST_SYS environment
+// variable used when running in a container.
+func hostSys() string {
+	if path := os.Getenv("HOST_SYS"); path != "" {
+		return path
+	}
+	return "/sys"
+}
+
+// readHwmon reads the temperature and fan sensors of the hwmon chips. It
+// returns os.ErrNotExist when there is no hwmon class, i.e. not on Linux.
+func readHwmon(sysPath string) ([]reading, error) {
+	hwmonPath := filepath.Join(sysPath, "class", "hwmon")
+	entries, err := ioutil.ReadDir(hwmonPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []reading
+	for _, entry := range entries {
+		chipReadings, err := readHwmonChip(filepath.Join(hwmonPath, entry.Name()))
+		if err != nil {
+			return readings, err
+		}
+		readings = append(readings, chipReadings...)
+	}
+	return readings, nil
+}
+
+// readHwmonChip reads the sensors of a hwmon chip. The sensors that cannot be
+// read, e.g. a disconnected fan header reporting an I/O error, are skipped.
+func readHwmonChip(chipPath string) ([]reading, error) {
+	files, err := ioutil.ReadDir(chipPath)
+	if err != nil {
+		return nil, err
+	}
+	chip, err := readString(chipPath, "name")
+	if err != nil {
+		// Chips without a name are identified by their hwmon directory.
+		chip = filepath.Base(chipPath)
+	}
+
+	var readings []reading
+	for _, file := range files {
+		match := hwmonInputRegexp.FindStringSubmatch(file.Name())
+		if match == nil {
+			continue
+		}
+		value, err := readInt(chipPath, file.Name())
+		if err != nil {
+			continue
+		}
+		r := reading{chip: chip, sensor: match[1], fan: match[2] == "fan", value: float64(value)}
+		if label, err := readString(chipPath, match[1]+"_label"); err == nil && label != "" {
+			r.sensor = label
+		}
+		if !r.fan {
+			// The temperatures are reported in millidegrees Celsius.
+			r.value /= 1e3
+		}
+		readings = append(readings, r)
+	}
+	return readings, nil
+}
+
+func readString(dir, name string) (string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func readInt(dir, name string) (int64, error) {
+	content, err := readString(dir, name)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(content, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s: %w", name, err)
+	}
+	return value, nil
+}
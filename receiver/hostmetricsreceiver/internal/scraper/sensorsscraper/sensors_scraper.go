@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensorsscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/sensorsscraper"
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/sensorsscraper/internal/metadata"
+)
+
+const sensorsMetricsLen = 2
+
+// scraper for Sensors Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+
+	// for mocking
+	sysPath      string
+	goos         string
+	temperatures func(ctx context.Context) ([]host.TemperatureStat, error)
+}
+
+// newSensorsScraper creates a Sensors Scraper
+func newSensorsScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, sysPath: hostSys(), goos: runtime.GOOS, temperatures: host.SensorsTemperaturesWithContext}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics)
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	var errors scrapererror.ScrapeErrors
+
+	readings, err := readHwmon(s.sysPath)
+	if os.IsNotExist(err) {
+		// There is no hwmon on the other operating systems, the temperatures
+		// are read from the sensors exposed by the platform.
+		readings, err = s.platformReadings(ctx)
+	}
+	if err != nil {
+		errors.AddPartial(sensorsMetricsLen, err)
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	for _, r := range readings {
+		if r.fan {
+			s.mb.RecordSystemFanSpeedDataPoint(now, int64(r.value), r.chip, r.sensor)
+		} else {
+			s.mb.RecordSystemTemperatureDataPoint(now, r.value, r.chip, r.sensor)
+		}
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+// platformReadings returns the temperatures read by gopsutil, from the System
+// Management Controller on macOS and from the ACPI thermal zones on Windows.
+// The temperatures read before an error are returned along with it.
+func (s *scraper) platformReadings(ctx context.Context) ([]reading, error) {
+	chip := "acpi"
+	if s.goos == "darwin" {
+		chip = "smc"
+	}
+	temperatures, err := s.temperatures(ctx)
+	readings := make([]reading, 0, len(temperatures))
+	for _, t := range temperatures {
+		readings = append(readings, reading{chip: chip, sensor: t.SensorKey, value: t.Temperature})
+	}
+	return readings, err
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensorsscraper
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/sensorsscraper/internal/metadata"
+)
+
+func newTestScraper(t *testing.T, sysPath string) *scraper {
+	scraper := newSensorsScraper(context.Background(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.sysPath = sysPath
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScrape(t *testing.T) {
+	scraper := newTestScraper(t, filepath.Join("testdata", "sys"))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]float64{
+		"system.temperature coretemp Package id 0": 52,
+		"system.temperature coretemp Core 0":       49.5,
+		"system.temperature nct6775 temp1":         38,
+		"system.temperature hwmon2 temp1":          61.25,
+		"system.fan.speed nct6775 CPU fan":         1250,
+		"system.fan.speed nct6775 fan2":            0,
+	}, metricValues(metrics))
+	internal.AssertSameTimeStampForAllMetrics(t, metrics)
+}
+
+func TestScrapePlatform(t *testing.T) {
+	scraper := newTestScraper(t, filepath.Join("testdata", "missing"))
+	scraper.goos = "darwin"
+	scraper.temperatures = func(context.Context) ([]host.TemperatureStat, error) {
+		return []host.TemperatureStat{
+			{SensorKey: "TC0P", Temperature: 47.25},
+			{SensorKey: "TG0P", Temperature: 39},
+		}, errors.New("failed to read the TB0T sensor")
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, sensorsMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+
+	metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]float64{
+		"system.temperature smc TC0P": 47.25,
+		"system.temperature smc TG0P": 39,
+	}, metricValues(metrics))
+}
+
+func metricValues(metrics pdata.MetricSlice) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			chip, _ := dps.At(j).Attributes().Get(metadata.A.Chip)
+			sensor, _ := dps.At(j).Attributes().Get(metadata.A.Sensor)
+			key := metric.Name() + " " + chip.StringVal() + " " + sensor.StringVal()
+			if dps.At(j).ValueType() == pdata.MetricValueTypeInt {
+				values[key] = float64(dps.At(j).IntVal())
+			} else {
+				values[key] = dps.At(j).DoubleVal()
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smartscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper/internal/metadata"
+)
+
+const deviceMetricsLen = 3
+
+// scraper for SMART Metrics
+type scraper struct {
+	config *Config
+	mb     *metadata.MetricsBuilder
+
+	// for mocking
+	smartctl func(ctx context.Context, path string, args ...string) ([]byte, error)
+}
+
+// newSmartScraper creates a SMART Scraper
+func newSmartScraper(_ context.Context, cfg *Config) *scraper {
+	return &scraper{config: cfg, smartctl: runSmartctl}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics)
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().InstrumentationLibraryMetrics().AppendEmpty().Metrics()
+	var errors scrapererror.ScrapeErrors
+
+	devices, err := s.devices(ctx)
+	if err != nil {
+		return md, scrapererror.NewPartialScrapeError(err, deviceMetricsLen)
+	}
+
+	now := pdata.NewTimestampFromTime(time.Now())
+	for _, device := range devices {
+		if err := s.recordDeviceMetrics(ctx, now, device); err != nil {
+			errors.AddPartial(deviceMetricsLen, err)
+		}
+	}
+
+	s.mb.Emit(metrics)
+	return md, errors.Combine()
+}
+
+// devices returns the configured devices, or the ones found by smartctl --scan.
+func (s *scraper) devices(ctx context.Context) ([]scannedDevice, error) {
+	if len(s.config.Devices) > 0 {
+		devices := make([]scannedDevice, 0, len(s.config.Devices))
+		for _, name := range s.config.Devices {
+			devices = append(devices, scannedDevice{Name: name})
+		}
+		return devices, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	out, err := s.smartctl(ctx, s.config.SmartctlPath, "--scan", "--json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan devices: %w", err)
+	}
+	return parseScanOutput(out)
+}
+
+func (s *scraper) recordDeviceMetrics(ctx context.Context, now pdata.Timestamp, device scannedDevice) error {
+	// The disks in standby are not woken up to be scraped, and are skipped.
+	args := []string{"--all", "--json", "--nocheck=standby,0"}
+	if device.Type != "" {
+		args = append(args, "--device", device.Type)
+	}
+	args = append(args, device.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	out, err := s.smartctl(ctx, s.config.SmartctlPath, args...)
+	if err != nil {
+		return err
+	}
+	d, err := parseDeviceOutput(device.Name, out)
+	if err != nil {
+		return err
+	}
+
+	if d.SmartStatus != nil {
+		var status int64
+		if d.SmartStatus.Passed {
+			status = 1
+		}
+		s.mb.RecordSystemDiskHealthStatusDataPoint(now, status, device.Name)
+	}
+	if d.Temperature != nil {
+		s.mb.RecordSystemDiskTemperatureDataPoint(now, d.Temperature.Current, device.Name)
+	}
+	if sectors, ok := d.reallocatedSectors(); ok {
+		s.mb.RecordSystemDiskReallocatedSectorsDataPoint(now, sectors, device.Name)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smartscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper/internal/metadata"
+)
+
+// fakeSmartctl returns the testdata file of the device passed as last argument,
+// or the scan output when scanning the devices.
+func fakeSmartctl(_ context.Context, _ string, args ...string) ([]byte, error) {
+	file := "scan.json"
+	if args[0] != "--scan" {
+		file = map[string]string{
+			"/dev/sda":   "sda.json",
+			"/dev/nvme0": "nvme0.json",
+			"/dev/sdb":   "sdb_open_failed.json",
+		}[args[len(args)-1]]
+	}
+	return os.ReadFile(filepath.Join("testdata", file))
+}
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name     string
+		devices  []string
+		expected map[string]int64
+	}
+
+	testCases := []testCase{
+		{
+			name: "Scanned devices",
+			expected: map[string]int64{
+				"system.disk.health.status /dev/sda":       0,
+				"system.disk.temperature /dev/sda":         36,
+				"system.disk.reallocated_sectors /dev/sda": 1264,
+				"system.disk.health.status /dev/nvme0":     1,
+				"system.disk.temperature /dev/nvme0":       41,
+			},
+		},
+		{
+			name:    "Configured devices",
+			devices: []string{"/dev/nvme0"},
+			expected: map[string]int64{
+				"system.disk.health.status /dev/nvme0": 1,
+				"system.disk.temperature /dev/nvme0":   41,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper := newSmartScraper(context.Background(), &Config{Devices: test.devices, Timeout: time.Second, Metrics: metadata.DefaultMetricsSettings()})
+			scraper.smartctl = fakeSmartctl
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize SMART scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			metrics := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+			assert.Equal(t, test.expected, metricValues(t, metrics))
+			internal.AssertSameTimeStampForAllMetrics(t, metrics)
+		})
+	}
+}
+
+func TestScrapePartialError(t *testing.T) {
+	scraper := newSmartScraper(context.Background(), &Config{Devices: []string{"/dev/sdb", "/dev/nvme0"}, Timeout: time.Second, Metrics: metadata.DefaultMetricsSettings()})
+	scraper.smartctl = fakeSmartctl
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, deviceMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Contains(t, err.Error(), "Permission denied")
+	assert.Equal(t, 2, md.MetricCount())
+}
+
+func TestScrapeScanError(t *testing.T) {
+	scraper := newSmartScraper(context.Background(), &Config{Timeout: time.Second, Metrics: metadata.DefaultMetricsSettings()})
+	scraper.smartctl = func(context.Context, string, ...string) ([]byte, error) {
+		return nil, errors.New("executable file not found in $PATH")
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestRunSmartctlTimeout(t *testing.T) {
+	if _, err := os.Stat("/bin/sleep"); err != nil {
+		t.Skip("sleep is not available")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := runSmartctl(ctx, "/bin/sleep", "1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func metricValues(t *testing.T, metrics pdata.MetricSlice) map[string]int64 {
+	values := make(map[string]int64)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			device, ok := dps.At(j).Attributes().Get(metadata.A.Device)
+			require.True(t, ok)
+			values[metric.Name()+" "+device.StringVal()] = dps.At(j).IntVal()
+		}
+	}
+	return values
+}
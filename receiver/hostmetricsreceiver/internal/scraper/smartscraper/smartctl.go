@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smartscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// The smartctl exit status is a bit mask, the two lowest bits meaning that the
+// command line could not be parsed or that the device could not be opened. The
+// other bits report the disk state, e.g. a failing disk, and come with a
+// complete output.
+const smartctlFatalExitStatus = 0x3
+
+// reallocatedSectorsAttributeID is the ID of the Reallocated_Sector_Ct ATA SMART attribute.
+const reallocatedSectorsAttributeID = 5
+
+// scanOutput is the part of the smartctl --scan JSON output used by the scraper.
+type scanOutput struct {
+	Devices []scannedDevice `json:"devices"`
+}
+
+type scannedDevice struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// deviceOutput is the part of the smartctl --all JSON output used by the scraper.
+type deviceOutput struct {
+	Smartctl struct {
+		ExitStatus int `json:"exit_status"`
+		Messages   []struct {
+			String   string `json:"string"`
+			Severity string `json:"severity"`
+		} `json:"messages"`
+	} `json:"smartctl"`
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature *struct {
+		Current int64 `json:"current"`
+	} `json:"temperature"`
+	ATASmartAttributes *struct {
+		Table []struct {
+			ID  int `json:"id"`
+			Raw struct {
+				Value int64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+}
+
+// reallocatedSectors returns the raw value of the reallocated sectors attribute.
+func (d *deviceOutput) reallocatedSectors() (int64, bool) {
+	if d.ATASmartAttributes == nil {
+		return 0, false
+	}
+	for _, attr := range d.ATASmartAttributes.Table {
+		if attr.ID == reallocatedSectorsAttributeID {
+			return attr.Raw.Value, true
+		}
+	}
+	return 0, false
+}
+
+// runSmartctl runs smartctl with the given arguments and returns its output. The
+// non-zero exit statuses are not reported as errors, as smartctl reports the
+// disk state with them, and are handled by the callers from the JSON output.
+func runSmartctl(ctx context.Context, path string, args ...string) ([]byte, error) {
+	out, err := exec.CommandContext(ctx, path, args...).Output()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("smartctl %v timed out: %w", args, ctx.Err())
+	}
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+	return out, nil
+}
+
+func parseScanOutput(out []byte) ([]scannedDevice, error) {
+	var scan scanOutput
+	if err := json.Unmarshal(out, &scan); err != nil {
+		return nil, fmt.Errorf("failed to parse smartctl --scan output: %w", err)
+	}
+	return scan.Devices, nil
+}
+
+func parseDeviceOutput(device string, out []byte) (*deviceOutput, error) {
+	var d deviceOutput
+	if err := json.Unmarshal(out, &d); err != nil {
+		return nil, fmt.Errorf("failed to parse smartctl output of %s: %w", device, err)
+	}
+	if d.Smartctl.ExitStatus&smartctlFatalExitStatus != 0 {
+		msg := "unknown error"
+		if len(d.Smartctl.Messages) > 0 {
+			msg = d.Smartctl.Messages[0].String
+		}
+		return nil, fmt.Errorf("smartctl failed to read %s (exit status %d): %s", device, d.Smartctl.ExitStatus, msg)
+	}
+	return &d, nil
+}
@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package iisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters/pdh"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters/third_party/telegraf/win_perf_counters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver/internal/metadata"
+)
+
+const instrumentationLibraryName = "otelcol/iis"
+
+// PerfCounterScraper is a performance counter whose values are scraped for all
+// its instances.
+type PerfCounterScraper interface {
+	// Path returns the counter path
+	Path() string
+	// ScrapeData collects a measurement and returns the value(s).
+	ScrapeData() ([]win_perf_counters.CounterValue, error)
+	// Close all counters/handles related to the query and free all associated memory.
+	Close() error
+}
+
+// recordFunc records the value of a performance counter for one instance.
+type recordFunc func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64)
+
+// counterRecorder maps a performance counter to the metric it is recorded as.
+type counterRecorder struct {
+	object  string
+	counter string
+	record  recordFunc
+}
+
+// siteRecorders are the performance counters which instances are the websites.
+var siteRecorders = []counterRecorder{
+	{
+		object:  "Web Service",
+		counter: "Current Connections",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisConnectionActiveDataPoint(ts, int64(val))
+		},
+	},
+	{
+		object:  "Web Service",
+		counter: "Total Method Requests/sec",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisRequestRateDataPoint(ts, val)
+		},
+	},
+	{
+		object:  "Web Service",
+		counter: "Total Bytes Sent",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisNetworkIoDataPoint(ts, int64(val), metadata.AttributeDirection.Sent)
+		},
+	},
+	{
+		object:  "Web Service",
+		counter: "Total Bytes Received",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisNetworkIoDataPoint(ts, int64(val), metadata.AttributeDirection.Received)
+		},
+	},
+}
+
+// appPoolRecorders are the performance counters which instances are the
+// application pools.
+var appPoolRecorders = []counterRecorder{
+	{
+		object:  "HTTP Service Request Queues",
+		counter: "CurrentQueueSize",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisRequestQueueCountDataPoint(ts, int64(val))
+		},
+	},
+	{
+		object:  "APP_POOL_WAS",
+		counter: "Current Application Pool State",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisApplicationPoolStateDataPoint(ts, int64(val))
+		},
+	},
+	{
+		object:  "APP_POOL_WAS",
+		counter: "Total Application Pool Recycles",
+		record: func(mb *metadata.MetricsBuilder, ts pdata.Timestamp, val float64) {
+			mb.RecordIisWorkerProcessRestartCountDataPoint(ts, int64(val))
+		},
+	},
+}
+
+// watchedCounter is a performance counter opened for all the instances of its
+// object.
+type watchedCounter struct {
+	PerfCounterScraper
+	record recordFunc
+}
+
+// instanceValue is the value of a performance counter scraped for an instance.
+type instanceValue struct {
+	record recordFunc
+	value  float64
+}
+
+type iisScraper struct {
+	logger *zap.Logger
+	mb     *metadata.MetricsBuilder
+
+	siteCounters    []watchedCounter
+	appPoolCounters []watchedCounter
+}
+
+func newScraper(cfg *Config, logger *zap.Logger) *iisScraper {
+	return &iisScraper{
+		logger: logger,
+		mb:     metadata.NewMetricsBuilder(cfg.Metrics),
+	}
+}
+
+func (s *iisScraper) start(context.Context, component.Host) error {
+	var errs error
+	s.siteCounters = watchCounters(siteRecorders, &errs)
+	s.appPoolCounters = watchCounters(appPoolRecorders, &errs)
+
+	// log a warning if some counters cannot be loaded, e.g. because an IIS
+	// feature is not installed, but do not crash the app
+	if errs != nil {
+		s.logger.Warn("some performance counters could not be initialized", zap.Error(errs))
+	}
+
+	return nil
+}
+
+// watchCounters opens the performance counters of the recorders for all the
+// instances of their object.
+func watchCounters(recorders []counterRecorder, errs *error) []watchedCounter {
+	counters := make([]watchedCounter, 0, len(recorders))
+	for _, recorder := range recorders {
+		counterPath := fmt.Sprintf("\\%s(*)\\%s", recorder.object, recorder.counter)
+		c, err := pdh.NewPerfCounter(counterPath, true)
+		if err != nil {
+			*errs = multierr.Append(*errs, fmt.Errorf("counter %v: %w", counterPath, err))
+			continue
+		}
+		counters = append(counters, watchedCounter{PerfCounterScraper: c, record: recorder.record})
+	}
+	return counters
+}
+
+func (s *iisScraper) shutdown(context.Context) error {
+	var errs error
+	for _, counter := range append(s.siteCounters, s.appPoolCounters...) {
+		errs = multierr.Append(errs, counter.Close())
+	}
+	return errs
+}
+
+func (s *iisScraper) scrape(context.Context) (pdata.Metrics, error) {
+	md := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+
+	var errs error
+	s.emitInstances(md.ResourceMetrics(), metadata.A.IisSite, scrapeInstances(s.siteCounters, &errs), now)
+	s.emitInstances(md.ResourceMetrics(), metadata.A.IisApplicationPool, scrapeInstances(s.appPoolCounters, &errs), now)
+
+	return md, errs
+}
+
+// scrapeInstances collects a measurement of the counters and returns the
+// values grouped by instance name.
+func scrapeInstances(counters []watchedCounter, errs *error) map[string][]instanceValue {
+	instances := map[string][]instanceValue{}
+	for _, counter := range counters {
+		counterValues, err := counter.ScrapeData()
+		if err != nil {
+			*errs = multierr.Append(*errs, err)
+			continue
+		}
+
+		for _, counterValue := range counterValues {
+			// the "_Total" instance is already removed when there are other
+			// instances, and its name cleared when it is the only one.
+			if counterValue.InstanceName == "" {
+				continue
+			}
+			instances[counterValue.InstanceName] = append(instances[counterValue.InstanceName],
+				instanceValue{record: counter.record, value: counterValue.Value})
+		}
+	}
+	return instances
+}
+
+// emitInstances reports the values of each instance as a resource identified by
+// the given attribute.
+func (s *iisScraper) emitInstances(rms pdata.ResourceMetricsSlice, attribute string, instances map[string][]instanceValue, now pdata.Timestamp) {
+	names := make([]string, 0, len(instances))
+	for name := range instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rm := rms.AppendEmpty()
+		rm.Resource().Attributes().InsertString(attribute, name)
+		ilm := rm.InstrumentationLibraryMetrics().AppendEmpty()
+		ilm.InstrumentationLibrary().SetName(instrumentationLibraryName)
+
+		for _, v := range instances[name] {
+			v.record(s.mb, now, v.value)
+		}
+		s.mb.Emit(ilm.Metrics())
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package iisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/winperfcounters/third_party/telegraf/win_perf_counters"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver/internal/metadata"
+)
+
+type mockPerfCounter struct {
+	values    []win_perf_counters.CounterValue
+	scrapeErr error
+}
+
+// Path
+func (mpc *mockPerfCounter) Path() string {
+	return ""
+}
+
+// ScrapeData
+func (mpc *mockPerfCounter) ScrapeData() ([]win_perf_counters.CounterValue, error) {
+	return mpc.values, mpc.scrapeErr
+}
+
+// Close
+func (mpc *mockPerfCounter) Close() error {
+	return nil
+}
+
+func newWatchedCounter(recorder counterRecorder, values ...win_perf_counters.CounterValue) watchedCounter {
+	return watchedCounter{PerfCounterScraper: &mockPerfCounter{values: values}, record: recorder.record}
+}
+
+func TestScrape(t *testing.T) {
+	s := newScraper(createDefaultConfig().(*Config), zap.NewNop())
+	s.siteCounters = []watchedCounter{
+		newWatchedCounter(siteRecorders[0],
+			win_perf_counters.CounterValue{InstanceName: "Default Web Site", Value: 3},
+			win_perf_counters.CounterValue{InstanceName: "api", Value: 5},
+		),
+		newWatchedCounter(siteRecorders[2],
+			win_perf_counters.CounterValue{InstanceName: "api", Value: 2048},
+		),
+	}
+	s.appPoolCounters = []watchedCounter{
+		newWatchedCounter(appPoolRecorders[1],
+			win_perf_counters.CounterValue{InstanceName: "DefaultAppPool", Value: 3},
+		),
+		{PerfCounterScraper: &mockPerfCounter{scrapeErr: errors.New("err1")}, record: appPoolRecorders[0].record},
+	}
+
+	md, err := s.scrape(context.Background())
+	assert.EqualError(t, err, "err1")
+
+	rms := md.ResourceMetrics()
+	require.Equal(t, 3, rms.Len())
+
+	expected := []struct {
+		attribute string
+		instance  string
+		metrics   []string
+	}{
+		{attribute: metadata.A.IisSite, instance: "Default Web Site", metrics: []string{"iis.connection.active"}},
+		{attribute: metadata.A.IisSite, instance: "api", metrics: []string{"iis.connection.active", "iis.network.io"}},
+		{attribute: metadata.A.IisApplicationPool, instance: "DefaultAppPool", metrics: []string{"iis.application_pool.state"}},
+	}
+	for i, e := range expected {
+		rm := rms.At(i)
+		assert.Equal(t, map[string]interface{}{e.attribute: e.instance}, rm.Resource().Attributes().AsRaw())
+
+		ilm := rm.InstrumentationLibraryMetrics().At(0)
+		assert.Equal(t, instrumentationLibraryName, ilm.InstrumentationLibrary().Name())
+		var names []string
+		for j := 0; j < ilm.Metrics().Len(); j++ {
+			names = append(names, ilm.Metrics().At(j).Name())
+		}
+		assert.Equal(t, e.metrics, names)
+	}
+
+	networkIO := rms.At(1).InstrumentationLibraryMetrics().At(0).Metrics().At(1).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(2048), networkIO.IntVal())
+	direction, _ := networkIO.Attributes().Get(metadata.A.Direction)
+	assert.Equal(t, metadata.AttributeDirection.Sent, direction.StringVal())
+}
+
+func TestScrapeSkipsTotal(t *testing.T) {
+	s := newScraper(createDefaultConfig().(*Config), zap.NewNop())
+	// the name of a single "_Total" instance is cleared by the perf counter.
+	s.siteCounters = []watchedCounter{
+		newWatchedCounter(siteRecorders[0], win_perf_counters.CounterValue{Value: 3}),
+	}
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
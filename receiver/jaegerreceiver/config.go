@@ -101,8 +101,10 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	var httpPort int
 	if cfg.ThriftHTTP != nil {
-		if _, err := extractPortFromEndpoint(cfg.ThriftHTTP.Endpoint); err != nil {
+		var err error
+		if httpPort, err = extractPortFromEndpoint(cfg.ThriftHTTP.Endpoint); err != nil {
 			return fmt.Errorf("unable to extract port for the Thrift HTTP endpoint: %w", err)
 		}
 	}
@@ -124,8 +126,8 @@ func (cfg *Config) Validate() error {
 			return fmt.Errorf("unable to extract port for the Remote Sampling endpoint: %w", err)
 		}
 
-		if len(cfg.RemoteSampling.StrategyFile) != 0 && grpcPort == 0 {
-			return fmt.Errorf("strategy file requires the gRPC protocol to be enabled")
+		if len(cfg.RemoteSampling.StrategyFile) != 0 && grpcPort == 0 && httpPort == 0 {
+			return fmt.Errorf("strategy file requires the gRPC or Thrift HTTP protocol to be enabled")
 		}
 
 		if cfg.RemoteSampling.StrategyFileReloadInterval < 0 {
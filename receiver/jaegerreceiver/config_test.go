@@ -288,3 +288,19 @@ func TestInvalidConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestStrategyFileWithThriftHTTPOnly(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Protocols = Protocols{
+		ThriftHTTP: &confighttp.HTTPServerSettings{
+			Endpoint: defaultHTTPBindEndpoint,
+		},
+	}
+	cfg.RemoteSampling = &RemoteSamplingConfig{
+		HostEndpoint: "localhost:5778",
+		StrategyFile: "strategies.json",
+	}
+
+	assert.NoError(t, cfg.Validate())
+}
@@ -123,6 +123,9 @@ func createTracesReceiver(
 		config.RemoteSamplingClientSettings = remoteSamplingConfig.GRPCClientSettings
 		if len(config.RemoteSamplingClientSettings.Endpoint) == 0 {
 			config.RemoteSamplingClientSettings.Endpoint = defaultGRPCBindEndpoint
+		} else {
+			// an explicit upstream backs the collector sampling endpoints when no strategy file is set
+			config.RemoteSamplingUpstream = true
 		}
 
 		if len(remoteSamplingConfig.HostEndpoint) == 0 {
@@ -131,7 +134,6 @@ func createTracesReceiver(
 			config.AgentHTTPPort, _ = extractPortFromEndpoint(remoteSamplingConfig.HostEndpoint)
 		}
 
-		// strategies are served over grpc so if grpc is not enabled and strategies are present return an error
 		if len(remoteSamplingConfig.StrategyFile) != 0 {
 			config.RemoteSamplingStrategyFile = remoteSamplingConfig.StrategyFile
 			config.RemoteSamplingStrategyFileReloadInterval = remoteSamplingConfig.StrategyFileReloadInterval
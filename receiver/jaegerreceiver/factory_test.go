@@ -207,6 +207,7 @@ func TestDefaultAgentRemoteSamplingEndpointAndPort(t *testing.T) {
 	assert.NoError(t, err, "create trace receiver should not error")
 	assert.Equal(t, defaultGRPCBindEndpoint, r.(*jReceiver).config.RemoteSamplingClientSettings.Endpoint)
 	assert.Equal(t, defaultAgentRemoteSamplingHTTPPort, r.(*jReceiver).config.AgentHTTPPort, "agent http port should be default")
+	assert.False(t, r.(*jReceiver).config.RemoteSamplingUpstream, "default endpoint should not be used as upstream")
 }
 
 func TestAgentRemoteSamplingEndpoint(t *testing.T) {
@@ -229,6 +230,7 @@ func TestAgentRemoteSamplingEndpoint(t *testing.T) {
 	assert.NoError(t, err, "create trace receiver should not error")
 	assert.Equal(t, endpoint, r.(*jReceiver).config.RemoteSamplingClientSettings.Endpoint)
 	assert.Equal(t, defaultAgentRemoteSamplingHTTPPort, r.(*jReceiver).config.AgentHTTPPort, "agent http port should be default")
+	assert.True(t, r.(*jReceiver).config.RemoteSamplingUpstream, "configured endpoint should be used as upstream")
 }
 
 func TestRemoteSamplingConfigPropagation(t *testing.T) {
@@ -36,7 +36,9 @@ import (
 	"github.com/jaegertracing/jaeger/cmd/agent/app/servers/thriftudp"
 	"github.com/jaegertracing/jaeger/cmd/collector/app/handler"
 	collectorSampling "github.com/jaegertracing/jaeger/cmd/collector/app/sampling"
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
 	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/pkg/clientcfg/clientcfghttp"
 	staticStrategyStore "github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
 	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
 	"github.com/jaegertracing/jaeger/thrift-gen/agent"
@@ -73,6 +75,7 @@ type configuration struct {
 	AgentBinaryThriftConfig                  ServerConfigUDP
 	AgentHTTPPort                            int
 	RemoteSamplingClientSettings             configgrpc.GRPCClientSettings
+	RemoteSamplingUpstream                   bool
 	RemoteSamplingStrategyFile               string
 	RemoteSamplingStrategyFileReloadInterval time.Duration
 }
@@ -87,6 +90,7 @@ type jReceiver struct {
 
 	grpc            *grpc.Server
 	collectorServer *http.Server
+	strategyStore   strategystore.StrategyStore
 
 	agentSamplingManager *jSamplingConfig.SamplingManager
 	agentProcessors      []processors.Processor
@@ -223,6 +227,9 @@ func (jr *jReceiver) Shutdown(ctx context.Context) error {
 	if jr.grpc != nil {
 		jr.grpc.GracefulStop()
 	}
+	if closer, ok := jr.strategyStore.(interface{ Close() }); ok {
+		closer.Close()
+	}
 
 	jr.goroutines.Wait()
 	return errs
@@ -454,6 +461,11 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 		return nil
 	}
 
+	// init the sampling strategy store shared by the gRPC and HTTP endpoints
+	if err := jr.buildStrategyStore(); err != nil {
+		return err
+	}
+
 	if jr.collectorHTTPEnabled() {
 		cln, cerr := jr.config.CollectorHTTPSettings.ToListener()
 		if cerr != nil {
@@ -463,6 +475,11 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 
 		nr := mux.NewRouter()
 		nr.HandleFunc("/api/traces", jr.HandleThriftHTTPBatch).Methods(http.MethodPost)
+		clientcfghttp.NewHTTPHandler(clientcfghttp.HTTPHandlerParams{
+			ConfigManager:  &clientcfghttp.ConfigManager{SamplingStrategyStore: jr.strategyStore},
+			MetricsFactory: metrics.NullFactory,
+			BasePath:       "/api",
+		}).RegisterRoutes(nr)
 		jr.collectorServer, cerr = jr.config.CollectorHTTPSettings.ToServer(host, jr.settings.TelemetrySettings, nr)
 		if cerr != nil {
 			return cerr
@@ -491,16 +508,7 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 		}
 
 		api_v2.RegisterCollectorServiceServer(jr.grpc, jr)
-
-		// init and register sampling strategy store
-		ss, gerr := staticStrategyStore.NewStrategyStore(staticStrategyStore.Options{
-			StrategiesFile: jr.config.RemoteSamplingStrategyFile,
-			ReloadInterval: jr.config.RemoteSamplingStrategyFileReloadInterval,
-		}, jr.settings.Logger)
-		if gerr != nil {
-			return fmt.Errorf("failed to create collector strategy store: %v", gerr)
-		}
-		api_v2.RegisterSamplingManagerServer(jr.grpc, collectorSampling.NewGRPCHandler(ss))
+		api_v2.RegisterSamplingManagerServer(jr.grpc, collectorSampling.NewGRPCHandler(jr.strategyStore))
 
 		jr.goroutines.Add(1)
 		go func() {
@@ -513,3 +521,23 @@ func (jr *jReceiver) startCollector(host component.Host) error {
 
 	return nil
 }
+
+// buildStrategyStore sets up the store serving sampling strategies on the collector endpoints.
+// A strategy file takes precedence, then an explicitly configured upstream remote sampling
+// endpoint; otherwise the default strategy is served.
+func (jr *jReceiver) buildStrategyStore() error {
+	if jr.config.RemoteSamplingStrategyFile == "" && jr.config.RemoteSamplingUpstream && jr.agentSamplingManager != nil {
+		jr.strategyStore = jr.agentSamplingManager
+		return nil
+	}
+
+	ss, err := staticStrategyStore.NewStrategyStore(staticStrategyStore.Options{
+		StrategiesFile: jr.config.RemoteSamplingStrategyFile,
+		ReloadInterval: jr.config.RemoteSamplingStrategyFileReloadInterval,
+	}, jr.settings.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to create collector strategy store: %v", err)
+	}
+	jr.strategyStore = ss
+	return nil
+}
@@ -428,6 +428,82 @@ func TestSamplingFailsOnBadFile(t *testing.T) {
 	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
 }
 
+func TestSamplingHTTP(t *testing.T) {
+	port := testutil.GetAvailablePort(t)
+	config := &configuration{
+		CollectorHTTPPort:          int(port),
+		CollectorHTTPSettings:      confighttp.HTTPServerSettings{Endpoint: fmt.Sprintf("localhost:%d", port)},
+		RemoteSamplingStrategyFile: "testdata/strategies.json",
+	}
+	sink := new(consumertest.TracesSink)
+
+	set := componenttest.NewNopReceiverCreateSettings()
+	jr := newJaegerReceiver(jaegerReceiver, config, sink, set)
+
+	require.NoError(t, jr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
+
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/api/sampling?service=bar", port))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":5}}`, string(body))
+
+	resp, err = http.Get(fmt.Sprintf("http://localhost:%d/api/sampling", port))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSamplingFromUpstream(t *testing.T) {
+	// upstream serving strategies from a file
+	upstreamStore, err := staticStrategyStore.NewStrategyStore(staticStrategyStore.Options{
+		StrategiesFile: "testdata/strategies.json",
+	}, zap.NewNop())
+	require.NoError(t, err)
+	upstream := grpc.NewServer()
+	api_v2.RegisterSamplingManagerServer(upstream, collectorSampling.NewGRPCHandler(upstreamStore))
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go func() { _ = upstream.Serve(ln) }()
+	defer upstream.Stop()
+
+	port := testutil.GetAvailablePort(t)
+	config := &configuration{
+		CollectorGRPCPort: int(port),
+		AgentHTTPPort:     int(testutil.GetAvailablePort(t)),
+		RemoteSamplingClientSettings: configgrpc.GRPCClientSettings{
+			Endpoint: ln.Addr().String(),
+			TLSSetting: configtls.TLSClientSetting{
+				Insecure: true,
+			},
+		},
+		RemoteSamplingUpstream: true,
+	}
+	sink := new(consumertest.TracesSink)
+
+	set := componenttest.NewNopReceiverCreateSettings()
+	jr := newJaegerReceiver(jaegerReceiver, config, sink, set)
+
+	require.NoError(t, jr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, jr.Shutdown(context.Background())) })
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", config.CollectorGRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cl := api_v2.NewSamplingManagerClient(conn)
+	resp, err := cl.GetSamplingStrategy(context.Background(), &api_v2.SamplingStrategyParameters{
+		ServiceName: "foo",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, resp.GetProbabilisticSampling().GetSamplingRate())
+	require.Len(t, resp.GetOperationSampling().GetPerOperationStrategies(), 2)
+}
+
 func TestSamplingStrategiesMutualTLS(t *testing.T) {
 	caPath := filepath.Join("testdata", "ca.crt")
 	serverCertPath := filepath.Join("testdata", "server.crt")
@@ -0,0 +1,256 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Only two types of events are created by Kubernetes as of now.
+var eventSeverityMap = map[string]pdata.SeverityNumber{
+	"normal":  pdata.SeverityNumberINFO,
+	"warning": pdata.SeverityNumberWARN,
+}
+
+var _ component.LogsReceiver = (*eventsReceiver)(nil)
+
+// eventsReceiver watches v1 Events and emits one log record for every new
+// occurrence of an event series.
+type eventsReceiver struct {
+	config    *Config
+	settings  component.ReceiverCreateSettings
+	client    kubernetes.Interface
+	consumer  consumer.Logs
+	obsrecv   *obsreport.Receiver
+	startTime time.Time
+	ctx       context.Context
+	cancel    context.CancelFunc
+
+	mu sync.Mutex
+	// seen tracks the last emitted count of every event series, so that
+	// resyncs and updates that don't add an occurrence are not emitted again.
+	seen map[types.UID]int32
+}
+
+// newEventsReceiver creates the Kubernetes cluster receiver for events.
+func newEventsReceiver(
+	set component.ReceiverCreateSettings, config *Config, consumer consumer.Logs,
+	client kubernetes.Interface) (component.LogsReceiver, error) {
+	return &eventsReceiver{
+		config:    config,
+		settings:  set,
+		client:    client,
+		consumer:  consumer,
+		startTime: time.Now(),
+		seen:      map[types.UID]int32{},
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			ReceiverCreateSettings: set,
+		}),
+	}, nil
+}
+
+func (er *eventsReceiver) Start(ctx context.Context, _ component.Host) error {
+	er.ctx, er.cancel = context.WithCancel(ctx)
+
+	factory := informers.NewSharedInformerFactoryWithOptions(er.client, 0)
+	factory.Core().V1().Events().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    er.onAdd,
+		UpdateFunc: er.onUpdate,
+		DeleteFunc: er.onDelete,
+	})
+
+	er.settings.Logger.Info("Starting to watch Kubernetes events.")
+	factory.Start(er.ctx.Done())
+	return nil
+}
+
+func (er *eventsReceiver) Shutdown(context.Context) error {
+	if er.cancel != nil {
+		er.cancel()
+	}
+	return nil
+}
+
+func (er *eventsReceiver) onAdd(obj interface{}) {
+	if ev, ok := obj.(*corev1.Event); ok {
+		er.handleEvent(ev)
+	}
+}
+
+func (er *eventsReceiver) onUpdate(_, newObj interface{}) {
+	if ev, ok := newObj.(*corev1.Event); ok {
+		er.handleEvent(ev)
+	}
+}
+
+func (er *eventsReceiver) onDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if ev, ok := obj.(*corev1.Event); ok {
+		er.mu.Lock()
+		delete(er.seen, ev.UID)
+		er.mu.Unlock()
+	}
+}
+
+// handleEvent emits the event if its series has new occurrences since it was
+// last seen. Events last observed before the receiver started are only
+// recorded, to avoid replaying the whole event history on startup.
+func (er *eventsReceiver) handleEvent(ev *corev1.Event) {
+	count := eventSeriesCount(ev)
+
+	er.mu.Lock()
+	last, ok := er.seen[ev.UID]
+	if ok && count <= last {
+		er.mu.Unlock()
+		return
+	}
+	er.seen[ev.UID] = count
+	er.mu.Unlock()
+
+	if eventTimestamp(ev).Before(er.startTime) {
+		return
+	}
+
+	ld := eventToLogData(er.settings.Logger, ev)
+	ctx := er.obsrecv.StartLogsOp(er.ctx)
+	err := er.consumer.ConsumeLogs(ctx, ld)
+	er.obsrecv.EndLogsOp(ctx, typeStr, 1, err)
+}
+
+// eventSeriesCount returns the number of occurrences of the event, taking the
+// events.k8s.io series into account when it is set.
+func eventSeriesCount(ev *corev1.Event) int32 {
+	switch {
+	case ev.Series != nil && ev.Series.Count > 0:
+		return ev.Series.Count
+	case ev.Count > 0:
+		return ev.Count
+	}
+	return 1
+}
+
+// eventTimestamp returns the time of the last occurrence of the event.
+// Priority: Series.LastObservedTime > EventTime > LastTimestamp > FirstTimestamp.
+func eventTimestamp(ev *corev1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	}
+	return ev.FirstTimestamp.Time
+}
+
+// eventToLogData converts a Kubernetes event to a log record. The involved
+// object is described by the resource attributes.
+func eventToLogData(logger *zap.Logger, ev *corev1.Event) pdata.Logs {
+	ld := pdata.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+
+	resourceAttrs := rl.Resource().Attributes()
+	obj := ev.InvolvedObject
+	resourceAttrs.InsertString("k8s.object.kind", obj.Kind)
+	resourceAttrs.InsertString("k8s.object.name", obj.Name)
+	resourceAttrs.InsertString("k8s.object.uid", string(obj.UID))
+	if obj.FieldPath != "" {
+		resourceAttrs.InsertString("k8s.object.fieldpath", obj.FieldPath)
+	}
+	if obj.Namespace != "" {
+		resourceAttrs.InsertString(conventions.AttributeK8SNamespaceName, obj.Namespace)
+	}
+	if nameKey, uidKey, ok := involvedObjectKeys(obj.Kind); ok {
+		resourceAttrs.InsertString(nameKey, obj.Name)
+		if uidKey != "" && obj.UID != "" {
+			resourceAttrs.InsertString(uidKey, string(obj.UID))
+		}
+	}
+
+	lr := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pdata.NewTimestampFromTime(eventTimestamp(ev)))
+	lr.Body().SetStringVal(ev.Message)
+	if severityNumber, ok := eventSeverityMap[strings.ToLower(ev.Type)]; ok {
+		lr.SetSeverityNumber(severityNumber)
+		lr.SetSeverityText(ev.Type)
+	} else {
+		logger.Debug("unknown event type", zap.String("type", ev.Type))
+	}
+
+	attrs := lr.Attributes()
+	attrs.InsertString("k8s.event.reason", ev.Reason)
+	attrs.InsertString("k8s.event.type", ev.Type)
+	attrs.InsertInt("k8s.event.count", int64(eventSeriesCount(ev)))
+	attrs.InsertString("k8s.event.name", ev.Name)
+	attrs.InsertString("k8s.event.uid", string(ev.UID))
+	if ev.Action != "" {
+		attrs.InsertString("k8s.event.action", ev.Action)
+	}
+	if ev.Source.Component != "" {
+		attrs.InsertString("k8s.event.source.component", ev.Source.Component)
+	} else if ev.ReportingController != "" {
+		attrs.InsertString("k8s.event.source.component", ev.ReportingController)
+	}
+	if ev.Source.Host != "" {
+		attrs.InsertString(conventions.AttributeK8SNodeName, ev.Source.Host)
+	}
+
+	return ld
+}
+
+// involvedObjectKeys returns the semantic convention name and uid attribute
+// keys of the given kind of involved object.
+func involvedObjectKeys(kind string) (nameKey, uidKey string, ok bool) {
+	switch kind {
+	case "Pod":
+		return conventions.AttributeK8SPodName, conventions.AttributeK8SPodUID, true
+	case "Node":
+		return conventions.AttributeK8SNodeName, conventions.AttributeK8SNodeUID, true
+	case "Deployment":
+		return conventions.AttributeK8SDeploymentName, conventions.AttributeK8SDeploymentUID, true
+	case "ReplicaSet":
+		return conventions.AttributeK8SReplicaSetName, conventions.AttributeK8SReplicaSetUID, true
+	case "StatefulSet":
+		return conventions.AttributeK8SStatefulSetName, conventions.AttributeK8SStatefulSetUID, true
+	case "DaemonSet":
+		return conventions.AttributeK8SDaemonSetName, conventions.AttributeK8SDaemonSetUID, true
+	case "Job":
+		return conventions.AttributeK8SJobName, conventions.AttributeK8SJobUID, true
+	case "CronJob":
+		return conventions.AttributeK8SCronJobName, conventions.AttributeK8SCronJobUID, true
+	case "Namespace":
+		return conventions.AttributeK8SNamespaceName, "", true
+	}
+	return "", "", false
+}
@@ -0,0 +1,173 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sclusterreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTestEventsReceiver(t *testing.T, client *fake.Clientset, sink *consumertest.LogsSink) *eventsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	r, err := newEventsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink, client)
+	require.NoError(t, err)
+	er := r.(*eventsReceiver)
+	er.ctx = context.Background()
+	return er
+}
+
+func newTestEvent(count int32, lastTimestamp time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-pod.16d5d2e9c1b3c1a1",
+			Namespace: "default",
+			UID:       types.UID("event-uid"),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "test-pod",
+			Namespace: "default",
+			UID:       types.UID("pod-uid"),
+			FieldPath: "spec.containers{app}",
+		},
+		Reason:        "BackOff",
+		Message:       "Back-off restarting failed container",
+		Type:          "Warning",
+		Count:         count,
+		LastTimestamp: v1.NewTime(lastTimestamp),
+		Source: corev1.EventSource{
+			Component: "kubelet",
+			Host:      "node-1",
+		},
+	}
+}
+
+func TestEventToLogData(t *testing.T) {
+	now := time.Now()
+	ld := eventToLogData(zap.NewNop(), newTestEvent(3, now))
+
+	require.Equal(t, 1, ld.LogRecordCount())
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"k8s.object.kind":      "Pod",
+		"k8s.object.name":      "test-pod",
+		"k8s.object.uid":       "pod-uid",
+		"k8s.object.fieldpath": "spec.containers{app}",
+		"k8s.namespace.name":   "default",
+		"k8s.pod.name":         "test-pod",
+		"k8s.pod.uid":          "pod-uid",
+	}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "Back-off restarting failed container", lr.Body().StringVal())
+	assert.Equal(t, pdata.SeverityNumberWARN, lr.SeverityNumber())
+	assert.Equal(t, "Warning", lr.SeverityText())
+	assert.Equal(t, pdata.NewTimestampFromTime(now), lr.Timestamp())
+	assert.Equal(t, map[string]interface{}{
+		"k8s.event.reason":           "BackOff",
+		"k8s.event.type":             "Warning",
+		"k8s.event.count":            int64(3),
+		"k8s.event.name":             "test-pod.16d5d2e9c1b3c1a1",
+		"k8s.event.uid":              "event-uid",
+		"k8s.event.source.component": "kubelet",
+		"k8s.node.name":              "node-1",
+	}, lr.Attributes().AsRaw())
+}
+
+func TestEventSeriesCount(t *testing.T) {
+	ev := newTestEvent(0, time.Now())
+	assert.Equal(t, int32(1), eventSeriesCount(ev))
+
+	ev.Count = 4
+	assert.Equal(t, int32(4), eventSeriesCount(ev))
+
+	observed := time.Now().Add(time.Minute)
+	ev.Series = &corev1.EventSeries{Count: 7, LastObservedTime: v1.NewMicroTime(observed)}
+	assert.Equal(t, int32(7), eventSeriesCount(ev))
+	assert.True(t, eventTimestamp(ev).Equal(observed))
+}
+
+func TestEventsDeduplication(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestEventsReceiver(t, fake.NewSimpleClientset(), sink)
+
+	ev := newTestEvent(1, time.Now())
+	r.onAdd(ev)
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	// A resync or an update without a new occurrence is not emitted again.
+	r.onUpdate(ev, ev)
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	updated := ev.DeepCopy()
+	updated.Count = 2
+	r.onUpdate(ev, updated)
+	require.Equal(t, 2, sink.LogRecordCount())
+	assert.Equal(t, int64(2), sink.AllLogs()[1].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).
+		LogRecords().At(0).Attributes().AsRaw()["k8s.event.count"])
+
+	// Once deleted, the series starts over.
+	r.onDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+	r.onAdd(ev)
+	require.Equal(t, 3, sink.LogRecordCount())
+}
+
+func TestEventsBeforeStartAreSkipped(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestEventsReceiver(t, fake.NewSimpleClientset(), sink)
+
+	ev := newTestEvent(5, r.startTime.Add(-time.Hour))
+	r.onAdd(ev)
+	require.Equal(t, 0, sink.LogRecordCount())
+
+	// New occurrences of an old series are emitted.
+	updated := ev.DeepCopy()
+	updated.Count = 6
+	updated.LastTimestamp = v1.NewTime(time.Now())
+	r.onUpdate(ev, updated)
+	require.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestEventsReceiver(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	r, err := newEventsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink, client)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.Shutdown(ctx)) }()
+
+	_, err = client.CoreV1().Events("default").Create(ctx, newTestEvent(1, time.Now().Add(time.Second)), v1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 10*time.Second, 100*time.Millisecond, "event not emitted")
+}
@@ -81,10 +81,24 @@ func createMetricsReceiver(
 	return newReceiver(params, rCfg, consumer, k8sClient, osQuotaClient)
 }
 
+func createLogsReceiver(
+	_ context.Context, params component.ReceiverCreateSettings, cfg config.Receiver,
+	consumer consumer.Logs) (component.LogsReceiver, error) {
+	rCfg := cfg.(*Config)
+
+	k8sClient, err := rCfg.getK8sClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return newEventsReceiver(params, rCfg, consumer, k8sClient)
+}
+
 // NewFactory creates a factory for k8s_cluster receiver.
 func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiverhelper.WithMetrics(createMetricsReceiver))
+		receiverhelper.WithMetrics(createMetricsReceiver),
+		receiverhelper.WithLogs(createLogsReceiver))
 }
@@ -15,6 +15,7 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -42,6 +43,28 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+type HeaderExtraction struct {
+	// The keys of the message headers copied to the kafka.header.<key>
+	// attributes.
+	Headers []string `mapstructure:"headers"`
+
+	// If true, the partition of the message is copied to the kafka.partition
+	// attribute.
+	Partition bool `mapstructure:"partition"`
+
+	// If true, the offset of the message is copied to the kafka.offset
+	// attribute.
+	Offset bool `mapstructure:"offset"`
+
+	// If true, the key of the message is copied to the kafka.key attribute.
+	Key bool `mapstructure:"key"`
+
+	// Where the attributes are set for traces and logs: "record" (default)
+	// for the spans and log records, or "resource". They are always set on
+	// the resources for metrics.
+	Target string `mapstructure:"target"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -69,11 +92,21 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls the metadata of the messages copied to the attributes of the
+	// received records
+	HeaderExtraction HeaderExtraction `mapstructure:"header_extraction"`
 }
 
 var _ config.Receiver = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	switch cfg.HeaderExtraction.Target {
+	case HeaderExtractionTargetRecord, HeaderExtractionTargetResource:
+	default:
+		return fmt.Errorf("header_extraction target %q is not one of %q or %q",
+			cfg.HeaderExtraction.Target, HeaderExtractionTargetRecord, HeaderExtractionTargetResource)
+	}
 	return nil
 }
@@ -67,5 +67,21 @@ func TestLoadConfig(t *testing.T) {
 			Enable:   true,
 			Interval: 1 * time.Second,
 		},
+		HeaderExtraction: HeaderExtraction{
+			Headers: []string{"tenant"},
+			Offset:  true,
+			Target:  HeaderExtractionTargetRecord,
+		},
 	}, r)
 }
+
+func TestValidateHeaderExtractionTarget(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.HeaderExtraction.Target = HeaderExtractionTargetResource
+	assert.NoError(t, cfg.Validate())
+
+	cfg.HeaderExtraction.Target = "span"
+	assert.EqualError(t, cfg.Validate(), `header_extraction target "span" is not one of "record" or "resource"`)
+}
@@ -108,6 +108,9 @@ func createDefaultConfig() config.Receiver {
 		Brokers:          []string{defaultBroker},
 		ClientID:         defaultClientID,
 		GroupID:          defaultGroupID,
+		HeaderExtraction: HeaderExtraction{
+			Target: HeaderExtractionTargetRecord,
+		},
 		Metadata: kafkaexporter.Metadata{
 			Full: defaultMetadataFull,
 			Retry: kafkaexporter.MetadataRetry{
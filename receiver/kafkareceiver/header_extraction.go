@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+const (
+	// HeaderExtractionTargetRecord sets the extracted attributes on the spans
+	// and log records of the message.
+	HeaderExtractionTargetRecord = "record"
+	// HeaderExtractionTargetResource sets the extracted attributes on the
+	// resources of the message.
+	HeaderExtractionTargetResource = "resource"
+)
+
+// Attributes holding the metadata of the Kafka message a record was received in.
+const (
+	attributeHeaderPrefix = "kafka.header."
+	attributePartition    = "kafka.partition"
+	attributeOffset       = "kafka.offset"
+	attributeKey          = "kafka.key"
+)
+
+// headerExtractor copies the metadata of the Kafka messages to the attributes
+// of their records according to the HeaderExtraction settings.
+type headerExtractor struct {
+	cfg HeaderExtraction
+}
+
+// newHeaderExtractor returns the extractor for the settings, nil if they
+// don't extract anything.
+func newHeaderExtractor(cfg HeaderExtraction) *headerExtractor {
+	if len(cfg.Headers) == 0 && !cfg.Partition && !cfg.Offset && !cfg.Key {
+		return nil
+	}
+	return &headerExtractor{cfg: cfg}
+}
+
+// attributes returns the attributes extracted from the message. When a
+// header is repeated the last value is kept.
+func (he *headerExtractor) attributes(message *sarama.ConsumerMessage) pdata.AttributeMap {
+	attrs := pdata.NewAttributeMap()
+	for _, key := range he.cfg.Headers {
+		for _, header := range message.Headers {
+			if header != nil && string(header.Key) == key {
+				attrs.UpsertString(attributeHeaderPrefix+key, string(header.Value))
+			}
+		}
+	}
+	if he.cfg.Partition {
+		attrs.UpsertInt(attributePartition, int64(message.Partition))
+	}
+	if he.cfg.Offset {
+		attrs.UpsertInt(attributeOffset, message.Offset)
+	}
+	if he.cfg.Key && message.Key != nil {
+		attrs.UpsertString(attributeKey, string(message.Key))
+	}
+	return attrs
+}
+
+// extractTraces sets the attributes extracted from the message on the spans,
+// or the resources, of the traces.
+func (he *headerExtractor) extractTraces(message *sarama.ConsumerMessage, traces pdata.Traces) {
+	attrs := he.attributes(message)
+	rss := traces.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		if he.cfg.Target == HeaderExtractionTargetResource {
+			upsertAttributes(rs.Resource().Attributes(), attrs)
+			continue
+		}
+		ilss := rs.InstrumentationLibrarySpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				upsertAttributes(spans.At(k).Attributes(), attrs)
+			}
+		}
+	}
+}
+
+// extractMetrics sets the attributes extracted from the message on the
+// resources of the metrics, whatever the target, as the attributes of the
+// data points identify their time series.
+func (he *headerExtractor) extractMetrics(message *sarama.ConsumerMessage, metrics pdata.Metrics) {
+	attrs := he.attributes(message)
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		upsertAttributes(rms.At(i).Resource().Attributes(), attrs)
+	}
+}
+
+// extractLogs sets the attributes extracted from the message on the log
+// records, or the resources, of the logs.
+func (he *headerExtractor) extractLogs(message *sarama.ConsumerMessage, logs pdata.Logs) {
+	attrs := he.attributes(message)
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		if he.cfg.Target == HeaderExtractionTargetResource {
+			upsertAttributes(rl.Resource().Attributes(), attrs)
+			continue
+		}
+		ills := rl.InstrumentationLibraryLogs()
+		for j := 0; j < ills.Len(); j++ {
+			records := ills.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				upsertAttributes(records.At(k).Attributes(), attrs)
+			}
+		}
+	}
+}
+
+func upsertAttributes(dest pdata.AttributeMap, attrs pdata.AttributeMap) {
+	attrs.Range(func(k string, v pdata.AttributeValue) bool {
+		dest.Upsert(k, v)
+		return true
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/otlp"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func testMessage() *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("trace"), Value: []byte("ignored")},
+			{Key: []byte("tenant"), Value: []byte("globex")},
+		},
+		Key:       []byte("checkout"),
+		Partition: 3,
+		Offset:    42,
+	}
+}
+
+func TestNewHeaderExtractor(t *testing.T) {
+	assert.Nil(t, newHeaderExtractor(HeaderExtraction{Target: HeaderExtractionTargetRecord}))
+	assert.NotNil(t, newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}}))
+	assert.NotNil(t, newHeaderExtractor(HeaderExtraction{Offset: true}))
+}
+
+func TestHeaderExtractorAttributes(t *testing.T) {
+	he := newHeaderExtractor(HeaderExtraction{
+		Headers:   []string{"tenant", "missing"},
+		Partition: true,
+		Offset:    true,
+		Key:       true,
+	})
+	assert.Equal(t, map[string]interface{}{
+		"kafka.header.tenant": "globex",
+		"kafka.partition":     int64(3),
+		"kafka.offset":        int64(42),
+		"kafka.key":           "checkout",
+	}, he.attributes(testMessage()).AsRaw())
+
+	message := testMessage()
+	message.Key = nil
+	assert.Equal(t, map[string]interface{}{
+		"kafka.header.tenant": "globex",
+		"kafka.partition":     int64(3),
+		"kafka.offset":        int64(42),
+	}, he.attributes(message).AsRaw())
+}
+
+func TestHeaderExtractorTraces(t *testing.T) {
+	he := newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}, Target: HeaderExtractionTargetRecord})
+	traces := testdata.GenerateTracesOneSpan()
+	he.extractTraces(testMessage(), traces)
+	span := traces.ResourceSpans().At(0).InstrumentationLibrarySpans().At(0).Spans().At(0)
+	tenant, ok := span.Attributes().Get("kafka.header.tenant")
+	require.True(t, ok)
+	assert.Equal(t, "globex", tenant.StringVal())
+	_, ok = traces.ResourceSpans().At(0).Resource().Attributes().Get("kafka.header.tenant")
+	assert.False(t, ok)
+
+	he = newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}, Target: HeaderExtractionTargetResource})
+	traces = testdata.GenerateTracesOneSpan()
+	he.extractTraces(testMessage(), traces)
+	tenant, ok = traces.ResourceSpans().At(0).Resource().Attributes().Get("kafka.header.tenant")
+	require.True(t, ok)
+	assert.Equal(t, "globex", tenant.StringVal())
+}
+
+func TestHeaderExtractorMetrics(t *testing.T) {
+	he := newHeaderExtractor(HeaderExtraction{Offset: true, Target: HeaderExtractionTargetRecord})
+	metrics := testdata.GenerateMetricsOneMetric()
+	he.extractMetrics(testMessage(), metrics)
+	offset, ok := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("kafka.offset")
+	require.True(t, ok)
+	assert.Equal(t, int64(42), offset.IntVal())
+}
+
+func TestLogsConsumerGroupHandlerHeaderExtraction(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	c := logsConsumerGroupHandler{
+		unmarshaler:     newPdataLogsUnmarshaler(otlp.NewProtobufLogsUnmarshaler(), defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    sink,
+		obsrecv:         obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings()}),
+		headerExtractor: newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}, Partition: true, Target: HeaderExtractionTargetRecord}),
+	}
+
+	testSession := testConsumerGroupSession{}
+	groupClaim := testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		require.NoError(t, c.ConsumeClaim(testSession, groupClaim))
+		wg.Done()
+	}()
+
+	value, err := otlp.NewProtobufLogsMarshaler().MarshalLogs(testdata.GenerateLogsOneLogRecord())
+	require.NoError(t, err)
+	message := testMessage()
+	message.Value = value
+	groupClaim.messageChan <- message
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, sink.AllLogs(), 1)
+	record := sink.AllLogs()[0].ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+	tenant, ok := record.Attributes().Get("kafka.header.tenant")
+	require.True(t, ok)
+	assert.Equal(t, "globex", tenant.StringVal())
+	partition, ok := record.Attributes().Get("kafka.partition")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), partition.IntVal())
+}
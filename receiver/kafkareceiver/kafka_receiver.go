@@ -50,6 +50,7 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -65,6 +66,7 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -80,6 +82,7 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 var _ component.Receiver = (*kafkaTracesConsumer)(nil)
@@ -120,6 +123,7 @@ func newTracesReceiver(config Config, set component.ReceiverCreateSettings, unma
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -139,6 +143,7 @@ func (c *kafkaTracesConsumer) Start(context.Context, component.Host) error {
 		}),
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go c.consumeLoop(ctx, consumerGroup) // nolint:errcheck
 	<-consumerGroup.ready
@@ -203,6 +208,7 @@ func newMetricsReceiver(config Config, set component.ReceiverCreateSettings, unm
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -222,6 +228,7 @@ func (c *kafkaMetricsConsumer) Start(context.Context, component.Host) error {
 		}),
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go c.consumeLoop(ctx, metricsConsumerGroup)
 	<-metricsConsumerGroup.ready
@@ -283,6 +290,7 @@ func newLogsReceiver(config Config, set component.ReceiverCreateSettings, unmars
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -302,6 +310,7 @@ func (c *kafkaLogsConsumer) Start(context.Context, component.Host) error {
 		}),
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go c.consumeLoop(ctx, logsConsumerGroup)
 	<-logsConsumerGroup.ready
@@ -342,6 +351,8 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	// headerExtractor is nil when no metadata of the messages is extracted
+	headerExtractor *headerExtractor
 }
 
 type metricsConsumerGroupHandler struct {
@@ -357,6 +368,8 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	// headerExtractor is nil when no metadata of the messages is extracted
+	headerExtractor *headerExtractor
 }
 
 type logsConsumerGroupHandler struct {
@@ -372,6 +385,8 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	// headerExtractor is nil when no metadata of the messages is extracted
+	headerExtractor *headerExtractor
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -422,6 +437,9 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 			}
 			return err
 		}
+		if c.headerExtractor != nil {
+			c.headerExtractor.extractTraces(message, traces)
+		}
 
 		spanCount := traces.SpanCount()
 		err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
@@ -487,6 +505,9 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 			}
 			return err
 		}
+		if c.headerExtractor != nil {
+			c.headerExtractor.extractMetrics(message, metrics)
+		}
 
 		dataPointCount := metrics.DataPointCount()
 		err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
@@ -556,6 +577,9 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 			}
 			return err
 		}
+		if c.headerExtractor != nil {
+			c.headerExtractor.extractLogs(message, logs)
+		}
 
 		err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
 		// TODO
@@ -15,7 +15,12 @@
 package memcachedreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver"
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grobie/gomemcache/memcache"
@@ -23,10 +28,18 @@ import (
 
 type client interface {
 	Stats() (map[net.Addr]memcache.Stats, error)
+	CacheDump(addr net.Addr, slab string, limit int) ([]cachedItem, error)
+}
+
+// cachedItem is an item listed by the `stats cachedump` command.
+type cachedItem struct {
+	Key  string
+	Size int64
 }
 
 type memcachedClient struct {
-	client *memcache.Client
+	client  *memcache.Client
+	timeout time.Duration
 }
 
 type newMemcachedClientFunc func(endpoint string, timeout time.Duration) (client, error)
@@ -39,7 +52,8 @@ func newMemcachedClient(endpoint string, timeout time.Duration) (client, error)
 
 	newClient.Timeout = timeout
 	return &memcachedClient{
-		client: newClient,
+		client:  newClient,
+		timeout: timeout,
 	}, nil
 }
 
@@ -48,3 +62,53 @@ var _ client = (*memcachedClient)(nil)
 func (c *memcachedClient) Stats() (map[net.Addr]memcache.Stats, error) {
 	return c.client.Stats()
 }
+
+// CacheDump lists up to limit items of the given slab class, most recently
+// accessed first. The gomemcache client does not support this command so it
+// is issued on a dedicated connection.
+func (c *memcachedClient) CacheDump(addr net.Addr, slab string, limit int) ([]cachedItem, error) {
+	conn, err := net.DialTimeout(addr.Network(), addr.String(), c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if c.timeout > 0 {
+		if err = conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = fmt.Fprintf(conn, "stats cachedump %s %d\r\n", slab, limit); err != nil {
+		return nil, err
+	}
+	return parseCacheDump(bufio.NewReader(conn))
+}
+
+// parseCacheDump parses the `ITEM <key> [<size> b; <expiration> s]` lines
+// returned by the `stats cachedump` command up to the final `END` line.
+func parseCacheDump(r *bufio.Reader) ([]cachedItem, error) {
+	var items []cachedItem
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "END":
+			return items, nil
+		case strings.HasPrefix(line, "ITEM "):
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("malformed cachedump line %q", line)
+			}
+			size, err := strconv.ParseInt(strings.TrimPrefix(fields[2], "["), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("malformed cachedump line %q: %w", line, err)
+			}
+			items = append(items, cachedItem{Key: fields[1], Size: size})
+		default:
+			return nil, errors.New(line)
+		}
+	}
+}
@@ -48,3 +48,14 @@ func (c *fakeClient) Stats() (map[net.Addr]memcache.Stats, error) {
 
 	return stats, nil
 }
+
+func (c *fakeClient) CacheDump(_ net.Addr, _ string, limit int) ([]cachedItem, error) {
+	items := []cachedItem{
+		{Key: "session:1", Size: 70},
+		{Key: "user:42", Size: 68},
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
@@ -0,0 +1,73 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memcachedreceiver
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCacheDump(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		resp     string
+		expected []cachedItem
+		errMsg   string
+	}{
+		{
+			desc: "items",
+			resp: "ITEM session:1 [70 b; 0 s]\r\nITEM user:42 [68 b; 1646400000 s]\r\nEND\r\n",
+			expected: []cachedItem{
+				{Key: "session:1", Size: 70},
+				{Key: "user:42", Size: 68},
+			},
+		},
+		{
+			desc: "empty slab",
+			resp: "END\r\n",
+		},
+		{
+			desc:   "malformed item",
+			resp:   "ITEM session:1 [seventy b; 0 s]\r\nEND\r\n",
+			errMsg: "malformed cachedump line",
+		},
+		{
+			desc:   "server error",
+			resp:   "CLIENT_ERROR bad command line format\r\n",
+			errMsg: "CLIENT_ERROR bad command line format",
+		},
+		{
+			desc:   "truncated response",
+			resp:   "ITEM session:1 [70 b; 0 s]\r\n",
+			errMsg: "EOF",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			items, err := parseCacheDump(bufio.NewReader(strings.NewReader(tc.resp)))
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, items)
+		})
+	}
+}
@@ -27,4 +27,21 @@ type Config struct {
 
 	// Timeout for the memcache stats request
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// SlabStats enables the per slab class metrics reported by the
+	// `stats slabs` and `stats items` commands.
+	SlabStats bool `mapstructure:"slab_stats"`
+
+	// HotKeys configures the sampling of recently accessed keys.
+	HotKeys HotKeysConfig `mapstructure:"hot_keys"`
+}
+
+// HotKeysConfig configures the sampling of the most recently accessed keys
+// of every slab class with the `stats cachedump` command. The command locks
+// the slab class while it runs, so it is disabled by default.
+type HotKeysConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Limit is the maximum number of keys sampled per slab class.
+	Limit int `mapstructure:"limit"`
 }
@@ -47,6 +47,9 @@ func createDefaultConfig() config.Receiver {
 			CollectionInterval: 10 * time.Second,
 		},
 		Timeout: 10 * time.Second,
+		HotKeys: HotKeysConfig{
+			Limit: 10,
+		},
 		NetAddr: confignet.NetAddr{
 			Endpoint: "localhost:11211",
 		},
@@ -48,9 +48,15 @@ type metricStruct struct {
 	MemcachedCPUUsage           MetricIntf
 	MemcachedCurrentItems       MetricIntf
 	MemcachedEvictions          MetricIntf
+	MemcachedHotKeySize         MetricIntf
 	MemcachedNetwork            MetricIntf
 	MemcachedOperationHitRatio  MetricIntf
 	MemcachedOperations         MetricIntf
+	MemcachedSlabChunkSize      MetricIntf
+	MemcachedSlabChunks         MetricIntf
+	MemcachedSlabEvictions      MetricIntf
+	MemcachedSlabItemAge        MetricIntf
+	MemcachedSlabItems          MetricIntf
 	MemcachedThreads            MetricIntf
 }
 
@@ -64,9 +70,15 @@ func (m *metricStruct) Names() []string {
 		"memcached.cpu.usage",
 		"memcached.current_items",
 		"memcached.evictions",
+		"memcached.hot_key.size",
 		"memcached.network",
 		"memcached.operation_hit_ratio",
 		"memcached.operations",
+		"memcached.slab.chunk_size",
+		"memcached.slab.chunks",
+		"memcached.slab.evictions",
+		"memcached.slab.item_age",
+		"memcached.slab.items",
 		"memcached.threads",
 	}
 }
@@ -79,9 +91,15 @@ var metricsByName = map[string]MetricIntf{
 	"memcached.cpu.usage":           Metrics.MemcachedCPUUsage,
 	"memcached.current_items":       Metrics.MemcachedCurrentItems,
 	"memcached.evictions":           Metrics.MemcachedEvictions,
+	"memcached.hot_key.size":        Metrics.MemcachedHotKeySize,
 	"memcached.network":             Metrics.MemcachedNetwork,
 	"memcached.operation_hit_ratio": Metrics.MemcachedOperationHitRatio,
 	"memcached.operations":          Metrics.MemcachedOperations,
+	"memcached.slab.chunk_size":     Metrics.MemcachedSlabChunkSize,
+	"memcached.slab.chunks":         Metrics.MemcachedSlabChunks,
+	"memcached.slab.evictions":      Metrics.MemcachedSlabEvictions,
+	"memcached.slab.item_age":       Metrics.MemcachedSlabItemAge,
+	"memcached.slab.items":          Metrics.MemcachedSlabItems,
 	"memcached.threads":             Metrics.MemcachedThreads,
 }
 
@@ -167,6 +185,15 @@ var Metrics = &metricStruct{
 			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 		},
 	},
+	&metricImpl{
+		"memcached.hot_key.size",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.hot_key.size")
+			metric.SetDescription("Size of the most recently accessed items sampled with the cachedump command.")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
 	&metricImpl{
 		"memcached.network",
 		func(metric pdata.Metric) {
@@ -198,6 +225,57 @@ var Metrics = &metricStruct{
 			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 		},
 	},
+	&metricImpl{
+		"memcached.slab.chunk_size",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.slab.chunk_size")
+			metric.SetDescription("Size of the chunks allocated in the slab class.")
+			metric.SetUnit("By")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"memcached.slab.chunks",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.slab.chunks")
+			metric.SetDescription("Number of chunks allocated in the slab class.")
+			metric.SetUnit("{chunks}")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(false)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"memcached.slab.evictions",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.slab.evictions")
+			metric.SetDescription("Items evicted from the slab class.")
+			metric.SetUnit("{evictions}")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(true)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
+	&metricImpl{
+		"memcached.slab.item_age",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.slab.item_age")
+			metric.SetDescription("Age of the oldest item in the slab class.")
+			metric.SetUnit("s")
+			metric.SetDataType(pdata.MetricDataTypeGauge)
+		},
+	},
+	&metricImpl{
+		"memcached.slab.items",
+		func(metric pdata.Metric) {
+			metric.SetName("memcached.slab.items")
+			metric.SetDescription("Number of items currently stored in the slab class.")
+			metric.SetUnit("{items}")
+			metric.SetDataType(pdata.MetricDataTypeSum)
+			metric.Sum().SetIsMonotonic(false)
+			metric.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+		},
+	},
 	&metricImpl{
 		"memcached.threads",
 		func(metric pdata.Metric) {
@@ -217,20 +295,29 @@ var M = Metrics
 
 // Attributes contains the possible metric attributes that can be used.
 var Attributes = struct {
+	// ChunkState (Whether the slab chunks are in use.)
+	ChunkState string
 	// Command (The type of command.)
 	Command string
 	// Direction (Direction of data flow.)
 	Direction string
+	// Key (The item key sampled from the slab class.)
+	Key string
 	// Operation (The type of operation.)
 	Operation string
+	// Slab (The slab class identifier.)
+	Slab string
 	// State (The type of CPU usage.)
 	State string
 	// Type (Result of cache request.)
 	Type string
 }{
+	"chunk_state",
 	"command",
 	"direction",
+	"key",
 	"operation",
+	"slab",
 	"state",
 	"type",
 }
@@ -238,6 +325,15 @@ var Attributes = struct {
 // A is an alias for Attributes.
 var A = Attributes
 
+// AttributeChunkState are the possible values that the attribute "chunk_state" can have.
+var AttributeChunkState = struct {
+	Used string
+	Free string
+}{
+	"used",
+	"free",
+}
+
 // AttributeCommand are the possible values that the attribute "command" can have.
 var AttributeCommand = struct {
 	Get   string
@@ -74,7 +74,16 @@ func (r *memcachedScraper) scrape(_ context.Context) (pdata.Metrics, error) {
 	threads := initMetric(ilm.Metrics(), metadata.M.MemcachedThreads).Sum().DataPoints()
 	evictions := initMetric(ilm.Metrics(), metadata.M.MemcachedEvictions).Sum().DataPoints()
 
-	for _, stats := range allServerStats {
+	var slabs slabDataPoints
+	if r.config.SlabStats {
+		slabs = newSlabDataPoints(ilm.Metrics())
+	}
+	var hotKeySize pdata.NumberDataPointSlice
+	if r.config.HotKeys.Enabled {
+		hotKeySize = initMetric(ilm.Metrics(), metadata.M.MemcachedHotKeySize).Gauge().DataPoints()
+	}
+
+	for addr, stats := range allServerStats {
 		for k, v := range stats.Stats {
 			attributes := pdata.NewAttributeMap()
 			switch k {
@@ -208,6 +217,13 @@ func (r *memcachedScraper) scrape(_ context.Context) (pdata.Metrics, error) {
 		if okHit && okMiss {
 			r.addToDoubleMetric(hitRatio, attributes, calculateHitRatio(parsedHit, parsedMiss), now)
 		}
+
+		if r.config.SlabStats {
+			r.recordSlabStats(slabs, stats, now)
+		}
+		if r.config.HotKeys.Enabled {
+			r.recordHotKeys(statsClient, addr, hotKeySize, stats, now)
+		}
 	}
 	return md, nil
 }
@@ -44,3 +44,23 @@ func TestScraper(t *testing.T) {
 
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
+
+func TestScraperSlabStats(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.SlabStats = true
+	cfg.HotKeys.Enabled = true
+	scraper := newMemcachedScraper(zap.NewNop(), cfg)
+	scraper.newClient = func(endpoint string, timeout time.Duration) (client, error) {
+		return &fakeClient{}, nil
+	}
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "expected_metrics", "test_scraper_slabs", "expected.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+}
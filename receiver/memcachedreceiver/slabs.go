@@ -0,0 +1,115 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memcachedreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver"
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/grobie/gomemcache/memcache"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver/internal/metadata"
+)
+
+// slabDataPoints holds the data points of the per slab class metrics.
+type slabDataPoints struct {
+	chunkSize pdata.NumberDataPointSlice
+	chunks    pdata.NumberDataPointSlice
+	items     pdata.NumberDataPointSlice
+	itemAge   pdata.NumberDataPointSlice
+	evictions pdata.NumberDataPointSlice
+}
+
+func newSlabDataPoints(ms pdata.MetricSlice) slabDataPoints {
+	return slabDataPoints{
+		chunkSize: initMetric(ms, metadata.M.MemcachedSlabChunkSize).Gauge().DataPoints(),
+		chunks:    initMetric(ms, metadata.M.MemcachedSlabChunks).Sum().DataPoints(),
+		items:     initMetric(ms, metadata.M.MemcachedSlabItems).Sum().DataPoints(),
+		itemAge:   initMetric(ms, metadata.M.MemcachedSlabItemAge).Gauge().DataPoints(),
+		evictions: initMetric(ms, metadata.M.MemcachedSlabEvictions).Sum().DataPoints(),
+	}
+}
+
+// recordSlabStats records the `stats slabs` and `stats items` statistics of
+// every slab class of a server.
+func (r *memcachedScraper) recordSlabStats(dps slabDataPoints, stats memcache.Stats, now pdata.Timestamp) {
+	for slab, slabStats := range stats.Slabs {
+		slabID := fmt.Sprint(slab)
+		for k, v := range slabStats {
+			attributes := pdata.NewAttributeMap()
+			attributes.Insert(metadata.A.Slab, pdata.NewAttributeValueString(slabID))
+			switch k {
+			case "chunk_size":
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.chunkSize, attributes, parsedV, now)
+				}
+			case "used_chunks":
+				attributes.Insert(metadata.A.ChunkState, pdata.NewAttributeValueString(metadata.AttributeChunkState.Used))
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.chunks, attributes, parsedV, now)
+				}
+			case "free_chunks":
+				attributes.Insert(metadata.A.ChunkState, pdata.NewAttributeValueString(metadata.AttributeChunkState.Free))
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.chunks, attributes, parsedV, now)
+				}
+			}
+		}
+	}
+
+	for slab, itemStats := range stats.Items {
+		slabID := fmt.Sprint(slab)
+		for k, v := range itemStats {
+			attributes := pdata.NewAttributeMap()
+			attributes.Insert(metadata.A.Slab, pdata.NewAttributeValueString(slabID))
+			switch k {
+			case "number":
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.items, attributes, parsedV, now)
+				}
+			case "age":
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.itemAge, attributes, parsedV, now)
+				}
+			case "evicted":
+				if parsedV, ok := r.parseInt(k, v); ok {
+					r.addToIntMetric(dps.evictions, attributes, parsedV, now)
+				}
+			}
+		}
+	}
+}
+
+// recordHotKeys samples the most recently accessed keys of every slab class
+// holding items. A failure for one slab class does not fail the scrape.
+func (r *memcachedScraper) recordHotKeys(c client, addr net.Addr, hotKeySize pdata.NumberDataPointSlice, stats memcache.Stats, now pdata.Timestamp) {
+	for slab := range stats.Items {
+		slabID := fmt.Sprint(slab)
+		items, err := c.CacheDump(addr, slabID, r.config.HotKeys.Limit)
+		if err != nil {
+			r.logger.Warn("Failed to sample memcached hot keys",
+				zap.String("server", addr.String()), zap.String("slab", slabID), zap.Error(err))
+			continue
+		}
+		for _, item := range items {
+			attributes := pdata.NewAttributeMap()
+			attributes.Insert(metadata.A.Slab, pdata.NewAttributeValueString(slabID))
+			attributes.Insert(metadata.A.Key, pdata.NewAttributeValueString(item.Key))
+			r.addToIntMetric(hotKeySize, attributes, item.Size, now)
+		}
+	}
+}
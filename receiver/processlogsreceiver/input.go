@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/processlogsreceiver"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-log-collection/entry"
+	"github.com/open-telemetry/opentelemetry-log-collection/operator"
+	"github.com/open-telemetry/opentelemetry-log-collection/operator/helper"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.uber.org/zap"
+)
+
+const (
+	// restartNever never restarts the command once it exited
+	restartNever = "never"
+	// restartOnFailure restarts the command when it exited with a non zero code
+	restartOnFailure = "on_failure"
+	// restartAlways restarts the command whatever its exit code
+	restartAlways = "always"
+
+	// iostreamAttribute is the attribute identifying the output a line was written to, stdout or stderr
+	iostreamAttribute = "log.iostream"
+	// exitCodeAttribute is the attribute of the exit records holding the exit code of the command,
+	// -1 when it was terminated by a signal
+	exitCodeAttribute = "process.exit_code"
+
+	defaultRestartDelay = time.Second
+	defaultMaxLogSize   = 1024 * 1024
+)
+
+func newProcessInputConfig(operatorID string) *processInputConfig {
+	return &processInputConfig{
+		InputConfig:   helper.NewInputConfig(operatorID, "process_input"),
+		RestartPolicy: restartNever,
+		RestartDelay:  helper.NewDuration(defaultRestartDelay),
+		MaxLogSize:    defaultMaxLogSize,
+	}
+}
+
+// processInputConfig is the configuration of the input operator running the command
+type processInputConfig struct {
+	helper.InputConfig `yaml:",inline"`
+
+	Command          string            `yaml:"command"`
+	Args             []string          `yaml:"args,omitempty"`
+	Env              map[string]string `yaml:"env,omitempty"`
+	WorkingDirectory string            `yaml:"working_directory,omitempty"`
+	RestartPolicy    string            `yaml:"restart_policy,omitempty"`
+	RestartDelay     helper.Duration   `yaml:"restart_delay,omitempty"`
+	MaxLogSize       helper.ByteSize   `yaml:"max_log_size,omitempty"`
+}
+
+// Build will build the input operator running the command from the supplied configuration
+func (c processInputConfig) Build(buildContext operator.BuildContext) ([]operator.Operator, error) {
+	if c.Command == "" {
+		return nil, errors.New("missing required parameter 'command'")
+	}
+
+	switch c.RestartPolicy {
+	case restartNever, restartOnFailure, restartAlways:
+	default:
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'restart_policy'", c.RestartPolicy)
+	}
+
+	if c.RestartDelay.Raw() < 0 {
+		return nil, errors.New("'restart_delay' must not be negative")
+	}
+
+	if c.MaxLogSize <= 0 {
+		return nil, errors.New("'max_log_size' must be positive")
+	}
+
+	inputOperator, err := c.InputConfig.Build(buildContext)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]string, 0, len(c.Env))
+	for name, value := range c.Env {
+		env = append(env, name+"="+value)
+	}
+	sort.Strings(env)
+
+	processInput := &processInput{
+		InputOperator: inputOperator,
+		command:       c.Command,
+		args:          c.Args,
+		env:           env,
+		dir:           c.WorkingDirectory,
+		restartPolicy: c.RestartPolicy,
+		restartDelay:  c.RestartDelay.Raw(),
+		maxLogSize:    int(c.MaxLogSize),
+		resource: map[string]string{
+			conventions.AttributeProcessCommand:     c.Command,
+			conventions.AttributeProcessCommandLine: strings.Join(append([]string{c.Command}, c.Args...), " "),
+		},
+	}
+	return []operator.Operator{processInput}, nil
+}
+
+// processInput is an operator running a command and writing the lines of its
+// standard output and error, and a record for each of its exits
+type processInput struct {
+	helper.InputOperator
+
+	command       string
+	args          []string
+	env           []string
+	dir           string
+	restartPolicy string
+	restartDelay  time.Duration
+	maxLogSize    int
+	resource      map[string]string
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Start will start the command, failing if it can't be started.
+func (p *processInput) Start(_ operator.Persister) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	cmd, outputs, err := p.startProcess(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to start %s: %w", p.command, err)
+	}
+
+	p.wg.Add(1)
+	go p.run(ctx, cmd, outputs)
+	return nil
+}
+
+// run waits for the command to exit and restarts it according to the restart policy.
+func (p *processInput) run(ctx context.Context, cmd *exec.Cmd, outputs *sync.WaitGroup) {
+	defer p.wg.Done()
+
+	for {
+		succeeded := p.waitProcess(ctx, cmd, outputs)
+		for {
+			if ctx.Err() != nil || !p.shouldRestart(succeeded) {
+				return
+			}
+
+			p.Infow("Restarting process", zap.Duration("delay", p.restartDelay))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(p.restartDelay):
+			}
+
+			var err error
+			if cmd, outputs, err = p.startProcess(ctx); err == nil {
+				break
+			}
+			p.Errorw("Failed to restart process", zap.Error(err))
+			succeeded = false
+		}
+	}
+}
+
+func (p *processInput) shouldRestart(succeeded bool) bool {
+	switch p.restartPolicy {
+	case restartAlways:
+		return true
+	case restartOnFailure:
+		return !succeeded
+	default:
+		return false
+	}
+}
+
+// startProcess starts the command and the goroutines reading its outputs,
+// returning the wait group of the goroutines.
+func (p *processInput) startProcess(ctx context.Context) (*exec.Cmd, *sync.WaitGroup, error) {
+	cmd := exec.CommandContext(ctx, p.command, p.args...) // #nosec
+	cmd.Dir = p.dir
+	if len(p.env) > 0 {
+		cmd.Env = append(os.Environ(), p.env...)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get stdout: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get stderr: %w", err)
+	}
+
+	if err = cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	pid := strconv.Itoa(cmd.Process.Pid)
+	outputs := &sync.WaitGroup{}
+	outputs.Add(2)
+	go p.readOutput(ctx, outputs, stdout, "stdout", pid)
+	go p.readOutput(ctx, outputs, stderr, "stderr", pid)
+	return cmd, outputs, nil
+}
+
+// waitProcess waits for the command to exit and writes its exit record,
+// returning whether it exited with a zero code.
+func (p *processInput) waitProcess(ctx context.Context, cmd *exec.Cmd, outputs *sync.WaitGroup) bool {
+	// The outputs must be read before waiting for the command, unless it is
+	// killed on shutdown: a process it started could keep them open.
+	outputsDone := make(chan struct{})
+	go func() {
+		outputs.Wait()
+		close(outputsDone)
+	}()
+	select {
+	case <-outputsDone:
+	case <-ctx.Done():
+	}
+
+	err := cmd.Wait()
+	<-outputsDone
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		p.Errorw("Failed to wait for process", zap.Error(err))
+		return false
+	}
+
+	exitCode := cmd.ProcessState.ExitCode()
+	var body string
+	if exitCode < 0 {
+		body = fmt.Sprintf("process terminated: %s", cmd.ProcessState)
+	} else {
+		body = fmt.Sprintf("process exited with code %d", exitCode)
+	}
+
+	e, err := p.newEntry(body, strconv.Itoa(cmd.ProcessState.Pid()))
+	if err != nil {
+		p.Errorw("Failed to create exit entry", zap.Error(err))
+	} else {
+		e.AddAttribute(exitCodeAttribute, strconv.Itoa(exitCode))
+		if exitCode == 0 {
+			e.Severity = entry.Info
+		} else {
+			e.Severity = entry.Error
+		}
+		p.Write(ctx, e)
+	}
+	return exitCode == 0
+}
+
+// readOutput writes an entry for each line of an output of the command.
+func (p *processInput) readOutput(ctx context.Context, wg *sync.WaitGroup, r io.Reader, stream, pid string) {
+	defer wg.Done()
+
+	scanner := bufio.NewScanner(r)
+	bufSize := 4096
+	if p.maxLogSize < bufSize {
+		bufSize = p.maxLogSize
+	}
+	scanner.Buffer(make([]byte, 0, bufSize), p.maxLogSize)
+	scanner.Split(splitLines(p.maxLogSize))
+
+	for scanner.Scan() {
+		e, err := p.newEntry(scanner.Text(), pid)
+		if err != nil {
+			p.Errorw("Failed to create entry", zap.Error(err))
+			continue
+		}
+		e.AddAttribute(iostreamAttribute, stream)
+		p.Write(ctx, e)
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		p.Errorw("Failed to read process output", zap.String("stream", stream), zap.Error(err))
+	}
+}
+
+// newEntry creates an entry with the process attributes, the resource
+// configured by the user taking precedence.
+func (p *processInput) newEntry(body, pid string) (*entry.Entry, error) {
+	e, err := p.NewEntry(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range p.resource {
+		if _, ok := e.Resource[k]; !ok {
+			e.AddResourceKey(k, v)
+		}
+	}
+	e.AddAttribute(conventions.AttributeProcessPID, pid)
+	return e, nil
+}
+
+// splitLines splits the output in lines, the lines longer than maxSize being
+// split in several entries.
+func splitLines(maxSize int) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if advance == 0 && token == nil && err == nil && len(data) >= maxSize {
+			return maxSize, data[:maxSize], nil
+		}
+		return advance, token, err
+	}
+}
+
+// Stop will kill the command and stop generating logs.
+func (p *processInput) Stop() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+	return nil
+}
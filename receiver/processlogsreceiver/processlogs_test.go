@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processlogsreceiver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+	"go.opentelemetry.io/collector/service/servicetest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/stanza"
+)
+
+// TestHelperProcess is not a real test, it is the command run by the tests,
+// writing a line to its stdout and stderr before exiting with a code.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("PROCESSLOGS_HELPER") != "1" {
+		return
+	}
+	fmt.Fprintln(os.Stdout, "hello from stdout")
+	fmt.Fprintln(os.Stderr, "hello from stderr")
+	code, _ := strconv.Atoi(os.Getenv("PROCESSLOGS_EXIT_CODE"))
+	os.Exit(code)
+}
+
+func helperProcessConfig(exitCode int, restartPolicy string) *ProcessLogsConfig {
+	cfg := NewFactory().CreateDefaultConfig().(*ProcessLogsConfig)
+	cfg.Input = stanza.InputConfig{
+		"command": os.Args[0],
+		"args":    []string{"-test.run=^TestHelperProcess$"},
+		"env": map[string]string{
+			"PROCESSLOGS_HELPER":    "1",
+			"PROCESSLOGS_EXIT_CODE": strconv.Itoa(exitCode),
+		},
+		"restart_policy": restartPolicy,
+		"restart_delay":  "10ms",
+	}
+	return cfg
+}
+
+func startReceiver(t *testing.T, cfg *ProcessLogsConfig) *consumertest.LogsSink {
+	sink := new(consumertest.LogsSink)
+	rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, rcvr.Shutdown(context.Background()))
+	})
+	return sink
+}
+
+// collectRecords returns the log records received by the sink, with the resource of each record.
+func collectRecords(sink *consumertest.LogsSink) ([]pdata.LogRecord, []pdata.Resource) {
+	var records []pdata.LogRecord
+	var resources []pdata.Resource
+	for _, logs := range sink.AllLogs() {
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			rl := logs.ResourceLogs().At(i)
+			for j := 0; j < rl.InstrumentationLibraryLogs().Len(); j++ {
+				lrs := rl.InstrumentationLibraryLogs().At(j).LogRecords()
+				for k := 0; k < lrs.Len(); k++ {
+					records = append(records, lrs.At(k))
+					resources = append(resources, rl.Resource())
+				}
+			}
+		}
+	}
+	return records, resources
+}
+
+func countExitRecords(sink *consumertest.LogsSink) int {
+	records, _ := collectRecords(sink)
+	count := 0
+	for _, record := range records {
+		if _, ok := record.Attributes().Get(exitCodeAttribute); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func TestProcessLogs(t *testing.T) {
+	sink := startReceiver(t, helperProcessConfig(3, restartNever))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 3 }, 10*time.Second, 10*time.Millisecond)
+
+	records, resources := collectRecords(sink)
+	bodies := map[string]pdata.LogRecord{}
+	for i, record := range records {
+		bodies[record.Body().StringVal()] = record
+
+		command, _ := resources[i].Attributes().Get(conventions.AttributeProcessCommand)
+		assert.Equal(t, os.Args[0], command.StringVal())
+		commandLine, _ := resources[i].Attributes().Get(conventions.AttributeProcessCommandLine)
+		assert.Equal(t, os.Args[0]+" -test.run=^TestHelperProcess$", commandLine.StringVal())
+		_, ok := record.Attributes().Get(conventions.AttributeProcessPID)
+		assert.True(t, ok)
+	}
+
+	require.Contains(t, bodies, "hello from stdout")
+	iostream, _ := bodies["hello from stdout"].Attributes().Get(iostreamAttribute)
+	assert.Equal(t, "stdout", iostream.StringVal())
+
+	require.Contains(t, bodies, "hello from stderr")
+	iostream, _ = bodies["hello from stderr"].Attributes().Get(iostreamAttribute)
+	assert.Equal(t, "stderr", iostream.StringVal())
+
+	require.Contains(t, bodies, "process exited with code 3")
+	exit := bodies["process exited with code 3"]
+	exitCode, _ := exit.Attributes().Get(exitCodeAttribute)
+	assert.Equal(t, "3", exitCode.StringVal())
+	assert.Equal(t, pdata.SeverityNumberERROR, exit.SeverityNumber())
+
+	// the command is not restarted
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 3, sink.LogRecordCount())
+}
+
+func TestProcessLogsRestart(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		exitCode      int
+		restartPolicy string
+	}{
+		{
+			desc:          "on_failure restarts a failed command",
+			exitCode:      1,
+			restartPolicy: restartOnFailure,
+		},
+		{
+			desc:          "always restarts a succeeded command",
+			exitCode:      0,
+			restartPolicy: restartAlways,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sink := startReceiver(t, helperProcessConfig(tc.exitCode, tc.restartPolicy))
+			require.Eventually(t, func() bool { return countExitRecords(sink) >= 3 }, 10*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestProcessLogsNoRestartOnSuccess(t *testing.T) {
+	sink := startReceiver(t, helperProcessConfig(0, restartOnFailure))
+	require.Eventually(t, func() bool { return countExitRecords(sink) == 1 }, 10*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, countExitRecords(sink))
+}
+
+func TestProcessLogsStartFailure(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*ProcessLogsConfig)
+	cfg.Input = stanza.InputConfig{
+		"command": filepath.Join(t.TempDir(), "missing"),
+	}
+	rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.Error(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, len(cfg.Receivers), 1)
+	assert.Equal(t, testdataConfigYamlAsMap(), cfg.Receivers[config.NewComponentID(typeStr)])
+}
+
+func TestDecodeInputConfigFailure(t *testing.T) {
+	factory := NewFactory()
+	badCfg := &ProcessLogsConfig{
+		BaseConfig: stanza.BaseConfig{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			Operators:        stanza.OperatorConfigs{},
+		},
+		Input: stanza.InputConfig{
+			"command":        "backup",
+			"restart_policy": "sometimes",
+		},
+	}
+	receiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), badCfg, consumertest.NewNop())
+	require.Error(t, err, "receiver creation should fail if input config isn't valid")
+	require.Nil(t, receiver, "receiver creation should fail if input config isn't valid")
+}
+
+func testdataConfigYamlAsMap() *ProcessLogsConfig {
+	return &ProcessLogsConfig{
+		BaseConfig: stanza.BaseConfig{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			Operators:        stanza.OperatorConfigs{},
+		},
+		Input: stanza.InputConfig{
+			"command": "/usr/local/bin/backup",
+			"args": []interface{}{
+				"--verbose",
+				"/var/lib/data",
+			},
+			"env": map[string]interface{}{
+				"BACKUP_TARGET": "s3://backups",
+			},
+			"restart_policy": "on_failure",
+			"restart_delay":  "30s",
+		},
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusexecreceiver/subprocessmanager"
 )
 
@@ -40,7 +41,8 @@ func NewFactory() component.ReceiverFactory {
 	return receiverhelper.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiverhelper.WithMetrics(createMetricsReceiver))
+		receiverhelper.WithMetrics(createMetricsReceiver),
+		receiverhelper.WithLogs(createLogsReceiver))
 }
 
 // createDefaultConfig returns a default config
@@ -62,6 +64,43 @@ func createMetricsReceiver(
 	cfg config.Receiver,
 	nextConsumer consumer.Metrics,
 ) (component.MetricsReceiver, error) {
-	rCfg := cfg.(*Config)
-	return newPromExecReceiver(params, rCfg, nextConsumer)
+	r, err := getOrCreateReceiver(params, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*prometheusExecReceiver).consumer = nextConsumer
+
+	return r, nil
 }
+
+// createLogsReceiver creates a logs receiver based on provided Config, converting the subprocess output to logs.
+func createLogsReceiver(
+	ctx context.Context,
+	params component.ReceiverCreateSettings,
+	cfg config.Receiver,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	r, err := getOrCreateReceiver(params, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*prometheusExecReceiver).logsConsumer = nextConsumer
+
+	return r, nil
+}
+
+// getOrCreateReceiver returns the receiver shared by the metrics and logs receivers of the config.
+func getOrCreateReceiver(params component.ReceiverCreateSettings, cfg *Config) (*sharedcomponent.SharedComponent, error) {
+	recv, err := newPromExecReceiver(params, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	return receivers.GetOrAdd(cfg, func() component.Component {
+		return recv
+	}), nil
+}
+
+// This is the map of already created prometheus_exec receivers for particular configurations.
+// The metrics and logs receivers of a configuration share the same object, so that the
+// subprocess is only run once.
+var receivers = sharedcomponent.NewSharedComponents()
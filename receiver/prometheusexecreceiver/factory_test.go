@@ -27,8 +27,10 @@ import (
 	"go.opentelemetry.io/collector/component/componenterror"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/service/servicetest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusexecreceiver/subprocessmanager"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
 )
@@ -104,5 +106,11 @@ func TestCreateTraceAndMetricsReceiver(t *testing.T) {
 		prometheusReceiver: nil,
 	}
 
-	assert.Equal(t, wantPer, metricReceiver)
+	assert.Equal(t, wantPer, metricReceiver.(*sharedcomponent.SharedComponent).Unwrap())
+
+	// Test CreateLogsReceiver shares the metrics receiver
+	logsReceiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), receiver, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.Same(t, metricReceiver, logsReceiver)
+	assert.NotNil(t, metricReceiver.(*sharedcomponent.SharedComponent).Unwrap().(*prometheusExecReceiver).logsConsumer)
 }
@@ -30,6 +30,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	conventions "go.opentelemetry.io/collector/model/semconv/v1.5.0"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusexecreceiver/subprocessmanager"
@@ -49,12 +51,16 @@ const (
 	initialDelay = 1 * time.Second
 	// default path to scrape metrics at endpoint
 	defaultMetricsPath = "/metrics"
+	// attribute holding the stream the subprocess output line was written to
+	iostreamAttribute = "log.iostream"
 )
 
 type prometheusExecReceiver struct {
 	params   component.ReceiverCreateSettings
 	config   *Config
 	consumer consumer.Metrics
+	// logsConsumer receives the subprocess output lines as logs, they are logged by the collector if nil
+	logsConsumer consumer.Logs
 
 	// Prometheus receiver config
 	promReceiverConfig *prometheusreceiver.Config
@@ -101,12 +107,7 @@ func getPromReceiverConfig(cfg *Config) *prometheusreceiver.Config {
 	scrapeConfig.ScrapeTimeout = model.Duration(cfg.ScrapeTimeout)
 	scrapeConfig.Scheme = "http"
 	scrapeConfig.MetricsPath = defaultMetricsPath
-	jobName := cfg.ID().Name()
-	if jobName == "" {
-		// Fallback to type if no name
-		jobName = string(cfg.ID().Type())
-	}
-	scrapeConfig.JobName = jobName
+	scrapeConfig.JobName = getJobName(cfg)
 	scrapeConfig.HonorLabels = false
 	scrapeConfig.HonorTimestamps = true
 
@@ -129,6 +130,16 @@ func getPromReceiverConfig(cfg *Config) *prometheusreceiver.Config {
 	}
 }
 
+// getJobName returns the Prometheus job name of the receiver, also used as the service name of the subprocess logs
+func getJobName(cfg *Config) string {
+	jobName := cfg.ID().Name()
+	if jobName == "" {
+		// Fallback to type if no name
+		jobName = string(cfg.ID().Type())
+	}
+	return jobName
+}
+
 // getSubprocessConfig returns the subprocess config
 func getSubprocessConfig(cfg *Config) *subprocessmanager.SubprocessConfig {
 	subprocessConfig := &subprocessmanager.SubprocessConfig{}
@@ -163,10 +174,12 @@ func (per *prometheusExecReceiver) manageProcess(ctx context.Context, host compo
 
 		elapsed := per.runProcess(ctx)
 
-		err = receiver.Shutdown(ctx)
-		if err != nil {
-			per.params.Logger.Error("could not stop receiver associated to process, killing it", zap.String("error", err.Error()))
-			return
+		if receiver != nil {
+			err = receiver.Shutdown(ctx)
+			if err != nil {
+				per.params.Logger.Error("could not stop receiver associated to process, killing it", zap.String("error", err.Error()))
+				return
+			}
 		}
 
 		crashCount = per.computeCrashCount(elapsed, crashCount)
@@ -181,7 +194,8 @@ func (per *prometheusExecReceiver) manageProcess(ctx context.Context, host compo
 	}
 }
 
-// createAndStartReceiver will create the underlying Prometheus receiver and generate a random port if one is needed, then start it
+// createAndStartReceiver will create the underlying Prometheus receiver and generate a random port if one is needed, then start it.
+// No receiver is returned when the receiver is only used in logs pipelines.
 func (per *prometheusExecReceiver) createAndStartReceiver(ctx context.Context, host component.Host) (component.MetricsReceiver, error) {
 	currentPort := per.port
 
@@ -199,6 +213,11 @@ func (per *prometheusExecReceiver) createAndStartReceiver(ctx context.Context, h
 		}
 	}
 
+	if per.consumer == nil {
+		per.subprocessConfig = per.fillPortPlaceholders(currentPort)
+		return nil, nil
+	}
+
 	// Create and start the underlying Prometheus receiver
 	factory := prometheusreceiver.NewFactory()
 	receiver, err := factory.CreateMetricsReceiver(ctx, per.params, per.promReceiverConfig, per.consumer)
@@ -240,10 +259,38 @@ func (per *prometheusExecReceiver) runProcess(ctx context.Context) time.Duration
 
 // handleProcessResult calls the process manager's run function and pipes the return value into the channel
 func (per *prometheusExecReceiver) handleProcessResult(childCtx context.Context, run chan<- runResult) {
-	elapsed, subprocessErr := per.subprocessConfig.Run(childCtx, per.params.Logger)
+	var handler subprocessmanager.OutputHandler
+	if per.logsConsumer != nil {
+		handler = per.outputLogsHandler(per.subprocessConfig.Command)
+	}
+	elapsed, subprocessErr := per.subprocessConfig.RunWithOutputHandler(childCtx, per.params.Logger, handler)
 	run <- runResult{elapsed, subprocessErr}
 }
 
+// outputLogsHandler returns the handler converting the output lines of the subprocess running command to logs
+func (per *prometheusExecReceiver) outputLogsHandler(command string) subprocessmanager.OutputHandler {
+	serviceName := getJobName(per.config)
+	return func(line string, stderr bool) {
+		logs := pdata.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().InsertString(conventions.AttributeServiceName, serviceName)
+		rl.Resource().Attributes().InsertString(conventions.AttributeProcessCommandLine, command)
+
+		lr := rl.InstrumentationLibraryLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetTimestamp(pdata.NewTimestampFromTime(time.Now()))
+		lr.Body().SetStringVal(line)
+		if stderr {
+			lr.Attributes().InsertString(iostreamAttribute, "stderr")
+		} else {
+			lr.Attributes().InsertString(iostreamAttribute, "stdout")
+		}
+
+		if err := per.logsConsumer.ConsumeLogs(context.Background(), logs); err != nil {
+			per.params.Logger.Debug("could not consume subprocess output line", zap.String("error", err.Error()))
+		}
+	}
+}
+
 // computeDelayAndSleep will compute how long the process should delay before restarting and handle a shutdown while this goroutine waits
 func (per *prometheusExecReceiver) computeDelayAndSleep(elapsed time.Duration, crashCount int) {
 	sleepTime := getDelay(elapsed, healthyProcessTime, crashCount, healthyCrashCount)
@@ -129,6 +129,35 @@ func assertTwoUniqueValuesScraped(t *testing.T, metricsSlice []pdata.Metrics) {
 	assert.Fail(t, fmt.Sprintf("All %v scraped values were non-unique", len(metricsSlice)))
 }
 
+func TestOutputLogsHandler(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	cfg := &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "mysqld")),
+		SubprocessConfig: subprocessmanager.SubprocessConfig{Command: "mysqld_exporter"},
+	}
+	per, err := newPromExecReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, nil)
+	require.NoError(t, err)
+	per.logsConsumer = sink
+
+	handler := per.outputLogsHandler("mysqld_exporter --web.listen-address=:9104")
+	handler("listening on :9104", false)
+	handler("connection refused", true)
+
+	logs := sink.AllLogs()
+	require.Len(t, logs, 2)
+	for i, want := range []struct{ body, stream string }{{"listening on :9104", "stdout"}, {"connection refused", "stderr"}} {
+		rl := logs[i].ResourceLogs().At(0)
+		assert.Equal(t, map[string]interface{}{
+			"service.name":         "mysqld",
+			"process.command_line": "mysqld_exporter --web.listen-address=:9104",
+		}, rl.Resource().Attributes().AsRaw())
+
+		lr := rl.InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+		assert.Equal(t, want.body, lr.Body().StringVal())
+		assert.Equal(t, map[string]interface{}{"log.iostream": want.stream}, lr.Attributes().AsRaw())
+	}
+}
+
 func TestConfigBuilderFunctions(t *testing.T) {
 	configTests := []struct {
 		name                 string
@@ -26,8 +26,18 @@ import (
 	"go.uber.org/zap"
 )
 
-// Run will start the process and keep track of running time
+// OutputHandler handles a line written by the subprocess to its standard output,
+// or to its standard error if stderr is true
+type OutputHandler func(line string, stderr bool)
+
+// Run will start the process and keep track of running time, logging its output lines
 func (proc *SubprocessConfig) Run(ctx context.Context, logger *zap.Logger) (time.Duration, error) {
+	return proc.RunWithOutputHandler(ctx, logger, nil)
+}
+
+// RunWithOutputHandler will start the process and keep track of running time, passing its output lines
+// to handler instead of logging them if handler is not nil
+func (proc *SubprocessConfig) RunWithOutputHandler(ctx context.Context, logger *zap.Logger, handler OutputHandler) (time.Duration, error) {
 
 	childProcess, err := ExecCommand(proc.Command)
 	if err != nil {
@@ -42,13 +52,13 @@ func (proc *SubprocessConfig) Run(ctx context.Context, logger *zap.Logger) (time
 	if stdoutErr != nil {
 		return 0, fmt.Errorf("could not get the command's stdout pipe, err: %w", stdoutErr)
 	}
-	go proc.pipeSubprocessOutput(bufio.NewReader(stdoutReader), logger, true)
+	go proc.pipeSubprocessOutput(bufio.NewReader(stdoutReader), logger, handler, true)
 
 	stderrReader, stderrErr := childProcess.StderrPipe()
 	if stderrErr != nil {
 		return 0, fmt.Errorf("could not get the command's stderr pipe, err: %w", stderrErr)
 	}
-	go proc.pipeSubprocessOutput(bufio.NewReader(stderrReader), logger, false)
+	go proc.pipeSubprocessOutput(bufio.NewReader(stderrReader), logger, handler, false)
 
 	// Start and stop timer (elapsed) right before and after executing the command
 	processErrCh := make(chan error, 1)
@@ -84,8 +94,8 @@ func (proc *SubprocessConfig) Run(ctx context.Context, logger *zap.Logger) (time
 	}
 }
 
-// Log every line of the subprocesse's output using zap, until pipe is closed (EOF)
-func (proc *SubprocessConfig) pipeSubprocessOutput(reader *bufio.Reader, logger *zap.Logger, isStdout bool) {
+// Log every line of the subprocesse's output using zap, or pass it to handler if not nil, until pipe is closed (EOF)
+func (proc *SubprocessConfig) pipeSubprocessOutput(reader *bufio.Reader, logger *zap.Logger, handler OutputHandler, isStdout bool) {
 	for {
 		line, err := reader.ReadString('\n')
 		if err != nil && err != io.EOF {
@@ -95,7 +105,9 @@ func (proc *SubprocessConfig) pipeSubprocessOutput(reader *bufio.Reader, logger
 
 		line = strings.TrimSpace(line)
 		if line != "" && line != "\n" {
-			if isStdout {
+			if handler != nil {
+				handler(line, !isStdout)
+			} else if isStdout {
 				logger.Info("subprocess output line", zap.String("output", line))
 			} else {
 				logger.Error("subprocess output line", zap.String("output", line))
@@ -15,8 +15,10 @@
 package subprocessmanager
 
 import (
+	"bufio"
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -186,3 +188,22 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestPipeSubprocessOutputHandler(t *testing.T) {
+	type outputLine struct {
+		line   string
+		stderr bool
+	}
+	var got []outputLine
+	handler := func(line string, stderr bool) {
+		got = append(got, outputLine{line, stderr})
+	}
+
+	proc := &SubprocessConfig{}
+	proc.pipeSubprocessOutput(bufio.NewReader(strings.NewReader("first line\n\n  second line  \nlast")), zap.NewNop(), handler, false)
+
+	want := []outputLine{{"first line", true}, {"second line", true}, {"last", true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pipeSubprocessOutput() got = %v, want %v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -54,12 +55,66 @@ type Config struct {
 	UseStartTimeMetric   bool   `mapstructure:"use_start_time_metric"`
 	StartTimeMetricRegex string `mapstructure:"start_time_metric_regex"`
 
+	// TargetAllocator configures the retrieval of the scrape jobs and targets assigned
+	// to this collector by the OpenTelemetry Target Allocator.
+	TargetAllocator *TargetAllocatorConfig `mapstructure:"target_allocator"`
+
 	// ConfigPlaceholder is just an entry to make the configuration pass a check
 	// that requires that all keys present in the config actually exist on the
 	// structure, ie.: it will error if an unknown key is present.
 	ConfigPlaceholder interface{} `mapstructure:"config"`
 }
 
+// TargetAllocatorConfig configures the OpenTelemetry Target Allocator the scrape
+// jobs and targets are retrieved from.
+type TargetAllocatorConfig struct {
+	// Endpoint is the base URL of the Target Allocator HTTP API.
+	Endpoint string `mapstructure:"endpoint"`
+	// Interval is the interval at which the scrape jobs and targets are refreshed,
+	// 30s by default.
+	Interval time.Duration `mapstructure:"interval"`
+	// CollectorID identifies this collector to the Target Allocator, which only
+	// returns the targets assigned to it.
+	CollectorID string `mapstructure:"collector_id"`
+	// Collectors are the identifiers of all the collectors sharing the targets of
+	// the Target Allocator. If set, the targets returned by the Target Allocator
+	// are only scraped if a hash ring of the collectors assigns them to CollectorID.
+	Collectors []string `mapstructure:"collectors"`
+}
+
+// Validate checks the target allocator configuration is valid.
+func (cfg *TargetAllocatorConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("target_allocator endpoint must be specified")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid target_allocator endpoint %q: %w", cfg.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid target_allocator endpoint %q: the scheme must be http or https", cfg.Endpoint)
+	}
+	if cfg.CollectorID == "" {
+		return errors.New("target_allocator collector_id must be specified")
+	}
+	if cfg.Interval < 0 {
+		return errors.New("target_allocator interval must not be negative")
+	}
+	if len(cfg.Collectors) > 0 {
+		collectors := make(map[string]bool, len(cfg.Collectors))
+		for _, collector := range cfg.Collectors {
+			if collectors[collector] {
+				return fmt.Errorf("target_allocator collector %q is listed more than once", collector)
+			}
+			collectors[collector] = true
+		}
+		if !collectors[cfg.CollectorID] {
+			return fmt.Errorf("target_allocator collectors must include the collector_id %q", cfg.CollectorID)
+		}
+	}
+	return nil
+}
+
 var _ config.Receiver = (*Config)(nil)
 var _ config.Unmarshallable = (*Config)(nil)
 
@@ -127,12 +182,18 @@ func checkSDFile(filename string) error {
 
 // Validate checks the receiver configuration is valid.
 func (cfg *Config) Validate() error {
+	if cfg.TargetAllocator != nil {
+		if err := cfg.TargetAllocator.Validate(); err != nil {
+			return err
+		}
+	}
+
 	promConfig := cfg.PrometheusConfig
 	if promConfig == nil {
-		return nil // noop receiver
+		return nil // noop receiver, unless scraping the targets of the target allocator
 	}
-	if len(promConfig.ScrapeConfigs) == 0 {
-		return errors.New("no Prometheus scrape_configs")
+	if len(promConfig.ScrapeConfigs) == 0 && cfg.TargetAllocator == nil {
+		return errors.New("no Prometheus scrape_configs or target_allocator")
 	}
 
 	// Reject features that Prometheus supports but that the receiver doesn't support:
@@ -254,3 +254,93 @@ func TestFileSDConfigYamlNilTargetGroup(t *testing.T) {
 	gotErrMsg := err.Error()
 	require.Equal(t, wantErrMsg, gotErrMsg)
 }
+
+func TestLoadTargetAllocatorConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_target_allocator.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	r0 := cfg.Receivers[config.NewComponentID(typeStr)].(*Config)
+	assert.Nil(t, r0.PrometheusConfig)
+	assert.Equal(t, &TargetAllocatorConfig{
+		Endpoint:    "http://localhost:8080",
+		Interval:    30 * time.Second,
+		CollectorID: "collector-1",
+		Collectors:  []string{"collector-1", "collector-2"},
+	}, r0.TargetAllocator)
+}
+
+func TestInvalidTargetAllocatorConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfig(filepath.Join("testdata", "invalid-config-target-allocator.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	err = cfg.Validate()
+	require.NotNil(t, err, "Expected a non-nil error")
+
+	wantErrMsg := `receiver "prometheus" has invalid configuration: invalid target_allocator endpoint "localhost:8080": the scheme must be http or https`
+
+	gotErrMsg := err.Error()
+	require.Equal(t, wantErrMsg, gotErrMsg)
+}
+
+func TestTargetAllocatorConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     TargetAllocatorConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			cfg:  TargetAllocatorConfig{Endpoint: "https://target-allocator", CollectorID: "collector-1"},
+		},
+		{
+			name:    "missing endpoint",
+			cfg:     TargetAllocatorConfig{CollectorID: "collector-1"},
+			wantErr: "target_allocator endpoint must be specified",
+		},
+		{
+			name:    "missing collector id",
+			cfg:     TargetAllocatorConfig{Endpoint: "http://target-allocator"},
+			wantErr: "target_allocator collector_id must be specified",
+		},
+		{
+			name: "valid collectors",
+			cfg:  TargetAllocatorConfig{Endpoint: "https://target-allocator", CollectorID: "collector-1", Collectors: []string{"collector-1", "collector-2"}},
+		},
+		{
+			name:    "collector id not in collectors",
+			cfg:     TargetAllocatorConfig{Endpoint: "http://target-allocator", CollectorID: "collector-1", Collectors: []string{"collector-2"}},
+			wantErr: `target_allocator collectors must include the collector_id "collector-1"`,
+		},
+		{
+			name:    "duplicate collector",
+			cfg:     TargetAllocatorConfig{Endpoint: "http://target-allocator", CollectorID: "collector-1", Collectors: []string{"collector-1", "collector-1"}},
+			wantErr: `target_allocator collector "collector-1" is listed more than once`,
+		},
+		{
+			name:    "negative interval",
+			cfg:     TargetAllocatorConfig{Endpoint: "http://target-allocator", CollectorID: "collector-1", Interval: -time.Second},
+			wantErr: "target_allocator interval must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+)
+
+// hashRingReplicas is the number of points of each collector on the hash ring,
+// spreading the targets evenly across the collectors.
+const hashRingReplicas = 100
+
+// ringPoint is a point of a collector on the hash ring.
+type ringPoint struct {
+	hash      uint64
+	collector string
+}
+
+// hashRing assigns the targets to the collectors with consistent hashing, so
+// that only the targets of a collector are moved when it is added or removed.
+type hashRing struct {
+	points []ringPoint
+}
+
+func newHashRing(collectors []string) *hashRing {
+	points := make([]ringPoint, 0, len(collectors)*hashRingReplicas)
+	for _, collector := range collectors {
+		for i := 0; i < hashRingReplicas; i++ {
+			points = append(points, ringPoint{
+				hash:      hashKey(collector + "#" + strconv.Itoa(i)),
+				collector: collector,
+			})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].collector < points[j].collector
+		}
+		return points[i].hash < points[j].hash
+	})
+	return &hashRing{points: points}
+}
+
+// collector returns the collector the key is assigned to, the one of the first
+// point following the hash of the key on the ring.
+func (r *hashRing) collector(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	hash := hashKey(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i].hash >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.points[i].collector
+}
+
+// hashKey hashes the key with FNV-1a, mixed with the finalizer of MurmurHash3
+// for the keys differing only by their last characters to spread on the ring.
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// hashRingSDConfig discovers the targets of a job of the target allocator,
+// keeping the ones the hash ring assigns to the collector.
+type hashRingSDConfig struct {
+	sdConfig    discovery.Config
+	ring        *hashRing
+	jobName     string
+	collectorID string
+}
+
+var _ discovery.Config = (*hashRingSDConfig)(nil)
+
+// Name returns the name of the discovery mechanism.
+func (c *hashRingSDConfig) Name() string { return "target_allocator" }
+
+// NewDiscoverer returns a Discoverer filtering the targets discovered with the
+// wrapped config.
+func (c *hashRingSDConfig) NewDiscoverer(opts discovery.DiscovererOptions) (discovery.Discoverer, error) {
+	d, err := c.sdConfig.NewDiscoverer(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &hashRingDiscoverer{discoverer: d, config: c}, nil
+}
+
+// hashRingDiscoverer drops the discovered targets that the hash ring doesn't
+// assign to the collector.
+type hashRingDiscoverer struct {
+	discoverer discovery.Discoverer
+	config     *hashRingSDConfig
+}
+
+// Run forwards the filtered target groups of the wrapped discoverer to up.
+func (d *hashRingDiscoverer) Run(ctx context.Context, up chan<- []*targetgroup.Group) {
+	ch := make(chan []*targetgroup.Group)
+	go d.discoverer.Run(ctx, ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case groups := <-ch:
+			select {
+			case <-ctx.Done():
+				return
+			case up <- d.config.filterGroups(groups):
+			}
+		}
+	}
+}
+
+// filterGroups returns copies of the groups with only the targets assigned to
+// the collector. Emptied groups are kept to drop the targets previously
+// discovered in them.
+func (c *hashRingSDConfig) filterGroups(groups []*targetgroup.Group) []*targetgroup.Group {
+	filtered := make([]*targetgroup.Group, 0, len(groups))
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		targets := make([]model.LabelSet, 0, len(group.Targets))
+		for _, target := range group.Targets {
+			address, ok := target[model.AddressLabel]
+			if !ok {
+				address = group.Labels[model.AddressLabel]
+			}
+			key := c.jobName + "/" + string(address)
+			if c.ring.collector(key) == c.collectorID {
+				targets = append(targets, target)
+			}
+		}
+		filtered = append(filtered, &targetgroup.Group{
+			Targets: targets,
+			Labels:  group.Labels,
+			Source:  group.Source,
+		})
+	}
+	return filtered
+}
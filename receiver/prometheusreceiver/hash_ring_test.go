@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashRing(t *testing.T) {
+	ring := newHashRing([]string{"collector-1", "collector-2", "collector-3"})
+	assigned := map[string]string{}
+	counts := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("job/10.0.%d.%d:9100", i/256, i%256)
+		collector := ring.collector(key)
+		assert.Equal(t, collector, ring.collector(key))
+		assigned[key] = collector
+		counts[collector]++
+	}
+	assert.Len(t, counts, 3)
+	for collector, count := range counts {
+		assert.Greater(t, count, 200, collector)
+	}
+
+	// only the keys of a removed collector are moved
+	ring = newHashRing([]string{"collector-1", "collector-3"})
+	for key, collector := range assigned {
+		if collector != "collector-2" {
+			assert.Equal(t, collector, ring.collector(key), key)
+		} else {
+			assert.NotEqual(t, "collector-2", ring.collector(key), key)
+		}
+	}
+
+	assert.Empty(t, newHashRing(nil).collector("job/10.0.0.1:9100"))
+}
+
+func TestHashRingSDConfigFilterGroups(t *testing.T) {
+	collectors := []string{"collector-1", "collector-2"}
+	ring := newHashRing(collectors)
+	var targets []model.LabelSet
+	for i := 0; i < 20; i++ {
+		targets = append(targets, model.LabelSet{model.AddressLabel: model.LabelValue(fmt.Sprintf("10.0.0.%d:9100", i))})
+	}
+	groups := []*targetgroup.Group{
+		{Targets: targets, Labels: model.LabelSet{"env": "test"}, Source: "0"},
+		{Source: "1"},
+	}
+
+	// each target is kept by a single collector
+	seen := map[model.LabelValue]string{}
+	for _, collector := range collectors {
+		cfg := &hashRingSDConfig{ring: ring, jobName: "job", collectorID: collector}
+		filtered := cfg.filterGroups(groups)
+		assert.Len(t, filtered, 2)
+		assert.Equal(t, "0", filtered[0].Source)
+		assert.Equal(t, model.LabelSet{"env": "test"}, filtered[0].Labels)
+		assert.NotEmpty(t, filtered[0].Targets)
+		for _, target := range filtered[0].Targets {
+			address := target[model.AddressLabel]
+			assert.Equal(t, collector, ring.collector("job/"+string(address)))
+			assert.NotContains(t, seen, address)
+			seen[address] = collector
+		}
+		// the emptied groups are kept to drop their previous targets
+		assert.Equal(t, "1", filtered[1].Source)
+		assert.Empty(t, filtered[1].Targets)
+	}
+	assert.Len(t, seen, len(targets))
+}
+
+// staticSDConfig discovers its groups once.
+type staticSDConfig []*targetgroup.Group
+
+func (c staticSDConfig) Name() string { return "static" }
+
+func (c staticSDConfig) NewDiscoverer(discovery.DiscovererOptions) (discovery.Discoverer, error) {
+	return c, nil
+}
+
+func (c staticSDConfig) Run(ctx context.Context, up chan<- []*targetgroup.Group) {
+	select {
+	case <-ctx.Done():
+	case up <- c:
+	}
+}
+
+func TestHashRingDiscoverer(t *testing.T) {
+	ring := newHashRing([]string{"collector-1", "collector-2"})
+	var targets []model.LabelSet
+	var expected []model.LabelSet
+	for i := 0; i < 20; i++ {
+		target := model.LabelSet{model.AddressLabel: model.LabelValue(fmt.Sprintf("10.0.0.%d:9100", i))}
+		targets = append(targets, target)
+		if ring.collector("job/"+string(target[model.AddressLabel])) == "collector-1" {
+			expected = append(expected, target)
+		}
+	}
+
+	cfg := &hashRingSDConfig{
+		sdConfig:    staticSDConfig{{Targets: targets, Source: "0"}},
+		ring:        ring,
+		jobName:     "job",
+		collectorID: "collector-1",
+	}
+	d, err := cfg.NewDiscoverer(discovery.DiscovererOptions{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	up := make(chan []*targetgroup.Group)
+	go d.Run(ctx, up)
+
+	groups := <-up
+	require.Len(t, groups, 1)
+	assert.Equal(t, expected, groups[0].Targets)
+}
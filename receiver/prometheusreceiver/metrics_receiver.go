@@ -16,10 +16,19 @@ package prometheusreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/config"
 	"github.com/prometheus/prometheus/discovery"
+	promhttp "github.com/prometheus/prometheus/discovery/http"
 	"github.com/prometheus/prometheus/scrape"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -31,6 +40,8 @@ import (
 const (
 	defaultGCInterval = 2 * time.Minute
 	gcIntervalDelta   = 1 * time.Minute
+
+	defaultTargetAllocatorInterval = 30 * time.Second
 )
 
 // pReceiver is the type that provides Prometheus scraper/receiver functionality.
@@ -39,9 +50,13 @@ type pReceiver struct {
 	consumer   consumer.Metrics
 	cancelFunc context.CancelFunc
 
-	settings      component.ReceiverCreateSettings
-	scrapeManager *scrape.Manager
-	ocaStore      *internal.OcaStore
+	settings         component.ReceiverCreateSettings
+	discoveryManager *discovery.Manager
+	scrapeManager    *scrape.Manager
+	ocaStore         *internal.OcaStore
+
+	// targetAllocatorWG waits for the synchronization with the target allocator to stop.
+	targetAllocatorWG sync.WaitGroup
 }
 
 // New creates a new prometheus.Receiver reference.
@@ -62,20 +77,12 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 
 	logger := internal.NewZapToGokitLogAdapter(r.settings.Logger)
 
-	discoveryManager := discovery.NewManager(discoveryCtx, logger)
-	discoveryCfg := make(map[string]discovery.Configs)
-	for _, scrapeConfig := range r.cfg.PrometheusConfig.ScrapeConfigs {
-		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
-	}
-	if err := discoveryManager.ApplyConfig(discoveryCfg); err != nil {
-		return err
+	baseCfg := r.cfg.PrometheusConfig
+	if baseCfg == nil {
+		// only the jobs of the target allocator are scraped
+		defaultCfg := config.DefaultConfig
+		baseCfg = &defaultCfg
 	}
-	go func() {
-		if err := discoveryManager.Run(); err != nil {
-			r.settings.Logger.Error("Discovery manager failed", zap.Error(err))
-			host.ReportFatalError(err)
-		}
-	}()
 
 	// Per component.Component Start instructions, for async operations we should not use the
 	// incoming context, it may get cancelled.
@@ -83,26 +90,223 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 		context.Background(),
 		r.consumer,
 		r.settings,
-		gcInterval(r.cfg.PrometheusConfig),
+		gcInterval(baseCfg),
 		r.cfg.UseStartTimeMetric,
 		r.cfg.StartTimeMetricRegex,
 		r.cfg.ID(),
-		r.cfg.PrometheusConfig.GlobalConfig.ExternalLabels,
+		baseCfg.GlobalConfig.ExternalLabels,
 	)
+	r.discoveryManager = discovery.NewManager(discoveryCtx, logger)
 	r.scrapeManager = scrape.NewManager(&scrape.Options{}, logger, r.ocaStore)
 	r.ocaStore.SetScrapeManager(r.scrapeManager)
-	if err := r.scrapeManager.ApplyConfig(r.cfg.PrometheusConfig); err != nil {
+	if err := r.applyCfg(baseCfg); err != nil {
 		return err
 	}
+
 	go func() {
-		if err := r.scrapeManager.Run(discoveryManager.SyncCh()); err != nil {
+		if err := r.discoveryManager.Run(); err != nil {
+			r.settings.Logger.Error("Discovery manager failed", zap.Error(err))
+			host.ReportFatalError(err)
+		}
+	}()
+	go func() {
+		if err := r.scrapeManager.Run(r.discoveryManager.SyncCh()); err != nil {
 			r.settings.Logger.Error("Scrape manager failed", zap.Error(err))
 			host.ReportFatalError(err)
 		}
 	}()
+
+	if r.cfg.TargetAllocator != nil {
+		r.startTargetAllocator(discoveryCtx, r.cfg.TargetAllocator, baseCfg)
+	}
 	return nil
 }
 
+// applyCfg applies the scrape configs to the discovery and scrape managers. The
+// scrape loops of the jobs no longer configured are stopped, which marks their
+// series as stale.
+func (r *pReceiver) applyCfg(cfg *config.Config) error {
+	if err := r.scrapeManager.ApplyConfig(cfg); err != nil {
+		return err
+	}
+
+	discoveryCfg := make(map[string]discovery.Configs)
+	for _, scrapeConfig := range cfg.ScrapeConfigs {
+		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
+	}
+	return r.discoveryManager.ApplyConfig(discoveryCfg)
+}
+
+// startTargetAllocator periodically synchronizes the scrape jobs with the ones
+// of the target allocator until ctx is done.
+func (r *pReceiver) startTargetAllocator(ctx context.Context, allocCfg *TargetAllocatorConfig, baseCfg *config.Config) {
+	interval := allocCfg.Interval
+	if interval == 0 {
+		interval = defaultTargetAllocatorInterval
+	}
+	client := &http.Client{Timeout: interval}
+	var ring *hashRing
+	if len(allocCfg.Collectors) > 0 {
+		ring = newHashRing(allocCfg.Collectors)
+	}
+
+	r.targetAllocatorWG.Add(1)
+	go func() {
+		defer r.targetAllocatorWG.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var hash uint64
+		for {
+			newHash, err := r.syncTargetAllocator(ctx, client, hash, allocCfg, interval, ring, baseCfg)
+			if err != nil {
+				r.settings.Logger.Error("Failed to sync the target allocator jobs", zap.Error(err))
+			} else {
+				hash = newHash
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// jobLink is the link to the targets of a job in the target allocator API.
+type jobLink struct {
+	Link string `json:"_link"`
+}
+
+// syncTargetAllocator retrieves the scrape jobs of the target allocator and, if
+// they changed since the ones hashed as compareHash, applies a scrape config for
+// each of them discovering its targets assigned to this collector through HTTP
+// service discovery. If ring is not nil, only the targets it assigns to this
+// collector are kept. It returns the hash of the jobs.
+func (r *pReceiver) syncTargetAllocator(ctx context.Context, client *http.Client, compareHash uint64, allocCfg *TargetAllocatorConfig, interval time.Duration, ring *hashRing, baseCfg *config.Config) (uint64, error) {
+	jobs, err := getTargetAllocatorJobs(ctx, client, allocCfg.Endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	names := make([]string, 0, len(jobs))
+	for name := range jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := hashJobs(names, jobs)
+	if hash == compareHash {
+		return hash, nil
+	}
+
+	cfg := *baseCfg
+	cfg.ScrapeConfigs = make([]*config.ScrapeConfig, 0, len(baseCfg.ScrapeConfigs)+len(names))
+	baseScrapeConfigs := make(map[string]*config.ScrapeConfig, len(baseCfg.ScrapeConfigs))
+	for _, scrapeConfig := range baseCfg.ScrapeConfigs {
+		if _, ok := jobs[scrapeConfig.JobName]; ok {
+			baseScrapeConfigs[scrapeConfig.JobName] = scrapeConfig
+			continue
+		}
+		cfg.ScrapeConfigs = append(cfg.ScrapeConfigs, scrapeConfig)
+	}
+
+	for _, name := range names {
+		// The scrape settings of a job configured in the receiver are kept, only
+		// its targets come from the target allocator.
+		var scrapeConfig config.ScrapeConfig
+		if base, ok := baseScrapeConfigs[name]; ok {
+			scrapeConfig = *base
+		} else {
+			scrapeConfig = config.DefaultScrapeConfig
+			scrapeConfig.JobName = name
+			scrapeConfig.ScrapeInterval = cfg.GlobalConfig.ScrapeInterval
+			scrapeConfig.ScrapeTimeout = cfg.GlobalConfig.ScrapeTimeout
+		}
+
+		sdURL, err := targetAllocatorURL(allocCfg.Endpoint, jobs[name].Link)
+		if err != nil {
+			return 0, fmt.Errorf("invalid link %q of job %q: %w", jobs[name].Link, name, err)
+		}
+		query := sdURL.Query()
+		query.Set("collector_id", allocCfg.CollectorID)
+		sdURL.RawQuery = query.Encode()
+
+		sdConfig := promhttp.DefaultSDConfig
+		sdConfig.URL = sdURL.String()
+		sdConfig.RefreshInterval = model.Duration(interval)
+		scrapeConfig.ServiceDiscoveryConfigs = discovery.Configs{&sdConfig}
+		if ring != nil {
+			scrapeConfig.ServiceDiscoveryConfigs = discovery.Configs{&hashRingSDConfig{
+				sdConfig:    &sdConfig,
+				ring:        ring,
+				jobName:     name,
+				collectorID: allocCfg.CollectorID,
+			}}
+		}
+		cfg.ScrapeConfigs = append(cfg.ScrapeConfigs, &scrapeConfig)
+	}
+
+	if err := r.applyCfg(&cfg); err != nil {
+		return 0, fmt.Errorf("failed to apply the target allocator jobs: %w", err)
+	}
+	r.settings.Logger.Info("Applied the target allocator jobs", zap.Strings("jobs", names))
+	return hash, nil
+}
+
+// getTargetAllocatorJobs returns the scrape jobs of the target allocator by name.
+func getTargetAllocatorJobs(ctx context.Context, client *http.Client, endpoint string) (map[string]jobLink, error) {
+	jobsURL, err := targetAllocatorURL(endpoint, "/jobs")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jobsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q getting the target allocator jobs", resp.Status)
+	}
+	jobs := map[string]jobLink{}
+	if err := json.NewDecoder(resp.Body).Decode(&jobs); err != nil {
+		return nil, fmt.Errorf("failed to decode the target allocator jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// targetAllocatorURL resolves the path against the target allocator endpoint.
+func targetAllocatorURL(endpoint string, path string) (*url.URL, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	return base.ResolveReference(ref), nil
+}
+
+// hashJobs hashes the jobs in the order of their sorted names, to detect changes
+// of the target allocator jobs.
+func hashJobs(names []string, jobs map[string]jobLink) uint64 {
+	h := fnv.New64a()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(jobs[name].Link))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
 // gcInterval returns the longest scrape interval used by a scrape config,
 // plus a delta to prevent race conditions.
 // This ensures jobs are not garbage collected between scrapes.
@@ -122,6 +326,7 @@ func gcInterval(cfg *config.Config) time.Duration {
 // Shutdown stops and cancels the underlying Prometheus scrapers.
 func (r *pReceiver) Shutdown(context.Context) error {
 	r.cancelFunc()
+	r.targetAllocatorWG.Wait()
 	// ocaStore (and internally metadataService) needs to stop first to prevent deadlocks.
 	// When stopping scrapeManager it waits for all scrapes to terminate. However during
 	// scraping metadataService calls scrapeManager.AllTargets() which acquires
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+// mockTargetAllocator serves a single job whose targets are assigned to the
+// collector "collector-1".
+func mockTargetAllocator(t *testing.T, targets []string, jobsRequests *int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(jobsRequests, 1)
+		assert.NoError(t, json.NewEncoder(rw).Encode(map[string]jobLink{
+			"allocated-job": {Link: "/jobs/allocated-job/targets"},
+		}))
+	})
+	mux.HandleFunc("/jobs/allocated-job/targets", func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("collector_id") != "collector-1" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(rw).Encode([]map[string]interface{}{
+			{"targets": targets, "labels": map[string]string{}},
+		}))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestTargetAllocatorJobs(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(rw, "# TYPE up_gauge gauge\nup_gauge 1\n")
+	}))
+	defer target.Close()
+	targetURL, err := url.Parse(target.URL)
+	require.NoError(t, err)
+
+	var jobsRequests int32
+	allocator := mockTargetAllocator(t, []string{targetURL.Host}, &jobsRequests)
+	defer allocator.Close()
+
+	receiver := newPrometheusReceiver(componenttest.NewNopReceiverCreateSettings(), &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		TargetAllocator: &TargetAllocatorConfig{
+			Endpoint:    allocator.URL,
+			Interval:    100 * time.Millisecond,
+			CollectorID: "collector-1",
+		},
+	}, new(consumertest.MetricsSink))
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+
+	assert.Eventually(t, func() bool {
+		targets := receiver.scrapeManager.TargetsActive()["allocated-job"]
+		return len(targets) == 1 && targets[0].URL().Host == targetURL.Host
+	}, 30*time.Second, 100*time.Millisecond, "the target of the allocated job is not scraped")
+	// the jobs are polled at each interval
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&jobsRequests) > 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	require.NoError(t, receiver.Shutdown(context.Background()))
+	assert.Len(t, flattenTargets(receiver.scrapeManager.TargetsAll()), 0, "expected scrape manager to have no targets")
+}
+
+func TestTargetAllocatorJobsHashRing(t *testing.T) {
+	var targets []string
+	for i := 0; i < 20; i++ {
+		targets = append(targets, fmt.Sprintf("10.0.0.%d:9100", i))
+	}
+	var jobsRequests int32
+	allocator := mockTargetAllocator(t, targets, &jobsRequests)
+	defer allocator.Close()
+
+	collectors := []string{"collector-1", "collector-2", "collector-3"}
+	ring := newHashRing(collectors)
+	var expected []string
+	for _, target := range targets {
+		if ring.collector("allocated-job/"+target) == "collector-1" {
+			expected = append(expected, target)
+		}
+	}
+	require.NotEmpty(t, expected)
+	require.Less(t, len(expected), len(targets))
+
+	receiver := newPrometheusReceiver(componenttest.NewNopReceiverCreateSettings(), &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		TargetAllocator: &TargetAllocatorConfig{
+			Endpoint:    allocator.URL,
+			Interval:    100 * time.Millisecond,
+			CollectorID: "collector-1",
+			Collectors:  collectors,
+		},
+	}, new(consumertest.MetricsSink))
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, receiver.Shutdown(context.Background()))
+	}()
+
+	// only the targets the hash ring assigns to the collector are scraped
+	assert.Eventually(t, func() bool {
+		var active []string
+		for _, target := range receiver.scrapeManager.TargetsActive()["allocated-job"] {
+			active = append(active, target.URL().Host)
+		}
+		sort.Strings(active)
+		sort.Strings(expected)
+		return assert.ObjectsAreEqual(expected, active)
+	}, 30*time.Second, 100*time.Millisecond, "the targets assigned to the collector are not scraped")
+}
+
+func TestGetTargetAllocatorJobsError(t *testing.T) {
+	allocator := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer allocator.Close()
+
+	_, err := getTargetAllocatorJobs(context.Background(), http.DefaultClient, allocator.URL)
+	assert.EqualError(t, err, `unexpected status "503 Service Unavailable" getting the target allocator jobs`)
+}
+
+func TestHashJobs(t *testing.T) {
+	jobs := map[string]jobLink{
+		"job1": {Link: "/jobs/job1/targets"},
+		"job2": {Link: "/jobs/job2/targets"},
+	}
+	hash := hashJobs([]string{"job1", "job2"}, jobs)
+	assert.Equal(t, hash, hashJobs([]string{"job1", "job2"}, jobs))
+
+	jobs["job2"] = jobLink{Link: "/jobs/job3/targets"}
+	assert.NotEqual(t, hash, hashJobs([]string{"job1", "job2"}, jobs))
+	assert.NotEqual(t, hash, hashJobs([]string{"job1"}, jobs))
+}
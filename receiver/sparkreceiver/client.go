@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparkreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/models"
+)
+
+const (
+	applicationsPath = "/api/v1/applications"
+
+	// The applications and stages are filtered by status on the server side,
+	// as a history server or a long-running driver keeps many of them.
+	runningApplicationsQuery = "status=running"
+	activeStagesQuery        = "status=active"
+)
+
+type client interface {
+	// GetApplications returns the running applications
+	GetApplications(ctx context.Context) ([]*models.Application, error)
+	// GetExecutors returns the executors of an application, including its driver
+	GetExecutors(ctx context.Context, appID string) ([]*models.Executor, error)
+	// GetStages returns the active stages of an application
+	GetStages(ctx context.Context, appID string) ([]*models.Stage, error)
+}
+
+var _ client = (*sparkClient)(nil)
+
+type sparkClient struct {
+	client       *http.Client
+	hostEndpoint string
+	logger       *zap.Logger
+}
+
+func newClient(cfg *Config, host component.Host, settings component.TelemetrySettings, logger *zap.Logger) (client, error) {
+	httpClient, err := cfg.ToClient(host.GetExtensions(), settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+	}
+
+	return &sparkClient{
+		client:       httpClient,
+		hostEndpoint: cfg.Endpoint,
+		logger:       logger,
+	}, nil
+}
+
+func (c *sparkClient) GetApplications(ctx context.Context) ([]*models.Application, error) {
+	var apps []*models.Application
+	if err := c.get(ctx, applicationsPath+"?"+runningApplicationsQuery, &apps); err != nil {
+		c.logger.Debug("Failed to retrieve applications", zap.Error(err))
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (c *sparkClient) GetExecutors(ctx context.Context, appID string) ([]*models.Executor, error) {
+	var executors []*models.Executor
+	if err := c.get(ctx, applicationPath(appID, "executors"), &executors); err != nil {
+		c.logger.Debug("Failed to retrieve executors", zap.String("application", appID), zap.Error(err))
+		return nil, err
+	}
+	return executors, nil
+}
+
+func (c *sparkClient) GetStages(ctx context.Context, appID string) ([]*models.Stage, error) {
+	var stages []*models.Stage
+	if err := c.get(ctx, applicationPath(appID, "stages")+"?"+activeStagesQuery, &stages); err != nil {
+		c.logger.Debug("Failed to retrieve stages", zap.String("application", appID), zap.Error(err))
+		return nil, err
+	}
+	return stages, nil
+}
+
+func applicationPath(appID, resource string) string {
+	return applicationsPath + "/" + url.PathEscape(appID) + "/" + resource
+}
+
+func (c *sparkClient) get(ctx context.Context, path string, respObj interface{}) error {
+	// Construct endpoint and create request
+	url := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create get request for path %s: %w", path, err)
+	}
+
+	// Make request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make http request: %w", err)
+	}
+
+	// Defer body close
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Warn("failed to close response body", zap.Error(closeErr))
+		}
+	}()
+
+	// Check for OK status code
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Debug("Spark REST API non-200", zap.Int("status_code", resp.StatusCode))
+
+		// Attempt to extract the error payload
+		payloadData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.logger.Debug("failed to read payload error message", zap.Error(err))
+		} else {
+			c.logger.Debug("Spark REST API Error", zap.ByteString("api_error", payloadData))
+		}
+
+		return fmt.Errorf("non 200 code returned %d", resp.StatusCode)
+	}
+
+	// Decode the payload into the passed in response object
+	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
+		return fmt.Errorf("failed to decode response payload: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparkreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver"
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/models"
+)
+
+const (
+	applicationsResponseFile = "get_applications_response.json"
+	executorsResponseFile    = "get_executors_response.json"
+	stagesResponseFile       = "get_stages_response.json"
+)
+
+func TestNewClient(t *testing.T) {
+	testCase := []struct {
+		desc        string
+		cfg         *Config
+		host        component.Host
+		settings    component.TelemetrySettings
+		logger      *zap.Logger
+		expectError error
+	}{
+		{
+			desc: "Invalid HTTP config",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+					TLSSetting: configtls.TLSClientSetting{
+						TLSSetting: configtls.TLSSetting{
+							CAFile: "/non/existent",
+						},
+					},
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: errors.New("failed to create HTTP Client"),
+		},
+		{
+			desc: "Valid Configuration",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					TLSSetting: configtls.TLSClientSetting{},
+					Endpoint:   defaultEndpoint,
+				},
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.desc, func(t *testing.T) {
+			sc, err := newClient(tc.cfg, tc.host, tc.settings, tc.logger)
+			if tc.expectError != nil {
+				require.Nil(t, sc)
+				require.Contains(t, err.Error(), tc.expectError.Error())
+			} else {
+				require.NoError(t, err)
+
+				actualClient, ok := sc.(*sparkClient)
+				require.True(t, ok)
+
+				require.Equal(t, tc.cfg.Endpoint, actualClient.hostEndpoint)
+				require.Equal(t, tc.logger, actualClient.logger)
+				require.NotNil(t, actualClient.client)
+			}
+		})
+	}
+}
+
+func TestGetApplications(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				apps, err := tc.GetApplications(context.Background())
+				require.Nil(t, apps)
+				require.EqualError(t, err, "non 200 code returned 404")
+			},
+		},
+		{
+			desc: "Bad payload returned",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte("{}"))
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				apps, err := tc.GetApplications(context.Background())
+				require.Nil(t, apps)
+				require.Contains(t, err.Error(), "failed to decode response payload")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, applicationsResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, applicationsPath, r.URL.Path)
+					require.Equal(t, "running", r.URL.Query().Get("status"))
+					w.Write(data)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				apps, err := tc.GetApplications(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, []*models.Application{
+					{ID: "app-20220301101500-0001", Name: "etl-daily"},
+				}, apps)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetExecutorsAndStages(t *testing.T) {
+	responses := map[string][]byte{
+		applicationsPath + "/app-20220301101500-0001/executors": loadAPIResponseData(t, executorsResponseFile),
+		applicationsPath + "/app-20220301101500-0001/stages":    loadAPIResponseData(t, stagesResponseFile),
+	}
+
+	// Setup test server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path == applicationsPath+"/app-20220301101500-0001/stages" {
+			require.Equal(t, "active", r.URL.Query().Get("status"))
+		}
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	tc := createTestClient(t, ts.URL)
+
+	executors, err := tc.GetExecutors(context.Background(), "app-20220301101500-0001")
+	require.NoError(t, err)
+	require.Len(t, executors, 2)
+	require.Equal(t, "driver", executors[0].ID)
+	require.Equal(t, &models.Executor{
+		ID:                "1",
+		IsActive:          true,
+		MemoryUsed:        10485760,
+		MaxMemory:         2101975449,
+		DiskUsed:          2048,
+		ActiveTasks:       3,
+		FailedTasks:       2,
+		CompletedTasks:    120,
+		TotalDuration:     98000,
+		TotalGCTime:       1500,
+		TotalInputBytes:   734003200,
+		TotalShuffleRead:  52428800,
+		TotalShuffleWrite: 41943040,
+	}, executors[1])
+
+	stages, err := tc.GetStages(context.Background(), "app-20220301101500-0001")
+	require.NoError(t, err)
+	require.Len(t, stages, 1)
+	require.Equal(t, int64(7), stages[0].StageID)
+	require.Equal(t, "save at EtlJob.scala:42", stages[0].Name)
+	require.Equal(t, int64(1), stages[0].NumFailedTasks)
+
+	_, err = tc.GetExecutors(context.Background(), "unknown")
+	require.EqualError(t, err, "non 200 code returned 404")
+}
+
+func createTestClient(t *testing.T, baseEndpoint string) client {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = baseEndpoint
+
+	testClient, err := newClient(cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+	require.NoError(t, err)
+	return testClient
+}
+
+func loadAPIResponseData(t *testing.T, fileName string) []byte {
+	t.Helper()
+	fullPath := filepath.Join("testdata", "apiresponses", fileName)
+
+	data, err := ioutil.ReadFile(fullPath)
+	require.NoError(t, err)
+
+	return data
+}
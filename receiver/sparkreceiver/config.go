@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparkreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/metadata"
+)
+
+var errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+
+const defaultEndpoint = "http://localhost:4040"
+
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+	// Applications is the allowlist of regular expressions matched against the
+	// names of the running applications to scrape. All the running applications
+	// are scraped when empty.
+	Applications []string                 `mapstructure:"applications"`
+	Metrics      metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+
+	u, parseErr := url.Parse(cfg.Endpoint)
+	if parseErr != nil {
+		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
+		err = multierr.Append(err, wrappedErr)
+	} else if u.Scheme == "" || u.Host == "" {
+		err = multierr.Append(err, errInvalidEndpoint)
+	}
+
+	if _, filterErr := compileFilters(cfg.Applications); filterErr != nil {
+		err = multierr.Append(err, filterErr)
+	}
+
+	return err
+}
+
+func compileFilters(filters []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(filters))
+	for _, filter := range filters {
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter regular expression %q: %w", filter, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/models"
+
+// Application is an application of the Spark REST API /applications endpoint
+type Application struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Executor is an executor of the Spark REST API /applications/[app-id]/executors endpoint
+type Executor struct {
+	ID                string `json:"id"`
+	IsActive          bool   `json:"isActive"`
+	MemoryUsed        int64  `json:"memoryUsed"`
+	MaxMemory         int64  `json:"maxMemory"`
+	DiskUsed          int64  `json:"diskUsed"`
+	ActiveTasks       int64  `json:"activeTasks"`
+	FailedTasks       int64  `json:"failedTasks"`
+	CompletedTasks    int64  `json:"completedTasks"`
+	TotalDuration     int64  `json:"totalDuration"`
+	TotalGCTime       int64  `json:"totalGCTime"`
+	TotalInputBytes   int64  `json:"totalInputBytes"`
+	TotalShuffleRead  int64  `json:"totalShuffleRead"`
+	TotalShuffleWrite int64  `json:"totalShuffleWrite"`
+}
+
+// Stage is a stage attempt of the Spark REST API /applications/[app-id]/stages endpoint
+type Stage struct {
+	Status             string `json:"status"`
+	StageID            int64  `json:"stageId"`
+	AttemptID          int64  `json:"attemptId"`
+	Name               string `json:"name"`
+	NumActiveTasks     int64  `json:"numActiveTasks"`
+	NumCompleteTasks   int64  `json:"numCompleteTasks"`
+	NumFailedTasks     int64  `json:"numFailedTasks"`
+	NumKilledTasks     int64  `json:"numKilledTasks"`
+	ExecutorRunTime    int64  `json:"executorRunTime"`
+	InputBytes         int64  `json:"inputBytes"`
+	OutputBytes        int64  `json:"outputBytes"`
+	ShuffleReadBytes   int64  `json:"shuffleReadBytes"`
+	ShuffleWriteBytes  int64  `json:"shuffleWriteBytes"`
+	MemoryBytesSpilled int64  `json:"memoryBytesSpilled"`
+	DiskBytesSpilled   int64  `json:"diskBytesSpilled"`
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparkreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver"
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/models"
+)
+
+const instrumentationLibraryName = "otelcol/spark"
+
+const (
+	executorMetricsLen = 9
+	stageMetricsLen    = 6
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+type sparkScraper struct {
+	client       client
+	logger       *zap.Logger
+	cfg          *Config
+	settings     component.TelemetrySettings
+	mb           *metadata.MetricsBuilder
+	applications []*regexp.Regexp
+}
+
+func newScraper(logger *zap.Logger, cfg *Config, settings component.TelemetrySettings) *sparkScraper {
+	return &sparkScraper{
+		logger:   logger,
+		cfg:      cfg,
+		settings: settings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics),
+	}
+}
+
+func (s *sparkScraper) start(ctx context.Context, host component.Host) (err error) {
+	if s.applications, err = compileFilters(s.cfg.Applications); err != nil {
+		return err
+	}
+
+	s.client, err = newClient(s.cfg, host, s.settings, s.logger)
+	return
+}
+
+func (s *sparkScraper) scrape(ctx context.Context) (pdata.Metrics, error) {
+	metrics := pdata.NewMetrics()
+	now := pdata.NewTimestampFromTime(time.Now())
+	rms := metrics.ResourceMetrics()
+
+	// Validate we don't attempt to scrape without initializing the client
+	if s.client == nil {
+		return metrics, errClientNotInit
+	}
+
+	apps, err := s.client.GetApplications(ctx)
+	if err != nil {
+		return metrics, scrapererror.NewPartialScrapeError(err, executorMetricsLen+stageMetricsLen)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, app := range apps {
+		if !matchesFilters(s.applications, app.Name) {
+			continue
+		}
+
+		executors, err := s.client.GetExecutors(ctx, app.ID)
+		if err != nil {
+			errs.AddPartial(executorMetricsLen, err)
+		}
+		for _, executor := range executors {
+			s.collectExecutor(app, executor, now, rms)
+		}
+
+		stages, err := s.client.GetStages(ctx, app.ID)
+		if err != nil {
+			errs.AddPartial(stageMetricsLen, err)
+		}
+		for _, stage := range stages {
+			s.collectStage(app, stage, now, rms)
+		}
+	}
+
+	return metrics, errs.Combine()
+}
+
+func matchesFilters(filters []*regexp.Regexp, name string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, re := range filters {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *sparkScraper) collectExecutor(app *models.Application, executor *models.Executor, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	ilms := appendResourceMetrics(rms, map[string]string{
+		metadata.A.SparkApplicationID:   app.ID,
+		metadata.A.SparkApplicationName: app.Name,
+		metadata.A.SparkExecutorID:      executor.ID,
+	})
+
+	s.mb.RecordSparkExecutorMemoryUsageDataPoint(now, executor.MemoryUsed)
+	s.mb.RecordSparkExecutorMemoryLimitDataPoint(now, executor.MaxMemory)
+	s.mb.RecordSparkExecutorDiskUsageDataPoint(now, executor.DiskUsed)
+	s.mb.RecordSparkExecutorTaskActiveDataPoint(now, executor.ActiveTasks)
+	s.mb.RecordSparkExecutorTaskCountDataPoint(now, executor.CompletedTasks, metadata.AttributeState.Completed)
+	s.mb.RecordSparkExecutorTaskCountDataPoint(now, executor.FailedTasks, metadata.AttributeState.Failed)
+	s.mb.RecordSparkExecutorTaskDurationDataPoint(now, executor.TotalDuration)
+	s.mb.RecordSparkExecutorGcTimeDataPoint(now, executor.TotalGCTime)
+	s.mb.RecordSparkExecutorInputSizeDataPoint(now, executor.TotalInputBytes)
+	s.mb.RecordSparkExecutorShuffleIoSizeDataPoint(now, executor.TotalShuffleRead, metadata.AttributeDirection.Read)
+	s.mb.RecordSparkExecutorShuffleIoSizeDataPoint(now, executor.TotalShuffleWrite, metadata.AttributeDirection.Write)
+
+	s.mb.Emit(ilms.Metrics())
+}
+
+func (s *sparkScraper) collectStage(app *models.Application, stage *models.Stage, now pdata.Timestamp, rms pdata.ResourceMetricsSlice) {
+	ilms := appendResourceMetrics(rms, map[string]string{
+		metadata.A.SparkApplicationID:   app.ID,
+		metadata.A.SparkApplicationName: app.Name,
+		metadata.A.SparkStageID:         strconv.FormatInt(stage.StageID, 10),
+		metadata.A.SparkStageAttemptID:  strconv.FormatInt(stage.AttemptID, 10),
+		metadata.A.SparkStageName:       stage.Name,
+	})
+
+	s.mb.RecordSparkStageTaskCountDataPoint(now, stage.NumActiveTasks, metadata.AttributeState.Active)
+	s.mb.RecordSparkStageTaskCountDataPoint(now, stage.NumCompleteTasks, metadata.AttributeState.Completed)
+	s.mb.RecordSparkStageTaskCountDataPoint(now, stage.NumFailedTasks, metadata.AttributeState.Failed)
+	s.mb.RecordSparkStageTaskCountDataPoint(now, stage.NumKilledTasks, metadata.AttributeState.Killed)
+	s.mb.RecordSparkStageExecutorRunTimeDataPoint(now, stage.ExecutorRunTime)
+	s.mb.RecordSparkStageIoSizeDataPoint(now, stage.InputBytes, metadata.AttributeDirection.Read)
+	s.mb.RecordSparkStageIoSizeDataPoint(now, stage.OutputBytes, metadata.AttributeDirection.Write)
+	s.mb.RecordSparkStageShuffleIoSizeDataPoint(now, stage.ShuffleReadBytes, metadata.AttributeDirection.Read)
+	s.mb.RecordSparkStageShuffleIoSizeDataPoint(now, stage.ShuffleWriteBytes, metadata.AttributeDirection.Write)
+	s.mb.RecordSparkStageMemorySpilledDataPoint(now, stage.MemoryBytesSpilled)
+	s.mb.RecordSparkStageDiskSpilledDataPoint(now, stage.DiskBytesSpilled)
+
+	s.mb.Emit(ilms.Metrics())
+}
+
+func appendResourceMetrics(rms pdata.ResourceMetricsSlice, attributes map[string]string) pdata.InstrumentationLibraryMetrics {
+	resourceMetric := rms.AppendEmpty()
+	resourceAttrs := resourceMetric.Resource().Attributes()
+	for key, value := range attributes {
+		if value != "" {
+			resourceAttrs.InsertString(key, value)
+		}
+	}
+
+	ilms := resourceMetric.InstrumentationLibraryMetrics().AppendEmpty()
+	ilms.InstrumentationLibrary().SetName(instrumentationLibraryName)
+	return ilms
+}
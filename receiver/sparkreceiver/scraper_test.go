@@ -0,0 +1,306 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparkreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver"
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sparkreceiver/internal/models"
+)
+
+type fakeClient struct {
+	apps         []*models.Application
+	executors    map[string][]*models.Executor
+	stages       map[string][]*models.Stage
+	appsErr      error
+	executorsErr error
+	stagesErr    error
+}
+
+func (c *fakeClient) GetApplications(context.Context) ([]*models.Application, error) {
+	return c.apps, c.appsErr
+}
+
+func (c *fakeClient) GetExecutors(_ context.Context, appID string) ([]*models.Executor, error) {
+	return c.executors[appID], c.executorsErr
+}
+
+func (c *fakeClient) GetStages(_ context.Context, appID string) ([]*models.Stage, error) {
+	return c.stages[appID], c.stagesErr
+}
+
+func testClient() *fakeClient {
+	return &fakeClient{
+		apps: []*models.Application{
+			{ID: "app-1", Name: "etl-daily"},
+			{ID: "app-2", Name: "adhoc-query"},
+		},
+		executors: map[string][]*models.Executor{
+			"app-1": {
+				{
+					ID:                "1",
+					IsActive:          true,
+					MemoryUsed:        10485760,
+					MaxMemory:         2101975449,
+					DiskUsed:          2048,
+					ActiveTasks:       3,
+					FailedTasks:       2,
+					CompletedTasks:    120,
+					TotalDuration:     98000,
+					TotalGCTime:       1500,
+					TotalInputBytes:   734003200,
+					TotalShuffleRead:  52428800,
+					TotalShuffleWrite: 41943040,
+				},
+			},
+			"app-2": {
+				{ID: "driver", IsActive: true, MaxMemory: 455501414},
+			},
+		},
+		stages: map[string][]*models.Stage{
+			"app-1": {
+				{
+					Status:             "ACTIVE",
+					StageID:            7,
+					AttemptID:          1,
+					Name:               "save at EtlJob.scala:42",
+					NumActiveTasks:     3,
+					NumCompleteTasks:   150,
+					NumFailedTasks:     1,
+					ExecutorRunTime:    42000,
+					InputBytes:         367001600,
+					OutputBytes:        1048576,
+					ShuffleReadBytes:   20971520,
+					ShuffleWriteBytes:  10485760,
+					MemoryBytesSpilled: 4194304,
+					DiskBytesSpilled:   1048576,
+				},
+			},
+		},
+	}
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		scraper     *sparkScraper
+		expectError bool
+	}{
+		{
+			desc: "Bad Config",
+			scraper: &sparkScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+						TLSSetting: configtls.TLSClientSetting{
+							TLSSetting: configtls.TLSSetting{
+								CAFile: "/non/existent",
+							},
+						},
+					},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Bad application regular expression",
+			scraper: &sparkScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: defaultEndpoint,
+					},
+					Applications: []string{"etl("},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid Config",
+			scraper: &sparkScraper{
+				cfg: &Config{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						TLSSetting: configtls.TLSClientSetting{},
+						Endpoint:   defaultEndpoint,
+					},
+					Applications: []string{"^etl-"},
+				},
+				settings: componenttest.NewNopTelemetrySettings(),
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrapeErrors(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+
+	client := testClient()
+	client.appsErr = errors.New("some api error")
+	scraper.client = client
+	metrics, err = scraper.scrape(context.Background())
+	require.EqualError(t, err, "some api error")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, executorMetricsLen+stageMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+
+	client = testClient()
+	client.stages = nil
+	client.stagesErr = errors.New("some api error")
+	scraper.client = client
+	metrics, err = scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 2*stageMetricsLen, err.(scrapererror.PartialScrapeError).Failed)
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		applications  []string
+		expectedNames []string
+	}{
+		{
+			desc:          "All applications",
+			expectedNames: []string{"etl-daily", "etl-daily", "adhoc-query"},
+		},
+		{
+			desc:          "Allowlist",
+			applications:  []string{"^etl-"},
+			expectedNames: []string{"etl-daily", "etl-daily"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Applications = tc.applications
+			scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			scraper.client = testClient()
+
+			metrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			rms := metrics.ResourceMetrics()
+			require.Equal(t, len(tc.expectedNames), rms.Len())
+			for i, name := range tc.expectedNames {
+				attrs := rms.At(i).Resource().Attributes().AsRaw()
+				require.Equal(t, name, attrs["spark.application.name"])
+
+				ilms := rms.At(i).InstrumentationLibraryMetrics()
+				require.Equal(t, 1, ilms.Len())
+				require.Equal(t, instrumentationLibraryName, ilms.At(0).InstrumentationLibrary().Name())
+			}
+		})
+	}
+}
+
+func TestScraperScrapeValues(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+	scraper.client = testClient()
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	rms := metrics.ResourceMetrics()
+	require.Equal(t, 3, rms.Len())
+
+	require.Equal(t, map[string]interface{}{
+		"spark.application.id":   "app-1",
+		"spark.application.name": "etl-daily",
+		"spark.executor.id":      "1",
+	}, rms.At(0).Resource().Attributes().AsRaw())
+	require.Equal(t, map[string]map[string]int64{
+		"spark.executor.memory.usage":    {"": 10485760},
+		"spark.executor.memory.limit":    {"": 2101975449},
+		"spark.executor.disk.usage":      {"": 2048},
+		"spark.executor.task.active":     {"": 3},
+		"spark.executor.task.count":      {"completed": 120, "failed": 2},
+		"spark.executor.task.duration":   {"": 98000},
+		"spark.executor.gc_time":         {"": 1500},
+		"spark.executor.input.size":      {"": 734003200},
+		"spark.executor.shuffle.io.size": {"read": 52428800, "write": 41943040},
+	}, dataPointValues(t, rms.At(0)))
+
+	require.Equal(t, map[string]interface{}{
+		"spark.application.id":   "app-1",
+		"spark.application.name": "etl-daily",
+		"spark.stage.id":         "7",
+		"spark.stage.attempt.id": "1",
+		"spark.stage.name":       "save at EtlJob.scala:42",
+	}, rms.At(1).Resource().Attributes().AsRaw())
+	require.Equal(t, map[string]map[string]int64{
+		"spark.stage.task.count":        {"active": 3, "completed": 150, "failed": 1, "killed": 0},
+		"spark.stage.executor.run_time": {"": 42000},
+		"spark.stage.io.size":           {"read": 367001600, "write": 1048576},
+		"spark.stage.shuffle.io.size":   {"read": 20971520, "write": 10485760},
+		"spark.stage.memory.spilled":    {"": 4194304},
+		"spark.stage.disk.spilled":      {"": 1048576},
+	}, dataPointValues(t, rms.At(1)))
+
+	require.Equal(t, map[string]interface{}{
+		"spark.application.id":   "app-2",
+		"spark.application.name": "adhoc-query",
+		"spark.executor.id":      "driver",
+	}, rms.At(2).Resource().Attributes().AsRaw())
+}
+
+// dataPointValues returns the values of the data points of the metrics keyed
+// by the metric name and the value of their attribute, if any.
+func dataPointValues(t *testing.T, rm pdata.ResourceMetrics) map[string]map[string]int64 {
+	values := make(map[string]map[string]int64)
+	ms := rm.InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		require.Equal(t, pdata.MetricDataTypeSum, m.DataType(), m.Name())
+		values[m.Name()] = make(map[string]int64)
+		dps := m.Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attribute := ""
+			dps.At(j).Attributes().Range(func(_ string, v pdata.AttributeValue) bool {
+				attribute = v.StringVal()
+				return false
+			})
+			values[m.Name()][attribute] = dps.At(j).IntVal()
+		}
+	}
+	return values
+}
@@ -1,4 +0,0 @@
-//go:build !windows
-// +build !windows
-
-package win_perf_counters // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver/internal/third_party/telegraf/win_perf_counters"